@@ -152,13 +152,164 @@ func (x *_Params_10_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_12_list)(nil)
+
+type _Params_12_list struct {
+	list *[]*ScheduledEIP
+}
+
+func (x *_Params_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledEIP)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledEIP)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_12_list) AppendMutable() protoreflect.Value {
+	v := new(ScheduledEIP)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_12_list) NewElement() protoreflect.Value {
+	v := new(ScheduledEIP)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_13_list)(nil)
+
+type _Params_13_list struct {
+	list *[]string
+}
+
+func (x *_Params_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field Bech32Hrps as it is not of Message kind"))
+}
+
+func (x *_Params_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_13_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_14_list)(nil)
+
+type _Params_14_list struct {
+	list *[]string
+}
+
+func (x *_Params_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_14_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field BlockedContracts as it is not of Message kind"))
+}
+
+func (x *_Params_14_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_14_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_14_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                           protoreflect.MessageDescriptor
-	fd_Params_extra_eips                protoreflect.FieldDescriptor
-	fd_Params_allow_unprotected_txs     protoreflect.FieldDescriptor
-	fd_Params_evm_channels              protoreflect.FieldDescriptor
-	fd_Params_access_control            protoreflect.FieldDescriptor
-	fd_Params_active_static_precompiles protoreflect.FieldDescriptor
+	md_Params                               protoreflect.MessageDescriptor
+	fd_Params_extra_eips                    protoreflect.FieldDescriptor
+	fd_Params_allow_unprotected_txs         protoreflect.FieldDescriptor
+	fd_Params_evm_channels                  protoreflect.FieldDescriptor
+	fd_Params_access_control                protoreflect.FieldDescriptor
+	fd_Params_active_static_precompiles     protoreflect.FieldDescriptor
+	fd_Params_precompile_dynamic_gas_height protoreflect.FieldDescriptor
+	fd_Params_scheduled_eips                protoreflect.FieldDescriptor
+	fd_Params_bech32_hrps                   protoreflect.FieldDescriptor
+	fd_Params_blocked_contracts             protoreflect.FieldDescriptor
+	fd_Params_security_council              protoreflect.FieldDescriptor
+	fd_Params_osmosis_outpost               protoreflect.FieldDescriptor
+	fd_Params_stride_outpost                protoreflect.FieldDescriptor
+	fd_Params_receipt_retention_blocks      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -169,6 +320,14 @@ func init() {
 	fd_Params_evm_channels = md_Params.Fields().ByName("evm_channels")
 	fd_Params_access_control = md_Params.Fields().ByName("access_control")
 	fd_Params_active_static_precompiles = md_Params.Fields().ByName("active_static_precompiles")
+	fd_Params_precompile_dynamic_gas_height = md_Params.Fields().ByName("precompile_dynamic_gas_height")
+	fd_Params_scheduled_eips = md_Params.Fields().ByName("scheduled_eips")
+	fd_Params_bech32_hrps = md_Params.Fields().ByName("bech32_hrps")
+	fd_Params_blocked_contracts = md_Params.Fields().ByName("blocked_contracts")
+	fd_Params_security_council = md_Params.Fields().ByName("security_council")
+	fd_Params_osmosis_outpost = md_Params.Fields().ByName("osmosis_outpost")
+	fd_Params_stride_outpost = md_Params.Fields().ByName("stride_outpost")
+	fd_Params_receipt_retention_blocks = md_Params.Fields().ByName("receipt_retention_blocks")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -266,6 +425,54 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.PrecompileDynamicGasHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PrecompileDynamicGasHeight)
+		if !f(fd_Params_precompile_dynamic_gas_height, value) {
+			return
+		}
+	}
+	if len(x.ScheduledEips) != 0 {
+		value := protoreflect.ValueOfList(&_Params_12_list{list: &x.ScheduledEips})
+		if !f(fd_Params_scheduled_eips, value) {
+			return
+		}
+	}
+	if len(x.Bech32Hrps) != 0 {
+		value := protoreflect.ValueOfList(&_Params_13_list{list: &x.Bech32Hrps})
+		if !f(fd_Params_bech32_hrps, value) {
+			return
+		}
+	}
+	if len(x.BlockedContracts) != 0 {
+		value := protoreflect.ValueOfList(&_Params_14_list{list: &x.BlockedContracts})
+		if !f(fd_Params_blocked_contracts, value) {
+			return
+		}
+	}
+	if x.SecurityCouncil != "" {
+		value := protoreflect.ValueOfString(x.SecurityCouncil)
+		if !f(fd_Params_security_council, value) {
+			return
+		}
+	}
+	if x.OsmosisOutpost != nil {
+		value := protoreflect.ValueOfMessage(x.OsmosisOutpost.ProtoReflect())
+		if !f(fd_Params_osmosis_outpost, value) {
+			return
+		}
+	}
+	if x.StrideOutpost != nil {
+		value := protoreflect.ValueOfMessage(x.StrideOutpost.ProtoReflect())
+		if !f(fd_Params_stride_outpost, value) {
+			return
+		}
+	}
+	if x.ReceiptRetentionBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReceiptRetentionBlocks)
+		if !f(fd_Params_receipt_retention_blocks, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -291,6 +498,22 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.AccessControl != nil
 	case "ethermint.evm.v1.Params.active_static_precompiles":
 		return len(x.ActiveStaticPrecompiles) != 0
+	case "ethermint.evm.v1.Params.precompile_dynamic_gas_height":
+		return x.PrecompileDynamicGasHeight != int64(0)
+	case "ethermint.evm.v1.Params.scheduled_eips":
+		return len(x.ScheduledEips) != 0
+	case "ethermint.evm.v1.Params.bech32_hrps":
+		return len(x.Bech32Hrps) != 0
+	case "ethermint.evm.v1.Params.blocked_contracts":
+		return len(x.BlockedContracts) != 0
+	case "ethermint.evm.v1.Params.security_council":
+		return x.SecurityCouncil != ""
+	case "ethermint.evm.v1.Params.osmosis_outpost":
+		return x.OsmosisOutpost != nil
+	case "ethermint.evm.v1.Params.stride_outpost":
+		return x.StrideOutpost != nil
+	case "ethermint.evm.v1.Params.receipt_retention_blocks":
+		return x.ReceiptRetentionBlocks != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Params"))
@@ -317,6 +540,22 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.AccessControl = nil
 	case "ethermint.evm.v1.Params.active_static_precompiles":
 		x.ActiveStaticPrecompiles = nil
+	case "ethermint.evm.v1.Params.precompile_dynamic_gas_height":
+		x.PrecompileDynamicGasHeight = int64(0)
+	case "ethermint.evm.v1.Params.scheduled_eips":
+		x.ScheduledEips = nil
+	case "ethermint.evm.v1.Params.bech32_hrps":
+		x.Bech32Hrps = nil
+	case "ethermint.evm.v1.Params.blocked_contracts":
+		x.BlockedContracts = nil
+	case "ethermint.evm.v1.Params.security_council":
+		x.SecurityCouncil = ""
+	case "ethermint.evm.v1.Params.osmosis_outpost":
+		x.OsmosisOutpost = nil
+	case "ethermint.evm.v1.Params.stride_outpost":
+		x.StrideOutpost = nil
+	case "ethermint.evm.v1.Params.receipt_retention_blocks":
+		x.ReceiptRetentionBlocks = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Params"))
@@ -357,6 +596,39 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_10_list{list: &x.ActiveStaticPrecompiles}
 		return protoreflect.ValueOfList(listValue)
+	case "ethermint.evm.v1.Params.precompile_dynamic_gas_height":
+		value := x.PrecompileDynamicGasHeight
+		return protoreflect.ValueOfInt64(value)
+	case "ethermint.evm.v1.Params.scheduled_eips":
+		if len(x.ScheduledEips) == 0 {
+			return protoreflect.ValueOfList(&_Params_12_list{})
+		}
+		listValue := &_Params_12_list{list: &x.ScheduledEips}
+		return protoreflect.ValueOfList(listValue)
+	case "ethermint.evm.v1.Params.bech32_hrps":
+		if len(x.Bech32Hrps) == 0 {
+			return protoreflect.ValueOfList(&_Params_13_list{})
+		}
+		listValue := &_Params_13_list{list: &x.Bech32Hrps}
+		return protoreflect.ValueOfList(listValue)
+	case "ethermint.evm.v1.Params.blocked_contracts":
+		if len(x.BlockedContracts) == 0 {
+			return protoreflect.ValueOfList(&_Params_14_list{})
+		}
+		listValue := &_Params_14_list{list: &x.BlockedContracts}
+		return protoreflect.ValueOfList(listValue)
+	case "ethermint.evm.v1.Params.security_council":
+		value := x.SecurityCouncil
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.Params.osmosis_outpost":
+		value := x.OsmosisOutpost
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ethermint.evm.v1.Params.stride_outpost":
+		value := x.StrideOutpost
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ethermint.evm.v1.Params.receipt_retention_blocks":
+		value := x.ReceiptRetentionBlocks
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Params"))
@@ -393,6 +665,28 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_10_list)
 		x.ActiveStaticPrecompiles = *clv.list
+	case "ethermint.evm.v1.Params.precompile_dynamic_gas_height":
+		x.PrecompileDynamicGasHeight = value.Int()
+	case "ethermint.evm.v1.Params.scheduled_eips":
+		lv := value.List()
+		clv := lv.(*_Params_12_list)
+		x.ScheduledEips = *clv.list
+	case "ethermint.evm.v1.Params.bech32_hrps":
+		lv := value.List()
+		clv := lv.(*_Params_13_list)
+		x.Bech32Hrps = *clv.list
+	case "ethermint.evm.v1.Params.blocked_contracts":
+		lv := value.List()
+		clv := lv.(*_Params_14_list)
+		x.BlockedContracts = *clv.list
+	case "ethermint.evm.v1.Params.security_council":
+		x.SecurityCouncil = value.Interface().(string)
+	case "ethermint.evm.v1.Params.osmosis_outpost":
+		x.OsmosisOutpost = value.Message().Interface().(*OsmosisOutpost)
+	case "ethermint.evm.v1.Params.stride_outpost":
+		x.StrideOutpost = value.Message().Interface().(*StrideOutpost)
+	case "ethermint.evm.v1.Params.receipt_retention_blocks":
+		x.ReceiptRetentionBlocks = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Params"))
@@ -436,8 +730,42 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_10_list{list: &x.ActiveStaticPrecompiles}
 		return protoreflect.ValueOfList(value)
+	case "ethermint.evm.v1.Params.scheduled_eips":
+		if x.ScheduledEips == nil {
+			x.ScheduledEips = []*ScheduledEIP{}
+		}
+		value := &_Params_12_list{list: &x.ScheduledEips}
+		return protoreflect.ValueOfList(value)
+	case "ethermint.evm.v1.Params.bech32_hrps":
+		if x.Bech32Hrps == nil {
+			x.Bech32Hrps = []string{}
+		}
+		value := &_Params_13_list{list: &x.Bech32Hrps}
+		return protoreflect.ValueOfList(value)
+	case "ethermint.evm.v1.Params.blocked_contracts":
+		if x.BlockedContracts == nil {
+			x.BlockedContracts = []string{}
+		}
+		value := &_Params_14_list{list: &x.BlockedContracts}
+		return protoreflect.ValueOfList(value)
+	case "ethermint.evm.v1.Params.osmosis_outpost":
+		if x.OsmosisOutpost == nil {
+			x.OsmosisOutpost = new(OsmosisOutpost)
+		}
+		return protoreflect.ValueOfMessage(x.OsmosisOutpost.ProtoReflect())
+	case "ethermint.evm.v1.Params.stride_outpost":
+		if x.StrideOutpost == nil {
+			x.StrideOutpost = new(StrideOutpost)
+		}
+		return protoreflect.ValueOfMessage(x.StrideOutpost.ProtoReflect())
 	case "ethermint.evm.v1.Params.allow_unprotected_txs":
 		panic(fmt.Errorf("field allow_unprotected_txs of message ethermint.evm.v1.Params is not mutable"))
+	case "ethermint.evm.v1.Params.precompile_dynamic_gas_height":
+		panic(fmt.Errorf("field precompile_dynamic_gas_height of message ethermint.evm.v1.Params is not mutable"))
+	case "ethermint.evm.v1.Params.security_council":
+		panic(fmt.Errorf("field security_council of message ethermint.evm.v1.Params is not mutable"))
+	case "ethermint.evm.v1.Params.receipt_retention_blocks":
+		panic(fmt.Errorf("field receipt_retention_blocks of message ethermint.evm.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Params"))
@@ -465,6 +793,27 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "ethermint.evm.v1.Params.active_static_precompiles":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
+	case "ethermint.evm.v1.Params.precompile_dynamic_gas_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "ethermint.evm.v1.Params.scheduled_eips":
+		list := []*ScheduledEIP{}
+		return protoreflect.ValueOfList(&_Params_12_list{list: &list})
+	case "ethermint.evm.v1.Params.bech32_hrps":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_13_list{list: &list})
+	case "ethermint.evm.v1.Params.blocked_contracts":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_14_list{list: &list})
+	case "ethermint.evm.v1.Params.security_council":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.Params.osmosis_outpost":
+		m := new(OsmosisOutpost)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ethermint.evm.v1.Params.stride_outpost":
+		m := new(StrideOutpost)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ethermint.evm.v1.Params.receipt_retention_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Params"))
@@ -559,6 +908,42 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.PrecompileDynamicGasHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrecompileDynamicGasHeight))
+		}
+		if len(x.ScheduledEips) > 0 {
+			for _, e := range x.ScheduledEips {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Bech32Hrps) > 0 {
+			for _, s := range x.Bech32Hrps {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BlockedContracts) > 0 {
+			for _, s := range x.BlockedContracts {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.SecurityCouncil)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OsmosisOutpost != nil {
+			l = options.Size(x.OsmosisOutpost)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.StrideOutpost != nil {
+			l = options.Size(x.StrideOutpost)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReceiptRetentionBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.ReceiptRetentionBlocks))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -588,6 +973,91 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ReceiptRetentionBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReceiptRetentionBlocks))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.StrideOutpost != nil {
+			encoded, err := options.Marshal(x.StrideOutpost)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.OsmosisOutpost != nil {
+			encoded, err := options.Marshal(x.OsmosisOutpost)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.SecurityCouncil) > 0 {
+			i -= len(x.SecurityCouncil)
+			copy(dAtA[i:], x.SecurityCouncil)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SecurityCouncil)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.BlockedContracts) > 0 {
+			for iNdEx := len(x.BlockedContracts) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BlockedContracts[iNdEx])
+				copy(dAtA[i:], x.BlockedContracts[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockedContracts[iNdEx])))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.Bech32Hrps) > 0 {
+			for iNdEx := len(x.Bech32Hrps) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Bech32Hrps[iNdEx])
+				copy(dAtA[i:], x.Bech32Hrps[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bech32Hrps[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.ScheduledEips) > 0 {
+			for iNdEx := len(x.ScheduledEips) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ScheduledEips[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.PrecompileDynamicGasHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrecompileDynamicGasHeight))
+			i--
+			dAtA[i] = 0x58
+		}
 		if len(x.ActiveStaticPrecompiles) > 0 {
 			for iNdEx := len(x.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.ActiveStaticPrecompiles[iNdEx])
@@ -840,17 +1310,257 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.ActiveStaticPrecompiles = append(x.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrecompileDynamicGasHeight", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.PrecompileDynamicGasHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrecompileDynamicGasHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledEips", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScheduledEips = append(x.ScheduledEips, &ScheduledEIP{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScheduledEips[len(x.ScheduledEips)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bech32Hrps", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bech32Hrps = append(x.Bech32Hrps, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockedContracts", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockedContracts = append(x.BlockedContracts, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SecurityCouncil", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SecurityCouncil = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OsmosisOutpost", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OsmosisOutpost == nil {
+					x.OsmosisOutpost = &OsmosisOutpost{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OsmosisOutpost); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StrideOutpost", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StrideOutpost == nil {
+					x.StrideOutpost = &StrideOutpost{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StrideOutpost); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptRetentionBlocks", wireType)
+				}
+				x.ReceiptRetentionBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReceiptRetentionBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
 					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
@@ -876,27 +1586,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_AccessControl        protoreflect.MessageDescriptor
-	fd_AccessControl_create protoreflect.FieldDescriptor
-	fd_AccessControl_call   protoreflect.FieldDescriptor
+	md_OsmosisOutpost              protoreflect.MessageDescriptor
+	fd_OsmosisOutpost_channel_id   protoreflect.FieldDescriptor
+	fd_OsmosisOutpost_xcs_contract protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ethermint_evm_v1_evm_proto_init()
-	md_AccessControl = File_ethermint_evm_v1_evm_proto.Messages().ByName("AccessControl")
-	fd_AccessControl_create = md_AccessControl.Fields().ByName("create")
-	fd_AccessControl_call = md_AccessControl.Fields().ByName("call")
+	md_OsmosisOutpost = File_ethermint_evm_v1_evm_proto.Messages().ByName("OsmosisOutpost")
+	fd_OsmosisOutpost_channel_id = md_OsmosisOutpost.Fields().ByName("channel_id")
+	fd_OsmosisOutpost_xcs_contract = md_OsmosisOutpost.Fields().ByName("xcs_contract")
 }
 
-var _ protoreflect.Message = (*fastReflection_AccessControl)(nil)
+var _ protoreflect.Message = (*fastReflection_OsmosisOutpost)(nil)
 
-type fastReflection_AccessControl AccessControl
+type fastReflection_OsmosisOutpost OsmosisOutpost
 
-func (x *AccessControl) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AccessControl)(x)
+func (x *OsmosisOutpost) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OsmosisOutpost)(x)
 }
 
-func (x *AccessControl) slowProtoReflect() protoreflect.Message {
+func (x *OsmosisOutpost) slowProtoReflect() protoreflect.Message {
 	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -908,43 +1618,43 @@ func (x *AccessControl) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AccessControl_messageType fastReflection_AccessControl_messageType
-var _ protoreflect.MessageType = fastReflection_AccessControl_messageType{}
+var _fastReflection_OsmosisOutpost_messageType fastReflection_OsmosisOutpost_messageType
+var _ protoreflect.MessageType = fastReflection_OsmosisOutpost_messageType{}
 
-type fastReflection_AccessControl_messageType struct{}
+type fastReflection_OsmosisOutpost_messageType struct{}
 
-func (x fastReflection_AccessControl_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AccessControl)(nil)
+func (x fastReflection_OsmosisOutpost_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OsmosisOutpost)(nil)
 }
-func (x fastReflection_AccessControl_messageType) New() protoreflect.Message {
-	return new(fastReflection_AccessControl)
+func (x fastReflection_OsmosisOutpost_messageType) New() protoreflect.Message {
+	return new(fastReflection_OsmosisOutpost)
 }
-func (x fastReflection_AccessControl_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControl
+func (x fastReflection_OsmosisOutpost_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OsmosisOutpost
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AccessControl) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControl
+func (x *fastReflection_OsmosisOutpost) Descriptor() protoreflect.MessageDescriptor {
+	return md_OsmosisOutpost
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AccessControl) Type() protoreflect.MessageType {
-	return _fastReflection_AccessControl_messageType
+func (x *fastReflection_OsmosisOutpost) Type() protoreflect.MessageType {
+	return _fastReflection_OsmosisOutpost_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AccessControl) New() protoreflect.Message {
-	return new(fastReflection_AccessControl)
+func (x *fastReflection_OsmosisOutpost) New() protoreflect.Message {
+	return new(fastReflection_OsmosisOutpost)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AccessControl) Interface() protoreflect.ProtoMessage {
-	return (*AccessControl)(x)
+func (x *fastReflection_OsmosisOutpost) Interface() protoreflect.ProtoMessage {
+	return (*OsmosisOutpost)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -952,16 +1662,16 @@ func (x *fastReflection_AccessControl) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AccessControl) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Create != nil {
-		value := protoreflect.ValueOfMessage(x.Create.ProtoReflect())
-		if !f(fd_AccessControl_create, value) {
+func (x *fastReflection_OsmosisOutpost) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_OsmosisOutpost_channel_id, value) {
 			return
 		}
 	}
-	if x.Call != nil {
-		value := protoreflect.ValueOfMessage(x.Call.ProtoReflect())
-		if !f(fd_AccessControl_call, value) {
+	if x.XcsContract != "" {
+		value := protoreflect.ValueOfString(x.XcsContract)
+		if !f(fd_OsmosisOutpost_xcs_contract, value) {
 			return
 		}
 	}
@@ -978,17 +1688,17 @@ func (x *fastReflection_AccessControl) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AccessControl) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OsmosisOutpost) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.AccessControl.create":
-		return x.Create != nil
-	case "ethermint.evm.v1.AccessControl.call":
-		return x.Call != nil
+	case "ethermint.evm.v1.OsmosisOutpost.channel_id":
+		return x.ChannelId != ""
+	case "ethermint.evm.v1.OsmosisOutpost.xcs_contract":
+		return x.XcsContract != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.OsmosisOutpost"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.OsmosisOutpost does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -998,17 +1708,17 @@ func (x *fastReflection_AccessControl) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OsmosisOutpost) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.AccessControl.create":
-		x.Create = nil
-	case "ethermint.evm.v1.AccessControl.call":
-		x.Call = nil
+	case "ethermint.evm.v1.OsmosisOutpost.channel_id":
+		x.ChannelId = ""
+	case "ethermint.evm.v1.OsmosisOutpost.xcs_contract":
+		x.XcsContract = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.OsmosisOutpost"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.OsmosisOutpost does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1018,19 +1728,19 @@ func (x *fastReflection_AccessControl) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AccessControl) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OsmosisOutpost) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ethermint.evm.v1.AccessControl.create":
-		value := x.Create
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "ethermint.evm.v1.AccessControl.call":
-		value := x.Call
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ethermint.evm.v1.OsmosisOutpost.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.OsmosisOutpost.xcs_contract":
+		value := x.XcsContract
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.OsmosisOutpost"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.AccessControl does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.OsmosisOutpost does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1044,17 +1754,17 @@ func (x *fastReflection_AccessControl) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OsmosisOutpost) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.AccessControl.create":
-		x.Create = value.Message().Interface().(*AccessControlType)
-	case "ethermint.evm.v1.AccessControl.call":
-		x.Call = value.Message().Interface().(*AccessControlType)
+	case "ethermint.evm.v1.OsmosisOutpost.channel_id":
+		x.ChannelId = value.Interface().(string)
+	case "ethermint.evm.v1.OsmosisOutpost.xcs_contract":
+		x.XcsContract = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.OsmosisOutpost"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.OsmosisOutpost does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1068,52 +1778,44 @@ func (x *fastReflection_AccessControl) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OsmosisOutpost) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.AccessControl.create":
-		if x.Create == nil {
-			x.Create = new(AccessControlType)
-		}
-		return protoreflect.ValueOfMessage(x.Create.ProtoReflect())
-	case "ethermint.evm.v1.AccessControl.call":
-		if x.Call == nil {
-			x.Call = new(AccessControlType)
-		}
-		return protoreflect.ValueOfMessage(x.Call.ProtoReflect())
+	case "ethermint.evm.v1.OsmosisOutpost.channel_id":
+		panic(fmt.Errorf("field channel_id of message ethermint.evm.v1.OsmosisOutpost is not mutable"))
+	case "ethermint.evm.v1.OsmosisOutpost.xcs_contract":
+		panic(fmt.Errorf("field xcs_contract of message ethermint.evm.v1.OsmosisOutpost is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.OsmosisOutpost"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.OsmosisOutpost does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AccessControl) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OsmosisOutpost) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.AccessControl.create":
-		m := new(AccessControlType)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "ethermint.evm.v1.AccessControl.call":
-		m := new(AccessControlType)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ethermint.evm.v1.OsmosisOutpost.channel_id":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.OsmosisOutpost.xcs_contract":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.OsmosisOutpost"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.OsmosisOutpost does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AccessControl) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OsmosisOutpost) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.AccessControl", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.OsmosisOutpost", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1121,7 +1823,7 @@ func (x *fastReflection_AccessControl) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AccessControl) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OsmosisOutpost) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1132,7 +1834,7 @@ func (x *fastReflection_AccessControl) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OsmosisOutpost) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1144,7 +1846,7 @@ func (x *fastReflection_AccessControl) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AccessControl) IsValid() bool {
+func (x *fastReflection_OsmosisOutpost) IsValid() bool {
 	return x != nil
 }
 
@@ -1154,9 +1856,9 @@ func (x *fastReflection_AccessControl) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OsmosisOutpost) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AccessControl)
+		x := input.Message.Interface().(*OsmosisOutpost)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1168,12 +1870,12 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Create != nil {
-			l = options.Size(x.Create)
+		l = len(x.ChannelId)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Call != nil {
-			l = options.Size(x.Call)
+		l = len(x.XcsContract)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -1186,7 +1888,7 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControl)
+		x := input.Message.Interface().(*OsmosisOutpost)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1205,31 +1907,17 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Call != nil {
-			encoded, err := options.Marshal(x.Call)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.XcsContract) > 0 {
+			i -= len(x.XcsContract)
+			copy(dAtA[i:], x.XcsContract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.XcsContract)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.Create != nil {
-			encoded, err := options.Marshal(x.Create)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1244,7 +1932,7 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControl)
+		x := input.Message.Interface().(*OsmosisOutpost)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1276,17 +1964,17 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OsmosisOutpost: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OsmosisOutpost: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1296,33 +1984,29 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Create == nil {
-					x.Create = &AccessControlType{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Create); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field XcsContract", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1332,27 +2016,23 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Call == nil {
-					x.Call = &AccessControlType{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Call); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.XcsContract = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1389,74 +2069,26 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_AccessControlType_2_list)(nil)
-
-type _AccessControlType_2_list struct {
-	list *[]string
-}
-
-func (x *_AccessControlType_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_AccessControlType_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_AccessControlType_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_AccessControlType_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_AccessControlType_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message AccessControlType at list field AccessControlList as it is not of Message kind"))
-}
-
-func (x *_AccessControlType_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_AccessControlType_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_AccessControlType_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_AccessControlType                     protoreflect.MessageDescriptor
-	fd_AccessControlType_access_type         protoreflect.FieldDescriptor
-	fd_AccessControlType_access_control_list protoreflect.FieldDescriptor
+	md_StrideOutpost            protoreflect.MessageDescriptor
+	fd_StrideOutpost_channel_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ethermint_evm_v1_evm_proto_init()
-	md_AccessControlType = File_ethermint_evm_v1_evm_proto.Messages().ByName("AccessControlType")
-	fd_AccessControlType_access_type = md_AccessControlType.Fields().ByName("access_type")
-	fd_AccessControlType_access_control_list = md_AccessControlType.Fields().ByName("access_control_list")
+	md_StrideOutpost = File_ethermint_evm_v1_evm_proto.Messages().ByName("StrideOutpost")
+	fd_StrideOutpost_channel_id = md_StrideOutpost.Fields().ByName("channel_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_AccessControlType)(nil)
+var _ protoreflect.Message = (*fastReflection_StrideOutpost)(nil)
 
-type fastReflection_AccessControlType AccessControlType
+type fastReflection_StrideOutpost StrideOutpost
 
-func (x *AccessControlType) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AccessControlType)(x)
+func (x *StrideOutpost) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StrideOutpost)(x)
 }
 
-func (x *AccessControlType) slowProtoReflect() protoreflect.Message {
+func (x *StrideOutpost) slowProtoReflect() protoreflect.Message {
 	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1468,43 +2100,43 @@ func (x *AccessControlType) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AccessControlType_messageType fastReflection_AccessControlType_messageType
-var _ protoreflect.MessageType = fastReflection_AccessControlType_messageType{}
+var _fastReflection_StrideOutpost_messageType fastReflection_StrideOutpost_messageType
+var _ protoreflect.MessageType = fastReflection_StrideOutpost_messageType{}
 
-type fastReflection_AccessControlType_messageType struct{}
+type fastReflection_StrideOutpost_messageType struct{}
 
-func (x fastReflection_AccessControlType_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AccessControlType)(nil)
+func (x fastReflection_StrideOutpost_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StrideOutpost)(nil)
 }
-func (x fastReflection_AccessControlType_messageType) New() protoreflect.Message {
-	return new(fastReflection_AccessControlType)
+func (x fastReflection_StrideOutpost_messageType) New() protoreflect.Message {
+	return new(fastReflection_StrideOutpost)
 }
-func (x fastReflection_AccessControlType_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControlType
+func (x fastReflection_StrideOutpost_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StrideOutpost
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AccessControlType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControlType
+func (x *fastReflection_StrideOutpost) Descriptor() protoreflect.MessageDescriptor {
+	return md_StrideOutpost
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AccessControlType) Type() protoreflect.MessageType {
-	return _fastReflection_AccessControlType_messageType
+func (x *fastReflection_StrideOutpost) Type() protoreflect.MessageType {
+	return _fastReflection_StrideOutpost_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AccessControlType) New() protoreflect.Message {
-	return new(fastReflection_AccessControlType)
+func (x *fastReflection_StrideOutpost) New() protoreflect.Message {
+	return new(fastReflection_StrideOutpost)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AccessControlType) Interface() protoreflect.ProtoMessage {
-	return (*AccessControlType)(x)
+func (x *fastReflection_StrideOutpost) Interface() protoreflect.ProtoMessage {
+	return (*StrideOutpost)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1512,16 +2144,10 @@ func (x *fastReflection_AccessControlType) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AccessControlType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AccessType != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AccessType))
-		if !f(fd_AccessControlType_access_type, value) {
-			return
-		}
-	}
-	if len(x.AccessControlList) != 0 {
-		value := protoreflect.ValueOfList(&_AccessControlType_2_list{list: &x.AccessControlList})
-		if !f(fd_AccessControlType_access_control_list, value) {
+func (x *fastReflection_StrideOutpost) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_StrideOutpost_channel_id, value) {
 			return
 		}
 	}
@@ -1538,17 +2164,15 @@ func (x *fastReflection_AccessControlType) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AccessControlType) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_StrideOutpost) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.AccessControlType.access_type":
-		return x.AccessType != 0
-	case "ethermint.evm.v1.AccessControlType.access_control_list":
-		return len(x.AccessControlList) != 0
+	case "ethermint.evm.v1.StrideOutpost.channel_id":
+		return x.ChannelId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.StrideOutpost"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.StrideOutpost does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1558,17 +2182,15 @@ func (x *fastReflection_AccessControlType) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_StrideOutpost) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.AccessControlType.access_type":
-		x.AccessType = 0
-	case "ethermint.evm.v1.AccessControlType.access_control_list":
-		x.AccessControlList = nil
+	case "ethermint.evm.v1.StrideOutpost.channel_id":
+		x.ChannelId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.StrideOutpost"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.StrideOutpost does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1578,22 +2200,16 @@ func (x *fastReflection_AccessControlType) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AccessControlType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StrideOutpost) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ethermint.evm.v1.AccessControlType.access_type":
-		value := x.AccessType
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "ethermint.evm.v1.AccessControlType.access_control_list":
-		if len(x.AccessControlList) == 0 {
-			return protoreflect.ValueOfList(&_AccessControlType_2_list{})
-		}
-		listValue := &_AccessControlType_2_list{list: &x.AccessControlList}
-		return protoreflect.ValueOfList(listValue)
+	case "ethermint.evm.v1.StrideOutpost.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.StrideOutpost"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.AccessControlType does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.StrideOutpost does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1607,19 +2223,15 @@ func (x *fastReflection_AccessControlType) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_StrideOutpost) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.AccessControlType.access_type":
-		x.AccessType = (AccessType)(value.Enum())
-	case "ethermint.evm.v1.AccessControlType.access_control_list":
-		lv := value.List()
-		clv := lv.(*_AccessControlType_2_list)
-		x.AccessControlList = *clv.list
+	case "ethermint.evm.v1.StrideOutpost.channel_id":
+		x.ChannelId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.StrideOutpost"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.StrideOutpost does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1633,49 +2245,40 @@ func (x *fastReflection_AccessControlType) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StrideOutpost) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.AccessControlType.access_control_list":
-		if x.AccessControlList == nil {
-			x.AccessControlList = []string{}
-		}
-		value := &_AccessControlType_2_list{list: &x.AccessControlList}
-		return protoreflect.ValueOfList(value)
-	case "ethermint.evm.v1.AccessControlType.access_type":
-		panic(fmt.Errorf("field access_type of message ethermint.evm.v1.AccessControlType is not mutable"))
+	case "ethermint.evm.v1.StrideOutpost.channel_id":
+		panic(fmt.Errorf("field channel_id of message ethermint.evm.v1.StrideOutpost is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.StrideOutpost"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.StrideOutpost does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AccessControlType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StrideOutpost) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.AccessControlType.access_type":
-		return protoreflect.ValueOfEnum(0)
-	case "ethermint.evm.v1.AccessControlType.access_control_list":
-		list := []string{}
-		return protoreflect.ValueOfList(&_AccessControlType_2_list{list: &list})
+	case "ethermint.evm.v1.StrideOutpost.channel_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.StrideOutpost"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.StrideOutpost does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AccessControlType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_StrideOutpost) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.AccessControlType", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.StrideOutpost", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1683,7 +2286,7 @@ func (x *fastReflection_AccessControlType) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AccessControlType) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_StrideOutpost) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1694,7 +2297,7 @@ func (x *fastReflection_AccessControlType) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_StrideOutpost) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1706,7 +2309,7 @@ func (x *fastReflection_AccessControlType) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AccessControlType) IsValid() bool {
+func (x *fastReflection_StrideOutpost) IsValid() bool {
 	return x != nil
 }
 
@@ -1716,9 +2319,9 @@ func (x *fastReflection_AccessControlType) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_StrideOutpost) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AccessControlType)
+		x := input.Message.Interface().(*StrideOutpost)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1730,14 +2333,9 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.AccessType != 0 {
-			n += 1 + runtime.Sov(uint64(x.AccessType))
-		}
-		if len(x.AccessControlList) > 0 {
-			for _, s := range x.AccessControlList {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1749,7 +2347,7 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControlType)
+		x := input.Message.Interface().(*StrideOutpost)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1768,19 +2366,12 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AccessControlList) > 0 {
-			for iNdEx := len(x.AccessControlList) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.AccessControlList[iNdEx])
-				copy(dAtA[i:], x.AccessControlList[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccessControlList[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.AccessType != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccessType))
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1793,7 +2384,7 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControlType)
+		x := input.Message.Interface().(*StrideOutpost)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1825,34 +2416,15 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StrideOutpost: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StrideOutpost: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
-				}
-				x.AccessType = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AccessType |= AccessType(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1880,7 +2452,7 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AccessControlList = append(x.AccessControlList, string(dAtA[iNdEx:postIndex]))
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1918,67 +2490,27 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ChainConfig                      protoreflect.MessageDescriptor
-	fd_ChainConfig_homestead_block      protoreflect.FieldDescriptor
-	fd_ChainConfig_dao_fork_block       protoreflect.FieldDescriptor
-	fd_ChainConfig_dao_fork_support     protoreflect.FieldDescriptor
-	fd_ChainConfig_eip150_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_eip150_hash          protoreflect.FieldDescriptor
-	fd_ChainConfig_eip155_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_eip158_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_byzantium_block      protoreflect.FieldDescriptor
-	fd_ChainConfig_constantinople_block protoreflect.FieldDescriptor
-	fd_ChainConfig_petersburg_block     protoreflect.FieldDescriptor
-	fd_ChainConfig_istanbul_block       protoreflect.FieldDescriptor
-	fd_ChainConfig_muir_glacier_block   protoreflect.FieldDescriptor
-	fd_ChainConfig_berlin_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_london_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_arrow_glacier_block  protoreflect.FieldDescriptor
-	fd_ChainConfig_gray_glacier_block   protoreflect.FieldDescriptor
-	fd_ChainConfig_merge_netsplit_block protoreflect.FieldDescriptor
-	fd_ChainConfig_shanghai_block       protoreflect.FieldDescriptor
-	fd_ChainConfig_cancun_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_chain_id             protoreflect.FieldDescriptor
-	fd_ChainConfig_denom                protoreflect.FieldDescriptor
-	fd_ChainConfig_decimals             protoreflect.FieldDescriptor
+	md_ScheduledEIP        protoreflect.MessageDescriptor
+	fd_ScheduledEIP_eip    protoreflect.FieldDescriptor
+	fd_ScheduledEIP_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ethermint_evm_v1_evm_proto_init()
-	md_ChainConfig = File_ethermint_evm_v1_evm_proto.Messages().ByName("ChainConfig")
-	fd_ChainConfig_homestead_block = md_ChainConfig.Fields().ByName("homestead_block")
-	fd_ChainConfig_dao_fork_block = md_ChainConfig.Fields().ByName("dao_fork_block")
-	fd_ChainConfig_dao_fork_support = md_ChainConfig.Fields().ByName("dao_fork_support")
-	fd_ChainConfig_eip150_block = md_ChainConfig.Fields().ByName("eip150_block")
-	fd_ChainConfig_eip150_hash = md_ChainConfig.Fields().ByName("eip150_hash")
-	fd_ChainConfig_eip155_block = md_ChainConfig.Fields().ByName("eip155_block")
-	fd_ChainConfig_eip158_block = md_ChainConfig.Fields().ByName("eip158_block")
-	fd_ChainConfig_byzantium_block = md_ChainConfig.Fields().ByName("byzantium_block")
-	fd_ChainConfig_constantinople_block = md_ChainConfig.Fields().ByName("constantinople_block")
-	fd_ChainConfig_petersburg_block = md_ChainConfig.Fields().ByName("petersburg_block")
-	fd_ChainConfig_istanbul_block = md_ChainConfig.Fields().ByName("istanbul_block")
-	fd_ChainConfig_muir_glacier_block = md_ChainConfig.Fields().ByName("muir_glacier_block")
-	fd_ChainConfig_berlin_block = md_ChainConfig.Fields().ByName("berlin_block")
-	fd_ChainConfig_london_block = md_ChainConfig.Fields().ByName("london_block")
-	fd_ChainConfig_arrow_glacier_block = md_ChainConfig.Fields().ByName("arrow_glacier_block")
-	fd_ChainConfig_gray_glacier_block = md_ChainConfig.Fields().ByName("gray_glacier_block")
-	fd_ChainConfig_merge_netsplit_block = md_ChainConfig.Fields().ByName("merge_netsplit_block")
-	fd_ChainConfig_shanghai_block = md_ChainConfig.Fields().ByName("shanghai_block")
-	fd_ChainConfig_cancun_block = md_ChainConfig.Fields().ByName("cancun_block")
-	fd_ChainConfig_chain_id = md_ChainConfig.Fields().ByName("chain_id")
-	fd_ChainConfig_denom = md_ChainConfig.Fields().ByName("denom")
-	fd_ChainConfig_decimals = md_ChainConfig.Fields().ByName("decimals")
+	md_ScheduledEIP = File_ethermint_evm_v1_evm_proto.Messages().ByName("ScheduledEIP")
+	fd_ScheduledEIP_eip = md_ScheduledEIP.Fields().ByName("eip")
+	fd_ScheduledEIP_height = md_ScheduledEIP.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_ChainConfig)(nil)
+var _ protoreflect.Message = (*fastReflection_ScheduledEIP)(nil)
 
-type fastReflection_ChainConfig ChainConfig
+type fastReflection_ScheduledEIP ScheduledEIP
 
-func (x *ChainConfig) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ChainConfig)(x)
+func (x *ScheduledEIP) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledEIP)(x)
 }
 
-func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
+func (x *ScheduledEIP) slowProtoReflect() protoreflect.Message {
 	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1990,43 +2522,43 @@ func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ChainConfig_messageType fastReflection_ChainConfig_messageType
-var _ protoreflect.MessageType = fastReflection_ChainConfig_messageType{}
+var _fastReflection_ScheduledEIP_messageType fastReflection_ScheduledEIP_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledEIP_messageType{}
 
-type fastReflection_ChainConfig_messageType struct{}
+type fastReflection_ScheduledEIP_messageType struct{}
 
-func (x fastReflection_ChainConfig_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ChainConfig)(nil)
+func (x fastReflection_ScheduledEIP_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledEIP)(nil)
 }
-func (x fastReflection_ChainConfig_messageType) New() protoreflect.Message {
-	return new(fastReflection_ChainConfig)
+func (x fastReflection_ScheduledEIP_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledEIP)
 }
-func (x fastReflection_ChainConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ChainConfig
+func (x fastReflection_ScheduledEIP_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledEIP
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ChainConfig) Descriptor() protoreflect.MessageDescriptor {
-	return md_ChainConfig
+func (x *fastReflection_ScheduledEIP) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledEIP
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ChainConfig) Type() protoreflect.MessageType {
-	return _fastReflection_ChainConfig_messageType
+func (x *fastReflection_ScheduledEIP) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledEIP_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ChainConfig) New() protoreflect.Message {
-	return new(fastReflection_ChainConfig)
+func (x *fastReflection_ScheduledEIP) New() protoreflect.Message {
+	return new(fastReflection_ScheduledEIP)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
-	return (*ChainConfig)(x)
+func (x *fastReflection_ScheduledEIP) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledEIP)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2034,136 +2566,16 @@ func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ChainConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.HomesteadBlock != "" {
-		value := protoreflect.ValueOfString(x.HomesteadBlock)
-		if !f(fd_ChainConfig_homestead_block, value) {
-			return
-		}
-	}
-	if x.DaoForkBlock != "" {
-		value := protoreflect.ValueOfString(x.DaoForkBlock)
-		if !f(fd_ChainConfig_dao_fork_block, value) {
-			return
-		}
-	}
-	if x.DaoForkSupport != false {
-		value := protoreflect.ValueOfBool(x.DaoForkSupport)
-		if !f(fd_ChainConfig_dao_fork_support, value) {
-			return
-		}
-	}
-	if x.Eip150Block != "" {
-		value := protoreflect.ValueOfString(x.Eip150Block)
-		if !f(fd_ChainConfig_eip150_block, value) {
-			return
-		}
-	}
-	if x.Eip150Hash != "" {
-		value := protoreflect.ValueOfString(x.Eip150Hash)
-		if !f(fd_ChainConfig_eip150_hash, value) {
-			return
-		}
-	}
-	if x.Eip155Block != "" {
-		value := protoreflect.ValueOfString(x.Eip155Block)
-		if !f(fd_ChainConfig_eip155_block, value) {
-			return
-		}
-	}
-	if x.Eip158Block != "" {
-		value := protoreflect.ValueOfString(x.Eip158Block)
-		if !f(fd_ChainConfig_eip158_block, value) {
-			return
-		}
-	}
-	if x.ByzantiumBlock != "" {
-		value := protoreflect.ValueOfString(x.ByzantiumBlock)
-		if !f(fd_ChainConfig_byzantium_block, value) {
-			return
-		}
-	}
-	if x.ConstantinopleBlock != "" {
-		value := protoreflect.ValueOfString(x.ConstantinopleBlock)
-		if !f(fd_ChainConfig_constantinople_block, value) {
-			return
-		}
-	}
-	if x.PetersburgBlock != "" {
-		value := protoreflect.ValueOfString(x.PetersburgBlock)
-		if !f(fd_ChainConfig_petersburg_block, value) {
-			return
-		}
-	}
-	if x.IstanbulBlock != "" {
-		value := protoreflect.ValueOfString(x.IstanbulBlock)
-		if !f(fd_ChainConfig_istanbul_block, value) {
-			return
-		}
-	}
-	if x.MuirGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.MuirGlacierBlock)
-		if !f(fd_ChainConfig_muir_glacier_block, value) {
-			return
-		}
-	}
-	if x.BerlinBlock != "" {
-		value := protoreflect.ValueOfString(x.BerlinBlock)
-		if !f(fd_ChainConfig_berlin_block, value) {
-			return
-		}
-	}
-	if x.LondonBlock != "" {
-		value := protoreflect.ValueOfString(x.LondonBlock)
-		if !f(fd_ChainConfig_london_block, value) {
-			return
-		}
-	}
-	if x.ArrowGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.ArrowGlacierBlock)
-		if !f(fd_ChainConfig_arrow_glacier_block, value) {
-			return
-		}
-	}
-	if x.GrayGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.GrayGlacierBlock)
-		if !f(fd_ChainConfig_gray_glacier_block, value) {
-			return
-		}
-	}
-	if x.MergeNetsplitBlock != "" {
-		value := protoreflect.ValueOfString(x.MergeNetsplitBlock)
-		if !f(fd_ChainConfig_merge_netsplit_block, value) {
-			return
-		}
-	}
-	if x.ShanghaiBlock != "" {
-		value := protoreflect.ValueOfString(x.ShanghaiBlock)
-		if !f(fd_ChainConfig_shanghai_block, value) {
-			return
-		}
-	}
-	if x.CancunBlock != "" {
-		value := protoreflect.ValueOfString(x.CancunBlock)
-		if !f(fd_ChainConfig_cancun_block, value) {
-			return
-		}
-	}
-	if x.ChainId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ChainId)
-		if !f(fd_ChainConfig_chain_id, value) {
-			return
-		}
-	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_ChainConfig_denom, value) {
+func (x *fastReflection_ScheduledEIP) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Eip != "" {
+		value := protoreflect.ValueOfString(x.Eip)
+		if !f(fd_ScheduledEIP_eip, value) {
 			return
 		}
 	}
-	if x.Decimals != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Decimals)
-		if !f(fd_ChainConfig_decimals, value) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ScheduledEIP_height, value) {
 			return
 		}
 	}
@@ -2180,57 +2592,17 @@ func (x *fastReflection_ChainConfig) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ChainConfig) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ScheduledEIP) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.ChainConfig.homestead_block":
-		return x.HomesteadBlock != ""
-	case "ethermint.evm.v1.ChainConfig.dao_fork_block":
-		return x.DaoForkBlock != ""
-	case "ethermint.evm.v1.ChainConfig.dao_fork_support":
-		return x.DaoForkSupport != false
-	case "ethermint.evm.v1.ChainConfig.eip150_block":
-		return x.Eip150Block != ""
-	case "ethermint.evm.v1.ChainConfig.eip150_hash":
-		return x.Eip150Hash != ""
-	case "ethermint.evm.v1.ChainConfig.eip155_block":
-		return x.Eip155Block != ""
-	case "ethermint.evm.v1.ChainConfig.eip158_block":
-		return x.Eip158Block != ""
-	case "ethermint.evm.v1.ChainConfig.byzantium_block":
-		return x.ByzantiumBlock != ""
-	case "ethermint.evm.v1.ChainConfig.constantinople_block":
-		return x.ConstantinopleBlock != ""
-	case "ethermint.evm.v1.ChainConfig.petersburg_block":
-		return x.PetersburgBlock != ""
-	case "ethermint.evm.v1.ChainConfig.istanbul_block":
-		return x.IstanbulBlock != ""
-	case "ethermint.evm.v1.ChainConfig.muir_glacier_block":
-		return x.MuirGlacierBlock != ""
-	case "ethermint.evm.v1.ChainConfig.berlin_block":
-		return x.BerlinBlock != ""
-	case "ethermint.evm.v1.ChainConfig.london_block":
-		return x.LondonBlock != ""
-	case "ethermint.evm.v1.ChainConfig.arrow_glacier_block":
-		return x.ArrowGlacierBlock != ""
-	case "ethermint.evm.v1.ChainConfig.gray_glacier_block":
-		return x.GrayGlacierBlock != ""
-	case "ethermint.evm.v1.ChainConfig.merge_netsplit_block":
-		return x.MergeNetsplitBlock != ""
-	case "ethermint.evm.v1.ChainConfig.shanghai_block":
-		return x.ShanghaiBlock != ""
-	case "ethermint.evm.v1.ChainConfig.cancun_block":
-		return x.CancunBlock != ""
-	case "ethermint.evm.v1.ChainConfig.chain_id":
-		return x.ChainId != uint64(0)
-	case "ethermint.evm.v1.ChainConfig.denom":
-		return x.Denom != ""
-	case "ethermint.evm.v1.ChainConfig.decimals":
-		return x.Decimals != uint64(0)
+	case "ethermint.evm.v1.ScheduledEIP.eip":
+		return x.Eip != ""
+	case "ethermint.evm.v1.ScheduledEIP.height":
+		return x.Height != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ScheduledEIP"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.ScheduledEIP does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2240,57 +2612,17 @@ func (x *fastReflection_ChainConfig) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ScheduledEIP) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.ChainConfig.homestead_block":
-		x.HomesteadBlock = ""
-	case "ethermint.evm.v1.ChainConfig.dao_fork_block":
-		x.DaoForkBlock = ""
-	case "ethermint.evm.v1.ChainConfig.dao_fork_support":
-		x.DaoForkSupport = false
-	case "ethermint.evm.v1.ChainConfig.eip150_block":
-		x.Eip150Block = ""
-	case "ethermint.evm.v1.ChainConfig.eip150_hash":
-		x.Eip150Hash = ""
-	case "ethermint.evm.v1.ChainConfig.eip155_block":
-		x.Eip155Block = ""
-	case "ethermint.evm.v1.ChainConfig.eip158_block":
-		x.Eip158Block = ""
-	case "ethermint.evm.v1.ChainConfig.byzantium_block":
-		x.ByzantiumBlock = ""
-	case "ethermint.evm.v1.ChainConfig.constantinople_block":
-		x.ConstantinopleBlock = ""
-	case "ethermint.evm.v1.ChainConfig.petersburg_block":
-		x.PetersburgBlock = ""
-	case "ethermint.evm.v1.ChainConfig.istanbul_block":
-		x.IstanbulBlock = ""
-	case "ethermint.evm.v1.ChainConfig.muir_glacier_block":
-		x.MuirGlacierBlock = ""
-	case "ethermint.evm.v1.ChainConfig.berlin_block":
-		x.BerlinBlock = ""
-	case "ethermint.evm.v1.ChainConfig.london_block":
-		x.LondonBlock = ""
-	case "ethermint.evm.v1.ChainConfig.arrow_glacier_block":
-		x.ArrowGlacierBlock = ""
-	case "ethermint.evm.v1.ChainConfig.gray_glacier_block":
-		x.GrayGlacierBlock = ""
-	case "ethermint.evm.v1.ChainConfig.merge_netsplit_block":
-		x.MergeNetsplitBlock = ""
-	case "ethermint.evm.v1.ChainConfig.shanghai_block":
-		x.ShanghaiBlock = ""
-	case "ethermint.evm.v1.ChainConfig.cancun_block":
-		x.CancunBlock = ""
-	case "ethermint.evm.v1.ChainConfig.chain_id":
-		x.ChainId = uint64(0)
-	case "ethermint.evm.v1.ChainConfig.denom":
-		x.Denom = ""
-	case "ethermint.evm.v1.ChainConfig.decimals":
-		x.Decimals = uint64(0)
+	case "ethermint.evm.v1.ScheduledEIP.eip":
+		x.Eip = ""
+	case "ethermint.evm.v1.ScheduledEIP.height":
+		x.Height = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ScheduledEIP"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.ScheduledEIP does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2300,79 +2632,19 @@ func (x *fastReflection_ChainConfig) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ChainConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ScheduledEIP) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ethermint.evm.v1.ChainConfig.homestead_block":
-		value := x.HomesteadBlock
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.dao_fork_block":
-		value := x.DaoForkBlock
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.dao_fork_support":
-		value := x.DaoForkSupport
-		return protoreflect.ValueOfBool(value)
-	case "ethermint.evm.v1.ChainConfig.eip150_block":
-		value := x.Eip150Block
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.eip150_hash":
-		value := x.Eip150Hash
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.eip155_block":
-		value := x.Eip155Block
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.eip158_block":
-		value := x.Eip158Block
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.byzantium_block":
-		value := x.ByzantiumBlock
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.constantinople_block":
-		value := x.ConstantinopleBlock
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.petersburg_block":
-		value := x.PetersburgBlock
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.istanbul_block":
-		value := x.IstanbulBlock
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.muir_glacier_block":
-		value := x.MuirGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.berlin_block":
-		value := x.BerlinBlock
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.london_block":
-		value := x.LondonBlock
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.arrow_glacier_block":
-		value := x.ArrowGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.gray_glacier_block":
-		value := x.GrayGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.merge_netsplit_block":
-		value := x.MergeNetsplitBlock
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.shanghai_block":
-		value := x.ShanghaiBlock
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.cancun_block":
-		value := x.CancunBlock
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfUint64(value)
-	case "ethermint.evm.v1.ChainConfig.denom":
-		value := x.Denom
+	case "ethermint.evm.v1.ScheduledEIP.eip":
+		value := x.Eip
 		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.ChainConfig.decimals":
-		value := x.Decimals
-		return protoreflect.ValueOfUint64(value)
+	case "ethermint.evm.v1.ScheduledEIP.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ScheduledEIP"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.ChainConfig does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.ScheduledEIP does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2386,57 +2658,17 @@ func (x *fastReflection_ChainConfig) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ScheduledEIP) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.ChainConfig.homestead_block":
-		x.HomesteadBlock = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.dao_fork_block":
-		x.DaoForkBlock = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.dao_fork_support":
-		x.DaoForkSupport = value.Bool()
-	case "ethermint.evm.v1.ChainConfig.eip150_block":
-		x.Eip150Block = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.eip150_hash":
-		x.Eip150Hash = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.eip155_block":
-		x.Eip155Block = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.eip158_block":
-		x.Eip158Block = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.byzantium_block":
-		x.ByzantiumBlock = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.constantinople_block":
-		x.ConstantinopleBlock = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.petersburg_block":
-		x.PetersburgBlock = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.istanbul_block":
-		x.IstanbulBlock = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.muir_glacier_block":
-		x.MuirGlacierBlock = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.berlin_block":
-		x.BerlinBlock = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.london_block":
-		x.LondonBlock = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.arrow_glacier_block":
-		x.ArrowGlacierBlock = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.gray_glacier_block":
-		x.GrayGlacierBlock = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.merge_netsplit_block":
-		x.MergeNetsplitBlock = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.shanghai_block":
-		x.ShanghaiBlock = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.cancun_block":
-		x.CancunBlock = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.chain_id":
-		x.ChainId = value.Uint()
-	case "ethermint.evm.v1.ChainConfig.denom":
-		x.Denom = value.Interface().(string)
-	case "ethermint.evm.v1.ChainConfig.decimals":
-		x.Decimals = value.Uint()
+	case "ethermint.evm.v1.ScheduledEIP.eip":
+		x.Eip = value.Interface().(string)
+	case "ethermint.evm.v1.ScheduledEIP.height":
+		x.Height = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ScheduledEIP"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.ScheduledEIP does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2450,124 +2682,44 @@ func (x *fastReflection_ChainConfig) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ScheduledEIP) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.ChainConfig.homestead_block":
-		panic(fmt.Errorf("field homestead_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.dao_fork_block":
-		panic(fmt.Errorf("field dao_fork_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.dao_fork_support":
-		panic(fmt.Errorf("field dao_fork_support of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.eip150_block":
-		panic(fmt.Errorf("field eip150_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.eip150_hash":
-		panic(fmt.Errorf("field eip150_hash of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.eip155_block":
-		panic(fmt.Errorf("field eip155_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.eip158_block":
-		panic(fmt.Errorf("field eip158_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.byzantium_block":
-		panic(fmt.Errorf("field byzantium_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.constantinople_block":
-		panic(fmt.Errorf("field constantinople_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.petersburg_block":
-		panic(fmt.Errorf("field petersburg_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.istanbul_block":
-		panic(fmt.Errorf("field istanbul_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.muir_glacier_block":
-		panic(fmt.Errorf("field muir_glacier_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.berlin_block":
-		panic(fmt.Errorf("field berlin_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.london_block":
-		panic(fmt.Errorf("field london_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.arrow_glacier_block":
-		panic(fmt.Errorf("field arrow_glacier_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.gray_glacier_block":
-		panic(fmt.Errorf("field gray_glacier_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.merge_netsplit_block":
-		panic(fmt.Errorf("field merge_netsplit_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.shanghai_block":
-		panic(fmt.Errorf("field shanghai_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.cancun_block":
-		panic(fmt.Errorf("field cancun_block of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.chain_id":
-		panic(fmt.Errorf("field chain_id of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.denom":
-		panic(fmt.Errorf("field denom of message ethermint.evm.v1.ChainConfig is not mutable"))
-	case "ethermint.evm.v1.ChainConfig.decimals":
-		panic(fmt.Errorf("field decimals of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ScheduledEIP.eip":
+		panic(fmt.Errorf("field eip of message ethermint.evm.v1.ScheduledEIP is not mutable"))
+	case "ethermint.evm.v1.ScheduledEIP.height":
+		panic(fmt.Errorf("field height of message ethermint.evm.v1.ScheduledEIP is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ScheduledEIP"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.ScheduledEIP does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ChainConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ScheduledEIP) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.ChainConfig.homestead_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.dao_fork_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.dao_fork_support":
-		return protoreflect.ValueOfBool(false)
-	case "ethermint.evm.v1.ChainConfig.eip150_block":
+	case "ethermint.evm.v1.ScheduledEIP.eip":
 		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.eip150_hash":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.eip155_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.eip158_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.byzantium_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.constantinople_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.petersburg_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.istanbul_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.muir_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.berlin_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.london_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.arrow_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.gray_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.merge_netsplit_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.shanghai_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.cancun_block":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.chain_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "ethermint.evm.v1.ChainConfig.denom":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.ChainConfig.decimals":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.evm.v1.ScheduledEIP.height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ScheduledEIP"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.ScheduledEIP does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ChainConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ScheduledEIP) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.ChainConfig", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.ScheduledEIP", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2575,7 +2727,7 @@ func (x *fastReflection_ChainConfig) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ChainConfig) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ScheduledEIP) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2586,7 +2738,7 @@ func (x *fastReflection_ChainConfig) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ScheduledEIP) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2598,7 +2750,7 @@ func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ChainConfig) IsValid() bool {
+func (x *fastReflection_ScheduledEIP) IsValid() bool {
 	return x != nil
 }
 
@@ -2608,9 +2760,9 @@ func (x *fastReflection_ChainConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ScheduledEIP) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ChainConfig)
+		x := input.Message.Interface().(*ScheduledEIP)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2622,90 +2774,12 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.HomesteadBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.DaoForkBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.DaoForkSupport {
-			n += 2
-		}
-		l = len(x.Eip150Block)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Eip150Hash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Eip155Block)
+		l = len(x.Eip)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Eip158Block)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ByzantiumBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ConstantinopleBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.PetersburgBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.IstanbulBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MuirGlacierBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.BerlinBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.LondonBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ArrowGlacierBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.GrayGlacierBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MergeNetsplitBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ShanghaiBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.CancunBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if x.ChainId != 0 {
-			n += 2 + runtime.Sov(uint64(x.ChainId))
-		}
-		l = len(x.Denom)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if x.Decimals != 0 {
-			n += 2 + runtime.Sov(uint64(x.Decimals))
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2717,7 +2791,7 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ChainConfig)
+		x := input.Message.Interface().(*ScheduledEIP)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2736,194 +2810,35 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Decimals != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xd0
-		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xca
-		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xc0
-		}
-		if len(x.CancunBlock) > 0 {
-			i -= len(x.CancunBlock)
-			copy(dAtA[i:], x.CancunBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CancunBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xba
-		}
-		if len(x.ShanghaiBlock) > 0 {
-			i -= len(x.ShanghaiBlock)
-			copy(dAtA[i:], x.ShanghaiBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ShanghaiBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xb2
-		}
-		if len(x.MergeNetsplitBlock) > 0 {
-			i -= len(x.MergeNetsplitBlock)
-			copy(dAtA[i:], x.MergeNetsplitBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MergeNetsplitBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xaa
-		}
-		if len(x.GrayGlacierBlock) > 0 {
-			i -= len(x.GrayGlacierBlock)
-			copy(dAtA[i:], x.GrayGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrayGlacierBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xa2
-		}
-		if len(x.ArrowGlacierBlock) > 0 {
-			i -= len(x.ArrowGlacierBlock)
-			copy(dAtA[i:], x.ArrowGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ArrowGlacierBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
-		if len(x.LondonBlock) > 0 {
-			i -= len(x.LondonBlock)
-			copy(dAtA[i:], x.LondonBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LondonBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
-		if len(x.BerlinBlock) > 0 {
-			i -= len(x.BerlinBlock)
-			copy(dAtA[i:], x.BerlinBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BerlinBlock)))
-			i--
-			dAtA[i] = 0x6a
-		}
-		if len(x.MuirGlacierBlock) > 0 {
-			i -= len(x.MuirGlacierBlock)
-			copy(dAtA[i:], x.MuirGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MuirGlacierBlock)))
-			i--
-			dAtA[i] = 0x62
-		}
-		if len(x.IstanbulBlock) > 0 {
-			i -= len(x.IstanbulBlock)
-			copy(dAtA[i:], x.IstanbulBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IstanbulBlock)))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x10
 		}
-		if len(x.PetersburgBlock) > 0 {
-			i -= len(x.PetersburgBlock)
-			copy(dAtA[i:], x.PetersburgBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PetersburgBlock)))
+		if len(x.Eip) > 0 {
+			i -= len(x.Eip)
+			copy(dAtA[i:], x.Eip)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip)))
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0xa
 		}
-		if len(x.ConstantinopleBlock) > 0 {
-			i -= len(x.ConstantinopleBlock)
-			copy(dAtA[i:], x.ConstantinopleBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConstantinopleBlock)))
-			i--
-			dAtA[i] = 0x4a
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
-		if len(x.ByzantiumBlock) > 0 {
-			i -= len(x.ByzantiumBlock)
-			copy(dAtA[i:], x.ByzantiumBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ByzantiumBlock)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.Eip158Block) > 0 {
-			i -= len(x.Eip158Block)
-			copy(dAtA[i:], x.Eip158Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip158Block)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.Eip155Block) > 0 {
-			i -= len(x.Eip155Block)
-			copy(dAtA[i:], x.Eip155Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip155Block)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Eip150Hash) > 0 {
-			i -= len(x.Eip150Hash)
-			copy(dAtA[i:], x.Eip150Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip150Hash)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Eip150Block) > 0 {
-			i -= len(x.Eip150Block)
-			copy(dAtA[i:], x.Eip150Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip150Block)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.DaoForkSupport {
-			i--
-			if x.DaoForkSupport {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.DaoForkBlock) > 0 {
-			i -= len(x.DaoForkBlock)
-			copy(dAtA[i:], x.DaoForkBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DaoForkBlock)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.HomesteadBlock) > 0 {
-			i -= len(x.HomesteadBlock)
-			copy(dAtA[i:], x.HomesteadBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HomesteadBlock)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ChainConfig)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledEIP)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
 		}
 		options := runtime.UnmarshalInputToOptions(input)
 		_ = options
@@ -2950,15 +2865,15 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledEIP: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledEIP: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2986,161 +2901,13 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.HomesteadBlock = string(dAtA[iNdEx:postIndex])
+				x.Eip = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkBlock", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DaoForkBlock = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkSupport", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.DaoForkSupport = bool(v != 0)
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip150Block", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Eip150Block = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip150Hash", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Eip150Hash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip155Block", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				x.Eip155Block = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip158Block", wireType)
-				}
-				var stringLen uint64
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3150,40 +2917,2355 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Height |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Eip158Block = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ByzantiumBlock", wireType)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AccessControl        protoreflect.MessageDescriptor
+	fd_AccessControl_create protoreflect.FieldDescriptor
+	fd_AccessControl_call   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ethermint_evm_v1_evm_proto_init()
+	md_AccessControl = File_ethermint_evm_v1_evm_proto.Messages().ByName("AccessControl")
+	fd_AccessControl_create = md_AccessControl.Fields().ByName("create")
+	fd_AccessControl_call = md_AccessControl.Fields().ByName("call")
+}
+
+var _ protoreflect.Message = (*fastReflection_AccessControl)(nil)
+
+type fastReflection_AccessControl AccessControl
+
+func (x *AccessControl) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccessControl)(x)
+}
+
+func (x *AccessControl) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AccessControl_messageType fastReflection_AccessControl_messageType
+var _ protoreflect.MessageType = fastReflection_AccessControl_messageType{}
+
+type fastReflection_AccessControl_messageType struct{}
+
+func (x fastReflection_AccessControl_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccessControl)(nil)
+}
+func (x fastReflection_AccessControl_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccessControl)
+}
+func (x fastReflection_AccessControl_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControl
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AccessControl) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControl
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AccessControl) Type() protoreflect.MessageType {
+	return _fastReflection_AccessControl_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AccessControl) New() protoreflect.Message {
+	return new(fastReflection_AccessControl)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AccessControl) Interface() protoreflect.ProtoMessage {
+	return (*AccessControl)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AccessControl) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Create != nil {
+		value := protoreflect.ValueOfMessage(x.Create.ProtoReflect())
+		if !f(fd_AccessControl_create, value) {
+			return
+		}
+	}
+	if x.Call != nil {
+		value := protoreflect.ValueOfMessage(x.Call.ProtoReflect())
+		if !f(fd_AccessControl_call, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AccessControl) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.AccessControl.create":
+		return x.Create != nil
+	case "ethermint.evm.v1.AccessControl.call":
+		return x.Call != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControl"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.AccessControl does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControl) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.AccessControl.create":
+		x.Create = nil
+	case "ethermint.evm.v1.AccessControl.call":
+		x.Call = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControl"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.AccessControl does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AccessControl) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ethermint.evm.v1.AccessControl.create":
+		value := x.Create
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ethermint.evm.v1.AccessControl.call":
+		value := x.Call
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControl"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.AccessControl does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControl) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.AccessControl.create":
+		x.Create = value.Message().Interface().(*AccessControlType)
+	case "ethermint.evm.v1.AccessControl.call":
+		x.Call = value.Message().Interface().(*AccessControlType)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControl"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.AccessControl does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControl) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.AccessControl.create":
+		if x.Create == nil {
+			x.Create = new(AccessControlType)
+		}
+		return protoreflect.ValueOfMessage(x.Create.ProtoReflect())
+	case "ethermint.evm.v1.AccessControl.call":
+		if x.Call == nil {
+			x.Call = new(AccessControlType)
+		}
+		return protoreflect.ValueOfMessage(x.Call.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControl"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.AccessControl does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AccessControl) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.AccessControl.create":
+		m := new(AccessControlType)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ethermint.evm.v1.AccessControl.call":
+		m := new(AccessControlType)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControl"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.AccessControl does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AccessControl) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.AccessControl", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AccessControl) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControl) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AccessControl) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AccessControl)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Create != nil {
+			l = options.Size(x.Create)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Call != nil {
+			l = options.Size(x.Call)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AccessControl)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Call != nil {
+			encoded, err := options.Marshal(x.Call)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Create != nil {
+			encoded, err := options.Marshal(x.Create)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AccessControl)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Create == nil {
+					x.Create = &AccessControlType{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Create); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Call == nil {
+					x.Call = &AccessControlType{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Call); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_AccessControlType_2_list)(nil)
+
+type _AccessControlType_2_list struct {
+	list *[]string
+}
+
+func (x *_AccessControlType_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AccessControlType_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AccessControlType_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AccessControlType_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AccessControlType_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AccessControlType at list field AccessControlList as it is not of Message kind"))
+}
+
+func (x *_AccessControlType_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AccessControlType_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AccessControlType_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AccessControlType                     protoreflect.MessageDescriptor
+	fd_AccessControlType_access_type         protoreflect.FieldDescriptor
+	fd_AccessControlType_access_control_list protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ethermint_evm_v1_evm_proto_init()
+	md_AccessControlType = File_ethermint_evm_v1_evm_proto.Messages().ByName("AccessControlType")
+	fd_AccessControlType_access_type = md_AccessControlType.Fields().ByName("access_type")
+	fd_AccessControlType_access_control_list = md_AccessControlType.Fields().ByName("access_control_list")
+}
+
+var _ protoreflect.Message = (*fastReflection_AccessControlType)(nil)
+
+type fastReflection_AccessControlType AccessControlType
+
+func (x *AccessControlType) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccessControlType)(x)
+}
+
+func (x *AccessControlType) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AccessControlType_messageType fastReflection_AccessControlType_messageType
+var _ protoreflect.MessageType = fastReflection_AccessControlType_messageType{}
+
+type fastReflection_AccessControlType_messageType struct{}
+
+func (x fastReflection_AccessControlType_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccessControlType)(nil)
+}
+func (x fastReflection_AccessControlType_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccessControlType)
+}
+func (x fastReflection_AccessControlType_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControlType
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AccessControlType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControlType
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AccessControlType) Type() protoreflect.MessageType {
+	return _fastReflection_AccessControlType_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AccessControlType) New() protoreflect.Message {
+	return new(fastReflection_AccessControlType)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AccessControlType) Interface() protoreflect.ProtoMessage {
+	return (*AccessControlType)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AccessControlType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AccessType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AccessType))
+		if !f(fd_AccessControlType_access_type, value) {
+			return
+		}
+	}
+	if len(x.AccessControlList) != 0 {
+		value := protoreflect.ValueOfList(&_AccessControlType_2_list{list: &x.AccessControlList})
+		if !f(fd_AccessControlType_access_control_list, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AccessControlType) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.AccessControlType.access_type":
+		return x.AccessType != 0
+	case "ethermint.evm.v1.AccessControlType.access_control_list":
+		return len(x.AccessControlList) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlType) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.AccessControlType.access_type":
+		x.AccessType = 0
+	case "ethermint.evm.v1.AccessControlType.access_control_list":
+		x.AccessControlList = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AccessControlType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ethermint.evm.v1.AccessControlType.access_type":
+		value := x.AccessType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ethermint.evm.v1.AccessControlType.access_control_list":
+		if len(x.AccessControlList) == 0 {
+			return protoreflect.ValueOfList(&_AccessControlType_2_list{})
+		}
+		listValue := &_AccessControlType_2_list{list: &x.AccessControlList}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.AccessControlType does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.AccessControlType.access_type":
+		x.AccessType = (AccessType)(value.Enum())
+	case "ethermint.evm.v1.AccessControlType.access_control_list":
+		lv := value.List()
+		clv := lv.(*_AccessControlType_2_list)
+		x.AccessControlList = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.AccessControlType.access_control_list":
+		if x.AccessControlList == nil {
+			x.AccessControlList = []string{}
+		}
+		value := &_AccessControlType_2_list{list: &x.AccessControlList}
+		return protoreflect.ValueOfList(value)
+	case "ethermint.evm.v1.AccessControlType.access_type":
+		panic(fmt.Errorf("field access_type of message ethermint.evm.v1.AccessControlType is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AccessControlType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.AccessControlType.access_type":
+		return protoreflect.ValueOfEnum(0)
+	case "ethermint.evm.v1.AccessControlType.access_control_list":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AccessControlType_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AccessControlType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.AccessControlType", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AccessControlType) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlType) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AccessControlType) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AccessControlType)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AccessType != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccessType))
+		}
+		if len(x.AccessControlList) > 0 {
+			for _, s := range x.AccessControlList {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AccessControlType)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AccessControlList) > 0 {
+			for iNdEx := len(x.AccessControlList) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AccessControlList[iNdEx])
+				copy(dAtA[i:], x.AccessControlList[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccessControlList[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.AccessType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccessType))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AccessControlType)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+				}
+				x.AccessType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccessType |= AccessType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccessControlList = append(x.AccessControlList, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ChainConfig                      protoreflect.MessageDescriptor
+	fd_ChainConfig_homestead_block      protoreflect.FieldDescriptor
+	fd_ChainConfig_dao_fork_block       protoreflect.FieldDescriptor
+	fd_ChainConfig_dao_fork_support     protoreflect.FieldDescriptor
+	fd_ChainConfig_eip150_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_eip150_hash          protoreflect.FieldDescriptor
+	fd_ChainConfig_eip155_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_eip158_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_byzantium_block      protoreflect.FieldDescriptor
+	fd_ChainConfig_constantinople_block protoreflect.FieldDescriptor
+	fd_ChainConfig_petersburg_block     protoreflect.FieldDescriptor
+	fd_ChainConfig_istanbul_block       protoreflect.FieldDescriptor
+	fd_ChainConfig_muir_glacier_block   protoreflect.FieldDescriptor
+	fd_ChainConfig_berlin_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_london_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_arrow_glacier_block  protoreflect.FieldDescriptor
+	fd_ChainConfig_gray_glacier_block   protoreflect.FieldDescriptor
+	fd_ChainConfig_merge_netsplit_block protoreflect.FieldDescriptor
+	fd_ChainConfig_shanghai_block       protoreflect.FieldDescriptor
+	fd_ChainConfig_cancun_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_chain_id             protoreflect.FieldDescriptor
+	fd_ChainConfig_denom                protoreflect.FieldDescriptor
+	fd_ChainConfig_decimals             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ethermint_evm_v1_evm_proto_init()
+	md_ChainConfig = File_ethermint_evm_v1_evm_proto.Messages().ByName("ChainConfig")
+	fd_ChainConfig_homestead_block = md_ChainConfig.Fields().ByName("homestead_block")
+	fd_ChainConfig_dao_fork_block = md_ChainConfig.Fields().ByName("dao_fork_block")
+	fd_ChainConfig_dao_fork_support = md_ChainConfig.Fields().ByName("dao_fork_support")
+	fd_ChainConfig_eip150_block = md_ChainConfig.Fields().ByName("eip150_block")
+	fd_ChainConfig_eip150_hash = md_ChainConfig.Fields().ByName("eip150_hash")
+	fd_ChainConfig_eip155_block = md_ChainConfig.Fields().ByName("eip155_block")
+	fd_ChainConfig_eip158_block = md_ChainConfig.Fields().ByName("eip158_block")
+	fd_ChainConfig_byzantium_block = md_ChainConfig.Fields().ByName("byzantium_block")
+	fd_ChainConfig_constantinople_block = md_ChainConfig.Fields().ByName("constantinople_block")
+	fd_ChainConfig_petersburg_block = md_ChainConfig.Fields().ByName("petersburg_block")
+	fd_ChainConfig_istanbul_block = md_ChainConfig.Fields().ByName("istanbul_block")
+	fd_ChainConfig_muir_glacier_block = md_ChainConfig.Fields().ByName("muir_glacier_block")
+	fd_ChainConfig_berlin_block = md_ChainConfig.Fields().ByName("berlin_block")
+	fd_ChainConfig_london_block = md_ChainConfig.Fields().ByName("london_block")
+	fd_ChainConfig_arrow_glacier_block = md_ChainConfig.Fields().ByName("arrow_glacier_block")
+	fd_ChainConfig_gray_glacier_block = md_ChainConfig.Fields().ByName("gray_glacier_block")
+	fd_ChainConfig_merge_netsplit_block = md_ChainConfig.Fields().ByName("merge_netsplit_block")
+	fd_ChainConfig_shanghai_block = md_ChainConfig.Fields().ByName("shanghai_block")
+	fd_ChainConfig_cancun_block = md_ChainConfig.Fields().ByName("cancun_block")
+	fd_ChainConfig_chain_id = md_ChainConfig.Fields().ByName("chain_id")
+	fd_ChainConfig_denom = md_ChainConfig.Fields().ByName("denom")
+	fd_ChainConfig_decimals = md_ChainConfig.Fields().ByName("decimals")
+}
+
+var _ protoreflect.Message = (*fastReflection_ChainConfig)(nil)
+
+type fastReflection_ChainConfig ChainConfig
+
+func (x *ChainConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ChainConfig)(x)
+}
+
+func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ChainConfig_messageType fastReflection_ChainConfig_messageType
+var _ protoreflect.MessageType = fastReflection_ChainConfig_messageType{}
+
+type fastReflection_ChainConfig_messageType struct{}
+
+func (x fastReflection_ChainConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ChainConfig)(nil)
+}
+func (x fastReflection_ChainConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_ChainConfig)
+}
+func (x fastReflection_ChainConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChainConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ChainConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChainConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ChainConfig) Type() protoreflect.MessageType {
+	return _fastReflection_ChainConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ChainConfig) New() protoreflect.Message {
+	return new(fastReflection_ChainConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
+	return (*ChainConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ChainConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.HomesteadBlock != "" {
+		value := protoreflect.ValueOfString(x.HomesteadBlock)
+		if !f(fd_ChainConfig_homestead_block, value) {
+			return
+		}
+	}
+	if x.DaoForkBlock != "" {
+		value := protoreflect.ValueOfString(x.DaoForkBlock)
+		if !f(fd_ChainConfig_dao_fork_block, value) {
+			return
+		}
+	}
+	if x.DaoForkSupport != false {
+		value := protoreflect.ValueOfBool(x.DaoForkSupport)
+		if !f(fd_ChainConfig_dao_fork_support, value) {
+			return
+		}
+	}
+	if x.Eip150Block != "" {
+		value := protoreflect.ValueOfString(x.Eip150Block)
+		if !f(fd_ChainConfig_eip150_block, value) {
+			return
+		}
+	}
+	if x.Eip150Hash != "" {
+		value := protoreflect.ValueOfString(x.Eip150Hash)
+		if !f(fd_ChainConfig_eip150_hash, value) {
+			return
+		}
+	}
+	if x.Eip155Block != "" {
+		value := protoreflect.ValueOfString(x.Eip155Block)
+		if !f(fd_ChainConfig_eip155_block, value) {
+			return
+		}
+	}
+	if x.Eip158Block != "" {
+		value := protoreflect.ValueOfString(x.Eip158Block)
+		if !f(fd_ChainConfig_eip158_block, value) {
+			return
+		}
+	}
+	if x.ByzantiumBlock != "" {
+		value := protoreflect.ValueOfString(x.ByzantiumBlock)
+		if !f(fd_ChainConfig_byzantium_block, value) {
+			return
+		}
+	}
+	if x.ConstantinopleBlock != "" {
+		value := protoreflect.ValueOfString(x.ConstantinopleBlock)
+		if !f(fd_ChainConfig_constantinople_block, value) {
+			return
+		}
+	}
+	if x.PetersburgBlock != "" {
+		value := protoreflect.ValueOfString(x.PetersburgBlock)
+		if !f(fd_ChainConfig_petersburg_block, value) {
+			return
+		}
+	}
+	if x.IstanbulBlock != "" {
+		value := protoreflect.ValueOfString(x.IstanbulBlock)
+		if !f(fd_ChainConfig_istanbul_block, value) {
+			return
+		}
+	}
+	if x.MuirGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.MuirGlacierBlock)
+		if !f(fd_ChainConfig_muir_glacier_block, value) {
+			return
+		}
+	}
+	if x.BerlinBlock != "" {
+		value := protoreflect.ValueOfString(x.BerlinBlock)
+		if !f(fd_ChainConfig_berlin_block, value) {
+			return
+		}
+	}
+	if x.LondonBlock != "" {
+		value := protoreflect.ValueOfString(x.LondonBlock)
+		if !f(fd_ChainConfig_london_block, value) {
+			return
+		}
+	}
+	if x.ArrowGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.ArrowGlacierBlock)
+		if !f(fd_ChainConfig_arrow_glacier_block, value) {
+			return
+		}
+	}
+	if x.GrayGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.GrayGlacierBlock)
+		if !f(fd_ChainConfig_gray_glacier_block, value) {
+			return
+		}
+	}
+	if x.MergeNetsplitBlock != "" {
+		value := protoreflect.ValueOfString(x.MergeNetsplitBlock)
+		if !f(fd_ChainConfig_merge_netsplit_block, value) {
+			return
+		}
+	}
+	if x.ShanghaiBlock != "" {
+		value := protoreflect.ValueOfString(x.ShanghaiBlock)
+		if !f(fd_ChainConfig_shanghai_block, value) {
+			return
+		}
+	}
+	if x.CancunBlock != "" {
+		value := protoreflect.ValueOfString(x.CancunBlock)
+		if !f(fd_ChainConfig_cancun_block, value) {
+			return
+		}
+	}
+	if x.ChainId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChainId)
+		if !f(fd_ChainConfig_chain_id, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_ChainConfig_denom, value) {
+			return
+		}
+	}
+	if x.Decimals != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Decimals)
+		if !f(fd_ChainConfig_decimals, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ChainConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.ChainConfig.homestead_block":
+		return x.HomesteadBlock != ""
+	case "ethermint.evm.v1.ChainConfig.dao_fork_block":
+		return x.DaoForkBlock != ""
+	case "ethermint.evm.v1.ChainConfig.dao_fork_support":
+		return x.DaoForkSupport != false
+	case "ethermint.evm.v1.ChainConfig.eip150_block":
+		return x.Eip150Block != ""
+	case "ethermint.evm.v1.ChainConfig.eip150_hash":
+		return x.Eip150Hash != ""
+	case "ethermint.evm.v1.ChainConfig.eip155_block":
+		return x.Eip155Block != ""
+	case "ethermint.evm.v1.ChainConfig.eip158_block":
+		return x.Eip158Block != ""
+	case "ethermint.evm.v1.ChainConfig.byzantium_block":
+		return x.ByzantiumBlock != ""
+	case "ethermint.evm.v1.ChainConfig.constantinople_block":
+		return x.ConstantinopleBlock != ""
+	case "ethermint.evm.v1.ChainConfig.petersburg_block":
+		return x.PetersburgBlock != ""
+	case "ethermint.evm.v1.ChainConfig.istanbul_block":
+		return x.IstanbulBlock != ""
+	case "ethermint.evm.v1.ChainConfig.muir_glacier_block":
+		return x.MuirGlacierBlock != ""
+	case "ethermint.evm.v1.ChainConfig.berlin_block":
+		return x.BerlinBlock != ""
+	case "ethermint.evm.v1.ChainConfig.london_block":
+		return x.LondonBlock != ""
+	case "ethermint.evm.v1.ChainConfig.arrow_glacier_block":
+		return x.ArrowGlacierBlock != ""
+	case "ethermint.evm.v1.ChainConfig.gray_glacier_block":
+		return x.GrayGlacierBlock != ""
+	case "ethermint.evm.v1.ChainConfig.merge_netsplit_block":
+		return x.MergeNetsplitBlock != ""
+	case "ethermint.evm.v1.ChainConfig.shanghai_block":
+		return x.ShanghaiBlock != ""
+	case "ethermint.evm.v1.ChainConfig.cancun_block":
+		return x.CancunBlock != ""
+	case "ethermint.evm.v1.ChainConfig.chain_id":
+		return x.ChainId != uint64(0)
+	case "ethermint.evm.v1.ChainConfig.denom":
+		return x.Denom != ""
+	case "ethermint.evm.v1.ChainConfig.decimals":
+		return x.Decimals != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.ChainConfig.homestead_block":
+		x.HomesteadBlock = ""
+	case "ethermint.evm.v1.ChainConfig.dao_fork_block":
+		x.DaoForkBlock = ""
+	case "ethermint.evm.v1.ChainConfig.dao_fork_support":
+		x.DaoForkSupport = false
+	case "ethermint.evm.v1.ChainConfig.eip150_block":
+		x.Eip150Block = ""
+	case "ethermint.evm.v1.ChainConfig.eip150_hash":
+		x.Eip150Hash = ""
+	case "ethermint.evm.v1.ChainConfig.eip155_block":
+		x.Eip155Block = ""
+	case "ethermint.evm.v1.ChainConfig.eip158_block":
+		x.Eip158Block = ""
+	case "ethermint.evm.v1.ChainConfig.byzantium_block":
+		x.ByzantiumBlock = ""
+	case "ethermint.evm.v1.ChainConfig.constantinople_block":
+		x.ConstantinopleBlock = ""
+	case "ethermint.evm.v1.ChainConfig.petersburg_block":
+		x.PetersburgBlock = ""
+	case "ethermint.evm.v1.ChainConfig.istanbul_block":
+		x.IstanbulBlock = ""
+	case "ethermint.evm.v1.ChainConfig.muir_glacier_block":
+		x.MuirGlacierBlock = ""
+	case "ethermint.evm.v1.ChainConfig.berlin_block":
+		x.BerlinBlock = ""
+	case "ethermint.evm.v1.ChainConfig.london_block":
+		x.LondonBlock = ""
+	case "ethermint.evm.v1.ChainConfig.arrow_glacier_block":
+		x.ArrowGlacierBlock = ""
+	case "ethermint.evm.v1.ChainConfig.gray_glacier_block":
+		x.GrayGlacierBlock = ""
+	case "ethermint.evm.v1.ChainConfig.merge_netsplit_block":
+		x.MergeNetsplitBlock = ""
+	case "ethermint.evm.v1.ChainConfig.shanghai_block":
+		x.ShanghaiBlock = ""
+	case "ethermint.evm.v1.ChainConfig.cancun_block":
+		x.CancunBlock = ""
+	case "ethermint.evm.v1.ChainConfig.chain_id":
+		x.ChainId = uint64(0)
+	case "ethermint.evm.v1.ChainConfig.denom":
+		x.Denom = ""
+	case "ethermint.evm.v1.ChainConfig.decimals":
+		x.Decimals = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ChainConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ethermint.evm.v1.ChainConfig.homestead_block":
+		value := x.HomesteadBlock
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.dao_fork_block":
+		value := x.DaoForkBlock
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.dao_fork_support":
+		value := x.DaoForkSupport
+		return protoreflect.ValueOfBool(value)
+	case "ethermint.evm.v1.ChainConfig.eip150_block":
+		value := x.Eip150Block
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.eip150_hash":
+		value := x.Eip150Hash
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.eip155_block":
+		value := x.Eip155Block
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.eip158_block":
+		value := x.Eip158Block
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.byzantium_block":
+		value := x.ByzantiumBlock
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.constantinople_block":
+		value := x.ConstantinopleBlock
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.petersburg_block":
+		value := x.PetersburgBlock
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.istanbul_block":
+		value := x.IstanbulBlock
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.muir_glacier_block":
+		value := x.MuirGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.berlin_block":
+		value := x.BerlinBlock
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.london_block":
+		value := x.LondonBlock
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.arrow_glacier_block":
+		value := x.ArrowGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.gray_glacier_block":
+		value := x.GrayGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.merge_netsplit_block":
+		value := x.MergeNetsplitBlock
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.shanghai_block":
+		value := x.ShanghaiBlock
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.cancun_block":
+		value := x.CancunBlock
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.evm.v1.ChainConfig.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.ChainConfig.decimals":
+		value := x.Decimals
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.ChainConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.ChainConfig.homestead_block":
+		x.HomesteadBlock = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.dao_fork_block":
+		x.DaoForkBlock = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.dao_fork_support":
+		x.DaoForkSupport = value.Bool()
+	case "ethermint.evm.v1.ChainConfig.eip150_block":
+		x.Eip150Block = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.eip150_hash":
+		x.Eip150Hash = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.eip155_block":
+		x.Eip155Block = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.eip158_block":
+		x.Eip158Block = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.byzantium_block":
+		x.ByzantiumBlock = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.constantinople_block":
+		x.ConstantinopleBlock = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.petersburg_block":
+		x.PetersburgBlock = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.istanbul_block":
+		x.IstanbulBlock = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.muir_glacier_block":
+		x.MuirGlacierBlock = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.berlin_block":
+		x.BerlinBlock = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.london_block":
+		x.LondonBlock = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.arrow_glacier_block":
+		x.ArrowGlacierBlock = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.gray_glacier_block":
+		x.GrayGlacierBlock = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.merge_netsplit_block":
+		x.MergeNetsplitBlock = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.shanghai_block":
+		x.ShanghaiBlock = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.cancun_block":
+		x.CancunBlock = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.chain_id":
+		x.ChainId = value.Uint()
+	case "ethermint.evm.v1.ChainConfig.denom":
+		x.Denom = value.Interface().(string)
+	case "ethermint.evm.v1.ChainConfig.decimals":
+		x.Decimals = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.ChainConfig.homestead_block":
+		panic(fmt.Errorf("field homestead_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.dao_fork_block":
+		panic(fmt.Errorf("field dao_fork_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.dao_fork_support":
+		panic(fmt.Errorf("field dao_fork_support of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.eip150_block":
+		panic(fmt.Errorf("field eip150_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.eip150_hash":
+		panic(fmt.Errorf("field eip150_hash of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.eip155_block":
+		panic(fmt.Errorf("field eip155_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.eip158_block":
+		panic(fmt.Errorf("field eip158_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.byzantium_block":
+		panic(fmt.Errorf("field byzantium_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.constantinople_block":
+		panic(fmt.Errorf("field constantinople_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.petersburg_block":
+		panic(fmt.Errorf("field petersburg_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.istanbul_block":
+		panic(fmt.Errorf("field istanbul_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.muir_glacier_block":
+		panic(fmt.Errorf("field muir_glacier_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.berlin_block":
+		panic(fmt.Errorf("field berlin_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.london_block":
+		panic(fmt.Errorf("field london_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.arrow_glacier_block":
+		panic(fmt.Errorf("field arrow_glacier_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.gray_glacier_block":
+		panic(fmt.Errorf("field gray_glacier_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.merge_netsplit_block":
+		panic(fmt.Errorf("field merge_netsplit_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.shanghai_block":
+		panic(fmt.Errorf("field shanghai_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.cancun_block":
+		panic(fmt.Errorf("field cancun_block of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.chain_id":
+		panic(fmt.Errorf("field chain_id of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.denom":
+		panic(fmt.Errorf("field denom of message ethermint.evm.v1.ChainConfig is not mutable"))
+	case "ethermint.evm.v1.ChainConfig.decimals":
+		panic(fmt.Errorf("field decimals of message ethermint.evm.v1.ChainConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ChainConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.ChainConfig.homestead_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.dao_fork_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.dao_fork_support":
+		return protoreflect.ValueOfBool(false)
+	case "ethermint.evm.v1.ChainConfig.eip150_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.eip150_hash":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.eip155_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.eip158_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.byzantium_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.constantinople_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.petersburg_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.istanbul_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.muir_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.berlin_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.london_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.arrow_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.gray_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.merge_netsplit_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.shanghai_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.cancun_block":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.chain_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.evm.v1.ChainConfig.denom":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.ChainConfig.decimals":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ChainConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.ChainConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ChainConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ChainConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.HomesteadBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DaoForkBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DaoForkSupport {
+			n += 2
+		}
+		l = len(x.Eip150Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Eip150Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Eip155Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Eip158Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ByzantiumBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConstantinopleBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PetersburgBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IstanbulBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MuirGlacierBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BerlinBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LondonBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ArrowGlacierBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GrayGlacierBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MergeNetsplitBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ShanghaiBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CancunBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 2 + runtime.Sov(uint64(x.ChainId))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Decimals != 0 {
+			n += 2 + runtime.Sov(uint64(x.Decimals))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd0
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
+		}
+		if len(x.CancunBlock) > 0 {
+			i -= len(x.CancunBlock)
+			copy(dAtA[i:], x.CancunBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CancunBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+		if len(x.ShanghaiBlock) > 0 {
+			i -= len(x.ShanghaiBlock)
+			copy(dAtA[i:], x.ShanghaiBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ShanghaiBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if len(x.MergeNetsplitBlock) > 0 {
+			i -= len(x.MergeNetsplitBlock)
+			copy(dAtA[i:], x.MergeNetsplitBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MergeNetsplitBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.GrayGlacierBlock) > 0 {
+			i -= len(x.GrayGlacierBlock)
+			copy(dAtA[i:], x.GrayGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrayGlacierBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.ArrowGlacierBlock) > 0 {
+			i -= len(x.ArrowGlacierBlock)
+			copy(dAtA[i:], x.ArrowGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ArrowGlacierBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.LondonBlock) > 0 {
+			i -= len(x.LondonBlock)
+			copy(dAtA[i:], x.LondonBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LondonBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.BerlinBlock) > 0 {
+			i -= len(x.BerlinBlock)
+			copy(dAtA[i:], x.BerlinBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BerlinBlock)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.MuirGlacierBlock) > 0 {
+			i -= len(x.MuirGlacierBlock)
+			copy(dAtA[i:], x.MuirGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MuirGlacierBlock)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.IstanbulBlock) > 0 {
+			i -= len(x.IstanbulBlock)
+			copy(dAtA[i:], x.IstanbulBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IstanbulBlock)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.PetersburgBlock) > 0 {
+			i -= len(x.PetersburgBlock)
+			copy(dAtA[i:], x.PetersburgBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PetersburgBlock)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.ConstantinopleBlock) > 0 {
+			i -= len(x.ConstantinopleBlock)
+			copy(dAtA[i:], x.ConstantinopleBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConstantinopleBlock)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.ByzantiumBlock) > 0 {
+			i -= len(x.ByzantiumBlock)
+			copy(dAtA[i:], x.ByzantiumBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ByzantiumBlock)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Eip158Block) > 0 {
+			i -= len(x.Eip158Block)
+			copy(dAtA[i:], x.Eip158Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip158Block)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Eip155Block) > 0 {
+			i -= len(x.Eip155Block)
+			copy(dAtA[i:], x.Eip155Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip155Block)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Eip150Hash) > 0 {
+			i -= len(x.Eip150Hash)
+			copy(dAtA[i:], x.Eip150Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip150Hash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Eip150Block) > 0 {
+			i -= len(x.Eip150Block)
+			copy(dAtA[i:], x.Eip150Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip150Block)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.DaoForkSupport {
+			i--
+			if x.DaoForkSupport {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.DaoForkBlock) > 0 {
+			i -= len(x.DaoForkBlock)
+			copy(dAtA[i:], x.DaoForkBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DaoForkBlock)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.HomesteadBlock) > 0 {
+			i -= len(x.HomesteadBlock)
+			copy(dAtA[i:], x.HomesteadBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HomesteadBlock)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HomesteadBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DaoForkBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkSupport", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DaoForkSupport = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip150Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip150Block = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip150Hash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip150Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip155Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip155Block = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip158Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip158Block = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ByzantiumBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
 						break
 					}
 				}
@@ -3483,46 +5565,568 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MergeNetsplitBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShanghaiBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ShanghaiBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CancunBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CancunBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 24:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 26:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				}
+				x.Decimals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Decimals |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_State       protoreflect.MessageDescriptor
+	fd_State_key   protoreflect.FieldDescriptor
+	fd_State_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ethermint_evm_v1_evm_proto_init()
+	md_State = File_ethermint_evm_v1_evm_proto.Messages().ByName("State")
+	fd_State_key = md_State.Fields().ByName("key")
+	fd_State_value = md_State.Fields().ByName("value")
+}
+
+var _ protoreflect.Message = (*fastReflection_State)(nil)
+
+type fastReflection_State State
+
+func (x *State) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_State)(x)
+}
+
+func (x *State) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_State_messageType fastReflection_State_messageType
+var _ protoreflect.MessageType = fastReflection_State_messageType{}
+
+type fastReflection_State_messageType struct{}
+
+func (x fastReflection_State_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_State)(nil)
+}
+func (x fastReflection_State_messageType) New() protoreflect.Message {
+	return new(fastReflection_State)
+}
+func (x fastReflection_State_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_State
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_State) Descriptor() protoreflect.MessageDescriptor {
+	return md_State
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_State) Type() protoreflect.MessageType {
+	return _fastReflection_State_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_State) New() protoreflect.Message {
+	return new(fastReflection_State)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_State) Interface() protoreflect.ProtoMessage {
+	return (*State)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_State) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Key != "" {
+		value := protoreflect.ValueOfString(x.Key)
+		if !f(fd_State_key, value) {
+			return
+		}
+	}
+	if x.Value != "" {
+		value := protoreflect.ValueOfString(x.Value)
+		if !f(fd_State_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_State) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.State.key":
+		return x.Key != ""
+	case "ethermint.evm.v1.State.value":
+		return x.Value != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.State"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.State.key":
+		x.Key = ""
+	case "ethermint.evm.v1.State.value":
+		x.Value = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.State"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_State) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ethermint.evm.v1.State.key":
+		value := x.Key
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.State.value":
+		value := x.Value
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.State"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.State does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.State.key":
+		x.Key = value.Interface().(string)
+	case "ethermint.evm.v1.State.value":
+		x.Value = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.State"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.State.key":
+		panic(fmt.Errorf("field key of message ethermint.evm.v1.State is not mutable"))
+	case "ethermint.evm.v1.State.value":
+		panic(fmt.Errorf("field value of message ethermint.evm.v1.State is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.State"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_State) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ethermint.evm.v1.State.key":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.State.value":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.State"))
+		}
+		panic(fmt.Errorf("message ethermint.evm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_State) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.State", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_State) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_State) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*State)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Value)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*State)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*State)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MergeNetsplitBlock = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 22:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShanghaiBlock", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.ShanghaiBlock = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 23:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CancunBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3550,30 +6154,11 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CancunBlock = string(dAtA[iNdEx:postIndex])
+				x.Key = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 24:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ChainId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 25:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3601,27 +6186,8 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				x.Value = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 26:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-				}
-				x.Decimals = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Decimals |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3657,29 +6223,80 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_TransactionLogs_2_list)(nil)
+
+type _TransactionLogs_2_list struct {
+	list *[]*Log
+}
+
+func (x *_TransactionLogs_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TransactionLogs_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TransactionLogs_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Log)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TransactionLogs_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Log)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TransactionLogs_2_list) AppendMutable() protoreflect.Value {
+	v := new(Log)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TransactionLogs_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TransactionLogs_2_list) NewElement() protoreflect.Value {
+	v := new(Log)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TransactionLogs_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_State       protoreflect.MessageDescriptor
-	fd_State_key   protoreflect.FieldDescriptor
-	fd_State_value protoreflect.FieldDescriptor
+	md_TransactionLogs      protoreflect.MessageDescriptor
+	fd_TransactionLogs_hash protoreflect.FieldDescriptor
+	fd_TransactionLogs_logs protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ethermint_evm_v1_evm_proto_init()
-	md_State = File_ethermint_evm_v1_evm_proto.Messages().ByName("State")
-	fd_State_key = md_State.Fields().ByName("key")
-	fd_State_value = md_State.Fields().ByName("value")
+	md_TransactionLogs = File_ethermint_evm_v1_evm_proto.Messages().ByName("TransactionLogs")
+	fd_TransactionLogs_hash = md_TransactionLogs.Fields().ByName("hash")
+	fd_TransactionLogs_logs = md_TransactionLogs.Fields().ByName("logs")
 }
 
-var _ protoreflect.Message = (*fastReflection_State)(nil)
+var _ protoreflect.Message = (*fastReflection_TransactionLogs)(nil)
 
-type fastReflection_State State
+type fastReflection_TransactionLogs TransactionLogs
 
-func (x *State) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_State)(x)
+func (x *TransactionLogs) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TransactionLogs)(x)
 }
 
-func (x *State) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[4]
+func (x *TransactionLogs) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3690,43 +6307,43 @@ func (x *State) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_State_messageType fastReflection_State_messageType
-var _ protoreflect.MessageType = fastReflection_State_messageType{}
+var _fastReflection_TransactionLogs_messageType fastReflection_TransactionLogs_messageType
+var _ protoreflect.MessageType = fastReflection_TransactionLogs_messageType{}
 
-type fastReflection_State_messageType struct{}
+type fastReflection_TransactionLogs_messageType struct{}
 
-func (x fastReflection_State_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_State)(nil)
+func (x fastReflection_TransactionLogs_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TransactionLogs)(nil)
 }
-func (x fastReflection_State_messageType) New() protoreflect.Message {
-	return new(fastReflection_State)
+func (x fastReflection_TransactionLogs_messageType) New() protoreflect.Message {
+	return new(fastReflection_TransactionLogs)
 }
-func (x fastReflection_State_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_State
+func (x fastReflection_TransactionLogs_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransactionLogs
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_State) Descriptor() protoreflect.MessageDescriptor {
-	return md_State
+func (x *fastReflection_TransactionLogs) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransactionLogs
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_State) Type() protoreflect.MessageType {
-	return _fastReflection_State_messageType
+func (x *fastReflection_TransactionLogs) Type() protoreflect.MessageType {
+	return _fastReflection_TransactionLogs_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_State) New() protoreflect.Message {
-	return new(fastReflection_State)
+func (x *fastReflection_TransactionLogs) New() protoreflect.Message {
+	return new(fastReflection_TransactionLogs)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_State) Interface() protoreflect.ProtoMessage {
-	return (*State)(x)
+func (x *fastReflection_TransactionLogs) Interface() protoreflect.ProtoMessage {
+	return (*TransactionLogs)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3734,16 +6351,16 @@ func (x *fastReflection_State) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_State) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Key != "" {
-		value := protoreflect.ValueOfString(x.Key)
-		if !f(fd_State_key, value) {
+func (x *fastReflection_TransactionLogs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_TransactionLogs_hash, value) {
 			return
 		}
 	}
-	if x.Value != "" {
-		value := protoreflect.ValueOfString(x.Value)
-		if !f(fd_State_value, value) {
+	if len(x.Logs) != 0 {
+		value := protoreflect.ValueOfList(&_TransactionLogs_2_list{list: &x.Logs})
+		if !f(fd_TransactionLogs_logs, value) {
 			return
 		}
 	}
@@ -3760,17 +6377,17 @@ func (x *fastReflection_State) Range(f func(protoreflect.FieldDescriptor, protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_State) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TransactionLogs) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.State.key":
-		return x.Key != ""
-	case "ethermint.evm.v1.State.value":
-		return x.Value != ""
+	case "ethermint.evm.v1.TransactionLogs.hash":
+		return x.Hash != ""
+	case "ethermint.evm.v1.TransactionLogs.logs":
+		return len(x.Logs) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3780,17 +6397,17 @@ func (x *fastReflection_State) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TransactionLogs) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.State.key":
-		x.Key = ""
-	case "ethermint.evm.v1.State.value":
-		x.Value = ""
+	case "ethermint.evm.v1.TransactionLogs.hash":
+		x.Hash = ""
+	case "ethermint.evm.v1.TransactionLogs.logs":
+		x.Logs = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3800,19 +6417,22 @@ func (x *fastReflection_State) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_State) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TransactionLogs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ethermint.evm.v1.State.key":
-		value := x.Key
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.State.value":
-		value := x.Value
+	case "ethermint.evm.v1.TransactionLogs.hash":
+		value := x.Hash
 		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.TransactionLogs.logs":
+		if len(x.Logs) == 0 {
+			return protoreflect.ValueOfList(&_TransactionLogs_2_list{})
+		}
+		listValue := &_TransactionLogs_2_list{list: &x.Logs}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.State does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.TransactionLogs does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3826,17 +6446,19 @@ func (x *fastReflection_State) Get(descriptor protoreflect.FieldDescriptor) prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TransactionLogs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.State.key":
-		x.Key = value.Interface().(string)
-	case "ethermint.evm.v1.State.value":
-		x.Value = value.Interface().(string)
+	case "ethermint.evm.v1.TransactionLogs.hash":
+		x.Hash = value.Interface().(string)
+	case "ethermint.evm.v1.TransactionLogs.logs":
+		lv := value.List()
+		clv := lv.(*_TransactionLogs_2_list)
+		x.Logs = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3850,44 +6472,49 @@ func (x *fastReflection_State) Set(fd protoreflect.FieldDescriptor, value protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TransactionLogs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.State.key":
-		panic(fmt.Errorf("field key of message ethermint.evm.v1.State is not mutable"))
-	case "ethermint.evm.v1.State.value":
-		panic(fmt.Errorf("field value of message ethermint.evm.v1.State is not mutable"))
+	case "ethermint.evm.v1.TransactionLogs.logs":
+		if x.Logs == nil {
+			x.Logs = []*Log{}
+		}
+		value := &_TransactionLogs_2_list{list: &x.Logs}
+		return protoreflect.ValueOfList(value)
+	case "ethermint.evm.v1.TransactionLogs.hash":
+		panic(fmt.Errorf("field hash of message ethermint.evm.v1.TransactionLogs is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_State) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TransactionLogs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.State.key":
-		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.State.value":
+	case "ethermint.evm.v1.TransactionLogs.hash":
 		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.TransactionLogs.logs":
+		list := []*Log{}
+		return protoreflect.ValueOfList(&_TransactionLogs_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_State) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TransactionLogs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.State", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.TransactionLogs", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3895,7 +6522,7 @@ func (x *fastReflection_State) WhichOneof(d protoreflect.OneofDescriptor) protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_State) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TransactionLogs) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3906,7 +6533,7 @@ func (x *fastReflection_State) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TransactionLogs) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3918,7 +6545,7 @@ func (x *fastReflection_State) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_State) IsValid() bool {
+func (x *fastReflection_TransactionLogs) IsValid() bool {
 	return x != nil
 }
 
@@ -3928,9 +6555,9 @@ func (x *fastReflection_State) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*State)
+		x := input.Message.Interface().(*TransactionLogs)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3942,13 +6569,15 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Key)
+		l = len(x.Hash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Value)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Logs) > 0 {
+			for _, e := range x.Logs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3960,7 +6589,7 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*State)
+		x := input.Message.Interface().(*TransactionLogs)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3979,17 +6608,26 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Value) > 0 {
-			i -= len(x.Value)
-			copy(dAtA[i:], x.Value)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
-			i--
-			dAtA[i] = 0x12
+		if len(x.Logs) > 0 {
+			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Logs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
-		if len(x.Key) > 0 {
-			i -= len(x.Key)
-			copy(dAtA[i:], x.Key)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4004,7 +6642,7 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*State)
+		x := input.Message.Interface().(*TransactionLogs)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4036,15 +6674,15 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransactionLogs: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransactionLogs: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4072,13 +6710,13 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Key = string(dAtA[iNdEx:postIndex])
+				x.Hash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4088,23 +6726,25 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Value = string(dAtA[iNdEx:postIndex])
+				x.Logs = append(x.Logs, &Log{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4141,80 +6781,89 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_TransactionLogs_2_list)(nil)
+var _ protoreflect.List = (*_Log_2_list)(nil)
 
-type _TransactionLogs_2_list struct {
-	list *[]*Log
+type _Log_2_list struct {
+	list *[]string
 }
 
-func (x *_TransactionLogs_2_list) Len() int {
+func (x *_Log_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_TransactionLogs_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *_Log_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_TransactionLogs_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Log)
+func (x *_Log_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_TransactionLogs_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Log)
+func (x *_Log_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_TransactionLogs_2_list) AppendMutable() protoreflect.Value {
-	v := new(Log)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_Log_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Log at list field Topics as it is not of Message kind"))
 }
 
-func (x *_TransactionLogs_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
+func (x *_Log_2_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_TransactionLogs_2_list) NewElement() protoreflect.Value {
-	v := new(Log)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_Log_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *_TransactionLogs_2_list) IsValid() bool {
+func (x *_Log_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_TransactionLogs      protoreflect.MessageDescriptor
-	fd_TransactionLogs_hash protoreflect.FieldDescriptor
-	fd_TransactionLogs_logs protoreflect.FieldDescriptor
+	md_Log              protoreflect.MessageDescriptor
+	fd_Log_address      protoreflect.FieldDescriptor
+	fd_Log_topics       protoreflect.FieldDescriptor
+	fd_Log_data         protoreflect.FieldDescriptor
+	fd_Log_block_number protoreflect.FieldDescriptor
+	fd_Log_tx_hash      protoreflect.FieldDescriptor
+	fd_Log_tx_index     protoreflect.FieldDescriptor
+	fd_Log_block_hash   protoreflect.FieldDescriptor
+	fd_Log_index        protoreflect.FieldDescriptor
+	fd_Log_removed      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ethermint_evm_v1_evm_proto_init()
-	md_TransactionLogs = File_ethermint_evm_v1_evm_proto.Messages().ByName("TransactionLogs")
-	fd_TransactionLogs_hash = md_TransactionLogs.Fields().ByName("hash")
-	fd_TransactionLogs_logs = md_TransactionLogs.Fields().ByName("logs")
+	md_Log = File_ethermint_evm_v1_evm_proto.Messages().ByName("Log")
+	fd_Log_address = md_Log.Fields().ByName("address")
+	fd_Log_topics = md_Log.Fields().ByName("topics")
+	fd_Log_data = md_Log.Fields().ByName("data")
+	fd_Log_block_number = md_Log.Fields().ByName("block_number")
+	fd_Log_tx_hash = md_Log.Fields().ByName("tx_hash")
+	fd_Log_tx_index = md_Log.Fields().ByName("tx_index")
+	fd_Log_block_hash = md_Log.Fields().ByName("block_hash")
+	fd_Log_index = md_Log.Fields().ByName("index")
+	fd_Log_removed = md_Log.Fields().ByName("removed")
 }
 
-var _ protoreflect.Message = (*fastReflection_TransactionLogs)(nil)
+var _ protoreflect.Message = (*fastReflection_Log)(nil)
 
-type fastReflection_TransactionLogs TransactionLogs
+type fastReflection_Log Log
 
-func (x *TransactionLogs) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TransactionLogs)(x)
+func (x *Log) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Log)(x)
 }
 
-func (x *TransactionLogs) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[5]
+func (x *Log) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4225,43 +6874,43 @@ func (x *TransactionLogs) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TransactionLogs_messageType fastReflection_TransactionLogs_messageType
-var _ protoreflect.MessageType = fastReflection_TransactionLogs_messageType{}
+var _fastReflection_Log_messageType fastReflection_Log_messageType
+var _ protoreflect.MessageType = fastReflection_Log_messageType{}
 
-type fastReflection_TransactionLogs_messageType struct{}
+type fastReflection_Log_messageType struct{}
 
-func (x fastReflection_TransactionLogs_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TransactionLogs)(nil)
+func (x fastReflection_Log_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Log)(nil)
 }
-func (x fastReflection_TransactionLogs_messageType) New() protoreflect.Message {
-	return new(fastReflection_TransactionLogs)
+func (x fastReflection_Log_messageType) New() protoreflect.Message {
+	return new(fastReflection_Log)
 }
-func (x fastReflection_TransactionLogs_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TransactionLogs
+func (x fastReflection_Log_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Log
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TransactionLogs) Descriptor() protoreflect.MessageDescriptor {
-	return md_TransactionLogs
+func (x *fastReflection_Log) Descriptor() protoreflect.MessageDescriptor {
+	return md_Log
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TransactionLogs) Type() protoreflect.MessageType {
-	return _fastReflection_TransactionLogs_messageType
+func (x *fastReflection_Log) Type() protoreflect.MessageType {
+	return _fastReflection_Log_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TransactionLogs) New() protoreflect.Message {
-	return new(fastReflection_TransactionLogs)
+func (x *fastReflection_Log) New() protoreflect.Message {
+	return new(fastReflection_Log)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TransactionLogs) Interface() protoreflect.ProtoMessage {
-	return (*TransactionLogs)(x)
+func (x *fastReflection_Log) Interface() protoreflect.ProtoMessage {
+	return (*Log)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4269,16 +6918,58 @@ func (x *fastReflection_TransactionLogs) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TransactionLogs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_TransactionLogs_hash, value) {
+func (x *fastReflection_Log) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Log_address, value) {
 			return
 		}
 	}
-	if len(x.Logs) != 0 {
-		value := protoreflect.ValueOfList(&_TransactionLogs_2_list{list: &x.Logs})
-		if !f(fd_TransactionLogs_logs, value) {
+	if len(x.Topics) != 0 {
+		value := protoreflect.ValueOfList(&_Log_2_list{list: &x.Topics})
+		if !f(fd_Log_topics, value) {
+			return
+		}
+	}
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_Log_data, value) {
+			return
+		}
+	}
+	if x.BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNumber)
+		if !f(fd_Log_block_number, value) {
+			return
+		}
+	}
+	if x.TxHash != "" {
+		value := protoreflect.ValueOfString(x.TxHash)
+		if !f(fd_Log_tx_hash, value) {
+			return
+		}
+	}
+	if x.TxIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TxIndex)
+		if !f(fd_Log_tx_index, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_Log_block_hash, value) {
+			return
+		}
+	}
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_Log_index, value) {
+			return
+		}
+	}
+	if x.Removed != false {
+		value := protoreflect.ValueOfBool(x.Removed)
+		if !f(fd_Log_removed, value) {
 			return
 		}
 	}
@@ -4295,17 +6986,31 @@ func (x *fastReflection_TransactionLogs) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TransactionLogs) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Log) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.TransactionLogs.hash":
-		return x.Hash != ""
-	case "ethermint.evm.v1.TransactionLogs.logs":
-		return len(x.Logs) != 0
+	case "ethermint.evm.v1.Log.address":
+		return x.Address != ""
+	case "ethermint.evm.v1.Log.topics":
+		return len(x.Topics) != 0
+	case "ethermint.evm.v1.Log.data":
+		return len(x.Data) != 0
+	case "ethermint.evm.v1.Log.block_number":
+		return x.BlockNumber != uint64(0)
+	case "ethermint.evm.v1.Log.tx_hash":
+		return x.TxHash != ""
+	case "ethermint.evm.v1.Log.tx_index":
+		return x.TxIndex != uint64(0)
+	case "ethermint.evm.v1.Log.block_hash":
+		return x.BlockHash != ""
+	case "ethermint.evm.v1.Log.index":
+		return x.Index != uint64(0)
+	case "ethermint.evm.v1.Log.removed":
+		return x.Removed != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Log"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4315,17 +7020,31 @@ func (x *fastReflection_TransactionLogs) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TransactionLogs) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Log) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.TransactionLogs.hash":
-		x.Hash = ""
-	case "ethermint.evm.v1.TransactionLogs.logs":
-		x.Logs = nil
+	case "ethermint.evm.v1.Log.address":
+		x.Address = ""
+	case "ethermint.evm.v1.Log.topics":
+		x.Topics = nil
+	case "ethermint.evm.v1.Log.data":
+		x.Data = nil
+	case "ethermint.evm.v1.Log.block_number":
+		x.BlockNumber = uint64(0)
+	case "ethermint.evm.v1.Log.tx_hash":
+		x.TxHash = ""
+	case "ethermint.evm.v1.Log.tx_index":
+		x.TxIndex = uint64(0)
+	case "ethermint.evm.v1.Log.block_hash":
+		x.BlockHash = ""
+	case "ethermint.evm.v1.Log.index":
+		x.Index = uint64(0)
+	case "ethermint.evm.v1.Log.removed":
+		x.Removed = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Log"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4335,22 +7054,43 @@ func (x *fastReflection_TransactionLogs) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TransactionLogs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Log) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ethermint.evm.v1.TransactionLogs.hash":
-		value := x.Hash
+	case "ethermint.evm.v1.Log.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.TransactionLogs.logs":
-		if len(x.Logs) == 0 {
-			return protoreflect.ValueOfList(&_TransactionLogs_2_list{})
+	case "ethermint.evm.v1.Log.topics":
+		if len(x.Topics) == 0 {
+			return protoreflect.ValueOfList(&_Log_2_list{})
 		}
-		listValue := &_TransactionLogs_2_list{list: &x.Logs}
+		listValue := &_Log_2_list{list: &x.Topics}
 		return protoreflect.ValueOfList(listValue)
+	case "ethermint.evm.v1.Log.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	case "ethermint.evm.v1.Log.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.evm.v1.Log.tx_hash":
+		value := x.TxHash
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.Log.tx_index":
+		value := x.TxIndex
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.evm.v1.Log.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.Log.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.evm.v1.Log.removed":
+		value := x.Removed
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Log"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.TransactionLogs does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.Log does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4364,19 +7104,33 @@ func (x *fastReflection_TransactionLogs) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TransactionLogs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Log) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.TransactionLogs.hash":
-		x.Hash = value.Interface().(string)
-	case "ethermint.evm.v1.TransactionLogs.logs":
+	case "ethermint.evm.v1.Log.address":
+		x.Address = value.Interface().(string)
+	case "ethermint.evm.v1.Log.topics":
 		lv := value.List()
-		clv := lv.(*_TransactionLogs_2_list)
-		x.Logs = *clv.list
+		clv := lv.(*_Log_2_list)
+		x.Topics = *clv.list
+	case "ethermint.evm.v1.Log.data":
+		x.Data = value.Bytes()
+	case "ethermint.evm.v1.Log.block_number":
+		x.BlockNumber = value.Uint()
+	case "ethermint.evm.v1.Log.tx_hash":
+		x.TxHash = value.Interface().(string)
+	case "ethermint.evm.v1.Log.tx_index":
+		x.TxIndex = value.Uint()
+	case "ethermint.evm.v1.Log.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "ethermint.evm.v1.Log.index":
+		x.Index = value.Uint()
+	case "ethermint.evm.v1.Log.removed":
+		x.Removed = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Log"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4390,49 +7144,77 @@ func (x *fastReflection_TransactionLogs) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TransactionLogs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Log) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.TransactionLogs.logs":
-		if x.Logs == nil {
-			x.Logs = []*Log{}
+	case "ethermint.evm.v1.Log.topics":
+		if x.Topics == nil {
+			x.Topics = []string{}
 		}
-		value := &_TransactionLogs_2_list{list: &x.Logs}
+		value := &_Log_2_list{list: &x.Topics}
 		return protoreflect.ValueOfList(value)
-	case "ethermint.evm.v1.TransactionLogs.hash":
-		panic(fmt.Errorf("field hash of message ethermint.evm.v1.TransactionLogs is not mutable"))
+	case "ethermint.evm.v1.Log.address":
+		panic(fmt.Errorf("field address of message ethermint.evm.v1.Log is not mutable"))
+	case "ethermint.evm.v1.Log.data":
+		panic(fmt.Errorf("field data of message ethermint.evm.v1.Log is not mutable"))
+	case "ethermint.evm.v1.Log.block_number":
+		panic(fmt.Errorf("field block_number of message ethermint.evm.v1.Log is not mutable"))
+	case "ethermint.evm.v1.Log.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message ethermint.evm.v1.Log is not mutable"))
+	case "ethermint.evm.v1.Log.tx_index":
+		panic(fmt.Errorf("field tx_index of message ethermint.evm.v1.Log is not mutable"))
+	case "ethermint.evm.v1.Log.block_hash":
+		panic(fmt.Errorf("field block_hash of message ethermint.evm.v1.Log is not mutable"))
+	case "ethermint.evm.v1.Log.index":
+		panic(fmt.Errorf("field index of message ethermint.evm.v1.Log is not mutable"))
+	case "ethermint.evm.v1.Log.removed":
+		panic(fmt.Errorf("field removed of message ethermint.evm.v1.Log is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Log"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TransactionLogs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Log) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.TransactionLogs.hash":
+	case "ethermint.evm.v1.Log.address":
 		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.TransactionLogs.logs":
-		list := []*Log{}
-		return protoreflect.ValueOfList(&_TransactionLogs_2_list{list: &list})
+	case "ethermint.evm.v1.Log.topics":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Log_2_list{list: &list})
+	case "ethermint.evm.v1.Log.data":
+		return protoreflect.ValueOfBytes(nil)
+	case "ethermint.evm.v1.Log.block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.evm.v1.Log.tx_hash":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.Log.tx_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.evm.v1.Log.block_hash":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.Log.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.evm.v1.Log.removed":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Log"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TransactionLogs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Log) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.TransactionLogs", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.Log", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4440,7 +7222,7 @@ func (x *fastReflection_TransactionLogs) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TransactionLogs) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Log) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4451,7 +7233,7 @@ func (x *fastReflection_TransactionLogs) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TransactionLogs) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Log) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4463,7 +7245,7 @@ func (x *fastReflection_TransactionLogs) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TransactionLogs) IsValid() bool {
+func (x *fastReflection_Log) IsValid() bool {
 	return x != nil
 }
 
@@ -4473,9 +7255,9 @@ func (x *fastReflection_TransactionLogs) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TransactionLogs)
+		x := input.Message.Interface().(*Log)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4487,16 +7269,40 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Hash)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Logs) > 0 {
-			for _, e := range x.Logs {
-				l = options.Size(e)
+		if len(x.Topics) > 0 {
+			for _, s := range x.Topics {
+				l = len(s)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		l = len(x.TxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TxIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxIndex))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if x.Removed {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4507,7 +7313,7 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TransactionLogs)
+		x := input.Message.Interface().(*Log)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4526,81 +7332,237 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Logs) > 0 {
-			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Logs[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+		if x.Removed {
+			i--
+			if x.Removed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.TxIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxIndex))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Topics) > 0 {
+			for iNdEx := len(x.Topics) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Topics[iNdEx])
+				copy(dAtA[i:], x.Topics[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Topics[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Log)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Log: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Topics = append(x.Topics, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TransactionLogs)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransactionLogs: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransactionLogs: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4628,13 +7590,32 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				x.TxHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				}
+				x.TxIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TxIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4644,26 +7625,63 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Logs = append(x.Logs, &Log{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Removed = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4699,89 +7717,106 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Log_2_list)(nil)
+var _ protoreflect.List = (*_Receipt_9_list)(nil)
 
-type _Log_2_list struct {
-	list *[]string
+type _Receipt_9_list struct {
+	list *[]*Log
 }
 
-func (x *_Log_2_list) Len() int {
+func (x *_Receipt_9_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Log_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_Receipt_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Log_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_Receipt_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Log)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Log_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_Receipt_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Log)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Log_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message Log at list field Topics as it is not of Message kind"))
+func (x *_Receipt_9_list) AppendMutable() protoreflect.Value {
+	v := new(Log)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Log_2_list) Truncate(n int) {
+func (x *_Receipt_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Log_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_Receipt_9_list) NewElement() protoreflect.Value {
+	v := new(Log)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Log_2_list) IsValid() bool {
+func (x *_Receipt_9_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Log              protoreflect.MessageDescriptor
-	fd_Log_address      protoreflect.FieldDescriptor
-	fd_Log_topics       protoreflect.FieldDescriptor
-	fd_Log_data         protoreflect.FieldDescriptor
-	fd_Log_block_number protoreflect.FieldDescriptor
-	fd_Log_tx_hash      protoreflect.FieldDescriptor
-	fd_Log_tx_index     protoreflect.FieldDescriptor
-	fd_Log_block_hash   protoreflect.FieldDescriptor
-	fd_Log_index        protoreflect.FieldDescriptor
-	fd_Log_removed      protoreflect.FieldDescriptor
+	md_Receipt                     protoreflect.MessageDescriptor
+	fd_Receipt_tx_hash             protoreflect.FieldDescriptor
+	fd_Receipt_tx_index            protoreflect.FieldDescriptor
+	fd_Receipt_from                protoreflect.FieldDescriptor
+	fd_Receipt_to                  protoreflect.FieldDescriptor
+	fd_Receipt_contract_address    protoreflect.FieldDescriptor
+	fd_Receipt_gas_used            protoreflect.FieldDescriptor
+	fd_Receipt_status              protoreflect.FieldDescriptor
+	fd_Receipt_vm_error            protoreflect.FieldDescriptor
+	fd_Receipt_logs                protoreflect.FieldDescriptor
+	fd_Receipt_block_number        protoreflect.FieldDescriptor
+	fd_Receipt_block_hash          protoreflect.FieldDescriptor
+	fd_Receipt_cumulative_gas_used protoreflect.FieldDescriptor
+	fd_Receipt_logs_bloom          protoreflect.FieldDescriptor
+	fd_Receipt_tx_type             protoreflect.FieldDescriptor
+	fd_Receipt_effective_gas_price protoreflect.FieldDescriptor
 )
 
-func init() {
-	file_ethermint_evm_v1_evm_proto_init()
-	md_Log = File_ethermint_evm_v1_evm_proto.Messages().ByName("Log")
-	fd_Log_address = md_Log.Fields().ByName("address")
-	fd_Log_topics = md_Log.Fields().ByName("topics")
-	fd_Log_data = md_Log.Fields().ByName("data")
-	fd_Log_block_number = md_Log.Fields().ByName("block_number")
-	fd_Log_tx_hash = md_Log.Fields().ByName("tx_hash")
-	fd_Log_tx_index = md_Log.Fields().ByName("tx_index")
-	fd_Log_block_hash = md_Log.Fields().ByName("block_hash")
-	fd_Log_index = md_Log.Fields().ByName("index")
-	fd_Log_removed = md_Log.Fields().ByName("removed")
-}
-
-var _ protoreflect.Message = (*fastReflection_Log)(nil)
-
-type fastReflection_Log Log
-
-func (x *Log) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Log)(x)
-}
-
-func (x *Log) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[6]
+func init() {
+	file_ethermint_evm_v1_evm_proto_init()
+	md_Receipt = File_ethermint_evm_v1_evm_proto.Messages().ByName("Receipt")
+	fd_Receipt_tx_hash = md_Receipt.Fields().ByName("tx_hash")
+	fd_Receipt_tx_index = md_Receipt.Fields().ByName("tx_index")
+	fd_Receipt_from = md_Receipt.Fields().ByName("from")
+	fd_Receipt_to = md_Receipt.Fields().ByName("to")
+	fd_Receipt_contract_address = md_Receipt.Fields().ByName("contract_address")
+	fd_Receipt_gas_used = md_Receipt.Fields().ByName("gas_used")
+	fd_Receipt_status = md_Receipt.Fields().ByName("status")
+	fd_Receipt_vm_error = md_Receipt.Fields().ByName("vm_error")
+	fd_Receipt_logs = md_Receipt.Fields().ByName("logs")
+	fd_Receipt_block_number = md_Receipt.Fields().ByName("block_number")
+	fd_Receipt_block_hash = md_Receipt.Fields().ByName("block_hash")
+	fd_Receipt_cumulative_gas_used = md_Receipt.Fields().ByName("cumulative_gas_used")
+	fd_Receipt_logs_bloom = md_Receipt.Fields().ByName("logs_bloom")
+	fd_Receipt_tx_type = md_Receipt.Fields().ByName("tx_type")
+	fd_Receipt_effective_gas_price = md_Receipt.Fields().ByName("effective_gas_price")
+}
+
+var _ protoreflect.Message = (*fastReflection_Receipt)(nil)
+
+type fastReflection_Receipt Receipt
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Receipt)(x)
+}
+
+func (x *Receipt) slowProtoReflect() protoreflect.Message {
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4792,43 +7827,43 @@ func (x *Log) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Log_messageType fastReflection_Log_messageType
-var _ protoreflect.MessageType = fastReflection_Log_messageType{}
+var _fastReflection_Receipt_messageType fastReflection_Receipt_messageType
+var _ protoreflect.MessageType = fastReflection_Receipt_messageType{}
 
-type fastReflection_Log_messageType struct{}
+type fastReflection_Receipt_messageType struct{}
 
-func (x fastReflection_Log_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Log)(nil)
+func (x fastReflection_Receipt_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Receipt)(nil)
 }
-func (x fastReflection_Log_messageType) New() protoreflect.Message {
-	return new(fastReflection_Log)
+func (x fastReflection_Receipt_messageType) New() protoreflect.Message {
+	return new(fastReflection_Receipt)
 }
-func (x fastReflection_Log_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Log
+func (x fastReflection_Receipt_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Receipt
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Log) Descriptor() protoreflect.MessageDescriptor {
-	return md_Log
+func (x *fastReflection_Receipt) Descriptor() protoreflect.MessageDescriptor {
+	return md_Receipt
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Log) Type() protoreflect.MessageType {
-	return _fastReflection_Log_messageType
+func (x *fastReflection_Receipt) Type() protoreflect.MessageType {
+	return _fastReflection_Receipt_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Log) New() protoreflect.Message {
-	return new(fastReflection_Log)
+func (x *fastReflection_Receipt) New() protoreflect.Message {
+	return new(fastReflection_Receipt)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Log) Interface() protoreflect.ProtoMessage {
-	return (*Log)(x)
+func (x *fastReflection_Receipt) Interface() protoreflect.ProtoMessage {
+	return (*Receipt)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4836,58 +7871,94 @@ func (x *fastReflection_Log) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Log) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_Log_address, value) {
+func (x *fastReflection_Receipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TxHash != "" {
+		value := protoreflect.ValueOfString(x.TxHash)
+		if !f(fd_Receipt_tx_hash, value) {
 			return
 		}
 	}
-	if len(x.Topics) != 0 {
-		value := protoreflect.ValueOfList(&_Log_2_list{list: &x.Topics})
-		if !f(fd_Log_topics, value) {
+	if x.TxIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TxIndex)
+		if !f(fd_Receipt_tx_index, value) {
 			return
 		}
 	}
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_Log_data, value) {
+	if x.From != "" {
+		value := protoreflect.ValueOfString(x.From)
+		if !f(fd_Receipt_from, value) {
 			return
 		}
 	}
-	if x.BlockNumber != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockNumber)
-		if !f(fd_Log_block_number, value) {
+	if x.To != "" {
+		value := protoreflect.ValueOfString(x.To)
+		if !f(fd_Receipt_to, value) {
 			return
 		}
 	}
-	if x.TxHash != "" {
-		value := protoreflect.ValueOfString(x.TxHash)
-		if !f(fd_Log_tx_hash, value) {
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_Receipt_contract_address, value) {
 			return
 		}
 	}
-	if x.TxIndex != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.TxIndex)
-		if !f(fd_Log_tx_index, value) {
+	if x.GasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasUsed)
+		if !f(fd_Receipt_gas_used, value) {
+			return
+		}
+	}
+	if x.Status != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Status)
+		if !f(fd_Receipt_status, value) {
+			return
+		}
+	}
+	if x.VmError != "" {
+		value := protoreflect.ValueOfString(x.VmError)
+		if !f(fd_Receipt_vm_error, value) {
+			return
+		}
+	}
+	if len(x.Logs) != 0 {
+		value := protoreflect.ValueOfList(&_Receipt_9_list{list: &x.Logs})
+		if !f(fd_Receipt_logs, value) {
+			return
+		}
+	}
+	if x.BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNumber)
+		if !f(fd_Receipt_block_number, value) {
 			return
 		}
 	}
 	if x.BlockHash != "" {
 		value := protoreflect.ValueOfString(x.BlockHash)
-		if !f(fd_Log_block_hash, value) {
+		if !f(fd_Receipt_block_hash, value) {
 			return
 		}
 	}
-	if x.Index != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Index)
-		if !f(fd_Log_index, value) {
+	if x.CumulativeGasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CumulativeGasUsed)
+		if !f(fd_Receipt_cumulative_gas_used, value) {
 			return
 		}
 	}
-	if x.Removed != false {
-		value := protoreflect.ValueOfBool(x.Removed)
-		if !f(fd_Log_removed, value) {
+	if len(x.LogsBloom) != 0 {
+		value := protoreflect.ValueOfBytes(x.LogsBloom)
+		if !f(fd_Receipt_logs_bloom, value) {
+			return
+		}
+	}
+	if x.TxType != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TxType)
+		if !f(fd_Receipt_tx_type, value) {
+			return
+		}
+	}
+	if x.EffectiveGasPrice != "" {
+		value := protoreflect.ValueOfString(x.EffectiveGasPrice)
+		if !f(fd_Receipt_effective_gas_price, value) {
 			return
 		}
 	}
@@ -4904,31 +7975,43 @@ func (x *fastReflection_Log) Range(f func(protoreflect.FieldDescriptor, protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Log) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Receipt) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.Log.address":
-		return x.Address != ""
-	case "ethermint.evm.v1.Log.topics":
-		return len(x.Topics) != 0
-	case "ethermint.evm.v1.Log.data":
-		return len(x.Data) != 0
-	case "ethermint.evm.v1.Log.block_number":
-		return x.BlockNumber != uint64(0)
-	case "ethermint.evm.v1.Log.tx_hash":
+	case "ethermint.evm.v1.Receipt.tx_hash":
 		return x.TxHash != ""
-	case "ethermint.evm.v1.Log.tx_index":
+	case "ethermint.evm.v1.Receipt.tx_index":
 		return x.TxIndex != uint64(0)
-	case "ethermint.evm.v1.Log.block_hash":
+	case "ethermint.evm.v1.Receipt.from":
+		return x.From != ""
+	case "ethermint.evm.v1.Receipt.to":
+		return x.To != ""
+	case "ethermint.evm.v1.Receipt.contract_address":
+		return x.ContractAddress != ""
+	case "ethermint.evm.v1.Receipt.gas_used":
+		return x.GasUsed != uint64(0)
+	case "ethermint.evm.v1.Receipt.status":
+		return x.Status != uint64(0)
+	case "ethermint.evm.v1.Receipt.vm_error":
+		return x.VmError != ""
+	case "ethermint.evm.v1.Receipt.logs":
+		return len(x.Logs) != 0
+	case "ethermint.evm.v1.Receipt.block_number":
+		return x.BlockNumber != uint64(0)
+	case "ethermint.evm.v1.Receipt.block_hash":
 		return x.BlockHash != ""
-	case "ethermint.evm.v1.Log.index":
-		return x.Index != uint64(0)
-	case "ethermint.evm.v1.Log.removed":
-		return x.Removed != false
+	case "ethermint.evm.v1.Receipt.cumulative_gas_used":
+		return x.CumulativeGasUsed != uint64(0)
+	case "ethermint.evm.v1.Receipt.logs_bloom":
+		return len(x.LogsBloom) != 0
+	case "ethermint.evm.v1.Receipt.tx_type":
+		return x.TxType != uint32(0)
+	case "ethermint.evm.v1.Receipt.effective_gas_price":
+		return x.EffectiveGasPrice != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Receipt"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.Receipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4938,31 +8021,43 @@ func (x *fastReflection_Log) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Log) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Receipt) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.Log.address":
-		x.Address = ""
-	case "ethermint.evm.v1.Log.topics":
-		x.Topics = nil
-	case "ethermint.evm.v1.Log.data":
-		x.Data = nil
-	case "ethermint.evm.v1.Log.block_number":
-		x.BlockNumber = uint64(0)
-	case "ethermint.evm.v1.Log.tx_hash":
+	case "ethermint.evm.v1.Receipt.tx_hash":
 		x.TxHash = ""
-	case "ethermint.evm.v1.Log.tx_index":
+	case "ethermint.evm.v1.Receipt.tx_index":
 		x.TxIndex = uint64(0)
-	case "ethermint.evm.v1.Log.block_hash":
+	case "ethermint.evm.v1.Receipt.from":
+		x.From = ""
+	case "ethermint.evm.v1.Receipt.to":
+		x.To = ""
+	case "ethermint.evm.v1.Receipt.contract_address":
+		x.ContractAddress = ""
+	case "ethermint.evm.v1.Receipt.gas_used":
+		x.GasUsed = uint64(0)
+	case "ethermint.evm.v1.Receipt.status":
+		x.Status = uint64(0)
+	case "ethermint.evm.v1.Receipt.vm_error":
+		x.VmError = ""
+	case "ethermint.evm.v1.Receipt.logs":
+		x.Logs = nil
+	case "ethermint.evm.v1.Receipt.block_number":
+		x.BlockNumber = uint64(0)
+	case "ethermint.evm.v1.Receipt.block_hash":
 		x.BlockHash = ""
-	case "ethermint.evm.v1.Log.index":
-		x.Index = uint64(0)
-	case "ethermint.evm.v1.Log.removed":
-		x.Removed = false
+	case "ethermint.evm.v1.Receipt.cumulative_gas_used":
+		x.CumulativeGasUsed = uint64(0)
+	case "ethermint.evm.v1.Receipt.logs_bloom":
+		x.LogsBloom = nil
+	case "ethermint.evm.v1.Receipt.tx_type":
+		x.TxType = uint32(0)
+	case "ethermint.evm.v1.Receipt.effective_gas_price":
+		x.EffectiveGasPrice = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Receipt"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.Receipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4972,43 +8067,61 @@ func (x *fastReflection_Log) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Log) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Receipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ethermint.evm.v1.Log.address":
-		value := x.Address
+	case "ethermint.evm.v1.Receipt.tx_hash":
+		value := x.TxHash
 		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.Log.topics":
-		if len(x.Topics) == 0 {
-			return protoreflect.ValueOfList(&_Log_2_list{})
+	case "ethermint.evm.v1.Receipt.tx_index":
+		value := x.TxIndex
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.evm.v1.Receipt.from":
+		value := x.From
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.Receipt.to":
+		value := x.To
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.Receipt.contract_address":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.Receipt.gas_used":
+		value := x.GasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.evm.v1.Receipt.status":
+		value := x.Status
+		return protoreflect.ValueOfUint64(value)
+	case "ethermint.evm.v1.Receipt.vm_error":
+		value := x.VmError
+		return protoreflect.ValueOfString(value)
+	case "ethermint.evm.v1.Receipt.logs":
+		if len(x.Logs) == 0 {
+			return protoreflect.ValueOfList(&_Receipt_9_list{})
 		}
-		listValue := &_Log_2_list{list: &x.Topics}
+		listValue := &_Receipt_9_list{list: &x.Logs}
 		return protoreflect.ValueOfList(listValue)
-	case "ethermint.evm.v1.Log.data":
-		value := x.Data
-		return protoreflect.ValueOfBytes(value)
-	case "ethermint.evm.v1.Log.block_number":
+	case "ethermint.evm.v1.Receipt.block_number":
 		value := x.BlockNumber
 		return protoreflect.ValueOfUint64(value)
-	case "ethermint.evm.v1.Log.tx_hash":
-		value := x.TxHash
-		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.Log.tx_index":
-		value := x.TxIndex
-		return protoreflect.ValueOfUint64(value)
-	case "ethermint.evm.v1.Log.block_hash":
+	case "ethermint.evm.v1.Receipt.block_hash":
 		value := x.BlockHash
 		return protoreflect.ValueOfString(value)
-	case "ethermint.evm.v1.Log.index":
-		value := x.Index
+	case "ethermint.evm.v1.Receipt.cumulative_gas_used":
+		value := x.CumulativeGasUsed
 		return protoreflect.ValueOfUint64(value)
-	case "ethermint.evm.v1.Log.removed":
-		value := x.Removed
-		return protoreflect.ValueOfBool(value)
+	case "ethermint.evm.v1.Receipt.logs_bloom":
+		value := x.LogsBloom
+		return protoreflect.ValueOfBytes(value)
+	case "ethermint.evm.v1.Receipt.tx_type":
+		value := x.TxType
+		return protoreflect.ValueOfUint32(value)
+	case "ethermint.evm.v1.Receipt.effective_gas_price":
+		value := x.EffectiveGasPrice
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Receipt"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.Log does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.Receipt does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5022,33 +8135,45 @@ func (x *fastReflection_Log) Get(descriptor protoreflect.FieldDescriptor) protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Log) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Receipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.Log.address":
-		x.Address = value.Interface().(string)
-	case "ethermint.evm.v1.Log.topics":
-		lv := value.List()
-		clv := lv.(*_Log_2_list)
-		x.Topics = *clv.list
-	case "ethermint.evm.v1.Log.data":
-		x.Data = value.Bytes()
-	case "ethermint.evm.v1.Log.block_number":
-		x.BlockNumber = value.Uint()
-	case "ethermint.evm.v1.Log.tx_hash":
+	case "ethermint.evm.v1.Receipt.tx_hash":
 		x.TxHash = value.Interface().(string)
-	case "ethermint.evm.v1.Log.tx_index":
+	case "ethermint.evm.v1.Receipt.tx_index":
 		x.TxIndex = value.Uint()
-	case "ethermint.evm.v1.Log.block_hash":
+	case "ethermint.evm.v1.Receipt.from":
+		x.From = value.Interface().(string)
+	case "ethermint.evm.v1.Receipt.to":
+		x.To = value.Interface().(string)
+	case "ethermint.evm.v1.Receipt.contract_address":
+		x.ContractAddress = value.Interface().(string)
+	case "ethermint.evm.v1.Receipt.gas_used":
+		x.GasUsed = value.Uint()
+	case "ethermint.evm.v1.Receipt.status":
+		x.Status = value.Uint()
+	case "ethermint.evm.v1.Receipt.vm_error":
+		x.VmError = value.Interface().(string)
+	case "ethermint.evm.v1.Receipt.logs":
+		lv := value.List()
+		clv := lv.(*_Receipt_9_list)
+		x.Logs = *clv.list
+	case "ethermint.evm.v1.Receipt.block_number":
+		x.BlockNumber = value.Uint()
+	case "ethermint.evm.v1.Receipt.block_hash":
 		x.BlockHash = value.Interface().(string)
-	case "ethermint.evm.v1.Log.index":
-		x.Index = value.Uint()
-	case "ethermint.evm.v1.Log.removed":
-		x.Removed = value.Bool()
+	case "ethermint.evm.v1.Receipt.cumulative_gas_used":
+		x.CumulativeGasUsed = value.Uint()
+	case "ethermint.evm.v1.Receipt.logs_bloom":
+		x.LogsBloom = value.Bytes()
+	case "ethermint.evm.v1.Receipt.tx_type":
+		x.TxType = uint32(value.Uint())
+	case "ethermint.evm.v1.Receipt.effective_gas_price":
+		x.EffectiveGasPrice = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Receipt"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.Receipt does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5062,77 +8187,101 @@ func (x *fastReflection_Log) Set(fd protoreflect.FieldDescriptor, value protoref
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Log) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Receipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.Log.topics":
-		if x.Topics == nil {
-			x.Topics = []string{}
+	case "ethermint.evm.v1.Receipt.logs":
+		if x.Logs == nil {
+			x.Logs = []*Log{}
 		}
-		value := &_Log_2_list{list: &x.Topics}
+		value := &_Receipt_9_list{list: &x.Logs}
 		return protoreflect.ValueOfList(value)
-	case "ethermint.evm.v1.Log.address":
-		panic(fmt.Errorf("field address of message ethermint.evm.v1.Log is not mutable"))
-	case "ethermint.evm.v1.Log.data":
-		panic(fmt.Errorf("field data of message ethermint.evm.v1.Log is not mutable"))
-	case "ethermint.evm.v1.Log.block_number":
-		panic(fmt.Errorf("field block_number of message ethermint.evm.v1.Log is not mutable"))
-	case "ethermint.evm.v1.Log.tx_hash":
-		panic(fmt.Errorf("field tx_hash of message ethermint.evm.v1.Log is not mutable"))
-	case "ethermint.evm.v1.Log.tx_index":
-		panic(fmt.Errorf("field tx_index of message ethermint.evm.v1.Log is not mutable"))
-	case "ethermint.evm.v1.Log.block_hash":
-		panic(fmt.Errorf("field block_hash of message ethermint.evm.v1.Log is not mutable"))
-	case "ethermint.evm.v1.Log.index":
-		panic(fmt.Errorf("field index of message ethermint.evm.v1.Log is not mutable"))
-	case "ethermint.evm.v1.Log.removed":
-		panic(fmt.Errorf("field removed of message ethermint.evm.v1.Log is not mutable"))
+	case "ethermint.evm.v1.Receipt.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message ethermint.evm.v1.Receipt is not mutable"))
+	case "ethermint.evm.v1.Receipt.tx_index":
+		panic(fmt.Errorf("field tx_index of message ethermint.evm.v1.Receipt is not mutable"))
+	case "ethermint.evm.v1.Receipt.from":
+		panic(fmt.Errorf("field from of message ethermint.evm.v1.Receipt is not mutable"))
+	case "ethermint.evm.v1.Receipt.to":
+		panic(fmt.Errorf("field to of message ethermint.evm.v1.Receipt is not mutable"))
+	case "ethermint.evm.v1.Receipt.contract_address":
+		panic(fmt.Errorf("field contract_address of message ethermint.evm.v1.Receipt is not mutable"))
+	case "ethermint.evm.v1.Receipt.gas_used":
+		panic(fmt.Errorf("field gas_used of message ethermint.evm.v1.Receipt is not mutable"))
+	case "ethermint.evm.v1.Receipt.status":
+		panic(fmt.Errorf("field status of message ethermint.evm.v1.Receipt is not mutable"))
+	case "ethermint.evm.v1.Receipt.vm_error":
+		panic(fmt.Errorf("field vm_error of message ethermint.evm.v1.Receipt is not mutable"))
+	case "ethermint.evm.v1.Receipt.block_number":
+		panic(fmt.Errorf("field block_number of message ethermint.evm.v1.Receipt is not mutable"))
+	case "ethermint.evm.v1.Receipt.block_hash":
+		panic(fmt.Errorf("field block_hash of message ethermint.evm.v1.Receipt is not mutable"))
+	case "ethermint.evm.v1.Receipt.cumulative_gas_used":
+		panic(fmt.Errorf("field cumulative_gas_used of message ethermint.evm.v1.Receipt is not mutable"))
+	case "ethermint.evm.v1.Receipt.logs_bloom":
+		panic(fmt.Errorf("field logs_bloom of message ethermint.evm.v1.Receipt is not mutable"))
+	case "ethermint.evm.v1.Receipt.tx_type":
+		panic(fmt.Errorf("field tx_type of message ethermint.evm.v1.Receipt is not mutable"))
+	case "ethermint.evm.v1.Receipt.effective_gas_price":
+		panic(fmt.Errorf("field effective_gas_price of message ethermint.evm.v1.Receipt is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Receipt"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.Receipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Log) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Receipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ethermint.evm.v1.Log.address":
+	case "ethermint.evm.v1.Receipt.tx_hash":
 		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.Log.topics":
-		list := []string{}
-		return protoreflect.ValueOfList(&_Log_2_list{list: &list})
-	case "ethermint.evm.v1.Log.data":
-		return protoreflect.ValueOfBytes(nil)
-	case "ethermint.evm.v1.Log.block_number":
+	case "ethermint.evm.v1.Receipt.tx_index":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "ethermint.evm.v1.Log.tx_hash":
+	case "ethermint.evm.v1.Receipt.from":
 		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.Log.tx_index":
+	case "ethermint.evm.v1.Receipt.to":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.Receipt.contract_address":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.Receipt.gas_used":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "ethermint.evm.v1.Log.block_hash":
+	case "ethermint.evm.v1.Receipt.status":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.evm.v1.Receipt.vm_error":
 		return protoreflect.ValueOfString("")
-	case "ethermint.evm.v1.Log.index":
+	case "ethermint.evm.v1.Receipt.logs":
+		list := []*Log{}
+		return protoreflect.ValueOfList(&_Receipt_9_list{list: &list})
+	case "ethermint.evm.v1.Receipt.block_number":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "ethermint.evm.v1.Log.removed":
-		return protoreflect.ValueOfBool(false)
+	case "ethermint.evm.v1.Receipt.block_hash":
+		return protoreflect.ValueOfString("")
+	case "ethermint.evm.v1.Receipt.cumulative_gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ethermint.evm.v1.Receipt.logs_bloom":
+		return protoreflect.ValueOfBytes(nil)
+	case "ethermint.evm.v1.Receipt.tx_type":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ethermint.evm.v1.Receipt.effective_gas_price":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ethermint.evm.v1.Receipt"))
 		}
-		panic(fmt.Errorf("message ethermint.evm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ethermint.evm.v1.Receipt does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Log) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Receipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.Log", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ethermint.evm.v1.Receipt", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5140,7 +8289,7 @@ func (x *fastReflection_Log) WhichOneof(d protoreflect.OneofDescriptor) protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Log) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Receipt) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5151,7 +8300,7 @@ func (x *fastReflection_Log) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Log) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Receipt) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5163,7 +8312,7 @@ func (x *fastReflection_Log) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Log) IsValid() bool {
+func (x *fastReflection_Receipt) IsValid() bool {
 	return x != nil
 }
 
@@ -5173,53 +8322,75 @@ func (x *fastReflection_Log) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Receipt) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Log)
+		x := input.Message.Interface().(*Receipt)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 				Size:              0,
 			}
 		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.Address)
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TxIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxIndex))
+		}
+		l = len(x.From)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.To)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Topics) > 0 {
-			for _, s := range x.Topics {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.GasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasUsed))
 		}
-		l = len(x.Data)
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.VmError)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Logs) > 0 {
+			for _, e := range x.Logs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.BlockNumber != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockNumber))
 		}
-		l = len(x.TxHash)
+		l = len(x.BlockHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.TxIndex != 0 {
-			n += 1 + runtime.Sov(uint64(x.TxIndex))
+		if x.CumulativeGasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.CumulativeGasUsed))
 		}
-		l = len(x.BlockHash)
+		l = len(x.LogsBloom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Index != 0 {
-			n += 1 + runtime.Sov(uint64(x.Index))
+		if x.TxType != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxType))
 		}
-		if x.Removed {
-			n += 2
+		l = len(x.EffectiveGasPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5231,7 +8402,7 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Log)
+		x := input.Message.Interface().(*Receipt)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5250,65 +8421,105 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Removed {
+		if len(x.EffectiveGasPrice) > 0 {
+			i -= len(x.EffectiveGasPrice)
+			copy(dAtA[i:], x.EffectiveGasPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EffectiveGasPrice)))
 			i--
-			if x.Removed {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+			dAtA[i] = 0x7a
+		}
+		if x.TxType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxType))
 			i--
-			dAtA[i] = 0x48
+			dAtA[i] = 0x70
 		}
-		if x.Index != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+		if len(x.LogsBloom) > 0 {
+			i -= len(x.LogsBloom)
+			copy(dAtA[i:], x.LogsBloom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LogsBloom)))
 			i--
-			dAtA[i] = 0x40
+			dAtA[i] = 0x6a
+		}
+		if x.CumulativeGasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CumulativeGasUsed))
+			i--
+			dAtA[i] = 0x60
 		}
 		if len(x.BlockHash) > 0 {
 			i -= len(x.BlockHash)
 			copy(dAtA[i:], x.BlockHash)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x5a
 		}
-		if x.TxIndex != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxIndex))
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.Logs) > 0 {
+			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Logs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.VmError) > 0 {
+			i -= len(x.VmError)
+			copy(dAtA[i:], x.VmError)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VmError)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.GasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
 			i--
 			dAtA[i] = 0x30
 		}
-		if len(x.TxHash) > 0 {
-			i -= len(x.TxHash)
-			copy(dAtA[i:], x.TxHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
 			i--
 			dAtA[i] = 0x2a
 		}
-		if x.BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+		if len(x.To) > 0 {
+			i -= len(x.To)
+			copy(dAtA[i:], x.To)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.To)))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x22
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		if len(x.From) > 0 {
+			i -= len(x.From)
+			copy(dAtA[i:], x.From)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.From)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Topics) > 0 {
-			for iNdEx := len(x.Topics) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Topics[iNdEx])
-				copy(dAtA[i:], x.Topics[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Topics[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
+		if x.TxIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxIndex))
+			i--
+			dAtA[i] = 0x10
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5323,7 +8534,7 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Log)
+		x := input.Message.Interface().(*Receipt)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5355,17 +8566,164 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Log: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Receipt: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Receipt: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				}
+				x.TxIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TxIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.From = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.To = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				var stringLen uint64
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				}
+				x.GasUsed = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5375,27 +8733,33 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.GasUsed |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
+			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5423,13 +8787,13 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Topics = append(x.Topics, string(dAtA[iNdEx:postIndex]))
+				x.VmError = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5439,27 +8803,27 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
+				x.Logs = append(x.Logs, &Log{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 10:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 				}
@@ -5478,9 +8842,9 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 5:
+			case 11:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5508,13 +8872,13 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TxHash = string(dAtA[iNdEx:postIndex])
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
+			case 12:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CumulativeGasUsed", wireType)
 				}
-				x.TxIndex = 0
+				x.CumulativeGasUsed = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5524,16 +8888,16 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.TxIndex |= uint64(b&0x7F) << shift
+					x.CumulativeGasUsed |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 7:
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LogsBloom", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5543,29 +8907,31 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				x.LogsBloom = append(x.LogsBloom[:0], dAtA[iNdEx:postIndex]...)
+				if x.LogsBloom == nil {
+					x.LogsBloom = []byte{}
+				}
 				iNdEx = postIndex
-			case 8:
+			case 14:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
 				}
-				x.Index = 0
+				x.TxType = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5575,16 +8941,16 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Index |= uint64(b&0x7F) << shift
+					x.TxType |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveGasPrice", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5594,12 +8960,24 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.Removed = bool(v != 0)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EffectiveGasPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5665,7 +9043,7 @@ func (x *TxResult) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TxResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[7]
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6436,7 +9814,7 @@ func (x *AccessTuple) ProtoReflect() protoreflect.Message {
 }
 
 func (x *AccessTuple) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[8]
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6952,7 +10330,7 @@ func (x *TraceConfig) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TraceConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[9]
+	mi := &file_ethermint_evm_v1_evm_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7974,79 +11352,297 @@ func (AccessType) EnumDescriptor() ([]byte, []int) {
 	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{0}
 }
 
-// Params defines the EVM module parameters
-type Params struct {
+// Params defines the EVM module parameters
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// extra_eips defines the additional EIPs for the vm.Config
+	ExtraEips []string `protobuf:"bytes,4,rep,name=extra_eips,json=extraEips,proto3" json:"extra_eips,omitempty"`
+	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
+	// signed) transactions can be executed on the state machine.
+	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// evm_channels is the list of channel identifiers from EVM compatible chains
+	EvmChannels []string `protobuf:"bytes,8,rep,name=evm_channels,json=evmChannels,proto3" json:"evm_channels,omitempty"`
+	// access_control defines the permission policy of the EVM
+	AccessControl *AccessControl `protobuf:"bytes,9,opt,name=access_control,json=accessControl,proto3" json:"access_control,omitempty"`
+	// active_static_precompiles defines the slice of hex addresses of the precompiled
+	// contracts that are active
+	ActiveStaticPrecompiles []string `protobuf:"bytes,10,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// precompile_dynamic_gas_height defines the block height from which the ERC-20
+	// precompiles charge gas based on the executed store operations instead of the
+	// static gas schedule. A value of zero keeps the static gas schedule, so that
+	// historical blocks are executed with their original costs.
+	PrecompileDynamicGasHeight int64 `protobuf:"varint,11,opt,name=precompile_dynamic_gas_height,json=precompileDynamicGasHeight,proto3" json:"precompile_dynamic_gas_height,omitempty"`
+	// scheduled_eips defines the additional EIPs for the vm.Config that are
+	// enabled from a given block height
+	ScheduledEips []*ScheduledEIP `protobuf:"bytes,12,rep,name=scheduled_eips,json=scheduledEips,proto3" json:"scheduled_eips,omitempty"`
+	// bech32_hrps defines the registry of bech32 human readable prefixes (HRPs)
+	// of the Cosmos chains that contracts translate addresses for through the
+	// bech32 precompile
+	Bech32Hrps []string `protobuf:"bytes,13,rep,name=bech32_hrps,json=bech32Hrps,proto3" json:"bech32_hrps,omitempty"`
+	// blocked_contracts defines the hex addresses of the contracts whose calls
+	// are reverted, to contain the contracts that are actively exploited
+	BlockedContracts []string `protobuf:"bytes,14,rep,name=blocked_contracts,json=blockedContracts,proto3" json:"blocked_contracts,omitempty"`
+	// security_council defines the bech32 address of the account that can block
+	// and unblock contracts in addition to the governance module. An empty value
+	// leaves the blocked contracts to the governance only.
+	SecurityCouncil string `protobuf:"bytes,15,opt,name=security_council,json=securityCouncil,proto3" json:"security_council,omitempty"`
+	// osmosis_outpost defines the configuration of the Osmosis outpost
+	// precompile. The outpost rejects the swaps while its channel is empty.
+	OsmosisOutpost *OsmosisOutpost `protobuf:"bytes,16,opt,name=osmosis_outpost,json=osmosisOutpost,proto3" json:"osmosis_outpost,omitempty"`
+	// stride_outpost defines the configuration of the Stride outpost precompile.
+	// The outpost rejects the liquid stakes and redeems while its channel is
+	// empty.
+	StrideOutpost *StrideOutpost `protobuf:"bytes,17,opt,name=stride_outpost,json=strideOutpost,proto3" json:"stride_outpost,omitempty"`
+	// receipt_retention_blocks defines the number of blocks the receipts of the
+	// ethereum txs are kept in the state. The receipts are not stored when it's
+	// zero.
+	ReceiptRetentionBlocks uint64 `protobuf:"varint,18,opt,name=receipt_retention_blocks,json=receiptRetentionBlocks,proto3" json:"receipt_retention_blocks,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetExtraEips() []string {
+	if x != nil {
+		return x.ExtraEips
+	}
+	return nil
+}
+
+func (x *Params) GetAllowUnprotectedTxs() bool {
+	if x != nil {
+		return x.AllowUnprotectedTxs
+	}
+	return false
+}
+
+func (x *Params) GetEvmChannels() []string {
+	if x != nil {
+		return x.EvmChannels
+	}
+	return nil
+}
+
+func (x *Params) GetAccessControl() *AccessControl {
+	if x != nil {
+		return x.AccessControl
+	}
+	return nil
+}
+
+func (x *Params) GetActiveStaticPrecompiles() []string {
+	if x != nil {
+		return x.ActiveStaticPrecompiles
+	}
+	return nil
+}
+
+func (x *Params) GetPrecompileDynamicGasHeight() int64 {
+	if x != nil {
+		return x.PrecompileDynamicGasHeight
+	}
+	return 0
+}
+
+func (x *Params) GetScheduledEips() []*ScheduledEIP {
+	if x != nil {
+		return x.ScheduledEips
+	}
+	return nil
+}
+
+func (x *Params) GetBech32Hrps() []string {
+	if x != nil {
+		return x.Bech32Hrps
+	}
+	return nil
+}
+
+func (x *Params) GetBlockedContracts() []string {
+	if x != nil {
+		return x.BlockedContracts
+	}
+	return nil
+}
+
+func (x *Params) GetSecurityCouncil() string {
+	if x != nil {
+		return x.SecurityCouncil
+	}
+	return ""
+}
+
+func (x *Params) GetOsmosisOutpost() *OsmosisOutpost {
+	if x != nil {
+		return x.OsmosisOutpost
+	}
+	return nil
+}
+
+func (x *Params) GetStrideOutpost() *StrideOutpost {
+	if x != nil {
+		return x.StrideOutpost
+	}
+	return nil
+}
+
+func (x *Params) GetReceiptRetentionBlocks() uint64 {
+	if x != nil {
+		return x.ReceiptRetentionBlocks
+	}
+	return 0
+}
+
+// OsmosisOutpost defines the channel to Osmosis and the cross-chain swaps
+// contract that the Osmosis outpost precompile sends the swaps to
+type OsmosisOutpost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel_id defines the identifier of the transfer channel to Osmosis
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// xcs_contract defines the bech32 address of the cross-chain swaps (XCS)
+	// contract on Osmosis
+	XcsContract string `protobuf:"bytes,2,opt,name=xcs_contract,json=xcsContract,proto3" json:"xcs_contract,omitempty"`
+}
+
+func (x *OsmosisOutpost) Reset() {
+	*x = OsmosisOutpost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OsmosisOutpost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OsmosisOutpost) ProtoMessage() {}
+
+// Deprecated: Use OsmosisOutpost.ProtoReflect.Descriptor instead.
+func (*OsmosisOutpost) Descriptor() ([]byte, []int) {
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OsmosisOutpost) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *OsmosisOutpost) GetXcsContract() string {
+	if x != nil {
+		return x.XcsContract
+	}
+	return ""
+}
+
+// StrideOutpost defines the channel to Stride that the Stride outpost
+// precompile sends the autopilot transfers through
+type StrideOutpost struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// extra_eips defines the additional EIPs for the vm.Config
-	ExtraEips []string `protobuf:"bytes,4,rep,name=extra_eips,json=extraEips,proto3" json:"extra_eips,omitempty"`
-	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
-	// signed) transactions can be executed on the state machine.
-	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
-	// evm_channels is the list of channel identifiers from EVM compatible chains
-	EvmChannels []string `protobuf:"bytes,8,rep,name=evm_channels,json=evmChannels,proto3" json:"evm_channels,omitempty"`
-	// access_control defines the permission policy of the EVM
-	AccessControl *AccessControl `protobuf:"bytes,9,opt,name=access_control,json=accessControl,proto3" json:"access_control,omitempty"`
-	// active_static_precompiles defines the slice of hex addresses of the precompiled
-	// contracts that are active
-	ActiveStaticPrecompiles []string `protobuf:"bytes,10,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// channel_id defines the identifier of the transfer channel to Stride
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *StrideOutpost) Reset() {
+	*x = StrideOutpost{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[0]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *StrideOutpost) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*StrideOutpost) ProtoMessage() {}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use StrideOutpost.ProtoReflect.Descriptor instead.
+func (*StrideOutpost) Descriptor() ([]byte, []int) {
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Params) GetExtraEips() []string {
+func (x *StrideOutpost) GetChannelId() string {
 	if x != nil {
-		return x.ExtraEips
+		return x.ChannelId
 	}
-	return nil
+	return ""
 }
 
-func (x *Params) GetAllowUnprotectedTxs() bool {
-	if x != nil {
-		return x.AllowUnprotectedTxs
-	}
-	return false
+// ScheduledEIP defines an additional EIP that is enabled from a block height
+type ScheduledEIP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// eip defines the name of the EIP, as registered in the vm activators
+	Eip string `protobuf:"bytes,1,opt,name=eip,proto3" json:"eip,omitempty"`
+	// height defines the block height from which the EIP is enabled
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *Params) GetEvmChannels() []string {
-	if x != nil {
-		return x.EvmChannels
+func (x *ScheduledEIP) Reset() {
+	*x = ScheduledEIP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Params) GetAccessControl() *AccessControl {
+func (x *ScheduledEIP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledEIP) ProtoMessage() {}
+
+// Deprecated: Use ScheduledEIP.ProtoReflect.Descriptor instead.
+func (*ScheduledEIP) Descriptor() ([]byte, []int) {
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ScheduledEIP) GetEip() string {
 	if x != nil {
-		return x.AccessControl
+		return x.Eip
 	}
-	return nil
+	return ""
 }
 
-func (x *Params) GetActiveStaticPrecompiles() []string {
+func (x *ScheduledEIP) GetHeight() int64 {
 	if x != nil {
-		return x.ActiveStaticPrecompiles
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
 // AccessControl defines the permission policy of the EVM
@@ -8065,7 +11661,7 @@ type AccessControl struct {
 func (x *AccessControl) Reset() {
 	*x = AccessControl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[1]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8079,7 +11675,7 @@ func (*AccessControl) ProtoMessage() {}
 
 // Deprecated: Use AccessControl.ProtoReflect.Descriptor instead.
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{1}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AccessControl) GetCreate() *AccessControlType {
@@ -8114,7 +11710,7 @@ type AccessControlType struct {
 func (x *AccessControlType) Reset() {
 	*x = AccessControlType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[2]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8128,7 +11724,7 @@ func (*AccessControlType) ProtoMessage() {}
 
 // Deprecated: Use AccessControlType.ProtoReflect.Descriptor instead.
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{2}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AccessControlType) GetAccessType() AccessType {
@@ -8202,7 +11798,7 @@ type ChainConfig struct {
 func (x *ChainConfig) Reset() {
 	*x = ChainConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[3]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8216,7 +11812,7 @@ func (*ChainConfig) ProtoMessage() {}
 
 // Deprecated: Use ChainConfig.ProtoReflect.Descriptor instead.
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{3}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ChainConfig) GetHomesteadBlock() string {
@@ -8388,7 +11984,7 @@ type State struct {
 func (x *State) Reset() {
 	*x = State{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[4]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8402,7 +11998,7 @@ func (*State) ProtoMessage() {}
 
 // Deprecated: Use State.ProtoReflect.Descriptor instead.
 func (*State) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{4}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *State) GetKey() string {
@@ -8436,7 +12032,7 @@ type TransactionLogs struct {
 func (x *TransactionLogs) Reset() {
 	*x = TransactionLogs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[5]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8450,7 +12046,7 @@ func (*TransactionLogs) ProtoMessage() {}
 
 // Deprecated: Use TransactionLogs.ProtoReflect.Descriptor instead.
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{5}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TransactionLogs) GetHash() string {
@@ -8503,7 +12099,7 @@ type Log struct {
 func (x *Log) Reset() {
 	*x = Log{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[6]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8517,7 +12113,7 @@ func (*Log) ProtoMessage() {}
 
 // Deprecated: Use Log.ProtoReflect.Descriptor instead.
 func (*Log) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{6}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Log) GetAddress() string {
@@ -8583,6 +12179,172 @@ func (x *Log) GetRemoved() bool {
 	return false
 }
 
+// Receipt defines the receipt of an ethereum tx included in a block. The
+// receipts of the delivered txs are stored in the module state by tx hash for
+// the receipt retention window of the module params.
+type Receipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tx_hash is the hex encoded ethereum tx hash
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// tx_index of the ethereum tx in the block
+	TxIndex uint64 `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// from is the hex address of the sender
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the hex address of the recipient, empty for contract creations
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// contract_address is the hex address of the created contract, if any
+	ContractAddress string `protobuf:"bytes,5,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// gas_used is the gas consumed by the tx
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// status is 1 if the tx succeeded and 0 if the execution failed
+	Status uint64 `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	// vm_error is the error returned by the EVM, if the execution failed
+	VmError string `protobuf:"bytes,8,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+	// logs emitted by the tx
+	Logs []*Log `protobuf:"bytes,9,rep,name=logs,proto3" json:"logs,omitempty"`
+	// block_number of the block in which the tx was included
+	BlockNumber uint64 `protobuf:"varint,10,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash is the hex encoded hash of the block in which the tx was included
+	BlockHash string `protobuf:"bytes,11,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// cumulative_gas_used is the gas consumed by the ethereum txs of the block up
+	// to this one, included
+	CumulativeGasUsed uint64 `protobuf:"varint,12,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	// logs_bloom is the bloom filter of the logs of the tx
+	LogsBloom []byte `protobuf:"bytes,13,opt,name=logs_bloom,json=logsBloom,proto3" json:"logs_bloom,omitempty"`
+	// tx_type is the type of the ethereum tx
+	TxType uint32 `protobuf:"varint,14,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	// effective_gas_price is the gas price paid by the sender per unit of gas
+	EffectiveGasPrice string `protobuf:"bytes,15,opt,name=effective_gas_price,json=effectiveGasPrice,proto3" json:"effective_gas_price,omitempty"`
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Receipt) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *Receipt) GetTxIndex() uint64 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *Receipt) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Receipt) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Receipt) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *Receipt) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *Receipt) GetStatus() uint64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Receipt) GetVmError() string {
+	if x != nil {
+		return x.VmError
+	}
+	return ""
+}
+
+func (x *Receipt) GetLogs() []*Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *Receipt) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *Receipt) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *Receipt) GetCumulativeGasUsed() uint64 {
+	if x != nil {
+		return x.CumulativeGasUsed
+	}
+	return 0
+}
+
+func (x *Receipt) GetLogsBloom() []byte {
+	if x != nil {
+		return x.LogsBloom
+	}
+	return nil
+}
+
+func (x *Receipt) GetTxType() uint32 {
+	if x != nil {
+		return x.TxType
+	}
+	return 0
+}
+
+func (x *Receipt) GetEffectiveGasPrice() string {
+	if x != nil {
+		return x.EffectiveGasPrice
+	}
+	return ""
+}
+
 // TxResult stores results of Tx execution.
 type TxResult struct {
 	state         protoimpl.MessageState
@@ -8609,7 +12371,7 @@ type TxResult struct {
 func (x *TxResult) Reset() {
 	*x = TxResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[7]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8623,7 +12385,7 @@ func (*TxResult) ProtoMessage() {}
 
 // Deprecated: Use TxResult.ProtoReflect.Descriptor instead.
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{7}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TxResult) GetContractAddress() string {
@@ -8683,7 +12445,7 @@ type AccessTuple struct {
 func (x *AccessTuple) Reset() {
 	*x = AccessTuple{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[8]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8697,7 +12459,7 @@ func (*AccessTuple) ProtoMessage() {}
 
 // Deprecated: Use AccessTuple.ProtoReflect.Descriptor instead.
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{8}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AccessTuple) GetAddress() string {
@@ -8748,7 +12510,7 @@ type TraceConfig struct {
 func (x *TraceConfig) Reset() {
 	*x = TraceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[9]
+		mi := &file_ethermint_evm_v1_evm_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8762,7 +12524,7 @@ func (*TraceConfig) ProtoMessage() {}
 
 // Deprecated: Use TraceConfig.ProtoReflect.Descriptor instead.
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{9}
+	return file_ethermint_evm_v1_evm_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TraceConfig) GetTracer() string {
@@ -8850,7 +12612,7 @@ var file_ethermint_evm_v1_evm_proto_rawDesc = []byte{
 	0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x11,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x07, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x65, 0x69, 0x70, 0x73,
 	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x22, 0xe2, 0xde, 0x1f, 0x09, 0x45, 0x78, 0x74, 0x72,
 	0x61, 0x45, 0x49, 0x50, 0x73, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65,
@@ -8871,270 +12633,350 @@ var file_ethermint_evm_v1_evm_proto_rawDesc = []byte{
 	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x70, 0x72,
 	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
 	0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x50, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x3a, 0x17, 0x8a, 0xe7, 0xb0, 0x2a, 0x12, 0x65,
-	0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x78, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08,
-	0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52,
-	0x09, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x41, 0x0a, 0x06, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x74, 0x68,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a,
-	0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x74,
-	0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x22, 0xdd, 0x01, 0x0a,
-	0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x63, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x79, 0x70, 0x65, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x79, 0x70, 0x65, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0a, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x63, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x42, 0x33, 0xe2, 0xde, 0x1f, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0xf2, 0xde, 0x1f, 0x1a, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xca, 0x0f, 0x0a,
-	0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5c, 0x0a, 0x0f,
-	0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
-	0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74,
-	0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0e, 0x68, 0x6f, 0x6d, 0x65,
-	0x73, 0x74, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x68, 0x0a, 0x0e, 0x64, 0x61,
-	0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x42, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f,
-	0x0c, 0x44, 0x41, 0x4f, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f,
-	0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0c, 0x64, 0x61, 0x6f, 0x46, 0x6f, 0x72, 0x6b, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x57, 0x0a, 0x10, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b,
-	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x2d,
-	0xe2, 0xde, 0x1f, 0x0e, 0x44, 0x41, 0x4f, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x75, 0x70, 0x70, 0x6f,
-	0x72, 0x74, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x61, 0x6f, 0x5f,
-	0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x52, 0x0e, 0x64,
-	0x61, 0x6f, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x62, 0x0a,
-	0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde,
-	0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x30, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f,
-	0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x49, 0x0a, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x49, 0x50, 0x31,
-	0x35, 0x30, 0x48, 0x61, 0x73, 0x68, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
-	0x52, 0x0a, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x48, 0x61, 0x73, 0x68, 0x12, 0x62, 0x0a, 0x0c,
-	0x65, 0x69, 0x70, 0x31, 0x35, 0x35, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f,
-	0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x35, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70, 0x31, 0x35, 0x35, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x35, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x1d, 0x70, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x5f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x67,
+	0x61, 0x73, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x1a, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x44, 0x79, 0x6e, 0x61, 0x6d,
+	0x69, 0x63, 0x47, 0x61, 0x73, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x5c, 0x0a, 0x0e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x65, 0x69, 0x70, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x45, 0x49, 0x50, 0x42, 0x15, 0xc8, 0xde, 0x1f, 0x00, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x49, 0x50, 0x73, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x69, 0x70, 0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x62, 0x65, 0x63,
+	0x68, 0x33, 0x32, 0x5f, 0x68, 0x72, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0e,
+	0xe2, 0xde, 0x1f, 0x0a, 0x42, 0x65, 0x63, 0x68, 0x33, 0x32, 0x48, 0x52, 0x50, 0x73, 0x52, 0x0a,
+	0x62, 0x65, 0x63, 0x68, 0x33, 0x32, 0x48, 0x72, 0x70, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x63, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x63,
+	0x69, 0x6c, 0x12, 0x4f, 0x0a, 0x0f, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x6f, 0x75,
+	0x74, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x73, 0x6d, 0x6f, 0x73, 0x69, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x73, 0x74, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0e, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x69, 0x73, 0x4f, 0x75, 0x74, 0x70,
+	0x6f, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x6f, 0x75,
+	0x74, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x73, 0x74, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x73,
+	0x74, 0x12, 0x38, 0x0a, 0x18, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x16, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x3a, 0x17, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x12, 0x65, 0x76, 0x6d, 0x6f, 0x73, 0x2f, 0x78, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
+	0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x07,
+	0x10, 0x08, 0x52, 0x09, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x0c, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x72, 0x0a, 0x0e, 0x4f,
+	0x73, 0x6d, 0x6f, 0x73, 0x69, 0x73, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x2c, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0c, 0x78,
+	0x63, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0f, 0xe2, 0xde, 0x1f, 0x0b, 0x58, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x52, 0x0b, 0x78, 0x63, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22,
+	0x3d, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x69, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xe2, 0xde, 0x1f, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x44, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x41,
+	0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x49, 0x50, 0x12, 0x19,
+	0x0a, 0x03, 0x65, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xe2, 0xde, 0x1f,
+	0x03, 0x45, 0x49, 0x50, 0x52, 0x03, 0x65, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x12, 0x41, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x04, 0x63, 0x61, 0x6c, 0x6c, 0x22, 0xdd, 0x01, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x63, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x42, 0x24,
+	0xe2, 0xde, 0x1f, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0xf2, 0xde,
+	0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x63, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x33, 0xe2,
+	0xde, 0x1f, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x4c, 0x69, 0x73, 0x74, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x22, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xca, 0x0f, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5c, 0x0a, 0x0f, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65,
+	0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x52, 0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x68, 0x0a, 0x0e, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x41, 0x4f, 0x46, 0x6f, 0x72,
+	0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
+	0x0c, 0x64, 0x61, 0x6f, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x57, 0x0a,
+	0x10, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x2d, 0xe2, 0xde, 0x1f, 0x0e, 0x44, 0x41, 0x4f,
+	0x46, 0x6f, 0x72, 0x6b, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0xf2, 0xde, 0x1f, 0x17, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x52, 0x0e, 0x64, 0x61, 0x6f, 0x46, 0x6f, 0x72, 0x6b, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35,
+	0x30, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65,
+	0x69, 0x70, 0x31, 0x35, 0x30, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x49, 0x0a, 0x0b, 0x65, 0x69,
+	0x70, 0x31, 0x35, 0x30, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x28, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x49, 0x50, 0x31, 0x35, 0x30, 0x48, 0x61, 0x73, 0x68, 0xf2,
+	0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69,
+	0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0a, 0x65, 0x69, 0x70, 0x31, 0x35,
+	0x30, 0x48, 0x61, 0x73, 0x68, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x35, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x35,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65,
+	0x69, 0x70, 0x31, 0x35, 0x35, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69,
+	0x70, 0x31, 0x35, 0x35, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70,
+	0x31, 0x35, 0x38, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49,
+	0x50, 0x31, 0x35, 0x38, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x52, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5c, 0x0a,
+	0x0f, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
-	0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x38, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5c, 0x0a, 0x0f, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75,
-	0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xda,
-	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x22, 0x52, 0x0e, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x6b, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e,
-	0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x38, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1b, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f,
-	0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x5f, 0x0a, 0x10, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72, 0x67, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x74, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x79, 0x7a, 0x61, 0x6e,
+	0x74, 0x69, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0e, 0x62, 0x79, 0x7a,
+	0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x6b, 0x0a, 0x14, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xda, 0xde, 0x1f, 0x15, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x65,
-	0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
-	0x0f, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x12, 0x59, 0x0a, 0x0e, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x73, 0x74,
-	0x61, 0x6e, 0x62, 0x75, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0d, 0x69, 0x73,
-	0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x12, 0x6d,
-	0x75, 0x69, 0x72, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
-	0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x75, 0x69, 0x72,
-	0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
-	0x10, 0x6d, 0x75, 0x69, 0x72, 0x47, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
-	0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x65, 0x72, 0x6c,
-	0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x62, 0x65, 0x72, 0x6c, 0x69,
-	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xda, 0xde,
-	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b,
-	0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x67, 0x0a, 0x13, 0x61,
-	0x72, 0x72, 0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x72, 0x72,
-	0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x22, 0x52, 0x11, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x47, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x12, 0x67, 0x72, 0x61, 0x79, 0x5f, 0x67, 0x6c, 0x61,
-	0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
+	0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f,
+	0x70, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5f, 0x0a, 0x10, 0x70, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x62, 0x75, 0x72, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x34, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f,
+	0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72,
+	0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0f, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x62, 0x75, 0x72, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x59, 0x0a, 0x0e, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x62, 0x75, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x32, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x15,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0d, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x12, 0x6d, 0x75, 0x69, 0x72, 0x5f, 0x67, 0x6c, 0x61,
+	0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x36, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
 	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x19, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x67, 0x72, 0x61, 0x79, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65,
-	0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x10, 0x67, 0x72, 0x61, 0x79, 0x47, 0x6c,
-	0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x6a, 0x0a, 0x14, 0x6d, 0x65,
-	0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x72,
-	0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x22, 0x52, 0x12, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x4e, 0x65, 0x74, 0x73, 0x70, 0x6c, 0x69,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x59, 0x0a, 0x0e, 0x73, 0x68, 0x61, 0x6e, 0x67, 0x68,
-	0x61, 0x69, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32,
-	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x22, 0x52, 0x0d, 0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x75, 0x69, 0x72, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65,
+	0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x10, 0x6d, 0x75, 0x69, 0x72, 0x47, 0x6c,
+	0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x62, 0x65,
+	0x72, 0x6c, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x30, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x52, 0x0b, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x53, 0x0a, 0x0c, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x67, 0x0a, 0x13, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x67, 0x6c,
+	0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x37, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1a,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61, 0x63,
+	0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x11, 0x61, 0x72, 0x72, 0x6f,
+	0x77, 0x47, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x64, 0x0a,
+	0x12, 0x67, 0x72, 0x61, 0x79, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x67, 0x72,
+	0x61, 0x79, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x22, 0x52, 0x10, 0x67, 0x72, 0x61, 0x79, 0x47, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x6a, 0x0a, 0x14, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x38, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1b,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x12, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x4e, 0x65, 0x74, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x59, 0x0a, 0x0e, 0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
-	0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x61, 0x6e, 0x63,
-	0x75, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x75,
-	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d,
-	0x61, 0x6c, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d,
-	0x61, 0x6c, 0x73, 0x4a, 0x04, 0x08, 0x0e, 0x10, 0x0f, 0x4a, 0x04, 0x08, 0x0f, 0x10, 0x10, 0x4a,
-	0x04, 0x08, 0x10, 0x10, 0x11, 0x4a, 0x04, 0x08, 0x13, 0x10, 0x14, 0x52, 0x0d, 0x79, 0x6f, 0x6c,
-	0x6f, 0x5f, 0x76, 0x33, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0b, 0x65, 0x77, 0x61, 0x73,
-	0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0e, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x79, 0x73,
-	0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x10, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x66,
-	0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x2f, 0x0a, 0x05, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x50, 0x0a, 0x0f, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
-	0x68, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xca, 0x02, 0x0a,
-	0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x0c, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c,
-	0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x13, 0xea, 0xde, 0x1f, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x08,
-	0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14,
-	0xea, 0xde, 0x1f, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a,
-	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
-	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08,
-	0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x90, 0x02, 0x0a, 0x08, 0x54, 0x78,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62,
-	0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x57, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x1b, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde,
-	0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x22,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x74, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x10, 0x0a,
-	0x03, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67,
-	0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67,
-	0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x61, 0x0a, 0x0b,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xea, 0xde, 0x1f,
-	0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x0b, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22,
-	0xa0, 0x04, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x12, 0x35, 0x0a, 0x0d, 0x64, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61,
-	0x63, 0x6b, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b,
-	0x12, 0x3b, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6f, 0x76, 0x65,
-	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65,
-	0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6f, 0x76, 0x65,
-	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea,
-	0xde, 0x1f, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52,
-	0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x42, 0x0a,
-	0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x5f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xea,
-	0xde, 0x1f, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x10, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x0e, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x2a, 0xc0, 0x01, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x53, 0x53, 0x10,
-	0x00, 0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
-	0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x12,
-	0x34, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
-	0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x18, 0x8a, 0x9d, 0x20,
-	0x14, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x74, 0x72,
-	0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x45,
-	0x44, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
-	0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x1a,
-	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xab, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74,
-	0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x08,
-	0x45, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x74, 0x68,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76,
-	0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45, 0x58, 0xaa, 0x02, 0x10, 0x45, 0x74, 0x68, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x45,
-	0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x1c, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x45, 0x76, 0x6d, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x12, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x68, 0x61, 0x6e,
+	0x67, 0x68, 0x61, 0x69, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0d, 0x73, 0x68, 0x61,
+	0x6e, 0x67, 0x68, 0x61, 0x69, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x63, 0x61,
+	0x6e, 0x63, 0x75, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x30, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x4a, 0x04, 0x08, 0x0e,
+	0x10, 0x0f, 0x4a, 0x04, 0x08, 0x0f, 0x10, 0x10, 0x4a, 0x04, 0x08, 0x10, 0x10, 0x11, 0x4a, 0x04,
+	0x08, 0x13, 0x10, 0x14, 0x52, 0x0d, 0x79, 0x6f, 0x6c, 0x6f, 0x5f, 0x76, 0x33, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x0b, 0x65, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x0e, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x79, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x10, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x2f, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x50, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52,
+	0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xca, 0x02, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xea, 0xde, 0x1f, 0x0f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x74,
+	0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x74,
+	0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x22, 0xfa, 0x03, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2e, 0x0a, 0x13,
+	0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75,
+	0x73, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x63, 0x75, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x6c, 0x6f, 0x67, 0x73, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x78, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x78,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x49, 0x0a, 0x13, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x19, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x11, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22,
+	0x90, 0x02, 0x0a, 0x08, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x10,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x57, 0x0a, 0x07, 0x74, 0x78,
+	0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x1b,
+	0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x78,
+	0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x74, 0x78, 0x4c,
+	0x6f, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x3a, 0x04, 0x88, 0xa0,
+	0x1f, 0x00, 0x22, 0x61, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x75, 0x70, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x3a,
+	0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xa0, 0x04, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65,
+	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x12,
+	0x35, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x12, 0xea, 0xde, 0x1f, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x3b, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x12, 0x42, 0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x14, 0xea, 0xde, 0x1f, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x72, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x4a, 0x73, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08,
+	0x07, 0x10, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x52, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x2a, 0xc0, 0x01, 0x0a, 0x0a, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x41, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x4c, 0x45, 0x53, 0x53, 0x10, 0x00, 0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x6c, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10,
+	0x01, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x41,
+	0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xab, 0x01, 0x0a, 0x14,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x45, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x27, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x65, 0x76, 0x6d,
+	0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45, 0x58, 0xaa,
+	0x02, 0x10, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x76, 0x6d, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x10, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x45,
+	0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x74, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74,
+	0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -9150,33 +12992,41 @@ func file_ethermint_evm_v1_evm_proto_rawDescGZIP() []byte {
 }
 
 var file_ethermint_evm_v1_evm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ethermint_evm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_ethermint_evm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_ethermint_evm_v1_evm_proto_goTypes = []interface{}{
 	(AccessType)(0),           // 0: ethermint.evm.v1.AccessType
 	(*Params)(nil),            // 1: ethermint.evm.v1.Params
-	(*AccessControl)(nil),     // 2: ethermint.evm.v1.AccessControl
-	(*AccessControlType)(nil), // 3: ethermint.evm.v1.AccessControlType
-	(*ChainConfig)(nil),       // 4: ethermint.evm.v1.ChainConfig
-	(*State)(nil),             // 5: ethermint.evm.v1.State
-	(*TransactionLogs)(nil),   // 6: ethermint.evm.v1.TransactionLogs
-	(*Log)(nil),               // 7: ethermint.evm.v1.Log
-	(*TxResult)(nil),          // 8: ethermint.evm.v1.TxResult
-	(*AccessTuple)(nil),       // 9: ethermint.evm.v1.AccessTuple
-	(*TraceConfig)(nil),       // 10: ethermint.evm.v1.TraceConfig
+	(*OsmosisOutpost)(nil),    // 2: ethermint.evm.v1.OsmosisOutpost
+	(*StrideOutpost)(nil),     // 3: ethermint.evm.v1.StrideOutpost
+	(*ScheduledEIP)(nil),      // 4: ethermint.evm.v1.ScheduledEIP
+	(*AccessControl)(nil),     // 5: ethermint.evm.v1.AccessControl
+	(*AccessControlType)(nil), // 6: ethermint.evm.v1.AccessControlType
+	(*ChainConfig)(nil),       // 7: ethermint.evm.v1.ChainConfig
+	(*State)(nil),             // 8: ethermint.evm.v1.State
+	(*TransactionLogs)(nil),   // 9: ethermint.evm.v1.TransactionLogs
+	(*Log)(nil),               // 10: ethermint.evm.v1.Log
+	(*Receipt)(nil),           // 11: ethermint.evm.v1.Receipt
+	(*TxResult)(nil),          // 12: ethermint.evm.v1.TxResult
+	(*AccessTuple)(nil),       // 13: ethermint.evm.v1.AccessTuple
+	(*TraceConfig)(nil),       // 14: ethermint.evm.v1.TraceConfig
 }
 var file_ethermint_evm_v1_evm_proto_depIdxs = []int32{
-	2, // 0: ethermint.evm.v1.Params.access_control:type_name -> ethermint.evm.v1.AccessControl
-	3, // 1: ethermint.evm.v1.AccessControl.create:type_name -> ethermint.evm.v1.AccessControlType
-	3, // 2: ethermint.evm.v1.AccessControl.call:type_name -> ethermint.evm.v1.AccessControlType
-	0, // 3: ethermint.evm.v1.AccessControlType.access_type:type_name -> ethermint.evm.v1.AccessType
-	7, // 4: ethermint.evm.v1.TransactionLogs.logs:type_name -> ethermint.evm.v1.Log
-	6, // 5: ethermint.evm.v1.TxResult.tx_logs:type_name -> ethermint.evm.v1.TransactionLogs
-	4, // 6: ethermint.evm.v1.TraceConfig.overrides:type_name -> ethermint.evm.v1.ChainConfig
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	5,  // 0: ethermint.evm.v1.Params.access_control:type_name -> ethermint.evm.v1.AccessControl
+	4,  // 1: ethermint.evm.v1.Params.scheduled_eips:type_name -> ethermint.evm.v1.ScheduledEIP
+	2,  // 2: ethermint.evm.v1.Params.osmosis_outpost:type_name -> ethermint.evm.v1.OsmosisOutpost
+	3,  // 3: ethermint.evm.v1.Params.stride_outpost:type_name -> ethermint.evm.v1.StrideOutpost
+	6,  // 4: ethermint.evm.v1.AccessControl.create:type_name -> ethermint.evm.v1.AccessControlType
+	6,  // 5: ethermint.evm.v1.AccessControl.call:type_name -> ethermint.evm.v1.AccessControlType
+	0,  // 6: ethermint.evm.v1.AccessControlType.access_type:type_name -> ethermint.evm.v1.AccessType
+	10, // 7: ethermint.evm.v1.TransactionLogs.logs:type_name -> ethermint.evm.v1.Log
+	10, // 8: ethermint.evm.v1.Receipt.logs:type_name -> ethermint.evm.v1.Log
+	9,  // 9: ethermint.evm.v1.TxResult.tx_logs:type_name -> ethermint.evm.v1.TransactionLogs
+	7,  // 10: ethermint.evm.v1.TraceConfig.overrides:type_name -> ethermint.evm.v1.ChainConfig
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_ethermint_evm_v1_evm_proto_init() }
@@ -9198,7 +13048,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessControl); i {
+			switch v := v.(*OsmosisOutpost); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9210,7 +13060,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessControlType); i {
+			switch v := v.(*StrideOutpost); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9222,7 +13072,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainConfig); i {
+			switch v := v.(*ScheduledEIP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9234,7 +13084,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*State); i {
+			switch v := v.(*AccessControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9246,7 +13096,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionLogs); i {
+			switch v := v.(*AccessControlType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9258,7 +13108,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Log); i {
+			switch v := v.(*ChainConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9270,7 +13120,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxResult); i {
+			switch v := v.(*State); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9282,7 +13132,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessTuple); i {
+			switch v := v.(*TransactionLogs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9294,6 +13144,54 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			}
 		}
 		file_ethermint_evm_v1_evm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Log); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ethermint_evm_v1_evm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Receipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ethermint_evm_v1_evm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ethermint_evm_v1_evm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessTuple); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ethermint_evm_v1_evm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TraceConfig); i {
 			case 0:
 				return &v.state
@@ -9312,7 +13210,7 @@ func file_ethermint_evm_v1_evm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ethermint_evm_v1_evm_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
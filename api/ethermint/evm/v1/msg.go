@@ -19,10 +19,17 @@ var supportedTxs = map[string]func() TxDataV2{
 	"/ethermint.evm.v1.DynamicFeeTx": func() TxDataV2 { return &DynamicFeeTx{} },
 	"/ethermint.evm.v1.AccessListTx": func() TxDataV2 { return &AccessListTx{} },
 	"/ethermint.evm.v1.LegacyTx":     func() TxDataV2 { return &LegacyTx{} },
+	"/ethermint.evm.v1.SetCodeTx":    func() TxDataV2 { return &SetCodeTx{} },
 }
 
 // getSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func getSender(txData TxDataV2) (common.Address, error) {
+	// go-ethereum doesn't support EIP-7702, set code txs are signed over
+	// their own signing hash
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		return setCodeTx.AsSetCodeTx().Sender()
+	}
+
 	signer := ethtypes.LatestSignerForChainID(txData.GetChainID())
 	from, err := signer.Sender(ethtypes.NewTx(txData.AsEthereumData()))
 	if err != nil {
@@ -45,7 +52,7 @@ func GetSigners(msg protov2.Message) ([][]byte, error) {
 	}
 	txData := txDataFn()
 
-	// msgEthTx.Data is a message (DynamicFeeTx, LegacyTx or AccessListTx)
+	// msgEthTx.Data is a message (DynamicFeeTx, LegacyTx, AccessListTx or SetCodeTx)
 	if err := msgEthTx.Data.UnmarshalTo(txData); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,98 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package evmv1
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
+)
+
+// GetChainID returns the chain id field from the SetCodeTx
+func (tx *SetCodeTx) GetChainID() *big.Int {
+	return stringToBigInt(tx.GetChainId())
+}
+
+// AsEthereumData returns the DynamicFeeTx execution view of the SetCodeTx.
+func (tx *SetCodeTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	return &ethtypes.DynamicFeeTx{
+		ChainID:    tx.GetChainID(),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  stringToBigInt(tx.GetGasTipCap()),
+		GasFeeCap:  stringToBigInt(tx.GetGasFeeCap()),
+		Gas:        tx.GetGas(),
+		To:         stringToAddress(tx.GetTo()),
+		Value:      stringToBigInt(tx.GetValue()),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		V:          v,
+		R:          r,
+		S:          s,
+	}
+}
+
+// AsSetCodeTx returns the RLP representation of the SetCodeTx.
+func (tx *SetCodeTx) AsSetCodeTx() *ethutils.SetCodeTx {
+	v, r, s := tx.GetRawSignatureValues()
+	authList := make([]ethutils.SetCodeAuthorization, len(tx.GetAuthorizations()))
+	for i, auth := range tx.GetAuthorizations() {
+		authV, authR, authS := ethutils.RawSignatureValues(auth.GetV(), auth.GetR(), auth.GetS())
+		authList[i] = ethutils.SetCodeAuthorization{
+			ChainID: stringToBigInt(auth.GetChainId()),
+			Address: common.HexToAddress(auth.GetAddress()),
+			Nonce:   auth.GetNonce(),
+			V:       authV,
+			R:       authR,
+			S:       authS,
+		}
+	}
+
+	return &ethutils.SetCodeTx{
+		ChainID:    tx.GetChainID(),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  stringToBigInt(tx.GetGasTipCap()),
+		GasFeeCap:  stringToBigInt(tx.GetGasFeeCap()),
+		Gas:        tx.GetGas(),
+		To:         common.HexToAddress(tx.GetTo()),
+		Value:      stringToBigInt(tx.GetValue()),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		AuthList:   authList,
+		V:          v,
+		R:          r,
+		S:          s,
+	}
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *SetCodeTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	var ethAccessList ethtypes.AccessList
+
+	for _, tuple := range tx.Accesses {
+		storageKeys := make([]common.Hash, len(tuple.StorageKeys))
+
+		for i := range tuple.StorageKeys {
+			storageKeys[i] = common.HexToHash(tuple.StorageKeys[i])
+		}
+
+		ethAccessList = append(ethAccessList, ethtypes.AccessTuple{
+			Address:     common.HexToAddress(tuple.Address),
+			StorageKeys: storageKeys,
+		})
+	}
+
+	return ethAccessList
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *SetCodeTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return ethutils.RawSignatureValues(tx.V, tx.R, tx.S)
+}
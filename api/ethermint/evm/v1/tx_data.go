@@ -15,6 +15,7 @@ var (
 	_ TxDataV2 = &LegacyTx{}
 	_ TxDataV2 = &AccessListTx{}
 	_ TxDataV2 = &DynamicFeeTx{}
+	_ TxDataV2 = &SetCodeTx{}
 )
 
 // TxDataV2 implements the Ethereum transaction tx structure. It is used
@@ -22,8 +22,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_EthereumTx_FullMethodName   = "/ethermint.evm.v1.Msg/EthereumTx"
-	Msg_UpdateParams_FullMethodName = "/ethermint.evm.v1.Msg/UpdateParams"
+	Msg_EthereumTx_FullMethodName             = "/ethermint.evm.v1.Msg/EthereumTx"
+	Msg_UpdateParams_FullMethodName           = "/ethermint.evm.v1.Msg/UpdateParams"
+	Msg_RegisterPrecompile_FullMethodName     = "/ethermint.evm.v1.Msg/RegisterPrecompile"
+	Msg_UpdateDeployers_FullMethodName        = "/ethermint.evm.v1.Msg/UpdateDeployers"
+	Msg_UpdateBlockedContracts_FullMethodName = "/ethermint.evm.v1.Msg/UpdateBlockedContracts"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +38,21 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterPrecompile defines a governance operation for activating a static precompile
+	// that is available in the precompile registry of the node without a chain upgrade.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	RegisterPrecompile(ctx context.Context, in *MsgRegisterPrecompile, opts ...grpc.CallOption) (*MsgRegisterPrecompileResponse, error)
+	// UpdateDeployers defines a governance operation for adding and removing addresses
+	// from the access control list of the contract deployments, without updating all the
+	// x/evm module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateDeployers(ctx context.Context, in *MsgUpdateDeployers, opts ...grpc.CallOption) (*MsgUpdateDeployersResponse, error)
+	// UpdateBlockedContracts defines an emergency operation for blocking and
+	// unblocking the calls to contracts, without updating all the x/evm module
+	// parameters.
+	// The authority is the Cosmos SDK x/gov module account or the security council
+	// set in the x/evm module parameters.
+	UpdateBlockedContracts(ctx context.Context, in *MsgUpdateBlockedContracts, opts ...grpc.CallOption) (*MsgUpdateBlockedContractsResponse, error)
 }
 
 type msgClient struct {
@@ -63,6 +81,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterPrecompile(ctx context.Context, in *MsgRegisterPrecompile, opts ...grpc.CallOption) (*MsgRegisterPrecompileResponse, error) {
+	out := new(MsgRegisterPrecompileResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterPrecompile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDeployers(ctx context.Context, in *MsgUpdateDeployers, opts ...grpc.CallOption) (*MsgUpdateDeployersResponse, error) {
+	out := new(MsgUpdateDeployersResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateDeployers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBlockedContracts(ctx context.Context, in *MsgUpdateBlockedContracts, opts ...grpc.CallOption) (*MsgUpdateBlockedContractsResponse, error) {
+	out := new(MsgUpdateBlockedContractsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateBlockedContracts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -72,6 +117,21 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterPrecompile defines a governance operation for activating a static precompile
+	// that is available in the precompile registry of the node without a chain upgrade.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	RegisterPrecompile(context.Context, *MsgRegisterPrecompile) (*MsgRegisterPrecompileResponse, error)
+	// UpdateDeployers defines a governance operation for adding and removing addresses
+	// from the access control list of the contract deployments, without updating all the
+	// x/evm module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateDeployers(context.Context, *MsgUpdateDeployers) (*MsgUpdateDeployersResponse, error)
+	// UpdateBlockedContracts defines an emergency operation for blocking and
+	// unblocking the calls to contracts, without updating all the x/evm module
+	// parameters.
+	// The authority is the Cosmos SDK x/gov module account or the security council
+	// set in the x/evm module parameters.
+	UpdateBlockedContracts(context.Context, *MsgUpdateBlockedContracts) (*MsgUpdateBlockedContractsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -85,6 +145,15 @@ func (UnimplementedMsgServer) EthereumTx(context.Context, *MsgEthereumTx) (*MsgE
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) RegisterPrecompile(context.Context, *MsgRegisterPrecompile) (*MsgRegisterPrecompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPrecompile not implemented")
+}
+func (UnimplementedMsgServer) UpdateDeployers(context.Context, *MsgUpdateDeployers) (*MsgUpdateDeployersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeployers not implemented")
+}
+func (UnimplementedMsgServer) UpdateBlockedContracts(context.Context, *MsgUpdateBlockedContracts) (*MsgUpdateBlockedContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlockedContracts not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -134,6 +203,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterPrecompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterPrecompile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterPrecompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterPrecompile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterPrecompile(ctx, req.(*MsgRegisterPrecompile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDeployers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDeployers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDeployers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateDeployers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDeployers(ctx, req.(*MsgUpdateDeployers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBlockedContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBlockedContracts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBlockedContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateBlockedContracts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBlockedContracts(ctx, req.(*MsgUpdateBlockedContracts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -149,6 +272,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterPrecompile",
+			Handler:    _Msg_RegisterPrecompile_Handler,
+		},
+		{
+			MethodName: "UpdateDeployers",
+			Handler:    _Msg_UpdateDeployers_Handler,
+		},
+		{
+			MethodName: "UpdateBlockedContracts",
+			Handler:    _Msg_UpdateBlockedContracts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -22,9 +22,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_TokenPairs_FullMethodName = "/evmos.erc20.v1.Query/TokenPairs"
-	Query_TokenPair_FullMethodName  = "/evmos.erc20.v1.Query/TokenPair"
-	Query_Params_FullMethodName     = "/evmos.erc20.v1.Query/Params"
+	Query_TokenPairs_FullMethodName              = "/evmos.erc20.v1.Query/TokenPairs"
+	Query_TokenPair_FullMethodName               = "/evmos.erc20.v1.Query/TokenPair"
+	Query_TokenPairsHeldBy_FullMethodName        = "/evmos.erc20.v1.Query/TokenPairsHeldBy"
+	Query_TokenPairHolders_FullMethodName        = "/evmos.erc20.v1.Query/TokenPairHolders"
+	Query_TokenPairHolderBalances_FullMethodName = "/evmos.erc20.v1.Query/TokenPairHolderBalances"
+	Query_AutoConversionOptOut_FullMethodName    = "/evmos.erc20.v1.Query/AutoConversionOptOut"
+	Query_PauseStatus_FullMethodName             = "/evmos.erc20.v1.Query/PauseStatus"
+	Query_ConversionRateLimit_FullMethodName     = "/evmos.erc20.v1.Query/ConversionRateLimit"
+	Query_DenomGroup_FullMethodName              = "/evmos.erc20.v1.Query/DenomGroup"
+	Query_Params_FullMethodName                  = "/evmos.erc20.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -35,6 +42,27 @@ type QueryClient interface {
 	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
+	// TokenPairsHeldBy retrieves the registered token pairs in which the given
+	// address holds a non-zero balance
+	TokenPairsHeldBy(ctx context.Context, in *QueryTokenPairsHeldByRequest, opts ...grpc.CallOption) (*QueryTokenPairsHeldByResponse, error)
+	// TokenPairHolders retrieves the accounts holding a non-zero balance of a
+	// registered token pair along with their count
+	TokenPairHolders(ctx context.Context, in *QueryTokenPairHoldersRequest, opts ...grpc.CallOption) (*QueryTokenPairHoldersResponse, error)
+	// TokenPairHolderBalances retrieves the balances of the accounts holding a
+	// registered token pair, so that they can be migrated before the token pair is
+	// sunset
+	TokenPairHolderBalances(ctx context.Context, in *QueryTokenPairHolderBalancesRequest, opts ...grpc.CallOption) (*QueryTokenPairHolderBalancesResponse, error)
+	// AutoConversionOptOut retrieves whether an account opted out of the automatic
+	// conversion of the received IBC vouchers
+	AutoConversionOptOut(ctx context.Context, in *QueryAutoConversionOptOutRequest, opts ...grpc.CallOption) (*QueryAutoConversionOptOutResponse, error)
+	// PauseStatus retrieves whether a registered token pair is paused
+	PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error)
+	// ConversionRateLimit retrieves the rate limit of the conversions of a
+	// registered token pair and the amount converted within the current epoch
+	ConversionRateLimit(ctx context.Context, in *QueryConversionRateLimitRequest, opts ...grpc.CallOption) (*QueryConversionRateLimitResponse, error)
+	// DenomGroup retrieves the member denominations of a denom group and their
+	// aggregate supply
+	DenomGroup(ctx context.Context, in *QueryDenomGroupRequest, opts ...grpc.CallOption) (*QueryDenomGroupResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -65,6 +93,69 @@ func (c *queryClient) TokenPair(ctx context.Context, in *QueryTokenPairRequest,
 	return out, nil
 }
 
+func (c *queryClient) TokenPairsHeldBy(ctx context.Context, in *QueryTokenPairsHeldByRequest, opts ...grpc.CallOption) (*QueryTokenPairsHeldByResponse, error) {
+	out := new(QueryTokenPairsHeldByResponse)
+	err := c.cc.Invoke(ctx, Query_TokenPairsHeldBy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenPairHolders(ctx context.Context, in *QueryTokenPairHoldersRequest, opts ...grpc.CallOption) (*QueryTokenPairHoldersResponse, error) {
+	out := new(QueryTokenPairHoldersResponse)
+	err := c.cc.Invoke(ctx, Query_TokenPairHolders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenPairHolderBalances(ctx context.Context, in *QueryTokenPairHolderBalancesRequest, opts ...grpc.CallOption) (*QueryTokenPairHolderBalancesResponse, error) {
+	out := new(QueryTokenPairHolderBalancesResponse)
+	err := c.cc.Invoke(ctx, Query_TokenPairHolderBalances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoConversionOptOut(ctx context.Context, in *QueryAutoConversionOptOutRequest, opts ...grpc.CallOption) (*QueryAutoConversionOptOutResponse, error) {
+	out := new(QueryAutoConversionOptOutResponse)
+	err := c.cc.Invoke(ctx, Query_AutoConversionOptOut_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error) {
+	out := new(QueryPauseStatusResponse)
+	err := c.cc.Invoke(ctx, Query_PauseStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConversionRateLimit(ctx context.Context, in *QueryConversionRateLimitRequest, opts ...grpc.CallOption) (*QueryConversionRateLimitResponse, error) {
+	out := new(QueryConversionRateLimitResponse)
+	err := c.cc.Invoke(ctx, Query_ConversionRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomGroup(ctx context.Context, in *QueryDenomGroupRequest, opts ...grpc.CallOption) (*QueryDenomGroupResponse, error) {
+	out := new(QueryDenomGroupResponse)
+	err := c.cc.Invoke(ctx, Query_DenomGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
@@ -82,6 +173,27 @@ type QueryServer interface {
 	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
+	// TokenPairsHeldBy retrieves the registered token pairs in which the given
+	// address holds a non-zero balance
+	TokenPairsHeldBy(context.Context, *QueryTokenPairsHeldByRequest) (*QueryTokenPairsHeldByResponse, error)
+	// TokenPairHolders retrieves the accounts holding a non-zero balance of a
+	// registered token pair along with their count
+	TokenPairHolders(context.Context, *QueryTokenPairHoldersRequest) (*QueryTokenPairHoldersResponse, error)
+	// TokenPairHolderBalances retrieves the balances of the accounts holding a
+	// registered token pair, so that they can be migrated before the token pair is
+	// sunset
+	TokenPairHolderBalances(context.Context, *QueryTokenPairHolderBalancesRequest) (*QueryTokenPairHolderBalancesResponse, error)
+	// AutoConversionOptOut retrieves whether an account opted out of the automatic
+	// conversion of the received IBC vouchers
+	AutoConversionOptOut(context.Context, *QueryAutoConversionOptOutRequest) (*QueryAutoConversionOptOutResponse, error)
+	// PauseStatus retrieves whether a registered token pair is paused
+	PauseStatus(context.Context, *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error)
+	// ConversionRateLimit retrieves the rate limit of the conversions of a
+	// registered token pair and the amount converted within the current epoch
+	ConversionRateLimit(context.Context, *QueryConversionRateLimitRequest) (*QueryConversionRateLimitResponse, error)
+	// DenomGroup retrieves the member denominations of a denom group and their
+	// aggregate supply
+	DenomGroup(context.Context, *QueryDenomGroupRequest) (*QueryDenomGroupResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -97,6 +209,27 @@ func (UnimplementedQueryServer) TokenPairs(context.Context, *QueryTokenPairsRequ
 func (UnimplementedQueryServer) TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenPair not implemented")
 }
+func (UnimplementedQueryServer) TokenPairsHeldBy(context.Context, *QueryTokenPairsHeldByRequest) (*QueryTokenPairsHeldByResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairsHeldBy not implemented")
+}
+func (UnimplementedQueryServer) TokenPairHolders(context.Context, *QueryTokenPairHoldersRequest) (*QueryTokenPairHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairHolders not implemented")
+}
+func (UnimplementedQueryServer) TokenPairHolderBalances(context.Context, *QueryTokenPairHolderBalancesRequest) (*QueryTokenPairHolderBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairHolderBalances not implemented")
+}
+func (UnimplementedQueryServer) AutoConversionOptOut(context.Context, *QueryAutoConversionOptOutRequest) (*QueryAutoConversionOptOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoConversionOptOut not implemented")
+}
+func (UnimplementedQueryServer) PauseStatus(context.Context, *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseStatus not implemented")
+}
+func (UnimplementedQueryServer) ConversionRateLimit(context.Context, *QueryConversionRateLimitRequest) (*QueryConversionRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversionRateLimit not implemented")
+}
+func (UnimplementedQueryServer) DenomGroup(context.Context, *QueryDenomGroupRequest) (*QueryDenomGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomGroup not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -149,6 +282,132 @@ func _Query_TokenPair_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenPairsHeldBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairsHeldByRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairsHeldBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenPairsHeldBy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairsHeldBy(ctx, req.(*QueryTokenPairsHeldByRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenPairHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenPairHolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairHolders(ctx, req.(*QueryTokenPairHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenPairHolderBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairHolderBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairHolderBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenPairHolderBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairHolderBalances(ctx, req.(*QueryTokenPairHolderBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoConversionOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoConversionOptOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoConversionOptOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AutoConversionOptOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoConversionOptOut(ctx, req.(*QueryAutoConversionOptOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PauseStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PauseStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseStatus(ctx, req.(*QueryPauseStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConversionRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConversionRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConversionRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ConversionRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConversionRateLimit(ctx, req.(*QueryConversionRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomGroup(ctx, req.(*QueryDenomGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -182,6 +441,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TokenPair",
 			Handler:    _Query_TokenPair_Handler,
 		},
+		{
+			MethodName: "TokenPairsHeldBy",
+			Handler:    _Query_TokenPairsHeldBy_Handler,
+		},
+		{
+			MethodName: "TokenPairHolders",
+			Handler:    _Query_TokenPairHolders_Handler,
+		},
+		{
+			MethodName: "TokenPairHolderBalances",
+			Handler:    _Query_TokenPairHolderBalances_Handler,
+		},
+		{
+			MethodName: "AutoConversionOptOut",
+			Handler:    _Query_AutoConversionOptOut_Handler,
+		},
+		{
+			MethodName: "PauseStatus",
+			Handler:    _Query_PauseStatus_Handler,
+		},
+		{
+			MethodName: "ConversionRateLimit",
+			Handler:    _Query_ConversionRateLimit_Handler,
+		},
+		{
+			MethodName: "DenomGroup",
+			Handler:    _Query_DenomGroup_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
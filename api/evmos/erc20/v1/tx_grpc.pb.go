@@ -22,10 +22,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_ConvertERC20_FullMethodName     = "/evmos.erc20.v1.Msg/ConvertERC20"
-	Msg_UpdateParams_FullMethodName     = "/evmos.erc20.v1.Msg/UpdateParams"
-	Msg_RegisterERC20_FullMethodName    = "/evmos.erc20.v1.Msg/RegisterERC20"
-	Msg_ToggleConversion_FullMethodName = "/evmos.erc20.v1.Msg/ToggleConversion"
+	Msg_ConvertERC20_FullMethodName                   = "/evmos.erc20.v1.Msg/ConvertERC20"
+	Msg_UpdateParams_FullMethodName                   = "/evmos.erc20.v1.Msg/UpdateParams"
+	Msg_RegisterERC20_FullMethodName                  = "/evmos.erc20.v1.Msg/RegisterERC20"
+	Msg_ToggleConversion_FullMethodName               = "/evmos.erc20.v1.Msg/ToggleConversion"
+	Msg_UpdateTokenPairMetadata_FullMethodName        = "/evmos.erc20.v1.Msg/UpdateTokenPairMetadata"
+	Msg_DeregisterTokenPair_FullMethodName            = "/evmos.erc20.v1.Msg/DeregisterTokenPair"
+	Msg_SetAutoConversionOptOut_FullMethodName        = "/evmos.erc20.v1.Msg/SetAutoConversionOptOut"
+	Msg_UpdateConversionRateLimit_FullMethodName      = "/evmos.erc20.v1.Msg/UpdateConversionRateLimit"
+	Msg_SetTokenPairEmitEvents_FullMethodName         = "/evmos.erc20.v1.Msg/SetTokenPairEmitEvents"
+	Msg_UpdateDenomGroup_FullMethodName               = "/evmos.erc20.v1.Msg/UpdateDenomGroup"
+	Msg_SetTokenPairComplianceFeatures_FullMethodName = "/evmos.erc20.v1.Msg/SetTokenPairComplianceFeatures"
+	Msg_UpdateTokenPairPaused_FullMethodName          = "/evmos.erc20.v1.Msg/UpdateTokenPairPaused"
+	Msg_UpdateBlockedAccount_FullMethodName           = "/evmos.erc20.v1.Msg/UpdateBlockedAccount"
 )
 
 // MsgClient is the client API for Msg service.
@@ -39,11 +48,47 @@ type MsgClient interface {
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// RegisterERC20 defines a governance operation for registering a token pair for the specified erc20 contract.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	// The authority is hard-coded to the Cosmos SDK x/gov module account unless permissionless registration
+	// is enabled, in which case any account can register token pairs by paying the registration fee.
 	RegisterERC20(ctx context.Context, in *MsgRegisterERC20, opts ...grpc.CallOption) (*MsgRegisterERC20Response, error)
 	// ToggleConversion defines a governance operation for enabling/disablen a token pair conversion.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error)
+	// UpdateTokenPairMetadata defines a governance operation for updating the name, symbol and
+	// decimals of a registered native coin token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateTokenPairMetadata(ctx context.Context, in *MsgUpdateTokenPairMetadata, opts ...grpc.CallOption) (*MsgUpdateTokenPairMetadataResponse, error)
+	// DeregisterTokenPair defines a governance operation for disabling a token pair and its precompile
+	// before the token is sunset.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	DeregisterTokenPair(ctx context.Context, in *MsgDeregisterTokenPair, opts ...grpc.CallOption) (*MsgDeregisterTokenPairResponse, error)
+	// SetAutoConversionOptOut defines an operation for an account to opt out of, or back into,
+	// the automatic conversion of the IBC vouchers it receives to their ERC20 representation.
+	SetAutoConversionOptOut(ctx context.Context, in *MsgSetAutoConversionOptOut, opts ...grpc.CallOption) (*MsgSetAutoConversionOptOutResponse, error)
+	// UpdateConversionRateLimit defines a governance operation for setting or removing
+	// the rate limit of the conversions of a token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateConversionRateLimit(ctx context.Context, in *MsgUpdateConversionRateLimit, opts ...grpc.CallOption) (*MsgUpdateConversionRateLimitResponse, error)
+	// SetTokenPairEmitEvents defines a governance operation for enabling or disabling
+	// the EVM event emission of the ERC-20 precompile of a token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetTokenPairEmitEvents(ctx context.Context, in *MsgSetTokenPairEmitEvents, opts ...grpc.CallOption) (*MsgSetTokenPairEmitEventsResponse, error)
+	// UpdateDenomGroup defines a governance operation for setting or removing the
+	// group of related denominations whose supplies are aggregated.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateDenomGroup(ctx context.Context, in *MsgUpdateDenomGroup, opts ...grpc.CallOption) (*MsgUpdateDenomGroupResponse, error)
+	// SetTokenPairComplianceFeatures defines a governance operation for setting the
+	// compliance hooks that are active for the ERC-20 precompile of a token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetTokenPairComplianceFeatures(ctx context.Context, in *MsgSetTokenPairComplianceFeatures, opts ...grpc.CallOption) (*MsgSetTokenPairComplianceFeaturesResponse, error)
+	// UpdateTokenPairPaused defines a governance operation for pausing or unpausing
+	// the transfers of a token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateTokenPairPaused(ctx context.Context, in *MsgUpdateTokenPairPaused, opts ...grpc.CallOption) (*MsgUpdateTokenPairPausedResponse, error)
+	// UpdateBlockedAccount defines a governance operation for adding an account to or
+	// removing it from the blocklist of a token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateBlockedAccount(ctx context.Context, in *MsgUpdateBlockedAccount, opts ...grpc.CallOption) (*MsgUpdateBlockedAccountResponse, error)
 }
 
 type msgClient struct {
@@ -90,6 +135,87 @@ func (c *msgClient) ToggleConversion(ctx context.Context, in *MsgToggleConversio
 	return out, nil
 }
 
+func (c *msgClient) UpdateTokenPairMetadata(ctx context.Context, in *MsgUpdateTokenPairMetadata, opts ...grpc.CallOption) (*MsgUpdateTokenPairMetadataResponse, error) {
+	out := new(MsgUpdateTokenPairMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTokenPairMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterTokenPair(ctx context.Context, in *MsgDeregisterTokenPair, opts ...grpc.CallOption) (*MsgDeregisterTokenPairResponse, error) {
+	out := new(MsgDeregisterTokenPairResponse)
+	err := c.cc.Invoke(ctx, Msg_DeregisterTokenPair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoConversionOptOut(ctx context.Context, in *MsgSetAutoConversionOptOut, opts ...grpc.CallOption) (*MsgSetAutoConversionOptOutResponse, error) {
+	out := new(MsgSetAutoConversionOptOutResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAutoConversionOptOut_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateConversionRateLimit(ctx context.Context, in *MsgUpdateConversionRateLimit, opts ...grpc.CallOption) (*MsgUpdateConversionRateLimitResponse, error) {
+	out := new(MsgUpdateConversionRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateConversionRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTokenPairEmitEvents(ctx context.Context, in *MsgSetTokenPairEmitEvents, opts ...grpc.CallOption) (*MsgSetTokenPairEmitEventsResponse, error) {
+	out := new(MsgSetTokenPairEmitEventsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTokenPairEmitEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDenomGroup(ctx context.Context, in *MsgUpdateDenomGroup, opts ...grpc.CallOption) (*MsgUpdateDenomGroupResponse, error) {
+	out := new(MsgUpdateDenomGroupResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateDenomGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTokenPairComplianceFeatures(ctx context.Context, in *MsgSetTokenPairComplianceFeatures, opts ...grpc.CallOption) (*MsgSetTokenPairComplianceFeaturesResponse, error) {
+	out := new(MsgSetTokenPairComplianceFeaturesResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTokenPairComplianceFeatures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTokenPairPaused(ctx context.Context, in *MsgUpdateTokenPairPaused, opts ...grpc.CallOption) (*MsgUpdateTokenPairPausedResponse, error) {
+	out := new(MsgUpdateTokenPairPausedResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTokenPairPaused_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBlockedAccount(ctx context.Context, in *MsgUpdateBlockedAccount, opts ...grpc.CallOption) (*MsgUpdateBlockedAccountResponse, error) {
+	out := new(MsgUpdateBlockedAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateBlockedAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -101,11 +227,47 @@ type MsgServer interface {
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// RegisterERC20 defines a governance operation for registering a token pair for the specified erc20 contract.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	// The authority is hard-coded to the Cosmos SDK x/gov module account unless permissionless registration
+	// is enabled, in which case any account can register token pairs by paying the registration fee.
 	RegisterERC20(context.Context, *MsgRegisterERC20) (*MsgRegisterERC20Response, error)
 	// ToggleConversion defines a governance operation for enabling/disablen a token pair conversion.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
+	// UpdateTokenPairMetadata defines a governance operation for updating the name, symbol and
+	// decimals of a registered native coin token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateTokenPairMetadata(context.Context, *MsgUpdateTokenPairMetadata) (*MsgUpdateTokenPairMetadataResponse, error)
+	// DeregisterTokenPair defines a governance operation for disabling a token pair and its precompile
+	// before the token is sunset.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	DeregisterTokenPair(context.Context, *MsgDeregisterTokenPair) (*MsgDeregisterTokenPairResponse, error)
+	// SetAutoConversionOptOut defines an operation for an account to opt out of, or back into,
+	// the automatic conversion of the IBC vouchers it receives to their ERC20 representation.
+	SetAutoConversionOptOut(context.Context, *MsgSetAutoConversionOptOut) (*MsgSetAutoConversionOptOutResponse, error)
+	// UpdateConversionRateLimit defines a governance operation for setting or removing
+	// the rate limit of the conversions of a token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateConversionRateLimit(context.Context, *MsgUpdateConversionRateLimit) (*MsgUpdateConversionRateLimitResponse, error)
+	// SetTokenPairEmitEvents defines a governance operation for enabling or disabling
+	// the EVM event emission of the ERC-20 precompile of a token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetTokenPairEmitEvents(context.Context, *MsgSetTokenPairEmitEvents) (*MsgSetTokenPairEmitEventsResponse, error)
+	// UpdateDenomGroup defines a governance operation for setting or removing the
+	// group of related denominations whose supplies are aggregated.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateDenomGroup(context.Context, *MsgUpdateDenomGroup) (*MsgUpdateDenomGroupResponse, error)
+	// SetTokenPairComplianceFeatures defines a governance operation for setting the
+	// compliance hooks that are active for the ERC-20 precompile of a token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetTokenPairComplianceFeatures(context.Context, *MsgSetTokenPairComplianceFeatures) (*MsgSetTokenPairComplianceFeaturesResponse, error)
+	// UpdateTokenPairPaused defines a governance operation for pausing or unpausing
+	// the transfers of a token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateTokenPairPaused(context.Context, *MsgUpdateTokenPairPaused) (*MsgUpdateTokenPairPausedResponse, error)
+	// UpdateBlockedAccount defines a governance operation for adding an account to or
+	// removing it from the blocklist of a token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateBlockedAccount(context.Context, *MsgUpdateBlockedAccount) (*MsgUpdateBlockedAccountResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -125,6 +287,33 @@ func (UnimplementedMsgServer) RegisterERC20(context.Context, *MsgRegisterERC20)
 func (UnimplementedMsgServer) ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleConversion not implemented")
 }
+func (UnimplementedMsgServer) UpdateTokenPairMetadata(context.Context, *MsgUpdateTokenPairMetadata) (*MsgUpdateTokenPairMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTokenPairMetadata not implemented")
+}
+func (UnimplementedMsgServer) DeregisterTokenPair(context.Context, *MsgDeregisterTokenPair) (*MsgDeregisterTokenPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterTokenPair not implemented")
+}
+func (UnimplementedMsgServer) SetAutoConversionOptOut(context.Context, *MsgSetAutoConversionOptOut) (*MsgSetAutoConversionOptOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoConversionOptOut not implemented")
+}
+func (UnimplementedMsgServer) UpdateConversionRateLimit(context.Context, *MsgUpdateConversionRateLimit) (*MsgUpdateConversionRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConversionRateLimit not implemented")
+}
+func (UnimplementedMsgServer) SetTokenPairEmitEvents(context.Context, *MsgSetTokenPairEmitEvents) (*MsgSetTokenPairEmitEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTokenPairEmitEvents not implemented")
+}
+func (UnimplementedMsgServer) UpdateDenomGroup(context.Context, *MsgUpdateDenomGroup) (*MsgUpdateDenomGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenomGroup not implemented")
+}
+func (UnimplementedMsgServer) SetTokenPairComplianceFeatures(context.Context, *MsgSetTokenPairComplianceFeatures) (*MsgSetTokenPairComplianceFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTokenPairComplianceFeatures not implemented")
+}
+func (UnimplementedMsgServer) UpdateTokenPairPaused(context.Context, *MsgUpdateTokenPairPaused) (*MsgUpdateTokenPairPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTokenPairPaused not implemented")
+}
+func (UnimplementedMsgServer) UpdateBlockedAccount(context.Context, *MsgUpdateBlockedAccount) (*MsgUpdateBlockedAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlockedAccount not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -210,6 +399,168 @@ func _Msg_ToggleConversion_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateTokenPairMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTokenPairMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTokenPairMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTokenPairMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTokenPairMetadata(ctx, req.(*MsgUpdateTokenPairMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterTokenPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterTokenPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterTokenPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeregisterTokenPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterTokenPair(ctx, req.(*MsgDeregisterTokenPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoConversionOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoConversionOptOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoConversionOptOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAutoConversionOptOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoConversionOptOut(ctx, req.(*MsgSetAutoConversionOptOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateConversionRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateConversionRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateConversionRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateConversionRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateConversionRateLimit(ctx, req.(*MsgUpdateConversionRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTokenPairEmitEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTokenPairEmitEvents)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTokenPairEmitEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTokenPairEmitEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTokenPairEmitEvents(ctx, req.(*MsgSetTokenPairEmitEvents))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDenomGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDenomGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDenomGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateDenomGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDenomGroup(ctx, req.(*MsgUpdateDenomGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTokenPairComplianceFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTokenPairComplianceFeatures)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTokenPairComplianceFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTokenPairComplianceFeatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTokenPairComplianceFeatures(ctx, req.(*MsgSetTokenPairComplianceFeatures))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTokenPairPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTokenPairPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTokenPairPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTokenPairPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTokenPairPaused(ctx, req.(*MsgUpdateTokenPairPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBlockedAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBlockedAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBlockedAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateBlockedAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBlockedAccount(ctx, req.(*MsgUpdateBlockedAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +584,42 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleConversion",
 			Handler:    _Msg_ToggleConversion_Handler,
 		},
+		{
+			MethodName: "UpdateTokenPairMetadata",
+			Handler:    _Msg_UpdateTokenPairMetadata_Handler,
+		},
+		{
+			MethodName: "DeregisterTokenPair",
+			Handler:    _Msg_DeregisterTokenPair_Handler,
+		},
+		{
+			MethodName: "SetAutoConversionOptOut",
+			Handler:    _Msg_SetAutoConversionOptOut_Handler,
+		},
+		{
+			MethodName: "UpdateConversionRateLimit",
+			Handler:    _Msg_UpdateConversionRateLimit_Handler,
+		},
+		{
+			MethodName: "SetTokenPairEmitEvents",
+			Handler:    _Msg_SetTokenPairEmitEvents_Handler,
+		},
+		{
+			MethodName: "UpdateDenomGroup",
+			Handler:    _Msg_UpdateDenomGroup_Handler,
+		},
+		{
+			MethodName: "SetTokenPairComplianceFeatures",
+			Handler:    _Msg_SetTokenPairComplianceFeatures_Handler,
+		},
+		{
+			MethodName: "UpdateTokenPairPaused",
+			Handler:    _Msg_UpdateTokenPairPaused_Handler,
+		},
+		{
+			MethodName: "UpdateBlockedAccount",
+			Handler:    _Msg_UpdateBlockedAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/erc20/v1/tx.proto",
@@ -14,8 +14,18 @@ import (
 // Ethereum or SDK transaction to an internal ante handler for performing
 // transaction-level processing (e.g. fee payment, signature verification) before
 // being passed onto it's respective handler.
+//
+// On CheckTx, the transactions of the priority lane are ranked above the rest.
+//
+// The Ethereum and Cosmos ante handlers are chained from the decorator
+// registries of the options when the handler is created, so later changes to
+// the registries are not applied.
 func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
-	return func(
+	evmAnteHandler := newMonoEVMAnteHandler(options)
+	cosmosAnteHandler := newCosmosAnteHandler(options)
+	priorityLane := options.priorityLane()
+
+	route := func(
 		ctx sdk.Context, tx sdk.Tx, sim bool,
 	) (newCtx sdk.Context, err error) {
 		var anteHandler sdk.AnteHandler
@@ -27,10 +37,10 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 				switch typeURL := opts[0].GetTypeUrl(); typeURL {
 				case "/ethermint.evm.v1.ExtensionOptionsEthereumTx":
 					// handle as *evmtypes.MsgEthereumTx
-					anteHandler = newMonoEVMAnteHandler(options)
+					anteHandler = evmAnteHandler
 				case "/ethermint.types.v1.ExtensionOptionDynamicFeeTx":
 					// cosmos-sdk tx with dynamic fee extension
-					anteHandler = newCosmosAnteHandler(options)
+					anteHandler = cosmosAnteHandler
 				default:
 					return ctx, errorsmod.Wrapf(
 						errortypes.ErrUnknownExtensionOptions,
@@ -45,11 +55,21 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		// handle as totally normal Cosmos SDK tx
 		switch tx.(type) {
 		case sdk.Tx:
-			anteHandler = newCosmosAnteHandler(options)
+			anteHandler = cosmosAnteHandler
 		default:
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid transaction type: %T", tx)
 		}
 
 		return anteHandler(ctx, tx, sim)
 	}
+
+	return func(
+		ctx sdk.Context, tx sdk.Tx, sim bool,
+	) (sdk.Context, error) {
+		newCtx, err := route(ctx, tx, sim)
+		if err != nil || !newCtx.IsCheckTx() {
+			return newCtx, err
+		}
+		return newCtx.WithPriority(priorityLane.Priority(tx, newCtx.Priority())), nil
+	}
 }
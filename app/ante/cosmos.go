@@ -6,36 +6,151 @@ package ante
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
-	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	ibcante "github.com/cosmos/ibc-go/v8/modules/core/ante"
 	cosmosante "github.com/evmos/evmos/v20/app/ante/cosmos"
 	evmante "github.com/evmos/evmos/v20/app/ante/evm"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
-// newCosmosAnteHandler creates the default ante handler for Cosmos transactions
-func newCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
-	return sdk.ChainAnteDecorators(
-		cosmosante.RejectMessagesDecorator{}, // reject MsgEthereumTxs
-		cosmosante.NewAuthzLimiterDecorator( // disable the Msg types that cannot be included on an authz.MsgExec msgs field
-			sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
-			sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
-		),
-		ante.NewSetUpContextDecorator(),
-		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
-		ante.NewValidateBasicDecorator(),
-		ante.NewTxTimeoutHeightDecorator(),
-		ante.NewValidateMemoDecorator(options.AccountKeeper),
-		cosmosante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper),
-		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+// Names of the decorators of the default Cosmos ante handler
+const (
+	DecoratorRejectMessages    = "reject_messages"
+	DecoratorAuthzLimiter      = "authz_limiter"
+	DecoratorSetUpContext      = "set_up_context"
+	DecoratorExtensionOptions  = "extension_options"
+	DecoratorValidateBasic     = "validate_basic"
+	DecoratorTxTimeoutHeight   = "tx_timeout_height"
+	DecoratorValidateMemo      = "validate_memo"
+	DecoratorMinGasPrice       = "min_gas_price"
+	DecoratorConsumeGasForSize = "consume_gas_for_tx_size"
+	DecoratorDeductFee         = "deduct_fee"
+	DecoratorSetPubKey         = "set_pub_key"
+	DecoratorValidateSigCount  = "validate_sig_count"
+	DecoratorSigGasConsume     = "sig_gas_consume"
+	DecoratorSigVerification   = "sig_verification"
+	DecoratorIncrementSequence = "increment_sequence"
+	DecoratorRedundantRelay    = "redundant_relay"
+	DecoratorGasWanted         = "gas_wanted"
+)
+
+// DefaultCosmosDecorators returns the registry of the decorators of the default
+// ante handler for Cosmos transactions.
+func DefaultCosmosDecorators() *DecoratorRegistry {
+	return NewDecoratorRegistry(
+		NamedDecorator{
+			Name: DecoratorRejectMessages,
+			Factory: func(HandlerOptions) sdk.AnteDecorator {
+				return cosmosante.RejectMessagesDecorator{} // reject MsgEthereumTxs
+			},
+		},
+		NamedDecorator{
+			Name: DecoratorAuthzLimiter,
+			Factory: func(HandlerOptions) sdk.AnteDecorator {
+				// disable the Msg types that cannot be included on an authz.MsgExec msgs field
+				return cosmosante.NewAuthzLimiterDecorator(evmtypes.AuthzDisabledMsgTypes...)
+			},
+		},
+		NamedDecorator{
+			Name: DecoratorSetUpContext,
+			Factory: func(HandlerOptions) sdk.AnteDecorator {
+				return ante.NewSetUpContextDecorator()
+			},
+		},
+		NamedDecorator{
+			Name: DecoratorExtensionOptions,
+			Factory: func(options HandlerOptions) sdk.AnteDecorator {
+				return ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker)
+			},
+		},
+		NamedDecorator{
+			Name: DecoratorValidateBasic,
+			Factory: func(HandlerOptions) sdk.AnteDecorator {
+				return ante.NewValidateBasicDecorator()
+			},
+		},
+		NamedDecorator{
+			Name: DecoratorTxTimeoutHeight,
+			Factory: func(HandlerOptions) sdk.AnteDecorator {
+				return ante.NewTxTimeoutHeightDecorator()
+			},
+		},
+		NamedDecorator{
+			Name: DecoratorValidateMemo,
+			Factory: func(options HandlerOptions) sdk.AnteDecorator {
+				return ante.NewValidateMemoDecorator(options.AccountKeeper)
+			},
+		},
+		NamedDecorator{
+			Name: DecoratorMinGasPrice,
+			Factory: func(options HandlerOptions) sdk.AnteDecorator {
+				return cosmosante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper)
+			},
+		},
+		NamedDecorator{
+			Name: DecoratorConsumeGasForSize,
+			Factory: func(options HandlerOptions) sdk.AnteDecorator {
+				return ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper)
+			},
+			After: []string{DecoratorSetUpContext},
+		},
+		NamedDecorator{
+			Name: DecoratorDeductFee,
+			Factory: func(options HandlerOptions) sdk.AnteDecorator {
+				return ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker)
+			},
+			After: []string{DecoratorSetUpContext},
+		},
 		// SetPubKeyDecorator must be called before all signature verification decorators
-		ante.NewSetPubKeyDecorator(options.AccountKeeper),
-		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
-		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
-		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
-		evmante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper),
+		NamedDecorator{
+			Name: DecoratorSetPubKey,
+			Factory: func(options HandlerOptions) sdk.AnteDecorator {
+				return ante.NewSetPubKeyDecorator(options.AccountKeeper)
+			},
+		},
+		NamedDecorator{
+			Name: DecoratorValidateSigCount,
+			Factory: func(options HandlerOptions) sdk.AnteDecorator {
+				return ante.NewValidateSigCountDecorator(options.AccountKeeper)
+			},
+		},
+		NamedDecorator{
+			Name: DecoratorSigGasConsume,
+			Factory: func(options HandlerOptions) sdk.AnteDecorator {
+				return ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer)
+			},
+			After: []string{DecoratorSetPubKey},
+		},
+		NamedDecorator{
+			Name: DecoratorSigVerification,
+			Factory: func(options HandlerOptions) sdk.AnteDecorator {
+				return ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler)
+			},
+			After: []string{DecoratorSetPubKey},
+		},
+		NamedDecorator{
+			Name: DecoratorIncrementSequence,
+			Factory: func(options HandlerOptions) sdk.AnteDecorator {
+				return ante.NewIncrementSequenceDecorator(options.AccountKeeper)
+			},
+			After: []string{DecoratorSigVerification},
+		},
+		NamedDecorator{
+			Name: DecoratorRedundantRelay,
+			Factory: func(options HandlerOptions) sdk.AnteDecorator {
+				return ibcante.NewRedundantRelayDecorator(options.IBCKeeper)
+			},
+		},
+		NamedDecorator{
+			Name: DecoratorGasWanted,
+			Factory: func(options HandlerOptions) sdk.AnteDecorator {
+				return evmante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper)
+			},
+		},
 	)
 }
+
+// newCosmosAnteHandler creates the ante handler for Cosmos transactions from
+// the registered Cosmos decorators
+func newCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
+	return options.cosmosDecorators().AnteHandler(options)
+}
@@ -37,7 +37,7 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
 	}
 
-	minGasPrice := mpd.feemarketKeeper.GetParams(ctx).MinGasPrice
+	minGasPrice := mpd.feemarketKeeper.GetMinGasPrice(ctx)
 
 	feeCoins := feeTx.GetFee()
 	baseDenom, err := sdk.GetBaseDenom()
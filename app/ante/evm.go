@@ -8,16 +8,36 @@ import (
 	evmante "github.com/evmos/evmos/v20/app/ante/evm"
 )
 
-func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
-	return sdk.ChainAnteDecorators(
-		evmante.NewMonoDecorator(
-			options.AccountKeeper,
-			options.BankKeeper,
-			options.FeeMarketKeeper,
-			options.EvmKeeper,
-			options.DistributionKeeper,
-			options.StakingKeeper,
-			options.MaxTxGasWanted,
-		),
+// DecoratorMonoEVM is the name of the decorator that performs all the checks
+// of the default ante handler for Ethereum transactions
+const DecoratorMonoEVM = "mono_evm"
+
+// DefaultEVMDecorators returns the registry of the decorators of the default
+// ante handler for Ethereum transactions.
+func DefaultEVMDecorators() *DecoratorRegistry {
+	return NewDecoratorRegistry(
+		NamedDecorator{
+			Name: DecoratorMonoEVM,
+			Factory: func(options HandlerOptions) sdk.AnteDecorator {
+				return evmante.NewMonoDecorator(
+					options.AccountKeeper,
+					options.BankKeeper,
+					options.FeeMarketKeeper,
+					options.EvmKeeper,
+					options.DistributionKeeper,
+					options.StakingKeeper,
+					options.FeegrantKeeper,
+					options.Erc20Keeper,
+					options.Mempool,
+					options.MaxTxGasWanted,
+				)
+			},
+		},
 	)
 }
+
+// newMonoEVMAnteHandler creates the ante handler for Ethereum transactions from
+// the registered EVM decorators
+func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
+	return options.evmDecorators().AnteHandler(options)
+}
@@ -11,6 +11,7 @@ import (
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/ethereum/go-ethereum/common"
 
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
@@ -54,6 +55,27 @@ func checkDisabledCreateCall(
 	return nil
 }
 
+// CheckCreatePermission checks that the sender of a contract creation is
+// permitted to deploy contracts by the deployment policy, which is set by the
+// create access control of the EVM parameters. The contracts deployed by other
+// contracts are checked during the execution.
+func CheckCreatePermission(
+	txData evmtypes.TxData,
+	permissions *evmtypes.AccessControl,
+	from common.Address,
+) error {
+	if txData.GetTo() != nil {
+		return nil
+	}
+
+	policy := evmtypes.NewRestrictedPermissionPolicy(permissions, from)
+	if !policy.CanCreate(from, from) {
+		return errorsmod.Wrapf(evmtypes.ErrCreateNotPermitted, "sender %s", from)
+	}
+
+	return nil
+}
+
 // ValidateTx validates an Ethereum specific transaction type and returns an error if invalid.
 //
 // FIXME: this shouldn't be required if the tx was an Ethereum transaction type.
@@ -89,8 +111,11 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty")
 	}
 
-	if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer and granter should be empty")
+	// NOTE: the fee granter is allowed to sponsor the eth tx fees through a
+	// feegrant allowance if the sender signs it in the EIP-712 fee options,
+	// while the fee payer must sign an EIP-712 sponsorship
+	if authInfo.Fee.Payer != "" {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer should be empty")
 	}
 
 	sigs := protoTx.Signatures
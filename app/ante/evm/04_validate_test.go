@@ -194,6 +194,75 @@ func (suite *EvmAnteTestSuite) TestValidateMsg() {
 	}
 }
 
+func (suite *EvmAnteTestSuite) TestCheckCreatePermission() {
+	keyring := testkeyring.New(2)
+	sender := keyring.GetAddr(0)
+
+	testCases := []struct {
+		name          string
+		typeTx        string
+		accessType    evmtypes.AccessType
+		accessList    []string
+		expectedError error
+	}{
+		{
+			name:       "success: call with restricted creation",
+			typeTx:     "call",
+			accessType: evmtypes.AccessTypeRestricted,
+		},
+		{
+			name:       "success: create with permissionless creation",
+			typeTx:     "create",
+			accessType: evmtypes.AccessTypePermissionless,
+		},
+		{
+			name:          "fail: create by a blocked sender",
+			typeTx:        "create",
+			accessType:    evmtypes.AccessTypePermissionless,
+			accessList:    []string{sender.Hex()},
+			expectedError: evmtypes.ErrCreateNotPermitted,
+		},
+		{
+			name:          "fail: create with restricted creation",
+			typeTx:        "create",
+			accessType:    evmtypes.AccessTypeRestricted,
+			expectedError: evmtypes.ErrCreateNotPermitted,
+		},
+		{
+			name:          "fail: create by a sender not in the allowlist",
+			typeTx:        "create",
+			accessType:    evmtypes.AccessTypePermissioned,
+			accessList:    []string{keyring.GetAddr(1).Hex()},
+			expectedError: evmtypes.ErrCreateNotPermitted,
+		},
+		{
+			name:       "success: create by a sender in the allowlist",
+			typeTx:     "create",
+			accessType: evmtypes.AccessTypePermissioned,
+			accessList: []string{sender.Hex()},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			txArgs := getTxByType(tc.typeTx, keyring.GetAddr(1))
+			txData, err := txArgs.ToTxData()
+			suite.Require().NoError(err)
+
+			permissions := evmtypes.DefaultParams().AccessControl
+			permissions.Create.AccessType = tc.accessType
+			permissions.Create.AccessControlList = tc.accessList
+
+			err = evm.CheckCreatePermission(txData, &permissions, sender)
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func getTxByType(typeTx string, recipient common.Address) evmtypes.EvmTxArgs {
 	switch typeTx {
 	case "call":
@@ -65,6 +65,26 @@ func SignatureVerification(
 	signer ethtypes.Signer,
 	allowUnprotectedTxs bool,
 ) error {
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to unpack tx data")
+	}
+
+	// go-ethereum doesn't support EIP-7702, set code txs are always replay
+	// protected and signed over their own signing hash
+	if setCodeTx, ok := txData.(*evmtypes.SetCodeTx); ok {
+		sender, err := setCodeTx.Sender(signer.ChainID())
+		if err != nil {
+			return errorsmod.Wrapf(
+				errortypes.ErrorInvalidSigner,
+				"couldn't retrieve sender address from the set code transaction: %s",
+				err.Error(),
+			)
+		}
+		msg.From = sender.Hex()
+		return nil
+	}
+
 	ethTx := msg.AsTransaction()
 
 	if !allowUnprotectedTxs && !ethTx.Protected() {
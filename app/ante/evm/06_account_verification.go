@@ -18,12 +18,16 @@ import (
 // This method will fail if:
 // - from address is NOT an EOA
 // - account balance is lower than the transaction cost
+//
+// When the fees are paid separately, either by a fee payer or in a fee token,
+// the account balance only has to cover the transferred value.
 func VerifyAccountBalance(
 	ctx sdk.Context,
 	accountKeeper evmtypes.AccountKeeper,
 	account *statedb.Account,
 	from common.Address,
 	txData evmtypes.TxData,
+	feesPaidSeparately bool,
 ) error {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.IsContract() {
@@ -39,7 +43,15 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance), txData); err != nil {
+	balance := sdkmath.NewIntFromBigInt(account.Balance)
+	if feesPaidSeparately {
+		if err := keeper.CheckSponsoredSenderBalance(balance, txData); err != nil {
+			return errorsmod.Wrap(err, "failed to check sponsored sender balance")
+		}
+		return nil
+	}
+
+	if err := keeper.CheckSenderBalance(balance, txData); err != nil {
 		return errorsmod.Wrap(err, "failed to check sender balance")
 	}
 
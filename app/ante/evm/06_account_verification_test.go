@@ -29,6 +29,7 @@ func (suite *EvmAnteTestSuite) TestVerifyAccountBalance() {
 	testCases := []struct {
 		name                   string
 		expectedError          error
+		sponsored              bool
 		generateAccountAndArgs func() (*statedb.Account, evmtypes.EvmTxArgs)
 	}{
 		{
@@ -85,6 +86,33 @@ func (suite *EvmAnteTestSuite) TestVerifyAccountBalance() {
 				return nil, txArgs
 			},
 		},
+		{
+			name:          "fail: sponsored sender balance is lower than the transaction value",
+			expectedError: errortypes.ErrInsufficientFunds,
+			sponsored:     true,
+			generateAccountAndArgs: func() (*statedb.Account, evmtypes.EvmTxArgs) {
+				statedbAccount := getDefaultStateDBAccount(unitNetwork, senderKey.Addr)
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, suite.ethTxType)
+				suite.Require().NoError(err)
+
+				txArgs.Amount = new(big.Int).Add(statedbAccount.Balance, big.NewInt(1))
+				return statedbAccount, txArgs
+			},
+		},
+		{
+			name:          "success: sponsored sender balance only covers the transaction value",
+			expectedError: nil,
+			sponsored:     true,
+			generateAccountAndArgs: func() (*statedb.Account, evmtypes.EvmTxArgs) {
+				statedbAccount := getDefaultStateDBAccount(unitNetwork, senderKey.Addr)
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, suite.ethTxType)
+				suite.Require().NoError(err)
+
+				// the fees are paid by the fee payer
+				txArgs.Amount = statedbAccount.Balance
+				return statedbAccount, txArgs
+			},
+		},
 		{
 			name:          "success: tx is successful if account is EOA and exists",
 			expectedError: nil,
@@ -111,6 +139,7 @@ func (suite *EvmAnteTestSuite) TestVerifyAccountBalance() {
 				statedbAccount,
 				senderKey.Addr,
 				txData,
+				tc.sponsored,
 			)
 
 			if tc.expectedError != nil {
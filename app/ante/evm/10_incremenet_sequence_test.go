@@ -3,12 +3,18 @@
 package evm_test
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/app/ante/evm"
+	evmosmempool "github.com/evmos/evmos/v20/app/mempool"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
 	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 func (suite *EvmAnteTestSuite) TestIncrementSequence() {
@@ -70,3 +76,81 @@ func (suite *EvmAnteTestSuite) TestIncrementSequence() {
 		})
 	}
 }
+
+func (suite *EvmAnteTestSuite) TestCheckReplacement() {
+	sender := common.HexToAddress("0xa")
+	newTx := func(gasTipCap, gasFeeCap int64) *evmtypes.MsgEthereumTx {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:   big.NewInt(9001),
+			Nonce:     0,
+			GasLimit:  21000,
+			GasTipCap: big.NewInt(gasTipCap),
+			GasFeeCap: big.NewInt(gasFeeCap),
+			To:        &sender,
+		})
+		msg.From = sender.Hex()
+		return msg
+	}
+	pending := newTx(100, 1000)
+	replacement := newTx(110, 1100)
+
+	// the pending tx has incremented the sequence of the sender on the check state
+	account := authtypes.NewBaseAccount(sender.Bytes(), nil, 0, 1)
+
+	testCases := []struct {
+		name          string
+		ctx           sdk.Context
+		tx            *evmtypes.MsgEthereumTx
+		expReplace    bool
+		expectedError error
+	}{
+		{
+			name: "deliver tx: no replacement",
+			ctx:  sdk.Context{},
+			tx:   replacement,
+		},
+		{
+			name:       "check tx: replaces the pending tx",
+			ctx:        sdk.Context{}.WithIsCheckTx(true),
+			tx:         replacement,
+			expReplace: true,
+		},
+		{
+			name:          "check tx: fees not bumped",
+			ctx:           sdk.Context{}.WithIsCheckTx(true),
+			tx:            newTx(105, 1050),
+			expectedError: evmtypes.ErrReplaceUnderpriced,
+		},
+		{
+			name: "recheck tx: pending tx",
+			ctx:  sdk.Context{}.WithIsReCheckTx(true),
+			tx:   pending,
+		},
+		{
+			name:          "recheck tx: replaced tx",
+			ctx:           sdk.Context{}.WithIsReCheckTx(true),
+			tx:            replacement,
+			expectedError: evmtypes.ErrTxReplaced,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			mempool := evmosmempool.NewMempool(10, 0)
+			suite.Require().NoError(mempool.Insert(sdk.Context{}, pending))
+
+			txData, err := evmtypes.UnpackTxData(tc.tx.Data)
+			suite.Require().NoError(err)
+
+			// Function under test
+			replace, err := evm.CheckReplacement(tc.ctx, mempool, account, sender, tc.tx.TxHash(), txData)
+
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expReplace, replace)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
@@ -38,3 +39,46 @@ func IncrementNonce(
 	accountKeeper.SetAccount(ctx, account)
 	return nil
 }
+
+// CheckReplacement checks the replacement of the pending tx of the sender with
+// the same nonce on the app-side mempool. On CheckTx, it returns true if the tx
+// bumps the fees of the pending tx enough to replace it, since the nonce has
+// already been incremented by the pending tx. On ReCheckTx, it returns an error
+// if the tx has been replaced, so that it's evicted from the mempool.
+//
+// NOTE: the fees of the replaced tx aren't refunded on the check state, so the
+// sender balance must cover the fees of both txs until the next block.
+func CheckReplacement(
+	ctx sdk.Context,
+	mempool Mempool,
+	account sdk.AccountI,
+	sender common.Address,
+	txHash common.Hash,
+	txData evmtypes.TxData,
+) (bool, error) {
+	if mempool == nil || !ctx.IsCheckTx() {
+		return false, nil
+	}
+
+	nonce := txData.GetNonce()
+	pendingHash, found := mempool.PendingTx(sender, nonce)
+	if !found || pendingHash == txHash {
+		return false, nil
+	}
+
+	if ctx.IsReCheckTx() {
+		return false, errorsmod.Wrapf(
+			evmtypes.ErrTxReplaced,
+			"tx %s replaced by tx %s with nonce %d", txHash, pendingHash, nonce,
+		)
+	}
+
+	if nonce >= account.GetSequence() {
+		return false, nil
+	}
+
+	if err := mempool.CheckReplacement(sender, nonce, txData.GetGasTipCap(), txData.GetGasFeeCap()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
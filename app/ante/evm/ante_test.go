@@ -10,6 +10,7 @@ import (
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -19,6 +20,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -1206,3 +1208,55 @@ func (suite *AnteTestSuite) TestAnteHandlerWithParams() {
 	}
 	suite.WithEvmParamsOptions(nil)
 }
+
+func (suite *AnteTestSuite) TestAnteHandlerDelegatedSender() {
+	to := utiltx.GenerateAddress()
+	evmChainID := evmtypes.GetEthChainConfig().ChainID
+
+	testCases := []struct {
+		name   string
+		code   []byte
+		expErr error
+	}{
+		{
+			"success - EIP-7702 delegated account sends as an EOA",
+			ethutils.AddressToDelegation(utiltx.GenerateAddress()),
+			nil,
+		},
+		{
+			"fail - contract account",
+			[]byte{0x60, 0x00},
+			errortypes.ErrInvalidType,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.WithFeemarketEnabled(false)
+			suite.SetupTest() // reset
+
+			sender := suite.GetKeyring().GetKey(0)
+			stateDB := suite.GetNetwork().GetStateDB()
+			stateDB.SetCode(sender.Addr, tc.code)
+			suite.Require().NoError(stateDB.Commit())
+
+			tx, err := suite.GetTxFactory().GenerateSignedEthTx(sender.Priv, evmtypes.EvmTxArgs{
+				ChainID:  evmChainID,
+				To:       &to,
+				Nonce:    suite.GetNetwork().App.EvmKeeper.GetNonce(suite.GetNetwork().GetContext(), sender.Addr),
+				Amount:   big.NewInt(10),
+				GasLimit: 100000,
+				GasPrice: big.NewInt(ethparams.InitialBaseFee),
+			})
+			suite.Require().NoError(err)
+
+			anteHandler := suite.GetAnteHandler()
+			_, err = anteHandler(suite.GetNetwork().GetContext(), tx, false)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -41,6 +41,10 @@ func (m MockFeemarketKeeper) GetParams(_ sdk.Context) (params feemarkettypes.Par
 	return feemarkettypes.DefaultParams()
 }
 
+func (m MockFeemarketKeeper) GetMinGasPrice(_ sdk.Context) math.LegacyDec {
+	return feemarkettypes.DefaultParams().MinGasPrice
+}
+
 func TestSDKTxFeeChecker(t *testing.T) {
 	// testCases:
 	//   fallback
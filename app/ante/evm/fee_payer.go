@@ -0,0 +1,176 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evm
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// FeePayer defines a third party account that pays the fees of an ethereum
+// transaction on behalf of its sender.
+type FeePayer struct {
+	// Address is the address of the account that pays the fees
+	Address common.Address
+	// Granter is true when the fees are paid through a feegrant allowance
+	// instead of an EIP-712 sponsorship signed by the fee payer
+	Granter bool
+}
+
+// GetFeePayer returns the third party account that pays the fees of the
+// ethereum transaction, or nil if they are paid by its sender. The fees can be
+// paid either by the signer of the EIP-712 sponsorship included in the
+// ethereum extension option, or by the fee granter of the Cosmos tx. As the
+// Cosmos tx isn't covered by the ethereum signature, the fee granter must be
+// signed by the sender through the fee options signature of the extension
+// option.
+//
+// NOTE: the sender of the message must be already verified.
+func GetFeePayer(tx sdk.Tx, ethMsg *evmtypes.MsgEthereumTx, chainID *big.Int) (*FeePayer, error) {
+	wrapperTx, ok := tx.(protoTxProvider)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid tx type %T, didn't implement interface protoTxProvider", tx)
+	}
+
+	protoTx := wrapperTx.GetProtoTx()
+	extOpt, err := getEthExtensionOption(protoTx.Body.ExtensionOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var sponsorship *evmtypes.FeeSponsorship
+	if extOpt != nil {
+		sponsorship = extOpt.Sponsorship
+	}
+
+	var granter string
+	if protoTx.AuthInfo != nil && protoTx.AuthInfo.Fee != nil {
+		granter = protoTx.AuthInfo.Fee.Granter
+	}
+
+	if sponsorship == nil && granter == "" {
+		return nil, nil
+	}
+
+	// NOTE: a sponsorship or grant covers the fees of the whole Cosmos tx,
+	// which are only checked against a single ethereum transaction
+	if len(tx.GetMsgs()) != 1 {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "sponsored eth tx must contain a single message")
+	}
+
+	var feePayer *FeePayer
+	switch {
+	case sponsorship != nil && granter != "":
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "eth tx can't define both a fee sponsorship and a fee granter")
+	case sponsorship != nil:
+		address, err := sponsorship.Verify(chainID, ethMsg.TxHash())
+		if err != nil {
+			return nil, err
+		}
+		feePayer = &FeePayer{Address: address}
+	default:
+		granterAddr, err := sdk.AccAddressFromBech32(granter)
+		if err != nil {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid fee granter %s: %s", granter, err)
+		}
+
+		if err := verifyFeeOptions(protoTx, extOpt, ethMsg, chainID); err != nil {
+			return nil, err
+		}
+
+		feePayer = &FeePayer{Address: common.BytesToAddress(granterAddr), Granter: true}
+	}
+
+	// the sender paying its own fees is not a sponsored transaction
+	if feePayer.Address == common.BytesToAddress(ethMsg.GetFrom()) {
+		return nil, nil
+	}
+
+	return feePayer, nil
+}
+
+// UseFeeAllowance consumes the fees from the feegrant allowance given by the
+// fee payer to the sender of the transaction. It's a no-op when the fees are
+// not paid through a feegrant allowance.
+func UseFeeAllowance(
+	ctx sdk.Context,
+	feegrantKeeper authante.FeegrantKeeper,
+	feePayer *FeePayer,
+	sender sdk.AccAddress,
+	fees sdk.Coins,
+	msgs []sdk.Msg,
+) error {
+	if feePayer == nil || !feePayer.Granter {
+		return nil
+	}
+
+	if feegrantKeeper == nil {
+		return errortypes.ErrInvalidRequest.Wrap("fee grants are not enabled")
+	}
+
+	// NOTE: the allowances are defined in the original decimals of the evm coin
+	if err := feegrantKeeper.UseGrantedFees(
+		ctx,
+		feePayer.Address.Bytes(),
+		sender,
+		evmtypes.ConvertCoinsFrom18Decimals(fees),
+		msgs,
+	); err != nil {
+		return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", sdk.AccAddress(feePayer.Address.Bytes()), sender)
+	}
+
+	return nil
+}
+
+// verifyFeeOptions checks that the fee options of the Cosmos tx, which are the
+// fee granter and the fee denom, are signed by the sender of the ethereum tx.
+//
+// NOTE: the sender of the message must be already verified.
+func verifyFeeOptions(
+	protoTx *tx.Tx,
+	extOpt *evmtypes.ExtensionOptionsEthereumTx,
+	ethMsg *evmtypes.MsgEthereumTx,
+	chainID *big.Int,
+) error {
+	var granter string
+	if protoTx.AuthInfo != nil && protoTx.AuthInfo.Fee != nil {
+		granter = protoTx.AuthInfo.Fee.Granter
+	}
+
+	var (
+		feeDenom  string
+		signature []byte
+	)
+	if extOpt != nil {
+		feeDenom = extOpt.FeeDenom
+		signature = extOpt.FeeOptionsSignature
+	}
+
+	sender := common.BytesToAddress(ethMsg.GetFrom())
+	return evmtypes.VerifyFeeOptions(chainID, ethMsg.TxHash(), sender, granter, feeDenom, signature)
+}
+
+// getEthExtensionOption returns the ethereum extension option of the tx, if any.
+func getEthExtensionOption(options []*codectypes.Any) (*evmtypes.ExtensionOptionsEthereumTx, error) {
+	typeURL := sdk.MsgTypeURL(&evmtypes.ExtensionOptionsEthereumTx{})
+	for _, option := range options {
+		if option.TypeUrl != typeURL {
+			continue
+		}
+
+		var extOpt evmtypes.ExtensionOptionsEthereumTx
+		if err := extOpt.Unmarshal(option.Value); err != nil {
+			return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "failed to unmarshal eth tx extension option")
+		}
+		return &extOpt, nil
+	}
+	return nil, nil
+}
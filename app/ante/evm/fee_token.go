@@ -0,0 +1,72 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evm
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// GetFeeToken returns the price of the EVM coin in the token used to pay the
+// fees of the ethereum transaction, or nil if they are paid in the EVM coin.
+// The fee token is defined on the ethereum extension option, must be signed by
+// the sender through the fee options signature and must be one of the token
+// pair denominations allowed by governance.
+//
+// NOTE: the sender of the message must be already verified.
+func GetFeeToken(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	ethMsg *evmtypes.MsgEthereumTx,
+	chainID *big.Int,
+	erc20Keeper Erc20Keeper,
+) (*sdk.DecCoin, error) {
+	wrapperTx, ok := tx.(protoTxProvider)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid tx type %T, didn't implement interface protoTxProvider", tx)
+	}
+
+	protoTx := wrapperTx.GetProtoTx()
+	extOpt, err := getEthExtensionOption(protoTx.Body.ExtensionOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if extOpt == nil || extOpt.FeeDenom == "" || extOpt.FeeDenom == evmtypes.GetEVMCoinDenom() {
+		return nil, nil
+	}
+
+	if erc20Keeper == nil {
+		return nil, errortypes.ErrInvalidRequest.Wrap("fee tokens are not enabled")
+	}
+
+	price, found := erc20Keeper.GetFeeTokenPrice(ctx, extOpt.FeeDenom)
+	if !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidCoins, "fees can't be paid in %s", extOpt.FeeDenom)
+	}
+
+	// NOTE: a fee token covers the fees of the whole Cosmos tx, which are only
+	// converted for a single ethereum transaction
+	if len(tx.GetMsgs()) != 1 {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "eth tx paying fees in a token must contain a single message")
+	}
+
+	// the Cosmos tx isn't covered by the ethereum signature, so the fee denom
+	// could be changed by anyone rewrapping the signed ethereum tx otherwise
+	if err := verifyFeeOptions(protoTx, extOpt, ethMsg, chainID); err != nil {
+		return nil, err
+	}
+
+	return &price, nil
+}
+
+// ConvertFeesToFeeToken converts the fees in the EVM coin, in its 18 decimals
+// representation, into the fee token with the given price.
+func ConvertFeesToFeeToken(fees sdk.Coins, price sdk.DecCoin) sdk.Coins {
+	amount := fees.AmountOf(evmtypes.GetEVMCoinDenom())
+	return sdk.NewCoins(evmtypes.ConvertToFeeToken(amount.BigInt(), price, true))
+}
@@ -26,6 +26,8 @@ type EVMKeeper interface { //nolint: revive
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
+	SetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash, feePayer common.Address)
+	SetTxFeeTokenTransient(ctx sdk.Context, txHash common.Hash, price sdk.DecCoin)
 	GetParams(ctx sdk.Context) evmtypes.Params
 	// GetBaseFee returns the BaseFee param from the fee market module
 	// adapted according to the evm denom decimals
@@ -37,11 +39,25 @@ type EVMKeeper interface { //nolint: revive
 
 type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
+	GetMinGasPrice(ctx sdk.Context) math.LegacyDec
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
 	GetBaseFeeEnabled(ctx sdk.Context) bool
 	GetBaseFee(ctx sdk.Context) math.LegacyDec
 }
 
+// Erc20Keeper defines the expected keeper interface used on the AnteHandler to
+// pay the fees of ethereum transactions in token pair denominations
+type Erc20Keeper interface {
+	GetFeeTokenPrice(ctx sdk.Context, denom string) (sdk.DecCoin, bool)
+}
+
+// Mempool defines the expected app-side mempool interface used on the
+// AnteHandler to replace the pending ethereum transactions
+type Mempool interface {
+	PendingTx(sender common.Address, nonce uint64) (common.Hash, bool)
+	CheckReplacement(sender common.Address, nonce uint64, gasTipCap, gasFeeCap *big.Int) error
+}
+
 type protoTxProvider interface {
 	GetProtoTx() *tx.Tx
 }
@@ -12,12 +12,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 
 	anteutils "github.com/evmos/evmos/v20/app/ante/utils"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
 	evmkeeper "github.com/evmos/evmos/v20/x/evm/keeper"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
@@ -33,6 +35,9 @@ type MonoDecorator struct {
 	evmKeeper          EVMKeeper
 	distributionKeeper anteutils.DistributionKeeper
 	stakingKeeper      anteutils.StakingKeeper
+	feegrantKeeper     authante.FeegrantKeeper
+	erc20Keeper        Erc20Keeper
+	mempool            Mempool
 	maxGasWanted       uint64
 }
 
@@ -58,6 +63,9 @@ func NewMonoDecorator(
 	evmKeeper EVMKeeper,
 	distributionKeeper anteutils.DistributionKeeper,
 	stakingKeeper anteutils.StakingKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
+	erc20Keeper Erc20Keeper,
+	mempool Mempool,
 	maxGasWanted uint64,
 ) MonoDecorator {
 	return MonoDecorator{
@@ -67,6 +75,9 @@ func NewMonoDecorator(
 		evmKeeper:          evmKeeper,
 		distributionKeeper: distributionKeeper,
 		stakingKeeper:      stakingKeeper,
+		feegrantKeeper:     feegrantKeeper,
+		erc20Keeper:        erc20Keeper,
+		mempool:            mempool,
 		maxGasWanted:       maxGasWanted,
 	}
 }
@@ -182,7 +193,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			}
 		}
 
-		if txData.TxType() == ethtypes.DynamicFeeTxType && decUtils.BaseFee != nil {
+		if (txData.TxType() == ethtypes.DynamicFeeTxType || txData.TxType() == evmtypes.SetCodeTxType) && decUtils.BaseFee != nil {
 			// If the base fee is not empty, we compute the effective gas price
 			// according to current base fee price. The gas limit is specified
 			// by the user, while the price is given by the minimum between the
@@ -218,17 +229,49 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		from := ethMsg.GetFrom()
 		fromAddr := common.BytesToAddress(from)
 
+		// the sender of a contract creation must be permitted by the deployment policy
+		if err := CheckCreatePermission(
+			txData,
+			&decUtils.EvmParams.AccessControl,
+			fromAddr,
+		); err != nil {
+			return ctx, err
+		}
+
+		// the fees can be paid by a third party account on behalf of the sender
+		feePayer, err := GetFeePayer(tx, ethMsg, ethCfg.ChainID)
+		if err != nil {
+			return ctx, err
+		}
+
+		// the fees can be paid in a token pair denomination instead of the EVM coin
+		feeToken, err := GetFeeToken(ctx, tx, ethMsg, ethCfg.ChainID, md.erc20Keeper)
+		if err != nil {
+			return ctx, err
+		}
+
 		// 6. account balance verification
 		// We get the account with the balance from the EVM keeper because it is
 		// using a wrapper of the bank keeper as a dependency to scale all
 		// balances to 18 decimals.
 		account := md.evmKeeper.GetAccount(ctx, fromAddr)
+		if account != nil && account.IsContract() {
+			// the accounts delegated through an EIP-7702 authorization keep
+			// sending transactions as EOAs
+			code := md.evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash))
+			if _, ok := ethutils.ParseDelegation(code); ok {
+				eoa := *account
+				eoa.CodeHash = evmtypes.EmptyCodeHash
+				account = &eoa
+			}
+		}
 		if err := VerifyAccountBalance(
 			ctx,
 			md.accountKeeper,
 			account,
 			fromAddr,
 			txData,
+			feePayer != nil || feeToken != nil,
 		); err != nil {
 			return ctx, err
 		}
@@ -286,6 +329,23 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
+		if feeToken != nil {
+			// the leftover gas is refunded in the fee token after the execution
+			msgFees = ConvertFeesToFeeToken(msgFees, *feeToken)
+			md.evmKeeper.SetTxFeeTokenTransient(ctx, ethMsg.TxHash(), *feeToken)
+		}
+
+		payer := from
+		if feePayer != nil {
+			if err := UseFeeAllowance(ctx, md.feegrantKeeper, feePayer, from, msgFees, msgs); err != nil {
+				return ctx, err
+			}
+
+			// the leftover gas is refunded to the fee payer after the execution
+			payer = feePayer.Address.Bytes()
+			md.evmKeeper.SetTxFeePayerTransient(ctx, ethMsg.TxHash(), feePayer.Address)
+		}
+
 		err = ConsumeFeesAndEmitEvent(
 			ctx,
 			&ConsumeGasKeepers{
@@ -295,7 +355,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 				Staking:      md.stakingKeeper,
 			},
 			msgFees,
-			from,
+			payer,
 		)
 		if err != nil {
 			return ctx, err
@@ -324,10 +384,16 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		// current message.
 		decUtils.TxGasLimit += gas
 
-		// 10. increment sequence
-		if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
+		// 10. increment sequence, unless the tx replaces a pending tx with the same nonce
+		replacement, err := CheckReplacement(ctx, md.mempool, acc, fromAddr, ethMsg.TxHash(), txData)
+		if err != nil {
 			return ctx, err
 		}
+		if !replacement {
+			if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
+				return ctx, err
+			}
+		}
 
 		// 11. gas wanted
 		if err := CheckGasWanted(ctx, md.feeMarketKeeper, tx, decUtils.Rules.IsLondon); err != nil {
@@ -22,20 +22,35 @@ import (
 // HandlerOptions defines the list of module keepers required to run the Evmos
 // AnteHandler decorators.
 type HandlerOptions struct {
-	Cdc                    codec.BinaryCodec
-	AccountKeeper          evmtypes.AccountKeeper
-	BankKeeper             evmtypes.BankKeeper
-	DistributionKeeper     anteutils.DistributionKeeper
-	IBCKeeper              *ibckeeper.Keeper
-	StakingKeeper          anteutils.StakingKeeper
-	FeeMarketKeeper        evmante.FeeMarketKeeper
-	EvmKeeper              evmante.EVMKeeper
-	FeegrantKeeper         ante.FeegrantKeeper
+	Cdc                codec.BinaryCodec
+	AccountKeeper      evmtypes.AccountKeeper
+	BankKeeper         evmtypes.BankKeeper
+	DistributionKeeper anteutils.DistributionKeeper
+	IBCKeeper          *ibckeeper.Keeper
+	StakingKeeper      anteutils.StakingKeeper
+	FeeMarketKeeper    evmante.FeeMarketKeeper
+	EvmKeeper          evmante.EVMKeeper
+	FeegrantKeeper     ante.FeegrantKeeper
+	// Erc20Keeper is used to pay the fees of Ethereum transactions in token
+	// pair denominations. The fees can only be paid in the EVM coin if it's nil.
+	Erc20Keeper evmante.Erc20Keeper
+	// Mempool is the app-side mempool used to replace the pending Ethereum
+	// transactions by fee bump. The replacements are rejected if it's nil.
+	Mempool                evmante.Mempool
 	ExtensionOptionChecker ante.ExtensionOptionChecker
 	SignModeHandler        *txsigning.HandlerMap
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
 	MaxTxGasWanted         uint64
 	TxFeeChecker           ante.TxFeeChecker
+	// PriorityMsgTypes defines the type URLs of the Cosmos messages of the
+	// priority lane, whose transactions are ranked above the rest on CheckTx.
+	PriorityMsgTypes []string
+	// CosmosDecorators defines the decorators of the Cosmos transactions ante
+	// handler. The DefaultCosmosDecorators are used if it's nil.
+	CosmosDecorators *DecoratorRegistry
+	// EVMDecorators defines the decorators of the Ethereum transactions ante
+	// handler. The DefaultEVMDecorators are used if it's nil.
+	EVMDecorators *DecoratorRegistry
 }
 
 // Validate checks if the keepers are defined
@@ -73,5 +88,35 @@ func (options HandlerOptions) Validate() error {
 	if options.TxFeeChecker == nil {
 		return errorsmod.Wrap(errortypes.ErrLogic, "tx fee checker is required for AnteHandler")
 	}
+	if err := options.priorityLane().Validate(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrLogic, "invalid priority lane: %s", err)
+	}
+	if err := options.cosmosDecorators().Validate(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrLogic, "invalid cosmos ante decorators: %s", err)
+	}
+	if err := options.evmDecorators().Validate(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrLogic, "invalid evm ante decorators: %s", err)
+	}
 	return nil
 }
+
+// cosmosDecorators returns the decorators of the Cosmos transactions ante handler
+func (options HandlerOptions) cosmosDecorators() *DecoratorRegistry {
+	if options.CosmosDecorators == nil {
+		return DefaultCosmosDecorators()
+	}
+	return options.CosmosDecorators
+}
+
+// priorityLane returns the priority lane of the priority message types
+func (options HandlerOptions) priorityLane() PriorityLane {
+	return NewPriorityLane(options.PriorityMsgTypes...)
+}
+
+// evmDecorators returns the decorators of the Ethereum transactions ante handler
+func (options HandlerOptions) evmDecorators() *DecoratorRegistry {
+	if options.EVMDecorators == nil {
+		return DefaultEVMDecorators()
+	}
+	return options.EVMDecorators
+}
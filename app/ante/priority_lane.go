@@ -0,0 +1,70 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ante
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// LanePriority is the lowest CheckTx priority of the transactions of the
+// priority lane. The priority of the rest of the transactions is capped below it.
+const LanePriority int64 = math.MaxInt64 / 2
+
+// PriorityLane ranks the Cosmos transactions whose messages are all of the lane
+// message types above the rest of the transactions, including the Ethereum
+// ones, so that the critical protocol messages aren't crowded out of the
+// proposals during gas spikes. The priority is only used to select the
+// transactions by the app-side mempool.
+type PriorityLane struct {
+	msgTypes map[string]struct{}
+}
+
+// NewPriorityLane creates a new priority lane of the given message type URLs.
+func NewPriorityLane(msgTypeURLs ...string) PriorityLane {
+	msgTypes := make(map[string]struct{}, len(msgTypeURLs))
+	for _, typeURL := range msgTypeURLs {
+		msgTypes[typeURL] = struct{}{}
+	}
+	return PriorityLane{msgTypes: msgTypes}
+}
+
+// Validate returns an error if an Ethereum transaction message is in the lane.
+func (l PriorityLane) Validate() error {
+	if _, ok := l.msgTypes[sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})]; ok {
+		return fmt.Errorf("ethereum transactions cannot be in the priority lane")
+	}
+	return nil
+}
+
+// Contains returns true if all the messages of the tx are of the lane types.
+func (l PriorityLane) Contains(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(l.msgTypes) == 0 || len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if _, ok := l.msgTypes[sdk.MsgTypeURL(msg)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Priority returns the priority of the tx on the lane. The fee priority of the
+// lane transactions is added to the lane priority, so that they are still
+// ranked by their fees among them.
+func (l PriorityLane) Priority(tx sdk.Tx, priority int64) int64 {
+	if len(l.msgTypes) == 0 {
+		return priority
+	}
+	if !l.Contains(tx) {
+		return min(priority, LanePriority-1)
+	}
+	return LanePriority + max(min(priority, LanePriority), 0)
+}
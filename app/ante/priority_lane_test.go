@@ -0,0 +1,48 @@
+package ante_test
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"github.com/evmos/evmos/v20/app/ante"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// msgsTx is a tx with the given messages.
+type msgsTx []sdk.Msg
+
+func (tx msgsTx) GetMsgs() []sdk.Msg { return tx }
+
+func (tx msgsTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func TestPriorityLane(t *testing.T) {
+	lane := ante.NewPriorityLane(sdk.MsgTypeURL(&govv1.MsgVote{}))
+
+	testCases := []struct {
+		name        string
+		lane        ante.PriorityLane
+		tx          sdk.Tx
+		priority    int64
+		expPriority int64
+	}{
+		{"empty lane", ante.NewPriorityLane(), msgsTx{&banktypes.MsgSend{}}, math.MaxInt64, math.MaxInt64},
+		{"lane tx", lane, msgsTx{&govv1.MsgVote{}}, 10, ante.LanePriority + 10},
+		{"lane tx with the max priority", lane, msgsTx{&govv1.MsgVote{}, &govv1.MsgVote{}}, math.MaxInt64, math.MaxInt64 - 1},
+		{"tx with a message out of the lane", lane, msgsTx{&govv1.MsgVote{}, &banktypes.MsgSend{}}, 10, 10},
+		{"eth tx with the max priority", lane, &evmtypes.MsgEthereumTx{}, math.MaxInt64, ante.LanePriority - 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expPriority, tc.lane.Priority(tc.tx, tc.priority))
+		})
+	}
+
+	require.Error(t, ante.NewPriorityLane(sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})).Validate())
+}
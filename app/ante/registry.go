@@ -0,0 +1,152 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ante
+
+import (
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DecoratorFactory creates an ante decorator from the handler options.
+type DecoratorFactory func(options HandlerOptions) sdk.AnteDecorator
+
+// NamedDecorator defines an ante decorator of a chain identified by its name.
+type NamedDecorator struct {
+	// Name identifies the decorator on the registry
+	Name string
+	// Factory creates the decorator from the handler options
+	Factory DecoratorFactory
+	// After defines the names of the decorators that must run before this one
+	After []string
+}
+
+// DecoratorRegistry defines the ordered list of decorators that are chained
+// into an ante handler. It allows the chains built on top of Evmos to append,
+// insert, replace or remove decorators of the default chains without copying
+// them, while the ordering constraints of each decorator are validated when
+// the ante handler options are validated.
+type DecoratorRegistry struct {
+	decorators []NamedDecorator
+}
+
+// NewDecoratorRegistry creates a new registry with the given decorators in order.
+func NewDecoratorRegistry(decorators ...NamedDecorator) *DecoratorRegistry {
+	return &DecoratorRegistry{decorators: slices.Clone(decorators)}
+}
+
+// Names returns the names of the registered decorators in order.
+func (r *DecoratorRegistry) Names() []string {
+	names := make([]string, len(r.decorators))
+	for i, decorator := range r.decorators {
+		names[i] = decorator.Name
+	}
+	return names
+}
+
+// Append adds the decorator at the end of the chain.
+func (r *DecoratorRegistry) Append(decorator NamedDecorator) error {
+	return r.insert(len(r.decorators), decorator)
+}
+
+// InsertBefore adds the decorator to the chain right before the decorator with the given name.
+func (r *DecoratorRegistry) InsertBefore(name string, decorator NamedDecorator) error {
+	i, err := r.index(name)
+	if err != nil {
+		return err
+	}
+	return r.insert(i, decorator)
+}
+
+// InsertAfter adds the decorator to the chain right after the decorator with the given name.
+func (r *DecoratorRegistry) InsertAfter(name string, decorator NamedDecorator) error {
+	i, err := r.index(name)
+	if err != nil {
+		return err
+	}
+	return r.insert(i+1, decorator)
+}
+
+// Replace changes the factory of the decorator with the given name, keeping
+// its position and ordering constraints.
+func (r *DecoratorRegistry) Replace(name string, factory DecoratorFactory) error {
+	i, err := r.index(name)
+	if err != nil {
+		return err
+	}
+	if factory == nil {
+		return fmt.Errorf("nil factory for ante decorator %s", name)
+	}
+	r.decorators[i].Factory = factory
+	return nil
+}
+
+// Remove deletes the decorator with the given name from the chain.
+func (r *DecoratorRegistry) Remove(name string) error {
+	i, err := r.index(name)
+	if err != nil {
+		return err
+	}
+	r.decorators = slices.Delete(r.decorators, i, i+1)
+	return nil
+}
+
+// Validate checks that the decorators have unique names and factories, and
+// that the decorators each one must run after are registered before it.
+func (r *DecoratorRegistry) Validate() error {
+	positions := make(map[string]int, len(r.decorators))
+	for i, decorator := range r.decorators {
+		if decorator.Name == "" {
+			return fmt.Errorf("empty name for ante decorator at position %d", i)
+		}
+		if decorator.Factory == nil {
+			return fmt.Errorf("nil factory for ante decorator %s", decorator.Name)
+		}
+		if _, ok := positions[decorator.Name]; ok {
+			return fmt.Errorf("duplicate ante decorator %s", decorator.Name)
+		}
+		positions[decorator.Name] = i
+	}
+
+	for i, decorator := range r.decorators {
+		for _, name := range decorator.After {
+			position, ok := positions[name]
+			if !ok {
+				return fmt.Errorf("ante decorator %s must run after %s, which is not registered", decorator.Name, name)
+			}
+			if position > i {
+				return fmt.Errorf("ante decorator %s must run after %s", decorator.Name, name)
+			}
+		}
+	}
+	return nil
+}
+
+// AnteHandler chains the registered decorators created from the handler options.
+func (r *DecoratorRegistry) AnteHandler(options HandlerOptions) sdk.AnteHandler {
+	decorators := make([]sdk.AnteDecorator, len(r.decorators))
+	for i, decorator := range r.decorators {
+		decorators[i] = decorator.Factory(options)
+	}
+	return sdk.ChainAnteDecorators(decorators...)
+}
+
+func (r *DecoratorRegistry) index(name string) (int, error) {
+	i := slices.IndexFunc(r.decorators, func(decorator NamedDecorator) bool {
+		return decorator.Name == name
+	})
+	if i < 0 {
+		return 0, fmt.Errorf("ante decorator %s is not registered", name)
+	}
+	return i, nil
+}
+
+func (r *DecoratorRegistry) insert(i int, decorator NamedDecorator) error {
+	if _, err := r.index(decorator.Name); err == nil {
+		return fmt.Errorf("duplicate ante decorator %s", decorator.Name)
+	}
+	r.decorators = slices.Insert(r.decorators, i, decorator)
+	return nil
+}
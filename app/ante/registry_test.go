@@ -0,0 +1,61 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/app/ante"
+)
+
+type noopDecorator struct{}
+
+func (noopDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate)
+}
+
+func newNoopDecorator(name string, after ...string) ante.NamedDecorator {
+	return ante.NamedDecorator{
+		Name:    name,
+		Factory: func(ante.HandlerOptions) sdk.AnteDecorator { return noopDecorator{} },
+		After:   after,
+	}
+}
+
+func TestDecoratorRegistry(t *testing.T) {
+	registry := ante.NewDecoratorRegistry(newNoopDecorator("a"), newNoopDecorator("c", "a"))
+	require.NoError(t, registry.Validate())
+
+	require.NoError(t, registry.InsertAfter("a", newNoopDecorator("b", "a")))
+	require.NoError(t, registry.InsertBefore("a", newNoopDecorator("first")))
+	require.NoError(t, registry.Append(newNoopDecorator("last", "c")))
+	require.Equal(t, []string{"first", "a", "b", "c", "last"}, registry.Names())
+	require.NoError(t, registry.Validate())
+
+	require.ErrorContains(t, registry.Append(newNoopDecorator("a")), "duplicate ante decorator a")
+	require.ErrorContains(t, registry.InsertAfter("unknown", newNoopDecorator("d")), "ante decorator unknown is not registered")
+	require.ErrorContains(t, registry.Replace("a", nil), "nil factory")
+	require.NoError(t, registry.Replace("a", func(ante.HandlerOptions) sdk.AnteDecorator { return noopDecorator{} }))
+
+	// removing a decorator that others must run after breaks the constraints
+	require.NoError(t, registry.Remove("a"))
+	require.ErrorContains(t, registry.Validate(), "ante decorator b must run after a, which is not registered")
+
+	// inserting a decorator before the one it must run after breaks the constraints
+	registry = ante.NewDecoratorRegistry(newNoopDecorator("a"))
+	require.NoError(t, registry.InsertBefore("a", newNoopDecorator("b", "a")))
+	require.ErrorContains(t, registry.Validate(), "ante decorator b must run after a")
+
+	registry = ante.NewDecoratorRegistry(newNoopDecorator(""))
+	require.ErrorContains(t, registry.Validate(), "empty name")
+}
+
+func TestDefaultDecorators(t *testing.T) {
+	require.NoError(t, ante.DefaultCosmosDecorators().Validate())
+	require.NoError(t, ante.DefaultEVMDecorators().Validate())
+
+	registry := ante.DefaultCosmosDecorators()
+	require.NoError(t, registry.Remove(ante.DecoratorSetPubKey))
+	require.Error(t, registry.Validate())
+}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"sort"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
@@ -34,6 +35,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
@@ -92,6 +94,7 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	sdkstaking "github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibccallbacks "github.com/cosmos/ibc-go/modules/apps/callbacks"
 	"github.com/cosmos/ibc-go/modules/capability"
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
@@ -140,6 +143,7 @@ import (
 
 	"github.com/evmos/evmos/v20/app/ante"
 	ethante "github.com/evmos/evmos/v20/app/ante/evm"
+	evmosmempool "github.com/evmos/evmos/v20/app/mempool"
 	"github.com/evmos/evmos/v20/app/post"
 	v20 "github.com/evmos/evmos/v20/app/upgrades/v20"
 	srvflags "github.com/evmos/evmos/v20/server/flags"
@@ -276,6 +280,13 @@ type Evmos struct {
 	// queryMultistore used on versionDB build
 	qms storetypes.MultiStore
 
+	// traceDB stores the call traces of the EVM live tracer, if enabled
+	traceDB dbm.DB
+
+	// blockEthTxs holds the eth txs of the block being finalized, which are
+	// pre-executed after the begin blockers
+	blockEthTxs []*evmtypes.MsgEthereumTx
+
 	tpsCounter *tpsCounter
 }
 
@@ -306,9 +317,16 @@ func NewEvmos(
 
 	// Setup Mempool and Proposal Handlers
 	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
-		mempool := mempool.NoOpMempool{}
-		app.SetMempool(mempool)
-		handler := baseapp.NewDefaultProposalHandler(mempool, app)
+		var appMempool mempool.Mempool = mempool.NoOpMempool{}
+		// the app-side mempool allows replacing the pending eth txs by fee bump
+		if cast.ToBool(appOpts.Get(srvflags.EVMMempool)) {
+			appMempool = evmosmempool.NewMempool(
+				cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriceBump)),
+				cast.ToInt(appOpts.Get(srvflags.EVMMempoolMaxTxs)),
+			)
+		}
+		app.SetMempool(appMempool)
+		handler := baseapp.NewDefaultProposalHandler(appMempool, app)
 		app.SetPrepareProposal(handler.PrepareProposalHandler())
 		app.SetProcessProposal(handler.ProcessProposalHandler())
 	})
@@ -447,7 +465,23 @@ func NewEvmos(
 		// is being developed.
 		&app.Erc20Keeper,
 		tracer, app.GetSubspace(evmtypes.ModuleName),
-	)
+	).WithGasReport(cast.ToBool(appOpts.Get(srvflags.EVMGasReport))).
+		WithTelemetry(cast.ToBool(appOpts.Get(srvflags.EVMTelemetry))).
+		WithParallelExecution(cast.ToInt(appOpts.Get(srvflags.EVMParallelExecutionWorkers)))
+
+	// open the call traces store of the EVM live tracer, if enabled
+	if cast.ToBool(appOpts.Get(srvflags.EVMLiveTracer)) {
+		traceDB, err := dbm.NewDB("evmtraces", server.GetAppDBBackend(appOpts), filepath.Join(homePath, "data"))
+		if err != nil {
+			panic(errorsmod.Wrap(err, "error on EVM trace store setup"))
+		}
+		app.traceDB = traceDB
+		evmKeeper.WithTraceStore(traceDB, cast.ToUint64(appOpts.Get(srvflags.EVMLiveTracerRetainBlocks)))
+	}
+	// stream the events of the committed blocks to the gRPC subscribers, if enabled
+	if cast.ToBool(appOpts.Get(srvflags.EVMEventStream)) {
+		evmKeeper.WithEventStream(txConfig.TxDecoder(), logger)
+	}
 	app.EvmKeeper = evmKeeper
 
 	// Create IBC Keeper
@@ -492,6 +526,18 @@ func NewEvmos(
 		app.AccountKeeper, app.BankKeeper, app.EvmKeeper, app.StakingKeeper,
 		app.AuthzKeeper, &app.TransferKeeper,
 	)
+	// NOTE: the erc20 hooks must be set before the keeper is passed by value to other modules
+	app.Erc20Keeper.SetHooks(
+		erc20keeper.NewMultiErc20Hooks(
+		// insert erc20 hooks receivers here
+		),
+	)
+	// reject the bank sends of paused token pairs
+	app.BankKeeper.AppendSendRestriction(app.Erc20Keeper.PausedSendRestriction)
+	// limit the conversions and the bridge transfers of the token pairs
+	app.BankKeeper.AppendSendRestriction(app.Erc20Keeper.RateLimitSendRestriction)
+	// track the holders of the token pairs on the bank sends
+	app.BankKeeper.AppendSendRestriction(app.Erc20Keeper.TrackHoldersSendRestriction)
 
 	// Create the rate limit keeper
 	app.RateLimitKeeper = *ratelimitkeeper.NewKeeper(
@@ -527,9 +573,25 @@ func NewEvmos(
 		),
 	)
 
+	// create evidence keeper with router
+	evidenceKeeper := evidencekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[evidencetypes.StoreKey]),
+		&app.StakingKeeper,
+		app.SlashingKeeper,
+		app.AccountKeeper.AddressCodec(),
+		runtime.ProvideCometInfoService(),
+	)
+	// If evidence needs to be handled for the app, set routes in router here and seal.
+	// NOTE: the router is set even if it's empty so that the evidence submitted
+	// through txs is rejected instead of panicking. The equivocation evidence is
+	// only trusted when reported by CometBFT, so no handler is registered for it.
+	evidenceKeeper.SetRouter(evidencetypes.NewRouter())
+	app.EvidenceKeeper = *evidenceKeeper
+
 	// We call this after setting the hooks to ensure that the hooks are set on the keeper
-	evmKeeper.WithStaticPrecompiles(
-		evmkeeper.NewAvailableStaticPrecompiles(
+	evmKeeper.WithPrecompileRegistry(
+		evmkeeper.NewStaticPrecompileRegistry(
 			*stakingKeeper,
 			app.DistrKeeper,
 			app.BankKeeper,
@@ -538,7 +600,13 @@ func NewEvmos(
 			app.AuthzKeeper,
 			app.TransferKeeper,
 			app.IBCKeeper.ChannelKeeper,
+			app.RateLimitKeeper,
 			app.GovKeeper,
+			app.SlashingKeeper,
+			app.EvidenceKeeper,
+			app.FeeGrantKeeper,
+			evmKeeper,
+			appCodec,
 		),
 	)
 
@@ -567,21 +635,26 @@ func NewEvmos(
 
 		transfer stack contains (from bottom to top):
 			- ERC-20 Middleware
-		 	- Recovery Middleware
+			- Rate Limit Middleware
+			- IBC Callbacks Middleware
 			- IBC Transfer
 
 		SendPacket, since it is originating from the application to core IBC:
-		 	transferKeeper.SendPacket -> claim.SendPacket -> recovery.SendPacket -> erc20.SendPacket -> channel.SendPacket
+		 	transferKeeper.SendPacket -> callbacks.SendPacket -> ratelimit.SendPacket -> channel.SendPacket
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-			channel.RecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
+			channel.RecvPacket -> erc20.OnRecvPacket -> ratelimit.OnRecvPacket -> callbacks.OnRecvPacket -> transfer.OnRecvPacket
 	*/
 
 	// create IBC module from top to bottom of stack
 	var transferStack porttypes.IBCModule
 
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
-	transferStack = ratelimit.NewIBCMiddleware(app.RateLimitKeeper, transferStack)
+	// the callbacks middleware wraps the transfer keeper's ICS4Wrapper to call
+	// back the EVM contracts that sent the packets on their acknowledgement or timeout
+	callbacksStack := ibccallbacks.NewIBCMiddleware(transferStack, app.RateLimitKeeper, app.EvmKeeper, evmkeeper.MaxIBCCallbackGas)
+	app.TransferKeeper.WithICS4Wrapper(callbacksStack)
+	transferStack = ratelimit.NewIBCMiddleware(app.RateLimitKeeper, callbacksStack)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
 
 	// Create static IBC router, add transfer route, then set and seal it
@@ -592,18 +665,6 @@ func NewEvmos(
 
 	app.IBCKeeper.SetRouter(ibcRouter)
 
-	// create evidence keeper with router
-	evidenceKeeper := evidencekeeper.NewKeeper(
-		appCodec,
-		runtime.NewKVStoreService(keys[evidencetypes.StoreKey]),
-		&app.StakingKeeper,
-		app.SlashingKeeper,
-		app.AccountKeeper.AddressCodec(),
-		runtime.ProvideCometInfoService(),
-	)
-	// If evidence needs to be handled for the app, set routes in router here and seal
-	app.EvidenceKeeper = *evidenceKeeper
-
 	/****  Module Options ****/
 
 	// NOTE: Any module instantiated in the module manager that is later modified
@@ -696,6 +757,7 @@ func NewEvmos(
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
 		evmtypes.ModuleName,
+		erc20types.ModuleName,
 		feemarkettypes.ModuleName,
 		feegrant.ModuleName,
 	)
@@ -775,6 +837,14 @@ func NewEvmos(
 		os.Exit(1)
 	}
 
+	// add the EVM event stream to the ABCI listeners, after the state streaming
+	// services that replace the streaming manager
+	if eventStream := app.EvmKeeper.EventStream(); eventStream != nil {
+		sm := app.StreamingManager()
+		sm.ABCIListeners = append(sm.ABCIListeners, eventStream)
+		app.SetStreamingManager(sm)
+	}
+
 	// wire up the versiondb's `StreamingService` and `MultiStore`.
 	if cast.ToBool(appOpts.Get("versiondb.enable")) {
 		app.qms, err = app.setupVersionDB(homePath, keys, tkeys, memKeys)
@@ -791,9 +861,13 @@ func NewEvmos(
 
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
 
-	app.setAnteHandler(app.txConfig, maxGasWanted)
+	priorityMsgTypes := cast.ToStringSlice(appOpts.Get(srvflags.EVMMempoolPriorityMsgTypes))
+
+	app.setAnteHandler(app.txConfig, maxGasWanted, priorityMsgTypes)
 	app.setPostHandler()
 	app.SetEndBlocker(app.EndBlocker)
+	app.SetPrecommiter(app.Precommiter)
+	app.SetPrepareCheckStater(app.PrepareCheckStater)
 	app.setupUpgradeHandlers()
 
 	// At startup, after all modules have been registered, check that all prot
@@ -809,6 +883,12 @@ func NewEvmos(
 		fmt.Fprintln(os.Stderr, err.Error())
 	}
 
+	// NOTE: the EVM module doesn't register a snapshot extension. The contract
+	// code and storage are kept in the EVM store, which is exported with all the
+	// other stores in the multistore state-sync snapshot. CometBFT chunks that
+	// snapshot, verifies the chunk hashes and checks the restored app hash
+	// against the light client.
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			logger.Error("error on loading last version", "err", err)
@@ -850,7 +930,7 @@ func NewEvmos(
 // Name returns the name of the App
 func (app *Evmos) Name() string { return app.BaseApp.Name() }
 
-func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64, priorityMsgTypes []string) {
 	options := ante.HandlerOptions{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
@@ -859,6 +939,7 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		EvmKeeper:              app.EvmKeeper,
 		StakingKeeper:          app.StakingKeeper,
 		FeegrantKeeper:         app.FeeGrantKeeper,
+		Erc20Keeper:            app.Erc20Keeper,
 		DistributionKeeper:     app.DistrKeeper,
 		IBCKeeper:              app.IBCKeeper,
 		FeeMarketKeeper:        app.FeeMarketKeeper,
@@ -866,6 +947,15 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
 		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.FeeMarketKeeper),
+		PriorityMsgTypes:       priorityMsgTypes,
+	}
+	if mempool, ok := app.Mempool().(*evmosmempool.Mempool); ok {
+		options.Mempool = mempool
+	}
+	for _, typeURL := range priorityMsgTypes {
+		if _, err := app.interfaceRegistry.Resolve(typeURL); err != nil {
+			panic(fmt.Errorf("invalid priority msg type %s: %w", typeURL, err))
+		}
 	}
 
 	if err := options.Validate(); err != nil {
@@ -892,7 +982,17 @@ func (app *Evmos) setPostHandler() {
 // of the new block for every registered module. If there is a registered fork at the current height,
 // BeginBlocker will schedule the upgrade plan and perform the state migration (if any).
 func (app *Evmos) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
-	return app.mm.BeginBlock(ctx)
+	res, err := app.mm.BeginBlock(ctx)
+	if err != nil {
+		return res, err
+	}
+
+	// pre-execute the eth txs of the block in parallel once the base fee of
+	// the block is set, which is a no-op if the parallel execution is disabled
+	txs := app.blockEthTxs
+	app.blockEthTxs = nil
+	app.EvmKeeper.PreExecuteTxs(ctx, app.CommitMultiStore(), txs)
+	return res, nil
 }
 
 // EndBlocker updates every end block
@@ -900,6 +1000,24 @@ func (app *Evmos) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
 	return app.mm.EndBlock(ctx)
 }
 
+// Precommiter runs the precommit logic of the modules before the block is
+// committed.
+func (app *Evmos) Precommiter(ctx sdk.Context) {
+	if err := app.mm.Precommit(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// PrepareCheckStater evicts the stale txs of the app-side mempool on the check
+// state of the committed block.
+func (app *Evmos) PrepareCheckStater(ctx sdk.Context) {
+	if appMempool, ok := app.Mempool().(*evmosmempool.Mempool); ok {
+		if removed := appMempool.RemoveStaleTxs(ctx, app.AccountKeeper); removed > 0 {
+			app.Logger().Debug("evicted stale txs from the mempool", "count", removed)
+		}
+	}
+}
+
 // The DeliverTx method is intentionally decomposed to calculate the transactions per second.
 func (app *Evmos) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.ResponseFinalizeBlock, err error) {
 	defer func() {
@@ -931,8 +1049,36 @@ func (app *Evmos) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abc
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
-func (app *Evmos) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-	return app.mm.PreBlock(ctx)
+func (app *Evmos) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	res, err := app.mm.PreBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the eth txs of the block are pre-executed after the begin blockers
+	if app.EvmKeeper.ParallelExecutionEnabled() {
+		app.blockEthTxs = app.ethereumTxs(req.Txs)
+	}
+	return res, nil
+}
+
+// ethereumTxs returns the eth tx messages of the given block txs. The txs
+// that can't be decoded are skipped, as they fail on their delivery.
+func (app *Evmos) ethereumTxs(txs [][]byte) []*evmtypes.MsgEthereumTx {
+	var ethTxs []*evmtypes.MsgEthereumTx
+	for _, bz := range txs {
+		tx, err := app.txConfig.TxDecoder()(bz)
+		if err != nil {
+			continue
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				ethTxs = append(ethTxs, ethMsg)
+			}
+		}
+	}
+	return ethTxs
 }
 
 // LoadHeight loads state at a particular height
@@ -981,6 +1127,9 @@ func (app *Evmos) BlockedAddrs() map[string]bool {
 	for _, addr := range vm.PrecompiledAddressesBerlin {
 		blockedPrecompilesHex = append(blockedPrecompilesHex, addr.Hex())
 	}
+	for _, addr := range vm.PrecompiledAddressesBLS {
+		blockedPrecompilesHex = append(blockedPrecompilesHex, addr.Hex())
+	}
 
 	for _, precompile := range blockedPrecompilesHex {
 		blockedAddrs[utils.EthHexToCosmosAddr(precompile).String()] = true
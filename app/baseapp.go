@@ -22,6 +22,11 @@ func (app *Evmos) Close() error {
 		errs = append(errs, closer.Close())
 	}
 
+	// the call traces of the EVM live tracer
+	if app.traceDB != nil {
+		errs = append(errs, app.traceDB.Close())
+	}
+
 	err := errors.Join(errs...)
 	app.BaseApp.Logger().Info("Application gracefully shutdown", "error", err)
 	return err
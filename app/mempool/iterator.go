@@ -0,0 +1,56 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package mempool
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ sdkmempool.Iterator = &iterator{}
+
+// iterator iterates over the selected transactions of the mempool.
+type iterator struct {
+	txs []sdk.Tx
+}
+
+// Next returns the iterator of the next transaction, or nil at the end.
+func (it *iterator) Next() sdkmempool.Iterator {
+	if len(it.txs) <= 1 {
+		return nil
+	}
+	return &iterator{txs: it.txs[1:]}
+}
+
+// Tx returns the current transaction.
+func (it *iterator) Tx() sdk.Tx {
+	return it.txs[0]
+}
+
+// senderQueues is a max-heap of the nonce ordered transactions of the senders
+// by the priority of their next transaction. The transaction inserted first
+// has precedence on the priority ties.
+type senderQueues [][]*pooledTx
+
+func (q senderQueues) Len() int { return len(q) }
+
+func (q senderQueues) Less(i, j int) bool {
+	a, b := q[i][0], q[j][0]
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+	return a.order < b.order
+}
+
+func (q senderQueues) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *senderQueues) Push(x any) { *q = append(*q, x.([]*pooledTx)) }
+
+func (q *senderQueues) Pop() any {
+	old := *q
+	n := len(old)
+	queue := old[n-1]
+	*q = old[:n-1]
+	return queue
+}
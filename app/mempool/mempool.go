@@ -0,0 +1,317 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package mempool
+
+import (
+	"cmp"
+	"container/heap"
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var _ sdkmempool.Mempool = &Mempool{}
+
+// AccountKeeper defines the account sequences used to evict the stale txs.
+type AccountKeeper interface {
+	GetSequence(ctx context.Context, addr sdk.AccAddress) (uint64, error)
+}
+
+// pooledTx is a transaction of the mempool with its sender, nonce and
+// priority. The hash and fee caps are only set for the ethereum transactions.
+type pooledTx struct {
+	tx       sdk.Tx
+	sender   string
+	nonce    uint64
+	priority int64
+	// order is the insertion order of the tx, which breaks the priority ties
+	order uint64
+
+	isEth     bool
+	hash      common.Hash
+	gasTipCap *big.Int
+	gasFeeCap *big.Int
+}
+
+// Mempool is the app-side mempool of the Cosmos and ethereum transactions.
+// The transactions of each sender are kept in nonce order, where the sender of
+// an ethereum tx and the signer of a Cosmos tx share the account sequence, and
+// the proposals select the next transaction of the senders by priority.
+//
+// A pending ethereum tx is replaced by a tx of the same sender and nonce only
+// if it bumps both the tip cap and the fee cap by the price bump percentage,
+// so that the stuck transactions can be replaced like on geth.
+//
+// The mempool holds at most maxTxs transactions, as the transactions dropped
+// by CometBFT are only evicted once their nonce is stale.
+type Mempool struct {
+	mtx sync.Mutex
+
+	priceBump       uint64
+	maxTxs          int
+	signerExtractor sdkmempool.SignerExtractionAdapter
+	senders         map[string]map[uint64]*pooledTx
+	count           int
+	order           uint64
+}
+
+// NewMempool creates a new mempool with the given price bump percentage
+// required to replace a pending ethereum tx and the max number of txs. A max
+// of zero disables the limit.
+func NewMempool(priceBump uint64, maxTxs int) *Mempool {
+	return &Mempool{
+		priceBump:       priceBump,
+		maxTxs:          maxTxs,
+		signerExtractor: sdkmempool.NewDefaultSignerExtractionAdapter(),
+		senders:         make(map[string]map[uint64]*pooledTx),
+	}
+}
+
+// Insert adds the tx to the mempool with the priority of the context. A Cosmos
+// tx overwrites the tx of its signer with the same sequence, while an ethereum
+// tx returns an error if it doesn't bump the fees of the pending ethereum tx
+// with the same nonce enough to replace it. A new tx is rejected when the
+// mempool is full.
+func (mp *Mempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	ptx, err := mp.newPooledTx(tx)
+	if err != nil {
+		return err
+	}
+	ptx.priority = sdk.UnwrapSDKContext(goCtx).Priority()
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	txs, ok := mp.senders[ptx.sender]
+	if !ok {
+		txs = make(map[uint64]*pooledTx)
+		mp.senders[ptx.sender] = txs
+	}
+
+	if pending, ok := txs[ptx.nonce]; ok {
+		if pending.isEth && ptx.isEth {
+			if pending.hash == ptx.hash {
+				return nil
+			}
+			if err := mp.checkReplacement(pending, ptx.gasTipCap, ptx.gasFeeCap); err != nil {
+				return err
+			}
+		}
+	} else {
+		if mp.maxTxs > 0 && mp.count >= mp.maxTxs {
+			return sdkmempool.ErrMempoolTxMaxCapacity
+		}
+		mp.count++
+	}
+
+	mp.order++
+	ptx.order = mp.order
+	txs[ptx.nonce] = ptx
+	return nil
+}
+
+// Select returns an iterator over a snapshot of the mempool transactions. The
+// next transaction of the sender with the highest priority is selected at each
+// step, so that the transactions of each sender are in nonce order.
+func (mp *Mempool) Select(_ context.Context, _ [][]byte) sdkmempool.Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	queues := make(senderQueues, 0, len(mp.senders))
+	for _, txs := range mp.senders {
+		queue := make([]*pooledTx, 0, len(txs))
+		for _, ptx := range txs {
+			queue = append(queue, ptx)
+		}
+		slices.SortFunc(queue, func(a, b *pooledTx) int {
+			return cmp.Compare(a.nonce, b.nonce)
+		})
+		queues = append(queues, queue)
+	}
+	heap.Init(&queues)
+
+	selected := make([]sdk.Tx, 0, mp.count)
+	for queues.Len() > 0 {
+		queue := queues[0]
+		selected = append(selected, queue[0].tx)
+		if len(queue) == 1 {
+			heap.Pop(&queues)
+			continue
+		}
+		queues[0] = queue[1:]
+		heap.Fix(&queues, 0)
+	}
+
+	if len(selected) == 0 {
+		return nil
+	}
+	return &iterator{txs: selected}
+}
+
+// CountTx returns the number of transactions of the mempool.
+func (mp *Mempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return mp.count
+}
+
+// Remove removes the tx from the mempool. An ethereum tx is only removed if it
+// is the pending tx of its sender and nonce, so that removing a replaced tx
+// doesn't remove its replacement.
+func (mp *Mempool) Remove(tx sdk.Tx) error {
+	ptx, err := mp.newPooledTx(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	txs := mp.senders[ptx.sender]
+	pending, ok := txs[ptx.nonce]
+	if !ok || pending.isEth != ptx.isEth || pending.hash != ptx.hash {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	delete(txs, ptx.nonce)
+	if len(txs) == 0 {
+		delete(mp.senders, ptx.sender)
+	}
+	mp.count--
+	return nil
+}
+
+// RemoveStaleTxs removes the txs whose nonce is lower than the account
+// sequence of their sender, and returns the number of removed txs. It's called
+// on the check state of the committed block, as the txs that CometBFT drops
+// from its mempool aren't removed otherwise.
+func (mp *Mempool) RemoveStaleTxs(ctx sdk.Context, ak AccountKeeper) int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	removed := 0
+	for sender, txs := range mp.senders {
+		sequence, err := ak.GetSequence(ctx, sdk.AccAddress(sender))
+		if err != nil {
+			// the account of a new sender is created by its first tx
+			continue
+		}
+
+		for nonce := range txs {
+			if nonce < sequence {
+				delete(txs, nonce)
+				removed++
+			}
+		}
+		if len(txs) == 0 {
+			delete(mp.senders, sender)
+		}
+	}
+
+	mp.count -= removed
+	return removed
+}
+
+// PendingTx returns the hash of the pending ethereum tx of the sender with the
+// given nonce.
+func (mp *Mempool) PendingTx(sender common.Address, nonce uint64) (common.Hash, bool) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	pending, ok := mp.senders[string(sender.Bytes())][nonce]
+	if !ok || !pending.isEth {
+		return common.Hash{}, false
+	}
+	return pending.hash, true
+}
+
+// CheckReplacement returns an error if the fee caps don't bump the fees of the
+// pending ethereum tx of the sender with the given nonce enough to replace it.
+func (mp *Mempool) CheckReplacement(sender common.Address, nonce uint64, gasTipCap, gasFeeCap *big.Int) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	pending, ok := mp.senders[string(sender.Bytes())][nonce]
+	if !ok || !pending.isEth {
+		return errorsmod.Wrapf(sdkmempool.ErrTxNotFound, "no pending transaction of %s with nonce %d", sender, nonce)
+	}
+	return mp.checkReplacement(pending, gasTipCap, gasFeeCap)
+}
+
+// checkReplacement returns an error if the tip cap and fee cap aren't greater
+// than the ones of the pending tx by at least the price bump percentage.
+func (mp *Mempool) checkReplacement(pending *pooledTx, gasTipCap, gasFeeCap *big.Int) error {
+	if gasTipCap.Cmp(pending.gasTipCap) <= 0 || gasFeeCap.Cmp(pending.gasFeeCap) <= 0 {
+		return errorsmod.Wrapf(
+			evmtypes.ErrReplaceUnderpriced,
+			"tip cap %s and fee cap %s must be greater than the pending %s and %s",
+			gasTipCap, gasFeeCap, pending.gasTipCap, pending.gasFeeCap,
+		)
+	}
+
+	minTipCap := bumpPrice(pending.gasTipCap, mp.priceBump)
+	minFeeCap := bumpPrice(pending.gasFeeCap, mp.priceBump)
+	if gasTipCap.Cmp(minTipCap) < 0 || gasFeeCap.Cmp(minFeeCap) < 0 {
+		return errorsmod.Wrapf(
+			evmtypes.ErrReplaceUnderpriced,
+			"tip cap %s and fee cap %s must be at least %s and %s (%d%% bump)",
+			gasTipCap, gasFeeCap, minTipCap, minFeeCap, mp.priceBump,
+		)
+	}
+	return nil
+}
+
+// newPooledTx returns the pooled tx of an ethereum or Cosmos tx. An ethereum tx
+// is keyed by the sender, nonce and hash of its first message, as it has no
+// Cosmos signers, while the sender and sequence of a Cosmos tx are the ones of
+// its first signer.
+func (mp *Mempool) newPooledTx(tx sdk.Tx) (*pooledTx, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) > 0 {
+		if ethMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx); ok {
+			txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed to unpack tx data")
+			}
+			return &pooledTx{
+				tx:        tx,
+				sender:    string(ethMsg.GetFrom()),
+				nonce:     txData.GetNonce(),
+				isEth:     true,
+				hash:      evmtypes.TxHash(txData),
+				gasTipCap: txData.GetGasTipCap(),
+				gasFeeCap: txData.GetGasFeeCap(),
+			}, nil
+		}
+	}
+
+	signers, err := mp.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) == 0 {
+		return nil, fmt.Errorf("tx must have at least one signer")
+	}
+	return &pooledTx{
+		tx:     tx,
+		sender: string(signers[0].Signer),
+		nonce:  signers[0].Sequence,
+	}, nil
+}
+
+// bumpPrice returns the price increased by the given percentage.
+func bumpPrice(price *big.Int, percentage uint64) *big.Int {
+	bumped := new(big.Int).Mul(price, new(big.Int).SetUint64(100+percentage))
+	return bumped.Div(bumped, big.NewInt(100))
+}
@@ -0,0 +1,192 @@
+package mempool
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// newEthTx returns a dynamic fee eth tx of the sender with the given nonce and fee caps.
+func newEthTx(sender common.Address, nonce uint64, gasTipCap, gasFeeCap int64) *evmtypes.MsgEthereumTx {
+	to := common.HexToAddress("0x1")
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:   big.NewInt(9001),
+		Nonce:     nonce,
+		GasLimit:  21000,
+		GasTipCap: big.NewInt(gasTipCap),
+		GasFeeCap: big.NewInt(gasFeeCap),
+		To:        &to,
+	})
+	msg.From = sender.Hex()
+	return msg
+}
+
+func withPriority(priority int64) sdk.Context {
+	return sdk.Context{}.WithPriority(priority)
+}
+
+// selectTxs returns the txs selected by the mempool in order.
+func selectTxs(mp *Mempool) []sdk.Tx {
+	var txs []sdk.Tx
+	for it := mp.Select(sdk.Context{}, nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+func TestMempoolReplacement(t *testing.T) {
+	sender := common.HexToAddress("0xa")
+	pending := newEthTx(sender, 0, 100, 1000)
+
+	testCases := []struct {
+		name   string
+		tx     *evmtypes.MsgEthereumTx
+		expErr bool
+	}{
+		{"same fees", newEthTx(sender, 0, 100, 1000), true},
+		{"tip cap not bumped", newEthTx(sender, 0, 100, 2000), true},
+		{"fee cap not bumped", newEthTx(sender, 0, 200, 1000), true},
+		{"fees bumped below the price bump", newEthTx(sender, 0, 105, 1050), true},
+		{"fees bumped by the price bump", newEthTx(sender, 0, 110, 1100), false},
+		{"different nonce", newEthTx(sender, 1, 100, 1000), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := NewMempool(10, 0)
+			require.NoError(t, mp.Insert(withPriority(1), pending))
+
+			err := mp.Insert(withPriority(1), tc.tx)
+			if tc.expErr {
+				require.ErrorIs(t, err, evmtypes.ErrReplaceUnderpriced)
+				require.Equal(t, []sdk.Tx{pending}, selectTxs(mp))
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.tx.AsTransaction().Nonce() == 0 {
+				require.Equal(t, 1, mp.CountTx())
+				hash, found := mp.PendingTx(sender, 0)
+				require.True(t, found)
+				require.Equal(t, tc.tx.TxHash(), hash)
+
+				// removing the replaced tx doesn't remove its replacement
+				require.ErrorIs(t, mp.Remove(pending), sdkmempool.ErrTxNotFound)
+				require.NoError(t, mp.Remove(tc.tx))
+				require.Zero(t, mp.CountTx())
+			} else {
+				require.Equal(t, 2, mp.CountTx())
+			}
+		})
+	}
+}
+
+func TestMempoolSelect(t *testing.T) {
+	alice := common.HexToAddress("0xa")
+	bob := common.HexToAddress("0xb")
+
+	aliceTx0 := newEthTx(alice, 0, 1, 1)
+	aliceTx1 := newEthTx(alice, 1, 1, 1)
+	bobTx0 := newEthTx(bob, 0, 1, 1)
+	bobTx1 := newEthTx(bob, 1, 1, 1)
+
+	mp := NewMempool(10, 0)
+	require.NoError(t, mp.Insert(withPriority(30), aliceTx1))
+	require.NoError(t, mp.Insert(withPriority(10), aliceTx0))
+	require.NoError(t, mp.Insert(withPriority(20), bobTx0))
+	require.NoError(t, mp.Insert(withPriority(5), bobTx1))
+
+	// the txs of each sender are in nonce order, regardless of their priority
+	require.Equal(t, []sdk.Tx{bobTx0, aliceTx0, aliceTx1, bobTx1}, selectTxs(mp))
+
+	require.NoError(t, mp.Remove(bobTx0))
+	require.NoError(t, mp.Remove(bobTx1))
+	require.Equal(t, []sdk.Tx{aliceTx0, aliceTx1}, selectTxs(mp))
+
+	require.NoError(t, mp.Remove(aliceTx0))
+	require.NoError(t, mp.Remove(aliceTx1))
+	require.Nil(t, mp.Select(sdk.Context{}, nil))
+}
+
+func TestMempoolMaxTxs(t *testing.T) {
+	sender := common.HexToAddress("0xa")
+	pending := newEthTx(sender, 0, 100, 1000)
+
+	mp := NewMempool(10, 1)
+	require.NoError(t, mp.Insert(withPriority(1), pending))
+	require.ErrorIs(t, mp.Insert(withPriority(1), newEthTx(sender, 1, 100, 1000)), sdkmempool.ErrMempoolTxMaxCapacity)
+
+	// a full mempool still accepts the replacements
+	replacement := newEthTx(sender, 0, 110, 1100)
+	require.NoError(t, mp.Insert(withPriority(1), replacement))
+	require.Equal(t, []sdk.Tx{replacement}, selectTxs(mp))
+}
+
+// mockAccountKeeper returns the sequences of the accounts, and an error for the
+// accounts that don't exist.
+type mockAccountKeeper map[string]uint64
+
+func (ak mockAccountKeeper) GetSequence(_ context.Context, addr sdk.AccAddress) (uint64, error) {
+	sequence, ok := ak[string(addr)]
+	if !ok {
+		return 0, sdkerrors.ErrUnknownAddress
+	}
+	return sequence, nil
+}
+
+func TestMempoolRemoveStaleTxs(t *testing.T) {
+	alice := common.HexToAddress("0xa")
+	bob := common.HexToAddress("0xb")
+
+	aliceTx0 := newEthTx(alice, 0, 1, 1)
+	aliceTx1 := newEthTx(alice, 1, 1, 1)
+	aliceTx2 := newEthTx(alice, 2, 1, 1)
+	bobTx0 := newEthTx(bob, 0, 1, 1)
+
+	mp := NewMempool(10, 0)
+	for _, tx := range []sdk.Tx{aliceTx0, aliceTx1, aliceTx2, bobTx0} {
+		require.NoError(t, mp.Insert(withPriority(1), tx))
+	}
+
+	// bob has no account yet, so none of its txs are stale
+	ak := mockAccountKeeper{string(alice.Bytes()): 2}
+	require.Equal(t, 2, mp.RemoveStaleTxs(sdk.Context{}, ak))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []sdk.Tx{aliceTx2, bobTx0}, selectTxs(mp))
+
+	ak[string(bob.Bytes())] = 1
+	require.Equal(t, 1, mp.RemoveStaleTxs(sdk.Context{}, ak))
+	require.Equal(t, []sdk.Tx{aliceTx2}, selectTxs(mp))
+}
+
+// multiMsgTx is a tx with several messages and no Cosmos signers.
+type multiMsgTx []sdk.Msg
+
+func (tx multiMsgTx) GetMsgs() []sdk.Msg { return tx }
+
+func (tx multiMsgTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func TestMempoolMultiMsgEthTx(t *testing.T) {
+	sender := common.HexToAddress("0xa")
+	tx := multiMsgTx{newEthTx(sender, 3, 100, 1000), newEthTx(sender, 4, 100, 1000)}
+
+	mp := NewMempool(10, 0)
+	require.NoError(t, mp.Insert(withPriority(1), tx))
+
+	// the tx is keyed by the sender and nonce of its first message
+	hash, found := mp.PendingTx(sender, 3)
+	require.True(t, found)
+	require.Equal(t, tx[0].(*evmtypes.MsgEthereumTx).TxHash(), hash)
+
+	require.NoError(t, mp.Remove(tx))
+	require.Zero(t, mp.CountTx())
+}
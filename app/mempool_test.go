@@ -0,0 +1,107 @@
+package app_test
+
+import (
+	"math/big"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmosmempool "github.com/evmos/evmos/v20/app/mempool"
+	srvflags "github.com/evmos/evmos/v20/server/flags"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// TestMempoolReplacement checks the replacement of a pending eth tx by fee bump
+// through CheckTx and ReCheckTx on the app-side mempool, and the eviction of
+// the stale pending tx once its nonce is committed by another tx.
+func TestMempoolReplacement(t *testing.T) {
+	keyring := keyring.New(1)
+	sender := keyring.GetKey(0)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(sender.AccAddr),
+		network.WithAppOptions(map[string]interface{}{
+			srvflags.EVMMempool:          true,
+			srvflags.EVMMempoolPriceBump: 10,
+		}),
+	)
+	handler := grpc.NewIntegrationHandler(nw)
+	txFactory := factory.New(nw, handler)
+
+	appMempool, ok := nw.App.Mempool().(*evmosmempool.Mempool)
+	require.True(t, ok, "expected the app-side mempool to be enabled")
+
+	baseFeeRes, err := handler.GetEvmBaseFee()
+	require.NoError(t, err)
+	baseFee := baseFeeRes.BaseFee.BigInt()
+
+	recipient := common.HexToAddress("0x1")
+	// newTx returns the encoded eth tx with nonce 0 and the given fee caps
+	// scaled by the percentage, and its hash
+	newTx := func(percentage int64) ([]byte, common.Hash) {
+		scale := func(price *big.Int) *big.Int {
+			scaled := new(big.Int).Mul(price, big.NewInt(percentage))
+			return scaled.Div(scaled, big.NewInt(100))
+		}
+
+		tx, err := txFactory.GenerateSignedEthTx(sender.Priv, evmtypes.EvmTxArgs{
+			To:        &recipient,
+			Amount:    big.NewInt(1),
+			GasLimit:  21000,
+			GasTipCap: scale(baseFee),
+			GasFeeCap: scale(new(big.Int).Mul(baseFee, big.NewInt(2))),
+		})
+		require.NoError(t, err)
+
+		bz, err := txFactory.EncodeTx(tx)
+		require.NoError(t, err)
+
+		msg, ok := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+		require.True(t, ok)
+		return bz, msg.AsTransaction().Hash()
+	}
+	checkTx := func(bz []byte, txType abcitypes.CheckTxType) *abcitypes.ResponseCheckTx {
+		res, err := nw.App.CheckTx(&abcitypes.RequestCheckTx{Tx: bz, Type: txType})
+		require.NoError(t, err)
+		return res
+	}
+
+	pendingTx, pendingHash := newTx(100)
+	underpricedTx, _ := newTx(105)
+	replacementTx, replacementHash := newTx(110)
+
+	res := checkTx(pendingTx, abcitypes.CheckTxType_New)
+	require.Zero(t, res.Code, res.Log)
+	require.Equal(t, 1, appMempool.CountTx())
+
+	res = checkTx(underpricedTx, abcitypes.CheckTxType_New)
+	require.Equal(t, evmtypes.ErrReplaceUnderpriced.ABCICode(), res.Code, res.Log)
+	hash, found := appMempool.PendingTx(sender.Addr, 0)
+	require.True(t, found)
+	require.Equal(t, pendingHash, hash)
+
+	res = checkTx(replacementTx, abcitypes.CheckTxType_New)
+	require.Zero(t, res.Code, res.Log)
+	require.Equal(t, 1, appMempool.CountTx())
+	hash, found = appMempool.PendingTx(sender.Addr, 0)
+	require.True(t, found)
+	require.Equal(t, replacementHash, hash)
+
+	// the replaced tx is evicted from the CometBFT mempool on recheck
+	res = checkTx(pendingTx, abcitypes.CheckTxType_Recheck)
+	require.Equal(t, evmtypes.ErrTxReplaced.ABCICode(), res.Code, res.Log)
+	res = checkTx(replacementTx, abcitypes.CheckTxType_Recheck)
+	require.Zero(t, res.Code, res.Log)
+	require.Equal(t, 1, appMempool.CountTx())
+
+	// the replacement is stale once another tx with its nonce is committed
+	blockRes, err := nw.NextBlockWithTxs(pendingTx)
+	require.NoError(t, err)
+	require.Zero(t, blockRes.TxResults[0].Code, blockRes.TxResults[0].Log)
+	require.Zero(t, appMempool.CountTx())
+}
@@ -33,7 +33,19 @@ func CreateUpgradeHandler(
 
 		// run module migrations first.
 		// so we wont override erc20 params when running strv2 migration,
-		return mm.RunMigrations(ctx, configurator, vm)
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return nil, err
+		}
+
+		// install the well-known contracts at their canonical addresses, after
+		// the EVM store migrations so that the code is written to the migrated store
+		logger.Info("adding the preinstalled contracts")
+		if err := ek.AddPreinstalls(ctx, evmtypes.DefaultPreinstalls); err != nil {
+			return nil, err
+		}
+
+		return vm, nil
 	}
 }
 
@@ -0,0 +1,252 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/evmos/evmos/v20/app"
+	"github.com/evmos/evmos/v20/cmd/evmosd/opendb"
+)
+
+const (
+	// FlagDumpFormat defines the format of the EVM state dump
+	FlagDumpFormat = "format"
+
+	dumpFormatJSON = "json"
+	dumpFormatRLP  = "rlp"
+)
+
+// ExportEVMStateCmd returns a command to export the EVM state of the node in
+// the format of the go-ethereum state dumps.
+func ExportEVMStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-evm-state",
+		Short: "Export the EVM state in the go-ethereum dump format",
+		Long: `Export the accounts, code and storage of the EVM state at the given height.
+
+The json format matches the output of 'geth dump --iterative': the first line holds the
+state root and each following line holds an account. The rlp format is a stream of the
+RLP encoded state root followed by the RLP encoded accounts, sorted by address.
+
+As the EVM state is not stored in a trie, the reported state root is the app hash of the
+chain at the given height. The storage root of each account is the root of its
+go-ethereum storage trie.`,
+		Example: fmt.Sprintf("%s export-evm-state --height 1000 --format json --output-document evm-state.json", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, _ := cmd.Flags().GetInt64(sdkserver.FlagHeight)
+			format, _ := cmd.Flags().GetString(FlagDumpFormat)
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+
+			if format != dumpFormatJSON && format != dumpFormatRLP {
+				return fmt.Errorf("invalid dump format %q, expected %q or %q", format, dumpFormatJSON, dumpFormatRLP)
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			db, err := opendb.OpenDB(serverCtx.Viper, config.RootDir, sdkserver.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			evmosApp := app.NewEvmos(
+				serverCtx.Logger, db, nil, height == -1, map[int64]bool{}, homeDir, uint(1), serverCtx.Viper,
+				baseapp.SetChainID(appGenesis.ChainID),
+			)
+			if height != -1 {
+				if err := evmosApp.LoadHeight(height); err != nil {
+					return fmt.Errorf("failed to load the state at height %d: %w", height, err)
+				}
+			}
+
+			var out io.Writer = cmd.OutOrStdout()
+			if outputDocument != "" {
+				f, err := os.Create(outputDocument)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+
+			w := bufio.NewWriter(out)
+			var collector dumpCollector
+			if format == dumpFormatJSON {
+				collector = newJSONDump(w)
+			} else {
+				collector = newRLPDump(w)
+			}
+
+			ctx := evmosApp.NewContextLegacy(true, cmtproto.Header{Height: evmosApp.LastBlockHeight()})
+			root := common.BytesToHash(evmosApp.LastCommitID().Hash)
+			evmosApp.EvmKeeper.DumpState(ctx, root, collector)
+
+			if err := collector.flush(); err != nil {
+				return fmt.Errorf("failed to write the EVM state dump: %w", err)
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(sdkserver.FlagHeight, -1, "Export the state at the given height, -1 for the latest height")
+	cmd.Flags().String(FlagDumpFormat, dumpFormatJSON, "The format of the dump (json|rlp)")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the dump to the given file instead of STDOUT")
+
+	return cmd
+}
+
+// dumpCollector is a state.DumpCollector that writes the dump and reports the
+// first encoding or write error on flush.
+type dumpCollector interface {
+	state.DumpCollector
+	flush() error
+}
+
+// jsonDump writes the state dump as JSON lines, as the geth iterative dump.
+type jsonDump struct {
+	enc *json.Encoder
+	err error
+}
+
+func newJSONDump(w io.Writer) *jsonDump {
+	return &jsonDump{enc: json.NewEncoder(w)}
+}
+
+// OnRoot implements state.DumpCollector.
+func (d *jsonDump) OnRoot(root common.Hash) {
+	d.encode(struct {
+		Root common.Hash `json:"root"`
+	}{root})
+}
+
+// OnAccount implements state.DumpCollector.
+func (d *jsonDump) OnAccount(addr common.Address, account state.DumpAccount) {
+	account.Address = &addr
+	d.encode(account)
+}
+
+func (d *jsonDump) encode(v interface{}) {
+	if d.err == nil {
+		d.err = d.enc.Encode(v)
+	}
+}
+
+func (d *jsonDump) flush() error {
+	return d.err
+}
+
+// rlpDumpAccount is the RLP encoding of an account of the state dump.
+type rlpDumpAccount struct {
+	Address  common.Address
+	Nonce    uint64
+	Balance  *big.Int
+	Root     common.Hash
+	CodeHash common.Hash
+	Code     []byte
+	Storage  []rlpDumpStorage
+}
+
+// rlpDumpStorage is the RLP encoding of a storage slot of the state dump.
+type rlpDumpStorage struct {
+	Key   common.Hash
+	Value []byte
+}
+
+// rlpDump writes the state dump as a stream of RLP encoded values. The
+// accounts are buffered to be written sorted by address.
+type rlpDump struct {
+	w        io.Writer
+	root     common.Hash
+	accounts []rlpDumpAccount
+	err      error
+}
+
+func newRLPDump(w io.Writer) *rlpDump {
+	return &rlpDump{w: w}
+}
+
+// OnRoot implements state.DumpCollector.
+func (d *rlpDump) OnRoot(root common.Hash) {
+	d.root = root
+}
+
+// OnAccount implements state.DumpCollector.
+func (d *rlpDump) OnAccount(addr common.Address, account state.DumpAccount) {
+	balance, ok := new(big.Int).SetString(account.Balance, 10)
+	if !ok {
+		if d.err == nil {
+			d.err = fmt.Errorf("invalid balance %q of account %s", account.Balance, addr)
+		}
+		return
+	}
+
+	storage := make([]rlpDumpStorage, 0, len(account.Storage))
+	for key, value := range account.Storage {
+		storage = append(storage, rlpDumpStorage{Key: key, Value: common.FromHex(value)})
+	}
+	sort.Slice(storage, func(i, j int) bool {
+		return bytes.Compare(storage[i].Key[:], storage[j].Key[:]) < 0
+	})
+
+	d.accounts = append(d.accounts, rlpDumpAccount{
+		Address:  addr,
+		Nonce:    account.Nonce,
+		Balance:  balance,
+		Root:     common.BytesToHash(account.Root),
+		CodeHash: common.BytesToHash(account.CodeHash),
+		Code:     account.Code,
+		Storage:  storage,
+	})
+}
+
+func (d *rlpDump) flush() error {
+	if d.err != nil {
+		return d.err
+	}
+
+	sort.Slice(d.accounts, func(i, j int) bool {
+		return bytes.Compare(d.accounts[i].Address[:], d.accounts[j].Address[:]) < 0
+	})
+
+	if err := rlp.Encode(d.w, d.root); err != nil {
+		return err
+	}
+	for _, account := range d.accounts {
+		if err := rlp.Encode(d.w, account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/evmos/evmos/v20/app"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// ImportEVMGenesisCmd returns a command to import a go-ethereum state dump into
+// the genesis file.
+func ImportEVMGenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-evm-genesis DUMP_FILE",
+		Short: "Import a go-ethereum state dump into genesis.json",
+		Long: `Import the accounts of a go-ethereum state dump into genesis.json, to migrate an
+existing EVM chain. The dump can be in the format of 'geth dump' or 'geth dump --iterative'
+and must hold the preimages of the account addresses and storage keys.
+
+Each account is added to the auth genesis with its nonce as sequence, its balance is added
+to the bank genesis in the EVM denom and its code and storage are added to the EVM genesis.
+The balances are converted from wei to the decimals of the EVM denom, truncating the
+remainder. The imported accounts must not already be in the genesis.`,
+		Example: fmt.Sprintf("%s import-evm-genesis /path/to/dump.json", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			// the EVM denom and its decimals depend on the chain
+			if err := app.InitializeAppConfiguration(genDoc.ChainID); err != nil {
+				return err
+			}
+
+			dumpFile, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer dumpFile.Close()
+
+			dumpAccounts, err := evmtypes.ParseGethStateDump(dumpFile)
+			if err != nil {
+				return fmt.Errorf("failed to parse the state dump: %w", err)
+			}
+
+			authGenState := authtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			if err != nil {
+				return fmt.Errorf("failed to get accounts from any: %w", err)
+			}
+
+			bankGenState := banktypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+
+			var evmGenState evmtypes.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(appState[evmtypes.ModuleName], &evmGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal evm genesis state: %w", err)
+			}
+
+			evmDenom := evmtypes.GetEVMCoinDenom()
+			for _, dumpAccount := range dumpAccounts {
+				addr := sdk.AccAddress(dumpAccount.Address.Bytes())
+				if accs.Contains(addr) {
+					return fmt.Errorf("cannot import account at existing address %s", dumpAccount.Address)
+				}
+				accs = append(accs, authtypes.NewBaseAccount(addr, nil, 0, dumpAccount.Nonce))
+
+				balance, err := evmtypes.ParseDumpAccountBalance(dumpAccount)
+				if err != nil {
+					return err
+				}
+				amount := sdkmath.NewIntFromBigInt(evmtypes.ConvertAmountFrom18DecimalsBigInt(balance))
+				if amount.IsPositive() {
+					coins := sdk.NewCoins(sdk.NewCoin(evmDenom, amount))
+					bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: addr.String(), Coins: coins})
+					bankGenState.Supply = bankGenState.Supply.Add(coins...)
+				}
+
+				if len(dumpAccount.Code) == 0 && len(dumpAccount.Storage) == 0 {
+					continue
+				}
+				genAccount, err := evmtypes.GenesisAccountFromDump(dumpAccount)
+				if err != nil {
+					return err
+				}
+				evmGenState.Accounts = append(evmGenState.Accounts, genAccount)
+			}
+
+			if err := evmGenState.Validate(); err != nil {
+				return fmt.Errorf("invalid evm genesis state: %w", err)
+			}
+
+			// Sanitize the accounts to assign unique account numbers to the
+			// imported ones.
+			accs = authtypes.SanitizeGenesisAccounts(accs)
+			genAccs, err := authtypes.PackAccounts(accs)
+			if err != nil {
+				return fmt.Errorf("failed to convert accounts into any's: %w", err)
+			}
+			authGenState.Accounts = genAccs
+
+			authGenStateBz, err := clientCtx.Codec.MarshalJSON(&authGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+			}
+			appState[authtypes.ModuleName] = authGenStateBz
+
+			bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+			bankGenStateBz, err := clientCtx.Codec.MarshalJSON(bankGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+			}
+			appState[banktypes.ModuleName] = bankGenStateBz
+
+			evmGenStateBz, err := clientCtx.Codec.MarshalJSON(&evmGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal evm genesis state: %w", err)
+			}
+			appState[evmtypes.ModuleName] = evmGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			if err := genutil.ExportGenesisFile(genDoc, genFile); err != nil {
+				return err
+			}
+
+			cmd.Printf("imported %d accounts into %s\n", len(dumpAccounts), genFile)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
@@ -170,6 +170,7 @@ func NewRootCmd() (*cobra.Command, sdktestutil.TestEncodingConfig) {
 			tempApp.GetTxConfig().SigningContext().ValidatorAddressCodec(),
 		),
 		MigrateGenesisCmd(),
+		ExportEVMStateCmd(),
 		genutilcli.GenTxCmd(
 			tempApp.BasicModuleManager, tempApp.GetTxConfig(),
 			banktypes.GenesisBalancesIterator{},
@@ -178,6 +179,7 @@ func NewRootCmd() (*cobra.Command, sdktestutil.TestEncodingConfig) {
 		),
 		genutilcli.ValidateGenesisCmd(tempApp.BasicModuleManager),
 		AddGenesisAccountCmd(app.DefaultNodeHome),
+		ImportEVMGenesisCmd(app.DefaultNodeHome),
 		cmtcli.NewCompletionCmd(rootCmd, true),
 		NewTestnetCmd(tempApp.BasicModuleManager, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
@@ -0,0 +1,272 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmostypes "github.com/evmos/evmos/v20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// KeyPrefixBlockBloom is the prefix of the blooms of the blocks of the
+	// sections that are not indexed yet
+	KeyPrefixBlockBloom = 3
+	// KeyPrefixBloomBits is the prefix of the compressed bitsets of the sections
+	KeyPrefixBloomBits = 4
+	// KeyPrefixBloomSection is the prefix of the markers of the indexed sections
+	KeyPrefixBloomSection = 5
+	// KeyPrefixBloomSectionSize is the key of the section size of the bloom index
+	KeyPrefixBloomSectionSize = 6
+
+	// DefaultBloomSectionSize is the default number of blocks of a bloom-bits section
+	DefaultBloomSectionSize uint64 = 4096
+)
+
+var _ evmostypes.EVMBloomIndexer = &KVIndexer{}
+
+// SetBloomSectionSize sets the number of blocks of the bloom-bits sections. The
+// size must be a multiple of 8 and match the one of the existing index, if any.
+func (kv *KVIndexer) SetBloomSectionSize(size uint64) error {
+	if size == 0 || size%8 != 0 {
+		return fmt.Errorf("bloom section size must be a positive multiple of 8, got %d", size)
+	}
+
+	bz, err := kv.db.Get([]byte{KeyPrefixBloomSectionSize})
+	if err != nil {
+		return errorsmod.Wrap(err, "SetBloomSectionSize")
+	}
+	if len(bz) > 0 && sdk.BigEndianToUint64(bz) != size {
+		return fmt.Errorf(
+			"bloom section size %d doesn't match the size %d of the existing index, the index db must be recreated",
+			size, sdk.BigEndianToUint64(bz),
+		)
+	}
+
+	kv.bloomSectionSize = size
+	return nil
+}
+
+// BloomSectionSize returns the number of blocks of a section.
+func (kv *KVIndexer) BloomSectionSize() uint64 {
+	return kv.bloomSectionSize
+}
+
+// BloomSections returns the number of indexed sections.
+func (kv *KVIndexer) BloomSections() (uint64, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixBloomSection}, []byte{KeyPrefixBloomSection + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "BloomSections")
+	}
+	defer it.Close()
+
+	var count uint64
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	return count, nil
+}
+
+// HasBloomSection returns true if the section is indexed.
+func (kv *KVIndexer) HasBloomSection(section uint64) (bool, error) {
+	ok, err := kv.db.Has(BloomSectionKey(section))
+	if err != nil {
+		return false, errorsmod.Wrapf(err, "HasBloomSection %d", section)
+	}
+	return ok, nil
+}
+
+// BloomBits returns the bitset of the bloom bit for the blocks of the section.
+// The bit of the n-th block of the section is the (7 - n%8) bit of the n/8 byte.
+func (kv *KVIndexer) BloomBits(bit uint, section uint64) ([]byte, error) {
+	if bit >= ethtypes.BloomBitLength {
+		return nil, fmt.Errorf("bloom bit %d out of bounds", bit)
+	}
+
+	bz, err := kv.db.Get(BloomBitsKey(bit, section))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "BloomBits %d %d", bit, section)
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("bloom section %d not indexed", section)
+	}
+	return bitutil.DecompressBytes(bz, int(kv.bloomSectionSize/8)) // #nosec G115
+}
+
+// IndexBloomSection indexes a section from the tx results of each of its blocks.
+func (kv *KVIndexer) IndexBloomSection(section uint64, txResults [][]*abci.ExecTxResult) error {
+	if uint64(len(txResults)) != kv.bloomSectionSize {
+		return fmt.Errorf("expected the tx results of %d blocks, got %d", kv.bloomSectionSize, len(txResults))
+	}
+
+	blooms := make([]ethtypes.Bloom, len(txResults))
+	for i, results := range txResults {
+		bloom, err := blockBloom(results)
+		if err != nil {
+			return errorsmod.Wrapf(err, "IndexBloomSection %d", section)
+		}
+		blooms[i] = bloom
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	if err := kv.saveBloomSection(batch, section, blooms); err != nil {
+		return errorsmod.Wrapf(err, "IndexBloomSection %d", section)
+	}
+	return batch.Write()
+}
+
+// indexBlockBloom stores the bloom of the block until its section can be
+// indexed. The section is indexed when its first or last block is indexed and
+// the blooms of all its blocks are stored, so that both forward and backward
+// indexing complete it.
+func (kv *KVIndexer) indexBlockBloom(height int64, txResults []*abci.ExecTxResult) error {
+	section := uint64(height) / kv.bloomSectionSize // #nosec G115
+	indexed, err := kv.HasBloomSection(section)
+	if err != nil || indexed {
+		return err
+	}
+
+	bloom, err := blockBloom(txResults)
+	if err != nil {
+		return err
+	}
+	if err := kv.db.Set(BlockBloomKey(height), bloom.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set block bloom")
+	}
+
+	start := section * kv.bloomSectionSize
+	end := start + kv.bloomSectionSize - 1
+	if uint64(height) != end && uint64(height) != max(start, 1) { // #nosec G115
+		return nil
+	}
+
+	blooms := make([]ethtypes.Bloom, kv.bloomSectionSize)
+	for i := range blooms {
+		blockHeight := int64(start) + int64(i) // #nosec G115
+		if blockHeight == 0 {
+			// there is no block at height 0
+			continue
+		}
+
+		bz, err := kv.db.Get(BlockBloomKey(blockHeight))
+		if err != nil {
+			return errorsmod.Wrap(err, "get block bloom")
+		}
+		if bz == nil {
+			kv.logger.Debug("bloom section not complete", "section", section, "missing", blockHeight)
+			return nil
+		}
+		blooms[i] = ethtypes.BytesToBloom(bz)
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	if err := kv.saveBloomSection(batch, section, blooms); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// saveBloomSection stores the bloom bits of the section into the batch and
+// deletes the blooms of its blocks.
+func (kv *KVIndexer) saveBloomSection(batch dbm.Batch, section uint64, blooms []ethtypes.Bloom) error {
+	gen, err := bloombits.NewGenerator(uint(kv.bloomSectionSize))
+	if err != nil {
+		return err
+	}
+	for i, bloom := range blooms {
+		if err := gen.AddBloom(uint(i), bloom); err != nil {
+			return err
+		}
+	}
+
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		bits, err := gen.Bitset(bit)
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(BloomBitsKey(bit, section), bitutil.CompressBytes(bits)); err != nil {
+			return errorsmod.Wrap(err, "set bloom bits")
+		}
+	}
+
+	start := int64(section * kv.bloomSectionSize) // #nosec G115
+	for i := range blooms {
+		if err := batch.Delete(BlockBloomKey(start + int64(i))); err != nil {
+			return errorsmod.Wrap(err, "delete block bloom")
+		}
+	}
+
+	if err := batch.Set([]byte{KeyPrefixBloomSectionSize}, sdk.Uint64ToBigEndian(kv.bloomSectionSize)); err != nil {
+		return errorsmod.Wrap(err, "set bloom section size")
+	}
+	if err := batch.Set(BloomSectionKey(section), []byte{1}); err != nil {
+		return errorsmod.Wrap(err, "set bloom section")
+	}
+
+	kv.logger.Debug("indexed bloom section", "section", section)
+	return nil
+}
+
+// blockBloom returns the bloom of the ethereum logs emitted by the txs of a block.
+func blockBloom(txResults []*abci.ExecTxResult) (ethtypes.Bloom, error) {
+	var bloom ethtypes.Bloom
+	for _, result := range txResults {
+		if result == nil {
+			continue
+		}
+
+		for _, event := range result.Events {
+			if event.Type != evmtypes.EventTypeTxLog {
+				continue
+			}
+
+			for _, attr := range event.Attributes {
+				if attr.Key != evmtypes.AttributeKeyTxLog {
+					continue
+				}
+
+				var log evmtypes.Log
+				if err := json.Unmarshal([]byte(attr.Value), &log); err != nil {
+					return ethtypes.Bloom{}, err
+				}
+
+				ethLog := log.ToEthereum()
+				bloom.Add(ethLog.Address.Bytes())
+				for _, topic := range ethLog.Topics {
+					bloom.Add(topic.Bytes())
+				}
+			}
+		}
+	}
+	return bloom, nil
+}
+
+// BlockBloomKey returns the key for db entry: `block number -> block bloom`
+func BlockBloomKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixBlockBloom}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115
+}
+
+// BloomBitsKey returns the key for db entry: `(section, bloom bit) -> compressed bitset`
+func BloomBitsKey(bit uint, section uint64) []byte {
+	bz1 := sdk.Uint64ToBigEndian(section)
+	bz2 := sdk.Uint64ToBigEndian(uint64(bit))
+	return append(append([]byte{KeyPrefixBloomBits}, bz1...), bz2...)
+}
+
+// BloomSectionKey returns the key for db entry: `section -> indexed marker`
+func BloomSectionKey(section uint64) []byte {
+	return append([]byte{KeyPrefixBloomSection}, sdk.Uint64ToBigEndian(section)...)
+}
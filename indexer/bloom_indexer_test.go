@@ -0,0 +1,150 @@
+package indexer_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v20/indexer"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+// logTxResults returns the tx results of a block with a single log emitted by the address.
+func logTxResults(t *testing.T, address common.Address) []*abci.ExecTxResult {
+	bz, err := json.Marshal(types.NewLogFromEth(&ethtypes.Log{Address: address}))
+	require.NoError(t, err)
+
+	return []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					{Key: types.AttributeKeyTxLog, Value: string(bz)},
+				}},
+			},
+		},
+	}
+}
+
+// requireBloomBit checks the bloom bits of the address for the n-th block of the section.
+func requireBloomBit(t *testing.T, idxer *indexer.KVIndexer, section uint64, n int, address common.Address, set bool) {
+	var bloom ethtypes.Bloom
+	bloom.Add(address.Bytes())
+
+	// the bloom bits of the address are the set bits of its bloom
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		if bloom[ethtypes.BloomByteLength-1-bit/8]&(1<<(bit%8)) == 0 {
+			continue
+		}
+
+		bits, err := idxer.BloomBits(bit, section)
+		require.NoError(t, err)
+		require.Equal(t, set, bits[n/8]&(0x80>>(n%8)) != 0, "bit %d, block %d", bit, n)
+	}
+}
+
+func TestBloomIndex(t *testing.T) {
+	const sectionSize = 8
+
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	address := common.HexToAddress("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")
+	indexBlock := func(t *testing.T, idxer *indexer.KVIndexer, height int64) {
+		var txResults []*abci.ExecTxResult
+		if height%5 == 0 {
+			txResults = logTxResults(t, address)
+		}
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		require.NoError(t, idxer.IndexBlock(block, txResults))
+	}
+
+	testCases := []struct {
+		name    string
+		heights []int64
+		indexed []bool
+	}{
+		{
+			"forward indexing completes the sections on their last block",
+			[]int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17},
+			[]bool{true, true, false},
+		},
+		{
+			"backward indexing completes the sections on their first block",
+			[]int64{15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+			[]bool{true, true},
+		},
+		{
+			"sections with missing blocks are not indexed",
+			[]int64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+			[]bool{false, true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+			require.NoError(t, idxer.SetBloomSectionSize(sectionSize))
+
+			for _, height := range tc.heights {
+				indexBlock(t, idxer, height)
+			}
+
+			var count uint64
+			for section, expIndexed := range tc.indexed {
+				indexed, err := idxer.HasBloomSection(uint64(section))
+				require.NoError(t, err)
+				require.Equal(t, expIndexed, indexed, "section %d", section)
+				if !indexed {
+					continue
+				}
+				count++
+
+				for n := 0; n < sectionSize; n++ {
+					height := section*sectionSize + n
+					requireBloomBit(t, idxer, uint64(section), n, address, height%5 == 0 && height > 0)
+				}
+			}
+
+			sections, err := idxer.BloomSections()
+			require.NoError(t, err)
+			require.Equal(t, count, sections)
+		})
+	}
+}
+
+func TestIndexBloomSection(t *testing.T) {
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	address := common.HexToAddress("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+	require.Error(t, idxer.SetBloomSectionSize(12))
+	require.NoError(t, idxer.SetBloomSectionSize(16))
+
+	txResults := make([][]*abci.ExecTxResult, 16)
+	txResults[9] = logTxResults(t, address)
+	require.Error(t, idxer.IndexBloomSection(2, txResults[:8]))
+	require.NoError(t, idxer.IndexBloomSection(2, txResults))
+
+	indexed, err := idxer.HasBloomSection(2)
+	require.NoError(t, err)
+	require.True(t, indexed)
+	requireBloomBit(t, idxer, 2, 9, address, true)
+	requireBloomBit(t, idxer, 2, 8, address, false)
+
+	// the section size can't change once the index is built
+	idxer = indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+	require.ErrorContains(t, idxer.SetBloomSectionSize(8), "doesn't match")
+}
@@ -35,11 +35,19 @@ type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+
+	// bloomSectionSize is the number of blocks of a bloom-bits section
+	bloomSectionSize uint64
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{
+		db:               db,
+		logger:           logger,
+		clientCtx:        clientCtx,
+		bloomSectionSize: DefaultBloomSectionSize,
+	}
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -47,6 +55,7 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Stores the bloom of the block and indexes its bloom-bits section once complete
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
 	height := block.Header.Height
 
@@ -118,6 +127,9 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
+	if err := kv.indexBlockBloom(height, txResults); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, index bloom", height)
+	}
 	return nil
 }
 
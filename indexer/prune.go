@@ -0,0 +1,153 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package indexer
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmostypes "github.com/evmos/evmos/v20/types"
+)
+
+const (
+	// KeyPrefixBackfillHeight is the key of the height down to which all the
+	// blocks are indexed
+	KeyPrefixBackfillHeight = 7
+
+	// pruneBatchSize is the max number of deletions written in a single batch
+	pruneBatchSize = 10_000
+)
+
+var _ evmostypes.EVMIndexerMaintainer = &KVIndexer{}
+
+// BackfillHeight returns the height down to which all the blocks are indexed,
+// returns -1 if it's not set.
+func (kv *KVIndexer) BackfillHeight() (int64, error) {
+	bz, err := kv.db.Get([]byte{KeyPrefixBackfillHeight})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "BackfillHeight")
+	}
+	if len(bz) == 0 {
+		return -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil // #nosec G115
+}
+
+// SetBackfillHeight sets the height down to which all the blocks are indexed.
+func (kv *KVIndexer) SetBackfillHeight(height int64) error {
+	if err := kv.db.Set([]byte{KeyPrefixBackfillHeight}, sdk.Uint64ToBigEndian(uint64(height))); err != nil { // #nosec G115
+		return errorsmod.Wrapf(err, "SetBackfillHeight %d", height)
+	}
+	return nil
+}
+
+// PruneBlocks deletes the indexed entries of the blocks lower than the retain
+// height, including the bloom-bits sections that end before it. It returns
+// the number of deleted eth txs.
+func (kv *KVIndexer) PruneBlocks(retainHeight int64) (uint64, error) {
+	if retainHeight <= 0 {
+		return 0, nil
+	}
+
+	var pruned uint64
+	err := kv.deleteRange(
+		[]byte{KeyPrefixTxIndex},
+		TxIndexKey(retainHeight, 0),
+		func(batch dbm.Batch, key, value []byte) error {
+			pruned++
+			return batch.Delete(TxHashKey(common.BytesToHash(value)))
+		},
+	)
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "PruneBlocks %d", retainHeight)
+	}
+
+	if err := kv.deleteRange([]byte{KeyPrefixBlockBloom}, BlockBloomKey(retainHeight), nil); err != nil {
+		return 0, errorsmod.Wrapf(err, "PruneBlocks %d", retainHeight)
+	}
+
+	// only the sections with all their blocks lower than the retain height
+	retainSection := uint64(retainHeight) / kv.bloomSectionSize // #nosec G115
+	if err := kv.deleteRange(
+		[]byte{KeyPrefixBloomSection},
+		BloomSectionKey(retainSection),
+		func(batch dbm.Batch, key, _ []byte) error {
+			section := sdk.BigEndianToUint64(key[1:])
+			for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+				if err := batch.Delete(BloomBitsKey(bit, section)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	); err != nil {
+		return 0, errorsmod.Wrapf(err, "PruneBlocks %d", retainHeight)
+	}
+
+	backfillHeight, err := kv.BackfillHeight()
+	if err != nil {
+		return 0, err
+	}
+	if backfillHeight != -1 && backfillHeight < retainHeight {
+		if err := kv.SetBackfillHeight(retainHeight); err != nil {
+			return 0, err
+		}
+	}
+
+	return pruned, nil
+}
+
+// deleteRange deletes the keys of the range [start, end) in batches. The
+// onDelete callback, if any, is called for each key to delete the related
+// entries in the same batch.
+func (kv *KVIndexer) deleteRange(start, end []byte, onDelete func(batch dbm.Batch, key, value []byte) error) error {
+	for {
+		keys, values, err := kv.rangeKeys(start, end, pruneBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+
+		batch := kv.db.NewBatch()
+		for i, key := range keys {
+			if onDelete != nil {
+				if err := onDelete(batch, key, values[i]); err != nil {
+					batch.Close()
+					return err
+				}
+			}
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+
+		if len(keys) < pruneBatchSize {
+			return nil
+		}
+	}
+}
+
+// rangeKeys returns up to limit keys, and their values, of the range [start, end).
+func (kv *KVIndexer) rangeKeys(start, end []byte, limit int) (keys, values [][]byte, err error) {
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	for ; it.Valid() && len(keys) < limit; it.Next() {
+		keys = append(keys, append([]byte{}, it.Key()...))
+		values = append(values, append([]byte{}, it.Value()...))
+	}
+	return keys, values, it.Error()
+}
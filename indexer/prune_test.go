@@ -0,0 +1,109 @@
+package indexer_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v20/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v20/indexer"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	evmostypes "github.com/evmos/evmos/v20/types"
+	"github.com/evmos/evmos/v20/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneBlocks(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+	require.NoError(t, idxer.SetBloomSectionSize(8))
+
+	// index a tx on each of the blocks 1 to 20
+	to := common.BigToAddress(big.NewInt(1))
+	hashes := make(map[int64]common.Hash)
+	for height := int64(1); height <= 20; height++ {
+		tx := types.NewTx(&types.EvmTxArgs{
+			Nonce:    uint64(height),
+			To:       &to,
+			Amount:   big.NewInt(1000),
+			GasLimit: 21000,
+		})
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		hashes[height] = tx.AsTransaction().Hash()
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), evmostypes.BaseDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+		require.NoError(t, idxer.IndexBlock(block, []*abci.ExecTxResult{
+			{
+				Code: 0,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: hashes[height].Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "txGasUsed", Value: "21000"},
+					}},
+				},
+			},
+		}))
+	}
+	require.NoError(t, idxer.SetBackfillHeight(1))
+
+	pruned, err := idxer.PruneBlocks(12)
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), pruned)
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(12), first)
+
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(20), last)
+
+	for height, hash := range hashes {
+		res, err := idxer.GetByTxHash(hash)
+		if height < 12 {
+			require.Error(t, err, "height %d", height)
+			continue
+		}
+		require.NoError(t, err, "height %d", height)
+		require.Equal(t, height, res.Height)
+	}
+
+	// the section 0 (blocks 0 to 7) is pruned, the section 1 (8 to 15) is kept
+	indexed, err := idxer.HasBloomSection(0)
+	require.NoError(t, err)
+	require.False(t, indexed)
+	indexed, err = idxer.HasBloomSection(1)
+	require.NoError(t, err)
+	require.True(t, indexed)
+	_, err = idxer.BloomBits(0, 0)
+	require.Error(t, err)
+
+	// the backfill doesn't go below the retain height
+	backfillHeight, err := idxer.BackfillHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(12), backfillHeight)
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev Grant defines an authorization given by a granter to a grantee.
+struct Grant {
+    // the address of the account that granted the authorization
+    address granter;
+    // the address of the account that can execute the messages on behalf of the granter
+    address grantee;
+    // the type URL of the authorization, e.g. "/cosmos.authz.v1beta1.GenericAuthorization"
+    string authorizationType;
+    // the type URL of the message that the grantee can execute
+    string msgTypeUrl;
+    // the unix timestamp at which the authorization expires, zero if it never expires
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompiled Contract
+/// @dev The interface through which solidity contracts grant, revoke and query
+/// generic authorizations of the authz module. The caller of the precompile is
+/// always the granter, so that contracts can only grant authorizations over their
+/// own account.
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface IAuthz {
+    /// @dev Emitted when a generic authorization is granted.
+    /// @param granter The address of the granter.
+    /// @param grantee The address of the grantee.
+    /// @param msgTypeUrl The type URL of the authorized message.
+    /// @param expiration The unix timestamp at which the authorization expires.
+    event GrantAuthorization(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl,
+        int64 expiration
+    );
+
+    /// @dev Emitted when an authorization is revoked.
+    /// @param granter The address of the granter.
+    /// @param grantee The address of the grantee.
+    /// @param msgTypeUrl The type URL of the message that is no longer authorized.
+    event RevokeAuthorization(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Grant grants a generic authorization from the caller to the grantee to
+    /// execute any message of the given type on behalf of the caller.
+    /// @param grantee The address of the grantee.
+    /// @param msgTypeUrl The type URL of the authorized message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+    /// @param expiration The unix timestamp at which the authorization expires, zero
+    /// if it never expires.
+    /// @return success Whether the authorization was granted.
+    function grant(
+        address grantee,
+        string calldata msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revoke revokes the authorization granted by the caller to the grantee
+    /// for the given message type, regardless of the authorization type.
+    /// @param grantee The address of the grantee.
+    /// @param msgTypeUrl The type URL of the authorized message.
+    /// @return success Whether the authorization was revoked.
+    function revoke(
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev Grants returns the authorizations given by the granter to the grantee.
+    /// @param granter The address of the granter.
+    /// @param grantee The address of the grantee.
+    /// @param msgTypeUrl The type URL of the authorized message, or an empty string
+    /// to return the authorizations for all the message types.
+    /// @param pageRequest The pagination options.
+    /// @return authorizations The authorizations.
+    /// @return pageResponse The pagination response.
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            Grant[] memory authorizations,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev GranterGrants returns the authorizations given by the granter.
+    /// @param granter The address of the granter.
+    /// @param pageRequest The pagination options.
+    /// @return authorizations The authorizations.
+    /// @return pageResponse The pagination response.
+    function granterGrants(
+        address granter,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            Grant[] memory authorizations,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev GranteeGrants returns the authorizations given to the grantee.
+    /// @param grantee The address of the grantee.
+    /// @param pageRequest The pagination options.
+    /// @return authorizations The authorizations.
+    /// @return pageResponse The pagination response.
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            Grant[] memory authorizations,
+            PageResponse memory pageResponse
+        );
+}
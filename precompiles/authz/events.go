@@ -0,0 +1,95 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// EventTypeGrantAuthorization defines the event type for the authz GrantMethod transaction.
+	EventTypeGrantAuthorization = "GrantAuthorization"
+	// EventTypeRevokeAuthorization defines the event type for the authz RevokeMethod transaction.
+	EventTypeRevokeAuthorization = "RevokeAuthorization"
+)
+
+// EmitGrantAuthorizationEvent creates a new event emitted on a Grant transaction.
+func (p Precompile) EmitGrantAuthorizationEvent(ctx sdk.Context, stateDB vm.StateDB, granter common.Address, input *GrantInput) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeGrantAuthorization]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(granter)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(input.Grantee)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(input.MsgTypeUrl, input.Expiration)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitRevokeAuthorizationEvent creates a new event emitted on a Revoke transaction.
+func (p Precompile) EmitRevokeAuthorizationEvent(ctx sdk.Context, stateDB vm.StateDB, granter common.Address, input *RevokeInput) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeRevokeAuthorization]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(granter)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(input.Grantee)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(input.MsgTypeUrl)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
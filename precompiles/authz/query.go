@@ -0,0 +1,92 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// GrantsMethod defines the method name for the grants precompile request.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the method name for the granter grants precompile request.
+	GranterGrantsMethod = "granterGrants"
+	// GranteeGrantsMethod defines the method name for the grantee grants precompile request.
+	GranteeGrantsMethod = "granteeGrants"
+)
+
+// Grants implements the query logic for getting the authorizations given by a
+// granter to a grantee.
+func (p *Precompile) Grants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, input, err := ParseGrantsArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.Grants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantsResponse(input.Granter, input.Grantee, res)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.Authorizations, output.PageResponse)
+}
+
+// GranterGrants implements the query logic for getting the authorizations given by a granter.
+func (p *Precompile) GranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranterGrantsArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.Authorizations, output.PageResponse)
+}
+
+// GranteeGrants implements the query logic for getting the authorizations given to a grantee.
+func (p *Precompile) GranteeGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranteeGrantsArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.Authorizations, output.PageResponse)
+}
@@ -0,0 +1,83 @@
+package authz_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/evmos/evmos/v20/precompiles/authz"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestGrantsQueries() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+
+	granter := s.keyring.GetAddr(0)
+	grantee := s.keyring.GetAddr(1)
+	expiration := ctx.BlockTime().AddDate(1, 0, 0)
+	err := s.network.App.AuthzKeeper.SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), authztypes.NewGenericAuthorization(msgSendTypeURL), &expiration)
+	s.Require().NoError(err)
+
+	expGrant := authz.Grant{
+		Granter:           granter,
+		Grantee:           grantee,
+		AuthorizationType: "/cosmos.authz.v1beta1.GenericAuthorization",
+		MsgTypeUrl:        msgSendTypeURL,
+		Expiration:        expiration.Unix(),
+	}
+
+	var contract *vm.Contract
+	contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter, s.precompile, 200000)
+	pageRequest := query.PageRequest{Limit: 10, CountTotal: true}
+
+	testCases := []struct {
+		name   string
+		method string
+		query  func(p *authz.Precompile) ([]byte, error)
+	}{
+		{
+			"grants by message type",
+			authz.GrantsMethod,
+			func(p *authz.Precompile) ([]byte, error) {
+				method := p.Methods[authz.GrantsMethod]
+				return p.Grants(ctx, &method, contract, []interface{}{granter, grantee, msgSendTypeURL, pageRequest})
+			},
+		},
+		{
+			"grants for all message types",
+			authz.GrantsMethod,
+			func(p *authz.Precompile) ([]byte, error) {
+				method := p.Methods[authz.GrantsMethod]
+				return p.Grants(ctx, &method, contract, []interface{}{granter, grantee, "", pageRequest})
+			},
+		},
+		{
+			"granter grants",
+			authz.GranterGrantsMethod,
+			func(p *authz.Precompile) ([]byte, error) {
+				method := p.Methods[authz.GranterGrantsMethod]
+				return p.GranterGrants(ctx, &method, contract, []interface{}{granter, pageRequest})
+			},
+		},
+		{
+			"grantee grants",
+			authz.GranteeGrantsMethod,
+			func(p *authz.Precompile) ([]byte, error) {
+				method := p.Methods[authz.GranteeGrantsMethod]
+				return p.GranteeGrants(ctx, &method, contract, []interface{}{grantee, pageRequest})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			bz, err := tc.query(s.precompile)
+			s.Require().NoError(err)
+
+			var out authz.GrantsOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, tc.method, bz))
+			s.Require().Equal([]authz.Grant{expGrant}, out.Authorizations)
+		})
+	}
+}
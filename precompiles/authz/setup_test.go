@@ -0,0 +1,41 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v20/precompiles/authz"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *authz.Precompile
+}
+
+func TestPrecompileUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	s.keyring = keyring
+	s.network = nw
+
+	var err error
+	if s.precompile, err = authz.NewPrecompile(
+		s.network.App.AuthzKeeper,
+	); err != nil {
+		panic(err)
+	}
+}
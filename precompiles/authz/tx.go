@@ -0,0 +1,69 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction.
+	GrantMethod = "grant"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+)
+
+// Grant defines a method to grant a generic authorization from the caller to the
+// grantee. The caller is the granter, so contracts can only grant authorizations
+// over their own account.
+func (p Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, input, err := NewMsgGrant(contract.CallerAddress, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.AuthzKeeper.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitGrantAuthorizationEvent(ctx, stateDB, contract.CallerAddress, input); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke defines a method to revoke the authorization granted by the caller to
+// the grantee for a message type.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, input, err := NewMsgRevoke(contract.CallerAddress, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.AuthzKeeper.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeAuthorizationEvent(ctx, stateDB, contract.CallerAddress, input); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
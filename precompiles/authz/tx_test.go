@@ -0,0 +1,203 @@
+package authz_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/precompiles/authz"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var msgSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func (s *PrecompileTestSuite) TestGrant() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GrantMethod]
+	grantee := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, msgSendTypeURL, int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - empty message type URL",
+			func() []interface{} {
+				return []interface{}{grantee, "", int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid message type URL",
+		},
+		{
+			"fail - disabled message type",
+			func() []interface{} {
+				return []interface{}{grantee, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			"cannot be granted through authz",
+		},
+		{
+			"fail - unknown message type",
+			func() []interface{} {
+				return []interface{}{grantee, "/cosmos.bank.v1beta1.MsgUnknown", int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			"doesn't exist",
+		},
+		{
+			"fail - negative expiration",
+			func() []interface{} {
+				return []interface{}{grantee, msgSendTypeURL, int64(-1)}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid expiration",
+		},
+		{
+			"fail - grantee is the granter",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), msgSendTypeURL, int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			authztypes.ErrGranteeIsGranter.Error(),
+		},
+		{
+			"success - grant generic authorization",
+			func() []interface{} {
+				return []interface{}{grantee, msgSendTypeURL, ctx.BlockTime().Unix() + 3600}
+			},
+			func() {
+				authorization, expiration := s.network.App.AuthzKeeper.GetAuthorization(ctx, grantee.Bytes(), s.keyring.GetAccAddr(0), msgSendTypeURL)
+				s.Require().NotNil(authorization)
+				s.Require().IsType(&authztypes.GenericAuthorization{}, authorization)
+				s.Require().Equal(ctx.BlockTime().Unix()+3600, expiration.Unix())
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			_, err := s.precompile.Grant(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.RevokeMethod]
+	grantee := s.keyring.GetAddr(1)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - authorization not found",
+			func() []interface{} {
+				return []interface{}{grantee, msgSendTypeURL}
+			},
+			200000,
+			true,
+			"authorization not found",
+		},
+		{
+			"success - revoke authorization",
+			func() []interface{} {
+				err := s.network.App.AuthzKeeper.SaveGrant(ctx, grantee.Bytes(), s.keyring.GetAccAddr(0), authztypes.NewGenericAuthorization(msgSendTypeURL), nil)
+				s.Require().NoError(err)
+				return []interface{}{grantee, msgSendTypeURL}
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			_, err := s.precompile.Revoke(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				authorization, _ := s.network.App.AuthzKeeper.GetAuthorization(ctx, grantee.Bytes(), s.keyring.GetAccAddr(0), msgSendTypeURL)
+				s.Require().Nil(authorization)
+			}
+		})
+	}
+}
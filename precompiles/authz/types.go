@@ -0,0 +1,274 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// Grant represents an authorization given by a granter to a grantee.
+type Grant struct {
+	Granter           common.Address `abi:"granter"`
+	Grantee           common.Address `abi:"grantee"`
+	AuthorizationType string         `abi:"authorizationType"`
+	MsgTypeUrl        string         `abi:"msgTypeUrl"` //nolint:revive,stylecheck
+	Expiration        int64          `abi:"expiration"`
+}
+
+// GrantInput defines the input for the Grant transaction.
+type GrantInput struct {
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive,stylecheck
+	Expiration int64
+}
+
+// RevokeInput defines the input for the Revoke transaction.
+type RevokeInput struct {
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive,stylecheck
+}
+
+// GrantsInput defines the input for the Grants query.
+type GrantsInput struct {
+	Granter     common.Address
+	Grantee     common.Address
+	MsgTypeUrl  string //nolint:revive,stylecheck
+	PageRequest query.PageRequest
+}
+
+// GranterGrantsInput defines the input for the GranterGrants query.
+type GranterGrantsInput struct {
+	Granter     common.Address
+	PageRequest query.PageRequest
+}
+
+// GranteeGrantsInput defines the input for the GranteeGrants query.
+type GranteeGrantsInput struct {
+	Grantee     common.Address
+	PageRequest query.PageRequest
+}
+
+// GrantsOutput defines the output for the Grants, GranterGrants and GranteeGrants queries.
+type GrantsOutput struct {
+	Authorizations []Grant            `abi:"authorizations"`
+	PageResponse   query.PageResponse `abi:"pageResponse"`
+}
+
+// NewMsgGrant creates a new MsgGrant instance with a generic authorization
+// given by the granter.
+func NewMsgGrant(granter common.Address, method *abi.Method, args []interface{}) (*authz.MsgGrant, *GrantInput, error) {
+	if len(args) != 3 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input GrantInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, nil, fmt.Errorf("error while unpacking args to GrantInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	if input.MsgTypeUrl == "" {
+		return nil, nil, fmt.Errorf(ErrInvalidMsgTypeURL, input.MsgTypeUrl)
+	}
+
+	if slices.Contains(evmtypes.AuthzDisabledMsgTypes, input.MsgTypeUrl) {
+		return nil, nil, fmt.Errorf(ErrDisabledMsgType, input.MsgTypeUrl)
+	}
+
+	if input.Expiration < 0 {
+		return nil, nil, fmt.Errorf(ErrInvalidExpiration, input.Expiration)
+	}
+
+	var expiration *time.Time
+	if input.Expiration > 0 {
+		t := time.Unix(input.Expiration, 0).UTC()
+		expiration = &t
+	}
+
+	msg, err := authz.NewMsgGrant(granter.Bytes(), input.Grantee.Bytes(), authz.NewGenericAuthorization(input.MsgTypeUrl), expiration)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return msg, &input, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke instance for the authorization given by the granter.
+func NewMsgRevoke(granter common.Address, method *abi.Method, args []interface{}) (*authz.MsgRevoke, *RevokeInput, error) {
+	if len(args) != 2 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input RevokeInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, nil, fmt.Errorf("error while unpacking args to RevokeInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	if input.MsgTypeUrl == "" {
+		return nil, nil, fmt.Errorf(ErrInvalidMsgTypeURL, input.MsgTypeUrl)
+	}
+
+	msg := authz.NewMsgRevoke(granter.Bytes(), input.Grantee.Bytes(), input.MsgTypeUrl)
+	return &msg, &input, nil
+}
+
+// ParseGrantsArgs parses the arguments for the Grants query.
+func ParseGrantsArgs(method *abi.Method, args []interface{}) (*authz.QueryGrantsRequest, *GrantsInput, error) {
+	if len(args) != 4 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, nil, fmt.Errorf("error while unpacking args to GrantsInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &authz.QueryGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		MsgTypeUrl: input.MsgTypeUrl,
+		Pagination: &input.PageRequest,
+	}, &input, nil
+}
+
+// ParseGranterGrantsArgs parses the arguments for the GranterGrants query.
+func ParseGranterGrantsArgs(method *abi.Method, args []interface{}) (*authz.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	return &authz.QueryGranterGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: &input.PageRequest,
+	}, nil
+}
+
+// ParseGranteeGrantsArgs parses the arguments for the GranteeGrants query.
+func ParseGranteeGrantsArgs(method *abi.Method, args []interface{}) (*authz.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &authz.QueryGranteeGrantsRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: &input.PageRequest,
+	}, nil
+}
+
+// FromGrantsResponse populates the GrantsOutput from a QueryGrantsResponse,
+// whose grants don't include the granter and the grantee.
+func (gro *GrantsOutput) FromGrantsResponse(granter, grantee common.Address, res *authz.QueryGrantsResponse) (*GrantsOutput, error) {
+	gro.Authorizations = make([]Grant, len(res.Grants))
+	for i, g := range res.Grants {
+		grant, err := newGrant(granter, grantee, g.Authorization, g.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		gro.Authorizations[i] = grant
+	}
+
+	gro.setPageResponse(res.Pagination)
+	return gro, nil
+}
+
+// FromGrantAuthorizations populates the GrantsOutput from the grants and the
+// pagination of a QueryGranterGrantsResponse or a QueryGranteeGrantsResponse.
+func (gro *GrantsOutput) FromGrantAuthorizations(grants []*authz.GrantAuthorization, pageRes *query.PageResponse) (*GrantsOutput, error) {
+	gro.Authorizations = make([]Grant, len(grants))
+	for i, g := range grants {
+		granter, err := sdk.AccAddressFromBech32(g.Granter)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidGranter, g.Granter)
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(g.Grantee)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidGrantee, g.Grantee)
+		}
+
+		grant, err := newGrant(common.BytesToAddress(granter), common.BytesToAddress(grantee), g.Authorization, g.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		gro.Authorizations[i] = grant
+	}
+
+	gro.setPageResponse(pageRes)
+	return gro, nil
+}
+
+func (gro *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		gro.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+}
+
+// newGrant converts an authorization to its ABI representation.
+func newGrant(granter, grantee common.Address, authorizationAny *codectypes.Any, expiration *time.Time) (Grant, error) {
+	authorization, ok := authorizationAny.GetCachedValue().(authz.Authorization)
+	if !ok {
+		return Grant{}, fmt.Errorf("unexpected authorization type %s", authorizationAny.TypeUrl)
+	}
+
+	var expirationUnix int64
+	if expiration != nil {
+		expirationUnix = expiration.Unix()
+	}
+
+	return Grant{
+		Granter:           granter,
+		Grantee:           grantee,
+		AuthorizationType: authorizationAny.TypeUrl,
+		MsgTypeUrl:        authorization.MsgTypeURL(),
+		Expiration:        expirationUnix,
+	}, nil
+}
@@ -15,6 +15,23 @@ struct Balance {
     uint256 amount;
 }
 
+/// @dev TokenMetadata specifies the bank metadata and total supply of the native coin
+/// backing an ERC20 contract.
+struct TokenMetadata {
+    /// denom defines the base denomination of the native coin.
+    string denom;
+    /// name defines the name of the token.
+    string name;
+    /// symbol defines the symbol of the token.
+    string symbol;
+    /// decimals defines the exponent of the display denomination.
+    uint8 decimals;
+    /// display defines the display denomination of the token.
+    string display;
+    /// totalSupply defines the total supply of the native coin.
+    uint256 totalSupply;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
@@ -39,4 +56,18 @@ interface IBank {
     function supplyOf(
         address erc20Address
     ) external view returns (uint256 totalSupply);
+
+    /// @dev totalSupplyOf defines a method for retrieving the total supply of a particular native coin.
+    /// It is an alias of supplyOf.
+    /// @return totalSupply the supply as a uint256
+    function totalSupplyOf(
+        address erc20Address
+    ) external view returns (uint256 totalSupply);
+
+    /// @dev metadata defines a method for retrieving the bank metadata and total supply
+    /// of a particular native coin.
+    /// @return metadata the token metadata of the native coin
+    function metadata(
+        address erc20Address
+    ) external view returns (TokenMetadata memory metadata);
 }
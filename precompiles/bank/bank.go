@@ -30,6 +30,10 @@ const (
 
 	// GasSupplyOf defines the gas cost for a single ERC-20 supplyOf query, taken from totalSupply of ERC20
 	GasSupplyOf = 2_477
+
+	// GasMetadata defines the gas cost for a single token metadata query, taken from the
+	// combined name, symbol and decimals queries of ERC20
+	GasMetadata = 7_000
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -95,8 +99,10 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasBalances
 	case TotalSupplyMethod:
 		return GasTotalSupply
-	case SupplyOfMethod:
+	case SupplyOfMethod, TotalSupplyOfMethod:
 		return GasSupplyOf
+	case MetadataMethod:
+		return GasMetadata
 	}
 
 	return 0
@@ -119,8 +125,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Balances(ctx, contract, method, args)
 	case TotalSupplyMethod:
 		bz, err = p.TotalSupply(ctx, contract, method, args)
-	case SupplyOfMethod:
+	case SupplyOfMethod, TotalSupplyOfMethod:
 		bz, err = p.SupplyOf(ctx, contract, method, args)
+	case MetadataMethod:
+		bz, err = p.Metadata(ctx, contract, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
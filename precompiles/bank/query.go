@@ -22,6 +22,12 @@ const (
 	// SupplyOfMethod defines the ABI method name for the bank SupplyOf
 	// query.
 	SupplyOfMethod = "supplyOf"
+	// TotalSupplyOfMethod defines the ABI method name for the bank SupplyOf
+	// query. It is an alias of supplyOf.
+	TotalSupplyOfMethod = "totalSupplyOf"
+	// MetadataMethod defines the ABI method name for the bank DenomMetadata
+	// query.
+	MetadataMethod = "metadata"
 )
 
 // Balances returns given account's balances of all tokens registered in the x/bank module
@@ -133,3 +139,31 @@ func (p Precompile) SupplyOf(
 
 	return method.Outputs.Pack(supply.Amount.BigInt())
 }
+
+// Metadata returns the bank metadata of a given registered erc20 token
+// together with its total supply. If the ERC20 token doesn't have a registered
+// TokenPair, the method returns empty metadata and a supply of zero. If the
+// token pair is registered but the bank metadata is not, only the denom and
+// the supply are populated.
+func (p Precompile) Metadata(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	erc20ContractAddress, err := ParseSupplyOfArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the metadata in bank precompile: %s", err)
+	}
+
+	tokenPairID := p.erc20Keeper.GetERC20Map(ctx, erc20ContractAddress)
+	tokenPair, found := p.erc20Keeper.GetTokenPair(ctx, tokenPairID)
+	if !found {
+		return method.Outputs.Pack(TokenMetadata{TotalSupply: big.NewInt(0)})
+	}
+
+	supply := p.bankKeeper.GetSupply(ctx, tokenPair.Denom)
+	metadata, _ := p.bankKeeper.GetDenomMetaData(ctx, tokenPair.Denom)
+
+	return method.Outputs.Pack(NewTokenMetadata(tokenPair.Denom, metadata, supply.Amount.BigInt()))
+}
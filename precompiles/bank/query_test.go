@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/precompiles/bank"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
@@ -279,3 +280,119 @@ func (s *PrecompileTestSuite) TestSupplyOf() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestMetadata() {
+	// setup test in order to have s.precompile, s.evmosAddr and s.xmplAddr defined
+	s.SetupTest()
+	method := s.precompile.Methods[bank.MetadataMethod]
+
+	xmplMetadata := banktypes.Metadata{
+		Description: "Example token",
+		Base:        xmplDenom,
+		Display:     "XMPL",
+		Name:        "Example",
+		Symbol:      "XMPL",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: xmplDenom, Exponent: 0},
+			{Denom: "XMPL", Exponent: 18},
+		},
+	}
+
+	testcases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		expErr      bool
+		errContains string
+		expMetadata func() bank.TokenMetadata
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(sdk.Context) []interface{} {
+				return []interface{}{
+					"", "", "",
+				}
+			},
+			true,
+			"invalid number of arguments",
+			nil,
+		},
+		{
+			"fail - invalid hex address",
+			func(sdk.Context) []interface{} {
+				return []interface{}{
+					"random text",
+				}
+			},
+			true,
+			"invalid type for erc20Address",
+			nil,
+		},
+		{
+			"pass - erc20 not registered return empty metadata",
+			func(sdk.Context) []interface{} {
+				return []interface{}{
+					evmosutiltx.GenerateAddress(),
+				}
+			},
+			false,
+			"",
+			func() bank.TokenMetadata {
+				return bank.TokenMetadata{TotalSupply: big.NewInt(0)}
+			},
+		},
+		{
+			"pass - XMPL metadata and total supply",
+			func(ctx sdk.Context) []interface{} {
+				s.network.App.BankKeeper.SetDenomMetaData(ctx, xmplMetadata)
+				return []interface{}{
+					s.xmplAddr,
+				}
+			},
+			false,
+			"",
+			func() bank.TokenMetadata {
+				supply := s.network.App.BankKeeper.GetSupply(s.network.GetContext(), xmplDenom)
+				return bank.TokenMetadata{
+					Denom:       xmplDenom,
+					Name:        "Example",
+					Symbol:      "XMPL",
+					Decimals:    18,
+					Display:     "XMPL",
+					TotalSupply: supply.Amount.BigInt(),
+				}
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx := s.SetupTest()
+
+			bz, err := s.precompile.Metadata(
+				ctx,
+				nil,
+				&method,
+				tc.malleate(ctx),
+			)
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out struct {
+					Metadata bank.TokenMetadata
+				}
+				err = s.precompile.UnpackIntoInterface(&out, bank.MetadataMethod, bz)
+				s.Require().NoError(err, "expected no error unpacking")
+				expMetadata := tc.expMetadata()
+				s.Require().Equal(expMetadata.Denom, out.Metadata.Denom)
+				s.Require().Equal(expMetadata.Name, out.Metadata.Name)
+				s.Require().Equal(expMetadata.Symbol, out.Metadata.Symbol)
+				s.Require().Equal(expMetadata.Decimals, out.Metadata.Decimals)
+				s.Require().Equal(expMetadata.Display, out.Metadata.Display)
+				s.Require().Equal(expMetadata.TotalSupply.String(), out.Metadata.TotalSupply.String())
+			}
+		})
+	}
+}
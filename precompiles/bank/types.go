@@ -5,9 +5,11 @@ package bank
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 )
@@ -18,6 +20,39 @@ type Balance struct {
 	Amount          *big.Int
 }
 
+// TokenMetadata contains the bank metadata and the total supply of the native
+// coin backing a registered ERC-20 contract.
+type TokenMetadata struct {
+	Denom       string
+	Name        string
+	Symbol      string
+	Decimals    uint8
+	Display     string
+	TotalSupply *big.Int
+}
+
+// NewTokenMetadata creates a TokenMetadata from the given bank metadata and
+// supply. The decimals are the exponent of the display denomination unit. An
+// empty bank metadata results in zero decimals and empty descriptive fields.
+func NewTokenMetadata(denom string, metadata banktypes.Metadata, supply *big.Int) TokenMetadata {
+	var decimals uint8
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display && unit.Exponent <= math.MaxUint8 {
+			decimals = uint8(unit.Exponent)
+			break
+		}
+	}
+
+	return TokenMetadata{
+		Denom:       denom,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+		Decimals:    decimals,
+		Display:     metadata.Display,
+		TotalSupply: supply,
+	}
+}
+
 // ParseBalancesArgs parses the call arguments for the bank Balances query.
 func ParseBalancesArgs(args []interface{}) (sdk.AccAddress, error) {
 	if len(args) != 1 {
@@ -28,4 +28,28 @@ interface Bech32I {
     function bech32ToHex(
         string memory bech32Address
     ) external returns (address addr);
+
+    /// @dev Defines a method for converting a list of hex formatted addresses to
+    /// bech32. Each address is converted with the prefix at the same index, so
+    /// that the addresses of different chains can be converted in a single call.
+    /// @param addrs The hex addresses to be converted.
+    /// @param prefixes The human readable prefixes (HRP) of the bech32 addresses.
+    /// @return bech32Addresses The addresses in bech32 format.
+    function hexToBech32Batch(
+        address[] memory addrs,
+        string[] memory prefixes
+    ) external returns (string[] memory bech32Addresses);
+
+    /// @dev Defines a method for converting a list of bech32 formatted addresses
+    /// to hex. The addresses can have different human readable prefixes.
+    /// @param bech32Addresses The bech32 addresses to be converted.
+    /// @return addrs The addresses in hex format.
+    function bech32ToHexBatch(
+        string[] memory bech32Addresses
+    ) external returns (address[] memory addrs);
+
+    /// @dev Defines a method for querying the human readable prefixes (HRP) of
+    /// the Cosmos chains registered through the EVM module parameters.
+    /// @return hrps The registered human readable prefixes.
+    function registeredHRPs() external view returns (string[] memory hrps);
 }
@@ -5,12 +5,15 @@ package bech32
 
 import (
 	"embed"
+	"errors"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -21,15 +24,22 @@ var _ vm.PrecompiledContract = &Precompile{}
 //go:embed abi.json
 var f embed.FS
 
+// HRPKeeper defines the expected interface to query the registry of bech32
+// human readable prefixes. It is implemented by the EVM module keeper.
+type HRPKeeper interface {
+	GetBech32HRPs(ctx sdk.Context) []string
+}
+
 // Precompile defines the precompiled contract for Bech32 encoding.
 type Precompile struct {
 	abi.ABI
-	baseGas uint64
+	baseGas   uint64
+	hrpKeeper HRPKeeper
 }
 
 // NewPrecompile creates a new bech32 Precompile instance as a
 // PrecompiledContract interface.
-func NewPrecompile(baseGas uint64) (*Precompile, error) {
+func NewPrecompile(baseGas uint64, hrpKeeper HRPKeeper) (*Precompile, error) {
 	newABI, err := cmn.LoadABI(f, "abi.json")
 	if err != nil {
 		return nil, err
@@ -40,8 +50,9 @@ func NewPrecompile(baseGas uint64) (*Precompile, error) {
 	}
 
 	return &Precompile{
-		ABI:     newABI,
-		baseGas: baseGas,
+		ABI:       newABI,
+		baseGas:   baseGas,
+		hrpKeeper: hrpKeeper,
 	}, nil
 }
 
@@ -50,13 +61,45 @@ func (Precompile) Address() common.Address {
 	return common.HexToAddress(evmtypes.Bech32PrecompileAddress)
 }
 
-// RequiredGas calculates the contract gas use.
-func (p Precompile) RequiredGas(_ []byte) uint64 {
-	return p.baseGas
+// RequiredGas calculates the contract gas use. The batch conversions are charged
+// the base gas for each of the converted addresses.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return p.baseGas
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		return p.baseGas
+	}
+
+	switch method.Name {
+	case HexToBech32BatchMethod, Bech32ToHexBatchMethod:
+		args, err := method.Inputs.Unpack(input[4:])
+		if err != nil || len(args) == 0 {
+			return p.baseGas
+		}
+
+		var count int
+		switch addresses := args[0].(type) {
+		case []common.Address:
+			count = len(addresses)
+		case []string:
+			count = len(addresses)
+		}
+
+		if count <= 1 {
+			return p.baseGas
+		}
+		return uint64(count) * p.baseGas //nolint:gosec // G115
+	default:
+		return p.baseGas
+	}
 }
 
 // Run executes the precompiled contract bech32 methods defined in the ABI.
-func (p Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
 	// NOTE: This check avoid panicking when trying to decode the method ID
 	if len(contract.Input) < 4 {
 		return nil, vm.ErrExecutionReverted
@@ -81,6 +124,16 @@ func (p Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, er
 		bz, err = p.HexToBech32(method, args)
 	case Bech32ToHexMethod:
 		bz, err = p.Bech32ToHex(method, args)
+	case HexToBech32BatchMethod:
+		bz, err = p.HexToBech32Batch(method, args)
+	case Bech32ToHexBatchMethod:
+		bz, err = p.Bech32ToHexBatch(method, args)
+	case RegisteredHRPsMethod:
+		stateDB, ok := evm.StateDB.(*statedb.StateDB)
+		if !ok {
+			return nil, errors.New(cmn.ErrNotRunInEvm)
+		}
+		bz, err = p.RegisteredHRPs(stateDB.GetContext(), method, args)
 	}
 
 	if err != nil {
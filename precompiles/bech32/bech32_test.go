@@ -35,7 +35,7 @@ func (s *PrecompileTestSuite) TestNewPrecompile() {
 		s.Run(tc.name, func() {
 			// setup basic test suite
 			s.SetupTest()
-			p, err := bech32.NewPrecompile(tc.baseGas)
+			p, err := bech32.NewPrecompile(tc.baseGas, s.network.App.EvmKeeper)
 			if tc.expPass {
 				s.Require().NoError(err)
 				s.Require().NotNil(p)
@@ -20,6 +20,15 @@ const (
 	// Bech32ToHexMethod defines the ABI method name to convert a bech32
 	// formatted address string to an EIP-55 address.
 	Bech32ToHexMethod = "bech32ToHex"
+	// HexToBech32BatchMethod defines the ABI method name to convert a list of
+	// EIP-55 hex formatted addresses to bech32 address strings.
+	HexToBech32BatchMethod = "hexToBech32Batch"
+	// Bech32ToHexBatchMethod defines the ABI method name to convert a list of
+	// bech32 formatted address strings to EIP-55 addresses.
+	Bech32ToHexBatchMethod = "bech32ToHexBatch"
+	// RegisteredHRPsMethod defines the ABI method name to query the registered
+	// bech32 human readable prefixes.
+	RegisteredHRPsMethod = "registeredHRPs"
 )
 
 // HexToBech32 converts a hex address to its corresponding Bech32 format. The Human Readable Prefix
@@ -38,22 +47,8 @@ func (p Precompile) HexToBech32(
 		return nil, fmt.Errorf("invalid hex address")
 	}
 
-	cfg := sdk.GetConfig()
-
 	prefix, _ := args[1].(string)
-	if strings.TrimSpace(prefix) == "" {
-		return nil, fmt.Errorf(
-			"invalid bech32 human readable prefix (HRP). Please provide a either an account, validator or consensus address prefix (eg: %s, %s, %s)",
-			cfg.GetBech32AccountAddrPrefix(), cfg.GetBech32ValidatorAddrPrefix(), cfg.GetBech32ConsensusAddrPrefix(),
-		)
-	}
-
-	// NOTE: safety check, should not happen given that the address is 20 bytes.
-	if err := sdk.VerifyAddressFormat(address.Bytes()); err != nil {
-		return nil, err
-	}
-
-	bech32Str, err := sdk.Bech32ifyAddressBytes(prefix, address.Bytes())
+	bech32Str, err := hexToBech32(address, prefix)
 	if err != nil {
 		return nil, err
 	}
@@ -77,19 +72,129 @@ func (p Precompile) Bech32ToHex(
 		return nil, fmt.Errorf("invalid bech32 address: %v", args[0])
 	}
 
+	hexAddr, err := bech32ToHex(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(hexAddr)
+}
+
+// HexToBech32Batch converts a list of hex addresses to their corresponding Bech32 format. Each
+// address is converted with the Human Readable Prefix (HRP) at the same index of the prefixes, so
+// that the addresses of different chains can be converted in a single call. This function fails if
+// any of the conversions fails.
+func (p Precompile) HexToBech32Batch(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	addresses, ok := args[0].([]common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid hex addresses: %v", args[0])
+	}
+
+	prefixes, ok := args[1].([]string)
+	if !ok {
+		return nil, fmt.Errorf("invalid bech32 human readable prefixes: %v", args[1])
+	}
+
+	if len(addresses) != len(prefixes) {
+		return nil, fmt.Errorf("addresses and prefixes length mismatch: %d != %d", len(addresses), len(prefixes))
+	}
+
+	bech32Strs := make([]string, len(addresses))
+	for i, address := range addresses {
+		bech32Str, err := hexToBech32(address, prefixes[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert address at index %d: %w", i, err)
+		}
+		bech32Strs[i] = bech32Str
+	}
+
+	return method.Outputs.Pack(bech32Strs)
+}
+
+// Bech32ToHexBatch converts a list of bech32 addresses to their corresponding EIP-55 hex format.
+// The addresses can have different Human Readable Prefixes (HRP). This function fails if any of
+// the conversions fails.
+func (p Precompile) Bech32ToHexBatch(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	addresses, ok := args[0].([]string)
+	if !ok {
+		return nil, fmt.Errorf("invalid bech32 addresses: %v", args[0])
+	}
+
+	hexAddrs := make([]common.Address, len(addresses))
+	for i, address := range addresses {
+		hexAddr, err := bech32ToHex(address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert address at index %d: %w", i, err)
+		}
+		hexAddrs[i] = hexAddr
+	}
+
+	return method.Outputs.Pack(hexAddrs)
+}
+
+// RegisteredHRPs returns the bech32 Human Readable Prefixes (HRP) of the Cosmos chains registered
+// through the governance of the EVM module parameters.
+func (p Precompile) RegisteredHRPs(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	hrps := p.hrpKeeper.GetBech32HRPs(ctx)
+	if hrps == nil {
+		hrps = []string{}
+	}
+
+	return method.Outputs.Pack(hrps)
+}
+
+// hexToBech32 converts a hex address to bech32 with the given Human Readable Prefix (HRP).
+func hexToBech32(address common.Address, prefix string) (string, error) {
+	if strings.TrimSpace(prefix) == "" {
+		cfg := sdk.GetConfig()
+		return "", fmt.Errorf(
+			"invalid bech32 human readable prefix (HRP). Please provide a either an account, validator or consensus address prefix (eg: %s, %s, %s)",
+			cfg.GetBech32AccountAddrPrefix(), cfg.GetBech32ValidatorAddrPrefix(), cfg.GetBech32ConsensusAddrPrefix(),
+		)
+	}
+
+	// NOTE: safety check, should not happen given that the address is 20 bytes.
+	if err := sdk.VerifyAddressFormat(address.Bytes()); err != nil {
+		return "", err
+	}
+
+	return sdk.Bech32ifyAddressBytes(prefix, address.Bytes())
+}
+
+// bech32ToHex converts a bech32 address to hex, using the Human Readable Prefix (HRP) of the
+// address itself.
+func bech32ToHex(address string) (common.Address, error) {
 	bech32Prefix := strings.SplitN(address, "1", 2)[0]
 	if bech32Prefix == address {
-		return nil, fmt.Errorf("invalid bech32 address: %s", address)
+		return common.Address{}, fmt.Errorf("invalid bech32 address: %s", address)
 	}
 
 	addressBz, err := sdk.GetFromBech32(address, bech32Prefix)
 	if err != nil {
-		return nil, err
+		return common.Address{}, err
 	}
 
 	if err := sdk.VerifyAddressFormat(addressBz); err != nil {
-		return nil, err
+		return common.Address{}, err
 	}
 
-	return method.Outputs.Pack(common.BytesToAddress(addressBz))
+	return common.BytesToAddress(addressBz), nil
 }
@@ -200,3 +200,213 @@ func (s *PrecompileTestSuite) TestBech32ToHex() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestHexToBech32Batch() {
+	method := s.precompile.Methods[bech32.HexToBech32BatchMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - invalid args length",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - addresses and prefixes length mismatch",
+			func() []interface{} {
+				return []interface{}{
+					[]common.Address{s.keyring.GetAddr(0), s.keyring.GetAddr(1)},
+					[]string{config.Bech32Prefix},
+				}
+			},
+			func([]byte) {},
+			true,
+			"addresses and prefixes length mismatch: 2 != 1",
+		},
+		{
+			"fail - invalid bech32 HRP",
+			func() []interface{} {
+				return []interface{}{
+					[]common.Address{s.keyring.GetAddr(0), s.keyring.GetAddr(1)},
+					[]string{config.Bech32Prefix, ""},
+				}
+			},
+			func([]byte) {},
+			true,
+			"failed to convert address at index 1",
+		},
+		{
+			"pass - addresses of multiple chains",
+			func() []interface{} {
+				return []interface{}{
+					[]common.Address{s.keyring.GetAddr(0), s.keyring.GetAddr(1)},
+					[]string{config.Bech32Prefix, "cosmos"},
+				}
+			},
+			func(data []byte) {
+				args, err := s.precompile.Unpack(bech32.HexToBech32BatchMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(args, 1)
+				addrs, ok := args[0].([]string)
+				s.Require().True(ok)
+
+				cosmosAddr, err := sdk.Bech32ifyAddressBytes("cosmos", s.keyring.GetAddr(1).Bytes())
+				s.Require().NoError(err)
+				s.Require().Equal([]string{s.keyring.GetAccAddr(0).String(), cosmosAddr}, addrs)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.HexToBech32Batch(&method, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().ErrorContains(err, tc.errContains, err.Error())
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestBech32ToHexBatch() {
+	method := s.precompile.Methods[bech32.Bech32ToHexBatchMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - invalid args length",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid bech32 address",
+			func() []interface{} {
+				return []interface{}{
+					[]string{s.keyring.GetAccAddr(0).String(), "invalid"},
+				}
+			},
+			func([]byte) {},
+			true,
+			"failed to convert address at index 1",
+		},
+		{
+			"pass - addresses of multiple chains",
+			func() []interface{} {
+				cosmosAddr, err := sdk.Bech32ifyAddressBytes("cosmos", s.keyring.GetAddr(1).Bytes())
+				s.Require().NoError(err)
+				return []interface{}{
+					[]string{s.keyring.GetAccAddr(0).String(), cosmosAddr},
+				}
+			},
+			func(data []byte) {
+				args, err := s.precompile.Unpack(bech32.Bech32ToHexBatchMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(args, 1)
+				addrs, ok := args[0].([]common.Address)
+				s.Require().True(ok)
+				s.Require().Equal([]common.Address{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}, addrs)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.Bech32ToHexBatch(&method, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().ErrorContains(err, tc.errContains, err.Error())
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRegisteredHRPs() {
+	method := s.precompile.Methods[bech32.RegisteredHRPsMethod]
+
+	testCases := []struct {
+		name    string
+		hrps    []string
+		expHRPs []string
+	}{
+		{
+			"pass - no registered HRPs",
+			nil,
+			[]string{},
+		},
+		{
+			"pass - registered HRPs",
+			[]string{"cosmos", "osmo"},
+			[]string{"cosmos", "osmo"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+
+			params := s.network.App.EvmKeeper.GetParams(ctx)
+			params.Bech32HRPs = tc.hrps
+			s.Require().NoError(s.network.App.EvmKeeper.SetParams(ctx, params))
+
+			bz, err := s.precompile.RegisteredHRPs(ctx, &method, nil)
+			s.Require().NoError(err)
+
+			args, err := s.precompile.Unpack(bech32.RegisteredHRPsMethod, bz)
+			s.Require().NoError(err, "failed to unpack output")
+			s.Require().Len(args, 1)
+			hrps, ok := args[0].([]string)
+			s.Require().True(ok)
+			s.Require().Equal(tc.expHRPs, hrps)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRequiredGas() {
+	addrs := []common.Address{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+	input, err := s.precompile.Pack(bech32.HexToBech32BatchMethod, addrs, []string{config.Bech32Prefix, config.Bech32Prefix})
+	s.Require().NoError(err)
+	s.Require().Equal(2*uint64(6000), s.precompile.RequiredGas(input))
+
+	input, err = s.precompile.Pack(bech32.HexToBech32Method, addrs[0], config.Bech32Prefix)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(6000), s.precompile.RequiredGas(input))
+}
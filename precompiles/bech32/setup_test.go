@@ -37,7 +37,7 @@ func (s *PrecompileTestSuite) SetupTest() {
 	s.keyring = keyring
 	s.network = integrationNetwork
 
-	precompile, err := bech32.NewPrecompile(6000)
+	precompile, err := bech32.NewPrecompile(6000, s.network.App.EvmKeeper)
 	s.Require().NoError(err, "failed to create bech32 precompile")
 
 	s.precompile = precompile
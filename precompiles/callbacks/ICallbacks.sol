@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @author Evmos Team
+/// @title IBC Callbacks Interface
+/// @dev The interface that solidity contracts implement to be called back on the
+/// acknowledgement or the timeout of the IBC packets they sent, e.g. through the
+/// ICS20 precompile. To request the callback, the contract must be the sender of
+/// the packet and set its own address in the memo of the transfer:
+/// {"src_callback": {"address": "<contract hex address>"}}
+/// The callbacks are executed with the gas limit of the relayer's transaction,
+/// capped by the chain, and their failure doesn't revert the IBC packet lifecycle.
+interface ICallbacks {
+    /// @dev Called when the acknowledgement of a packet sent by the contract is
+    /// received.
+    /// @param channelId The source channel of the packet.
+    /// @param portId The source port of the packet.
+    /// @param sequence The sequence of the packet.
+    /// @param data The data of the packet.
+    /// @param acknowledgement The acknowledgement of the packet, as written by the
+    /// destination chain.
+    function onPacketAcknowledgement(
+        string calldata channelId,
+        string calldata portId,
+        uint64 sequence,
+        bytes calldata data,
+        bytes calldata acknowledgement
+    ) external;
+
+    /// @dev Called when a packet sent by the contract times out.
+    /// @param channelId The source channel of the packet.
+    /// @param portId The source port of the packet.
+    /// @param sequence The sequence of the packet.
+    /// @param data The data of the packet.
+    function onPacketTimeout(
+        string calldata channelId,
+        string calldata portId,
+        uint64 sequence,
+        bytes calldata data
+    ) external;
+}
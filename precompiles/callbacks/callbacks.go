@@ -0,0 +1,32 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package callbacks
+
+import (
+	"embed"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+const (
+	// OnPacketAcknowledgementMethod defines the ABI method name of the callback
+	// executed on the acknowledgement of a packet.
+	OnPacketAcknowledgementMethod = "onPacketAcknowledgement"
+	// OnPacketTimeoutMethod defines the ABI method name of the callback executed
+	// on the timeout of a packet.
+	OnPacketTimeoutMethod = "onPacketTimeout"
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// LoadABI loads the ABI of the interface that the contracts implement to be
+// called back on the lifecycle of the IBC packets they sent.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -145,3 +146,37 @@ func LoadABI(fs embed.FS, path string) (abi.ABI, error) {
 
 	return contract.ABI, nil
 }
+
+// CheckSelectorCollisions returns an error if two methods of the given ABIs
+// share the same 4-byte selector but have different signatures.
+//
+// Precompiles resolve the called method from the selector and dispatch it by
+// name, so a selector shared by two different methods would make the resolution
+// depend on the iteration order of the ABI methods. Methods with identical
+// signatures, e.g. the ERC-20 methods inherited by the WERC-20 ABI, are allowed.
+func CheckSelectorCollisions(abis ...abi.ABI) error {
+	signatures := make(map[[4]byte]string)
+	for _, contractABI := range abis {
+		// NOTE: iterate over the sorted method names so that the reported
+		// collision is deterministic.
+		names := make([]string, 0, len(contractABI.Methods))
+		for name := range contractABI.Methods {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			method := contractABI.Methods[name]
+
+			var selector [4]byte
+			copy(selector[:], method.ID)
+
+			if sig, found := signatures[selector]; found && sig != method.Sig {
+				return fmt.Errorf(ErrSelectorCollision, selector, sig, method.Sig)
+			}
+			signatures[selector] = method.Sig
+		}
+	}
+
+	return nil
+}
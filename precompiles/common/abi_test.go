@@ -0,0 +1,69 @@
+package common_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// transferABI contains the ERC-20 transfer method, which has the selector 0xa9059cbb.
+	transferABI = `[{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}]`
+	// collidingABI contains a method, which has the same selector as the ERC-20 transfer method.
+	collidingABI = `[{"type":"function","name":"many_msg_babbage","stateMutability":"nonpayable","inputs":[{"name":"","type":"bytes1"}],"outputs":[]}]`
+	// bothMethodsABI contains both the transfer method and the colliding method.
+	bothMethodsABI = `[
+		{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+		{"type":"function","name":"many_msg_babbage","stateMutability":"nonpayable","inputs":[{"name":"","type":"bytes1"}],"outputs":[]}
+	]`
+	// depositABI contains a method without selector collisions with the transfer method.
+	depositABI = `[{"type":"function","name":"deposit","stateMutability":"payable","inputs":[],"outputs":[]}]`
+)
+
+func TestCheckSelectorCollisions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		abis        []string
+		errContains string
+	}{
+		{
+			name: "pass - no collisions",
+			abis: []string{transferABI, depositABI},
+		},
+		{
+			name: "pass - same method in different ABIs",
+			abis: []string{transferABI, transferABI},
+		},
+		{
+			name:        "fail - colliding selectors in different ABIs",
+			abis:        []string{transferABI, collidingABI},
+			errContains: "method selector collision for 0xa9059cbb: transfer(address,uint256) and many_msg_babbage(bytes1)",
+		},
+		{
+			name:        "fail - colliding selectors in the same ABI",
+			abis:        []string{bothMethodsABI},
+			errContains: "method selector collision for 0xa9059cbb: many_msg_babbage(bytes1) and transfer(address,uint256)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			abis := make([]abi.ABI, len(tc.abis))
+			for i, abiJSON := range tc.abis {
+				contractABI, err := abi.JSON(strings.NewReader(abiJSON))
+				require.NoError(t, err)
+				abis[i] = contractABI
+			}
+
+			err := common.CheckSelectorCollisions(abis...)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
@@ -12,8 +12,14 @@ const (
 	ErrSpenderDifferentOrigin = "tx origin address %s does not match the spender address %s"
 	// ErrInvalidABI is raised when the ABI cannot be parsed.
 	ErrInvalidABI = "invalid ABI: %w"
+	// ErrInvalidGasPrice is raised when the gas price is nil or negative.
+	ErrInvalidGasPrice = "invalid gas price: %v"
 	// ErrInvalidAmount is raised when the amount cannot be cast to a big.Int.
 	ErrInvalidAmount = "invalid amount: %v"
+	// ErrInexactScaling is raised when scaling an amount would lose precision.
+	ErrInexactScaling = "amount %s cannot be scaled from %d to %d decimals without losing precision"
+	// ErrInvalidRoundingMode is raised when the rounding mode is not known.
+	ErrInvalidRoundingMode = "invalid rounding mode: %d"
 	// ErrInvalidHexAddress is raised when the hex address is not valid.
 	ErrInvalidHexAddress = "invalid hex address address: %s"
 	// ErrInvalidDelegator is raised when the delegator address is not valid.
@@ -28,6 +34,8 @@ const (
 	ErrInvalidNumberOfArgs = "invalid number of arguments; expected %d; got: %d"
 	// ErrUnknownMethod is raised when the method is not known.
 	ErrUnknownMethod = "unknown method: %s"
+	// ErrSelectorCollision is raised when two different methods share the same 4-byte selector.
+	ErrSelectorCollision = "method selector collision for 0x%x: %s and %s"
 	// ErrIntegerOverflow is raised when an integer overflow occurs.
 	ErrIntegerOverflow = "integer overflow when increasing allowance"
 	// ErrNegativeAmount is raised when an amount is negative.
@@ -36,6 +44,8 @@ const (
 	ErrInvalidType = "invalid type for %s: expected %T, received %T"
 	// ErrInvalidDescription is raised when the input description cannot be cast to stakingtypes.Description{}.
 	ErrInvalidDescription = "invalid description: %v"
+	// ErrReentrantCall is raised when a guarded method is called while the precompile is executing a guarded method.
+	ErrReentrantCall = "reentrant call to method %s of precompile %s"
 	// ErrInvalidCommission is raised when the input commission cannot be cast to stakingtypes.CommissionRates{}.
 	ErrInvalidCommission = "invalid commission: %v"
 )
@@ -17,15 +17,25 @@ import (
 )
 
 // Precompile is a common struct for all precompiles that holds the common data each
-// precompile needs to run which includes the ABI, Gas config, approval expiration and the authz keeper.
+// precompile needs to run which includes the ABI, Gas config, approval expiration,
+// the authz keeper and the reentrancy guard.
 type Precompile struct {
 	abi.ABI
 	AuthzKeeper          authzkeeper.Keeper
 	ApprovalExpiration   time.Duration
 	KvGasConfig          storetypes.GasConfig
 	TransientKVGasConfig storetypes.GasConfig
-	address              common.Address
-	journalEntries       []balanceChangeEntry
+	// ReentrancyGuard blocks the reentrant calls to the guarded methods of the
+	// precompile. The reentrant calls are allowed if it is nil.
+	ReentrancyGuard *ReentrancyGuard
+	address         common.Address
+	journalEntries  []balanceChangeEntry
+}
+
+// ABIPrecompile is a precompile that exposes the ABI its methods are resolved
+// from.
+type ABIPrecompile interface {
+	GetABI() abi.ABI
 }
 
 // Operation is a type that defines if the precompile call
@@ -150,6 +160,13 @@ func (p Precompile) RunSetup(
 	// we need to consume the gas that was already used by the EVM
 	ctx.GasMeter().ConsumeGas(initialGas, "creating a new gas meter")
 
+	// mark the precompile as entered, so that the calls made through the EVM
+	// with the returned context can't reenter its guarded methods
+	ctx, err = p.ReentrancyGuard.Enter(ctx, p.Address(), method.Name)
+	if err != nil {
+		return sdk.Context{}, nil, s, nil, uint64(0), nil, err
+	}
+
 	return ctx, stateDB, s, method, initialGas, args, nil
 }
 
@@ -204,6 +221,17 @@ func (p *Precompile) SetBalanceChangeEntries(entries ...balanceChangeEntry) {
 	p.journalEntries = entries
 }
 
+// AddBalanceChangeEntries appends the balanceChange entries
+// to the journalEntries field of the precompile.
+func (p *Precompile) AddBalanceChangeEntries(entries ...balanceChangeEntry) {
+	p.journalEntries = append(p.journalEntries, entries...)
+}
+
+// GetABI returns the ABI of the precompile.
+func (p Precompile) GetABI() abi.ABI {
+	return p.ABI
+}
+
 func (p Precompile) Address() common.Address {
 	return p.address
 }
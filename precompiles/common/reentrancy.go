@@ -0,0 +1,83 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package common
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// enteredPrecompilesKey is the context key of the stateful precompiles that are
+// executing a guarded method.
+type enteredPrecompilesKey struct{}
+
+// enteredPrecompile is a stateful precompile that is executing a guarded method.
+// The entries form a linked list from the innermost to the outermost call, so
+// that the context of a call never sees the entries of the calls it made.
+type enteredPrecompile struct {
+	address common.Address
+	parent  *enteredPrecompile
+}
+
+// ReentrancyGuard blocks the reentrant calls into a stateful precompile. A call
+// to a guarded method fails while a guarded method of the same precompile is
+// being executed, e.g. when a hook invoked by the precompile calls back into it
+// through the EVM. The calls to the methods that are not guarded are allowed.
+//
+// NOTE: the entered precompiles are tracked in the context returned by
+// RunSetup, so the guard only covers the nested EVMs created from that
+// context, such as the ones of the keeper calls made by the hooks. A nested EVM
+// created from another context doesn't see the entered precompiles.
+type ReentrancyGuard struct {
+	methods map[string]bool
+}
+
+// NewReentrancyGuard creates a new ReentrancyGuard for the given method names.
+func NewReentrancyGuard(methods ...string) *ReentrancyGuard {
+	guarded := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		guarded[method] = true
+	}
+	return &ReentrancyGuard{methods: guarded}
+}
+
+// IsGuarded returns true if the method with the given name is guarded.
+func (g *ReentrancyGuard) IsGuarded(method string) bool {
+	return g != nil && g.methods[method]
+}
+
+// Enter marks the precompile at the given address as executing the given
+// method and returns the context to be used for the execution. It returns an
+// error if the method is guarded and the precompile is already executing a
+// guarded method. The context is returned unchanged for the methods that are
+// not guarded.
+func (g *ReentrancyGuard) Enter(ctx sdk.Context, address common.Address, method string) (sdk.Context, error) {
+	if !g.IsGuarded(method) {
+		return ctx, nil
+	}
+
+	if IsEntered(ctx, address) {
+		return ctx, fmt.Errorf(ErrReentrantCall, method, address)
+	}
+
+	parent, _ := ctx.Value(enteredPrecompilesKey{}).(*enteredPrecompile)
+	return ctx.WithValue(enteredPrecompilesKey{}, &enteredPrecompile{
+		address: address,
+		parent:  parent,
+	}), nil
+}
+
+// IsEntered returns true if the precompile at the given address is executing a
+// guarded method in the given context.
+func IsEntered(ctx sdk.Context, address common.Address) bool {
+	entry, _ := ctx.Value(enteredPrecompilesKey{}).(*enteredPrecompile)
+	for ; entry != nil; entry = entry.parent {
+		if entry.address == address {
+			return true
+		}
+	}
+	return false
+}
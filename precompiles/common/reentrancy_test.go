@@ -0,0 +1,49 @@
+package common_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReentrancyGuard(t *testing.T) {
+	precompile := gethcommon.HexToAddress("0x0000000000000000000000000000000000000800")
+	other := gethcommon.HexToAddress("0x0000000000000000000000000000000000000801")
+	guard := common.NewReentrancyGuard("transfer")
+	ctx := sdk.Context{}.WithContext(context.Background())
+
+	// methods that are not guarded don't enter the precompile
+	queryCtx, err := guard.Enter(ctx, precompile, "balanceOf")
+	require.NoError(t, err)
+	require.False(t, common.IsEntered(queryCtx, precompile))
+
+	transferCtx, err := guard.Enter(ctx, precompile, "transfer")
+	require.NoError(t, err)
+	require.True(t, common.IsEntered(transferCtx, precompile))
+	require.False(t, common.IsEntered(ctx, precompile), "expected the parent context to be unchanged")
+
+	// reentrant calls to guarded methods fail
+	_, err = guard.Enter(transferCtx, precompile, "transfer")
+	require.ErrorContains(t, err, "reentrant call to method transfer")
+
+	// reentrant calls to methods that are not guarded are allowed
+	_, err = guard.Enter(transferCtx, precompile, "balanceOf")
+	require.NoError(t, err)
+
+	// calls to other precompiles are allowed and keep the entered precompiles
+	otherCtx, err := guard.Enter(transferCtx, other, "transfer")
+	require.NoError(t, err)
+	require.True(t, common.IsEntered(otherCtx, precompile))
+	require.True(t, common.IsEntered(otherCtx, other))
+	_, err = guard.Enter(otherCtx, precompile, "transfer")
+	require.Error(t, err)
+
+	// a nil guard doesn't guard any method
+	var nilGuard *common.ReentrancyGuard
+	_, err = nilGuard.Enter(transferCtx, precompile, "transfer")
+	require.NoError(t, err)
+}
@@ -0,0 +1,79 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package common
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+// CosmosErrorSignature is the signature of the custom Solidity error that
+// is used to revert with the codespace and code of a Cosmos SDK error.
+const CosmosErrorSignature = "CosmosError(string,uint32,string)"
+
+var (
+	// cosmosErrorID is the 4-byte selector of the CosmosError custom error.
+	cosmosErrorID = crypto.Keccak256([]byte(CosmosErrorSignature))[:4]
+
+	// cosmosErrorArgs are the arguments of the CosmosError custom error.
+	cosmosErrorArgs = abi.Arguments{
+		{Name: "codespace", Type: mustNewType("string")},
+		{Name: "code", Type: mustNewType("uint32")},
+		{Name: "message", Type: mustNewType("string")},
+	}
+)
+
+// RevertWithCosmosError returns the revert data of the CosmosError custom error
+// together with the execution reverted error if the given error wraps a
+// registered Cosmos SDK error, so that callers can branch on its codespace and
+// code. The full error message is kept as the message of the custom error.
+// Any other error is returned unchanged.
+func RevertWithCosmosError(err error) ([]byte, error) {
+	var sdkErr *errorsmod.Error
+	if !errors.As(err, &sdkErr) {
+		return nil, err
+	}
+
+	packed, packErr := cosmosErrorArgs.Pack(sdkErr.Codespace(), sdkErr.ABCICode(), err.Error())
+	if packErr != nil {
+		return nil, err
+	}
+
+	revertData := make([]byte, 0, len(cosmosErrorID)+len(packed))
+	revertData = append(revertData, cosmosErrorID...)
+	revertData = append(revertData, packed...)
+
+	return revertData, vm.ErrExecutionReverted
+}
+
+// UnpackCosmosError decodes the codespace, code and message from the revert
+// data of the CosmosError custom error.
+func UnpackCosmosError(revertData []byte) (codespace string, code uint32, message string, err error) {
+	if len(revertData) < len(cosmosErrorID) || !bytes.Equal(revertData[:len(cosmosErrorID)], cosmosErrorID) {
+		return "", 0, "", fmt.Errorf("revert data is not a %s error", CosmosErrorSignature)
+	}
+
+	out, err := cosmosErrorArgs.Unpack(revertData[len(cosmosErrorID):])
+	if err != nil {
+		return "", 0, "", err
+	}
+
+	return out[0].(string), out[1].(uint32), out[2].(string), nil
+}
+
+// mustNewType returns the ABI type for the given type name and panics if it
+// is not valid.
+func mustNewType(typeName string) abi.Type {
+	typ, err := abi.NewType(typeName, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
@@ -0,0 +1,60 @@
+package common_test
+
+import (
+	"errors"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevertWithCosmosError(t *testing.T) {
+	testCases := []struct {
+		name         string
+		err          error
+		expRevert    bool
+		expCodespace string
+		expCode      uint32
+	}{
+		{
+			name:         "bank error",
+			err:          banktypes.ErrSendDisabled,
+			expRevert:    true,
+			expCodespace: banktypes.ModuleName,
+			expCode:      banktypes.ErrSendDisabled.ABCICode(),
+		},
+		{
+			name:         "wrapped bank error",
+			err:          errorsmod.Wrapf(banktypes.ErrSendDisabled, "denom: %s", "aevmos"),
+			expRevert:    true,
+			expCodespace: banktypes.ModuleName,
+			expCode:      banktypes.ErrSendDisabled.ABCICode(),
+		},
+		{
+			name: "non-Cosmos SDK error",
+			err:  errors.New("some error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			revertData, err := common.RevertWithCosmosError(tc.err)
+			if !tc.expRevert {
+				require.Nil(t, revertData)
+				require.Equal(t, tc.err, err)
+				return
+			}
+
+			require.ErrorIs(t, err, vm.ErrExecutionReverted)
+
+			codespace, code, message, err := common.UnpackCosmosError(revertData)
+			require.NoError(t, err)
+			require.Equal(t, tc.expCodespace, codespace)
+			require.Equal(t, tc.expCode, code)
+			require.Equal(t, tc.err.Error(), message)
+		})
+	}
+}
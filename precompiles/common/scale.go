@@ -0,0 +1,68 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package common
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode defines how amounts are rounded when they are scaled down to a
+// domain with fewer decimals and the conversion loses precision.
+type RoundingMode uint8
+
+const (
+	// RoundingRejectInexact rejects any conversion that would lose precision.
+	// This is the default mode and must be used for amounts that are moved
+	// (e.g. transfers), so that no funds are silently lost.
+	RoundingRejectInexact RoundingMode = iota
+	// RoundingTruncate discards the digits that cannot be represented. It is
+	// meant for display purposes only (e.g. balanceOf or totalSupply values).
+	RoundingTruncate
+	// RoundingHalfUp rounds to the nearest representable amount, rounding
+	// halves away from zero.
+	RoundingHalfUp
+)
+
+// ScaleAmount converts the given amount from a domain with fromDecimals
+// decimals to a domain with toDecimals decimals. Scaling up is always exact,
+// while scaling down applies the given rounding mode to the digits that cannot
+// be represented in the target domain.
+//
+// The amounts moved between the 18 decimals of the EVM and the decimals of the
+// EVM coin, i.e. the ERC-20 transfers of the EVM coin and the WERC-20 deposits,
+// are scaled with RoundingRejectInexact.
+func ScaleAmount(amount *big.Int, fromDecimals, toDecimals uint8, rounding RoundingMode) (*big.Int, error) {
+	if amount == nil {
+		return nil, fmt.Errorf(ErrInvalidAmount, amount)
+	}
+
+	if fromDecimals <= toDecimals {
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(toDecimals-fromDecimals)), nil)
+		return new(big.Int).Mul(amount, factor), nil
+	}
+
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(fromDecimals-toDecimals)), nil)
+	// NOTE: QuoRem truncates towards zero, so the remainder has the sign of the amount.
+	quotient, remainder := new(big.Int).QuoRem(amount, factor, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient, nil
+	}
+
+	switch rounding {
+	case RoundingRejectInexact:
+		return nil, fmt.Errorf(ErrInexactScaling, amount, fromDecimals, toDecimals)
+	case RoundingTruncate:
+		return quotient, nil
+	case RoundingHalfUp:
+		// round away from zero if the discarded part is at least half of the factor
+		doubled := new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2))
+		if doubled.Cmp(factor) >= 0 {
+			quotient.Add(quotient, big.NewInt(int64(amount.Sign())))
+		}
+		return quotient, nil
+	default:
+		return nil, fmt.Errorf(ErrInvalidRoundingMode, rounding)
+	}
+}
@@ -0,0 +1,113 @@
+package common_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScaleAmount(t *testing.T) {
+	testCases := []struct {
+		name         string
+		amount       *big.Int
+		fromDecimals uint8
+		toDecimals   uint8
+		rounding     common.RoundingMode
+		expAmount    *big.Int
+		errContains  string
+	}{
+		{
+			name:         "pass - scale up is exact",
+			amount:       big.NewInt(1_234),
+			fromDecimals: 6,
+			toDecimals:   18,
+			rounding:     common.RoundingRejectInexact,
+			expAmount:    new(big.Int).Mul(big.NewInt(1_234), big.NewInt(1e12)),
+		},
+		{
+			name:         "pass - same decimals",
+			amount:       big.NewInt(1_234),
+			fromDecimals: 6,
+			toDecimals:   6,
+			rounding:     common.RoundingRejectInexact,
+			expAmount:    big.NewInt(1_234),
+		},
+		{
+			name:         "pass - exact scale down with reject inexact",
+			amount:       big.NewInt(1_200),
+			fromDecimals: 4,
+			toDecimals:   2,
+			rounding:     common.RoundingRejectInexact,
+			expAmount:    big.NewInt(12),
+		},
+		{
+			name:         "fail - inexact scale down with reject inexact",
+			amount:       big.NewInt(1_250),
+			fromDecimals: 4,
+			toDecimals:   2,
+			rounding:     common.RoundingRejectInexact,
+			errContains:  "amount 1250 cannot be scaled from 4 to 2 decimals without losing precision",
+		},
+		{
+			name:         "pass - inexact scale down with truncate",
+			amount:       big.NewInt(1_299),
+			fromDecimals: 4,
+			toDecimals:   2,
+			rounding:     common.RoundingTruncate,
+			expAmount:    big.NewInt(12),
+		},
+		{
+			name:         "pass - inexact scale down with half up below half",
+			amount:       big.NewInt(1_249),
+			fromDecimals: 4,
+			toDecimals:   2,
+			rounding:     common.RoundingHalfUp,
+			expAmount:    big.NewInt(12),
+		},
+		{
+			name:         "pass - inexact scale down with half up at half",
+			amount:       big.NewInt(1_250),
+			fromDecimals: 4,
+			toDecimals:   2,
+			rounding:     common.RoundingHalfUp,
+			expAmount:    big.NewInt(13),
+		},
+		{
+			name:         "pass - negative amount with half up rounds away from zero",
+			amount:       big.NewInt(-1_250),
+			fromDecimals: 4,
+			toDecimals:   2,
+			rounding:     common.RoundingHalfUp,
+			expAmount:    big.NewInt(-13),
+		},
+		{
+			name:         "fail - unknown rounding mode",
+			amount:       big.NewInt(1_250),
+			fromDecimals: 4,
+			toDecimals:   2,
+			rounding:     common.RoundingMode(10),
+			errContains:  "invalid rounding mode: 10",
+		},
+		{
+			name:         "fail - nil amount",
+			fromDecimals: 4,
+			toDecimals:   2,
+			rounding:     common.RoundingTruncate,
+			errContains:  "invalid amount",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scaled, err := common.ScaleAmount(tc.amount, tc.fromDecimals, tc.toDecimals, tc.rounding)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expAmount.String(), scaled.String())
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package common
 
 import (
+	"fmt"
 	"math/big"
 	"strings"
 	"time"
@@ -109,3 +110,13 @@ func SafeAdd(a, b math.Int) (res *big.Int, overflow bool) {
 	res = a.BigInt().Add(a.BigInt(), b.BigInt())
 	return res, res.BitLen() > math.MaxBitLen
 }
+
+// CalculateFee returns the fee in native coin for the given amount of gas at
+// the given gas price. It returns an error if the gas price is nil or negative.
+func CalculateFee(gas uint64, gasPrice *big.Int) (*big.Int, error) {
+	if gasPrice == nil || gasPrice.Sign() < 0 {
+		return nil, fmt.Errorf(ErrInvalidGasPrice, gasPrice)
+	}
+
+	return new(big.Int).Mul(new(big.Int).SetUint64(gas), gasPrice), nil
+}
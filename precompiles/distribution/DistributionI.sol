@@ -85,6 +85,16 @@ interface DistributionI {
         uint32 maxRetrieve
     ) external returns (bool success);
 
+    /// @dev Claims all rewards of the caller from a select set of validators or all of them
+    /// and sends them to the given receiver. The withdraw address of the caller must be the caller itself.
+    /// @param receiver The address that receives the claimed rewards
+    /// @param maxRetrieve The maximum number of validators to claim rewards from
+    /// @return success Whether the transaction was successful or not
+    function claimRewardsTo(
+        address receiver,
+        uint32 maxRetrieve
+    ) external returns (bool success);
+
     /// @dev Change the address, that can withdraw the rewards of a delegator.
     /// Note that this address cannot be a module account.
     /// @param delegatorAddress The address of the delegator
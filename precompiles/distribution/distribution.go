@@ -9,6 +9,7 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -30,6 +31,7 @@ type Precompile struct {
 	cmn.Precompile
 	distributionKeeper distributionkeeper.Keeper
 	stakingKeeper      stakingkeeper.Keeper
+	bankKeeper         bankkeeper.Keeper
 }
 
 // NewPrecompile creates a new distribution Precompile instance as a
@@ -37,6 +39,7 @@ type Precompile struct {
 func NewPrecompile(
 	distributionKeeper distributionkeeper.Keeper,
 	stakingKeeper stakingkeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 ) (*Precompile, error) {
 	newAbi, err := cmn.LoadABI(f, "abi.json")
@@ -51,9 +54,17 @@ func NewPrecompile(
 			KvGasConfig:          storetypes.KVGasConfig(),
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+			ReentrancyGuard: cmn.NewReentrancyGuard(
+				ClaimRewardsMethod,
+				ClaimRewardsToMethod,
+				WithdrawDelegatorRewardsMethod,
+				WithdrawValidatorCommissionMethod,
+				FundCommunityPoolMethod,
+			),
 		},
 		stakingKeeper:      stakingKeeper,
 		distributionKeeper: distributionKeeper,
+		bankKeeper:         bankKeeper,
 	}
 
 	// SetAddress defines the address of the distribution compile contract.
@@ -95,6 +106,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// Custom transactions
 	case ClaimRewardsMethod:
 		bz, err = p.ClaimRewards(ctx, evm.Origin, contract, stateDB, method, args)
+	case ClaimRewardsToMethod:
+		bz, err = p.ClaimRewardsTo(ctx, evm.Origin, contract, stateDB, method, args)
 	// Distribution transactions
 	case SetWithdrawAddressMethod:
 		bz, err = p.SetWithdrawAddress(ctx, evm.Origin, contract, stateDB, method, args)
@@ -144,12 +157,14 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //
 // Available distribution transactions are:
 //   - ClaimRewards
+//   - ClaimRewardsTo
 //   - SetWithdrawAddress
 //   - WithdrawDelegatorRewards
 //   - WithdrawValidatorCommission
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case ClaimRewardsMethod,
+		ClaimRewardsToMethod,
 		SetWithdrawAddressMethod,
 		WithdrawDelegatorRewardsMethod,
 		WithdrawValidatorCommissionMethod,
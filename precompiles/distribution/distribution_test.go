@@ -275,3 +275,62 @@ func (s *PrecompileTestSuite) TestRun() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestClaimRewardsToItselfFromContract() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	baseFee := s.network.App.EvmKeeper.GetBaseFee(ctx)
+
+	// the delegator calls the precompile as a contract, so the origin of the
+	// transaction is a different account
+	delegator := s.keyring.GetAddr(0)
+	origin := s.keyring.GetAddr(1)
+
+	ctx, err := s.prepareStakingRewards(
+		ctx,
+		stakingRewards{
+			Delegator: s.keyring.GetAccAddr(0),
+			Validator: s.network.GetValidators()[0],
+			RewardAmt: testRewardsAmt,
+		},
+	)
+	s.Require().NoError(err, "failed to prepare staking rewards")
+
+	input, err := s.precompile.Pack(distribution.ClaimRewardsToMethod, delegator, uint32(1))
+	s.Require().NoError(err, "failed to pack input")
+
+	contract := vm.NewPrecompile(vm.AccountRef(delegator), s.precompile, big.NewInt(0), uint64(1e6))
+	contract.Input = input
+	contractAddr := contract.Address()
+
+	msgEthereumTx, err := s.factory.GenerateMsgEthereumTx(s.keyring.GetPrivKey(1), evmtypes.EvmTxArgs{
+		ChainID:   evmtypes.GetEthChainConfig().ChainID,
+		To:        &contractAddr,
+		GasLimit:  100000,
+		GasFeeCap: baseFee,
+		GasTipCap: big.NewInt(1),
+		Accesses:  &gethtypes.AccessList{},
+	})
+	s.Require().NoError(err, "failed to generate Ethereum message")
+	signedMsg, err := s.factory.SignMsgEthereumTx(s.keyring.GetPrivKey(1), msgEthereumTx)
+	s.Require().NoError(err, "failed to sign Ethereum message")
+
+	cfg, err := s.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err, "failed to instantiate EVM config")
+	msg, err := signedMsg.AsMessage(gethtypes.LatestSignerForChainID(s.network.GetEIP155ChainID()), baseFee)
+	s.Require().NoError(err, "failed to instantiate Ethereum message")
+	s.Require().Equal(origin, msg.From())
+
+	stateDB := s.network.GetStateDB()
+	evm := s.network.App.EvmKeeper.NewEVM(ctx, msg, cfg, nil, stateDB)
+
+	// the balance of the contract is loaded in the stateDB before the call
+	prevBalance := stateDB.GetBalance(delegator)
+
+	_, err = s.precompile.Run(evm, contract, false)
+	s.Require().NoError(err, "expected no error when running the precompile")
+
+	// the claimed rewards are mirrored to the EVM balance of the contract
+	expRewards := evmtypes.ConvertAmountTo18DecimalsBigInt(expRewardsAmt.BigInt())
+	s.Require().Equal(new(big.Int).Add(prevBalance, expRewards), stateDB.GetBalance(delegator))
+}
@@ -11,4 +11,10 @@ const (
 	ErrWithdrawValCommissionAuth = "withdraw validator commission authorization for address %s does not exist"
 	// ErrDifferentValidator is raised when the origin address is not the same as the validator address.
 	ErrDifferentValidator = "origin address %s is not the same as validator address %s"
+	// ErrInvalidReceiver is raised when the receiver of the claimed rewards is not a valid address.
+	ErrInvalidReceiver = "invalid receiver address: %v"
+	// ErrBlockedReceiver is raised when the receiver of the claimed rewards is not allowed to receive funds.
+	ErrBlockedReceiver = "receiver address %s is not allowed to receive funds"
+	// ErrWithdrawerNotDelegator is raised when the withdraw address of the delegator is not the delegator itself.
+	ErrWithdrawerNotDelegator = "withdraw address %s is not the same as delegator address %s"
 )
@@ -90,6 +90,7 @@ func (s *PrecompileTestSuite) SetupTest() {
 	s.precompile, err = distribution.NewPrecompile(
 		s.network.App.DistrKeeper,
 		s.network.App.StakingKeeper,
+		s.network.App.BankKeeper,
 		s.network.App.AuthzKeeper,
 	)
 	if err != nil {
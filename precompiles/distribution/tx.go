@@ -31,6 +31,8 @@ const (
 	FundCommunityPoolMethod = "fundCommunityPool"
 	// ClaimRewardsMethod defines the ABI method name for the custom ClaimRewards transaction
 	ClaimRewardsMethod = "claimRewards"
+	// ClaimRewardsToMethod defines the ABI method name for the custom ClaimRewardsTo transaction
+	ClaimRewardsToMethod = "claimRewardsTo"
 )
 
 // ClaimRewards claims the rewards accumulated by a delegator from multiple or all validators.
@@ -47,14 +49,6 @@ func (p *Precompile) ClaimRewards(
 		return nil, err
 	}
 
-	maxVals, err := p.stakingKeeper.MaxValidators(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if maxRetrieve > maxVals {
-		return nil, fmt.Errorf("maxRetrieve (%d) parameter exceeds the maximum number of validators (%d)", maxRetrieve, maxVals)
-	}
-
 	// If the contract is the delegator, we don't need an origin check
 	// Otherwise check if the origin matches the delegator address
 	isContractDelegator := (contract.CallerAddress == delegatorAddr) && (origin != delegatorAddr)
@@ -62,26 +56,10 @@ func (p *Precompile) ClaimRewards(
 		return nil, fmt.Errorf(cmn.ErrDelegatorDifferentOrigin, origin.String(), delegatorAddr.String())
 	}
 
-	res, err := p.stakingKeeper.GetDelegatorValidators(ctx, delegatorAddr.Bytes(), maxRetrieve)
+	totalCoins, err := p.withdrawAllRewards(ctx, delegatorAddr, maxRetrieve)
 	if err != nil {
 		return nil, err
 	}
-	totalCoins := sdk.Coins{}
-	for _, validator := range res.Validators {
-		// Convert the validator operator address into an ValAddress
-		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
-		if err != nil {
-			return nil, err
-		}
-
-		// Withdraw the rewards for each validator address
-		coins, err := p.distributionKeeper.WithdrawDelegationRewards(ctx, delegatorAddr.Bytes(), valAddr)
-		if err != nil {
-			return nil, err
-		}
-
-		totalCoins = totalCoins.Add(coins...)
-	}
 
 	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
 	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
@@ -107,6 +85,67 @@ func (p *Precompile) ClaimRewards(
 	return method.Outputs.Pack(true)
 }
 
+// ClaimRewardsTo claims the rewards accumulated by the caller from multiple or all validators
+// and sends them to the given receiver in the same call. The withdraw address of the caller
+// has to be the caller itself, since the claimed rewards are sent from its balance.
+func (p *Precompile) ClaimRewardsTo(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	receiverAddr, maxRetrieve, err := parseClaimRewardsToArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the caller is always the delegator, so no origin check or authorization is needed
+	delegatorAddr := contract.CallerAddress
+
+	withdrawerHexAddr, err := p.getWithdrawerHexAddr(ctx, delegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	if withdrawerHexAddr != delegatorAddr {
+		return nil, fmt.Errorf(ErrWithdrawerNotDelegator, withdrawerHexAddr.String(), delegatorAddr.String())
+	}
+
+	if p.bankKeeper.BlockedAddr(receiverAddr.Bytes()) {
+		return nil, fmt.Errorf(ErrBlockedReceiver, receiverAddr.String())
+	}
+
+	totalCoins, err := p.withdrawAllRewards(ctx, delegatorAddr, maxRetrieve)
+	if err != nil {
+		return nil, err
+	}
+
+	if !totalCoins.IsZero() && receiverAddr != delegatorAddr {
+		if err := p.bankKeeper.SendCoins(ctx, delegatorAddr.Bytes(), receiverAddr.Bytes(), totalCoins); err != nil {
+			return nil, err
+		}
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	// this happens when the precompile is called from a smart contract
+	if contract.CallerAddress != origin {
+		// The rewards end up in the balance of the receiver, which is the delegator itself when
+		// claiming to itself. Otherwise the balance of the delegator is left unchanged.
+		convertedAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(totalCoins.AmountOf(evmtypes.GetEVMCoinDenom()).BigInt())
+		if convertedAmount.Cmp(common.Big0) == 1 {
+			p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(receiverAddr, convertedAmount, cmn.Add))
+		}
+	}
+
+	if err := p.EmitClaimRewardsEvent(ctx, stateDB, delegatorAddr, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
 // SetWithdrawAddress sets the withdrawal address for a delegator (or validator self-delegation).
 func (p Precompile) SetWithdrawAddress(
 	ctx sdk.Context,
@@ -285,6 +324,41 @@ func (p *Precompile) FundCommunityPool(
 	return method.Outputs.Pack(true)
 }
 
+// withdrawAllRewards withdraws the rewards of the delegator from up to maxRetrieve of its
+// validators and returns the total amount of withdrawn coins.
+func (p Precompile) withdrawAllRewards(ctx sdk.Context, delegatorAddr common.Address, maxRetrieve uint32) (sdk.Coins, error) {
+	maxVals, err := p.stakingKeeper.MaxValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if maxRetrieve > maxVals {
+		return nil, fmt.Errorf("maxRetrieve (%d) parameter exceeds the maximum number of validators (%d)", maxRetrieve, maxVals)
+	}
+
+	res, err := p.stakingKeeper.GetDelegatorValidators(ctx, delegatorAddr.Bytes(), maxRetrieve)
+	if err != nil {
+		return nil, err
+	}
+	totalCoins := sdk.Coins{}
+	for _, validator := range res.Validators {
+		// Convert the validator operator address into an ValAddress
+		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		// Withdraw the rewards for each validator address
+		coins, err := p.distributionKeeper.WithdrawDelegationRewards(ctx, delegatorAddr.Bytes(), valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		totalCoins = totalCoins.Add(coins...)
+	}
+
+	return totalCoins, nil
+}
+
 // getWithdrawerHexAddr is a helper function to get the hex address
 // of the withdrawer for the specified account address
 func (p Precompile) getWithdrawerHexAddr(ctx sdk.Context, delegatorAddr common.Address) (common.Address, error) {
@@ -491,6 +491,154 @@ func (s *PrecompileTestSuite) TestClaimRewards() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestClaimRewardsTo() {
+	var (
+		ctx                 sdk.Context
+		prevBalance         sdk.Coin
+		prevReceiverBalance sdk.Coin
+	)
+	method := s.precompile.Methods[distribution.ClaimRewardsToMethod]
+	receiver := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid receiver address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					uint32(3),
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"invalid receiver address",
+		},
+		{
+			"fail - too many retrieved results",
+			func() []interface{} {
+				return []interface{}{
+					receiver,
+					uint32(32_000_000),
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"maxRetrieve (32000000) parameter exceeds the maximum number of validators (100)",
+		},
+		{
+			"fail - withdraw address is not the delegator",
+			func() []interface{} {
+				err := s.network.App.DistrKeeper.SetWithdrawAddr(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().NoError(err)
+
+				return []interface{}{
+					receiver,
+					uint32(3),
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			fmt.Sprintf(distribution.ErrWithdrawerNotDelegator, s.keyring.GetAddr(1).String(), s.keyring.GetAddr(0).String()),
+		},
+		{
+			"success - claim rewards from all validators to the receiver",
+			func() []interface{} {
+				return []interface{}{
+					receiver,
+					uint32(3),
+				}
+			},
+			func([]byte) {
+				// rewards from 3 validators - 5% commission
+				expRewards := expRewardsAmt.Mul(math.NewInt(3))
+				balance := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), s.baseDenom)
+				s.Require().Equal(prevBalance.Amount, balance.Amount, "expected delegator balance to be unchanged")
+				receiverBalance := s.network.App.BankKeeper.GetBalance(ctx, receiver.Bytes(), s.baseDenom)
+				s.Require().Equal(prevReceiverBalance.Amount.Add(expRewards), receiverBalance.Amount)
+			},
+			20000,
+			false,
+			"",
+		},
+		{
+			"success - claim rewards to the delegator itself",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint32(1),
+				}
+			},
+			func([]byte) {
+				balance := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), s.baseDenom)
+				s.Require().Equal(prevBalance.Amount.Add(expRewardsAmt), balance.Amount)
+			},
+			20000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var (
+				contract *vm.Contract
+				err      error
+			)
+			addr := s.keyring.GetAddr(0)
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, addr, s.precompile, tc.gas)
+
+			validators := s.network.GetValidators()
+			srs := make([]stakingRewards, len(validators))
+			for i, val := range validators {
+				srs[i] = stakingRewards{
+					Delegator: addr.Bytes(),
+					Validator: val,
+					RewardAmt: testRewardsAmt,
+				}
+			}
+
+			ctx, err = s.prepareStakingRewards(ctx, srs...)
+			s.Require().NoError(err)
+
+			// get previous balances to compare final balances in the postCheck func
+			prevBalance = s.network.App.BankKeeper.GetBalance(ctx, addr.Bytes(), s.baseDenom)
+			prevReceiverBalance = s.network.App.BankKeeper.GetBalance(ctx, receiver.Bytes(), s.baseDenom)
+
+			bz, err := s.precompile.ClaimRewardsTo(ctx, addr, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestFundCommunityPool() {
 	var ctx sdk.Context
 	method := s.precompile.Methods[distribution.FundCommunityPoolMethod]
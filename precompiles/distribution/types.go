@@ -69,6 +69,26 @@ func parseClaimRewardsArgs(args []interface{}) (common.Address, uint32, error) {
 	return delegatorAddress, maxRetrieve, nil
 }
 
+// parseClaimRewardsToArgs parses the arguments of the claimRewardsTo method and returns
+// the receiver address and the maximum number of validators to claim rewards from.
+func parseClaimRewardsToArgs(args []interface{}) (common.Address, uint32, error) {
+	if len(args) != 2 {
+		return common.Address{}, 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	receiverAddress, ok := args[0].(common.Address)
+	if !ok || receiverAddress == (common.Address{}) {
+		return common.Address{}, 0, fmt.Errorf(ErrInvalidReceiver, args[0])
+	}
+
+	maxRetrieve, ok := args[1].(uint32)
+	if !ok {
+		return common.Address{}, 0, fmt.Errorf(cmn.ErrInvalidType, "maxRetrieve", uint32(0), args[1])
+	}
+
+	return receiverAddress, maxRetrieve, nil
+}
+
 // NewMsgSetWithdrawAddress creates a new MsgSetWithdrawAddress instance.
 func NewMsgSetWithdrawAddress(args []interface{}) (*distributiontypes.MsgSetWithdrawAddress, common.Address, error) {
 	if len(args) != 2 {
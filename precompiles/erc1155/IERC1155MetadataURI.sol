@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/**
+ * @author Evmos Team
+ * @title ERC1155 Metadata URI Interface
+ * @dev Interface for the ERC1155 multi-token standard including the optional metadata URI extension.
+ * Each token ID represents a native Cosmos coin denomination of the token family.
+ */
+interface IERC1155MetadataURI {
+    /** @dev Emitted when value tokens of token type id are transferred from from to to by operator.
+      * @param operator The address of the account that executed the transfer.
+      * @param from The address of the account sending the tokens.
+      * @param to The address of the account receiving the tokens.
+      * @param id The token ID.
+      * @param value The amount of tokens transferred.
+    */
+    event TransferSingle(
+        address indexed operator,
+        address indexed from,
+        address indexed to,
+        uint256 id,
+        uint256 value
+    );
+
+    /** @dev Equivalent to multiple TransferSingle events, where operator, from and to are the same
+      * for all transfers.
+      * @param operator The address of the account that executed the transfer.
+      * @param from The address of the account sending the tokens.
+      * @param to The address of the account receiving the tokens.
+      * @param ids The token IDs.
+      * @param values The amounts of tokens transferred for each token ID.
+    */
+    event TransferBatch(
+        address indexed operator,
+        address indexed from,
+        address indexed to,
+        uint256[] ids,
+        uint256[] values
+    );
+
+    /** @dev Emitted when account grants or revokes permission to operator to transfer their tokens.
+      * @param account The address of the account owning the tokens.
+      * @param operator The address of the operator.
+      * @param approved Whether the operator is approved.
+    */
+    event ApprovalForAll(
+        address indexed account,
+        address indexed operator,
+        bool approved
+    );
+
+    /** @dev Returns the amount of tokens of token type id owned by account.
+      * @param account The address of the account.
+      * @param id The token ID.
+      * @return balance The balance of the account.
+    */
+    function balanceOf(
+        address account,
+        uint256 id
+    ) external view returns (uint256 balance);
+
+    /** @dev Batched version of balanceOf.
+      * @param accounts The addresses of the accounts.
+      * @param ids The token IDs.
+      * @return balances The balances of the accounts for each token ID.
+    */
+    function balanceOfBatch(
+        address[] calldata accounts,
+        uint256[] calldata ids
+    ) external view returns (uint256[] memory balances);
+
+    /** @dev Grants or revokes permission to operator to transfer the caller's tokens.
+      * @param operator The address of the operator.
+      * @param approved Whether the operator is approved.
+    */
+    function setApprovalForAll(address operator, bool approved) external;
+
+    /** @dev Returns true if operator is approved to transfer the tokens of account.
+      * @param account The address of the account owning the tokens.
+      * @param operator The address of the operator.
+      * @return approved Whether the operator is approved.
+    */
+    function isApprovedForAll(
+        address account,
+        address operator
+    ) external view returns (bool approved);
+
+    /** @dev Transfers value tokens of token type id from from to to.
+      * @param from The address of the account sending the tokens.
+      * @param to The address of the account receiving the tokens.
+      * @param id The token ID.
+      * @param value The amount of tokens to transfer.
+      * @param data Additional data with no specified format.
+    */
+    function safeTransferFrom(
+        address from,
+        address to,
+        uint256 id,
+        uint256 value,
+        bytes calldata data
+    ) external;
+
+    /** @dev Batched version of safeTransferFrom.
+      * @param from The address of the account sending the tokens.
+      * @param to The address of the account receiving the tokens.
+      * @param ids The token IDs.
+      * @param values The amounts of tokens to transfer for each token ID.
+      * @param data Additional data with no specified format.
+    */
+    function safeBatchTransferFrom(
+        address from,
+        address to,
+        uint256[] calldata ids,
+        uint256[] calldata values,
+        bytes calldata data
+    ) external;
+
+    /** @dev Returns the URI of the token type id, taken from the bank denomination metadata.
+      * @param id The token ID.
+      * @return uri The URI of the token type.
+    */
+    function uri(uint256 id) external view returns (string memory uri);
+
+    /** @dev Returns true if the contract implements the interface defined by interfaceId (ERC165).
+      * @param interfaceId The interface identifier.
+      * @return supported Whether the interface is supported.
+    */
+    function supportsInterface(bytes4 interfaceId) external view returns (bool supported);
+}
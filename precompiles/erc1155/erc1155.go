@@ -0,0 +1,246 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+//
+// The erc1155 package contains the implementation of the ERC-1155 multi-token
+// precompile. Each token ID of the precompile represents a native Cosmos coin
+// denomination of a family of bank denominations.
+
+package erc1155
+
+import (
+	"embed"
+	"fmt"
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// abiPath defines the path to the ERC-1155 precompile ABI JSON file.
+	abiPath = "abi.json"
+
+	GasSafeTransferFrom      = 3_000_000
+	GasSafeBatchTransferFrom = 3_000_000
+	GasSetApprovalForAll     = 26_384
+	GasBalanceOf             = 2_851
+	GasBalanceOfBatch        = 5_702
+	GasIsApprovedForAll      = 2_604
+	GasURI                   = 3_421
+	GasSupportsInterface     = 427
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Precompile defines the precompiled contract for ERC-1155.
+type Precompile struct {
+	cmn.Precompile
+	// denoms are the bank denominations of the token family. The token ID of
+	// each denomination is its index in the slice.
+	denoms []string
+	// BankKeeper is a public field so that wrapping precompiles can use it.
+	BankKeeper bankkeeper.Keeper
+}
+
+// LoadABI loads the ERC-1155 ABI from the embedded abi.json file
+// for the erc1155 precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, abiPath)
+}
+
+// NewPrecompile creates a new ERC-1155 Precompile instance as a
+// PrecompiledContract interface for the given family of bank denominations.
+func NewPrecompile(
+	address common.Address,
+	denoms []string,
+	bankKeeper bankkeeper.Keeper,
+) (*Precompile, error) {
+	if err := validateDenoms(denoms); err != nil {
+		return nil, err
+	}
+
+	newABI, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newABI,
+			KvGasConfig:          storetypes.GasConfig{},
+			TransientKVGasConfig: storetypes.GasConfig{},
+		},
+		denoms:     denoms,
+		BankKeeper: bankKeeper,
+	}
+	// Address defines the address of the ERC-1155 precompile contract.
+	p.SetAddress(address)
+	return p, nil
+}
+
+// Denoms returns the bank denominations of the token family, indexed by token ID.
+func (p Precompile) Denoms() []string {
+	return p.denoms
+}
+
+// RequiredGas calculates the contract gas used for the
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		return 0
+	}
+
+	switch method.Name {
+	// ERC-1155 transactions
+	case SafeTransferFromMethod:
+		return GasSafeTransferFrom
+	case SafeBatchTransferFromMethod:
+		return GasSafeBatchTransferFrom
+	case SetApprovalForAllMethod:
+		return GasSetApprovalForAll
+	// ERC-1155 queries
+	case BalanceOfMethod:
+		return GasBalanceOf
+	case BalanceOfBatchMethod:
+		return GasBalanceOfBatch
+	case IsApprovedForAllMethod:
+		return GasIsApprovedForAll
+	case URIMethod:
+		return GasURI
+	case SupportsInterfaceMethod:
+		return GasSupportsInterface
+	default:
+		return 0
+	}
+}
+
+// Run executes the precompiled contract ERC-1155 methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	// ERC-1155 precompiles cannot receive funds because they are not managed
+	// by an EOA and it would not be possible to recover the funds.
+	if value := contract.Value(); value.Sign() == 1 {
+		return nil, fmt.Errorf(ErrCannotReceiveFunds, contract.Value().String())
+	}
+
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	bz, err = p.HandleMethod(ctx, contract, stateDB, method, args)
+	if err != nil {
+		// NOTE: Cosmos SDK errors revert with the CosmosError custom error, which
+		// returns the remaining gas to the caller, so the gas consumed until the
+		// failure is charged here.
+		if !contract.UseGas(ctx.GasMeter().GasConsumed() - initialGas) {
+			return nil, vm.ErrOutOfGas
+		}
+		return cmn.RevertWithCosmosError(err)
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case SafeTransferFromMethod,
+		SafeBatchTransferFromMethod,
+		SetApprovalForAllMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// HandleMethod handles the execution of each of the ERC-1155 methods.
+func (p *Precompile) HandleMethod(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) (bz []byte, err error) {
+	switch method.Name {
+	// ERC-1155 transactions
+	case SafeTransferFromMethod:
+		bz, err = p.SafeTransferFrom(ctx, contract, stateDB, method, args)
+	case SafeBatchTransferFromMethod:
+		bz, err = p.SafeBatchTransferFrom(ctx, contract, stateDB, method, args)
+	case SetApprovalForAllMethod:
+		bz, err = p.SetApprovalForAll(ctx, contract, stateDB, method, args)
+	// ERC-1155 queries
+	case BalanceOfMethod:
+		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
+	case BalanceOfBatchMethod:
+		bz, err = p.BalanceOfBatch(ctx, contract, stateDB, method, args)
+	case IsApprovedForAllMethod:
+		bz, err = p.IsApprovedForAll(ctx, contract, stateDB, method, args)
+	case URIMethod:
+		bz, err = p.URI(ctx, contract, stateDB, method, args)
+	case SupportsInterfaceMethod:
+		bz, err = p.SupportsInterface(ctx, contract, stateDB, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	return bz, err
+}
+
+// denomOf returns the bank denomination of the given token ID.
+func (p Precompile) denomOf(id *big.Int) (string, error) {
+	if id == nil || !id.IsInt64() || id.Sign() < 0 || id.Int64() >= int64(len(p.denoms)) {
+		return "", fmt.Errorf(ErrInvalidTokenID, id)
+	}
+
+	return p.denoms[id.Int64()], nil
+}
+
+// validateDenoms checks that the token family contains at least one denomination
+// and that all the denominations are valid and unique.
+func validateDenoms(denoms []string) error {
+	if len(denoms) == 0 {
+		return ErrEmptyTokenFamily
+	}
+
+	seen := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf(cmn.ErrInvalidDenom, denom)
+		}
+		if _, ok := seen[denom]; ok {
+			return fmt.Errorf(ErrDuplicateDenom, denom)
+		}
+		seen[denom] = struct{}{}
+	}
+
+	return nil
+}
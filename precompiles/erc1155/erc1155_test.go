@@ -0,0 +1,85 @@
+package erc1155_test
+
+import (
+	"github.com/evmos/evmos/v20/precompiles/erc1155"
+)
+
+func (s *PrecompileTestSuite) TestNewPrecompile() {
+	testcases := []struct {
+		name        string
+		denoms      []string
+		errContains string
+	}{
+		{
+			name:        "fail - empty token family",
+			denoms:      []string{},
+			errContains: erc1155.ErrEmptyTokenFamily.Error(),
+		},
+		{
+			name:        "fail - invalid denomination",
+			denoms:      []string{"xmpla", "1"},
+			errContains: "invalid denom: 1",
+		},
+		{
+			name:        "fail - duplicate denomination",
+			denoms:      []string{"xmpla", "xmpla"},
+			errContains: "duplicate denomination in token family: xmpla",
+		},
+		{
+			name:   "pass",
+			denoms: []string{"xmpla", "xmplb"},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			p, err := erc1155.NewPrecompile(s.precompile.Address(), tc.denoms, s.network.App.BankKeeper)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.denoms, p.Denoms())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	s.SetupTest()
+
+	// Queries
+	for _, name := range []string{
+		erc1155.BalanceOfMethod,
+		erc1155.BalanceOfBatchMethod,
+		erc1155.IsApprovedForAllMethod,
+		erc1155.URIMethod,
+		erc1155.SupportsInterfaceMethod,
+	} {
+		method := s.precompile.Methods[name]
+		s.Require().False(s.precompile.IsTransaction(&method), name)
+	}
+
+	// Transactions
+	for _, name := range []string{
+		erc1155.SafeTransferFromMethod,
+		erc1155.SafeBatchTransferFromMethod,
+		erc1155.SetApprovalForAllMethod,
+	} {
+		method := s.precompile.Methods[name]
+		s.Require().True(s.precompile.IsTransaction(&method), name)
+	}
+}
+
+func (s *PrecompileTestSuite) TestRequiredGas() {
+	s.SetupTest()
+
+	bz, err := s.precompile.ABI.Pack(erc1155.SetApprovalForAllMethod, s.keyring.GetAddr(1), true)
+	s.Require().NoError(err, "expected no error packing ABI")
+	s.Require().Equal(uint64(erc1155.GasSetApprovalForAll), s.precompile.RequiredGas(bz))
+
+	s.Require().Zero(s.precompile.RequiredGas([]byte{0x00, 0x00, 0x00}))
+	s.Require().Zero(s.precompile.RequiredGas([]byte("invalid method")))
+}
@@ -0,0 +1,27 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc1155
+
+import "errors"
+
+// Errors that have formatted information are defined here as a string.
+const (
+	ErrCannotReceiveFunds = "cannot receive funds, received: %s"
+	ErrDuplicateDenom     = "duplicate denomination in token family: %s"
+	ErrInvalidTokenID     = "invalid token id: %v"
+)
+
+var (
+	// Precompile errors
+	ErrEmptyTokenFamily = errors.New("token family must contain at least one denomination")
+
+	// ERC1155 errors
+	ErrLengthMismatch          = errors.New("ERC1155: ids and values length mismatch")
+	ErrAccountsLengthMismatch  = errors.New("ERC1155: accounts and ids length mismatch")
+	ErrTransferToZeroAddress   = errors.New("ERC1155: transfer to the zero address")
+	ErrMissingApprovalForAll   = errors.New("ERC1155: caller is not token owner or approved")
+	ErrApprovalForSelf         = errors.New("ERC1155: setting approval status for self")
+	ErrInsufficientBalance     = errors.New("ERC1155: insufficient balance for transfer")
+	ErrBalanceQueryZeroAddress = errors.New("ERC1155: address zero is not a valid owner")
+)
@@ -0,0 +1,142 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc1155
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// EventTypeTransferSingle defines the event type for the ERC-1155 safeTransferFrom transactions.
+	EventTypeTransferSingle = "TransferSingle"
+	// EventTypeTransferBatch defines the event type for the ERC-1155 safeBatchTransferFrom transactions.
+	EventTypeTransferBatch = "TransferBatch"
+	// EventTypeApprovalForAll defines the event type for the ERC-1155 setApprovalForAll transactions.
+	EventTypeApprovalForAll = "ApprovalForAll"
+)
+
+// EmitTransferSingleEvent creates a new TransferSingle event emitted on safeTransferFrom transactions.
+func (p Precompile) EmitTransferSingleEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	operator, from, to common.Address,
+	id, value *big.Int,
+) error {
+	event := p.ABI.Events[EventTypeTransferSingle]
+	topics, err := transferTopics(event, operator, from, to)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[3], event.Inputs[4]}
+	packed, err := arguments.Pack(id, value)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitTransferBatchEvent creates a new TransferBatch event emitted on safeBatchTransferFrom transactions.
+func (p Precompile) EmitTransferBatchEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	operator, from, to common.Address,
+	ids, values []*big.Int,
+) error {
+	event := p.ABI.Events[EventTypeTransferBatch]
+	topics, err := transferTopics(event, operator, from, to)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[3], event.Inputs[4]}
+	packed, err := arguments.Pack(ids, values)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitApprovalForAllEvent creates a new ApprovalForAll event emitted on setApprovalForAll transactions.
+func (p Precompile) EmitApprovalForAllEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	account, operator common.Address,
+	approved bool,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeApprovalForAll]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(account)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(operator)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(approved)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// transferTopics returns the topics of the transfer events, which are the
+// event signature and the operator, sender and receiver addresses.
+func transferTopics(event abi.Event, operator, from, to common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, 4)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	for i, addr := range []common.Address{operator, from, to} {
+		topics[i+1], err = cmn.MakeTopic(addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return topics, nil
+}
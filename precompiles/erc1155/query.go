@@ -0,0 +1,185 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc1155
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// BalanceOfMethod defines the ABI method name for the ERC-1155 BalanceOf
+	// query.
+	BalanceOfMethod = "balanceOf"
+	// BalanceOfBatchMethod defines the ABI method name for the ERC-1155
+	// BalanceOfBatch query.
+	BalanceOfBatchMethod = "balanceOfBatch"
+	// IsApprovedForAllMethod defines the ABI method name for the ERC-1155
+	// IsApprovedForAll query.
+	IsApprovedForAllMethod = "isApprovedForAll"
+	// URIMethod defines the ABI method name for the ERC-1155 URI query.
+	URIMethod = "uri"
+	// SupportsInterfaceMethod defines the ABI method name for the ERC-165
+	// SupportsInterface query.
+	SupportsInterfaceMethod = "supportsInterface"
+)
+
+var (
+	// InterfaceIDERC165 is the ERC-165 interface ID of the ERC-165 standard.
+	InterfaceIDERC165 = [4]byte{0x01, 0xff, 0xc9, 0xa7}
+	// InterfaceIDERC1155 is the ERC-165 interface ID of the ERC-1155 standard.
+	InterfaceIDERC1155 = [4]byte{0xd9, 0xb6, 0x7a, 0x26}
+	// InterfaceIDERC1155MetadataURI is the ERC-165 interface ID of the ERC-1155
+	// metadata URI extension.
+	InterfaceIDERC1155MetadataURI = [4]byte{0x0e, 0x89, 0x34, 0x1c}
+
+	// operatorApprovalsSlotPrefix is the prefix of the storage slots of the
+	// precompile that hold the operator approvals of each account.
+	operatorApprovalsSlotPrefix = []byte("erc1155.operator.approvals")
+)
+
+// BalanceOf returns the amount of tokens of the given token ID owned by the
+// account.
+func (p Precompile) BalanceOf(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	account, id, err := ParseBalanceOfArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := p.balanceOf(ctx, account, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(balance)
+}
+
+// BalanceOfBatch returns the amounts of tokens of the given token IDs owned by
+// the accounts. The i-th balance corresponds to the i-th account and token ID.
+func (p Precompile) BalanceOfBatch(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	accounts, ids, err := ParseBalanceOfBatchArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]*big.Int, len(accounts))
+	for i, account := range accounts {
+		balances[i], err = p.balanceOf(ctx, account, ids[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(balances)
+}
+
+// IsApprovedForAll returns true if the operator is allowed to transfer all the
+// tokens of the account.
+func (p Precompile) IsApprovedForAll(
+	_ sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	account, operator, err := ParseIsApprovedForAllArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(p.isApprovedForAll(stateDB, account, operator))
+}
+
+// URI returns the URI of the given token ID from the bank metadata of its
+// denomination. It returns an empty string if no metadata is registered.
+func (p Precompile) URI(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	id, err := ParseURIArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	denom, err := p.denomOf(id)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, found := p.BankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return method.Outputs.Pack("")
+	}
+
+	return method.Outputs.Pack(metadata.URI)
+}
+
+// SupportsInterface returns true if the precompile implements the interface
+// with the given ERC-165 ID.
+func (p Precompile) SupportsInterface(
+	_ sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	interfaceID, err := ParseSupportsInterfaceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	switch interfaceID {
+	case InterfaceIDERC165, InterfaceIDERC1155, InterfaceIDERC1155MetadataURI:
+		return method.Outputs.Pack(true)
+	default:
+		return method.Outputs.Pack(false)
+	}
+}
+
+// balanceOf returns the bank balance of the account for the denomination of
+// the given token ID.
+func (p Precompile) balanceOf(ctx sdk.Context, account common.Address, id *big.Int) (*big.Int, error) {
+	if account == (common.Address{}) {
+		return nil, ErrBalanceQueryZeroAddress
+	}
+
+	denom, err := p.denomOf(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.BankKeeper.GetBalance(ctx, account.Bytes(), denom).Amount.BigInt(), nil
+}
+
+// operatorApprovalSlot returns the storage slot of the precompile that holds
+// the approval of the operator over the tokens of the account.
+func operatorApprovalSlot(account, operator common.Address) common.Hash {
+	return crypto.Keccak256Hash(operatorApprovalsSlotPrefix, account.Bytes(), operator.Bytes())
+}
+
+// isApprovedForAll returns true if the operator is approved for all the tokens
+// of the account.
+func (p Precompile) isApprovedForAll(stateDB vm.StateDB, account, operator common.Address) bool {
+	return stateDB.GetState(p.Address(), operatorApprovalSlot(account, operator)) != (common.Hash{})
+}
@@ -0,0 +1,178 @@
+package erc1155_test
+
+import (
+	"math/big"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/erc1155"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+)
+
+func (s *PrecompileTestSuite) TestBalanceOf() {
+	method := s.precompile.Methods[erc1155.BalanceOfMethod]
+
+	testcases := []struct {
+		name        string
+		args        func() []interface{}
+		errContains string
+		expBalance  *big.Int
+	}{
+		{
+			name: "fail - invalid number of arguments",
+			args: func() []interface{} {
+				return []interface{}{}
+			},
+			errContains: "invalid number of arguments; expected 2; got: 0",
+		},
+		{
+			name: "fail - unknown token id",
+			args: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), big.NewInt(2)}
+			},
+			errContains: "invalid token id: 2",
+		},
+		{
+			name: "fail - zero address",
+			args: func() []interface{} {
+				return []interface{}{common.Address{}, big.NewInt(0)}
+			},
+			errContains: erc1155.ErrBalanceQueryZeroAddress.Error(),
+		},
+		{
+			name: "pass - funded account",
+			args: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), big.NewInt(1)}
+			},
+			expBalance: network.PrefundedAccountInitialBalance.BigInt(),
+		},
+		{
+			name: "pass - account without balance",
+			args: func() []interface{} {
+				return []interface{}{common.HexToAddress("0x1234"), big.NewInt(0)}
+			},
+			expBalance: common.Big0,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.BalanceOf(s.network.GetContext(), nil, nil, &method, tc.args())
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err, "expected no error unpacking")
+			s.Require().Equal(tc.expBalance.String(), out[0].(*big.Int).String())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestBalanceOfBatch() {
+	method := s.precompile.Methods[erc1155.BalanceOfBatchMethod]
+
+	testcases := []struct {
+		name        string
+		args        func() []interface{}
+		errContains string
+		expBalances []*big.Int
+	}{
+		{
+			name: "fail - length mismatch",
+			args: func() []interface{} {
+				return []interface{}{[]common.Address{s.keyring.GetAddr(0)}, []*big.Int{}}
+			},
+			errContains: erc1155.ErrAccountsLengthMismatch.Error(),
+		},
+		{
+			name: "fail - unknown token id",
+			args: func() []interface{} {
+				return []interface{}{[]common.Address{s.keyring.GetAddr(0)}, []*big.Int{big.NewInt(5)}}
+			},
+			errContains: "invalid token id: 5",
+		},
+		{
+			name: "pass",
+			args: func() []interface{} {
+				return []interface{}{
+					[]common.Address{s.keyring.GetAddr(0), s.keyring.GetAddr(1), common.HexToAddress("0x1234")},
+					[]*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(1)},
+				}
+			},
+			expBalances: []*big.Int{
+				network.PrefundedAccountInitialBalance.BigInt(),
+				network.PrefundedAccountInitialBalance.BigInt(),
+				common.Big0,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.BalanceOfBatch(s.network.GetContext(), nil, nil, &method, tc.args())
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err, "expected no error unpacking")
+			s.Require().Equal(tc.expBalances, out[0].([]*big.Int))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestURI() {
+	method := s.precompile.Methods[erc1155.URIMethod]
+
+	s.SetupTest()
+	s.network.App.BankKeeper.SetDenomMetaData(s.network.GetContext(), banktypes.Metadata{
+		Base:       s.tokenDenoms[0],
+		Display:    s.tokenDenoms[0],
+		DenomUnits: []*banktypes.DenomUnit{{Denom: s.tokenDenoms[0]}},
+		URI:        "ipfs://xmpla",
+	})
+
+	for id, expURI := range []string{"ipfs://xmpla", ""} {
+		bz, err := s.precompile.URI(s.network.GetContext(), nil, nil, &method, []interface{}{big.NewInt(int64(id))})
+		s.Require().NoError(err)
+		out, err := method.Outputs.Unpack(bz)
+		s.Require().NoError(err, "expected no error unpacking")
+		s.Require().Equal(expURI, out[0].(string))
+	}
+
+	_, err := s.precompile.URI(s.network.GetContext(), nil, nil, &method, []interface{}{big.NewInt(2)})
+	s.Require().ErrorContains(err, "invalid token id: 2")
+}
+
+func (s *PrecompileTestSuite) TestSupportsInterface() {
+	method := s.precompile.Methods[erc1155.SupportsInterfaceMethod]
+
+	s.SetupTest()
+
+	testcases := []struct {
+		interfaceID [4]byte
+		expSupport  bool
+	}{
+		{erc1155.InterfaceIDERC165, true},
+		{erc1155.InterfaceIDERC1155, true},
+		{erc1155.InterfaceIDERC1155MetadataURI, true},
+		{[4]byte{0xff, 0xff, 0xff, 0xff}, false},
+	}
+
+	for _, tc := range testcases {
+		bz, err := s.precompile.SupportsInterface(s.network.GetContext(), nil, nil, &method, []interface{}{tc.interfaceID})
+		s.Require().NoError(err)
+		out, err := method.Outputs.Unpack(bz)
+		s.Require().NoError(err, "expected no error unpacking")
+		s.Require().Equal(tc.expSupport, out[0].(bool), "interface 0x%x", tc.interfaceID)
+	}
+}
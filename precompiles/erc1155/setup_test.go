@@ -0,0 +1,53 @@
+package erc1155_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v20/precompiles/erc1155"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/stretchr/testify/suite"
+)
+
+var s *PrecompileTestSuite
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for ERC-1155 precompile
+// unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	// tokenDenoms are the denominations of the token family used to instantiate the
+	// ERC-1155 precompile. The token ID of each denomination is its index.
+	tokenDenoms []string
+	network     *network.UnitTestNetwork
+	keyring     testkeyring.Keyring
+
+	precompile *erc1155.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	s = new(PrecompileTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	s.tokenDenoms = []string{"xmpla", "xmplb"}
+
+	keyring := testkeyring.New(3)
+	integrationNetwork := network.NewUnitTestNetwork(
+		network.WithOtherDenoms(s.tokenDenoms),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	s.keyring = keyring
+	s.network = integrationNetwork
+
+	precompile, err := erc1155.NewPrecompile(
+		utiltx.GenerateAddress(),
+		s.tokenDenoms,
+		s.network.App.BankKeeper,
+	)
+	s.Require().NoError(err, "failed to set up the erc1155 precompile")
+	s.precompile = precompile
+}
@@ -0,0 +1,179 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc1155
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// SafeTransferFromMethod defines the ABI method name for the ERC-1155
+	// SafeTransferFrom transaction.
+	SafeTransferFromMethod = "safeTransferFrom"
+	// SafeBatchTransferFromMethod defines the ABI method name for the ERC-1155
+	// SafeBatchTransferFrom transaction.
+	SafeBatchTransferFromMethod = "safeBatchTransferFrom"
+	// SetApprovalForAllMethod defines the ABI method name for the ERC-1155
+	// SetApprovalForAll transaction.
+	SetApprovalForAllMethod = "setApprovalForAll"
+)
+
+// SafeTransferFrom transfers the given amount of tokens of the token ID from
+// the sender to the receiver. The caller has to be the sender or an operator
+// approved by the sender.
+//
+// NOTE: The ERC-1155 receiver hook (onERC1155Received) is not called on
+// contract receivers, since the tokens are native coins held by the bank module.
+func (p *Precompile) SafeTransferFrom(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	from, to, id, value, err := ParseSafeTransferFromArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	operator := contract.CallerAddress
+	if err := p.transfer(ctx, stateDB, operator, from, to, []*big.Int{id}, []*big.Int{value}); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitTransferSingleEvent(ctx, stateDB, operator, from, to, id, value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// SafeBatchTransferFrom transfers the given amounts of tokens of each token ID
+// from the sender to the receiver in a single bank send. The caller has to be
+// the sender or an operator approved by the sender.
+//
+// NOTE: The ERC-1155 receiver hook (onERC1155BatchReceived) is not called on
+// contract receivers, since the tokens are native coins held by the bank module.
+func (p *Precompile) SafeBatchTransferFrom(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	from, to, ids, values, err := ParseSafeBatchTransferFromArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	operator := contract.CallerAddress
+	if err := p.transfer(ctx, stateDB, operator, from, to, ids, values); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitTransferBatchEvent(ctx, stateDB, operator, from, to, ids, values); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// SetApprovalForAll grants or revokes the permission of the operator to
+// transfer all the tokens of the caller.
+//
+// The approvals are kept in the EVM storage of the precompile so that they are
+// reverted together with the rest of the EVM state if the call fails.
+func (p *Precompile) SetApprovalForAll(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	operator, approved, err := ParseSetApprovalForAllArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	account := contract.CallerAddress
+	if account == operator {
+		return nil, ErrApprovalForSelf
+	}
+
+	var value common.Hash
+	if approved {
+		value = common.BigToHash(common.Big1)
+	}
+	stateDB.SetState(p.Address(), operatorApprovalSlot(account, operator), value)
+
+	if err := p.EmitApprovalForAllEvent(ctx, stateDB, account, operator, approved); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// transfer sends the amounts of the denominations of the given token IDs from
+// the sender to the receiver, after checking that the operator is allowed to
+// transfer the sender's tokens.
+func (p *Precompile) transfer(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	operator, from, to common.Address,
+	ids, values []*big.Int,
+) error {
+	if to == (common.Address{}) {
+		return ErrTransferToZeroAddress
+	}
+
+	if operator != from && !p.isApprovedForAll(stateDB, from, operator) {
+		return ErrMissingApprovalForAll
+	}
+
+	coins := sdk.Coins{}
+	for i, id := range ids {
+		denom, err := p.denomOf(id)
+		if err != nil {
+			return err
+		}
+
+		coins = coins.Add(sdk.Coin{Denom: denom, Amount: math.NewIntFromBigInt(values[i])})
+	}
+
+	// NOTE: zero value transfers are valid ERC-1155 transfers that only emit
+	// the transfer event.
+	if coins.IsZero() {
+		return nil
+	}
+
+	for _, coin := range coins {
+		if p.BankKeeper.GetBalance(ctx, from.Bytes(), coin.Denom).Amount.LT(coin.Amount) {
+			return ErrInsufficientBalance
+		}
+	}
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.BankKeeper)
+	if _, err := msgSrv.Send(ctx, banktypes.NewMsgSend(from.Bytes(), to.Bytes(), coins)); err != nil {
+		return err
+	}
+
+	if amount := coins.AmountOf(evmtypes.GetEVMCoinDenom()); amount.IsPositive() {
+		// add the entries to the statedb journal in 18 decimals
+		convertedAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt())
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(from, convertedAmount, cmn.Sub),
+			cmn.NewBalanceChangeEntry(to, convertedAmount, cmn.Add))
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package erc1155_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/erc1155"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+)
+
+// balance returns the bank balance of the account for the denomination of the given token ID.
+func (s *PrecompileTestSuite) balance(account common.Address, id int) *big.Int {
+	return s.network.App.BankKeeper.GetBalance(s.network.GetContext(), account.Bytes(), s.tokenDenoms[id]).Amount.BigInt()
+}
+
+// approveOperator approves the operator for all the tokens of the owner with the given index.
+func (s *PrecompileTestSuite) approveOperator(stateDB *statedb.StateDB, ownerIdx int, operator common.Address) {
+	method := s.precompile.Methods[erc1155.SetApprovalForAllMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(ownerIdx), s.precompile, 200_000)
+
+	_, err := s.precompile.SetApprovalForAll(ctx, contract, stateDB, &method, []interface{}{operator, true})
+	s.Require().NoError(err, "expected no error approving the operator")
+}
+
+func (s *PrecompileTestSuite) TestSetApprovalForAll() {
+	method := s.precompile.Methods[erc1155.SetApprovalForAllMethod]
+	isApprovedMethod := s.precompile.Methods[erc1155.IsApprovedForAllMethod]
+
+	testcases := []struct {
+		name        string
+		approvals   []bool
+		operatorIdx int
+		errContains string
+		expApproved bool
+	}{
+		{
+			name:        "fail - approve self",
+			approvals:   []bool{true},
+			operatorIdx: 0,
+			errContains: erc1155.ErrApprovalForSelf.Error(),
+		},
+		{
+			name:        "pass - approve",
+			approvals:   []bool{true},
+			operatorIdx: 1,
+			expApproved: true,
+		},
+		{
+			name:        "pass - approve and revoke",
+			approvals:   []bool{true, false},
+			operatorIdx: 1,
+			expApproved: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			operator := s.keyring.GetAddr(tc.operatorIdx)
+
+			var err error
+			for _, approved := range tc.approvals {
+				contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+				_, err = s.precompile.SetApprovalForAll(ctx, contract, stateDB, &method, []interface{}{operator, approved})
+				if err != nil {
+					break
+				}
+			}
+
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			bz, err := s.precompile.IsApprovedForAll(s.network.GetContext(), nil, stateDB, &isApprovedMethod, []interface{}{s.keyring.GetAddr(0), operator})
+			s.Require().NoError(err)
+			out, err := isApprovedMethod.Outputs.Unpack(bz)
+			s.Require().NoError(err, "expected no error unpacking")
+			s.Require().Equal(tc.expApproved, out[0].(bool))
+			s.Require().Len(stateDB.Logs(), len(tc.approvals), "expected one ApprovalForAll event per call")
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSafeTransferFrom() {
+	method := s.precompile.Methods[erc1155.SafeTransferFromMethod]
+	initialBalance := network.PrefundedAccountInitialBalance.BigInt()
+	amount := big.NewInt(100)
+
+	testcases := []struct {
+		name        string
+		callerIdx   int
+		malleate    func(stateDB *statedb.StateDB) []interface{}
+		errContains string
+	}{
+		{
+			name:      "fail - invalid number of arguments",
+			callerIdx: 0,
+			malleate: func(_ *statedb.StateDB) []interface{} {
+				return []interface{}{}
+			},
+			errContains: "invalid number of arguments; expected 5; got: 0",
+		},
+		{
+			name:      "fail - transfer to zero address",
+			callerIdx: 0,
+			malleate: func(_ *statedb.StateDB) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), common.Address{}, big.NewInt(1), amount, []byte{}}
+			},
+			errContains: erc1155.ErrTransferToZeroAddress.Error(),
+		},
+		{
+			name:      "fail - unknown token id",
+			callerIdx: 0,
+			malleate: func(_ *statedb.StateDB) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(2), amount, []byte{}}
+			},
+			errContains: "invalid token id: 2",
+		},
+		{
+			name:      "fail - operator not approved",
+			callerIdx: 2,
+			malleate: func(_ *statedb.StateDB) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(1), amount, []byte{}}
+			},
+			errContains: erc1155.ErrMissingApprovalForAll.Error(),
+		},
+		{
+			name:      "fail - insufficient balance",
+			callerIdx: 0,
+			malleate: func(_ *statedb.StateDB) []interface{} {
+				value := new(big.Int).Add(initialBalance, common.Big1)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(1), value, []byte{}}
+			},
+			errContains: erc1155.ErrInsufficientBalance.Error(),
+		},
+		{
+			name:      "pass - owner transfer",
+			callerIdx: 0,
+			malleate: func(_ *statedb.StateDB) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(1), amount, []byte{}}
+			},
+		},
+		{
+			name:      "pass - approved operator transfer",
+			callerIdx: 2,
+			malleate: func(stateDB *statedb.StateDB) []interface{} {
+				s.approveOperator(stateDB, 0, s.keyring.GetAddr(2))
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(1), amount, []byte{}}
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			args := tc.malleate(stateDB)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(tc.callerIdx), s.precompile, 200_000)
+			_, err := s.precompile.SafeTransferFrom(ctx, contract, stateDB, &method, args)
+
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Equal(initialBalance, s.balance(s.keyring.GetAddr(1), 1))
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(new(big.Int).Sub(initialBalance, amount), s.balance(s.keyring.GetAddr(0), 1))
+			s.Require().Equal(new(big.Int).Add(initialBalance, amount), s.balance(s.keyring.GetAddr(1), 1))
+			s.Require().Equal(initialBalance, s.balance(s.keyring.GetAddr(0), 0), "expected other token ids to be unchanged")
+
+			logs := stateDB.Logs()
+			s.Require().NotEmpty(logs, "expected TransferSingle event")
+			event := s.precompile.Events[erc1155.EventTypeTransferSingle]
+			s.Require().Equal(event.ID, logs[len(logs)-1].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSafeBatchTransferFrom() {
+	method := s.precompile.Methods[erc1155.SafeBatchTransferFromMethod]
+	initialBalance := network.PrefundedAccountInitialBalance.BigInt()
+
+	testcases := []struct {
+		name        string
+		ids         []*big.Int
+		values      []*big.Int
+		errContains string
+		expSent     []*big.Int
+	}{
+		{
+			name:        "fail - length mismatch",
+			ids:         []*big.Int{big.NewInt(0)},
+			values:      []*big.Int{},
+			errContains: erc1155.ErrLengthMismatch.Error(),
+		},
+		{
+			name:        "fail - unknown token id",
+			ids:         []*big.Int{big.NewInt(0), big.NewInt(3)},
+			values:      []*big.Int{big.NewInt(1), big.NewInt(1)},
+			errContains: "invalid token id: 3",
+		},
+		{
+			name:    "pass - multiple token ids",
+			ids:     []*big.Int{big.NewInt(0), big.NewInt(1)},
+			values:  []*big.Int{big.NewInt(10), big.NewInt(20)},
+			expSent: []*big.Int{big.NewInt(10), big.NewInt(20)},
+		},
+		{
+			name:    "pass - repeated token id",
+			ids:     []*big.Int{big.NewInt(1), big.NewInt(1)},
+			values:  []*big.Int{big.NewInt(10), big.NewInt(20)},
+			expSent: []*big.Int{common.Big0, big.NewInt(30)},
+		},
+		{
+			name:    "pass - zero values",
+			ids:     []*big.Int{big.NewInt(0)},
+			values:  []*big.Int{common.Big0},
+			expSent: []*big.Int{common.Big0, common.Big0},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+			_, err := s.precompile.SafeBatchTransferFrom(
+				ctx, contract, stateDB, &method,
+				[]interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), tc.ids, tc.values, []byte{}},
+			)
+
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			for id, sent := range tc.expSent {
+				s.Require().Equal(new(big.Int).Sub(initialBalance, sent), s.balance(s.keyring.GetAddr(0), id))
+				s.Require().Equal(new(big.Int).Add(initialBalance, sent), s.balance(s.keyring.GetAddr(1), id))
+			}
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1, "expected TransferBatch event")
+			s.Require().Equal(s.precompile.Events[erc1155.EventTypeTransferBatch].ID, logs[0].Topics[0])
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc1155
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EventTransferSingle defines the event data for the ERC-1155 TransferSingle events.
+type EventTransferSingle struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Id       *big.Int //nolint:revive,stylecheck // the field name has to match the ABI argument
+	Value    *big.Int
+}
+
+// EventTransferBatch defines the event data for the ERC-1155 TransferBatch events.
+type EventTransferBatch struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Ids      []*big.Int
+	Values   []*big.Int
+}
+
+// EventApprovalForAll defines the event data for the ERC-1155 ApprovalForAll events.
+type EventApprovalForAll struct {
+	Account  common.Address
+	Operator common.Address
+	Approved bool
+}
+
+// ParseBalanceOfArgs parses the balanceOf arguments and returns the account
+// address and the token ID.
+func ParseBalanceOfArgs(args []interface{}) (common.Address, *big.Int, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf("invalid account address: %v", args[0])
+	}
+
+	id, ok := args[1].(*big.Int)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf("invalid id: %v", args[1])
+	}
+
+	return account, id, nil
+}
+
+// ParseBalanceOfBatchArgs parses the balanceOfBatch arguments and returns the
+// account addresses and the token IDs.
+func ParseBalanceOfBatchArgs(args []interface{}) ([]common.Address, []*big.Int, error) {
+	if len(args) != 2 {
+		return nil, nil, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	accounts, ok := args[0].([]common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid account addresses: %v", args[0])
+	}
+
+	ids, ok := args[1].([]*big.Int)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid ids: %v", args[1])
+	}
+
+	if len(accounts) != len(ids) {
+		return nil, nil, ErrAccountsLengthMismatch
+	}
+
+	return accounts, ids, nil
+}
+
+// ParseSetApprovalForAllArgs parses the setApprovalForAll arguments and returns
+// the operator address and the approval status.
+func ParseSetApprovalForAllArgs(args []interface{}) (common.Address, bool, error) {
+	if len(args) != 2 {
+		return common.Address{}, false, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	operator, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, false, fmt.Errorf("invalid operator address: %v", args[0])
+	}
+
+	approved, ok := args[1].(bool)
+	if !ok {
+		return common.Address{}, false, fmt.Errorf("invalid approved: %v", args[1])
+	}
+
+	return operator, approved, nil
+}
+
+// ParseIsApprovedForAllArgs parses the isApprovedForAll arguments and returns
+// the account and operator addresses.
+func ParseIsApprovedForAllArgs(args []interface{}) (account, operator common.Address, err error) {
+	if len(args) != 2 {
+		return common.Address{}, common.Address{}, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, fmt.Errorf("invalid account address: %v", args[0])
+	}
+
+	operator, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, fmt.Errorf("invalid operator address: %v", args[1])
+	}
+
+	return account, operator, nil
+}
+
+// ParseSafeTransferFromArgs parses the safeTransferFrom arguments and returns
+// the sender and receiver addresses, the token ID and the amount.
+func ParseSafeTransferFromArgs(args []interface{}) (
+	from, to common.Address, id, value *big.Int, err error,
+) {
+	if len(args) != 5 {
+		return common.Address{}, common.Address{}, nil, nil, fmt.Errorf("invalid number of arguments; expected 5; got: %d", len(args))
+	}
+
+	from, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, fmt.Errorf("invalid from address: %v", args[0])
+	}
+
+	to, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, fmt.Errorf("invalid to address: %v", args[1])
+	}
+
+	id, ok = args[2].(*big.Int)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, fmt.Errorf("invalid id: %v", args[2])
+	}
+
+	value, ok = args[3].(*big.Int)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, fmt.Errorf("invalid value: %v", args[3])
+	}
+
+	return from, to, id, value, nil
+}
+
+// ParseSafeBatchTransferFromArgs parses the safeBatchTransferFrom arguments and
+// returns the sender and receiver addresses, the token IDs and the amounts.
+func ParseSafeBatchTransferFromArgs(args []interface{}) (
+	from, to common.Address, ids, values []*big.Int, err error,
+) {
+	if len(args) != 5 {
+		return common.Address{}, common.Address{}, nil, nil, fmt.Errorf("invalid number of arguments; expected 5; got: %d", len(args))
+	}
+
+	from, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, fmt.Errorf("invalid from address: %v", args[0])
+	}
+
+	to, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, fmt.Errorf("invalid to address: %v", args[1])
+	}
+
+	ids, ok = args[2].([]*big.Int)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, fmt.Errorf("invalid ids: %v", args[2])
+	}
+
+	values, ok = args[3].([]*big.Int)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, fmt.Errorf("invalid values: %v", args[3])
+	}
+
+	if len(ids) != len(values) {
+		return common.Address{}, common.Address{}, nil, nil, ErrLengthMismatch
+	}
+
+	return from, to, ids, values, nil
+}
+
+// ParseURIArgs parses the uri arguments and returns the token ID.
+func ParseURIArgs(args []interface{}) (*big.Int, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	id, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid id: %v", args[0])
+	}
+
+	return id, nil
+}
+
+// ParseSupportsInterfaceArgs parses the supportsInterface arguments and returns
+// the interface ID.
+func ParseSupportsInterfaceArgs(args []interface{}) ([4]byte, error) {
+	if len(args) != 1 {
+		return [4]byte{}, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	interfaceID, ok := args[0].([4]byte)
+	if !ok {
+		return [4]byte{}, fmt.Errorf("invalid interface id: %v", args[0])
+	}
+
+	return interfaceID, nil
+}
@@ -9,6 +9,42 @@ import "./IERC20Metadata.sol";
  * @dev Interface for the optional metadata and allowance functions from the ERC20 standard.
  */
 interface IERC20MetadataAllowance is IERC20Metadata {
+    /** @dev Defines the allowance of a spender over the tokens of an owner.
+      * @param spender The address which can spend the funds.
+      * @param amount The amount of tokens that the spender is allowed to spend.
+    */
+    struct Allowance {
+        address spender;
+        uint256 amount;
+    }
+
+    /** @dev Raised when a call reverts because of a Cosmos SDK error.
+      * @param codespace The codespace of the Cosmos SDK error.
+      * @param code The code of the Cosmos SDK error.
+      * @param message The human-readable error message.
+    */
+    error CosmosError(string codespace, uint32 code, string message);
+
+    /** @dev Emitted when the token is paused by account.
+      * @param account The address of the account that paused the token.
+    */
+    event Paused(address account);
+
+    /** @dev Emitted when the token is unpaused by account.
+      * @param account The address of the account that unpaused the token.
+    */
+    event Unpaused(address account);
+
+    /** @dev Emitted when account is blocked from transferring the token.
+      * @param account The address of the blocked account.
+    */
+    event AccountBlocked(address indexed account);
+
+    /** @dev Emitted when account is unblocked from transferring the token.
+      * @param account The address of the unblocked account.
+    */
+    event AccountUnblocked(address indexed account);
+
     /** @dev Atomically increases the allowance granted to spender by the caller.
       * This is an alternative to approve that can be used as a mitigation for problems described in
       * IERC20.approve.
@@ -32,4 +68,143 @@ interface IERC20MetadataAllowance is IERC20Metadata {
         address spender,
         uint256 subtractedValue
     ) external returns (bool approved);
+
+    /** @dev Returns the maximum amount of tokens that the spender can currently transfer
+      * on behalf of the owner, i.e. the minimum between the owner's balance and the
+      * spender's allowance. If the owner is the spender, the owner's balance is returned.
+      * @param owner The address which owns the funds.
+      * @param spender The address which will spend the funds.
+      * @return amount The maximum spendable amount of tokens.
+    */
+    function maxSpendable(
+        address owner,
+        address spender
+    ) external view returns (uint256 amount);
+
+    /** @dev Returns the aggregate supply of all the related representations of the token
+      * (e.g. native, IBC and wrapped). If the token is not part of a group of related
+      * representations, the total supply of the token is returned.
+      * @return supply The aggregate supply of the token group.
+    */
+    function groupSupply() external view returns (uint256 supply);
+
+    /** @dev Returns true if the token can be sent to another chain through an ICS-20
+      * transfer, i.e. IBC transfers are enabled, sending the token is not blocked
+      * and, for IBC vouchers, the denomination trace is valid.
+      * @return transferable Whether the token is IBC-transferable.
+    */
+    function ibcTransferable() external view returns (bool transferable);
+
+    /** @dev Returns the address of the account that registered the token pair.
+      * Returns the zero address if the registering account was not recorded.
+      * @return creator The address of the account that registered the token pair.
+    */
+    function creator() external view returns (address creator);
+
+    /** @dev Returns the number of seconds until the allowance of the spender expires.
+      * Returns 0 if there is no allowance or it is expired, and the max uint256 value
+      * if the allowance never expires.
+      * @param owner The address of the account owning tokens.
+      * @param spender The address of the account able to transfer the tokens.
+      * @return remaining The number of seconds until the allowance expires.
+    */
+    function allowanceTimeRemaining(
+        address owner,
+        address spender
+    ) external view returns (uint256 remaining);
+
+    /** @dev Returns all the allowances over the owner's tokens, so that the outstanding
+      * approvals can be displayed and revoked. Expired allowances are not included.
+      * @param owner The address which owns the funds.
+      * @return allowances The spenders and amounts of the allowances.
+    */
+    function allowancesOf(
+        address owner
+    ) external view returns (Allowance[] memory allowances);
+
+    /** @dev Sets value as the allowance of spender over the owner's tokens, given the
+      * owner's signed approval (EIP-2612). Emits an Approval event.
+      * @param owner The address which owns the funds.
+      * @param spender The address which will spend the funds.
+      * @param value The amount of tokens to be approved.
+      * @param deadline The timestamp until which the signature is valid.
+      * @param v The recovery byte of the signature.
+      * @param r Half of the ECDSA signature pair.
+      * @param s Half of the ECDSA signature pair.
+    */
+    function permit(
+        address owner,
+        address spender,
+        uint256 value,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /** @dev Returns the current nonce of the owner, which must be included in the
+      * signature of the next permit.
+      * @param owner The address which owns the funds.
+      * @return nonce The current permit nonce of the owner.
+    */
+    function nonces(address owner) external view returns (uint256 nonce);
+
+    /** @dev Returns the EIP-712 domain separator used in the encoding of the permit signatures.
+      * @return domainSeparator The EIP-712 domain separator.
+    */
+    // solhint-disable-next-line func-name-mixedcase
+    function DOMAIN_SEPARATOR() external view returns (bytes32 domainSeparator);
+
+    /** @dev Halts the transfers of the token. Can only be called by the account that
+      * registered the token pair. Emits a Paused event.
+    */
+    function pause() external;
+
+    /** @dev Resumes the transfers of the token. Can only be called by the account that
+      * registered the token pair. Emits an Unpaused event.
+    */
+    function unpause() external;
+
+    /** @dev Returns true if the token is paused.
+      * @return isPaused Boolean value to indicate if the token is paused.
+    */
+    function paused() external view returns (bool isPaused);
+
+    /** @dev Blocks account from sending and receiving the token. Can only be called by
+      * the account that registered the token pair. Emits an AccountBlocked event.
+      * @param account The address of the account to block.
+    */
+    function blockAccount(address account) external;
+
+    /** @dev Unblocks account from sending and receiving the token. Can only be called by
+      * the account that registered the token pair. Emits an AccountUnblocked event.
+      * @param account The address of the account to unblock.
+    */
+    function unblockAccount(address account) external;
+
+    /** @dev Returns true if account is blocked from sending and receiving the token.
+      * @param account The address of the account.
+      * @return blocked Boolean value to indicate if the account is blocked.
+    */
+    function isBlocked(address account) external view returns (bool blocked);
+
+    /** @dev Returns the number of accounts holding a non-zero balance of the token. The
+      * holders are updated at the end of each block.
+      * @return count The number of holders of the token.
+    */
+    function holdersCount() external view returns (uint256 count);
+
+    /** @dev Moves amount tokens from the caller's account to `to` and attaches the memo
+      * to the transfer event of the underlying bank send, so that the receiver can
+      * attribute the transfer. Emits a Transfer event.
+      * @param to The address which will receive the funds.
+      * @param amount The amount of tokens to be transferred.
+      * @param memo The memo of the transfer, of at most 256 bytes.
+      * @return success Boolean value to indicate if the transfer was successful.
+    */
+    function transferWithMemo(
+        address to,
+        uint256 amount,
+        string calldata memo
+    ) external returns (bool success);
 }
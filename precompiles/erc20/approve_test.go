@@ -2,17 +2,15 @@ package erc20_test
 
 import (
 	"errors"
-	"fmt"
 	"math/big"
 
-	"cosmossdk.io/math"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/precompiles/erc20"
 	"github.com/evmos/evmos/v20/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 )
 
@@ -20,6 +18,7 @@ import (
 func (s *PrecompileTestSuite) TestApprove() {
 	method := s.precompile.Methods[authorization.ApproveMethod]
 	amount := int64(100)
+	otherToken := utiltx.GenerateAddress()
 
 	testcases := []struct {
 		name        string
@@ -79,37 +78,16 @@ func (s *PrecompileTestSuite) TestApprove() {
 			errContains: "causes integer overflow",
 		},
 		{
-			name: "fail - approve to zero with existing authorization only for other denominations",
+			name: "fail - spender is the owner",
 			malleate: func() []interface{} {
-				// NOTE: We are setting up a grant with a spend limit for a different denomination
-				// and then trying to approve an amount of zero for the token denomination
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(
-						sdk.NewInt64Coin(s.bondDenom, 1),
-					),
-				)
-
 				return []interface{}{
-					s.keyring.GetAddr(1), common.Big0,
+					s.keyring.GetAddr(0), big.NewInt(amount),
 				}
 			},
-			errContains: fmt.Sprintf(erc20.ErrNoAllowanceForToken, s.tokenDenom),
-			postCheck: func() {
-				// NOTE: Here we check that the authorization was not adjusted
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					sdk.NewCoins(
-						sdk.NewInt64Coin(s.bondDenom, 1),
-					),
-					[]string{},
-				)
-			},
+			errContains: erc20.ErrSpenderIsOwner.Error(),
 		},
 		{
-			name: "pass - approve without existing authorization",
+			name: "pass - approve without existing allowance",
 			malleate: func() []interface{} {
 				return []interface{}{
 					s.keyring.GetAddr(1), big.NewInt(amount),
@@ -117,22 +95,13 @@ func (s *PrecompileTestSuite) TestApprove() {
 			},
 			expPass: true,
 			postCheck: func() {
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, amount)),
-					[]string{},
-				)
+				s.requireAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount))
 			},
 		},
 		{
-			name: "pass - approve with existing authorization",
+			name: "pass - approve with existing allowance",
 			malleate: func() []interface{} {
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, 1)),
-				)
+				s.setupAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), common.Big1)
 
 				return []interface{}{
 					s.keyring.GetAddr(1), big.NewInt(amount),
@@ -140,46 +109,25 @@ func (s *PrecompileTestSuite) TestApprove() {
 			},
 			expPass: true,
 			postCheck: func() {
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, amount)),
-					[]string{},
-				)
+				s.requireAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount))
 			},
 		},
 		{
-			name: "pass - approve with existing authorization in different denomination",
+			name: "pass - approve zero without existing allowance",
 			malleate: func() []interface{} {
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 1)),
-				)
-
 				return []interface{}{
-					s.keyring.GetAddr(1), big.NewInt(amount),
+					s.keyring.GetAddr(1), common.Big0,
 				}
 			},
 			expPass: true,
 			postCheck: func() {
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					// Check that the approval is extended with the new denomination instead of overwritten
-					sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 1), sdk.NewInt64Coin(s.tokenDenom, amount)),
-					[]string{},
-				)
+				s.requireAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), common.Big0)
 			},
 		},
 		{
-			name: "pass - delete existing authorization",
+			name: "pass - delete existing allowance",
 			malleate: func() []interface{} {
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, 1)),
-				)
+				s.setupAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), common.Big1)
 
 				return []interface{}{
 					s.keyring.GetAddr(1), common.Big0,
@@ -187,37 +135,29 @@ func (s *PrecompileTestSuite) TestApprove() {
 			},
 			expPass: true,
 			postCheck: func() {
-				grants, err := s.grpcHandler.GetGrantsByGrantee(s.keyring.GetAccAddr(1).String())
-				s.Require().NoError(err, "expected no error querying the grants")
-				s.Require().Len(grants, 0, "expected grant to be deleted")
+				s.requireAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), common.Big0)
 			},
 		},
 		{
-			name: "pass - delete denomination from spend limit but leave other denoms",
+			name: "pass - approve does not change the allowance over other tokens",
 			malleate: func() []interface{} {
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(
-						sdk.NewInt64Coin(s.tokenDenom, 1),
-						sdk.NewInt64Coin(s.bondDenom, 1),
-					),
+				err := s.network.App.Erc20Keeper.SetAllowance(
+					s.network.GetContext(), otherToken, s.keyring.GetAddr(0), s.keyring.GetAddr(1), common.Big1,
 				)
+				s.Require().NoError(err, "failed to set up allowance")
 
 				return []interface{}{
-					s.keyring.GetAddr(1), common.Big0,
+					s.keyring.GetAddr(1), big.NewInt(amount),
 				}
 			},
 			expPass: true,
 			postCheck: func() {
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					// Check that the approval does not have a spend limit for the deleted denomination
-					// but still contains the other denom
-					sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 1)),
-					[]string{},
+				s.requireAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount))
+
+				allowance := s.network.App.Erc20Keeper.GetAllowance(
+					s.network.GetContext(), otherToken, s.keyring.GetAddr(0), s.keyring.GetAddr(1),
 				)
+				s.Require().Equal(common.Big1.String(), allowance.String(), "expected other allowance to be unchanged")
 			},
 		},
 	}
@@ -266,6 +206,92 @@ func (s *PrecompileTestSuite) TestApprove() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestApproveSafeMode() {
+	method := s.precompile.Methods[authorization.ApproveMethod]
+
+	testcases := []struct {
+		name        string
+		safeApprove bool
+		// approvals are the amounts approved in sequence by the owner to the spender
+		approvals   []int64
+		expPass     bool
+		errContains string
+		expAllow    int64
+	}{
+		{
+			name:        "fail - safe approve on, nonzero to nonzero",
+			safeApprove: true,
+			approvals:   []int64{100, 200},
+			errContains: erc20.ErrUnsafeApprove.Error(),
+			expAllow:    100,
+		},
+		{
+			name:        "pass - safe approve on, nonzero to zero to nonzero",
+			safeApprove: true,
+			approvals:   []int64{100, 0, 200},
+			expPass:     true,
+			expAllow:    200,
+		},
+		{
+			name:        "pass - safe approve off, nonzero to nonzero",
+			safeApprove: false,
+			approvals:   []int64{100, 200},
+			expPass:     true,
+			expAllow:    200,
+		},
+		{
+			name:        "pass - safe approve off, nonzero to zero to nonzero",
+			safeApprove: false,
+			approvals:   []int64{100, 0, 200},
+			expPass:     true,
+			expAllow:    200,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.precompile.SetSafeApprove(tc.safeApprove)
+
+			contract, ctx := testutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(0),
+				s.precompile,
+				200_000,
+			)
+
+			var (
+				bz  []byte
+				err error
+			)
+			for _, amount := range tc.approvals {
+				bz, err = s.precompile.Approve(
+					ctx,
+					contract,
+					s.network.GetStateDB(),
+					&method,
+					[]interface{}{s.keyring.GetAddr(1), big.NewInt(amount)},
+				)
+				if err != nil {
+					break
+				}
+			}
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error")
+				s.Require().NotNil(bz, "expected non-nil bytes")
+			} else {
+				s.Require().Error(err, "expected error")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+				s.Require().Empty(bz, "expected empty bytes")
+			}
+
+			s.requireAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(tc.expAllow))
+		})
+	}
+}
+
 //nolint:dupl // tests are not duplicate between the functions
 func (s *PrecompileTestSuite) TestIncreaseAllowance() {
 	method := s.precompile.Methods[authorization.IncreaseAllowanceMethod]
@@ -321,100 +347,55 @@ func (s *PrecompileTestSuite) TestIncreaseAllowance() {
 			errContains: erc20.ErrIncreaseNonPositiveValue.Error(),
 		},
 		{
-			name: "pass - increase allowance without existing authorization",
+			name: "fail - spender is the owner",
 			malleate: func() []interface{} {
 				return []interface{}{
-					s.keyring.GetAddr(1), big.NewInt(increaseAmount),
+					s.keyring.GetAddr(0), big.NewInt(increaseAmount),
 				}
 			},
-			expPass: true,
-			postCheck: func() {
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, increaseAmount)),
-					[]string{},
-				)
-			},
+			errContains: erc20.ErrSpenderIsOwner.Error(),
 		},
 		{
-			name: "pass - increase allowance with existing authorization",
+			name: "pass - increase allowance without existing allowance",
 			malleate: func() []interface{} {
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, amount)),
-				)
-
 				return []interface{}{
 					s.keyring.GetAddr(1), big.NewInt(increaseAmount),
 				}
 			},
 			expPass: true,
 			postCheck: func() {
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, amount+increaseAmount)),
-					[]string{},
-				)
+				s.requireAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(increaseAmount))
 			},
 		},
 		{
-			name: "fail - uint256 overflow when increasing allowance",
+			name: "pass - increase allowance with existing allowance",
 			malleate: func() []interface{} {
-				// NOTE: We are setting up a grant with a spend limit of the maximum uint256 value
-				// and then trying to approve an amount that would overflow the uint256 value
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(
-						sdk.NewInt64Coin(s.bondDenom, 1),
-						sdk.NewCoin(s.tokenDenom, math.NewIntFromBigInt(abi.MaxUint256)),
-					),
-				)
+				s.setupAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount))
 
 				return []interface{}{
-					s.keyring.GetAddr(1), big.NewInt(amount),
+					s.keyring.GetAddr(1), big.NewInt(increaseAmount),
 				}
 			},
-			errContains: erc20.ConvertErrToERC20Error(errors.New(cmn.ErrIntegerOverflow)).Error(),
+			expPass: true,
 			postCheck: func() {
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					// NOTE: The amounts should not have been adjusted after failing the overflow check.
-					sdk.NewCoins(
-						sdk.NewInt64Coin(s.bondDenom, 1),
-						sdk.NewCoin(s.tokenDenom, math.NewIntFromBigInt(abi.MaxUint256)),
-					),
-					[]string{},
-				)
+				s.requireAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount+increaseAmount))
 			},
 		},
 		{
-			name: "pass - increase allowance with existing authorization in different denomination",
+			name: "fail - uint256 overflow when increasing allowance",
 			malleate: func() []interface{} {
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, amount)),
-				)
+				// NOTE: We are setting up an allowance of the maximum uint256 value
+				// and then trying to increase it by an amount that would overflow the uint256 value
+				s.setupAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), abi.MaxUint256)
 
 				return []interface{}{
-					s.keyring.GetAddr(1), big.NewInt(increaseAmount),
+					s.keyring.GetAddr(1), big.NewInt(amount),
 				}
 			},
-			expPass: true,
+			errContains: erc20.ConvertErrToERC20Error(errors.New(cmn.ErrIntegerOverflow)).Error(),
 			postCheck: func() {
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					// NOTE: The approval in the precompile denomination is added to the existing
-					// approval for the different denomination.
-					sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, amount), sdk.NewInt64Coin(s.tokenDenom, increaseAmount)),
-					[]string{},
-				)
+				// NOTE: The allowance should not have been adjusted after failing the overflow check.
+				s.requireAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), abi.MaxUint256)
 			},
 		},
 	}
@@ -518,52 +499,27 @@ func (s *PrecompileTestSuite) TestDecreaseAllowance() {
 			errContains: erc20.ErrDecreaseNonPositiveValue.Error(),
 		},
 		{
-			name: "fail - decrease allowance without existing authorization",
+			name: "fail - spender is the owner",
 			malleate: func() []interface{} {
 				return []interface{}{
-					s.keyring.GetAddr(1), big.NewInt(decreaseAmount),
+					s.keyring.GetAddr(0), big.NewInt(decreaseAmount),
 				}
 			},
-			errContains: "does not exist or is expired",
+			errContains: erc20.ErrSpenderIsOwner.Error(),
 		},
 		{
-			name: "fail - decrease allowance with existing authorization only for other denominations",
+			name: "fail - decrease allowance without existing allowance",
 			malleate: func() []interface{} {
-				// NOTE: We are setting up a grant with a spend limit for a different denomination
-				// and then trying to decrease the allowance for the token denomination
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(
-						sdk.NewInt64Coin(s.bondDenom, 1),
-					),
-				)
-
 				return []interface{}{
 					s.keyring.GetAddr(1), big.NewInt(decreaseAmount),
 				}
 			},
-			errContains: fmt.Sprintf("allowance for token %s does not exist", s.tokenDenom),
-			postCheck: func() {
-				// NOTE: Here we check that the authorization was not adjusted
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					sdk.NewCoins(
-						sdk.NewInt64Coin(s.bondDenom, 1),
-					),
-					[]string{},
-				)
-			},
+			errContains: erc20.ErrDecreasedAllowanceBelowZero.Error(),
 		},
 		{
-			name: "pass - decrease allowance with existing authorization",
+			name: "pass - decrease allowance with existing allowance",
 			malleate: func() []interface{} {
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, amount)),
-				)
+				s.setupAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount))
 
 				return []interface{}{
 					s.keyring.GetAddr(1), big.NewInt(decreaseAmount),
@@ -571,22 +527,13 @@ func (s *PrecompileTestSuite) TestDecreaseAllowance() {
 			},
 			expPass: true,
 			postCheck: func() {
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, decreaseAmount)),
-					[]string{},
-				)
+				s.requireAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount-decreaseAmount))
 			},
 		},
 		{
-			name: "pass - decrease to zero and delete existing authorization",
+			name: "pass - decrease to zero and delete existing allowance",
 			malleate: func() []interface{} {
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, amount)),
-				)
+				s.setupAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount))
 
 				return []interface{}{
 					s.keyring.GetAddr(1), big.NewInt(amount),
@@ -594,125 +541,25 @@ func (s *PrecompileTestSuite) TestDecreaseAllowance() {
 			},
 			expPass: true,
 			postCheck: func() {
-				// Check that the authorization was deleted
-				grants, err := s.grpcHandler.GetGrantsByGrantee(s.keyring.GetAccAddr(1).String())
-				s.Require().NoError(err, "expected no error querying the grants")
-				s.Require().Len(grants, 0, "expected grant to be deleted")
-			},
-		},
-		{
-			name: "pass - decrease allowance with existing authorization in different denomination",
-			malleate: func() []interface{} {
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, amount), sdk.NewInt64Coin(s.tokenDenom, amount)),
-				)
+				s.requireAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), common.Big0)
 
-				return []interface{}{
-					s.keyring.GetAddr(1), big.NewInt(decreaseAmount),
-				}
-			},
-			expPass: true,
-			postCheck: func() {
-				// NOTE: Here we check that the authorization for the other denom was not deleted and the spend limit
-				// for token denom was adjusted as expected
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, amount), sdk.NewInt64Coin(s.tokenDenom, amount-decreaseAmount)),
-					[]string{},
-				)
+				allowances := s.network.App.Erc20Keeper.GetAllowances(s.network.GetContext(), s.precompile.Address(), s.keyring.GetAddr(0))
+				s.Require().Empty(allowances, "expected allowance to be deleted")
 			},
 		},
 		{
-			name: "pass - decrease allowance to zero for denom with existing authorization in other denominations",
+			name: "fail - decrease allowance with existing allowance but decreased amount too high",
 			malleate: func() []interface{} {
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(
-						sdk.NewInt64Coin(s.bondDenom, amount),
-						sdk.NewInt64Coin(s.tokenDenom, amount),
-					),
-				)
-
-				return []interface{}{
-					s.keyring.GetAddr(1), big.NewInt(amount),
-				}
-			},
-			expPass: true,
-			postCheck: func() {
-				// NOTE: Here we check that the authorization for the other denom was not deleted
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, amount)),
-					[]string{},
-				)
-			},
-		},
-		{
-			name: "fail - decrease allowance with existing authorization but decreased amount too high",
-			malleate: func() []interface{} {
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, amount)),
-				)
+				s.setupAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount))
 
 				return []interface{}{
 					s.keyring.GetAddr(1), big.NewInt(amount + 1),
 				}
 			},
-			errContains: erc20.ConvertErrToERC20Error(errors.New("subtracted value cannot be greater than existing allowance")).Error(),
-		},
-		{
-			name: "fail - decrease allowance with existing authorization in different denomination",
-			malleate: func() []interface{} {
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, amount)),
-				)
-
-				return []interface{}{
-					s.keyring.GetAddr(1), big.NewInt(decreaseAmount),
-				}
-			},
-			errContains: fmt.Sprintf(erc20.ErrNoAllowanceForToken, s.tokenDenom),
+			errContains: erc20.ErrDecreasedAllowanceBelowZero.Error(),
 			postCheck: func() {
-				// NOTE: Here we check that the authorization for the other denom was not deleted
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, amount)),
-					[]string{},
-				)
-			},
-		},
-		{
-			name: "fail - decrease allowance with existing authorization in different denomination but decreased amount too high",
-			malleate: func() []interface{} {
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetPrivKey(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, amount), sdk.NewInt64Coin(s.tokenDenom, 1)),
-				)
-
-				return []interface{}{
-					s.keyring.GetAddr(1), big.NewInt(decreaseAmount),
-				}
-			},
-			errContains: erc20.ConvertErrToERC20Error(errors.New("subtracted value cannot be greater than existing allowance")).Error(),
-			postCheck: func() {
-				// NOTE: Here we check that the authorization was not adjusted
-				s.requireSendAuthz(
-					s.keyring.GetAccAddr(1),
-					s.keyring.GetAccAddr(0),
-					sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, amount), sdk.NewInt64Coin(s.tokenDenom, 1)),
-					[]string{},
-				)
+				// NOTE: Here we check that the allowance was not adjusted
+				s.requireAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount))
 			},
 		},
 	}
@@ -0,0 +1,148 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// BlockAccountMethod defines the ABI method name for the ERC-20
+	// BlockAccount transaction.
+	BlockAccountMethod = "blockAccount"
+	// UnblockAccountMethod defines the ABI method name for the ERC-20
+	// UnblockAccount transaction.
+	UnblockAccountMethod = "unblockAccount"
+	// IsBlockedMethod defines the ABI method name for the ERC-20 IsBlocked
+	// query.
+	IsBlockedMethod = "isBlocked"
+
+	// EventTypeAccountBlocked defines the event type for the ERC-20 BlockAccount transactions.
+	EventTypeAccountBlocked = "AccountBlocked"
+	// EventTypeAccountUnblocked defines the event type for the ERC-20 UnblockAccount transactions.
+	EventTypeAccountUnblocked = "AccountUnblocked"
+)
+
+// BlockAccount freezes the tokens of the given account, so that it can neither
+// send nor receive them. Only the account that registered the token pair can
+// block accounts.
+func (p Precompile) BlockAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	account, err := ParseBlockAccountArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.setAccountBlocked(ctx, contract.CallerAddress, account, true); err != nil {
+		return nil, err
+	}
+
+	if err := p.emitBlocklistEvent(ctx, stateDB, EventTypeAccountBlocked, account); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// UnblockAccount unfreezes the tokens of the given account. Only the account
+// that registered the token pair can unblock accounts.
+func (p Precompile) UnblockAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	account, err := ParseBlockAccountArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.setAccountBlocked(ctx, contract.CallerAddress, account, false); err != nil {
+		return nil, err
+	}
+
+	if err := p.emitBlocklistEvent(ctx, stateDB, EventTypeAccountUnblocked, account); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// IsBlocked returns true if the given account is blocked from transferring the
+// tokens.
+func (p Precompile) IsBlocked(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	account, err := ParseBlockAccountArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(p.isBlocked(ctx, account))
+}
+
+// isBlocked returns true if the account is on the blocklist of the token pair.
+// Without a token pair keeper no account can be blocked.
+func (p Precompile) isBlocked(ctx sdk.Context, account common.Address) bool {
+	if p.tokenPairKeeper == nil {
+		return false
+	}
+
+	return p.tokenPairKeeper.IsAccountBlocked(ctx, p.tokenPair.GetID(), account)
+}
+
+// setAccountBlocked updates the blocklist of the token pair through the token
+// pair keeper after checking that the caller is the owner of the token pair.
+func (p Precompile) setAccountBlocked(ctx sdk.Context, caller, account common.Address, blocked bool) error {
+	if err := p.checkOwner(caller); err != nil {
+		return err
+	}
+
+	return p.tokenPairKeeper.SetAccountBlocked(ctx, p.tokenPair.Erc20Address, account, blocked)
+}
+
+// emitBlocklistEvent creates a new AccountBlocked or AccountUnblocked event
+// for the given account.
+// No event is emitted if the event emission is disabled for the token pair.
+func (p Precompile) emitBlocklistEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, account common.Address) error {
+	if !p.emitEvents {
+		return nil
+	}
+
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(account)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        nil,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package erc20_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/erc20"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (s *PrecompileTestSuite) TestBlockAccount() {
+	blockMethod := s.precompile.Methods[erc20.BlockAccountMethod]
+	unblockMethod := s.precompile.Methods[erc20.UnblockAccountMethod]
+	isBlockedMethod := s.precompile.Methods[erc20.IsBlockedMethod]
+	owner := s.keyring.GetAddr(0)
+	account := s.keyring.GetAddr(1)
+
+	testcases := []struct {
+		name        string
+		creator     common.Address
+		caller      common.Address
+		methods     []string
+		expPass     bool
+		errContains string
+		expBlocked  bool
+	}{
+		{
+			name:        "fail - creator was not recorded",
+			caller:      owner,
+			methods:     []string{erc20.BlockAccountMethod},
+			errContains: erc20.ErrCallerNotOwner.Error(),
+		},
+		{
+			name:        "fail - caller is not the owner",
+			creator:     owner,
+			caller:      account,
+			methods:     []string{erc20.BlockAccountMethod},
+			errContains: erc20.ErrCallerNotOwner.Error(),
+		},
+		{
+			name:        "fail - unblock an account that is not blocked",
+			creator:     owner,
+			caller:      owner,
+			methods:     []string{erc20.UnblockAccountMethod},
+			errContains: erc20types.ErrAccountNotBlocked.Error(),
+		},
+		{
+			name:        "fail - block an account that is already blocked",
+			creator:     owner,
+			caller:      owner,
+			methods:     []string{erc20.BlockAccountMethod, erc20.BlockAccountMethod},
+			errContains: erc20types.ErrAccountBlocked.Error(),
+			expBlocked:  true,
+		},
+		{
+			name:       "pass - block",
+			creator:    owner,
+			caller:     owner,
+			methods:    []string{erc20.BlockAccountMethod},
+			expPass:    true,
+			expBlocked: true,
+		},
+		{
+			name:    "pass - block and unblock",
+			creator: owner,
+			caller:  owner,
+			methods: []string{erc20.BlockAccountMethod, erc20.UnblockAccountMethod},
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			precompile := s.setupOwnedERC20Precompile(s.tokenDenom, tc.creator)
+			stateDB := s.network.GetStateDB()
+
+			var err error
+			for _, methodName := range tc.methods {
+				contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), tc.caller, precompile, 200_000)
+				if methodName == erc20.BlockAccountMethod {
+					_, err = precompile.BlockAccount(ctx, contract, stateDB, &blockMethod, []interface{}{account})
+				} else {
+					_, err = precompile.UnblockAccount(ctx, contract, stateDB, &unblockMethod, []interface{}{account})
+				}
+				if err != nil {
+					break
+				}
+			}
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error")
+			} else {
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+
+			bz, err := precompile.IsBlocked(s.network.GetContext(), nil, nil, &isBlockedMethod, []interface{}{account})
+			s.requireOut(bz, err, isBlockedMethod, true, "", tc.expBlocked)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestTransferBlockedAccount() {
+	method := s.precompile.Methods[erc20.TransferMethod]
+	blockMethod := s.precompile.Methods[erc20.BlockAccountMethod]
+	owner := s.keyring.GetAddr(0)
+	sender := s.keyring.GetAddr(1)
+	receiver := s.keyring.GetAddr(0)
+
+	testcases := []struct {
+		name    string
+		blocked common.Address
+		expPass bool
+	}{
+		{
+			name:    "fail - sender is blocked",
+			blocked: sender,
+		},
+		{
+			name:    "fail - receiver is blocked",
+			blocked: receiver,
+		},
+		{
+			name:    "pass - another account is blocked",
+			blocked: common.HexToAddress("0x1234567890123456789012345678901234567890"),
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			precompile := s.setupOwnedERC20Precompile(s.bondDenom, owner)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), owner, precompile, 200_000)
+			_, err := precompile.BlockAccount(ctx, contract, stateDB, &blockMethod, []interface{}{tc.blocked})
+			s.Require().NoError(err, "expected no error blocking the account")
+
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), sender, precompile, 200_000)
+			_, err = precompile.Transfer(ctx, contract, stateDB, &method, []interface{}{receiver, big.NewInt(100)})
+			if tc.expPass {
+				s.Require().NoError(err, "expected transfer to succeed")
+			} else {
+				s.Require().ErrorContains(err, erc20.ErrAccountBlocked.Error(), "expected transfer to fail")
+			}
+		})
+	}
+}
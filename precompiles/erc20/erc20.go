@@ -6,15 +6,16 @@ package erc20
 import (
 	"embed"
 	"fmt"
+	"math/big"
 
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	auth "github.com/evmos/evmos/v20/precompiles/authorization"
 	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
@@ -25,18 +26,72 @@ const (
 	// abiPath defines the path to the ERC-20 precompile ABI JSON file.
 	abiPath = "abi.json"
 
-	GasTransfer          = 3_000_000
-	GasApprove           = 30_956
-	GasIncreaseAllowance = 34_605
-	GasDecreaseAllowance = 34_519
-	GasName              = 3_421
-	GasSymbol            = 3_464
-	GasDecimals          = 427
-	GasTotalSupply       = 2_477
-	GasBalanceOf         = 2_851
-	GasAllowance         = 3_246
+	GasTransfer               = 3_000_000
+	GasApprove                = 30_956
+	GasIncreaseAllowance      = 34_605
+	GasDecreaseAllowance      = 34_519
+	GasName                   = 3_421
+	GasSymbol                 = 3_464
+	GasDecimals               = 427
+	GasTotalSupply            = 2_477
+	GasBalanceOf              = 2_851
+	GasAllowance              = 3_246
+	GasMaxSpendable           = 6_097
+	GasGroupSupply            = 4_954
+	GasIBCTransferable        = 5_312
+	GasCreator                = 2_315
+	GasAllowanceTimeRemaining = 3_412
+	GasPermit                 = 41_836
+	GasNonces                 = 2_604
+	GasDomainSeparator        = 3_902
+	GasAllowancesOf           = 8_640
+	GasPause                  = 28_310
+	GasUnpause                = 28_310
+	GasPaused                 = 2_340
+	GasBlockAccount           = 28_530
+	GasUnblockAccount         = 28_530
+	GasIsBlocked              = 2_590
+	GasHoldersCount           = 2_640
+
+	// GasSurchargeScreening is the additional gas charged for token transfers
+	// when the screening compliance hook is active for the token pair.
+	GasSurchargeScreening = 2_100
+	// GasSurchargeWhitelist is the additional gas charged for token transfers
+	// when the whitelist compliance hook is active for the token pair.
+	GasSurchargeWhitelist = 2_100
+)
+
+// ComplianceFeature defines a compliance hook that can be enabled for the
+// token pair of an ERC-20 precompile. Features are combined as a bit mask and
+// set through governance on the erc20 module.
+type ComplianceFeature = erc20types.ComplianceFeature
+
+const (
+	// ComplianceScreening checks the sender and receiver of a transfer.
+	ComplianceScreening = erc20types.ComplianceScreening
+	// ComplianceWhitelist restricts the receivers of a transfer to a set of addresses.
+	ComplianceWhitelist = erc20types.ComplianceWhitelist
 )
 
+// TokenPairKeeper defines the expected interface to manage the state of the
+// token pairs that is controlled by their owners. It is implemented by the
+// erc20 module keeper.
+type TokenPairKeeper interface {
+	SetTokenPairPaused(ctx sdk.Context, token string, paused bool) (erc20types.TokenPair, error)
+	SetAccountBlocked(ctx sdk.Context, token string, account common.Address, blocked bool) error
+	IsAccountBlocked(ctx sdk.Context, id []byte, account common.Address) bool
+	GetTokenHoldersCount(ctx sdk.Context, denom string) uint64
+}
+
+// AllowanceKeeper defines the expected interface to manage the allowances of
+// the spenders over the owners' tokens. It is implemented by the erc20 module
+// keeper.
+type AllowanceKeeper interface {
+	GetAllowance(ctx sdk.Context, erc20, owner, spender common.Address) *big.Int
+	SetAllowance(ctx sdk.Context, erc20, owner, spender common.Address, value *big.Int) error
+	GetAllowances(ctx sdk.Context, erc20, owner common.Address) []erc20types.Allowance
+}
+
 // Embed abi json file to the executable binary. Needed when importing as dependency.
 //
 //go:embed abi.json
@@ -49,19 +104,49 @@ type Precompile struct {
 	cmn.Precompile
 	tokenPair      erc20types.TokenPair
 	transferKeeper transferkeeper.Keeper
+	// allowanceKeeper stores the allowances of the spenders over the owners'
+	// tokens.
+	allowanceKeeper AllowanceKeeper
+	// emitEvents defines whether the precompile adds EVM logs for its events.
+	emitEvents bool
+	// safeApprove defines whether approving a non-zero allowance requires the
+	// current allowance to be zero.
+	safeApprove bool
+	// denomGroup contains the denominations of the group of related
+	// representations of the same asset that the token pair denom belongs to.
+	denomGroup []string
+	// creator is the address of the account that registered the token pair.
+	creator common.Address
+	// complianceFeatures are the compliance hooks that are active for the
+	// token pair of the precompile.
+	complianceFeatures ComplianceFeature
+	// dynamicGas defines whether the gas of the precompile calls is derived
+	// from the executed store operations instead of the static gas schedule.
+	dynamicGas bool
+	// tokenPairKeeper manages the owner controlled state of the token pair,
+	// such as the pause status and the blocked accounts.
+	tokenPairKeeper TokenPairKeeper
+	// hooks are the erc20 hooks invoked around the token transfers.
+	hooks erc20types.Erc20Hooks
 	// BankKeeper is a public field so that the werc20 precompile can use it.
 	BankKeeper bankkeeper.Keeper
 }
 
+// LoadABI loads the ERC-20 ABI from the embedded abi.json file
+// for the erc20 precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, abiPath)
+}
+
 // NewPrecompile creates a new ERC-20 Precompile instance as a
 // PrecompiledContract interface.
 func NewPrecompile(
 	tokenPair erc20types.TokenPair,
 	bankKeeper bankkeeper.Keeper,
-	authzKeeper authzkeeper.Keeper,
+	allowanceKeeper AllowanceKeeper,
 	transferKeeper transferkeeper.Keeper,
 ) (*Precompile, error) {
-	newABI, err := cmn.LoadABI(f, abiPath)
+	newABI, err := LoadABI()
 	if err != nil {
 		return nil, err
 	}
@@ -69,20 +154,155 @@ func NewPrecompile(
 	p := &Precompile{
 		Precompile: cmn.Precompile{
 			ABI:                  newABI,
-			AuthzKeeper:          authzKeeper,
-			ApprovalExpiration:   cmn.DefaultExpirationDuration,
 			KvGasConfig:          storetypes.GasConfig{},
 			TransientKVGasConfig: storetypes.GasConfig{},
+			// the token transfers invoke the erc20 hooks, which can call back
+			// into the precompile through the EVM
+			ReentrancyGuard: cmn.NewReentrancyGuard(
+				TransferMethod,
+				TransferFromMethod,
+				TransferWithMemoMethod,
+			),
 		},
-		tokenPair:      tokenPair,
-		BankKeeper:     bankKeeper,
-		transferKeeper: transferKeeper,
+		tokenPair:       tokenPair,
+		BankKeeper:      bankKeeper,
+		transferKeeper:  transferKeeper,
+		allowanceKeeper: allowanceKeeper,
+		emitEvents:      true,
 	}
 	// Address defines the address of the ERC-20 precompile contract.
 	p.SetAddress(p.tokenPair.GetERC20Contract())
 	return p, nil
 }
 
+// SetEmitEvents enables or disables the emission of EVM events (e.g. Transfer
+// and Approval) for the token pair of the precompile.
+func (p *Precompile) SetEmitEvents(emit bool) {
+	p.emitEvents = emit
+}
+
+// SetTokenPairSettings sets the event emission, the compliance hooks and the
+// creator of the token pair of the precompile from its stored settings.
+func (p *Precompile) SetTokenPairSettings(settings erc20types.TokenPairSettings) {
+	p.emitEvents = settings.EmitEvents
+	p.complianceFeatures = settings.ComplianceFeatures
+	p.creator = settings.Creator
+}
+
+// EmitsEvents returns true if the precompile emits EVM events.
+func (p Precompile) EmitsEvents() bool {
+	return p.emitEvents
+}
+
+// SetSafeApprove enables or disables the safe approve mode. When enabled,
+// changing a non-zero allowance to another non-zero value through Approve
+// reverts, so that callers have to use increaseAllowance and decreaseAllowance
+// or reset the allowance to zero first.
+func (p *Precompile) SetSafeApprove(safeApprove bool) {
+	p.safeApprove = safeApprove
+}
+
+// SetDenomGroup sets the denominations of the group of related
+// representations (e.g. native, IBC and wrapped) that the token pair denom
+// belongs to. It is used to compute the aggregate supply of the group.
+func (p *Precompile) SetDenomGroup(denoms []string) {
+	p.denomGroup = denoms
+}
+
+// SetCreator sets the address of the account that registered the token pair.
+func (p *Precompile) SetCreator(creator common.Address) {
+	p.creator = creator
+}
+
+// SetTokenPairKeeper sets the keeper used to manage the owner controlled state
+// of the token pair of the precompile.
+func (p *Precompile) SetTokenPairKeeper(tokenPairKeeper TokenPairKeeper) {
+	p.tokenPairKeeper = tokenPairKeeper
+}
+
+// SetHooks sets the erc20 hooks that are invoked before and after the token
+// transfers of the precompile.
+func (p *Precompile) SetHooks(hooks erc20types.Erc20Hooks) {
+	p.hooks = hooks
+}
+
+// RunAfterMintHook invokes the AfterMint erc20 hook, if any, for the tokens of
+// the token pair minted to the given account. It's used by the precompiles that
+// wrap the ERC-20 precompile, like the werc20 deposits.
+func (p Precompile) RunAfterMintHook(ctx sdk.Context, to common.Address, amount *big.Int) error {
+	if p.hooks == nil {
+		return nil
+	}
+	return p.hooks.AfterMint(ctx, p.tokenPair, to, amount)
+}
+
+// RunAfterBurnHook invokes the AfterBurn erc20 hook, if any, for the tokens of
+// the token pair burned from the given account. It's used by the precompiles
+// that wrap the ERC-20 precompile, like the werc20 withdrawals.
+func (p Precompile) RunAfterBurnHook(ctx sdk.Context, from common.Address, amount *big.Int) error {
+	if p.hooks == nil {
+		return nil
+	}
+	return p.hooks.AfterBurn(ctx, p.tokenPair, from, amount)
+}
+
+// checkOwner returns an error if the given caller is not the account that
+// registered the token pair or if the owner controlled state of the token pair
+// cannot be managed by the precompile. The token pairs without a recorded
+// creator, like the automatically registered IBC ones, have no owner and can
+// only be paused or block accounts through governance.
+func (p Precompile) checkOwner(caller common.Address) error {
+	if p.creator == (common.Address{}) || caller != p.creator {
+		return ErrCallerNotOwner
+	}
+
+	if p.tokenPairKeeper == nil {
+		return ErrTokenPairKeeperNotSet
+	}
+
+	return nil
+}
+
+// SetComplianceFeatures sets the compliance hooks that are active for the token
+// pair of the precompile. Active hooks add a deterministic gas surcharge to the
+// token transfers.
+func (p *Precompile) SetComplianceFeatures(features ComplianceFeature) {
+	p.complianceFeatures = features
+}
+
+// SetDynamicGas enables or disables the dynamic gas model. When enabled, the
+// precompile charges a base cost for the call data and the KV store gas of
+// the operations executed by the call instead of the static gas schedule.
+func (p *Precompile) SetDynamicGas(enabled bool) {
+	p.dynamicGas = enabled
+	if enabled {
+		p.KvGasConfig = storetypes.KVGasConfig()
+		p.TransientKVGasConfig = storetypes.TransientGasConfig()
+		return
+	}
+
+	p.KvGasConfig = storetypes.GasConfig{}
+	p.TransientKVGasConfig = storetypes.GasConfig{}
+}
+
+// complianceSurcharge returns the additional gas charged for the execution of
+// the given method due to the active compliance hooks. Only the methods that
+// move tokens run the compliance hooks.
+func (p Precompile) complianceSurcharge(methodName string) uint64 {
+	if methodName != TransferMethod && methodName != TransferFromMethod && methodName != TransferWithMemoMethod {
+		return 0
+	}
+
+	var surcharge uint64
+	if p.complianceFeatures&ComplianceScreening != 0 {
+		surcharge += GasSurchargeScreening
+	}
+	if p.complianceFeatures&ComplianceWhitelist != 0 {
+		surcharge += GasSurchargeWhitelist
+	}
+	return surcharge
+}
+
 // RequiredGas calculates the contract gas used for the
 func (p Precompile) RequiredGas(input []byte) uint64 {
 	// NOTE: This check avoid panicking when trying to decode the method ID
@@ -96,39 +316,113 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return 0
 	}
 
+	// NOTE: with the dynamic gas model, the store operations are charged
+	// during the execution, so only the base cost is required upfront.
+	if p.dynamicGas {
+		return p.Precompile.RequiredGas(input, p.IsTransaction(method)) + p.complianceSurcharge(method.Name)
+	}
+
+	gas, err := p.GasCost(method.Name)
+	if err != nil {
+		return 0
+	}
+
+	return gas
+}
+
+// GasCost returns the gas required to execute the method with the given name
+// according to the static gas schedule, including the surcharge of the active
+// compliance hooks. It returns an error if the method is unknown.
+func (p Precompile) GasCost(methodName string) (uint64, error) {
+	gas, err := baseGasCost(methodName)
+	if err != nil {
+		return 0, err
+	}
+
+	return gas + p.complianceSurcharge(methodName), nil
+}
+
+// baseGasCost returns the flat gas cost of the method with the given name.
+func baseGasCost(methodName string) (uint64, error) {
 	// TODO: these values were obtained from Remix using the ERC20.sol from OpenZeppelin.
 	// We should execute the transactions using the ERC20MinterBurnerDecimals.sol from Evmos testnet
 	// to ensure parity in the values.
-	switch method.Name {
+	switch methodName {
 	// ERC-20 transactions
 	case TransferMethod:
-		return GasTransfer
+		return GasTransfer, nil
 	case TransferFromMethod:
-		return GasTransfer
+		return GasTransfer, nil
+	case TransferWithMemoMethod:
+		return GasTransfer, nil
 	case auth.ApproveMethod:
-		return GasApprove
+		return GasApprove, nil
 	case auth.IncreaseAllowanceMethod:
-		return GasIncreaseAllowance
+		return GasIncreaseAllowance, nil
 	case auth.DecreaseAllowanceMethod:
-		return GasDecreaseAllowance
+		return GasDecreaseAllowance, nil
+	case PermitMethod:
+		return GasPermit, nil
+	case PauseMethod:
+		return GasPause, nil
+	case UnpauseMethod:
+		return GasUnpause, nil
+	case BlockAccountMethod:
+		return GasBlockAccount, nil
+	case UnblockAccountMethod:
+		return GasUnblockAccount, nil
 	// ERC-20 queries
 	case NameMethod:
-		return GasName
+		return GasName, nil
 	case SymbolMethod:
-		return GasSymbol
+		return GasSymbol, nil
 	case DecimalsMethod:
-		return GasDecimals
+		return GasDecimals, nil
 	case TotalSupplyMethod:
-		return GasTotalSupply
+		return GasTotalSupply, nil
 	case BalanceOfMethod:
-		return GasBalanceOf
+		return GasBalanceOf, nil
 	case auth.AllowanceMethod:
-		return GasAllowance
+		return GasAllowance, nil
+	case MaxSpendableMethod:
+		return GasMaxSpendable, nil
+	case GroupSupplyMethod:
+		return GasGroupSupply, nil
+	case IBCTransferableMethod:
+		return GasIBCTransferable, nil
+	case CreatorMethod:
+		return GasCreator, nil
+	case AllowanceTimeRemainingMethod:
+		return GasAllowanceTimeRemaining, nil
+	case NoncesMethod:
+		return GasNonces, nil
+	case DomainSeparatorMethod:
+		return GasDomainSeparator, nil
+	case AllowancesOfMethod:
+		return GasAllowancesOf, nil
+	case PausedMethod:
+		return GasPaused, nil
+	case IsBlockedMethod:
+		return GasIsBlocked, nil
+	case HoldersCountMethod:
+		return GasHoldersCount, nil
 	default:
-		return 0
+		return 0, fmt.Errorf(cmn.ErrUnknownMethod, methodName)
 	}
 }
 
+// EstimateFee returns the fee in native coin that is charged for the execution
+// of the method with the given name at the given gas price. It allows clients to
+// preview the cost of a precompile call without simulating it.
+func (p Precompile) EstimateFee(methodName string, gasPrice *big.Int) (*big.Int, error) {
+	gas, err := p.GasCost(methodName)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmn.CalculateFee(gas, gasPrice)
+}
+
 // Run executes the precompiled contract ERC-20 methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
 	// ERC20 precompiles cannot receive funds because they are not managed by an
@@ -150,7 +444,13 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 
 	bz, err = p.HandleMethod(ctx, contract, stateDB, method, args)
 	if err != nil {
-		return nil, err
+		// NOTE: Cosmos SDK errors revert with the CosmosError custom error, which
+		// returns the remaining gas to the caller, so the gas consumed until the
+		// failure is charged here.
+		if !contract.UseGas(ctx.GasMeter().GasConsumed() - initialGas) {
+			return nil, vm.ErrOutOfGas
+		}
+		return cmn.RevertWithCosmosError(err)
 	}
 
 	cost := ctx.GasMeter().GasConsumed() - initialGas
@@ -169,9 +469,15 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case TransferMethod,
 		TransferFromMethod,
+		TransferWithMemoMethod,
 		auth.ApproveMethod,
 		auth.IncreaseAllowanceMethod,
-		auth.DecreaseAllowanceMethod:
+		auth.DecreaseAllowanceMethod,
+		PermitMethod,
+		PauseMethod,
+		UnpauseMethod,
+		BlockAccountMethod,
+		UnblockAccountMethod:
 		return true
 	default:
 		return false
@@ -192,12 +498,24 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.Transfer(ctx, contract, stateDB, method, args)
 	case TransferFromMethod:
 		bz, err = p.TransferFrom(ctx, contract, stateDB, method, args)
+	case TransferWithMemoMethod:
+		bz, err = p.TransferWithMemo(ctx, contract, stateDB, method, args)
 	case auth.ApproveMethod:
 		bz, err = p.Approve(ctx, contract, stateDB, method, args)
 	case auth.IncreaseAllowanceMethod:
 		bz, err = p.IncreaseAllowance(ctx, contract, stateDB, method, args)
 	case auth.DecreaseAllowanceMethod:
 		bz, err = p.DecreaseAllowance(ctx, contract, stateDB, method, args)
+	case PermitMethod:
+		bz, err = p.Permit(ctx, contract, stateDB, method, args)
+	case PauseMethod:
+		bz, err = p.Pause(ctx, contract, stateDB, method, args)
+	case UnpauseMethod:
+		bz, err = p.Unpause(ctx, contract, stateDB, method, args)
+	case BlockAccountMethod:
+		bz, err = p.BlockAccount(ctx, contract, stateDB, method, args)
+	case UnblockAccountMethod:
+		bz, err = p.UnblockAccount(ctx, contract, stateDB, method, args)
 	// ERC-20 queries
 	case NameMethod:
 		bz, err = p.Name(ctx, contract, stateDB, method, args)
@@ -211,6 +529,28 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
 	case auth.AllowanceMethod:
 		bz, err = p.Allowance(ctx, contract, stateDB, method, args)
+	case MaxSpendableMethod:
+		bz, err = p.MaxSpendable(ctx, contract, stateDB, method, args)
+	case GroupSupplyMethod:
+		bz, err = p.GroupSupply(ctx, contract, stateDB, method, args)
+	case IBCTransferableMethod:
+		bz, err = p.IBCTransferable(ctx, contract, stateDB, method, args)
+	case CreatorMethod:
+		bz, err = p.Creator(ctx, contract, stateDB, method, args)
+	case AllowanceTimeRemainingMethod:
+		bz, err = p.AllowanceTimeRemaining(ctx, contract, stateDB, method, args)
+	case NoncesMethod:
+		bz, err = p.Nonces(ctx, contract, stateDB, method, args)
+	case DomainSeparatorMethod:
+		bz, err = p.DomainSeparator(ctx, contract, stateDB, method, args)
+	case AllowancesOfMethod:
+		bz, err = p.AllowancesOf(ctx, contract, stateDB, method, args)
+	case PausedMethod:
+		bz, err = p.Paused(ctx, contract, stateDB, method, args)
+	case IsBlockedMethod:
+		bz, err = p.IsBlocked(ctx, contract, stateDB, method, args)
+	case HoldersCountMethod:
+		bz, err = p.HoldersCount(ctx, contract, stateDB, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
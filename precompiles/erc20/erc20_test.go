@@ -6,6 +6,7 @@ package erc20_test
 import (
 	"math/big"
 
+	storetypes "cosmossdk.io/store/types"
 	auth "github.com/evmos/evmos/v20/precompiles/authorization"
 	"github.com/evmos/evmos/v20/precompiles/erc20"
 )
@@ -24,6 +25,20 @@ func (s *PrecompileTestSuite) TestIsTransaction() {
 	s.Require().False(s.precompile.IsTransaction(&method))
 	method = s.precompile.Methods[erc20.TotalSupplyMethod]
 	s.Require().False(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.MaxSpendableMethod]
+	s.Require().False(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.IBCTransferableMethod]
+	s.Require().False(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.NoncesMethod]
+	s.Require().False(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.DomainSeparatorMethod]
+	s.Require().False(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.AllowancesOfMethod]
+	s.Require().False(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.PausedMethod]
+	s.Require().False(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.IsBlockedMethod]
+	s.Require().False(s.precompile.IsTransaction(&method))
 
 	// Transactions
 	method = s.precompile.Methods[auth.ApproveMethod]
@@ -36,6 +51,18 @@ func (s *PrecompileTestSuite) TestIsTransaction() {
 	s.Require().True(s.precompile.IsTransaction(&method))
 	method = s.precompile.Methods[erc20.TransferFromMethod]
 	s.Require().True(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.TransferWithMemoMethod]
+	s.Require().True(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.PermitMethod]
+	s.Require().True(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.PauseMethod]
+	s.Require().True(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.UnpauseMethod]
+	s.Require().True(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.BlockAccountMethod]
+	s.Require().True(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.UnblockAccountMethod]
+	s.Require().True(s.precompile.IsTransaction(&method))
 }
 
 func (s *PrecompileTestSuite) TestRequiredGas() {
@@ -145,6 +172,42 @@ func (s *PrecompileTestSuite) TestRequiredGas() {
 			},
 			expGas: erc20.GasAllowance,
 		},
+		{
+			name: erc20.MaxSpendableMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.ABI.Pack(erc20.MaxSpendableMethod, s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: erc20.GasMaxSpendable,
+		},
+		{
+			name: erc20.IBCTransferableMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.ABI.Pack(erc20.IBCTransferableMethod)
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: erc20.GasIBCTransferable,
+		},
+		{
+			name: erc20.NoncesMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.ABI.Pack(erc20.NoncesMethod, s.keyring.GetAddr(0))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: erc20.GasNonces,
+		},
+		{
+			name: erc20.DomainSeparatorMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.ABI.Pack(erc20.DomainSeparatorMethod)
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: erc20.GasDomainSeparator,
+		},
 		{
 			name: "invalid method",
 			malleate: func() []byte {
@@ -169,3 +232,136 @@ func (s *PrecompileTestSuite) TestRequiredGas() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestRequiredGasDynamic() {
+	s.SetupTest()
+	s.precompile.SetDynamicGas(true)
+
+	kvGasConfig := storetypes.KVGasConfig()
+
+	// queries are charged the read base cost
+	bz, err := s.precompile.ABI.Pack(erc20.BalanceOfMethod, s.keyring.GetAddr(0))
+	s.Require().NoError(err, "expected no error packing ABI")
+	expGas := kvGasConfig.ReadCostFlat + kvGasConfig.ReadCostPerByte*uint64(len(bz)-4)
+	s.Require().Equal(expGas, s.precompile.RequiredGas(bz))
+
+	// transactions are charged the write base cost
+	bz, err = s.precompile.ABI.Pack(erc20.TransferMethod, s.keyring.GetAddr(0), big.NewInt(1))
+	s.Require().NoError(err, "expected no error packing ABI")
+	expGas = kvGasConfig.WriteCostFlat + kvGasConfig.WriteCostPerByte*uint64(len(bz)-4)
+	s.Require().Equal(expGas, s.precompile.RequiredGas(bz))
+
+	// the compliance surcharge is still applied
+	s.precompile.SetComplianceFeatures(erc20.ComplianceScreening)
+	s.Require().Equal(expGas+erc20.GasSurchargeScreening, s.precompile.RequiredGas(bz))
+
+	// disabling the dynamic gas model restores the static gas schedule
+	s.precompile.SetComplianceFeatures(0)
+	s.precompile.SetDynamicGas(false)
+	s.Require().Equal(uint64(erc20.GasTransfer), s.precompile.RequiredGas(bz))
+}
+
+func (s *PrecompileTestSuite) TestEstimateFee() {
+	testcases := []struct {
+		name        string
+		method      string
+		gasPrice    *big.Int
+		expFee      *big.Int
+		errContains string
+	}{
+		{
+			name:     "pass - transfer",
+			method:   erc20.TransferMethod,
+			gasPrice: big.NewInt(10),
+			expFee:   big.NewInt(erc20.GasTransfer * 10),
+		},
+		{
+			name:     "pass - balanceOf",
+			method:   erc20.BalanceOfMethod,
+			gasPrice: big.NewInt(1_000_000_000),
+			expFee:   new(big.Int).Mul(big.NewInt(erc20.GasBalanceOf), big.NewInt(1_000_000_000)),
+		},
+		{
+			name:     "pass - zero gas price",
+			method:   auth.ApproveMethod,
+			gasPrice: big.NewInt(0),
+			expFee:   big.NewInt(0),
+		},
+		{
+			name:        "fail - unknown method",
+			method:      "unknown",
+			gasPrice:    big.NewInt(10),
+			errContains: "unknown method: unknown",
+		},
+		{
+			name:        "fail - nil gas price",
+			method:      erc20.TransferMethod,
+			errContains: "invalid gas price",
+		},
+		{
+			name:        "fail - negative gas price",
+			method:      erc20.TransferMethod,
+			gasPrice:    big.NewInt(-1),
+			errContains: "invalid gas price",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			fee, err := s.precompile.EstimateFee(tc.method, tc.gasPrice)
+			if tc.errContains == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expFee.String(), fee.String())
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRequiredGasComplianceSurcharge() {
+	transferInput, err := s.precompile.ABI.Pack(erc20.TransferMethod, s.keyring.GetAddr(0), big.NewInt(1))
+	s.Require().NoError(err, "expected no error packing ABI")
+	balanceOfInput, err := s.precompile.ABI.Pack(erc20.BalanceOfMethod, s.keyring.GetAddr(0))
+	s.Require().NoError(err, "expected no error packing ABI")
+
+	testcases := []struct {
+		name     string
+		features erc20.ComplianceFeature
+		input    []byte
+		expGas   uint64
+	}{
+		{
+			name:   "transfer without compliance features",
+			input:  transferInput,
+			expGas: erc20.GasTransfer,
+		},
+		{
+			name:     "transfer with screening",
+			features: erc20.ComplianceScreening,
+			input:    transferInput,
+			expGas:   erc20.GasTransfer + erc20.GasSurchargeScreening,
+		},
+		{
+			name:     "transfer with screening and whitelist",
+			features: erc20.ComplianceScreening | erc20.ComplianceWhitelist,
+			input:    transferInput,
+			expGas:   erc20.GasTransfer + erc20.GasSurchargeScreening + erc20.GasSurchargeWhitelist,
+		},
+		{
+			name:     "query is not charged a surcharge",
+			features: erc20.ComplianceScreening | erc20.ComplianceWhitelist,
+			input:    balanceOfInput,
+			expGas:   erc20.GasBalanceOf,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			precompile := s.setupERC20Precompile(s.tokenDenom)
+			precompile.SetComplianceFeatures(tc.features)
+
+			s.Require().Equal(tc.expGas, precompile.RequiredGas(tc.input))
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"errors"
 	"strings"
 
-	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/evmos/v20/ibc"
@@ -18,12 +17,11 @@ import (
 
 // Errors that have formatted information are defined here as a string.
 const (
-	ErrIntegerOverflow           = "amount %s causes integer overflow"
-	ErrInvalidOwner              = "invalid from address: %s"
-	ErrInvalidReceiver           = "invalid to address: %s"
-	ErrNoAllowanceForToken       = "allowance for token %s does not exist"
-	ErrSubtractMoreThanAllowance = "subtracted value cannot be greater than existing allowance for denom %s: %s > %s"
-	ErrCannotReceiveFunds        = "cannot receive funds, received: %s"
+	ErrIntegerOverflow    = "amount %s causes integer overflow"
+	ErrInvalidOwner       = "invalid from address: %s"
+	ErrInvalidReceiver    = "invalid to address: %s"
+	ErrCannotReceiveFunds = "cannot receive funds, received: %s"
+	ErrMemoTooLong        = "memo length %d exceeds the maximum of %d"
 )
 
 var (
@@ -35,11 +33,24 @@ var (
 	ErrIncreaseNonPositiveValue = errors.New("cannot increase allowance with non-positive values")
 	ErrNegativeAmount           = errors.New("cannot approve negative values")
 	ErrSpenderIsOwner           = errors.New("spender cannot be the owner")
+	ErrUnsafeApprove            = errors.New("cannot approve non-zero amount when allowance is not zero; use increaseAllowance or decreaseAllowance")
+	ErrTokenPairKeeperNotSet    = errors.New("token pair keeper is not set")
 
 	// ERC20 errors
 	ErrDecreasedAllowanceBelowZero  = errors.New("ERC20: decreased allowance below zero")
 	ErrInsufficientAllowance        = errors.New("ERC20: insufficient allowance")
 	ErrTransferAmountExceedsBalance = errors.New("ERC20: transfer amount exceeds balance")
+	ErrCallerNotOwner               = errors.New("ERC20: caller is not the owner")
+
+	// ERC20 permit errors
+	ErrPermitExpired          = errors.New("ERC20Permit: expired deadline")
+	ErrInvalidPermitSignature = errors.New("ERC20Permit: invalid signature")
+
+	// ERC20 pausable errors
+	ErrTokenPaused = errors.New("ERC20Pausable: token transfer while paused")
+
+	// ERC20 blocklist errors
+	ErrAccountBlocked = errors.New("ERC20Blocklist: account is blocked")
 )
 
 // BuildExecRevertedErr returns a mocked error that should align with the
@@ -75,12 +86,6 @@ func ConvertErrToERC20Error(err error) error {
 	switch {
 	case strings.Contains(err.Error(), "spendable balance"):
 		return ErrTransferAmountExceedsBalance
-	case strings.Contains(err.Error(), "requested amount is more than spend limit"):
-		return ErrInsufficientAllowance
-	case strings.Contains(err.Error(), authz.ErrNoAuthorizationFound.Error()):
-		return ErrInsufficientAllowance
-	case strings.Contains(err.Error(), "subtracted value cannot be greater than existing allowance"):
-		return ErrDecreasedAllowanceBelowZero
 	case strings.Contains(err.Error(), cmn.ErrIntegerOverflow):
 		return vm.ErrExecutionReverted
 	case errors.Is(err, ibc.ErrNoIBCVoucherDenom) ||
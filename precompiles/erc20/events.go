@@ -23,7 +23,12 @@ const (
 )
 
 // EmitTransferEvent creates a new Transfer event emitted on transfer and transferFrom transactions.
+// No event is emitted if the event emission is disabled for the token pair.
 func (p Precompile) EmitTransferEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, value *big.Int) error {
+	if !p.emitEvents {
+		return nil
+	}
+
 	// Prepare the event topics
 	event := p.ABI.Events[EventTypeTransfer]
 	topics := make([]common.Hash, 3)
@@ -60,9 +65,37 @@ func (p Precompile) EmitTransferEvent(ctx sdk.Context, stateDB vm.StateDB, from,
 
 // EmitApprovalEvent creates a new approval event emitted on Approve, IncreaseAllowance
 // and DecreaseAllowance transactions.
+// No event is emitted if the event emission is disabled for the token pair.
 func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, owner, spender common.Address, value *big.Int) error {
+	if !p.emitEvents {
+		return nil
+	}
+
+	log, err := newApprovalLog(p.ABI.Events[auth.EventTypeApproval], p.Address(), owner, spender, value)
+	if err != nil {
+		return err
+	}
+	log.BlockNumber = uint64(ctx.BlockHeight()) //nolint:gosec // G115
+
+	stateDB.AddLog(log)
+	return nil
+}
+
+// NewApprovalLog returns the Approval log of the ERC-20 precompile at the given
+// address for an allowance changed outside of the EVM.
+func NewApprovalLog(precompile, owner, spender common.Address, value *big.Int) (*ethtypes.Log, error) {
+	erc20ABI, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	return newApprovalLog(erc20ABI.Events[auth.EventTypeApproval], precompile, owner, spender, value)
+}
+
+// newApprovalLog returns the log of the given Approval event of the ERC-20
+// precompile at the given address.
+func newApprovalLog(event abi.Event, precompile, owner, spender common.Address, value *big.Int) (*ethtypes.Log, error) {
 	// Prepare the event topics
-	event := p.ABI.Events[auth.EventTypeApproval]
 	topics := make([]common.Hash, 3)
 
 	// The first topic is always the signature of the event.
@@ -71,26 +104,23 @@ func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, owner
 	var err error
 	topics[1], err = cmn.MakeTopic(owner)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	topics[2], err = cmn.MakeTopic(spender)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	arguments := abi.Arguments{event.Inputs[2]}
 	packed, err := arguments.Pack(value)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	stateDB.AddLog(&ethtypes.Log{
-		Address:     p.Address(),
-		Topics:      topics,
-		Data:        packed,
-		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
-	})
-
-	return nil
+	return &ethtypes.Log{
+		Address: precompile,
+		Topics:  topics,
+		Data:    packed,
+	}, nil
 }
@@ -8,7 +8,9 @@ import (
 	"github.com/evmos/evmos/v20/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	erc20precompile "github.com/evmos/evmos/v20/precompiles/erc20"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
 )
 
 //nolint:dupl // this is not a duplicate of the approval events test
@@ -103,3 +105,47 @@ func (s *PrecompileTestSuite) TestEmitApprovalEvent() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestTransferEventEmissionToggle() {
+	method := s.precompile.Methods[erc20precompile.TransferMethod]
+	fromAddr := s.keyring.GetKey(0).Addr
+
+	testcases := []struct {
+		name       string
+		emitEvents bool
+		expLogs    int
+	}{
+		{
+			name:       "events enabled - transfer log emitted",
+			emitEvents: true,
+			expLogs:    1,
+		},
+		{
+			name:       "events disabled - no logs emitted",
+			emitEvents: false,
+			expLogs:    0,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.precompile.SetEmitEvents(tc.emitEvents)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), fromAddr, s.precompile, 0)
+
+			err := s.network.App.BankKeeper.MintCoins(ctx, erc20types.ModuleName, XMPLCoin)
+			s.Require().NoError(err, "failed to mint coins")
+			err = s.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, erc20types.ModuleName, fromAddr.Bytes(), XMPLCoin)
+			s.Require().NoError(err, "failed to send coins from module to account")
+
+			_, err = s.precompile.Transfer(ctx, contract, stateDB, &method, []interface{}{toAddr, big.NewInt(100)})
+			s.Require().NoError(err, "expected transfer transaction to succeed")
+
+			toAddrBalance := s.network.App.BankKeeper.GetBalance(ctx, toAddr.Bytes(), tokenDenom)
+			s.Require().Equal(big.NewInt(100), toAddrBalance.Amount.BigInt(), "expected toAddr to have 100 XMPL")
+			s.Require().Len(stateDB.Logs(), tc.expLogs, "expected different number of logs")
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+// HoldersCountMethod defines the ABI method name for the ERC-20 HoldersCount
+// query.
+const HoldersCountMethod = "holdersCount"
+
+// HoldersCount returns the number of accounts holding a non-zero balance of
+// the token. The holders are updated at the end of each block, so the count
+// doesn't reflect the transfers of the current block.
+func (p Precompile) HoldersCount(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	if p.tokenPairKeeper == nil {
+		return nil, ErrTokenPairKeeperNotSet
+	}
+
+	count := p.tokenPairKeeper.GetTokenHoldersCount(ctx, p.tokenPair.Denom)
+	return method.Outputs.Pack(new(big.Int).SetUint64(count))
+}
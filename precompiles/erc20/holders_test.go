@@ -0,0 +1,28 @@
+package erc20_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v20/precompiles/erc20"
+	"github.com/evmos/evmos/v20/testutil"
+)
+
+func (s *PrecompileTestSuite) TestHoldersCount() {
+	method := s.precompile.Methods[erc20.HoldersCountMethod]
+	denom := "xholders"
+
+	ctx := s.network.GetContext()
+	for i := 0; i < 2; i++ {
+		err := testutil.FundAccount(ctx, s.network.App.BankKeeper, s.keyring.GetAccAddr(i), sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+		s.Require().NoError(err, "failed to fund account")
+	}
+
+	// without the token pair keeper the holders cannot be queried
+	bz, err := s.precompile.HoldersCount(ctx, nil, nil, &method, nil)
+	s.requireOut(bz, err, method, false, erc20.ErrTokenPairKeeperNotSet.Error(), nil)
+
+	precompile := s.setupOwnedERC20Precompile(denom, s.keyring.GetAddr(0))
+	bz, err = precompile.HoldersCount(s.network.GetContext(), nil, nil, &method, nil)
+	s.requireOut(bz, err, method, true, "", big.NewInt(2))
+}
@@ -1,7 +1,6 @@
 package erc20_test
 
 import (
-	"fmt"
 	"math/big"
 	"slices"
 	"strings"
@@ -679,7 +678,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					ownerInitialBalance := sdk.Coins{sdk.NewCoin(is.tokenDenom, ownerInitialAmt)}
 
 					// Set allowance
-					is.setupSendAuthzForContract(callType, contractsData, spender.Addr, owner.Priv, transferCoins)
+					is.setupAllowanceForContract(callType, contractsData, spender.Addr, owner.Priv, transferCoins)
 
 					// Transfer tokens
 					txArgs, transferArgs := is.getTxAndCallArgs(
@@ -710,7 +709,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					)
 
 					// Check that the allowance was removed since we authorized only the transferred amount
-					is.ExpectNoSendAuthzForContract(
+					is.ExpectNoAllowanceForContract(
 						callType, contractsData,
 						spender.Addr, owner.Addr,
 					)
@@ -792,7 +791,7 @@ var _ = Describe("ERC20 Extension -", func() {
 						err = is.network.NextBlock()
 						Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
-						is.ExpectSendAuthzForContract(
+						is.ExpectAllowanceForContract(
 							callType, contractsData,
 							owner.Addr, owner.Addr, transferCoins,
 						)
@@ -831,7 +830,7 @@ var _ = Describe("ERC20 Extension -", func() {
 						// Check that the allowance was removed since we authorized only the transferred amount
 						// FIXME: This is not working for the case where we transfer from the own account
 						// because the allowance is not removed on the SDK side.
-						is.ExpectNoSendAuthzForContract(
+						is.ExpectNoAllowanceForContract(
 							callType, contractsData,
 							owner.Addr, owner.Addr,
 						)
@@ -852,7 +851,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					// Fund account with some tokens
 					is.fundWithTokens(callType, contractsData, owner.Addr, fundCoins)
 					// Set allowance
-					is.setupSendAuthzForContract(
+					is.setupAllowanceForContract(
 						callType, contractsData,
 						spender.Addr, owner.Priv, authzCoins,
 					)
@@ -929,7 +928,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					transferCoins := senderInitialBalance.Add(sdk.NewInt64Coin(is.tokenDenom, 100))
 
 					// Set allowance
-					is.setupSendAuthzForContract(
+					is.setupAllowanceForContract(
 						callType, contractsData,
 						sender.Addr, from.Priv, transferCoins,
 					)
@@ -974,7 +973,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					ownerInitialBalance := sdk.Coins{sdk.NewCoin(is.tokenDenom, ownerInitialAmt)}
 
 					// Set allowance
-					is.setupSendAuthzForContract(
+					is.setupAllowanceForContract(
 						callType, contractsData,
 						spender, owner.Priv, transferCoins,
 					)
@@ -1008,7 +1007,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					)
 
 					// Check that the allowance was removed since we authorized only the transferred amount
-					is.ExpectNoSendAuthzForContract(
+					is.ExpectNoAllowanceForContract(
 						callType, contractsData,
 						spender, owner.Addr,
 					)
@@ -1036,7 +1035,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					ownerInitialBalance := sdk.Coins{sdk.NewCoin(is.tokenDenom, ownerInitialAmt)}
 
 					// Set allowance
-					is.setupSendAuthzForContract(
+					is.setupAllowanceForContract(
 						callType, contractsData,
 						spender, owner.Priv, transferCoins,
 					)
@@ -1070,7 +1069,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					)
 
 					// Check that the allowance was removed since we authorized only the transferred amount
-					is.ExpectNoSendAuthzForContract(
+					is.ExpectNoAllowanceForContract(
 						callType, contractsData,
 						spender, owner.Addr,
 					)
@@ -1095,7 +1094,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					is.fundWithTokens(callType, contractsData, from.Addr, fundCoins)
 
 					// Set allowance
-					is.setupSendAuthzForContract(callType, contractsData, spender, from.Priv, authzCoins)
+					is.setupAllowanceForContract(callType, contractsData, spender, from.Priv, authzCoins)
 
 					// Transfer tokens
 					txArgs, transferArgs := is.getTxAndCallArgs(
@@ -1202,7 +1201,7 @@ var _ = Describe("ERC20 Extension -", func() {
 				granter := is.keyring.GetKey(0)
 				authzCoins := sdk.Coins{sdk.NewInt64Coin(is.tokenDenom, 100)}
 
-				is.setupSendAuthzForContract(callType, contractsData, grantee, granter.Priv, authzCoins)
+				is.setupAllowanceForContract(callType, contractsData, grantee, granter.Priv, authzCoins)
 
 				txArgs, allowanceArgs := is.getTxAndCallArgs(callType, contractsData, auth.AllowanceMethod, granter.Addr, grantee)
 
@@ -1248,7 +1247,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					granter := is.keyring.GetKey(0)
 					authzCoins := sdk.Coins{sdk.NewInt64Coin(is.tokenDenom, 100)}
 
-					is.setupSendAuthzForContract(callType, contractsData, granter.Addr, granter.Priv, authzCoins)
+					is.setupAllowanceForContract(callType, contractsData, granter.Addr, granter.Priv, authzCoins)
 
 					txArgs, allowanceArgs := is.getTxAndCallArgs(callType, contractsData, auth.AllowanceMethod, granter.Addr, granter.Addr)
 
@@ -1407,7 +1406,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
 					is.ExpectTrueToBeReturned(ethRes, auth.ApproveMethod)
-					is.ExpectSendAuthzForContract(
+					is.ExpectAllowanceForContract(
 						callType, contractsData,
 						grantee.Addr, granter.Addr, transferCoins,
 					)
@@ -1417,7 +1416,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Entry(" - through erc20 v5 contract", erc20V5Call),
 				)
 
-				DescribeTable("it should add a new spend limit to an existing allowance with a different token", func(callType CallType) {
+				DescribeTable("it should leave an existing authz grant untouched", func(callType CallType) {
 					grantee := is.keyring.GetKey(1)
 					granter := is.keyring.GetKey(0)
 					bondCoins := sdk.Coins{sdk.NewInt64Coin(is.network.GetDenom(), 200)}
@@ -1439,24 +1438,21 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
 					is.ExpectTrueToBeReturned(ethRes, auth.ApproveMethod)
-					// Check allowance contains both spend limits
-					is.expectSendAuthz(grantee.AccAddr, granter.AccAddr, bondCoins.Add(tokenCoins...))
+					// Check the authorization is unchanged and the allowance is stored separately
+					is.expectSendAuthz(grantee.AccAddr, granter.AccAddr, bondCoins)
+					is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr, tokenCoins)
 				},
 					Entry(" - direct call", directCall),
-
-					// NOTE 2: we are not passing the erc20 contract call here because the ERC20 contract
-					// only supports the actual token denomination and doesn't know of other allowances.
 				)
 
-				DescribeTable("it should set the new spend limit for an existing allowance with the same token", func(callType CallType) {
+				DescribeTable("it should overwrite an existing allowance", func(callType CallType) {
 					grantee := is.keyring.GetKey(1)
 					granter := is.keyring.GetKey(0)
-					bondCoins := sdk.Coins{sdk.NewInt64Coin(is.network.GetDenom(), 200)}
 					tokenCoins := sdk.Coins{sdk.NewInt64Coin(is.tokenDenom, 100)}
-					doubleTokenCoin := sdk.NewInt64Coin(is.tokenDenom, 200)
+					doubleTokenCoins := sdk.Coins{sdk.NewInt64Coin(is.tokenDenom, 200)}
 
-					// set up a previous authorization
-					is.setupSendAuthz(grantee.AccAddr, granter.Priv, bondCoins.Add(doubleTokenCoin))
+					// set up a previous allowance
+					is.setupAllowanceForContract(callType, contractsData, grantee.Addr, granter.Priv, doubleTokenCoins)
 
 					// Approve allowance
 					txArgs, approveArgs := is.getTxAndCallArgs(callType, contractsData, auth.ApproveMethod, grantee.Addr, tokenCoins[0].Amount.BigInt())
@@ -1471,52 +1467,20 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
 					is.ExpectTrueToBeReturned(ethRes, auth.ApproveMethod)
-					// Check allowance contains both spend limits
-					is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granter.Addr, bondCoins.Add(tokenCoins...))
+					is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr, tokenCoins)
 				},
 					Entry(" - direct call", directCall),
 					Entry(" - through erc20 contract", erc20Call),
 					Entry(" - through erc20 v5 contract", erc20V5Call),
 				)
 
-				DescribeTable("it should remove the token from the spend limit of an existing authorization when approving zero", func(callType CallType) {
-					grantee := is.keyring.GetKey(1)
-					granter := is.keyring.GetKey(0)
-					bondCoins := sdk.Coins{sdk.NewInt64Coin(is.network.GetDenom(), 200)}
-					tokenCoin := sdk.NewInt64Coin(is.tokenDenom, 100)
-
-					// set up a previous authorization
-					is.setupSendAuthz(grantee.AccAddr, granter.Priv, bondCoins.Add(tokenCoin))
-
-					// Approve allowance
-					txArgs, approveArgs := is.getTxAndCallArgs(callType, contractsData, auth.ApproveMethod, grantee.Addr, common.Big0)
-
-					approveCheck := passCheck.WithExpEvents(auth.EventTypeApproval)
-
-					_, ethRes, err := is.factory.CallContractAndCheckLogs(granter.Priv, txArgs, approveArgs, approveCheck)
-					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
-
-					is.ExpectTrueToBeReturned(ethRes, auth.ApproveMethod)
-
-					// commit the changes to state
-					err = is.network.NextBlock()
-					Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
-
-					// Check allowance contains only the spend limit in network denomination
-					is.expectSendAuthz(grantee.AccAddr, granter.AccAddr, bondCoins)
-				},
-					Entry(" - direct call", directCall),
-					// NOTE: we are not passing the erc20 contract call here because the ERC20 contract
-					// only supports the actual token denomination and doesn't know of other allowances.
-				)
-
-				DescribeTable("it should delete the authorization when approving zero with no other spend limits", func(callType CallType) {
+				DescribeTable("it should delete the allowance when approving zero", func(callType CallType) {
 					grantee := is.keyring.GetKey(1)
 					granter := is.keyring.GetKey(0)
 					tokenCoins := sdk.Coins{sdk.NewInt64Coin(is.tokenDenom, 100)}
 
-					// set up a previous authorization
-					is.setupSendAuthzForContract(callType, contractsData, grantee.Addr, granter.Priv, tokenCoins)
+					// set up a previous allowance
+					is.setupAllowanceForContract(callType, contractsData, grantee.Addr, granter.Priv, tokenCoins)
 
 					// Approve allowance
 					txArgs, approveArgs := is.getTxAndCallArgs(callType, contractsData, auth.ApproveMethod, grantee.Addr, common.Big0)
@@ -1532,7 +1496,7 @@ var _ = Describe("ERC20 Extension -", func() {
 
 					is.ExpectTrueToBeReturned(ethRes, auth.ApproveMethod)
 					// Check allowance was deleted
-					is.expectNoSendAuthz(grantee.AccAddr, granter.AccAddr)
+					is.ExpectNoAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr)
 				},
 					Entry(" - direct call", directCall),
 					Entry(" - through erc20 contract", erc20Call),
@@ -1558,7 +1522,7 @@ var _ = Describe("ERC20 Extension -", func() {
 
 					is.ExpectTrueToBeReturned(ethRes, auth.ApproveMethod)
 					// Check still no authorization exists
-					is.ExpectNoSendAuthzForContract(callType, contractsData, grantee.Addr, granter.Addr)
+					is.ExpectNoAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr)
 				},
 					Entry(" - direct call", directCall),
 					Entry(" - through erc20 contract", erc20Call),
@@ -1593,7 +1557,7 @@ var _ = Describe("ERC20 Extension -", func() {
 						err = is.network.NextBlock()
 						Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
-						is.ExpectNoSendAuthzForContract(
+						is.ExpectNoAllowanceForContract(
 							directCall, contractsData,
 							grantee.Addr, granter.Addr,
 						)
@@ -1621,7 +1585,7 @@ var _ = Describe("ERC20 Extension -", func() {
 						Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
 						is.ExpectTrueToBeReturned(ethRes, auth.ApproveMethod)
-						is.ExpectSendAuthzForContract(
+						is.ExpectAllowanceForContract(
 							callType, contractsData,
 							grantee.Addr, granter.Addr, authzCoins,
 						)
@@ -1631,7 +1595,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					)
 				})
 
-				DescribeTable("it should return an error if approving 0 and allowance only exists for other tokens", func(callType CallType) {
+				DescribeTable("it should no-op if approving 0 and only an authz grant exists", func(callType CallType) {
 					grantee := is.keyring.GetKey(1)
 					granter := is.keyring.GetKey(0)
 					bondCoins := sdk.Coins{sdk.NewInt64Coin(is.network.GetDenom(), 200)}
@@ -1642,21 +1606,20 @@ var _ = Describe("ERC20 Extension -", func() {
 					// Approve allowance
 					txArgs, approveArgs := is.getTxAndCallArgs(callType, contractsData, auth.ApproveMethod, grantee.Addr, common.Big0)
 
-					notFoundCheck := failCheck.WithErrContains(
-						fmt.Sprintf(erc20.ErrNoAllowanceForToken, is.tokenDenom),
-					)
+					approveCheck := passCheck.WithExpEvents(auth.EventTypeApproval)
 
-					_, ethRes, err := is.factory.CallContractAndCheckLogs(granter.Priv, txArgs, approveArgs, notFoundCheck)
+					_, ethRes, err := is.factory.CallContractAndCheckLogs(granter.Priv, txArgs, approveArgs, approveCheck)
 					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
-					Expect(ethRes).To(BeNil(), "expected empty result")
 
 					// commit changes to chain state
 					err = is.network.NextBlock()
 					Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
+
+					is.ExpectTrueToBeReturned(ethRes, auth.ApproveMethod)
+					// Check the authorization is unchanged
+					is.expectSendAuthz(grantee.AccAddr, granter.AccAddr, bondCoins)
 				},
 					Entry(" - direct call", directCall),
-					// NOTE: we are not passing the erc20 contract call here because the ERC20 contract
-					// only supports the actual token denomination and doesn't know of other allowances.
 				)
 			})
 
@@ -1684,7 +1647,7 @@ var _ = Describe("ERC20 Extension -", func() {
 
 					is.ExpectTrueToBeReturned(ethRes, auth.ApproveMethod)
 					// Check allowance
-					is.ExpectSendAuthzForContract(
+					is.ExpectAllowanceForContract(
 						callType, contractsData,
 						grantee.Addr, granter, transferCoins,
 					)
@@ -1724,7 +1687,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
 					// Check allowance has been updated
-					is.ExpectSendAuthzForContract(
+					is.ExpectAllowanceForContract(
 						callType, contractsData,
 						grantee.Addr, granter, newAmount,
 					)
@@ -1733,7 +1696,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Entry(" - through erc20 v5 caller contract", erc20V5CallerCall),
 				)
 
-				DescribeTable("it should delete the authorization when approving zero with no other spend limits", func(callType CallType) {
+				DescribeTable("it should delete the allowance when approving zero", func(callType CallType) {
 					sender := is.keyring.GetKey(0)
 					grantee := is.keyring.GetKey(1)
 					granter := contractsData.GetContractData(callType).Address // the granter will be the contract address
@@ -1765,7 +1728,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					is.ExpectTrueToBeReturned(ethRes, auth.ApproveMethod)
 
 					// Check allowance was deleted from the keeper / is returning 0 for smart contracts
-					is.ExpectNoSendAuthzForContract(callType, contractsData, grantee.Addr, granter)
+					is.ExpectNoAllowanceForContract(callType, contractsData, grantee.Addr, granter)
 				},
 					Entry(" - through contract", contractCall),
 					Entry(" - through erc20 v5 caller contract", erc20V5CallerCall),
@@ -1791,7 +1754,7 @@ var _ = Describe("ERC20 Extension -", func() {
 
 					is.ExpectTrueToBeReturned(ethRes, auth.ApproveMethod)
 					// Check still no authorization exists
-					is.ExpectNoSendAuthzForContract(callType, contractsData, grantee.Addr, granter)
+					is.ExpectNoAllowanceForContract(callType, contractsData, grantee.Addr, granter)
 				},
 					Entry(" - through contract", contractCall),
 					Entry(" - through erc20 v5 caller contract", erc20V5CallerCall),
@@ -1825,7 +1788,7 @@ var _ = Describe("ERC20 Extension -", func() {
 						err = is.network.NextBlock()
 						Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
-						is.ExpectNoSendAuthzForContract(
+						is.ExpectNoAllowanceForContract(
 							callType, contractsData,
 							grantee, granter,
 						)
@@ -1854,7 +1817,7 @@ var _ = Describe("ERC20 Extension -", func() {
 						Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
 						is.ExpectTrueToBeReturned(ethRes, auth.ApproveMethod)
-						is.ExpectSendAuthzForContract(
+						is.ExpectAllowanceForContract(
 							callType, contractsData,
 							grantee, granter, authzCoins,
 						)
@@ -2102,7 +2065,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					err = is.network.NextBlock()
 					Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
-					is.ExpectNoSendAuthzForContract(
+					is.ExpectNoAllowanceForContract(
 						directCall, contractsData,
 						grantee.Addr, granter.Addr,
 					)
@@ -2130,7 +2093,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
 					is.ExpectTrueToBeReturned(ethRes, auth.IncreaseAllowanceMethod)
-					is.ExpectSendAuthzForContract(
+					is.ExpectAllowanceForContract(
 						callType, contractsData,
 						grantee.Addr, granter.Addr, authzCoins,
 					)
@@ -2159,7 +2122,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
 					Expect(ethRes).To(BeNil(), "expected empty result")
 
-					is.ExpectNoSendAuthzForContract(
+					is.ExpectNoAllowanceForContract(
 						directCall, contractsData,
 						grantee.Addr, granter.Addr,
 					)
@@ -2175,7 +2138,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					authzCoins := sdk.Coins{sdk.NewInt64Coin(is.tokenDenom, 200)}
 					decreaseCoins := sdk.Coins{sdk.NewInt64Coin(is.tokenDenom, 100)}
 
-					is.setupSendAuthzForContract(
+					is.setupAllowanceForContract(
 						callType, contractsData,
 						grantee.Addr, granter.Priv, authzCoins,
 					)
@@ -2196,7 +2159,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
 
 					is.ExpectTrueToBeReturned(ethRes, auth.IncreaseAllowanceMethod)
-					is.ExpectSendAuthzForContract(
+					is.ExpectAllowanceForContract(
 						callType, contractsData,
 						grantee.Addr, granter.Addr, decreaseCoins,
 					)
@@ -2215,9 +2178,7 @@ var _ = Describe("ERC20 Extension -", func() {
 
 				notFoundCheck := execRevertedCheck
 				if callType == directCall {
-					notFoundCheck = failCheck.WithErrContains(
-						fmt.Sprintf(auth.ErrAuthzDoesNotExistOrExpired, erc20.SendMsgURL, grantee.Addr.String()),
-					)
+					notFoundCheck = failCheck.WithErrContains(erc20.ErrDecreasedAllowanceBelowZero.Error())
 				}
 
 				_, ethRes, err := is.factory.CallContractAndCheckLogs(granter.Priv, txArgs, decreaseArgs, notFoundCheck)
@@ -2254,7 +2215,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
 					is.ExpectTrueToBeReturned(ethRes, auth.ApproveMethod)
-					is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins)
+					is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins)
 				},
 					Entry(" - direct call", directCall),
 					Entry(" - through erc20 contract", erc20Call),
@@ -2280,7 +2241,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
 					is.ExpectTrueToBeReturned(ethRes, auth.IncreaseAllowanceMethod)
-					is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, contractAddr, authzCoins)
+					is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, contractAddr, authzCoins)
 				},
 					Entry(" - contract call", contractCall),
 					Entry(" - through erc20 caller contract", erc20CallerCall),
@@ -2288,7 +2249,7 @@ var _ = Describe("ERC20 Extension -", func() {
 			})
 		})
 
-		When("an allowance exists for other tokens", func() {
+		When("only an authz grant exists", func() {
 			var bondCoins sdk.Coins
 
 			BeforeEach(func() {
@@ -2296,7 +2257,7 @@ var _ = Describe("ERC20 Extension -", func() {
 				is.setupSendAuthz(grantee.AccAddr, granter.Priv, bondCoins)
 			})
 
-			DescribeTable("increasing the allowance should add the token to the spend limit", func(callType CallType) {
+			DescribeTable("increasing the allowance should create a new allowance", func(callType CallType) {
 				increaseCoins := sdk.Coins{sdk.NewInt64Coin(is.tokenDenom, 100)}
 
 				txArgs, increaseArgs := is.getTxAndCallArgs(callType, contractsData, auth.IncreaseAllowanceMethod, grantee.Addr, increaseCoins[0].Amount.BigInt())
@@ -2311,11 +2272,11 @@ var _ = Describe("ERC20 Extension -", func() {
 				Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
 				is.ExpectTrueToBeReturned(ethRes, auth.IncreaseAllowanceMethod)
-				is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granter.Addr, bondCoins.Add(increaseCoins...))
+				is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr, increaseCoins)
+				// Check the authorization is unchanged
+				is.expectSendAuthz(grantee.AccAddr, granter.AccAddr, bondCoins)
 			},
 				Entry(" - direct call", directCall),
-				// NOTE: we are not passing the erc20 contract call here because the ERC20 contract
-				// only supports the actual token denomination and doesn't know of other allowances.
 			)
 
 			DescribeTable("decreasing the allowance should return an error", func(callType CallType) {
@@ -2325,9 +2286,7 @@ var _ = Describe("ERC20 Extension -", func() {
 
 				notFoundCheck := execRevertedCheck
 				if callType == directCall {
-					notFoundCheck = failCheck.WithErrContains(
-						fmt.Sprintf(erc20.ErrNoAllowanceForToken, is.tokenDenom),
-					)
+					notFoundCheck = failCheck.WithErrContains(erc20.ErrDecreasedAllowanceBelowZero.Error())
 				}
 
 				_, ethRes, err := is.factory.CallContractAndCheckLogs(granter.Priv, txArgs, decreaseArgs, notFoundCheck)
@@ -2339,21 +2298,21 @@ var _ = Describe("ERC20 Extension -", func() {
 				Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 			},
 				Entry(" - direct call", directCall),
-				// NOTE: we are not passing the erc20 contract call here because the ERC20 contract
-				// only supports the actual token denomination and doesn't know of other allowances.
 			)
 		})
 
-		When("an allowance exists for the same token", func() {
-			var authzCoins sdk.Coins
+		When("an allowance and an authz grant exist", func() {
+			var (
+				bondCoins  sdk.Coins
+				authzCoins sdk.Coins
+			)
 
 			BeforeEach(func() {
-				authzCoins = sdk.NewCoins(
-					sdk.NewInt64Coin(is.network.GetDenom(), 100),
-					sdk.NewInt64Coin(is.tokenDenom, 200),
-				)
+				bondCoins = sdk.Coins{sdk.NewInt64Coin(is.network.GetDenom(), 100)}
+				authzCoins = sdk.Coins{sdk.NewInt64Coin(is.tokenDenom, 200)}
 
-				is.setupSendAuthz(grantee.AccAddr, granter.Priv, authzCoins)
+				is.setupSendAuthz(grantee.AccAddr, granter.Priv, bondCoins)
+				is.setupAllowanceForContract(directCall, contractsData, grantee.Addr, granter.Priv, authzCoins)
 			})
 
 			DescribeTable("increasing the allowance should increase the spend limit", func(callType CallType) {
@@ -2371,7 +2330,7 @@ var _ = Describe("ERC20 Extension -", func() {
 				Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
 				is.ExpectTrueToBeReturned(ethRes, auth.IncreaseAllowanceMethod)
-				is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins.Add(increaseCoins...))
+				is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins.Add(increaseCoins...))
 			},
 				Entry(" - direct call", directCall),
 				// NOTE: we are not passing the erc20 contract call here because the ERC20 contract
@@ -2393,7 +2352,7 @@ var _ = Describe("ERC20 Extension -", func() {
 				Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
 				is.ExpectTrueToBeReturned(ethRes, auth.DecreaseAllowanceMethod)
-				is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins.Sub(decreaseCoins...))
+				is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins.Sub(decreaseCoins...))
 			},
 				Entry(" - direct call", directCall),
 				// NOTE: we are not passing the erc20 contract call here because the ERC20 contract
@@ -2417,7 +2376,7 @@ var _ = Describe("ERC20 Extension -", func() {
 				// only supports the actual token denomination and doesn't know of other allowances.
 			)
 
-			DescribeTable("decreasing the allowance to zero should remove the token from the spend limit", func(callType CallType) {
+			DescribeTable("decreasing the allowance to zero should delete the allowance", func(callType CallType) {
 				txArgs, decreaseArgs := is.getTxAndCallArgs(callType, contractsData, auth.DecreaseAllowanceMethod, grantee.Addr, authzCoins.AmountOf(is.tokenDenom).BigInt())
 
 				approveCheck := passCheck.WithExpEvents(auth.EventTypeApproval)
@@ -2431,10 +2390,9 @@ var _ = Describe("ERC20 Extension -", func() {
 				err = is.network.NextBlock()
 				Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
-				// Check that only the spend limit in the network denomination remains
-				bondDenom := is.network.GetDenom()
-				expCoins := sdk.Coins{sdk.NewCoin(bondDenom, authzCoins.AmountOf(bondDenom))}
-				is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granter.Addr, expCoins)
+				// Check that the allowance was deleted and the authorization is unchanged
+				is.ExpectNoAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr)
+				is.expectSendAuthz(grantee.AccAddr, granter.AccAddr, bondCoins)
 			},
 				Entry(" - direct call", directCall),
 				// NOTE: we are not passing the erc20 contract call here because the ERC20 contract
@@ -2455,7 +2413,7 @@ var _ = Describe("ERC20 Extension -", func() {
 				Expect(err).ToNot(HaveOccurred(), "error on NextBlock call")
 
 				// Check that the allowance was not changed
-				is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins)
+				is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins)
 			},
 				Entry(" - direct call", directCall),
 			)
@@ -2473,8 +2431,8 @@ var _ = Describe("ERC20 Extension -", func() {
 
 					// NOTE: We set up the standard authorization here for the authz keeper and then also
 					// set up the authorization for the ERC20 contract, so that we can test both.
-					is.setupSendAuthzForContract(directCall, contractsData, grantee.Addr, granter.Priv, authzCoins)
-					is.setupSendAuthzForContract(erc20Call, contractsData, grantee.Addr, granter.Priv, authzCoins)
+					is.setupAllowanceForContract(directCall, contractsData, grantee.Addr, granter.Priv, authzCoins)
+					is.setupAllowanceForContract(erc20Call, contractsData, grantee.Addr, granter.Priv, authzCoins)
 				})
 
 				DescribeTable("increasing the allowance should increase the spend limit", func(callType CallType) {
@@ -2490,7 +2448,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
 
 					is.ExpectTrueToBeReturned(ethRes, auth.DecreaseAllowanceMethod)
-					is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins.Add(increaseCoins...))
+					is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins.Add(increaseCoins...))
 				},
 					Entry(" - direct call", directCall),
 					Entry(" - through erc20 contract", erc20Call),
@@ -2511,7 +2469,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
 
 					is.ExpectTrueToBeReturned(ethRes, auth.DecreaseAllowanceMethod)
-					is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins.Sub(decreaseCoins...))
+					is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins.Sub(decreaseCoins...))
 				},
 					Entry(" - direct call", directCall),
 					Entry(" - through erc20 contract", erc20Call),
@@ -2530,7 +2488,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
 
 					is.ExpectTrueToBeReturned(ethRes, auth.DecreaseAllowanceMethod)
-					is.ExpectNoSendAuthzForContract(callType, contractsData, grantee.Addr, granter.Addr)
+					is.ExpectNoAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr)
 				},
 					Entry(" - direct call", directCall),
 					Entry(" - through erc20 contract", erc20Call),
@@ -2553,7 +2511,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
 
 					// Check that the allowance was not changed
-					is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins)
+					is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins)
 				},
 					Entry(" - direct call", directCall),
 					Entry(" - through erc20 contract", erc20Call),
@@ -2573,7 +2531,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
 
 					// Check that the allowance was not changed
-					is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins)
+					is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granter.Addr, authzCoins)
 				},
 					Entry(" - direct call", directCall),
 					Entry(" - through erc20 contract", erc20Call),
@@ -2607,7 +2565,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					err = is.network.NextBlock()
 					Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
 
-					is.ExpectSendAuthzForContract(contractCall, contractsData, grantee.Addr, callerContractAddr, authzCoins)
+					is.ExpectAllowanceForContract(contractCall, contractsData, grantee.Addr, callerContractAddr, authzCoins)
 
 					// Create the authorization for the ERC20 caller contract
 					txArgs, approveArgs = is.getTxAndCallArgs(erc20CallerCall, contractsData, auth.ApproveMethod, grantee.Addr, authzCoins[0].Amount.BigInt())
@@ -2618,7 +2576,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					err = is.network.NextBlock()
 					Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
 
-					is.ExpectSendAuthzForContract(erc20CallerCall, contractsData, grantee.Addr, erc20CallerContractAddr, authzCoins)
+					is.ExpectAllowanceForContract(erc20CallerCall, contractsData, grantee.Addr, erc20CallerContractAddr, authzCoins)
 				})
 
 				DescribeTable("increasing the allowance should increase the spend limit", func(callType CallType) { //nolint:dupl
@@ -2636,7 +2594,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
 
 					is.ExpectTrueToBeReturned(ethRes, auth.IncreaseAllowanceMethod)
-					is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granterAddr, authzCoins.Add(increaseCoins...))
+					is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granterAddr, authzCoins.Add(increaseCoins...))
 				},
 					Entry(" - contract call", contractCall),
 					Entry(" - through erc20 caller contract", erc20CallerCall),
@@ -2656,7 +2614,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
 
 					// Check that the allowance was not changed
-					is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granterAddr, authzCoins)
+					is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granterAddr, authzCoins)
 				},
 					Entry(" - contract call", contractCall),
 					Entry(" - through erc20 caller contract", erc20CallerCall),
@@ -2677,7 +2635,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
 
 					is.ExpectTrueToBeReturned(ethRes, auth.DecreaseAllowanceMethod)
-					is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granterAddr, authzCoins.Sub(decreaseCoins...))
+					is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granterAddr, authzCoins.Sub(decreaseCoins...))
 				},
 					Entry(" - contract call", contractCall),
 					Entry(" - through erc20 caller contract", erc20CallerCall),
@@ -2697,7 +2655,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
 
 					is.ExpectTrueToBeReturned(ethRes, auth.DecreaseAllowanceMethod)
-					is.ExpectNoSendAuthzForContract(callType, contractsData, grantee.Addr, granterAddr)
+					is.ExpectNoAllowanceForContract(callType, contractsData, grantee.Addr, granterAddr)
 				},
 					Entry(" - contract call", contractCall),
 					Entry(" - through erc20 caller contract", erc20CallerCall),
@@ -2717,7 +2675,7 @@ var _ = Describe("ERC20 Extension -", func() {
 					Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
 
 					// Check that the allowance was not changed
-					is.ExpectSendAuthzForContract(callType, contractsData, grantee.Addr, granterAddr, authzCoins)
+					is.ExpectAllowanceForContract(callType, contractsData, grantee.Addr, granterAddr, authzCoins)
 				},
 					Entry(" - contract call", contractCall),
 					Entry(" - through erc20 caller contract", erc20CallerCall),
@@ -0,0 +1,130 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// PauseMethod defines the ABI method name for the ERC-20 Pause
+	// transaction.
+	PauseMethod = "pause"
+	// UnpauseMethod defines the ABI method name for the ERC-20 Unpause
+	// transaction.
+	UnpauseMethod = "unpause"
+	// PausedMethod defines the ABI method name for the ERC-20 Paused
+	// query.
+	PausedMethod = "paused"
+
+	// EventTypePaused defines the event type for the ERC-20 Pause transactions.
+	EventTypePaused = "Paused"
+	// EventTypeUnpaused defines the event type for the ERC-20 Unpause transactions.
+	EventTypeUnpaused = "Unpaused"
+)
+
+// IsPaused returns true if the token pair of the precompile is paused.
+func (p Precompile) IsPaused() bool {
+	return p.tokenPair.Paused
+}
+
+// Pause halts the transfers of the token. Only the account that registered the
+// token pair can pause it.
+func (p *Precompile) Pause(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	if err := p.setPaused(ctx, contract.CallerAddress, true); err != nil {
+		return nil, err
+	}
+
+	if err := p.emitPauseEvent(ctx, stateDB, EventTypePaused, contract.CallerAddress); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Unpause resumes the transfers of the token. Only the account that registered
+// the token pair can unpause it.
+func (p *Precompile) Unpause(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	if err := p.setPaused(ctx, contract.CallerAddress, false); err != nil {
+		return nil, err
+	}
+
+	if err := p.emitPauseEvent(ctx, stateDB, EventTypeUnpaused, contract.CallerAddress); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Paused returns true if the token is paused.
+func (p Precompile) Paused(
+	_ sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	return method.Outputs.Pack(p.tokenPair.Paused)
+}
+
+// setPaused updates the pause status of the token pair through the token pair
+// keeper after checking that the caller is the owner of the token pair.
+func (p *Precompile) setPaused(ctx sdk.Context, caller common.Address, paused bool) error {
+	if err := p.checkOwner(caller); err != nil {
+		return err
+	}
+
+	pair, err := p.tokenPairKeeper.SetTokenPairPaused(ctx, p.tokenPair.Erc20Address, paused)
+	if err != nil {
+		return err
+	}
+
+	p.tokenPair = pair
+	return nil
+}
+
+// emitPauseEvent creates a new Paused or Unpaused event with the account that
+// changed the pause status of the token.
+// No event is emitted if the event emission is disabled for the token pair.
+func (p Precompile) emitPauseEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, account common.Address) error {
+	if !p.emitEvents {
+		return nil
+	}
+
+	event := p.ABI.Events[eventType]
+
+	// NOTE: the account is not indexed, so the only topic is the event signature.
+	topics := []common.Hash{event.ID}
+
+	arguments := abi.Arguments{event.Inputs[0]}
+	packed, err := arguments.Pack(account)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package erc20_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/erc20"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// setupOwnedERC20Precompile sets up an ERC-20 precompile for a registered token pair
+// of the given denomination that is managed by the given creator.
+func (s *PrecompileTestSuite) setupOwnedERC20Precompile(denom string, creator common.Address) *erc20.Precompile {
+	tokenPair := erc20types.NewTokenPair(utiltx.GenerateAddress(), denom, erc20types.OWNER_MODULE)
+	s.network.App.Erc20Keeper.SetToken(s.network.GetContext(), tokenPair)
+
+	precompile, err := setupERC20PrecompileForTokenPair(*s.network, tokenPair)
+	s.Require().NoError(err, "failed to set up %q erc20 precompile", tokenPair.Denom)
+
+	precompile.SetCreator(creator)
+	precompile.SetTokenPairKeeper(s.network.App.Erc20Keeper)
+	return precompile
+}
+
+func (s *PrecompileTestSuite) TestPause() {
+	pauseMethod := s.precompile.Methods[erc20.PauseMethod]
+	unpauseMethod := s.precompile.Methods[erc20.UnpauseMethod]
+	owner := s.keyring.GetAddr(0)
+
+	testcases := []struct {
+		name        string
+		creator     common.Address
+		caller      common.Address
+		methods     []string
+		expPass     bool
+		errContains string
+		expPaused   bool
+	}{
+		{
+			name:        "fail - creator was not recorded",
+			caller:      owner,
+			methods:     []string{erc20.PauseMethod},
+			errContains: erc20.ErrCallerNotOwner.Error(),
+		},
+		{
+			name:        "fail - caller is not the owner",
+			creator:     owner,
+			caller:      s.keyring.GetAddr(1),
+			methods:     []string{erc20.PauseMethod},
+			errContains: erc20.ErrCallerNotOwner.Error(),
+		},
+		{
+			name:        "fail - unpause a token that is not paused",
+			creator:     owner,
+			caller:      owner,
+			methods:     []string{erc20.UnpauseMethod},
+			errContains: erc20types.ErrTokenPairNotPaused.Error(),
+		},
+		{
+			name:        "fail - pause a token that is already paused",
+			creator:     owner,
+			caller:      owner,
+			methods:     []string{erc20.PauseMethod, erc20.PauseMethod},
+			errContains: erc20types.ErrTokenPairPaused.Error(),
+			expPaused:   true,
+		},
+		{
+			name:      "pass - pause",
+			creator:   owner,
+			caller:    owner,
+			methods:   []string{erc20.PauseMethod},
+			expPass:   true,
+			expPaused: true,
+		},
+		{
+			name:    "pass - pause and unpause",
+			creator: owner,
+			caller:  owner,
+			methods: []string{erc20.PauseMethod, erc20.UnpauseMethod},
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			precompile := s.setupOwnedERC20Precompile(s.tokenDenom, tc.creator)
+			stateDB := s.network.GetStateDB()
+
+			var err error
+			for _, methodName := range tc.methods {
+				contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), tc.caller, precompile, 200_000)
+				if methodName == erc20.PauseMethod {
+					_, err = precompile.Pause(ctx, contract, stateDB, &pauseMethod, []interface{}{})
+				} else {
+					_, err = precompile.Unpause(ctx, contract, stateDB, &unpauseMethod, []interface{}{})
+				}
+				if err != nil {
+					break
+				}
+			}
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error")
+			} else {
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+
+			s.Require().Equal(tc.expPaused, precompile.IsPaused(), "expected different pause status")
+			ctx := s.network.GetContext()
+			id := s.network.App.Erc20Keeper.GetTokenPairID(ctx, precompile.Address().Hex())
+			pair, found := s.network.App.Erc20Keeper.GetTokenPair(ctx, id)
+			s.Require().True(found, "expected token pair to be registered")
+			s.Require().Equal(tc.expPaused, pair.Paused, "expected different stored pause status")
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestPaused() {
+	method := s.precompile.Methods[erc20.PausedMethod]
+	pauseMethod := s.precompile.Methods[erc20.PauseMethod]
+	owner := s.keyring.GetAddr(0)
+
+	s.SetupTest()
+	precompile := s.setupOwnedERC20Precompile(s.tokenDenom, owner)
+
+	bz, err := precompile.Paused(s.network.GetContext(), nil, nil, &method, []interface{}{})
+	s.requireOut(bz, err, method, true, "", false)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), owner, precompile, 200_000)
+	_, err = precompile.Pause(ctx, contract, s.network.GetStateDB(), &pauseMethod, []interface{}{})
+	s.Require().NoError(err, "expected no error pausing the token")
+
+	bz, err = precompile.Paused(s.network.GetContext(), nil, nil, &method, []interface{}{})
+	s.requireOut(bz, err, method, true, "", true)
+}
+
+func (s *PrecompileTestSuite) TestTransferWhilePaused() {
+	method := s.precompile.Methods[erc20.TransferMethod]
+	pauseMethod := s.precompile.Methods[erc20.PauseMethod]
+	owner := s.keyring.GetAddr(0)
+
+	s.SetupTest()
+	precompile := s.setupOwnedERC20Precompile(s.bondDenom, owner)
+	stateDB := s.network.GetStateDB()
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), owner, precompile, 200_000)
+	_, err := precompile.Pause(ctx, contract, stateDB, &pauseMethod, []interface{}{})
+	s.Require().NoError(err, "expected no error pausing the token")
+
+	contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), owner, precompile, 200_000)
+	_, err = precompile.Transfer(ctx, contract, stateDB, &method, []interface{}{s.keyring.GetAddr(1), big.NewInt(100)})
+	s.Require().ErrorContains(err, erc20.ErrTokenPaused.Error(), "expected transfer to fail while paused")
+}
@@ -0,0 +1,205 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// PermitMethod defines the ABI method name for the EIP-2612 Permit
+	// transaction.
+	PermitMethod = "permit"
+	// NoncesMethod defines the ABI method name for the EIP-2612 Nonces
+	// query.
+	NoncesMethod = "nonces"
+	// DomainSeparatorMethod defines the ABI method name for the EIP-2612
+	// DomainSeparator query.
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
+
+	// PermitVersion is the version of the EIP-712 signing domain of the permits.
+	PermitVersion = "1"
+)
+
+var (
+	// eip712DomainTypeHash is the EIP-712 type hash of the signing domain.
+	eip712DomainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	// permitTypeHash is the EIP-712 type hash of the permit message.
+	permitTypeHash = crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+	// noncesSlotPrefix is the prefix of the storage slots of the precompile that
+	// hold the permit nonces of each owner.
+	noncesSlotPrefix = []byte("erc20.permit.nonces")
+)
+
+// Permit sets the given value as the allowance of the spender over the owner's
+// tokens, given the owner's EIP-712 signature of the permit. This allows the
+// owner to approve a spender without sending a transaction (EIP-2612).
+//
+// The permit nonce of the owner is kept in the EVM storage of the precompile
+// so that it is reverted together with the allowance if the call fails.
+func (p Precompile) Permit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, spender, value, deadline, v, r, s, err := ParsePermitArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline.Cmp(big.NewInt(ctx.BlockTime().Unix())) < 0 {
+		return nil, ErrPermitExpired
+	}
+
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.getNonce(stateDB, owner)
+	digest := permitDigest(domainSeparator, owner, spender, value, nonce, deadline)
+
+	signer, err := recoverSigner(digest, v, r, s)
+	if err != nil || signer != owner {
+		return nil, ErrInvalidPermitSignature
+	}
+
+	p.setNonce(stateDB, owner, new(big.Int).Add(nonce, common.Big1))
+
+	if err := p.approve(ctx, owner, spender, value); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Nonces returns the current permit nonce of the given owner. The nonce must
+// be included in the owner's signature of the next permit.
+func (p Precompile) Nonces(
+	_ sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseNoncesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(p.getNonce(stateDB, owner))
+}
+
+// DomainSeparator returns the EIP-712 domain separator used to sign the
+// permits of the token.
+func (p Precompile) DomainSeparator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(domainSeparator)
+}
+
+// domainSeparator computes the EIP-712 domain separator from the token name,
+// the permit version, the EVM chain ID and the precompile address.
+func (p Precompile) domainSeparator(ctx sdk.Context) (common.Hash, error) {
+	name, err := p.tokenName(ctx)
+	if err != nil {
+		return common.Hash{}, ConvertErrToERC20Error(err)
+	}
+
+	chainID := evmtypes.GetEthChainConfig().ChainID
+	if chainID == nil {
+		return common.Hash{}, fmt.Errorf("EVM chain ID is not set")
+	}
+
+	return crypto.Keccak256Hash(
+		eip712DomainTypeHash.Bytes(),
+		crypto.Keccak256([]byte(name)),
+		crypto.Keccak256([]byte(PermitVersion)),
+		math.U256Bytes(new(big.Int).Set(chainID)),
+		common.LeftPadBytes(p.Address().Bytes(), 32),
+	), nil
+}
+
+// permitDigest returns the EIP-712 digest of the permit that has to be signed
+// by the owner.
+func permitDigest(
+	domainSeparator common.Hash,
+	owner, spender common.Address,
+	value, nonce, deadline *big.Int,
+) common.Hash {
+	structHash := crypto.Keccak256(
+		permitTypeHash.Bytes(),
+		common.LeftPadBytes(owner.Bytes(), 32),
+		common.LeftPadBytes(spender.Bytes(), 32),
+		math.U256Bytes(new(big.Int).Set(value)),
+		math.U256Bytes(new(big.Int).Set(nonce)),
+		math.U256Bytes(new(big.Int).Set(deadline)),
+	)
+
+	return crypto.Keccak256Hash([]byte("\x19\x01"), domainSeparator.Bytes(), structHash)
+}
+
+// recoverSigner returns the address that signed the digest with the given
+// signature values. It accepts recovery IDs of 0/1 and 27/28.
+func recoverSigner(digest common.Hash, v uint8, r, s [32]byte) (common.Address, error) {
+	if v >= 27 {
+		v -= 27
+	}
+
+	if !crypto.ValidateSignatureValues(v, new(big.Int).SetBytes(r[:]), new(big.Int).SetBytes(s[:]), true) {
+		return common.Address{}, ErrInvalidPermitSignature
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[:32], r[:])
+	copy(sig[32:64], s[:])
+	sig[64] = v
+
+	pubKey, err := crypto.SigToPub(digest.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// noncesSlot returns the storage slot of the precompile that holds the permit
+// nonce of the given owner.
+func noncesSlot(owner common.Address) common.Hash {
+	return crypto.Keccak256Hash(noncesSlotPrefix, owner.Bytes())
+}
+
+// getNonce returns the permit nonce of the owner.
+func (p Precompile) getNonce(stateDB vm.StateDB, owner common.Address) *big.Int {
+	return stateDB.GetState(p.Address(), noncesSlot(owner)).Big()
+}
+
+// setNonce sets the permit nonce of the owner.
+func (p Precompile) setNonce(stateDB vm.StateDB, owner common.Address, nonce *big.Int) {
+	stateDB.SetState(p.Address(), noncesSlot(owner), common.BigToHash(nonce))
+}
@@ -0,0 +1,210 @@
+package erc20_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/evmos/v20/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v20/precompiles/erc20"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// permitTypedData returns the EIP-712 typed data of a permit for the ERC-20 precompile
+// under test.
+func (s *PrecompileTestSuite) permitTypedData(owner, spender common.Address, value, nonce, deadline *big.Int) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Permit": {
+				{Name: "owner", Type: "address"},
+				{Name: "spender", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Permit",
+		Domain: apitypes.TypedDataDomain{
+			Name:              validMetadataName,
+			Version:           erc20.PermitVersion,
+			ChainId:           (*math.HexOrDecimal256)(evmtypes.GetEthChainConfig().ChainID),
+			VerifyingContract: s.precompile.Address().Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"owner":    owner.Hex(),
+			"spender":  spender.Hex(),
+			"value":    value.String(),
+			"nonce":    nonce.String(),
+			"deadline": deadline.String(),
+		},
+	}
+}
+
+// signPermit signs a permit with the private key of the keyring account at the given index
+// and returns the permit method arguments.
+func (s *PrecompileTestSuite) signPermit(
+	signerIdx int, owner, spender common.Address, value, nonce, deadline *big.Int,
+) []interface{} {
+	digest, _, err := apitypes.TypedDataAndHash(s.permitTypedData(owner, spender, value, nonce, deadline))
+	s.Require().NoError(err, "expected no error hashing the permit")
+
+	privKey, ok := s.keyring.GetPrivKey(signerIdx).(*ethsecp256k1.PrivKey)
+	s.Require().True(ok, "expected eth_secp256k1 private key")
+	key, err := privKey.ToECDSA()
+	s.Require().NoError(err, "expected no error converting the private key")
+
+	sig, err := crypto.Sign(digest, key)
+	s.Require().NoError(err, "expected no error signing the permit")
+
+	var r, sv [32]byte
+	copy(r[:], sig[:32])
+	copy(sv[:], sig[32:64])
+
+	return []interface{}{owner, spender, value, deadline, sig[64] + 27, r, sv}
+}
+
+func (s *PrecompileTestSuite) TestPermit() {
+	method := s.precompile.Methods[erc20.PermitMethod]
+	noncesMethod := s.precompile.Methods[erc20.NoncesMethod]
+
+	value := big.NewInt(100)
+
+	testcases := []struct {
+		name        string
+		malleate    func(deadline *big.Int) [][]interface{}
+		expPass     bool
+		errContains string
+		expNonce    int64
+	}{
+		{
+			name: "fail - invalid number of arguments",
+			malleate: func(_ *big.Int) [][]interface{} {
+				return [][]interface{}{{1}}
+			},
+			errContains: "invalid number of arguments; expected 7; got: 1",
+		},
+		{
+			name: "fail - expired deadline",
+			malleate: func(deadline *big.Int) [][]interface{} {
+				expired := new(big.Int).Sub(deadline, big.NewInt(7200))
+				return [][]interface{}{
+					s.signPermit(0, s.keyring.GetAddr(0), s.keyring.GetAddr(1), value, common.Big0, expired),
+				}
+			},
+			errContains: erc20.ErrPermitExpired.Error(),
+		},
+		{
+			name: "fail - signed by another account",
+			malleate: func(deadline *big.Int) [][]interface{} {
+				return [][]interface{}{
+					s.signPermit(1, s.keyring.GetAddr(0), s.keyring.GetAddr(1), value, common.Big0, deadline),
+				}
+			},
+			errContains: erc20.ErrInvalidPermitSignature.Error(),
+		},
+		{
+			name: "fail - wrong nonce",
+			malleate: func(deadline *big.Int) [][]interface{} {
+				return [][]interface{}{
+					s.signPermit(0, s.keyring.GetAddr(0), s.keyring.GetAddr(1), value, common.Big1, deadline),
+				}
+			},
+			errContains: erc20.ErrInvalidPermitSignature.Error(),
+		},
+		{
+			name: "fail - replayed permit",
+			malleate: func(deadline *big.Int) [][]interface{} {
+				args := s.signPermit(0, s.keyring.GetAddr(0), s.keyring.GetAddr(1), value, common.Big0, deadline)
+				return [][]interface{}{args, args}
+			},
+			errContains: erc20.ErrInvalidPermitSignature.Error(),
+			expNonce:    1,
+		},
+		{
+			name: "pass - valid permit",
+			malleate: func(deadline *big.Int) [][]interface{} {
+				return [][]interface{}{
+					s.signPermit(0, s.keyring.GetAddr(0), s.keyring.GetAddr(1), value, common.Big0, deadline),
+				}
+			},
+			expPass:  true,
+			expNonce: 1,
+		},
+		{
+			name: "pass - consecutive permits",
+			malleate: func(deadline *big.Int) [][]interface{} {
+				return [][]interface{}{
+					s.signPermit(0, s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(1), common.Big0, deadline),
+					s.signPermit(0, s.keyring.GetAddr(0), s.keyring.GetAddr(1), value, common.Big1, deadline),
+				}
+			},
+			expPass:  true,
+			expNonce: 2,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.network.App.BankKeeper.SetDenomMetaData(s.network.GetContext(), validMetadata)
+			s.precompile = s.setupERC20Precompile(validMetadataDenom)
+
+			ctx := s.network.GetContext()
+			stateDB := s.network.GetStateDB()
+			deadline := big.NewInt(ctx.BlockTime().Unix() + 3600)
+
+			var err error
+			for _, args := range tc.malleate(deadline) {
+				// NOTE: any account can submit the permit on behalf of the owner
+				contract, callCtx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(1), s.precompile, 200_000)
+				if _, err = s.precompile.Permit(callCtx, contract, stateDB, &method, args); err != nil {
+					break
+				}
+			}
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error")
+				s.requireAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), value)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+
+			bz, err := s.precompile.Nonces(ctx, nil, stateDB, &noncesMethod, []interface{}{s.keyring.GetAddr(0)})
+			s.Require().NoError(err, "expected no error querying the nonce")
+			out, err := noncesMethod.Outputs.Unpack(bz)
+			s.Require().NoError(err, "expected no error unpacking")
+			nonce, ok := out[0].(*big.Int)
+			s.Require().True(ok, "expected output to be a big.Int")
+			s.Require().Equal(tc.expNonce, nonce.Int64(), "expected different nonce")
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDomainSeparator() {
+	s.SetupTest()
+	s.network.App.BankKeeper.SetDenomMetaData(s.network.GetContext(), validMetadata)
+	s.precompile = s.setupERC20Precompile(validMetadataDenom)
+
+	method := s.precompile.Methods[erc20.DomainSeparatorMethod]
+	bz, err := s.precompile.DomainSeparator(s.network.GetContext(), nil, nil, &method, []interface{}{})
+	s.Require().NoError(err, "expected no error")
+
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err, "expected no error unpacking")
+	domainSeparator, ok := out[0].([32]byte)
+	s.Require().True(ok, "expected output to be a bytes32")
+
+	typedData := s.permitTypedData(common.Address{}, common.Address{}, common.Big0, common.Big0, common.Big0)
+	expDomainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	s.Require().NoError(err, "expected no error hashing the domain")
+	s.Require().Equal(expDomainSeparator, domainSeparator[:])
+}
@@ -9,14 +9,9 @@ import (
 	"math"
 	"math/big"
 	"strings"
-	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/authz"
-	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/evmos/evmos/v20/ibc"
-	auth "github.com/evmos/evmos/v20/precompiles/authorization"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -39,6 +34,24 @@ const (
 	// BalanceOfMethod defines the ABI method name for the ERC-20 BalanceOf
 	// query.
 	BalanceOfMethod = "balanceOf"
+	// MaxSpendableMethod defines the ABI method name for the MaxSpendable
+	// query.
+	MaxSpendableMethod = "maxSpendable"
+	// GroupSupplyMethod defines the ABI method name for the GroupSupply
+	// query.
+	GroupSupplyMethod = "groupSupply"
+	// IBCTransferableMethod defines the ABI method name for the IBCTransferable
+	// query.
+	IBCTransferableMethod = "ibcTransferable"
+	// CreatorMethod defines the ABI method name for the Creator
+	// query.
+	CreatorMethod = "creator"
+	// AllowanceTimeRemainingMethod defines the ABI method name for the
+	// AllowanceTimeRemaining query.
+	AllowanceTimeRemainingMethod = "allowanceTimeRemaining"
+	// AllowancesOfMethod defines the ABI method name for the AllowancesOf
+	// query.
+	AllowancesOfMethod = "allowancesOf"
 )
 
 // Name returns the name of the token. If the token metadata is registered in the
@@ -51,18 +64,28 @@ func (p Precompile) Name(
 	method *abi.Method,
 	_ []interface{},
 ) ([]byte, error) {
+	name, err := p.tokenName(ctx)
+	if err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	return method.Outputs.Pack(name)
+}
+
+// tokenName returns the name of the token from the bank metadata or, if not
+// registered, from the base denomination of the IBC voucher.
+func (p Precompile) tokenName(ctx sdk.Context) (string, error) {
 	metadata, found := p.BankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
 	if found {
-		return method.Outputs.Pack(metadata.Name)
+		return metadata.Name, nil
 	}
 
 	baseDenom, err := p.getBaseDenomFromIBCVoucher(ctx, p.tokenPair.Denom)
 	if err != nil {
-		return nil, ConvertErrToERC20Error(err)
+		return "", err
 	}
 
-	name := strings.ToUpper(string(baseDenom[1])) + baseDenom[2:]
-	return method.Outputs.Pack(name)
+	return strings.ToUpper(string(baseDenom[1])) + baseDenom[2:], nil
 }
 
 // Symbol returns the symbol of the token. If the token metadata is registered in the
@@ -157,6 +180,58 @@ func (p Precompile) TotalSupply(
 	return method.Outputs.Pack(supply.Amount.BigInt())
 }
 
+// GroupSupply returns the aggregate supply of all the denominations in the
+// group of related representations that the token belongs to (e.g. native, IBC
+// and wrapped). If the token is not part of a group, it returns the total supply
+// of the token.
+func (p Precompile) GroupSupply(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	if len(p.denomGroup) == 0 {
+		supply := p.BankKeeper.GetSupply(ctx, p.tokenPair.Denom)
+		return method.Outputs.Pack(supply.Amount.BigInt())
+	}
+
+	groupSupply := new(big.Int)
+	for _, denom := range p.denomGroup {
+		supply := p.BankKeeper.GetSupply(ctx, denom)
+		groupSupply.Add(groupSupply, supply.Amount.BigInt())
+	}
+
+	return method.Outputs.Pack(groupSupply)
+}
+
+// IBCTransferable returns true if the token can be sent over IBC through an
+// ICS-20 transfer. This is the case when transfers are enabled in the IBC
+// transfer module, sending the denomination is not blocked in the bank module
+// and, for IBC vouchers, the denomination trace is registered and valid.
+func (p Precompile) IBCTransferable(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	return method.Outputs.Pack(p.isIBCTransferable(ctx, p.tokenPair.Denom))
+}
+
+// Creator returns the address of the account that registered the token pair.
+// It returns the zero address for token pairs that were registered before the
+// creator was recorded.
+func (p Precompile) Creator(
+	_ sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	return method.Outputs.Pack(p.creator)
+}
+
 // BalanceOf returns the amount of tokens owned by account. It fetches the balance
 // of the coin from the bank keeper and returns zero if not found.
 func (p Precompile) BalanceOf(
@@ -176,8 +251,8 @@ func (p Precompile) BalanceOf(
 	return method.Outputs.Pack(balance.Amount.BigInt())
 }
 
-// Allowance returns the remaining allowance of a spender to the contract by
-// checking the existence of a bank SendAuthorization.
+// Allowance returns the remaining allowance of a spender over the owner's
+// tokens, which is zero if there is none.
 func (p Precompile) Allowance(
 	ctx sdk.Context,
 	_ *vm.Contract,
@@ -196,38 +271,91 @@ func (p Precompile) Allowance(
 		return method.Outputs.Pack(abi.MaxUint256)
 	}
 
-	_, _, allowance, err := GetAuthzExpirationAndAllowance(p.AuthzKeeper, ctx, spender, owner, p.tokenPair.Denom)
+	return method.Outputs.Pack(p.getAllowance(ctx, owner, spender))
+}
+
+// AllowanceTimeRemaining returns the number of seconds until the allowance of
+// the spender to the contract expires. The allowances never expire, so it
+// returns the max uint256 value if there is an allowance and zero otherwise.
+func (p Precompile) AllowanceTimeRemaining(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, spender, err := ParseAllowanceArgs(args)
 	if err != nil {
-		// NOTE: We are not returning the error here, because we want to align the behavior with
-		// standard ERC20 smart contracts, which return zero if an allowance is not found.
-		allowance = common.Big0
+		return nil, err
+	}
+
+	// NOTE: In case the allowance is queried by the owner, the allowance is
+	// infinite and never expires, aligned with the allowance query.
+	if bytes.Equal(owner.Bytes(), spender.Bytes()) {
+		return method.Outputs.Pack(abi.MaxUint256)
+	}
+
+	if p.getAllowance(ctx, owner, spender).Sign() == 0 {
+		return method.Outputs.Pack(common.Big0)
 	}
 
-	return method.Outputs.Pack(allowance)
+	return method.Outputs.Pack(abi.MaxUint256)
 }
 
-// GetAuthzExpirationAndAllowance returns the authorization, its expiration as well as the amount of denom
-// that the grantee is allowed to spend on behalf of the granter.
-func GetAuthzExpirationAndAllowance(
-	authzKeeper authzkeeper.Keeper,
+// AllowancesOf returns all the spenders that have an allowance over the
+// owner's tokens together with the allowed amounts, ordered by spender
+// address.
+func (p Precompile) AllowancesOf(
 	ctx sdk.Context,
-	grantee, granter common.Address,
-	denom string,
-) (authz.Authorization, *time.Time, *big.Int, error) {
-	authorization, expiration, err := auth.CheckAuthzExists(ctx, authzKeeper, grantee, granter, SendMsgURL)
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseAllowancesOfArgs(args)
 	if err != nil {
-		return nil, nil, common.Big0, err
+		return nil, err
 	}
 
-	sendAuth, ok := authorization.(*banktypes.SendAuthorization)
-	if !ok {
-		return nil, nil, common.Big0, fmt.Errorf(
-			"expected authorization to be a %T", banktypes.SendAuthorization{},
-		)
+	allowances := make([]Allowance, 0)
+	for _, allowance := range p.allowanceKeeper.GetAllowances(ctx, p.Address(), owner) {
+		allowances = append(allowances, Allowance{
+			Spender: common.HexToAddress(allowance.Spender),
+			Amount:  allowance.Value.BigInt(),
+		})
 	}
 
-	allowance := sendAuth.SpendLimit.AmountOfNoDenomValidation(denom)
-	return authorization, expiration, allowance.BigInt(), nil
+	return method.Outputs.Pack(allowances)
+}
+
+// MaxSpendable returns the maximum amount of tokens that the spender can
+// currently transfer on behalf of the owner, which is the minimum between the
+// owner's balance and the spender's allowance. If the owner is the spender, the
+// allowance is unbounded and the owner's balance is returned.
+func (p Precompile) MaxSpendable(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, spender, err := ParseAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := p.BankKeeper.GetBalance(ctx, owner.Bytes(), p.tokenPair.Denom).Amount.BigInt()
+
+	if bytes.Equal(owner.Bytes(), spender.Bytes()) {
+		return method.Outputs.Pack(balance)
+	}
+
+	allowance := p.getAllowance(ctx, owner, spender)
+	if allowance.Cmp(balance) < 0 {
+		return method.Outputs.Pack(allowance)
+	}
+
+	return method.Outputs.Pack(balance)
 }
 
 // getBaseDenomFromIBCVoucher returns the base denomination from the given IBC voucher denomination.
@@ -247,3 +375,25 @@ func (p Precompile) getBaseDenomFromIBCVoucher(ctx sdk.Context, denom string) (s
 
 	return denomTrace.BaseDenom, nil
 }
+
+// isIBCTransferable checks if the given denomination is eligible for an ICS-20 transfer.
+func (p Precompile) isIBCTransferable(ctx sdk.Context, denom string) bool {
+	if !p.transferKeeper.GetParams(ctx).SendEnabled {
+		return false
+	}
+
+	if !p.BankKeeper.IsSendEnabledDenom(ctx, denom) {
+		return false
+	}
+
+	if !strings.HasPrefix(denom, "ibc/") {
+		return sdk.ValidateDenom(denom) == nil
+	}
+
+	denomTrace, err := ibc.GetDenomTrace(p.transferKeeper, ctx, denom)
+	if err != nil {
+		return false
+	}
+
+	return denomTrace.Validate() == nil
+}
@@ -4,18 +4,22 @@
 package erc20_test
 
 import (
+	"bytes"
 	"math"
 	"math/big"
+	"sort"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/app"
 	auth "github.com/evmos/evmos/v20/precompiles/authorization"
 	"github.com/evmos/evmos/v20/precompiles/erc20"
 	"github.com/evmos/evmos/v20/testutil"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	inflationtypes "github.com/evmos/evmos/v20/x/inflation/v1/types"
 )
@@ -524,7 +528,7 @@ func (s *PrecompileTestSuite) TestAllowance() {
 			expAllow: common.Big0,
 		},
 		{
-			name: "pass - allowance exists but not for precompile token pair denom",
+			name: "pass - authz grant for precompile token pair denom is not an allowance",
 			malleate: func(_ sdk.Context, _ *app.Evmos, _ *big.Int) []interface{} {
 				granterIdx := 0
 				granteeIdx := 1
@@ -532,7 +536,7 @@ func (s *PrecompileTestSuite) TestAllowance() {
 				s.setupSendAuthz(
 					s.keyring.GetAccAddr(granteeIdx),
 					s.keyring.GetPrivKey(granterIdx),
-					sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 100)),
+					sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, 100)),
 				)
 
 				return []interface{}{s.keyring.GetAddr(granterIdx), s.keyring.GetAddr(granteeIdx)}
@@ -541,18 +545,22 @@ func (s *PrecompileTestSuite) TestAllowance() {
 			expAllow: common.Big0,
 		},
 		{
-			name: "pass - allowance exists for precompile token pair denom",
-			malleate: func(_ sdk.Context, _ *app.Evmos, amount *big.Int) []interface{} {
-				granterIdx := 0
-				granteeIdx := 1
+			name: "pass - allowance exists for other token",
+			malleate: func(ctx sdk.Context, evmosApp *app.Evmos, amount *big.Int) []interface{} {
+				err := evmosApp.Erc20Keeper.SetAllowance(ctx, utiltx.GenerateAddress(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), amount)
+				s.Require().NoError(err, "failed to set up allowance")
 
-				s.setupSendAuthz(
-					s.keyring.GetAccAddr(granteeIdx),
-					s.keyring.GetPrivKey(granterIdx),
-					sdk.NewCoins(sdk.NewCoin(s.tokenDenom, sdkmath.NewIntFromBigInt(amount))),
-				)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			expPass:  true,
+			expAllow: common.Big0,
+		},
+		{
+			name: "pass - allowance exists for precompile token",
+			malleate: func(_ sdk.Context, _ *app.Evmos, _ *big.Int) []interface{} {
+				s.setupAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(100))
 
-				return []interface{}{s.keyring.GetAddr(granterIdx), s.keyring.GetAddr(granteeIdx)}
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
 			},
 			expPass:  true,
 			expAllow: big.NewInt(100),
@@ -565,12 +573,10 @@ func (s *PrecompileTestSuite) TestAllowance() {
 
 			var allowanceArgs []interface{}
 			if tc.malleate != nil {
-				allowanceArgs = tc.malleate(s.network.GetContext(), s.network.App, tc.expAllow)
+				allowanceArgs = tc.malleate(s.network.GetContext(), s.network.App, big.NewInt(100))
 			}
 
-			precompile := s.setupERC20Precompile(s.tokenDenom)
-
-			bz, err := precompile.Allowance(
+			bz, err := s.precompile.Allowance(
 				s.network.GetContext(),
 				nil,
 				nil,
@@ -583,3 +589,442 @@ func (s *PrecompileTestSuite) TestAllowance() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestMaxSpendable() {
+	method := s.precompile.Methods[erc20.MaxSpendableMethod]
+
+	// fundOwner funds the owner account with the given amount of the token denomination
+	// that was used for the precompile.
+	fundOwner := func(amount int64) {
+		err := testutil.FundAccount(
+			s.network.GetContext(), s.network.App.BankKeeper, s.keyring.GetAccAddr(0), sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, amount)),
+		)
+		s.Require().NoError(err, "expected no error funding account")
+	}
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		expAmount   *big.Int
+	}{
+		{
+			name: "fail - invalid number of arguments",
+			malleate: func() []interface{} {
+				return []interface{}{1}
+			},
+			errContains: "invalid number of arguments; expected 2; got: 1",
+		},
+		{
+			name: "fail - invalid owner address",
+			malleate: func() []interface{} {
+				return []interface{}{"invalid address", s.keyring.GetAddr(1)}
+			},
+			errContains: "invalid owner address: invalid address",
+		},
+		{
+			name: "pass - no allowance should return 0",
+			malleate: func() []interface{} {
+				fundOwner(100)
+
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			expPass:   true,
+			expAmount: common.Big0,
+		},
+		{
+			name: "pass - allowance-limited",
+			malleate: func() []interface{} {
+				fundOwner(100)
+				s.setupAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(40))
+
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			expPass:   true,
+			expAmount: big.NewInt(40),
+		},
+		{
+			name: "pass - balance-limited",
+			malleate: func() []interface{} {
+				fundOwner(30)
+				s.setupAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(100))
+
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			expPass:   true,
+			expAmount: big.NewInt(30),
+		},
+		{
+			name: "pass - owner is spender returns the balance",
+			malleate: func() []interface{} {
+				fundOwner(75)
+
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(0)}
+			},
+			expPass:   true,
+			expAmount: big.NewInt(75),
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+
+			bz, err := s.precompile.MaxSpendable(
+				s.network.GetContext(),
+				nil,
+				nil,
+				&method,
+				args,
+			)
+
+			// NOTE: all output and error checking happens in here
+			s.requireOut(bz, err, method, tc.expPass, tc.errContains, tc.expAmount)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGroupSupply() {
+	method := s.precompile.Methods[erc20.GroupSupplyMethod]
+
+	testcases := []struct {
+		name       string
+		denomGroup []string
+		expTotal   *big.Int
+	}{
+		{
+			name:     "pass - token not in a group returns its total supply",
+			expTotal: big.NewInt(100),
+		},
+		{
+			name:       "pass - token in a group returns the aggregate supply",
+			denomGroup: []string{s.tokenDenom, "wxmpl", "ibcxmpl"},
+			expTotal:   big.NewInt(100 + 200 + 300),
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			coins := sdk.NewCoins(
+				sdk.NewInt64Coin(s.tokenDenom, 100),
+				sdk.NewInt64Coin("wxmpl", 200),
+				sdk.NewInt64Coin("ibcxmpl", 300),
+			)
+			err := s.network.App.BankKeeper.MintCoins(s.network.GetContext(), inflationtypes.ModuleName, coins)
+			s.Require().NoError(err)
+
+			s.precompile.SetDenomGroup(tc.denomGroup)
+
+			bz, err := s.precompile.GroupSupply(
+				s.network.GetContext(),
+				nil,
+				nil,
+				&method,
+				[]interface{}{},
+			)
+
+			// NOTE: all output and error checking happens in here
+			s.requireOut(bz, err, method, true, "", tc.expTotal)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestIBCTransferable() {
+	method := s.precompile.Methods[erc20.IBCTransferableMethod]
+
+	testcases := []struct {
+		name            string
+		denom           string
+		malleate        func(sdk.Context, *app.Evmos)
+		expTransferable bool
+	}{
+		{
+			name:  "pass - transferable IBC denom",
+			denom: validTraceDenom.IBCDenom(),
+			malleate: func(ctx sdk.Context, app *app.Evmos) {
+				app.TransferKeeper.SetDenomTrace(ctx, validTraceDenom)
+			},
+			expTransferable: true,
+		},
+		{
+			name:            "pass - transferable native denom",
+			denom:           validMetadataDenom,
+			expTransferable: true,
+		},
+		{
+			name:  "pass - blocked denom is not transferable",
+			denom: validMetadataDenom,
+			malleate: func(ctx sdk.Context, app *app.Evmos) {
+				app.BankKeeper.SetSendEnabled(ctx, validMetadataDenom, false)
+			},
+			expTransferable: false,
+		},
+		{
+			name:            "pass - IBC denom without denom trace is not transferable",
+			denom:           types.DenomTrace{Path: "channel-0", BaseDenom: "notfound"}.IBCDenom(),
+			expTransferable: false,
+		},
+		{
+			name:  "pass - denom is not transferable when IBC transfers are disabled",
+			denom: validMetadataDenom,
+			malleate: func(ctx sdk.Context, app *app.Evmos) {
+				params := app.TransferKeeper.GetParams(ctx)
+				params.SendEnabled = false
+				app.TransferKeeper.SetParams(ctx, params)
+			},
+			expTransferable: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			if tc.malleate != nil {
+				tc.malleate(s.network.GetContext(), s.network.App)
+			}
+
+			precompile := s.setupERC20Precompile(tc.denom)
+
+			bz, err := precompile.IBCTransferable(
+				s.network.GetContext(),
+				nil,
+				nil,
+				&method,
+				[]interface{}{},
+			)
+
+			// NOTE: all output and error checking happens in here
+			s.requireOut(bz, err, method, true, "", tc.expTransferable)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCreator() {
+	method := s.precompile.Methods[erc20.CreatorMethod]
+	creator := s.keyring.GetAddr(0)
+
+	testcases := []struct {
+		name       string
+		malleate   func(*erc20.Precompile)
+		expCreator common.Address
+	}{
+		{
+			name:       "pass - zero address if creator was not recorded",
+			expCreator: common.Address{},
+		},
+		{
+			name: "pass - creator recorded",
+			malleate: func(precompile *erc20.Precompile) {
+				precompile.SetCreator(creator)
+			},
+			expCreator: creator,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			precompile := s.setupERC20Precompile(s.tokenDenom)
+			if tc.malleate != nil {
+				tc.malleate(precompile)
+			}
+
+			bz, err := precompile.Creator(
+				s.network.GetContext(),
+				nil,
+				nil,
+				&method,
+				[]interface{}{},
+			)
+
+			// NOTE: all output and error checking happens in here
+			s.requireOut(bz, err, method, true, "", tc.expCreator)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowanceTimeRemaining() {
+	method := s.precompile.Methods[erc20.AllowanceTimeRemainingMethod]
+
+	testcases := []struct {
+		name         string
+		malleate     func() []interface{}
+		expPass      bool
+		errContains  string
+		expRemaining *big.Int
+	}{
+		{
+			name: "fail - invalid number of arguments",
+			malleate: func() []interface{} {
+				return []interface{}{1}
+			},
+			errContains: "invalid number of arguments; expected 2; got: 1",
+		},
+		{
+			name: "pass - no allowance",
+			malleate: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			expPass:      true,
+			expRemaining: common.Big0,
+		},
+		{
+			name: "pass - allowance never expires",
+			malleate: func() []interface{} {
+				s.setupAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(100))
+
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			expPass:      true,
+			expRemaining: abi.MaxUint256,
+		},
+		{
+			name: "pass - owner is spender",
+			malleate: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(0)}
+			},
+			expPass:      true,
+			expRemaining: abi.MaxUint256,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+
+			bz, err := s.precompile.AllowanceTimeRemaining(
+				s.network.GetContext(),
+				nil,
+				nil,
+				&method,
+				args,
+			)
+
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err, "expected no error unpacking")
+			remaining, ok := out[0].(*big.Int)
+			s.Require().True(ok, "expected output to be a big.Int")
+			s.Require().Equal(tc.expRemaining.String(), remaining.String())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowancesOf() {
+	method := s.precompile.Methods[erc20.AllowancesOfMethod]
+
+	testcases := []struct {
+		name          string
+		malleate      func() []interface{}
+		expPass       bool
+		errContains   string
+		expAllowances func() []erc20.Allowance
+	}{
+		{
+			name: "fail - invalid number of arguments",
+			malleate: func() []interface{} {
+				return []interface{}{}
+			},
+			errContains: "invalid number of arguments; expected 1; got: 0",
+		},
+		{
+			name: "fail - invalid owner address",
+			malleate: func() []interface{} {
+				return []interface{}{"invalid address"}
+			},
+			errContains: "invalid owner address",
+		},
+		{
+			name: "pass - no allowances",
+			malleate: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			expPass:       true,
+			expAllowances: func() []erc20.Allowance { return []erc20.Allowance{} },
+		},
+		{
+			name: "pass - multiple spenders",
+			malleate: func() []interface{} {
+				s.setupAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(100))
+				s.setupAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(2), big.NewInt(200))
+
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			expPass: true,
+			expAllowances: func() []erc20.Allowance {
+				allowances := []erc20.Allowance{
+					{Spender: s.keyring.GetAddr(1), Amount: big.NewInt(100)},
+					{Spender: s.keyring.GetAddr(2), Amount: big.NewInt(200)},
+				}
+				// NOTE: the allowances are returned in the order of the spender addresses
+				sort.Slice(allowances, func(i, j int) bool {
+					return bytes.Compare(allowances[i].Spender.Bytes(), allowances[j].Spender.Bytes()) < 0
+				})
+				return allowances
+			},
+		},
+		{
+			name: "pass - skip authz grants and allowances of other owners and tokens",
+			malleate: func() []interface{} {
+				s.setupSendAuthz(s.keyring.GetAccAddr(1), s.keyring.GetPrivKey(0), sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, 100)))
+				s.setupAllowance(s.keyring.GetAddr(1), s.keyring.GetAddr(2), big.NewInt(100))
+
+				err := s.network.App.Erc20Keeper.SetAllowance(
+					s.network.GetContext(), utiltx.GenerateAddress(), s.keyring.GetAddr(0), s.keyring.GetAddr(2), big.NewInt(200),
+				)
+				s.Require().NoError(err, "failed to set up allowance")
+
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			expPass:       true,
+			expAllowances: func() []erc20.Allowance { return []erc20.Allowance{} },
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.keyring.AddKey()
+
+			args := tc.malleate()
+
+			bz, err := s.precompile.AllowancesOf(
+				s.network.GetContext(),
+				nil,
+				nil,
+				&method,
+				args,
+			)
+
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			unpacked, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err, "expected no error unpacking")
+
+			var out struct {
+				Allowances []erc20.Allowance
+			}
+			err = method.Outputs.Copy(&out, unpacked)
+			s.Require().NoError(err, "expected no error copying the output")
+			s.Require().Equal(tc.expAllowances(), out.Allowances)
+		})
+	}
+}
@@ -5,10 +5,8 @@ package erc20
 import (
 	"math/big"
 
-	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/authz"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -25,11 +23,18 @@ const (
 	// TransferFromMethod defines the ABI method name for the ERC-20 transferFrom
 	// transaction.
 	TransferFromMethod = "transferFrom"
+	// TransferWithMemoMethod defines the ABI method name for the ERC-20
+	// transferWithMemo transaction.
+	TransferWithMemoMethod = "transferWithMemo"
+
+	// MaxMemoLength is the maximum length in bytes of the memo of a
+	// transferWithMemo transaction.
+	MaxMemoLength = 256
+	// AttributeKeyMemo is the key of the attribute that holds the memo in the
+	// bank transfer event of a transferWithMemo transaction.
+	AttributeKeyMemo = "memo"
 )
 
-// SendMsgURL defines the authorization type for MsgSend
-var SendMsgURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
-
 // Transfer executes a direct transfer from the caller address to the
 // destination address.
 func (p *Precompile) Transfer(
@@ -65,9 +70,48 @@ func (p *Precompile) TransferFrom(
 	return p.transfer(ctx, contract, stateDB, method, from, to, amount)
 }
 
-// transfer is a common function that handles transfers for the ERC-20 Transfer
-// and TransferFrom methods. It executes a bank Send message if the spender is
-// the sender of the transfer, otherwise it executes an authorization.
+// TransferWithMemo executes a direct transfer from the caller address to the
+// destination address and attaches the memo to the transfer event of the
+// underlying bank send. This allows the receiver (e.g. an exchange) to
+// attribute the deposit without a dedicated deposit address.
+func (p *Precompile) TransferWithMemo(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	from := contract.CallerAddress
+	to, amount, memo, err := ParseTransferWithMemoArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the bank send events are collected in a separate event manager so
+	// that the memo can be added to the transfer event before emitting them.
+	eventManager := ctx.EventManager()
+	sendCtx := ctx.WithEventManager(sdk.NewEventManager())
+
+	bz, err := p.transfer(sendCtx, contract, stateDB, method, from, to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	events := sendCtx.EventManager().Events()
+	for i, event := range events {
+		if event.Type == banktypes.EventTypeTransfer {
+			events[i] = event.AppendAttributes(sdk.NewAttribute(AttributeKeyMemo, memo))
+		}
+	}
+	eventManager.EmitEvents(events)
+
+	return bz, nil
+}
+
+// transfer is a common function that handles transfers for the ERC-20 Transfer,
+// TransferFrom and TransferWithMemo methods. It executes a bank Send message and,
+// if the spender is not the sender of the transfer, spends the allowance of the
+// spender. Unlimited allowances (max uint256) are not decreased.
 func (p *Precompile) transfer(
 	ctx sdk.Context,
 	contract *vm.Contract,
@@ -76,6 +120,24 @@ func (p *Precompile) transfer(
 	from, to common.Address,
 	amount *big.Int,
 ) (data []byte, err error) {
+	if p.tokenPair.Paused {
+		return nil, ErrTokenPaused
+	}
+
+	// NOTE: the spender of a transferFrom is checked too, so that blocked
+	// accounts cannot move the tokens of other accounts with their allowances.
+	for _, account := range []common.Address{from, to, contract.CallerAddress} {
+		if p.isBlocked(ctx, account) {
+			return nil, ErrAccountBlocked
+		}
+	}
+
+	if p.hooks != nil {
+		if err := p.hooks.BeforeTransfer(ctx, p.tokenPair, from, to, amount); err != nil {
+			return nil, err
+		}
+	}
+
 	coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: math.NewIntFromBigInt(amount)}}
 
 	msg := banktypes.NewMsgSend(from.Bytes(), to.Bytes(), coins)
@@ -85,25 +147,24 @@ func (p *Precompile) transfer(
 	}
 
 	isTransferFrom := method.Name == TransferFromMethod
-	owner := sdk.AccAddress(from.Bytes())
 	spenderAddr := contract.CallerAddress
-	spender := sdk.AccAddress(spenderAddr.Bytes()) // aka. grantee
-	ownerIsSpender := spender.Equals(owner)
+	ownerIsSpender := spenderAddr == from
 
-	var prevAllowance *big.Int
-	if ownerIsSpender {
-		msgSrv := bankkeeper.NewMsgServerImpl(p.BankKeeper)
-		_, err = msgSrv.Send(ctx, msg)
-	} else {
-		_, _, prevAllowance, err = GetAuthzExpirationAndAllowance(p.AuthzKeeper, ctx, spenderAddr, from, p.tokenPair.Denom)
-		if err != nil {
-			return nil, ConvertErrToERC20Error(errorsmod.Wrapf(authz.ErrNoAuthorizationFound, "%s", err.Error()))
+	if !ownerIsSpender {
+		prevAllowance := p.getAllowance(ctx, from, spenderAddr)
+		if prevAllowance.Cmp(amount) < 0 {
+			return nil, ErrInsufficientAllowance
 		}
 
-		_, err = p.AuthzKeeper.DispatchActions(ctx, spender, []sdk.Msg{msg})
+		if prevAllowance.Cmp(abi.MaxUint256) < 0 {
+			if err := p.setAllowance(ctx, from, spenderAddr, new(big.Int).Sub(prevAllowance, amount)); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	if err != nil {
+	msgSrv := bankkeeper.NewMsgServerImpl(p.BankKeeper)
+	if _, err = msgSrv.Send(ctx, msg); err != nil {
 		err = ConvertErrToERC20Error(err)
 		// This should return an error to avoid the contract from being executed and an event being emitted
 		return nil, err
@@ -111,11 +172,22 @@ func (p *Precompile) transfer(
 
 	if p.tokenPair.Denom == evmtypes.GetEVMCoinDenom() {
 		// add the entries to the statedb journal in 18 decimals
-		convertedAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(amount)
+		convertedAmount, err := cmn.ScaleAmount(
+			amount, uint8(evmtypes.GetEVMCoinDecimals()), uint8(evmtypes.EighteenDecimals), cmn.RoundingRejectInexact,
+		)
+		if err != nil {
+			return nil, err
+		}
 		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(from, convertedAmount, cmn.Sub),
 			cmn.NewBalanceChangeEntry(to, convertedAmount, cmn.Add))
 	}
 
+	if p.hooks != nil {
+		if err := p.hooks.AfterTransfer(ctx, p.tokenPair, from, to, amount); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = p.EmitTransferEvent(ctx, stateDB, from, to, amount); err != nil {
 		return nil, err
 	}
@@ -132,7 +204,7 @@ func (p *Precompile) transfer(
 		// the maxUint256 value.
 		newAllowance = abi.MaxUint256
 	} else {
-		newAllowance = new(big.Int).Sub(prevAllowance, amount)
+		newAllowance = p.getAllowance(ctx, from, spenderAddr)
 	}
 
 	if err = p.EmitApprovalEvent(ctx, stateDB, from, spenderAddr, newAllowance); err != nil {
@@ -2,17 +2,23 @@ package erc20_test
 
 import (
 	"math/big"
-	"time"
+	"strings"
 
-	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/precompiles/erc20"
+	"github.com/evmos/evmos/v20/precompiles/erc20/testdata"
 	"github.com/evmos/evmos/v20/precompiles/testutil"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 var (
@@ -110,6 +116,99 @@ func (s *PrecompileTestSuite) TestTransfer() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestTransferWithMemo() {
+	method := s.precompile.Methods[erc20.TransferWithMemoMethod]
+	// fromAddr is the address of the keyring account used for testing.
+	fromAddr := s.keyring.GetKey(0).Addr
+	memo := "deposit-id-1234"
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{toAddr, big.NewInt(100)}
+			},
+			true,
+			"invalid number of arguments; expected 3; got: 2",
+		},
+		{
+			"fail - invalid memo",
+			func() []interface{} {
+				return []interface{}{toAddr, big.NewInt(100), 1}
+			},
+			true,
+			"invalid memo",
+		},
+		{
+			"fail - memo too long",
+			func() []interface{} {
+				return []interface{}{toAddr, big.NewInt(100), strings.Repeat("a", erc20.MaxMemoLength+1)}
+			},
+			true,
+			"memo length 257 exceeds the maximum of 256",
+		},
+		{
+			"fail - not enough balance",
+			func() []interface{} {
+				return []interface{}{toAddr, big.NewInt(2e18), memo}
+			},
+			true,
+			erc20.ErrTransferAmountExceedsBalance.Error(),
+		},
+		{
+			"pass",
+			func() []interface{} {
+				return []interface{}{toAddr, big.NewInt(100), memo}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), fromAddr, s.precompile, 0)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// Mint some coins to the module account and then send to the from address
+			err := s.network.App.BankKeeper.MintCoins(s.network.GetContext(), erc20types.ModuleName, XMPLCoin)
+			s.Require().NoError(err, "failed to mint coins")
+			err = s.network.App.BankKeeper.SendCoinsFromModuleToAccount(s.network.GetContext(), erc20types.ModuleName, fromAddr.Bytes(), XMPLCoin)
+			s.Require().NoError(err, "failed to send coins from module to account")
+
+			_, err = s.precompile.TransferWithMemo(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains, "expected transfer transaction to fail with specific error")
+				return
+			}
+
+			s.Require().NoError(err, "expected transfer transaction succeeded")
+			toAddrBalance := s.network.App.BankKeeper.GetBalance(s.network.GetContext(), toAddr.Bytes(), tokenDenom)
+			s.Require().Equal(big.NewInt(100), toAddrBalance.Amount.BigInt(), "expected toAddr to have 100 XMPL")
+
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != banktypes.EventTypeTransfer {
+					continue
+				}
+				attr, ok := event.GetAttribute(erc20.AttributeKeyMemo)
+				s.Require().True(ok, "expected memo attribute in bank transfer event")
+				s.Require().Equal(memo, attr.Value, "expected different memo")
+				found = true
+			}
+			s.Require().True(found, "expected bank transfer event")
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestTransferFrom() {
 	var (
 		ctx  sdk.Context
@@ -176,15 +275,8 @@ func (s *PrecompileTestSuite) TestTransferFrom() {
 		{
 			"fail - not enough balance",
 			func() []interface{} {
-				expiration := time.Now().Add(time.Hour)
-				err := s.network.App.AuthzKeeper.SaveGrant(
-					ctx,
-					spender.AccAddr,
-					owner.AccAddr,
-					&banktypes.SendAuthorization{SpendLimit: sdk.Coins{sdk.Coin{Denom: s.tokenDenom, Amount: math.NewInt(5e18)}}},
-					&expiration,
-				)
-				s.Require().NoError(err, "failed to save grant")
+				err := s.network.App.Erc20Keeper.SetAllowance(ctx, s.precompile.Address(), owner.Addr, spender.Addr, big.NewInt(5e18))
+				s.Require().NoError(err, "failed to set allowance")
 
 				return []interface{}{owner.Addr, toAddr, big.NewInt(2e18)}
 			},
@@ -195,21 +287,32 @@ func (s *PrecompileTestSuite) TestTransferFrom() {
 		{
 			"pass - spend on behalf of other account",
 			func() []interface{} {
-				expiration := time.Now().Add(time.Hour)
-				err := s.network.App.AuthzKeeper.SaveGrant(
-					ctx,
-					spender.AccAddr,
-					owner.AccAddr,
-					&banktypes.SendAuthorization{SpendLimit: sdk.Coins{sdk.Coin{Denom: tokenDenom, Amount: math.NewInt(300)}}},
-					&expiration,
-				)
-				s.Require().NoError(err, "failed to save grant")
+				err := s.network.App.Erc20Keeper.SetAllowance(ctx, s.precompile.Address(), owner.Addr, spender.Addr, big.NewInt(300))
+				s.Require().NoError(err, "failed to set allowance")
 
 				return []interface{}{owner.Addr, toAddr, big.NewInt(100)}
 			},
 			func() {
 				toAddrBalance := s.network.App.BankKeeper.GetBalance(ctx, toAddr.Bytes(), tokenDenom)
 				s.Require().Equal(big.NewInt(100), toAddrBalance.Amount.BigInt(), "expected toAddr to have 100 XMPL")
+
+				allowance := s.network.App.Erc20Keeper.GetAllowance(ctx, s.precompile.Address(), owner.Addr, spender.Addr)
+				s.Require().Equal(big.NewInt(200), allowance, "expected the allowance to be decreased")
+			},
+			false,
+			"",
+		},
+		{
+			"pass - spend on behalf of other account with unlimited allowance",
+			func() []interface{} {
+				err := s.network.App.Erc20Keeper.SetAllowance(ctx, s.precompile.Address(), owner.Addr, spender.Addr, abi.MaxUint256)
+				s.Require().NoError(err, "failed to set allowance")
+
+				return []interface{}{owner.Addr, toAddr, big.NewInt(100)}
+			},
+			func() {
+				allowance := s.network.App.Erc20Keeper.GetAllowance(ctx, s.precompile.Address(), owner.Addr, spender.Addr)
+				s.Require().Equal(abi.MaxUint256, allowance, "expected the unlimited allowance not to be decreased")
 			},
 			false,
 			"",
@@ -261,3 +364,173 @@ func (s *PrecompileTestSuite) TestTransferFrom() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestTransferRevertsWithCosmosError() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	from := s.keyring.GetKey(0)
+
+	err := s.network.App.BankKeeper.MintCoins(ctx, erc20types.ModuleName, XMPLCoin)
+	s.Require().NoError(err, "failed to mint coins")
+	err = s.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, erc20types.ModuleName, from.AccAddr, XMPLCoin)
+	s.Require().NoError(err, "failed to send coins from module to account")
+
+	// force a bank error by disabling sends of the token
+	s.network.App.BankKeeper.SetSendEnabled(ctx, tokenDenom, false)
+
+	gas := uint64(200_000)
+	contract := vm.NewPrecompile(vm.AccountRef(from.Addr), s.precompile, big.NewInt(0), gas)
+	contract.Input, err = s.precompile.Pack(erc20.TransferMethod, toAddr, big.NewInt(100))
+	s.Require().NoError(err, "failed to pack transfer input")
+
+	precompileAddr := s.precompile.Address()
+	msg, err := s.factory.GenerateGethCoreMsg(from.Priv, evmtypes.EvmTxArgs{
+		ChainID:   evmtypes.GetEthChainConfig().ChainID,
+		To:        &precompileAddr,
+		GasLimit:  gas,
+		GasFeeCap: s.network.App.EvmKeeper.GetBaseFee(ctx),
+		GasTipCap: big.NewInt(1),
+		Accesses:  &ethtypes.AccessList{},
+	})
+	s.Require().NoError(err, "failed to generate the core message")
+
+	cfg, err := s.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err, "failed to instantiate EVM config")
+	stateDB := statedb.New(ctx, s.network.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := s.network.App.EvmKeeper.NewEVM(ctx, msg, cfg, nil, stateDB)
+
+	revertData, err := s.precompile.Run(evm, contract, false)
+	s.Require().ErrorIs(err, vm.ErrExecutionReverted)
+
+	codespace, code, message, err := cmn.UnpackCosmosError(revertData)
+	s.Require().NoError(err, "expected no error decoding the revert data")
+	s.Require().Equal(banktypes.ModuleName, codespace)
+	s.Require().Equal(banktypes.ErrSendDisabled.ABCICode(), code)
+	s.Require().Contains(message, banktypes.ErrSendDisabled.Error())
+
+	// the gas consumed until the failure is charged
+	s.Require().Less(contract.Gas, gas)
+}
+
+// reentrantHooks are erc20 hooks that call back into the ERC-20 precompile
+// through the ERC20TestCaller contract before the tokens are transferred.
+type reentrantHooks struct {
+	s          *PrecompileTestSuite
+	from       common.Address
+	caller     evmtypes.CompiledContract
+	callerAddr common.Address
+	// entered records whether the precompile was entered in the context of
+	// the hook.
+	entered bool
+}
+
+func (h *reentrantHooks) BeforeTransfer(ctx sdk.Context, pair erc20types.TokenPair, _, _ common.Address, _ *big.Int) error {
+	h.entered = cmn.IsEntered(ctx, pair.GetERC20Contract())
+	_, err := h.s.network.App.EvmKeeper.CallEVM(
+		ctx, h.caller.ABI, h.from, h.callerAddr, true,
+		"transferWithRevert", toAddr, big.NewInt(1), false, false,
+	)
+	return err
+}
+
+func (h *reentrantHooks) AfterTransfer(sdk.Context, erc20types.TokenPair, common.Address, common.Address, *big.Int) error {
+	return nil
+}
+
+func (h *reentrantHooks) AfterMint(sdk.Context, erc20types.TokenPair, common.Address, *big.Int) error {
+	return nil
+}
+
+func (h *reentrantHooks) AfterBurn(sdk.Context, erc20types.TokenPair, common.Address, *big.Int) error {
+	return nil
+}
+
+func (s *PrecompileTestSuite) TestTransferReentrancy() {
+	testCases := []struct {
+		name       string
+		guarded    bool
+		expErr     bool
+		expEntered bool
+	}{
+		{
+			"fail - reentrant transfer through a contract",
+			true,
+			true,
+			true,
+		},
+		{
+			"pass - reentrant transfer without the reentrancy guard",
+			false,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			from := s.keyring.GetKey(0)
+
+			caller, err := testdata.LoadERC20TestCaller()
+			s.Require().NoError(err, "failed to load the ERC20TestCaller contract")
+			callerAddr, err := s.factory.DeployContract(
+				from.Priv,
+				evmtypes.EvmTxArgs{},
+				factory.ContractDeploymentData{
+					Contract:        caller,
+					ConstructorArgs: []interface{}{s.precompile.Address()},
+				},
+			)
+			s.Require().NoError(err, "failed to deploy the ERC20TestCaller contract")
+			s.Require().NoError(s.network.NextBlock(), "failed to advance block")
+
+			ctx := s.network.GetContext()
+			for _, account := range []sdk.AccAddress{from.AccAddr, callerAddr.Bytes()} {
+				err = s.network.App.BankKeeper.MintCoins(ctx, erc20types.ModuleName, XMPLCoin)
+				s.Require().NoError(err, "failed to mint coins")
+				err = s.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, erc20types.ModuleName, account, XMPLCoin)
+				s.Require().NoError(err, "failed to send coins from module to account")
+			}
+
+			hooks := &reentrantHooks{
+				s:          s,
+				from:       s.keyring.GetAddr(1),
+				caller:     caller,
+				callerAddr: callerAddr,
+			}
+			s.precompile.SetHooks(hooks)
+			if !tc.guarded {
+				s.precompile.ReentrancyGuard = nil
+			}
+
+			gas := uint64(2_000_000)
+			contract := vm.NewPrecompile(vm.AccountRef(from.Addr), s.precompile, big.NewInt(0), gas)
+			contract.Input, err = s.precompile.Pack(erc20.TransferMethod, toAddr, big.NewInt(100))
+			s.Require().NoError(err, "failed to pack transfer input")
+
+			precompileAddr := s.precompile.Address()
+			msg, err := s.factory.GenerateGethCoreMsg(from.Priv, evmtypes.EvmTxArgs{
+				ChainID:   evmtypes.GetEthChainConfig().ChainID,
+				To:        &precompileAddr,
+				GasLimit:  gas,
+				GasFeeCap: s.network.App.EvmKeeper.GetBaseFee(ctx),
+				GasTipCap: big.NewInt(1),
+				Accesses:  &ethtypes.AccessList{},
+			})
+			s.Require().NoError(err, "failed to generate the core message")
+
+			cfg, err := s.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+			s.Require().NoError(err, "failed to instantiate EVM config")
+			stateDB := statedb.New(ctx, s.network.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			evm := s.network.App.EvmKeeper.NewEVM(ctx, msg, cfg, nil, stateDB)
+
+			_, err = s.precompile.Run(evm, contract, false)
+			s.Require().Equal(tc.expEntered, hooks.entered)
+			if tc.expErr {
+				s.Require().Error(err, "expected the reentrant transfer to fail")
+			} else {
+				s.Require().NoError(err, "expected the reentrant transfer to succeed")
+			}
+		})
+	}
+}
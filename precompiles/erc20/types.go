@@ -26,6 +26,13 @@ type EventApproval struct {
 	Value   *big.Int
 }
 
+// Allowance defines the spender and the amount of an allowance returned by the
+// allowancesOf query.
+type Allowance struct {
+	Spender common.Address
+	Amount  *big.Int
+}
+
 // ParseTransferArgs parses the arguments from the transfer method and returns
 // the destination address (to) and amount.
 func ParseTransferArgs(args []interface{}) (
@@ -48,6 +55,37 @@ func ParseTransferArgs(args []interface{}) (
 	return to, amount, nil
 }
 
+// ParseTransferWithMemoArgs parses the arguments from the transferWithMemo method and
+// returns the destination address (to), amount and memo.
+func ParseTransferWithMemoArgs(args []interface{}) (
+	to common.Address, amount *big.Int, memo string, err error,
+) {
+	if len(args) != 3 {
+		return common.Address{}, nil, "", fmt.Errorf("invalid number of arguments; expected 3; got: %d", len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, nil, "", fmt.Errorf("invalid to address: %v", args[0])
+	}
+
+	amount, ok = args[1].(*big.Int)
+	if !ok {
+		return common.Address{}, nil, "", fmt.Errorf("invalid amount: %v", args[1])
+	}
+
+	memo, ok = args[2].(string)
+	if !ok {
+		return common.Address{}, nil, "", fmt.Errorf("invalid memo: %v", args[2])
+	}
+
+	if len(memo) > MaxMemoLength {
+		return common.Address{}, nil, "", fmt.Errorf(ErrMemoTooLong, len(memo), MaxMemoLength)
+	}
+
+	return to, amount, memo, nil
+}
+
 // ParseTransferFromArgs parses the arguments from the transferFrom method and returns
 // the sender address (from), destination address (to) and amount.
 func ParseTransferFromArgs(args []interface{}) (
@@ -150,3 +188,89 @@ func updateOrAddCoin(coins sdk.Coins, coin sdk.Coin) sdk.Coins {
 	// add it here.
 	return coins.Add(coin)
 }
+
+// ParsePermitArgs parses the permit arguments and returns the owner and spender
+// addresses, the value, the deadline and the signature values.
+func ParsePermitArgs(args []interface{}) (
+	owner, spender common.Address, value, deadline *big.Int, v uint8, r, s [32]byte, err error,
+) {
+	if len(args) != 7 {
+		err = fmt.Errorf("invalid number of arguments; expected 7; got: %d", len(args))
+		return
+	}
+
+	var ok bool
+	if owner, ok = args[0].(common.Address); !ok {
+		err = fmt.Errorf("invalid owner address: %v", args[0])
+		return
+	}
+	if spender, ok = args[1].(common.Address); !ok {
+		err = fmt.Errorf("invalid spender address: %v", args[1])
+		return
+	}
+	if value, ok = args[2].(*big.Int); !ok {
+		err = fmt.Errorf("invalid value: %v", args[2])
+		return
+	}
+	if deadline, ok = args[3].(*big.Int); !ok {
+		err = fmt.Errorf("invalid deadline: %v", args[3])
+		return
+	}
+	if v, ok = args[4].(uint8); !ok {
+		err = fmt.Errorf("invalid v: %v", args[4])
+		return
+	}
+	if r, ok = args[5].([32]byte); !ok {
+		err = fmt.Errorf("invalid r: %v", args[5])
+		return
+	}
+	if s, ok = args[6].([32]byte); !ok {
+		err = fmt.Errorf("invalid s: %v", args[6])
+		return
+	}
+
+	return owner, spender, value, deadline, v, r, s, nil
+}
+
+// ParseNoncesArgs parses the nonces arguments and returns the owner address.
+func ParseNoncesArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	return owner, nil
+}
+
+// ParseAllowancesOfArgs parses the allowancesOf arguments and returns the owner address.
+func ParseAllowancesOfArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	return owner, nil
+}
+
+// ParseBlockAccountArgs parses the blockAccount, unblockAccount and isBlocked
+// arguments and returns the account address.
+func ParseBlockAccountArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("invalid account address: %v", args[0])
+	}
+
+	return account, nil
+}
@@ -98,13 +98,12 @@ func setupSendAuthz(
 	return nil
 }
 
-// setupSendAuthzForContract is a helper function which executes an approval
+// setupAllowanceForContract is a helper function which executes an approval
 // for the given contract data.
 //
-// If:
-//   - the classic ERC20 contract is used, it calls the `approve` method on the contract.
-//   - in other cases, it sends a `MsgGrant` to set up the authorization.
-func (is *IntegrationTestSuite) setupSendAuthzForContract(
+// NOTE: For the native call types, the approval is sent directly to the precompile
+// so that the owner of the allowance is the given granter and not the caller contract.
+func (is *IntegrationTestSuite) setupAllowanceForContract(
 	callType CallType, contractData ContractsData, grantee common.Address, granterPriv cryptotypes.PrivKey, amount sdk.Coins,
 ) {
 	Expect(amount).To(HaveLen(1), "expected only one coin")
@@ -114,9 +113,9 @@ func (is *IntegrationTestSuite) setupSendAuthzForContract(
 
 	switch {
 	case slices.Contains(nativeCallTypes, callType):
-		is.setupSendAuthz(grantee.Bytes(), granterPriv, amount)
+		is.setupAllowanceForERC20(directCallType(callType), contractData, grantee, granterPriv, amount)
 	case slices.Contains(erc20CallTypes, callType):
-		is.setupSendAuthzForERC20(callType, contractData, grantee, granterPriv, amount)
+		is.setupAllowanceForERC20(callType, contractData, grantee, granterPriv, amount)
 	default:
 		panic("unknown contract call type")
 	}
@@ -126,9 +125,9 @@ func (is *IntegrationTestSuite) setupSendAuthzForContract(
 	Expect(err).ToNot(HaveOccurred(), "error while calling NextBlock")
 }
 
-// setupSendAuthzForERC20 is a helper function to set up a SendAuthorization for
+// setupAllowanceForERC20 is a helper function to approve an allowance for
 // a given grantee and granter combination for a given amount.
-func (is *IntegrationTestSuite) setupSendAuthzForERC20(
+func (is *IntegrationTestSuite) setupAllowanceForERC20(
 	callType CallType, contractData ContractsData, grantee common.Address, granterPriv cryptotypes.PrivKey, amount sdk.Coins,
 ) {
 	if callType == erc20V5CallerCall {
@@ -150,6 +149,19 @@ func (is *IntegrationTestSuite) setupSendAuthzForERC20(
 	Expect(err).ToNot(HaveOccurred(), "failed to execute approve")
 }
 
+// directCallType returns the call type that calls the precompile directly
+// for the given native call type.
+func directCallType(callType CallType) CallType {
+	switch callType {
+	case contractCall:
+		return directCall
+	case contractCallToken2:
+		return directCallToken2
+	default:
+		return callType
+	}
+}
+
 // requireOut is a helper utility to reduce the amount of boilerplate code in the query tests.
 //
 // It requires the output bytes and error to match the expected values. Additionally, the method outputs
@@ -189,30 +201,18 @@ func (s *PrecompileTestSuite) requireOut(
 	}
 }
 
-// requireSendAuthz is a helper function to check that a SendAuthorization
-// exists for a given grantee and granter combination for a given amount.
-//
-// NOTE: This helper expects only one authorization to exist.
-func (s *PrecompileTestSuite) requireSendAuthz(grantee, granter sdk.AccAddress, amount sdk.Coins, allowList []string) {
-	grants, err := s.grpcHandler.GetGrantsByGrantee(grantee.String())
-	s.Require().NoError(err, "expected no error querying the grants")
-	s.Require().Len(grants, 1, "expected one grant")
-	s.Require().Equal(grantee.String(), grants[0].Grantee, "expected different grantee")
-	s.Require().Equal(granter.String(), grants[0].Granter, "expected different granter")
-
-	authzs, err := s.grpcHandler.GetAuthorizationsByGrantee(grantee.String())
-	s.Require().NoError(err, "expected no error unpacking the authorization")
-	s.Require().Len(authzs, 1, "expected one authorization")
-
-	sendAuthz, ok := authzs[0].(*banktypes.SendAuthorization)
-	s.Require().True(ok, "expected send authorization")
+// setupAllowance is a helper function to set up an allowance of the precompile
+// token for a given owner and spender combination.
+func (s *PrecompileTestSuite) setupAllowance(owner, spender common.Address, amount *big.Int) {
+	err := s.network.App.Erc20Keeper.SetAllowance(s.network.GetContext(), s.precompile.Address(), owner, spender, amount)
+	s.Require().NoError(err, "failed to set up allowance")
+}
 
-	s.Require().Equal(amount, sendAuthz.SpendLimit, "expected different spend limit amount")
-	if len(allowList) == 0 {
-		s.Require().Empty(sendAuthz.AllowList, "expected empty allow list")
-	} else {
-		s.Require().Equal(allowList, sendAuthz.AllowList, "expected different allow list")
-	}
+// requireAllowance is a helper function to check that the allowance of the precompile
+// token for a given owner and spender combination has the expected amount.
+func (s *PrecompileTestSuite) requireAllowance(owner, spender common.Address, expAmount *big.Int) {
+	allowance := s.network.App.Erc20Keeper.GetAllowance(s.network.GetContext(), s.precompile.Address(), owner, spender)
+	s.Require().Equal(expAmount.String(), allowance.String(), "expected different allowance")
 }
 
 // setupERC20Precompile is a helper function to set up an instance of the ERC20 precompile for
@@ -243,7 +243,7 @@ func (is *IntegrationTestSuite) setupERC20Precompile(denom string, tokenPairs []
 	precompile, err := erc20.NewPrecompile(
 		tokenPair,
 		is.network.App.BankKeeper,
-		is.network.App.AuthzKeeper,
+		is.network.App.Erc20Keeper,
 		is.network.App.TransferKeeper,
 	)
 	Expect(err).ToNot(HaveOccurred(), "failed to set up %q erc20 precompile", tokenPair.Denom)
@@ -260,7 +260,7 @@ func setupERC20PrecompileForTokenPair(
 	precompile, err := erc20.NewPrecompile(
 		tokenPair,
 		unitNetwork.App.BankKeeper,
-		unitNetwork.App.AuthzKeeper,
+		unitNetwork.App.Erc20Keeper,
 		unitNetwork.App.TransferKeeper,
 	)
 	if err != nil {
@@ -289,7 +289,7 @@ func setupNewERC20PrecompileForTokenPair(
 	precompile, err := erc20.NewPrecompile(
 		tokenPair,
 		unitNetwork.App.BankKeeper,
-		unitNetwork.App.AuthzKeeper,
+		unitNetwork.App.Erc20Keeper,
 		unitNetwork.App.TransferKeeper,
 	)
 	if err != nil {
@@ -418,11 +418,9 @@ func (is *IntegrationTestSuite) ExpectBalancesForERC20(callType CallType, contra
 }
 
 // expectSendAuthz is a helper function to check that a SendAuthorization
-// exists for a given grantee and granter combination for a given amount and optionally an access list.
+// exists for a given grantee and granter combination for a given amount.
 //
 // NOTE: This helper expects only one authorization to exist.
-//
-// NOTE 2: This mirrors the requireSendAuthz method but adapted to Ginkgo.
 func (is *IntegrationTestSuite) expectSendAuthz(grantee, granter sdk.AccAddress, expAmount sdk.Coins) {
 	authzs, err := is.handler.GetAuthorizations(grantee.String(), granter.String())
 	Expect(err).ToNot(HaveOccurred(), "expected no error unpacking the authorization")
@@ -434,9 +432,9 @@ func (is *IntegrationTestSuite) expectSendAuthz(grantee, granter sdk.AccAddress,
 	Expect(sendAuthz.SpendLimit).To(Equal(expAmount), "expected different spend limit amount")
 }
 
-// expectSendAuthzForERC20 is a helper function to check that a SendAuthorization
-// exists for a given grantee and granter combination for a given amount.
-func (is *IntegrationTestSuite) expectSendAuthzForERC20(callType CallType, contractData ContractsData, grantee, granter common.Address, expAmount sdk.Coins) {
+// expectAllowanceForERC20 is a helper function to check that the allowance
+// for a given grantee and granter combination has the expected amount.
+func (is *IntegrationTestSuite) expectAllowanceForERC20(callType CallType, contractData ContractsData, grantee, granter common.Address, expAmount sdk.Coins) {
 	contractABI := contractData.GetContractData(callType).ABI
 
 	txArgs, callArgs := is.getTxAndCallArgs(callType, contractData, auth.AllowanceMethod, granter, grantee)
@@ -454,50 +452,27 @@ func (is *IntegrationTestSuite) expectSendAuthzForERC20(callType CallType, contr
 	Expect(math.NewIntFromBigInt(allowance)).To(Equal(expAmount.AmountOf(is.tokenDenom)), "expected different allowance")
 }
 
-// ExpectSendAuthzForContract is a helper function to check that a SendAuthorization
-// exists for a given grantee and granter combination for a given amount and optionally an access list.
-//
-// NOTE: This helper expects only one authorization to exist.
-func (is *IntegrationTestSuite) ExpectSendAuthzForContract(
+// ExpectAllowanceForContract is a helper function to check that the allowance
+// for a given grantee and granter combination has the expected amount.
+func (is *IntegrationTestSuite) ExpectAllowanceForContract(
 	callType CallType, contractData ContractsData, grantee, granter common.Address, expAmount sdk.Coins,
 ) {
 	switch {
 	case slices.Contains(nativeCallTypes, callType):
-		is.expectSendAuthz(grantee.Bytes(), granter.Bytes(), expAmount)
+		is.expectAllowanceForERC20(directCallType(callType), contractData, grantee, granter, expAmount)
 	case slices.Contains(erc20CallTypes, callType):
-		is.expectSendAuthzForERC20(callType, contractData, grantee, granter, expAmount)
+		is.expectAllowanceForERC20(callType, contractData, grantee, granter, expAmount)
 	default:
 		panic("unknown contract call type")
 	}
 }
 
-// expectNoSendAuthz is a helper function to check that no SendAuthorization
-// exists for a given grantee and granter combination.
-func (is *IntegrationTestSuite) expectNoSendAuthz(grantee, granter sdk.AccAddress) {
-	authzs, err := is.handler.GetAuthorizations(grantee.String(), granter.String())
-	Expect(err).ToNot(HaveOccurred(), "expected no error unpacking the authorizations")
-	Expect(authzs).To(HaveLen(0), "expected no authorizations")
-}
-
-// expectNoSendAuthzForERC20 is a helper function to check that no SendAuthorization
+// ExpectNoAllowanceForContract is a helper function to check that no allowance
 // exists for a given grantee and granter combination.
-func (is *IntegrationTestSuite) expectNoSendAuthzForERC20(callType CallType, contractData ContractsData, grantee, granter common.Address) {
-	is.expectSendAuthzForERC20(callType, contractData, grantee, granter, sdk.Coins{})
-}
-
-// ExpectNoSendAuthzForContract is a helper function to check that no SendAuthorization
-// exists for a given grantee and granter combination.
-func (is *IntegrationTestSuite) ExpectNoSendAuthzForContract(
+func (is *IntegrationTestSuite) ExpectNoAllowanceForContract(
 	callType CallType, contractData ContractsData, grantee, granter common.Address,
 ) {
-	switch {
-	case slices.Contains(nativeCallTypes, callType):
-		is.expectNoSendAuthz(grantee.Bytes(), granter.Bytes())
-	case slices.Contains(erc20CallTypes, callType):
-		is.expectNoSendAuthzForERC20(callType, contractData, grantee, granter)
-	default:
-		panic("unknown contract call type")
-	}
+	is.ExpectAllowanceForContract(callType, contractData, grantee, granter, sdk.Coins{})
 }
 
 // ExpectTrueToBeReturned is a helper function to check that the precompile returns true
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IEvidence contract's address.
+address constant EVIDENCE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The IEvidence contract's instance.
+IEvidence constant EVIDENCE_CONTRACT = IEvidence(EVIDENCE_PRECOMPILE_ADDRESS);
+
+/// @dev Equivocation defines the evidence of a validator signing two conflicting
+/// blocks at the same height.
+struct Equivocation {
+    // the height at which the validator double signed
+    int64 height;
+    // the unix timestamp of the block at which the validator double signed
+    int64 time;
+    // the voting power of the validator at the time of the infraction
+    int64 power;
+    // the consensus address of the validator
+    address consensusAddress;
+}
+
+/// @author Evmos Team
+/// @title Evidence Precompiled Contract
+/// @dev The interface through which solidity contracts query the evidence of
+/// validator misbehaviour. The evidence can't be submitted through the
+/// precompile, since an equivocation doesn't carry the signed votes that prove
+/// it. Only the evidence reported by CometBFT is stored.
+/// @custom:address 0x0000000000000000000000000000000000000807
+interface IEvidence {
+    /// @dev Evidence returns the evidence with the given hash.
+    /// @param evidenceHash The hash of the evidence.
+    /// @return equivocation The evidence.
+    function evidence(
+        bytes calldata evidenceHash
+    ) external view returns (Equivocation memory equivocation);
+
+    /// @dev GetAllEvidence returns all the stored evidence.
+    /// @param pageRequest The pagination options.
+    /// @return equivocations The stored evidence.
+    /// @return pageResponse The pagination response.
+    function getAllEvidence(
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            Equivocation[] memory equivocations,
+            PageResponse memory pageResponse
+        );
+}
@@ -0,0 +1,12 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evidence
+
+const (
+	// ErrInvalidEvidenceHash is raised when the evidence hash is not valid.
+	ErrInvalidEvidenceHash = "invalid evidence hash: %v"
+	// ErrInvalidEquivocation is raised when the equivocation evidence is not valid.
+	ErrInvalidEquivocation = "invalid equivocation evidence: %v"
+	// ErrUnexpectedEvidenceType is raised when the stored evidence is not an equivocation.
+	ErrUnexpectedEvidenceType = "unexpected evidence type %T"
+)
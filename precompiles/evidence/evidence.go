@@ -0,0 +1,84 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package evidence
+
+import (
+	"embed"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+//go:generate go run github.com/evmos/evmos/v20/precompiles/sdkgen/cmd/sdkgen -abi abi.json -out precompile.gen.go
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for evidence.
+//
+// The precompile only queries the evidence handled by x/evidence. It doesn't
+// submit evidence: an Equivocation doesn't carry the conflicting signed votes,
+// so a submission can't be verified. The evidence is only trusted when
+// reported by CometBFT.
+type Precompile struct {
+	cmn.Precompile
+	evidenceKeeper evidencekeeper.Keeper
+	cdc            codec.Codec
+}
+
+// LoadABI loads the evidence ABI from the embedded abi.json file
+// for the evidence precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new evidence Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	evidenceKeeper evidencekeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	cdc codec.Codec,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			AuthzKeeper:          authzKeeper,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+		},
+		evidenceKeeper: evidenceKeeper,
+		cdc:            cdc,
+	}
+
+	// SetAddress defines the address of the evidence precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.EvidencePrecompileAddress))
+
+	return p, nil
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "evidence")
+}
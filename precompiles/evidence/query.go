@@ -0,0 +1,65 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package evidence
+
+import (
+	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+// Evidence implements the query logic for getting the evidence with a given hash.
+func (p *Precompile) Evidence(
+	ctx sdk.Context,
+	_ *vm.EVM,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseEvidenceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	querier := evidencekeeper.NewQuerier(&p.evidenceKeeper)
+	res, err := querier.Evidence(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(EvidenceOutput).FromResponse(p.cdc, res)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.Equivocation)
+}
+
+// GetAllEvidence implements the query logic for getting all the stored evidence.
+func (p *Precompile) GetAllEvidence(
+	ctx sdk.Context,
+	_ *vm.EVM,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllEvidenceArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	querier := evidencekeeper.NewQuerier(&p.evidenceKeeper)
+	res, err := querier.AllEvidence(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(AllEvidenceOutput).FromResponse(p.cdc, res)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.Equivocations, output.PageResponse)
+}
@@ -0,0 +1,126 @@
+package evidence_test
+
+import (
+	"fmt"
+	"time"
+
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/evidence"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestEvidence() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[evidence.EvidenceMethod]
+	consAddr := utiltx.GenerateAddress()
+	equivocation := &evidencetypes.Equivocation{
+		Height:           1,
+		Time:             time.Unix(1_000_000, 0).UTC(),
+		Power:            100,
+		ConsensusAddress: sdk.ConsAddress(consAddr.Bytes()).String(),
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - empty evidence hash",
+			func() []interface{} {
+				return []interface{}{[]byte{}}
+			},
+			true,
+			"invalid evidence hash",
+		},
+		{
+			"fail - evidence not found",
+			func() []interface{} {
+				return []interface{}{equivocation.Hash()}
+			},
+			true,
+			"not found",
+		},
+		{
+			"success - evidence found",
+			func() []interface{} {
+				err := s.network.App.EvidenceKeeper.Evidences.Set(ctx, equivocation.Hash(), equivocation)
+				s.Require().NoError(err)
+				return []interface{}{equivocation.Hash()}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+
+			bz, err := s.precompile.Evidence(ctx, nil, contract, nil, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out evidence.EvidenceOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, evidence.EvidenceMethod, bz))
+			s.Require().Equal(evidence.Equivocation{
+				Height:           1,
+				Time:             1_000_000,
+				Power:            100,
+				ConsensusAddress: consAddr,
+			}, out.Equivocation)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetAllEvidence() {
+	method := s.precompile.Methods[evidence.GetAllEvidenceMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+
+	for i := int64(1); i <= 3; i++ {
+		equivocation := &evidencetypes.Equivocation{
+			Height:           i,
+			Time:             time.Unix(1_000_000, 0).UTC(),
+			Power:            100,
+			ConsensusAddress: sdk.ConsAddress(utiltx.GenerateAddress().Bytes()).String(),
+		}
+		s.Require().NoError(s.network.App.EvidenceKeeper.Evidences.Set(ctx, equivocation.Hash(), equivocation))
+	}
+
+	var contract *vm.Contract
+	contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+
+	bz, err := s.precompile.GetAllEvidence(ctx, nil, contract, nil, &method, []interface{}{query.PageRequest{Limit: 2, CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out evidence.AllEvidenceOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, evidence.GetAllEvidenceMethod, bz))
+	s.Require().Len(out.Equivocations, 2)
+	s.Require().Equal(uint64(3), out.PageResponse.Total)
+	s.Require().NotEmpty(out.PageResponse.NextKey)
+}
@@ -0,0 +1,132 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package evidence
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"cosmossdk.io/x/evidence/exported"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+// Equivocation represents the evidence of a validator double sign.
+type Equivocation struct {
+	Height           int64          `abi:"height"`
+	Time             int64          `abi:"time"`
+	Power            int64          `abi:"power"`
+	ConsensusAddress common.Address `abi:"consensusAddress"`
+}
+
+// EvidenceOutput defines the output for the Evidence query.
+type EvidenceOutput struct {
+	Equivocation Equivocation
+}
+
+// AllEvidenceInput defines the input for the AllEvidence query.
+type AllEvidenceInput struct {
+	PageRequest query.PageRequest
+}
+
+// AllEvidenceOutput defines the output for the AllEvidence query.
+type AllEvidenceOutput struct {
+	Equivocations []Equivocation     `abi:"equivocations"`
+	PageResponse  query.PageResponse `abi:"pageResponse"`
+}
+
+// ParseEvidenceArgs parses the arguments for the Evidence query.
+func ParseEvidenceArgs(args []interface{}) (*evidencetypes.QueryEvidenceRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	hash, ok := args[0].([]byte)
+	if !ok || len(hash) == 0 {
+		return nil, fmt.Errorf(ErrInvalidEvidenceHash, args[0])
+	}
+
+	return &evidencetypes.QueryEvidenceRequest{
+		Hash: hex.EncodeToString(hash),
+	}, nil
+}
+
+// ParseAllEvidenceArgs parses the arguments for the AllEvidence query.
+func ParseAllEvidenceArgs(method *abi.Method, args []interface{}) (*evidencetypes.QueryAllEvidenceRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input AllEvidenceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllEvidenceInput: %s", err)
+	}
+
+	return &evidencetypes.QueryAllEvidenceRequest{
+		Pagination: &input.PageRequest,
+	}, nil
+}
+
+// FromResponse populates the EvidenceOutput from a QueryEvidenceResponse.
+func (eo *EvidenceOutput) FromResponse(cdc codec.Codec, res *evidencetypes.QueryEvidenceResponse) (*EvidenceOutput, error) {
+	equivocation, err := newEquivocation(cdc, res.Evidence)
+	if err != nil {
+		return nil, err
+	}
+	eo.Equivocation = equivocation
+	return eo, nil
+}
+
+// FromResponse populates the AllEvidenceOutput from a QueryAllEvidenceResponse.
+func (aeo *AllEvidenceOutput) FromResponse(cdc codec.Codec, res *evidencetypes.QueryAllEvidenceResponse) (*AllEvidenceOutput, error) {
+	aeo.Equivocations = make([]Equivocation, len(res.Evidence))
+	for i, evidenceAny := range res.Evidence {
+		equivocation, err := newEquivocation(cdc, evidenceAny)
+		if err != nil {
+			return nil, err
+		}
+		aeo.Equivocations[i] = equivocation
+	}
+
+	if res.Pagination != nil {
+		aeo.PageResponse = query.PageResponse{
+			NextKey: res.Pagination.NextKey,
+			Total:   res.Pagination.Total,
+		}
+	}
+	return aeo, nil
+}
+
+// newEquivocation unpacks the evidence and converts it to its ABI representation.
+// Equivocation is the only evidence type handled by the precompile.
+func newEquivocation(cdc codec.Codec, evidenceAny *codectypes.Any) (Equivocation, error) {
+	var evidence exported.Evidence
+	if err := cdc.UnpackAny(evidenceAny, &evidence); err != nil {
+		return Equivocation{}, err
+	}
+
+	equivocation, ok := evidence.(*evidencetypes.Equivocation)
+	if !ok {
+		return Equivocation{}, fmt.Errorf(ErrUnexpectedEvidenceType, evidence)
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(equivocation.ConsensusAddress)
+	if err != nil {
+		return Equivocation{}, fmt.Errorf(ErrInvalidEquivocation, err)
+	}
+
+	return Equivocation{
+		Height:           equivocation.Height,
+		Time:             equivocation.Time.Unix(),
+		Power:            equivocation.Power,
+		ConsensusAddress: common.BytesToAddress(consAddr.Bytes()),
+	}, nil
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev Allowance defines the fees that a granter allows a grantee to spend.
+struct Allowance {
+    // the address of the account paying the fees
+    address granter;
+    // the address of the account whose fees are paid
+    address grantee;
+    // the maximum amount of fees that can be spent, unlimited if empty
+    Coin[] spendLimit;
+    // the unix timestamp at which the allowance expires, zero if it never expires
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Feegrant Precompiled Contract
+/// @dev The interface through which solidity contracts sponsor the fees of other
+/// accounts. The allowances are spent by the Cosmos transactions of the grantees
+/// that set the granter as the fee granter.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IFeegrant {
+    /// @dev Emitted when an allowance is granted.
+    /// @param granter The address of the account paying the fees.
+    /// @param grantee The address of the account whose fees are paid.
+    /// @param spendLimit The maximum amount of fees that can be spent.
+    /// @param expiration The unix timestamp at which the allowance expires.
+    event GrantAllowance(
+        address indexed granter,
+        address indexed grantee,
+        Coin[] spendLimit,
+        int64 expiration
+    );
+
+    /// @dev Emitted when an allowance is revoked.
+    /// @param granter The address of the account paying the fees.
+    /// @param grantee The address of the account whose fees were paid.
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev GrantBasicAllowance grants an allowance from the caller to the grantee.
+    /// @param grantee The address of the account whose fees are paid.
+    /// @param spendLimit The maximum amount of fees that can be spent, unlimited if empty.
+    /// @param expiration The unix timestamp at which the allowance expires, zero if
+    /// it never expires.
+    /// @return success Whether the allowance was granted.
+    function grantBasicAllowance(
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev RevokeAllowance revokes the allowance granted by the caller to the grantee.
+    /// @param grantee The address of the account whose fees were paid.
+    /// @return success Whether the allowance was revoked.
+    function revokeAllowance(address grantee) external returns (bool success);
+
+    /// @dev Allowance returns the allowance granted by the granter to the grantee.
+    /// @param granter The address of the account paying the fees.
+    /// @param grantee The address of the account whose fees are paid.
+    /// @return feeAllowance The allowance.
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (Allowance memory feeAllowance);
+
+    /// @dev Allowances returns the allowances granted to the grantee.
+    /// @param grantee The address of the account whose fees are paid.
+    /// @param pageRequest The pagination options.
+    /// @return feeAllowances The allowances.
+    /// @return pageResponse The pagination response.
+    function allowances(
+        address grantee,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            Allowance[] memory feeAllowances,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev AllowancesByGranter returns the allowances granted by the granter.
+    /// @param granter The address of the account paying the fees.
+    /// @param pageRequest The pagination options.
+    /// @return feeAllowances The allowances.
+    /// @return pageResponse The pagination response.
+    function allowancesByGranter(
+        address granter,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            Allowance[] memory feeAllowances,
+            PageResponse memory pageResponse
+        );
+}
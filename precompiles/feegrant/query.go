@@ -0,0 +1,91 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// AllowanceMethod defines the method name for the allowance precompile request.
+	AllowanceMethod = "allowance"
+	// AllowancesMethod defines the method name for the allowances precompile request.
+	AllowancesMethod = "allowances"
+	// AllowancesByGranterMethod defines the method name for the allowances by granter precompile request.
+	AllowancesByGranterMethod = "allowancesByGranter"
+)
+
+// Allowance implements the query logic for getting the allowance granted by a granter to a grantee.
+func (p *Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(AllowanceOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.FeeAllowance)
+}
+
+// Allowances implements the query logic for getting the allowances granted to a grantee.
+func (p *Precompile) Allowances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowancesArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowances(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(AllowancesOutput).FromResponse(res.Allowances, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.FeeAllowances, output.PageResponse)
+}
+
+// AllowancesByGranter implements the query logic for getting the allowances granted by a granter.
+func (p *Precompile) AllowancesByGranter(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowancesByGranterArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.AllowancesByGranter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(AllowancesOutput).FromResponse(res.Allowances, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.FeeAllowances, output.PageResponse)
+}
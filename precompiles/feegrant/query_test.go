@@ -0,0 +1,136 @@
+package feegrant_test
+
+import (
+	"fmt"
+	"time"
+
+	feegranttypes "cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/feegrant"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+	grantee := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, grantee}
+			},
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - allowance not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), grantee}
+			},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - periodic allowance is returned as its basic allowance",
+			func() []interface{} {
+				expiration := ctx.BlockTime().Add(time.Hour)
+				allowance := &feegranttypes.PeriodicAllowance{
+					Basic: feegranttypes.BasicAllowance{
+						SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(s.network.GetDenom(), 100)),
+						Expiration: &expiration,
+					},
+					Period:           time.Minute,
+					PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin(s.network.GetDenom(), 10)),
+				}
+				err := s.network.App.FeeGrantKeeper.GrantAllowance(ctx, s.keyring.GetAccAddr(0), grantee.Bytes(), allowance)
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(0), grantee}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+
+			bz, err := s.precompile.Allowance(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out feegrant.AllowanceOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz))
+			s.Require().Equal(s.keyring.GetAddr(0), out.FeeAllowance.Granter)
+			s.Require().Equal(grantee, out.FeeAllowance.Grantee)
+			s.Require().Len(out.FeeAllowance.SpendLimit, 1)
+			s.Require().Equal(int64(100), out.FeeAllowance.SpendLimit[0].Amount.Int64())
+			s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), out.FeeAllowance.Expiration)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowancesAndAllowancesByGranter() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+
+	granter := s.keyring.GetAddr(0)
+	grantee := s.keyring.GetAddr(1)
+	for _, g := range []common.Address{grantee, utiltx.GenerateAddress()} {
+		err := s.network.App.FeeGrantKeeper.GrantAllowance(ctx, granter.Bytes(), g.Bytes(), &feegranttypes.BasicAllowance{})
+		s.Require().NoError(err)
+	}
+
+	var contract *vm.Contract
+	contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, granter, s.precompile, 200000)
+
+	allowancesMethod := s.precompile.Methods[feegrant.AllowancesMethod]
+	bz, err := s.precompile.Allowances(ctx, &allowancesMethod, contract, []interface{}{grantee, query.PageRequest{Limit: 10, CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out feegrant.AllowancesOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz))
+	s.Require().Len(out.FeeAllowances, 1)
+	s.Require().Equal(granter, out.FeeAllowances[0].Granter)
+	s.Require().Equal(grantee, out.FeeAllowances[0].Grantee)
+
+	byGranterMethod := s.precompile.Methods[feegrant.AllowancesByGranterMethod]
+	bz, err = s.precompile.AllowancesByGranter(ctx, &byGranterMethod, contract, []interface{}{granter, query.PageRequest{Limit: 10, CountTotal: true}})
+	s.Require().NoError(err)
+
+	out = feegrant.AllowancesOutput{}
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesByGranterMethod, bz))
+	s.Require().Len(out.FeeAllowances, 2)
+	for _, allowance := range out.FeeAllowances {
+		s.Require().Equal(granter, allowance.Granter)
+	}
+}
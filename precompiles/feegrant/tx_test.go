@@ -0,0 +1,221 @@
+package feegrant_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	feegranttypes "cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/feegrant"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestGrantBasicAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.GrantBasicAllowanceMethod]
+	grantee := utiltx.GenerateAddress()
+	spendLimit := []cmn.Coin{{Denom: s.network.GetDenom(), Amount: big.NewInt(1e18)}}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, spendLimit, int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - negative expiration",
+			func() []interface{} {
+				return []interface{}{grantee, spendLimit, int64(-1)}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid expiration",
+		},
+		{
+			"fail - invalid spend limit",
+			func() []interface{} {
+				return []interface{}{grantee, []cmn.Coin{{Denom: s.network.GetDenom(), Amount: big.NewInt(0)}}, int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid spend limit",
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{grantee, spendLimit, ctx.BlockTime().Unix() - 1}
+			},
+			func() {},
+			200000,
+			true,
+			"expiration is before current block time",
+		},
+		{
+			"fail - self grant",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), spendLimit, int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			"cannot self-grant fee authorization",
+		},
+		{
+			"success - grant allowance with spend limit and expiration",
+			func() []interface{} {
+				return []interface{}{grantee, spendLimit, ctx.BlockTime().Unix() + 3600}
+			},
+			func() {
+				allowance, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(0), grantee.Bytes())
+				s.Require().NoError(err)
+				basic, ok := allowance.(*feegranttypes.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.network.GetDenom(), math.NewInt(1e18))), basic.SpendLimit)
+				s.Require().Equal(ctx.BlockTime().Unix()+3600, basic.Expiration.Unix())
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - grant unlimited allowance",
+			func() []interface{} {
+				return []interface{}{grantee, []cmn.Coin{}, int64(0)}
+			},
+			func() {
+				allowance, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(0), grantee.Bytes())
+				s.Require().NoError(err)
+				basic, ok := allowance.(*feegranttypes.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().True(basic.SpendLimit.Empty())
+				s.Require().Nil(basic.Expiration)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			_, err := s.precompile.GrantBasicAllowance(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+	grantee := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}}
+			},
+			200000,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - allowance not found",
+			func() []interface{} {
+				return []interface{}{grantee}
+			},
+			200000,
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - revoke allowance",
+			func() []interface{} {
+				err := s.network.App.FeeGrantKeeper.GrantAllowance(ctx, s.keyring.GetAccAddr(0), grantee.Bytes(), &feegranttypes.BasicAllowance{})
+				s.Require().NoError(err)
+				return []interface{}{grantee}
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			_, err := s.precompile.RevokeAllowance(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				allowance, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(0), grantee.Bytes())
+				s.Require().Error(err)
+				s.Require().Nil(allowance)
+			}
+		})
+	}
+}
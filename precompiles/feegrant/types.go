@@ -0,0 +1,266 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+// Allowance represents the fees that a granter allows a grantee to spend.
+type Allowance struct {
+	Granter    common.Address `abi:"granter"`
+	Grantee    common.Address `abi:"grantee"`
+	SpendLimit []cmn.Coin     `abi:"spendLimit"`
+	Expiration int64          `abi:"expiration"`
+}
+
+// GrantBasicAllowanceInput defines the input for the GrantBasicAllowance transaction.
+type GrantBasicAllowanceInput struct {
+	Grantee    common.Address
+	SpendLimit []cmn.Coin
+	Expiration int64
+}
+
+// AllowanceOutput defines the output for the Allowance query.
+type AllowanceOutput struct {
+	FeeAllowance Allowance
+}
+
+// AllowancesInput defines the input for the Allowances query.
+type AllowancesInput struct {
+	Grantee     common.Address
+	PageRequest query.PageRequest
+}
+
+// AllowancesByGranterInput defines the input for the AllowancesByGranter query.
+type AllowancesByGranterInput struct {
+	Granter     common.Address
+	PageRequest query.PageRequest
+}
+
+// AllowancesOutput defines the output for the Allowances and AllowancesByGranter queries.
+type AllowancesOutput struct {
+	FeeAllowances []Allowance        `abi:"feeAllowances"`
+	PageResponse  query.PageResponse `abi:"pageResponse"`
+}
+
+// NewMsgGrantBasicAllowance creates a new MsgGrantAllowance instance with a
+// basic allowance granted by the given granter.
+func NewMsgGrantBasicAllowance(granter common.Address, method *abi.Method, args []interface{}) (*feegrant.MsgGrantAllowance, *GrantBasicAllowanceInput, error) {
+	if len(args) != 3 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input GrantBasicAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, nil, fmt.Errorf("error while unpacking args to GrantBasicAllowanceInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	if input.Expiration < 0 {
+		return nil, nil, fmt.Errorf(ErrInvalidExpiration, input.Expiration)
+	}
+
+	allowance := &feegrant.BasicAllowance{}
+	if len(input.SpendLimit) > 0 {
+		spendLimit := make(sdk.Coins, len(input.SpendLimit))
+		for i, coin := range input.SpendLimit {
+			spendLimit[i] = coin.ToSDKType()
+		}
+		spendLimit = spendLimit.Sort()
+		if err := spendLimit.Validate(); err != nil {
+			return nil, nil, fmt.Errorf(ErrInvalidSpendLimit, err)
+		}
+		allowance.SpendLimit = spendLimit
+	}
+
+	if input.Expiration > 0 {
+		expiration := time.Unix(input.Expiration, 0).UTC()
+		allowance.Expiration = &expiration
+	}
+
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, granter.Bytes(), input.Grantee.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return msg, &input, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance instance for the
+// allowance granted by the given granter.
+func NewMsgRevokeAllowance(granter common.Address, args []interface{}) (*feegrant.MsgRevokeAllowance, common.Address, error) {
+	if len(args) != 1 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msg := feegrant.NewMsgRevokeAllowance(granter.Bytes(), grantee.Bytes())
+	return &msg, grantee, nil
+}
+
+// ParseAllowanceArgs parses the arguments for the Allowance query.
+func ParseAllowanceArgs(args []interface{}) (*feegrant.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, ok := args[0].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok := args[1].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	return &feegrant.QueryAllowanceRequest{
+		Granter: sdk.AccAddress(granter.Bytes()).String(),
+		Grantee: sdk.AccAddress(grantee.Bytes()).String(),
+	}, nil
+}
+
+// ParseAllowancesArgs parses the arguments for the Allowances query.
+func ParseAllowancesArgs(method *abi.Method, args []interface{}) (*feegrant.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &feegrant.QueryAllowancesRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: &input.PageRequest,
+	}, nil
+}
+
+// ParseAllowancesByGranterArgs parses the arguments for the AllowancesByGranter query.
+func ParseAllowancesByGranterArgs(method *abi.Method, args []interface{}) (*feegrant.QueryAllowancesByGranterRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesByGranterInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesByGranterInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	return &feegrant.QueryAllowancesByGranterRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: &input.PageRequest,
+	}, nil
+}
+
+// FromResponse populates the AllowanceOutput from a QueryAllowanceResponse.
+func (ao *AllowanceOutput) FromResponse(res *feegrant.QueryAllowanceResponse) (*AllowanceOutput, error) {
+	allowance, err := newAllowance(res.Allowance)
+	if err != nil {
+		return nil, err
+	}
+	ao.FeeAllowance = allowance
+	return ao, nil
+}
+
+// FromResponse populates the AllowancesOutput from the grants and the pagination
+// of a QueryAllowancesResponse or a QueryAllowancesByGranterResponse.
+func (ao *AllowancesOutput) FromResponse(grants []*feegrant.Grant, pageRes *query.PageResponse) (*AllowancesOutput, error) {
+	ao.FeeAllowances = make([]Allowance, len(grants))
+	for i, grant := range grants {
+		allowance, err := newAllowance(grant)
+		if err != nil {
+			return nil, err
+		}
+		ao.FeeAllowances[i] = allowance
+	}
+
+	if pageRes != nil {
+		ao.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+	return ao, nil
+}
+
+// newAllowance converts a fee grant to its ABI representation. The periodic and
+// the allowed messages allowances are represented by their basic allowance.
+func newAllowance(grant *feegrant.Grant) (Allowance, error) {
+	granter, err := sdk.AccAddressFromBech32(grant.Granter)
+	if err != nil {
+		return Allowance{}, fmt.Errorf(ErrInvalidGranter, grant.Granter)
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+	if err != nil {
+		return Allowance{}, fmt.Errorf(ErrInvalidGrantee, grant.Grantee)
+	}
+
+	feeAllowance, err := grant.GetGrant()
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	basic, err := basicAllowance(feeAllowance)
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	var expiration int64
+	if basic.Expiration != nil {
+		expiration = basic.Expiration.Unix()
+	}
+
+	return Allowance{
+		Granter:    common.BytesToAddress(granter.Bytes()),
+		Grantee:    common.BytesToAddress(grantee.Bytes()),
+		SpendLimit: cmn.NewCoinsResponse(basic.SpendLimit),
+		Expiration: expiration,
+	}, nil
+}
+
+// basicAllowance returns the basic allowance of the given fee allowance.
+func basicAllowance(feeAllowance feegrant.FeeAllowanceI) (feegrant.BasicAllowance, error) {
+	switch allowance := feeAllowance.(type) {
+	case *feegrant.BasicAllowance:
+		return *allowance, nil
+	case *feegrant.PeriodicAllowance:
+		return allowance.Basic, nil
+	case *feegrant.AllowedMsgAllowance:
+		inner, err := allowance.GetAllowance()
+		if err != nil {
+			return feegrant.BasicAllowance{}, err
+		}
+		return basicAllowance(inner)
+	default:
+		return feegrant.BasicAllowance{}, fmt.Errorf(ErrUnsupportedAllowance, feeAllowance)
+	}
+}
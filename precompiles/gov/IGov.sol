@@ -87,6 +87,22 @@ interface IGov {
     /// @param options the options for voter
     event VoteWeighted(address indexed voter, uint64 proposalId, WeightedVoteOption[] options);
 
+    /// @dev SubmitProposal defines an Event emitted when a proposal is submitted.
+    /// @param proposer the address of the proposer
+    /// @param proposalId the id of the proposal
+    event SubmitProposal(address indexed proposer, uint64 proposalId);
+
+    /// @dev Deposit defines an Event emitted when a deposit is made on a proposal.
+    /// @param depositor the address of the depositor
+    /// @param proposalId the id of the proposal
+    /// @param amount the amount deposited
+    event Deposit(address indexed depositor, uint64 proposalId, uint256 amount);
+
+    /// @dev CancelProposal defines an Event emitted when a proposal is canceled.
+    /// @param proposer the address of the proposer
+    /// @param proposalId the id of the proposal
+    event CancelProposal(address indexed proposer, uint64 proposalId);
+
     /// TRANSACTIONS
 
     /// @dev vote defines a method to add a vote on a specific proposal.
@@ -114,6 +130,44 @@ interface IGov {
         WeightedVoteOption[] calldata options,
         string memory metadata
     ) external returns (bool success);
+
+    /// @dev submitProposal defines a method to submit a proposal.
+    /// @param proposer The address of the proposer
+    /// @param protoMsgs The protobuf encoding of the proposal messages, i.e. the
+    /// repeated google.protobuf.Any messages field (1) of a MsgSubmitProposal
+    /// @param deposit The initial deposit, in the denomination of the minimum deposit
+    /// @param metadata The metadata of the proposal
+    /// @param title The title of the proposal
+    /// @param summary The summary of the proposal
+    /// @return proposalId The id of the submitted proposal
+    function submitProposal(
+        address proposer,
+        bytes calldata protoMsgs,
+        uint256 deposit,
+        string memory metadata,
+        string memory title,
+        string memory summary
+    ) external returns (uint64 proposalId);
+
+    /// @dev deposit defines a method to add a deposit on a specific proposal.
+    /// @param depositor The address of the depositor
+    /// @param proposalId The proposal id
+    /// @param amount The amount to deposit, in the denomination of the minimum deposit
+    /// @return success Whether the transaction was successful or not
+    function deposit(
+        address depositor,
+        uint64 proposalId,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev cancelProposal defines a method to cancel a proposal by its proposer.
+    /// @param proposer The address of the proposer
+    /// @param proposalId The proposal id
+    /// @return success Whether the transaction was successful or not
+    function cancelProposal(
+        address proposer,
+        uint64 proposalId
+    ) external returns (bool success);
      
     /// QUERIES
 
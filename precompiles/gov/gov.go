@@ -9,8 +9,10 @@ import (
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -31,7 +33,9 @@ var f embed.FS
 // Precompile defines the precompiled contract for gov.
 type Precompile struct {
 	cmn.Precompile
-	govKeeper govkeeper.Keeper
+	govKeeper  govkeeper.Keeper
+	bankKeeper bankkeeper.Keeper
+	cdc        codec.Codec
 }
 
 // LoadABI loads the gov ABI from the embedded abi.json file
@@ -44,7 +48,9 @@ func LoadABI() (abi.ABI, error) {
 // PrecompiledContract interface.
 func NewPrecompile(
 	govKeeper govkeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
+	cdc codec.Codec,
 ) (*Precompile, error) {
 	abi, err := LoadABI()
 	if err != nil {
@@ -59,7 +65,9 @@ func NewPrecompile(
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
 		},
-		govKeeper: govKeeper,
+		govKeeper:  govKeeper,
+		bankKeeper: bankKeeper,
+		cdc:        cdc,
 	}
 
 	// SetAddress defines the address of the gov precompiled contract.
@@ -106,6 +114,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Vote(ctx, evm.Origin, contract, stateDB, method, args)
 	case VoteWeightedMethod:
 		bz, err = p.VoteWeighted(ctx, evm.Origin, contract, stateDB, method, args)
+	case SubmitProposalMethod:
+		bz, err = p.SubmitProposal(ctx, evm.Origin, contract, stateDB, method, args)
+	case DepositMethod:
+		bz, err = p.Deposit(ctx, evm.Origin, contract, stateDB, method, args)
+	case CancelProposalMethod:
+		bz, err = p.CancelProposal(ctx, evm.Origin, contract, stateDB, method, args)
 	// gov queries
 	case GetVoteMethod:
 		bz, err = p.GetVote(ctx, method, contract, args)
@@ -147,9 +161,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 // Available gov transactions are:
 //   - Vote
 //   - VoteWeighted
+//   - SubmitProposal
+//   - Deposit
+//   - CancelProposal
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case VoteMethod, VoteWeightedMethod:
+	case VoteMethod, VoteWeightedMethod, SubmitProposalMethod, DepositMethod, CancelProposalMethod:
 		return true
 	default:
 		return false
@@ -138,3 +138,52 @@ func (s *PrecompileTestSuite) TestRun() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestDepositFromContract() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	const proposalID uint64 = 1
+	deposit := big.NewInt(50)
+
+	// the depositor calls the precompile as a contract, so the origin of the
+	// transaction is a different account
+	depositor := s.keyring.GetAddr(0)
+
+	input, err := s.precompile.Pack(gov.DepositMethod, depositor, proposalID, deposit)
+	s.Require().NoError(err, "failed to pack input")
+
+	contract := vm.NewPrecompile(vm.AccountRef(depositor), s.precompile, big.NewInt(0), uint64(1e6))
+	contract.Input = input
+	contractAddr := contract.Address()
+
+	msg, err := s.factory.GenerateGethCoreMsg(s.keyring.GetPrivKey(1), evmtypes.EvmTxArgs{
+		ChainID:   evmtypes.GetEthChainConfig().ChainID,
+		To:        &contractAddr,
+		GasLimit:  100000,
+		GasFeeCap: s.network.App.EvmKeeper.GetBaseFee(ctx),
+		GasTipCap: big.NewInt(1),
+		Accesses:  &ethtypes.AccessList{},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(1), msg.From())
+
+	cfg, err := s.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err, "failed to instantiate EVM config")
+
+	stDB := statedb.New(
+		ctx,
+		s.network.App.EvmKeeper,
+		statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())),
+	)
+	evm := s.network.App.EvmKeeper.NewEVM(ctx, msg, cfg, nil, stDB)
+
+	// the balance of the contract is loaded in the stateDB before the call
+	prevBalance := stDB.GetBalance(depositor)
+
+	_, err = s.precompile.Run(evm, contract, false)
+	s.Require().NoError(err, "expected no error when running the precompile")
+
+	// the deposit is mirrored to the EVM balance of the contract
+	expDeposit := evmtypes.ConvertAmountTo18DecimalsBigInt(deposit)
+	s.Require().Equal(new(big.Int).Sub(prevBalance, expDeposit), stDB.GetBalance(depositor))
+}
@@ -4,15 +4,21 @@
 package gov
 
 import (
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 const (
@@ -20,6 +26,12 @@ const (
 	VoteMethod = "vote"
 	// VoteWeightedMethod defines the ABI method name for the gov VoteWeighted transaction.
 	VoteWeightedMethod = "voteWeighted"
+	// SubmitProposalMethod defines the ABI method name for the gov SubmitProposal transaction.
+	SubmitProposalMethod = "submitProposal"
+	// DepositMethod defines the ABI method name for the gov Deposit transaction.
+	DepositMethod = "deposit"
+	// CancelProposalMethod defines the ABI method name for the gov CancelProposal transaction.
+	CancelProposalMethod = "cancelProposal"
 )
 
 // Vote defines a method to add a vote on a specific proposal.
@@ -87,3 +99,184 @@ func (p Precompile) VoteWeighted(
 
 	return method.Outputs.Pack(true)
 }
+
+// SubmitProposal defines a method to submit a proposal with the given messages and
+// initial deposit. The messages are decoded from their protobuf encoding, so that
+// contracts such as DAOs can open proposals on-chain.
+func (p *Precompile) SubmitProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	denom, err := p.depositDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, proposerHexAddr, err := NewMsgSubmitProposal(args, p.cdc, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the proposer, we don't need an origin check
+	// Otherwise check if the origin matches the proposer address
+	isContractProposer := contract.CallerAddress == proposerHexAddr && contract.CallerAddress != origin
+	if !isContractProposer && origin != proposerHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginProposer, origin.String(), proposerHexAddr.String())
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	res, err := msgSrv.SubmitProposal(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	p.setDepositBalanceChange(origin, contract, proposerHexAddr, msg.InitialDeposit)
+
+	if err = p.EmitSubmitProposalEvent(ctx, stateDB, proposerHexAddr, res.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ProposalId)
+}
+
+// Deposit defines a method to add a deposit on a specific proposal.
+func (p *Precompile) Deposit(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	denom, err := p.depositDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, depositorHexAddr, err := NewMsgDeposit(args, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the depositor, we don't need an origin check
+	// Otherwise check if the origin matches the depositor address
+	isContractDepositor := contract.CallerAddress == depositorHexAddr && contract.CallerAddress != origin
+	if !isContractDepositor && origin != depositorHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginDepositor, origin.String(), depositorHexAddr.String())
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.Deposit(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	p.setDepositBalanceChange(origin, contract, depositorHexAddr, msg.Amount)
+
+	if err = p.EmitDepositEvent(ctx, stateDB, depositorHexAddr, msg.ProposalId, sdk.Coins(msg.Amount).AmountOf(denom).BigInt()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CancelProposal defines a method to cancel a proposal by its proposer. The
+// remaining deposits, after charging the cancellation fee, are refunded to the
+// depositors.
+func (p *Precompile) CancelProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgCancelProposal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the proposer, we don't need an origin check
+	// Otherwise check if the origin matches the proposer address
+	isContractProposer := contract.CallerAddress == proposerHexAddr && contract.CallerAddress != origin
+	if !isContractProposer && origin != proposerHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginProposer, origin.String(), proposerHexAddr.String())
+	}
+
+	// NOTE: the balances of the depositors are checked before and after the
+	// cancellation to mirror the refunded deposits to the EVM stateDB.
+	depositors, err := p.getDepositors(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	evmDenom := evmtypes.GetEVMCoinDenom()
+	prevBalances := make([]math.Int, len(depositors))
+	for i, depositor := range depositors {
+		prevBalances[i] = p.bankKeeper.GetBalance(ctx, depositor.Bytes(), evmDenom).Amount
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.CancelProposal(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	p.SetBalanceChangeEntries()
+	for i, depositor := range depositors {
+		refund := p.bankKeeper.GetBalance(ctx, depositor.Bytes(), evmDenom).Amount.Sub(prevBalances[i])
+		if refund.IsPositive() {
+			p.AddBalanceChangeEntries(cmn.NewBalanceChangeEntry(depositor, evmtypes.ConvertAmountTo18DecimalsBigInt(refund.BigInt()), cmn.Add))
+		}
+	}
+
+	if err = p.EmitCancelProposalEvent(ctx, stateDB, proposerHexAddr, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// depositDenom returns the denomination of the proposal deposits, which is the
+// denomination of the minimum deposit defined in the gov parameters.
+func (p Precompile) depositDenom(ctx sdk.Context) (string, error) {
+	params, err := p.govKeeper.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(params.MinDeposit) == 0 {
+		return "", errors.New(ErrNoMinDeposit)
+	}
+	return params.MinDeposit[0].Denom, nil
+}
+
+// getDepositors returns the addresses of the depositors of the given proposal.
+func (p Precompile) getDepositors(ctx sdk.Context, proposalID uint64) ([]common.Address, error) {
+	var depositors []common.Address
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	err := p.govKeeper.Deposits.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], _ govv1.Deposit) (bool, error) {
+		depositors = append(depositors, common.BytesToAddress(key.K2()))
+		return false, nil
+	})
+	return depositors, err
+}
+
+// setDepositBalanceChange mirrors the deposit of the EVM denomination paid by
+// the depositor to the EVM stateDB.
+//
+// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+// this happens when the precompile is called from a smart contract
+func (p *Precompile) setDepositBalanceChange(origin common.Address, contract *vm.Contract, depositor common.Address, deposit sdk.Coins) {
+	if contract.CallerAddress == origin {
+		return
+	}
+
+	amount := deposit.AmountOf(evmtypes.GetEVMCoinDenom())
+	if !amount.IsPositive() {
+		return
+	}
+	convertedAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(amount.BigInt())
+	p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(depositor, convertedAmount, cmn.Sub))
+}
@@ -2,16 +2,20 @@ package gov_test
 
 import (
 	"fmt"
+	"math/big"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/precompiles/gov"
 	"github.com/evmos/evmos/v20/precompiles/testutil"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	evmostypes "github.com/evmos/evmos/v20/types"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 )
 
@@ -276,3 +280,365 @@ func (s *PrecompileTestSuite) TestVoteWeighted() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestSubmitProposal() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.SubmitProposalMethod]
+	const (
+		metadata = "ipfs://CID"
+		title    = "test prop"
+		summary  = "test summary"
+	)
+
+	// encodeMsgs returns the protobuf encoding of the messages field of a MsgSubmitProposal
+	encodeMsgs := func(msgs []sdk.Msg) []byte {
+		proposal, err := govv1.NewMsgSubmitProposal(msgs, nil, "", "", "", "", false)
+		s.Require().NoError(err)
+		bz, err := s.network.App.AppCodec().Marshal(proposal)
+		s.Require().NoError(err)
+		return bz
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			"fail - invalid proposer address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					encodeMsgs(TestProposalMsgs),
+					big.NewInt(100),
+					metadata,
+					title,
+					summary,
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"invalid proposer",
+		},
+		{
+			"fail - using a different proposer address",
+			func() []interface{} {
+				return []interface{}{
+					utiltx.GenerateAddress(),
+					encodeMsgs(TestProposalMsgs),
+					big.NewInt(100),
+					metadata,
+					title,
+					summary,
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"does not match the proposer address",
+		},
+		{
+			"fail - invalid proposal messages encoding",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					[]byte{0xff, 0xff},
+					big.NewInt(100),
+					metadata,
+					title,
+					summary,
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"invalid proposal messages",
+		},
+		{
+			"fail - empty title",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					encodeMsgs(TestProposalMsgs),
+					big.NewInt(100),
+					metadata,
+					"",
+					summary,
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"proposal title cannot be empty",
+		},
+		{
+			"success - submit proposal with initial deposit",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					encodeMsgs(TestProposalMsgs),
+					big.NewInt(100),
+					metadata,
+					title,
+					summary,
+				}
+			},
+			func(data []byte) {
+				var proposalID uint64
+				err := s.precompile.UnpackIntoInterface(&proposalID, gov.SubmitProposalMethod, data)
+				s.Require().NoError(err)
+
+				proposal, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAccAddr(0).String(), proposal.Proposer)
+				s.Require().Equal(title, proposal.Title)
+				s.Require().Len(proposal.Messages, 1)
+				s.Require().Equal(govv1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD, proposal.Status)
+
+				deposit, err := s.network.App.GovKeeper.Deposits.Get(ctx, collections.Join(proposalID, s.keyring.GetAccAddr(0)))
+				s.Require().NoError(err)
+				s.Require().Equal(math.NewInt(100), sdk.Coins(deposit.Amount).AmountOf(evmostypes.BaseDenom))
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			bz, err := s.precompile.SubmitProposal(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDeposit() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.DepositMethod]
+	const proposalID uint64 = 1
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid deposit amount",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposalID,
+					big.NewInt(0),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid deposit",
+		},
+		{
+			"fail - using a different depositor address",
+			func() []interface{} {
+				return []interface{}{
+					utiltx.GenerateAddress(),
+					proposalID,
+					big.NewInt(50),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the depositor address",
+		},
+		{
+			"fail - proposal not found",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint64(10),
+					big.NewInt(50),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"not found",
+		},
+		{
+			"success - add deposit to proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposalID,
+					big.NewInt(50),
+				}
+			},
+			func() {
+				deposit, err := s.network.App.GovKeeper.Deposits.Get(ctx, collections.Join(proposalID, s.keyring.GetAccAddr(0)))
+				s.Require().NoError(err)
+				s.Require().Equal(math.NewInt(150), sdk.Coins(deposit.Amount).AmountOf(evmostypes.BaseDenom))
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			_, err := s.precompile.Deposit(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCancelProposal() {
+	var (
+		ctx         sdk.Context
+		prevBalance math.Int
+	)
+	method := s.precompile.Methods[gov.CancelProposalMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - using a different proposer address",
+			func() []interface{} {
+				return []interface{}{
+					utiltx.GenerateAddress(),
+					uint64(1),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the proposer address",
+		},
+		{
+			"fail - caller is not the proposer of the proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint64(2),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid proposer",
+		},
+		{
+			"success - cancel proposal and refund the remaining deposit",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint64(1),
+				}
+			},
+			func() {
+				_, err := s.network.App.GovKeeper.Proposals.Get(ctx, 1)
+				s.Require().Error(err, "expected proposal to be deleted")
+
+				params, err := s.network.App.GovKeeper.Params.Get(ctx)
+				s.Require().NoError(err)
+				cancelRate := math.LegacyMustNewDecFromStr(params.ProposalCancelRatio)
+				expRefund := math.NewInt(100).Sub(math.LegacyNewDec(100).Mul(cancelRate).TruncateInt())
+
+				balance := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), evmostypes.BaseDenom)
+				s.Require().Equal(prevBalance.Add(expRefund), balance.Amount)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			prevBalance = s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), evmostypes.BaseDenom).Amount
+
+			_, err := s.precompile.CancelProposal(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
@@ -5,7 +5,10 @@ package gov
 
 import (
 	"fmt"
+	"math/big"
 
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -31,6 +34,25 @@ type EventVoteWeighted struct {
 	Options    WeightedVoteOptions
 }
 
+// EventSubmitProposal defines the event data for the SubmitProposal transaction.
+type EventSubmitProposal struct {
+	Proposer   common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+}
+
+// EventDeposit defines the event data for the Deposit transaction.
+type EventDeposit struct {
+	Depositor  common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+	Amount     *big.Int
+}
+
+// EventCancelProposal defines the event data for the CancelProposal transaction.
+type EventCancelProposal struct {
+	Proposer   common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+}
+
 // VotesInput defines the input for the Votes query.
 type VotesInput struct {
 	ProposalId uint64 //nolint:revive,stylecheck
@@ -144,6 +166,118 @@ func NewMsgVote(args []interface{}) (*govv1.MsgVote, common.Address, error) {
 	return msg, voterAddress, nil
 }
 
+// NewMsgSubmitProposal creates a new MsgSubmitProposal instance. The proposal messages
+// are the protobuf encoding of the messages field of a MsgSubmitProposal, i.e. a list
+// of google.protobuf.Any, and the initial deposit is paid in the given denomination.
+func NewMsgSubmitProposal(args []interface{}, cdc codec.Codec, denom string) (*govv1.MsgSubmitProposal, common.Address, error) {
+	if len(args) != 6 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	proposerAddress, ok := args[0].(common.Address)
+	if !ok || proposerAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	protoMsgs, ok := args[1].([]byte)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalMsgs, args[1])
+	}
+
+	deposit, ok := args[2].(*big.Int)
+	if !ok || deposit == nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDeposit, args[2])
+	}
+
+	metadata, ok := args[3].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMetadata, args[3])
+	}
+
+	title, ok := args[4].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidTitle, args[4])
+	}
+
+	summary, ok := args[5].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSummary, args[5])
+	}
+
+	// NOTE: the messages are decoded into a MsgSubmitProposal so that the codec
+	// unpacks the Any messages, which is required to execute the proposal.
+	var proposal govv1.MsgSubmitProposal
+	if err := cdc.Unmarshal(protoMsgs, &proposal); err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalMsgs, err)
+	}
+
+	msg := &govv1.MsgSubmitProposal{
+		Messages:       proposal.Messages,
+		InitialDeposit: sdk.NewCoins(sdk.NewCoin(denom, math.NewIntFromBigInt(deposit))),
+		Proposer:       sdk.AccAddress(proposerAddress.Bytes()).String(),
+		Metadata:       metadata,
+		Title:          title,
+		Summary:        summary,
+	}
+
+	return msg, proposerAddress, nil
+}
+
+// NewMsgDeposit creates a new MsgDeposit instance with the deposit paid in the
+// given denomination.
+func NewMsgDeposit(args []interface{}, denom string) (*govv1.MsgDeposit, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	depositorAddress, ok := args[0].(common.Address)
+	if !ok || depositorAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDepositor, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	amount, ok := args[2].(*big.Int)
+	if !ok || amount == nil || amount.Sign() <= 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDeposit, args[2])
+	}
+
+	msg := &govv1.MsgDeposit{
+		ProposalId: proposalID,
+		Depositor:  sdk.AccAddress(depositorAddress.Bytes()).String(),
+		Amount:     sdk.NewCoins(sdk.NewCoin(denom, math.NewIntFromBigInt(amount))),
+	}
+
+	return msg, depositorAddress, nil
+}
+
+// NewMsgCancelProposal creates a new MsgCancelProposal instance.
+func NewMsgCancelProposal(args []interface{}) (*govv1.MsgCancelProposal, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	proposerAddress, ok := args[0].(common.Address)
+	if !ok || proposerAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	msg := &govv1.MsgCancelProposal{
+		ProposalId: proposalID,
+		Proposer:   sdk.AccAddress(proposerAddress.Bytes()).String(),
+	}
+
+	return msg, proposerAddress, nil
+}
+
 // NewMsgVoteWeighted creates a new MsgVoteWeighted instance.
 func NewMsgVoteWeighted(method *abi.Method, args []interface{}) (*govv1.MsgVoteWeighted, common.Address, WeightedVoteOptions, error) {
 	if len(args) != 4 {
@@ -20,6 +20,17 @@ struct DenomTrace {
     string baseDenom;
 }
 
+/// @dev ForwardHop defines an intermediate chain of a multi-hop transfer routed by
+/// the packet forward middleware.
+struct ForwardHop {
+    // receiver of the tokens on the intermediate chain
+    string receiver;
+    // port on the intermediate chain through which the tokens are forwarded
+    string port;
+    // channel on the intermediate chain through which the tokens are forwarded
+    string channel;
+}
+
 /// @author Evmos Team
 /// @title ICS20 Transfer Precompiled Contract
 /// @dev The interface through which solidity contracts will interact with IBC Transfer (ICS20)
@@ -68,6 +79,63 @@ interface ICS20I is IICS20Authorization {
         string memory memo
     ) external returns (uint64 nextSequence);
 
+    /// @dev TransferWithHook defines a method for performing an IBC transfer that calls
+    /// a contract on the destination chain through the IBC hooks middleware. The memo
+    /// {"wasm":{"contract":<contractAddress>,"msg":<hookMsg>}} is built on-chain and the
+    /// contract is used as the receiver of the transfer.
+    /// @param sourcePort the port on which the packet will be sent
+    /// @param sourceChannel the channel by which the packet will be sent
+    /// @param denom the denomination of the Coin to be transferred to the contract
+    /// @param amount the amount of the Coin to be transferred to the contract
+    /// @param sender the hex address of the sender
+    /// @param contractAddress the bech32 address of the contract on the destination chain
+    /// @param hookMsg the JSON object message to execute on the contract
+    /// @param timeoutHeight the timeout height relative to the current block height.
+    /// The timeout is disabled when set to 0
+    /// @param timeoutTimestamp the timeout timestamp in absolute nanoseconds since unix epoch.
+    /// The timeout is disabled when set to 0
+    /// @return nextSequence sequence number of the transfer packet sent
+    function transferWithHook(
+        string memory sourcePort,
+        string memory sourceChannel,
+        string memory denom,
+        uint256 amount,
+        address sender,
+        string memory contractAddress,
+        string memory hookMsg,
+        Height memory timeoutHeight,
+        uint64 timeoutTimestamp
+    ) external returns (uint64 nextSequence);
+
+    /// @dev TransferWithForward defines a method for performing a multi-hop IBC transfer.
+    /// The tokens are sent to the receiver of the first hop, and the packet forward
+    /// middleware memo built on-chain routes them through the remaining hops to the receiver.
+    /// @param sourcePort the port on which the packet will be sent
+    /// @param sourceChannel the channel by which the packet will be sent
+    /// @param denom the denomination of the Coin to be transferred
+    /// @param amount the amount of the Coin to be transferred
+    /// @param sender the hex address of the sender
+    /// @param hops the intermediate chains the tokens are routed through, in order
+    /// @param receiver the bech32 address of the receiver on the destination chain
+    /// @param timeoutHeight the timeout height relative to the current block height.
+    /// The timeout is disabled when set to 0
+    /// @param timeoutTimestamp the timeout timestamp in absolute nanoseconds since unix epoch.
+    /// The timeout is disabled when set to 0
+    /// @param memo optional JSON object memo of the packet received by the receiver
+    /// @return nextSequence sequence number of the transfer packet sent
+    function transferWithForward(
+        string memory sourcePort,
+        string memory sourceChannel,
+        string memory denom,
+        uint256 amount,
+        address sender,
+        ForwardHop[] memory hops,
+        string memory receiver,
+        Height memory timeoutHeight,
+        uint64 timeoutTimestamp,
+        string memory memo
+    ) external returns (uint64 nextSequence);
+
     /// @dev DenomTraces Defines a method for returning all denom traces.
     /// @param pageRequest Defines the pagination parameters to for the request.
     function denomTraces(
@@ -90,4 +158,45 @@ interface ICS20I is IICS20Authorization {
         string memory trace
     ) external view returns (string memory hash);
 
+    /// @dev EscrowAddress defines a method for returning the address of the account
+    /// that escrows the native tokens sent through a channel.
+    /// @param portId The port identifier of the channel.
+    /// @param channelId The channel identifier.
+    /// @return escrowAddress The address of the escrow account.
+    function escrowAddress(
+        string memory portId,
+        string memory channelId
+    ) external view returns (address escrowAddress);
+
+    /// @dev EscrowBalance defines a method for returning the balance of a denomination
+    /// held by the escrow account of a channel.
+    /// @param portId The port identifier of the channel.
+    /// @param channelId The channel identifier.
+    /// @param denom The denomination of the escrowed tokens.
+    /// @return amount The escrowed amount.
+    function escrowBalance(
+        string memory portId,
+        string memory channelId,
+        string memory denom
+    ) external view returns (uint256 amount);
+
+    /// @dev TotalEscrow defines a method for returning the total amount of a
+    /// denomination escrowed across all the channels.
+    /// @param denom The denomination of the escrowed tokens.
+    /// @return amount The total escrowed amount.
+    function totalEscrow(
+        string memory denom
+    ) external view returns (uint256 amount);
+
+    /// @dev RateLimit defines a method for returning the remaining quota of the
+    /// IBC rate limit of a denomination on a channel within the current window.
+    /// @param channelId The channel identifier.
+    /// @param denom The denomination of the transferred tokens on this chain.
+    /// @return limited Whether the transfers are rate limited.
+    /// @return send The amount that can still be sent through the channel.
+    /// @return recv The amount that can still be received through the channel.
+    function rateLimit(
+        string memory channelId,
+        string memory denom
+    ) external view returns (bool limited, uint256 send, uint256 recv);
 }
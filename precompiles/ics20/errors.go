@@ -16,6 +16,16 @@ const (
 	ErrInvalidTimeoutTimestamp = "invalid timeout timestamp: %d"
 	// ErrInvalidMemo is raised when the memo is invalid.
 	ErrInvalidMemo = "invalid memo: %s"
+	// ErrInvalidHookContract is raised when the contract address of an IBC hooks memo is invalid.
+	ErrInvalidHookContract = "invalid hook contract: %s"
+	// ErrInvalidHookMsg is raised when the contract message of an IBC hooks memo is not a JSON object.
+	ErrInvalidHookMsg = "invalid hook msg: %s"
+	// ErrInvalidForwardHops is raised when the hops of a multi-hop transfer are invalid.
+	ErrInvalidForwardHops = "invalid forward hops: %s"
+	// ErrInvalidPortID is raised when the port identifier of an escrow account is invalid.
+	ErrInvalidPortID = "invalid port id: %v"
+	// ErrInvalidChannelID is raised when the channel identifier of an escrow account is invalid.
+	ErrInvalidChannelID = "invalid channel id: %v"
 	// ErrInvalidHash is raised when the hash is invalid.
 	ErrInvalidHash = "invalid hash: %s"
 	// ErrNoMatchingAllocation is raised when no matching allocation is found.
@@ -9,6 +9,8 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -32,9 +34,11 @@ var f embed.FS
 
 type Precompile struct {
 	cmn.Precompile
-	stakingKeeper  stakingkeeper.Keeper
-	transferKeeper transferkeeper.Keeper
-	channelKeeper  channelkeeper.Keeper
+	stakingKeeper   stakingkeeper.Keeper
+	transferKeeper  transferkeeper.Keeper
+	channelKeeper   channelkeeper.Keeper
+	rateLimitKeeper ratelimitkeeper.Keeper
+	bankKeeper      bankkeeper.Keeper
 }
 
 // NewPrecompile creates a new ICS-20 Precompile instance as a
@@ -43,6 +47,8 @@ func NewPrecompile(
 	stakingKeeper stakingkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
+	rateLimitKeeper ratelimitkeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 ) (*Precompile, error) {
 	newAbi, err := cmn.LoadABI(f, "abi.json")
@@ -57,10 +63,17 @@ func NewPrecompile(
 			KvGasConfig:          storetypes.KVGasConfig(),
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+			ReentrancyGuard: cmn.NewReentrancyGuard(
+				TransferMethod,
+				TransferWithHookMethod,
+				TransferWithForwardMethod,
+			),
 		},
-		transferKeeper: transferKeeper,
-		channelKeeper:  channelKeeper,
-		stakingKeeper:  stakingKeeper,
+		transferKeeper:  transferKeeper,
+		channelKeeper:   channelKeeper,
+		rateLimitKeeper: rateLimitKeeper,
+		stakingKeeper:   stakingKeeper,
+		bankKeeper:      bankKeeper,
 	}
 
 	// SetAddress defines the address of the ICS-20 compile contract.
@@ -112,6 +125,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// ICS20 transactions
 	case TransferMethod:
 		bz, err = p.Transfer(ctx, evm.Origin, contract, stateDB, method, args)
+	case TransferWithHookMethod:
+		bz, err = p.TransferWithHook(ctx, evm.Origin, contract, stateDB, method, args)
+	case TransferWithForwardMethod:
+		bz, err = p.TransferWithForward(ctx, evm.Origin, contract, stateDB, method, args)
 	// ICS20 queries
 	case DenomTraceMethod:
 		bz, err = p.DenomTrace(ctx, contract, method, args)
@@ -119,6 +136,14 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.DenomTraces(ctx, contract, method, args)
 	case DenomHashMethod:
 		bz, err = p.DenomHash(ctx, contract, method, args)
+	case EscrowAddressMethod:
+		bz, err = p.EscrowAddress(ctx, contract, method, args)
+	case EscrowBalanceMethod:
+		bz, err = p.EscrowBalance(ctx, contract, method, args)
+	case TotalEscrowMethod:
+		bz, err = p.TotalEscrow(ctx, contract, method, args)
+	case RateLimitMethod:
+		bz, err = p.RateLimit(ctx, contract, method, args)
 	case authorization.AllowanceMethod:
 		bz, err = p.Allowance(ctx, method, args)
 	default:
@@ -146,6 +171,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //
 // Available ics20 transactions are:
 //   - Transfer
+//   - TransferWithHook
+//   - TransferWithForward
 //
 // Available authorization transactions are:
 //   - Approve
@@ -155,6 +182,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case TransferMethod,
+		TransferWithHookMethod,
+		TransferWithForwardMethod,
 		authorization.ApproveMethod,
 		authorization.RevokeMethod,
 		authorization.IncreaseAllowanceMethod,
@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
@@ -25,6 +26,18 @@ const (
 	// DenomHashMethod defines the ABI method name for the ICS20 DenomHash
 	// query.
 	DenomHashMethod = "denomHash"
+	// EscrowAddressMethod defines the ABI method name for the ICS20 escrow
+	// address query.
+	EscrowAddressMethod = "escrowAddress"
+	// EscrowBalanceMethod defines the ABI method name for the ICS20 escrowed
+	// balance query of a channel.
+	EscrowBalanceMethod = "escrowBalance"
+	// TotalEscrowMethod defines the ABI method name for the ICS20
+	// TotalEscrowForDenom query.
+	TotalEscrowMethod = "totalEscrow"
+	// RateLimitMethod defines the ABI method name for the query of the
+	// remaining quota of the IBC rate limit of a denomination on a channel.
+	RateLimitMethod = "rateLimit"
 )
 
 // DenomTrace returns the requested denomination trace information.
@@ -95,6 +108,95 @@ func (p Precompile) DenomHash(
 	return method.Outputs.Pack(res.Hash)
 }
 
+// EscrowAddress returns the address of the account escrowing the native tokens
+// sent through the given port and channel.
+func (p Precompile) EscrowAddress(
+	_ sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	portID, channelID, err := NewEscrowAddressRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	escrowAddress := transfertypes.GetEscrowAddress(portID, channelID)
+	return method.Outputs.Pack(common.BytesToAddress(escrowAddress))
+}
+
+// EscrowBalance returns the balance of the given denomination held by the escrow
+// account of the given port and channel.
+func (p Precompile) EscrowBalance(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	portID, channelID, denom, err := NewEscrowBalanceRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	escrowAddress := transfertypes.GetEscrowAddress(portID, channelID)
+	balance := p.bankKeeper.GetBalance(ctx, escrowAddress, denom)
+
+	return method.Outputs.Pack(balance.Amount.BigInt())
+}
+
+// TotalEscrow returns the total amount of the given denomination escrowed
+// across all the channels.
+func (p Precompile) TotalEscrow(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewTotalEscrowRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.transferKeeper.TotalEscrowForDenom(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Amount.Amount.BigInt())
+}
+
+// RateLimit returns whether the transfers of the given denomination through the
+// given channel are rate limited and the amounts that can still be sent and
+// received within the current window of the rate limit. The transfers of a
+// blacklisted denomination are denied, so that nothing can be sent or received.
+func (p Precompile) RateLimit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	channelID, denom, err := NewRateLimitRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.rateLimitKeeper.IsDenomBlacklisted(ctx, denom) {
+		return method.Outputs.Pack(true, common.Big0, common.Big0)
+	}
+
+	rateLimit, found := p.rateLimitKeeper.GetRateLimit(ctx, denom, channelID)
+	// the quota is not enforced without a supply of the denomination
+	if !found || rateLimit.Flow.ChannelValue.IsZero() {
+		return method.Outputs.Pack(false, common.Big0, common.Big0)
+	}
+
+	flow := rateLimit.Flow
+	send := remainingQuota(flow.ChannelValue, rateLimit.Quota.MaxPercentSend, flow.Outflow.Sub(flow.Inflow))
+	recv := remainingQuota(flow.ChannelValue, rateLimit.Quota.MaxPercentRecv, flow.Inflow.Sub(flow.Outflow))
+
+	return method.Outputs.Pack(true, send.BigInt(), recv.BigInt())
+}
+
 // Allowance returns the remaining allowance of for a combination of grantee - granter.
 // The grantee is the smart contract that was authorized by the granter to spend.
 func (p Precompile) Allowance(
@@ -0,0 +1,390 @@
+package ics20_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/ics20"
+	"github.com/evmos/evmos/v20/testutil"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestEscrowAddress() {
+	method := s.precompile.Methods[ics20.EscrowAddressMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			[]interface{}{transfertypes.PortID},
+			true,
+			"invalid number of arguments",
+		},
+		{
+			"fail - invalid port",
+			[]interface{}{"", "channel-0"},
+			true,
+			"invalid port id",
+		},
+		{
+			"fail - invalid channel",
+			[]interface{}{transfertypes.PortID, "channel"},
+			true,
+			"invalid channel id",
+		},
+		{
+			"pass",
+			[]interface{}{transfertypes.PortID, "channel-0"},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 100_000)
+
+			bz, err := s.precompile.EscrowAddress(ctx, contract, &method, tc.args)
+
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			expAddress := common.BytesToAddress(transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0"))
+			s.Require().Equal(expAddress, out[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestEscrowBalance() {
+	method := s.precompile.Methods[ics20.EscrowBalanceMethod]
+	denom := s.network.GetDenom()
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context)
+		args        []interface{}
+		expErr      bool
+		errContains string
+		expBalance  *big.Int
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(sdk.Context) {},
+			[]interface{}{transfertypes.PortID, "channel-0"},
+			true,
+			"invalid number of arguments",
+			nil,
+		},
+		{
+			"fail - invalid channel",
+			func(sdk.Context) {},
+			[]interface{}{transfertypes.PortID, "channel", denom},
+			true,
+			"invalid channel id",
+			nil,
+		},
+		{
+			"fail - invalid denom",
+			func(sdk.Context) {},
+			[]interface{}{transfertypes.PortID, "channel-0", "1denom"},
+			true,
+			"invalid denom",
+			nil,
+		},
+		{
+			"pass - empty escrow",
+			func(sdk.Context) {},
+			[]interface{}{transfertypes.PortID, "channel-0", denom},
+			false,
+			"",
+			big.NewInt(0),
+		},
+		{
+			"pass - escrowed balance of the channel",
+			func(ctx sdk.Context) {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+				s.Require().NoError(testutil.FundAccount(ctx, s.network.App.BankKeeper, escrow, coins))
+				// the escrow of other channels is not included
+				other := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-1")
+				s.Require().NoError(testutil.FundAccount(ctx, s.network.App.BankKeeper, other, coins))
+			},
+			[]interface{}{transfertypes.PortID, "channel-0", denom},
+			false,
+			"",
+			big.NewInt(100),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			tc.malleate(ctx)
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 100_000)
+
+			bz, err := s.precompile.EscrowBalance(ctx, contract, &method, tc.args)
+
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expBalance, out[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestTotalEscrow() {
+	method := s.precompile.Methods[ics20.TotalEscrowMethod]
+	denom := s.network.GetDenom()
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context)
+		args        []interface{}
+		expErr      bool
+		errContains string
+		expTotal    *big.Int
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(sdk.Context) {},
+			[]interface{}{},
+			true,
+			"invalid number of arguments",
+			nil,
+		},
+		{
+			"fail - invalid denom",
+			func(sdk.Context) {},
+			[]interface{}{"1denom"},
+			true,
+			"invalid denom",
+			nil,
+		},
+		{
+			"pass - nothing escrowed",
+			func(sdk.Context) {},
+			[]interface{}{denom},
+			false,
+			"",
+			big.NewInt(0),
+		},
+		{
+			"pass - total escrowed across the channels",
+			func(ctx sdk.Context) {
+				s.network.App.TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin(denom, math.NewInt(250)))
+			},
+			[]interface{}{denom},
+			false,
+			"",
+			big.NewInt(250),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			tc.malleate(ctx)
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 100_000)
+
+			bz, err := s.precompile.TotalEscrow(ctx, contract, &method, tc.args)
+
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expTotal, out[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRateLimit() {
+	method := s.precompile.Methods[ics20.RateLimitMethod]
+	denom := "uatom"
+	channelID := "channel-0"
+
+	// setRateLimit stores a rate limit of 10% of the sends and 20% of the
+	// receives of the channel value with the given flow.
+	setRateLimit := func(ctx sdk.Context, channelValue, inflow, outflow int64) {
+		s.network.App.RateLimitKeeper.SetRateLimit(ctx, ratelimittypes.RateLimit{
+			Path: &ratelimittypes.Path{Denom: denom, ChannelId: channelID},
+			Quota: &ratelimittypes.Quota{
+				MaxPercentSend: math.NewInt(10),
+				MaxPercentRecv: math.NewInt(20),
+				DurationHours:  24,
+			},
+			Flow: &ratelimittypes.Flow{
+				Inflow:       math.NewInt(inflow),
+				Outflow:      math.NewInt(outflow),
+				ChannelValue: math.NewInt(channelValue),
+			},
+		})
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context)
+		args        []interface{}
+		expErr      bool
+		errContains string
+		expLimited  bool
+		expSend     *big.Int
+		expRecv     *big.Int
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(sdk.Context) {},
+			[]interface{}{channelID},
+			true,
+			"invalid number of arguments",
+			false, nil, nil,
+		},
+		{
+			"fail - invalid channel",
+			func(sdk.Context) {},
+			[]interface{}{"ch", denom},
+			true,
+			"invalid channel",
+			false, nil, nil,
+		},
+		{
+			"fail - invalid denom",
+			func(sdk.Context) {},
+			[]interface{}{channelID, "1denom"},
+			true,
+			"invalid denom",
+			false, nil, nil,
+		},
+		{
+			"pass - not rate limited",
+			func(sdk.Context) {},
+			[]interface{}{channelID, denom},
+			false,
+			"",
+			false, big.NewInt(0), big.NewInt(0),
+		},
+		{
+			"pass - not rate limited without a channel value",
+			func(ctx sdk.Context) {
+				setRateLimit(ctx, 0, 0, 0)
+			},
+			[]interface{}{channelID, denom},
+			false,
+			"",
+			false, big.NewInt(0), big.NewInt(0),
+		},
+		{
+			"pass - blacklisted denom",
+			func(ctx sdk.Context) {
+				s.network.App.RateLimitKeeper.AddDenomToBlacklist(ctx, denom)
+			},
+			[]interface{}{channelID, denom},
+			false,
+			"",
+			true, big.NewInt(0), big.NewInt(0),
+		},
+		{
+			"pass - full quota",
+			func(ctx sdk.Context) {
+				setRateLimit(ctx, 1000, 0, 0)
+			},
+			[]interface{}{channelID, denom},
+			false,
+			"",
+			true, big.NewInt(100), big.NewInt(200),
+		},
+		{
+			"pass - partially used quota",
+			func(ctx sdk.Context) {
+				setRateLimit(ctx, 1000, 10, 60)
+			},
+			[]interface{}{channelID, denom},
+			false,
+			"",
+			true, big.NewInt(50), big.NewInt(250),
+		},
+		{
+			"pass - exhausted send quota",
+			func(ctx sdk.Context) {
+				setRateLimit(ctx, 1000, 0, 150)
+			},
+			[]interface{}{channelID, denom},
+			false,
+			"",
+			true, big.NewInt(0), big.NewInt(350),
+		},
+		{
+			"pass - exhausted receive quota",
+			func(ctx sdk.Context) {
+				setRateLimit(ctx, 1000, 200, 0)
+			},
+			[]interface{}{channelID, denom},
+			false,
+			"",
+			true, big.NewInt(300), big.NewInt(0),
+		},
+		{
+			"pass - quota reset by a new epoch",
+			func(ctx sdk.Context) {
+				setRateLimit(ctx, 1000, 200, 150)
+				// the channel value is reset to the supply of the denom
+				err := s.network.App.RateLimitKeeper.ResetRateLimit(ctx, denom, channelID)
+				s.Require().NoError(err)
+			},
+			[]interface{}{channelID, denom},
+			false,
+			"",
+			true, big.NewInt(100), big.NewInt(200),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			err := testutil.FundAccount(ctx, s.network.App.BankKeeper, s.keyring.GetAccAddr(0), sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)))
+			s.Require().NoError(err)
+			tc.malleate(ctx)
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 100_000)
+
+			bz, err := s.precompile.RateLimit(ctx, contract, &method, tc.args)
+
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expLimited, out[0])
+			s.Require().Equal(tc.expSend, out[1])
+			s.Require().Equal(tc.expRecv, out[2])
+		})
+	}
+}
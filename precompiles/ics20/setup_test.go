@@ -0,0 +1,44 @@
+package ics20_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v20/precompiles/ics20"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/suite"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *ics20.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	precompile, err := ics20.NewPrecompile(
+		nw.App.StakingKeeper,
+		nw.App.TransferKeeper,
+		nw.App.IBCKeeper.ChannelKeeper,
+		nw.App.RateLimitKeeper,
+		nw.App.BankKeeper,
+		nw.App.AuthzKeeper,
+	)
+	s.Require().NoError(err)
+
+	s.network = nw
+	s.keyring = keyring
+	s.precompile = precompile
+}
@@ -24,6 +24,12 @@ const (
 	// TransferMethod defines the ABI method name for the ICS20 Transfer
 	// transaction.
 	TransferMethod = "transfer"
+	// TransferWithHookMethod defines the ABI method name for the ICS20
+	// TransferWithHook transaction.
+	TransferWithHookMethod = "transferWithHook"
+	// TransferWithForwardMethod defines the ABI method name for the ICS20
+	// TransferWithForward transaction.
+	TransferWithForwardMethod = "transferWithForward"
 )
 
 // Transfer implements the ICS20 transfer transactions.
@@ -40,6 +46,59 @@ func (p *Precompile) Transfer(
 		return nil, err
 	}
 
+	return p.transfer(ctx, origin, contract, stateDB, method, msg, sender)
+}
+
+// TransferWithHook implements the ICS20 transfer transactions that call a
+// contract on the destination chain through the IBC hooks middleware. The
+// hooks memo is built from the contract address and the contract message, so
+// that callers do not need to build the memo JSON themselves.
+func (p *Precompile) TransferWithHook(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, sender, err := NewMsgTransferWithHook(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.transfer(ctx, origin, contract, stateDB, method, msg, sender)
+}
+
+// TransferWithForward implements the ICS20 multi-hop transfer transactions. The
+// tokens are routed through the given intermediate chains to the final receiver
+// by the packet forward middleware, using the forward memo built from the hops.
+func (p *Precompile) TransferWithForward(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, sender, err := NewMsgTransferWithForward(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.transfer(ctx, origin, contract, stateDB, method, msg, sender)
+}
+
+// transfer executes the given ICS20 transfer message on behalf of the sender
+// and returns the sequence of the transfer packet.
+func (p *Precompile) transfer(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *transfertypes.MsgTransfer,
+	sender common.Address,
+) ([]byte, error) {
 	// check if channel exists and is open
 	if !p.channelKeeper.HasChannel(ctx, msg.SourcePort, msg.SourceChannel) {
 		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.SourcePort, msg.SourceChannel)
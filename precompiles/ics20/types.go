@@ -4,9 +4,12 @@
 package ics20
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -19,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/precompiles/authorization"
@@ -34,6 +38,9 @@ const (
 
 	// DefaultTimeoutMinutes is the default value in minutes used to set a timeout timestamp
 	DefaultTimeoutMinutes = 10
+
+	// MaxForwardHops is the maximum number of intermediate chains of a multi-hop transfer
+	MaxForwardHops = 8
 )
 
 // DefaultTimeoutHeight is the default value used to set a timeout height
@@ -166,6 +173,315 @@ func NewMsgTransfer(method *abi.Method, args []interface{}) (*transfertypes.MsgT
 	return msg, sender, nil
 }
 
+// NewMsgTransferWithHook returns a new transfer message from the given arguments of the
+// transferWithHook method. The receiver of the transfer is the contract to be called
+// on the destination chain, and the memo is the IBC hooks memo built from the contract
+// address and the contract message.
+func NewMsgTransferWithHook(method *abi.Method, args []interface{}) (*transfertypes.MsgTransfer, common.Address, error) {
+	if len(args) != 9 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 9, len(args))
+	}
+
+	sourcePort, ok := args[0].(string)
+	if !ok {
+		return nil, common.Address{}, errors.New(ErrInvalidSourcePort)
+	}
+
+	sourceChannel, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, errors.New(ErrInvalidSourceChannel)
+	}
+
+	denom, ok := args[2].(string)
+	if !ok {
+		return nil, common.Address{}, errorsmod.Wrapf(transfertypes.ErrInvalidDenomForTransfer, cmn.ErrInvalidDenom, args[2])
+	}
+
+	amount, ok := args[3].(*big.Int)
+	if !ok || amount == nil {
+		return nil, common.Address{}, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, cmn.ErrInvalidAmount, args[3])
+	}
+
+	sender, ok := args[4].(common.Address)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSender, args[4])
+	}
+
+	contractAddress, ok := args[5].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidHookContract, args[5])
+	}
+
+	hookMsg, ok := args[6].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidHookMsg, args[6])
+	}
+
+	var input height
+	heightArg := abi.Arguments{method.Inputs[7]}
+	if err := heightArg.Copy(&input, []interface{}{args[7]}); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to TransferInput struct: %s", err)
+	}
+
+	timeoutTimestamp, ok := args[8].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidTimeoutTimestamp, args[8])
+	}
+
+	memo, err := BuildWasmHookMemo(contractAddress, hookMsg)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	// Use instance to prevent errors on denom or amount
+	token := sdk.Coin{
+		Denom:  denom,
+		Amount: math.NewIntFromBigInt(amount),
+	}
+
+	// NOTE: the IBC hooks middleware requires the receiver of the transfer to be
+	// the contract that is called with the memo.
+	msg, err := CreateAndValidateMsgTransfer(sourcePort, sourceChannel, token, sdk.AccAddress(sender.Bytes()).String(), contractAddress, input.TimeoutHeight, timeoutTimestamp, memo)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, sender, nil
+}
+
+// wasmHookMemo defines the memo of an ICS-20 transfer that triggers a contract
+// call on the destination chain through the IBC hooks middleware.
+type wasmHookMemo struct {
+	Wasm wasmHook `json:"wasm"`
+}
+
+// wasmHook defines the contract and message of a contract call triggered by
+// the IBC hooks middleware.
+type wasmHook struct {
+	Contract string          `json:"contract"`
+	Msg      json.RawMessage `json:"msg"`
+}
+
+// BuildWasmHookMemo returns the IBC hooks memo JSON that calls the given contract
+// with the given message on the destination chain, i.e.
+// {"wasm":{"contract":"<contract>","msg":<msg>}}. The message must be a JSON object.
+func BuildWasmHookMemo(contractAddress, hookMsg string) (string, error) {
+	if strings.TrimSpace(contractAddress) == "" {
+		return "", fmt.Errorf(ErrInvalidHookContract, contractAddress)
+	}
+
+	msg, err := compactJSONObject(hookMsg)
+	if err != nil {
+		return "", fmt.Errorf(ErrInvalidHookMsg, err.Error())
+	}
+
+	bz, err := json.Marshal(wasmHookMemo{
+		Wasm: wasmHook{
+			Contract: contractAddress,
+			Msg:      msg,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+// NewMsgTransferWithForward returns a new transfer message from the given arguments
+// of the transferWithForward method. The transfer is sent to the receiver of the first
+// hop, and the packet forward middleware memo routes the tokens through the remaining
+// hops to the final receiver.
+func NewMsgTransferWithForward(method *abi.Method, args []interface{}) (*transfertypes.MsgTransfer, common.Address, error) {
+	if len(args) != 10 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 10, len(args))
+	}
+
+	sourcePort, ok := args[0].(string)
+	if !ok {
+		return nil, common.Address{}, errors.New(ErrInvalidSourcePort)
+	}
+
+	sourceChannel, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, errors.New(ErrInvalidSourceChannel)
+	}
+
+	denom, ok := args[2].(string)
+	if !ok {
+		return nil, common.Address{}, errorsmod.Wrapf(transfertypes.ErrInvalidDenomForTransfer, cmn.ErrInvalidDenom, args[2])
+	}
+
+	amount, ok := args[3].(*big.Int)
+	if !ok || amount == nil {
+		return nil, common.Address{}, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, cmn.ErrInvalidAmount, args[3])
+	}
+
+	sender, ok := args[4].(common.Address)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSender, args[4])
+	}
+
+	var hopsInput forwardHops
+	hopsArg := abi.Arguments{method.Inputs[5]}
+	if err := hopsArg.Copy(&hopsInput, []interface{}{args[5]}); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to forwardHops struct: %s", err)
+	}
+
+	receiver, ok := args[6].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidReceiver, args[6])
+	}
+
+	var input height
+	heightArg := abi.Arguments{method.Inputs[7]}
+	if err := heightArg.Copy(&input, []interface{}{args[7]}); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to TransferInput struct: %s", err)
+	}
+
+	timeoutTimestamp, ok := args[8].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidTimeoutTimestamp, args[8])
+	}
+
+	finalMemo, ok := args[9].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMemo, args[9])
+	}
+
+	memo, err := BuildForwardMemo(hopsInput.Hops, receiver, finalMemo)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	// Use instance to prevent errors on denom or amount
+	token := sdk.Coin{
+		Denom:  denom,
+		Amount: math.NewIntFromBigInt(amount),
+	}
+
+	// NOTE: the packet is first received by the intermediate receiver of the first
+	// hop, from where the packet forward middleware forwards the tokens.
+	msg, err := CreateAndValidateMsgTransfer(sourcePort, sourceChannel, token, sdk.AccAddress(sender.Bytes()).String(), hopsInput.Hops[0].Receiver, input.TimeoutHeight, timeoutTimestamp, memo)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, sender, nil
+}
+
+// ForwardHop defines an intermediate chain of a multi-hop ICS-20 transfer. The
+// tokens are received by the receiver on the intermediate chain, and forwarded
+// through the given port and channel of that chain.
+type ForwardHop struct {
+	Receiver string
+	Port     string
+	Channel  string
+}
+
+// forwardHops is a struct used to parse the hops parameter of the
+// transferWithForward method.
+type forwardHops struct {
+	Hops []ForwardHop
+}
+
+// forwardMemo defines the memo of an ICS-20 transfer that is forwarded by the
+// packet forward middleware of the receiving chain.
+type forwardMemo struct {
+	Forward forwardMetadata `json:"forward"`
+}
+
+// forwardMetadata defines where the packet forward middleware forwards the
+// received tokens to, and the memo of the forwarded packet.
+type forwardMetadata struct {
+	Receiver string          `json:"receiver"`
+	Port     string          `json:"port"`
+	Channel  string          `json:"channel"`
+	Next     json.RawMessage `json:"next,omitempty"`
+}
+
+// BuildForwardMemo returns the packet forward middleware memo JSON that routes
+// the tokens through the given hops to the final receiver. The memo of each hop
+// is nested in the "next" field of the previous one, i.e.
+// {"forward":{"receiver":"<receiver>","port":"<port>","channel":"<channel>","next":{...}}}.
+// The optional final memo must be a JSON object and is set as the memo of the
+// last forwarded packet.
+func BuildForwardMemo(hops []ForwardHop, receiver, finalMemo string) (string, error) {
+	if len(hops) == 0 || len(hops) > MaxForwardHops {
+		return "", fmt.Errorf(ErrInvalidForwardHops, fmt.Sprintf("expected between 1 and %d hops, got %d", MaxForwardHops, len(hops)))
+	}
+
+	if strings.TrimSpace(receiver) == "" {
+		return "", fmt.Errorf(ErrInvalidReceiver, receiver)
+	}
+
+	var next json.RawMessage
+	if finalMemo != "" {
+		var err error
+		if next, err = compactJSONObject(finalMemo); err != nil {
+			return "", fmt.Errorf(ErrInvalidMemo, err.Error())
+		}
+	}
+
+	// build the memo from the last hop to the first one, nesting each memo in
+	// the "next" field of the previous hop
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := hops[i]
+		if err := hop.Validate(); err != nil {
+			return "", fmt.Errorf(ErrInvalidForwardHops, fmt.Sprintf("hop %d: %s", i, err))
+		}
+
+		// the tokens are forwarded to the receiver of the next hop, or the
+		// final receiver on the last hop
+		hopReceiver := receiver
+		if i < len(hops)-1 {
+			hopReceiver = hops[i+1].Receiver
+		}
+
+		bz, err := json.Marshal(forwardMemo{
+			Forward: forwardMetadata{
+				Receiver: hopReceiver,
+				Port:     hop.Port,
+				Channel:  hop.Channel,
+				Next:     next,
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+		next = bz
+	}
+
+	return string(next), nil
+}
+
+// Validate checks that the receiver, port and channel of the hop are valid.
+func (h ForwardHop) Validate() error {
+	if strings.TrimSpace(h.Receiver) == "" {
+		return errors.New("receiver cannot be empty")
+	}
+	if err := host.PortIdentifierValidator(h.Port); err != nil {
+		return err
+	}
+	return host.ChannelIdentifierValidator(h.Channel)
+}
+
+// compactJSONObject checks that the given string is a JSON object and returns it
+// without any insignificant whitespace.
+func compactJSONObject(s string) (json.RawMessage, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(s), &obj); err != nil {
+		return nil, err
+	}
+
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, []byte(s)); err != nil {
+		return nil, err
+	}
+
+	return compacted.Bytes(), nil
+}
+
 // CreateAndValidateMsgTransfer creates a new MsgTransfer message and run validate basic.
 func CreateAndValidateMsgTransfer(
 	sourcePort, sourceChannel string,
@@ -246,6 +562,116 @@ func NewDenomHashRequest(args []interface{}) (*transfertypes.QueryDenomHashReque
 	return req, nil
 }
 
+// NewEscrowAddressRequest returns the port and channel identifiers of an escrow
+// account from the given arguments.
+func NewEscrowAddressRequest(args []interface{}) (portID, channelID string, err error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	return checkEscrowChannelArgs(args[0], args[1])
+}
+
+// NewEscrowBalanceRequest returns the port and channel identifiers of an escrow
+// account and the denomination of the escrowed balance from the given arguments.
+func NewEscrowBalanceRequest(args []interface{}) (portID, channelID, denom string, err error) {
+	if len(args) != 3 {
+		return "", "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	portID, channelID, err = checkEscrowChannelArgs(args[0], args[1])
+	if err != nil {
+		return "", "", "", err
+	}
+
+	denom, ok := args[2].(string)
+	if !ok {
+		return "", "", "", fmt.Errorf(cmn.ErrInvalidDenom, args[2])
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", "", fmt.Errorf(cmn.ErrInvalidDenom, denom)
+	}
+
+	return portID, channelID, denom, nil
+}
+
+// NewTotalEscrowRequest returns a new total escrow request from the given arguments.
+func NewTotalEscrowRequest(args []interface{}) (*transfertypes.QueryTotalEscrowForDenomRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidDenom, args[0])
+	}
+
+	req := &transfertypes.QueryTotalEscrowForDenomRequest{
+		Denom: denom,
+	}
+
+	return req, nil
+}
+
+// NewRateLimitRequest returns the channel identifier and the denomination of a
+// rate limit from the given arguments.
+func NewRateLimitRequest(args []interface{}) (channelID, denom string, err error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	channelID, ok := args[0].(string)
+	if !ok {
+		return "", "", fmt.Errorf(ErrInvalidChannelID, args[0])
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return "", "", fmt.Errorf(ErrInvalidChannelID, err)
+	}
+
+	denom, ok = args[1].(string)
+	if !ok {
+		return "", "", fmt.Errorf(cmn.ErrInvalidDenom, args[1])
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", fmt.Errorf(cmn.ErrInvalidDenom, denom)
+	}
+
+	return channelID, denom, nil
+}
+
+// remainingQuota returns the amount that can still be transferred in the
+// direction of the given net flow before it exceeds the given percentage of
+// the channel value, as enforced by the rate limiting middleware.
+func remainingQuota(channelValue, maxPercent, netFlow math.Int) math.Int {
+	threshold := channelValue.Mul(maxPercent).QuoRaw(100)
+	if netFlow.GTE(threshold) {
+		return math.ZeroInt()
+	}
+	return threshold.Sub(netFlow)
+}
+
+// checkEscrowChannelArgs checks that the given arguments are valid port and
+// channel identifiers.
+func checkEscrowChannelArgs(portArg, channelArg interface{}) (string, string, error) {
+	portID, ok := portArg.(string)
+	if !ok {
+		return "", "", fmt.Errorf(ErrInvalidPortID, portArg)
+	}
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return "", "", fmt.Errorf(ErrInvalidPortID, err)
+	}
+
+	channelID, ok := channelArg.(string)
+	if !ok {
+		return "", "", fmt.Errorf(ErrInvalidChannelID, channelArg)
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return "", "", fmt.Errorf(ErrInvalidChannelID, err)
+	}
+
+	return portID, channelID, nil
+}
+
 // checkRevokeArgs checks if the given arguments are valid for the Revoke tx.
 func checkRevokeArgs(args []interface{}) (common.Address, error) {
 	if len(args) != 1 {
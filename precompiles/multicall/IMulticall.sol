@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IMulticall contract's address.
+address constant MULTICALL_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000402;
+
+/// @dev The IMulticall contract's instance.
+IMulticall constant MULTICALL_CONTRACT = IMulticall(MULTICALL_PRECOMPILE_ADDRESS);
+
+/// @dev Call defines a call to be executed in a batch.
+/// @param target The address of the called contract or precompile.
+/// @param allowFailure Whether the batch continues if the call fails.
+/// @param callData The input data of the call.
+struct Call {
+    address target;
+    bool allowFailure;
+    bytes callData;
+}
+
+/// @dev Result defines the result of a call executed in a batch.
+/// @param success Whether the call succeeded.
+/// @param returnData The return data of the call, or its revert data if it failed.
+struct Result {
+    bool success;
+    bytes returnData;
+}
+
+/// @author Evmos Team
+/// @title Multicall Precompiled Contract
+/// @dev The interface through which solidity contracts and accounts can execute
+/// a batch of calls to other contracts and precompiles atomically. The calls are
+/// made on behalf of the caller of the precompile, which is the msg.sender of
+/// each call in the batch.
+/// @custom:address 0x0000000000000000000000000000000000000402
+interface IMulticall {
+    /// @dev Emitted when a call of the batch that doesn't allow failures fails.
+    /// The whole batch is reverted.
+    /// @param index The index of the failed call in the batch.
+    /// @param returnData The revert data of the failed call.
+    error CallFailed(uint256 index, bytes returnData);
+
+    /// @dev Executes the given calls in order. If a call that doesn't allow
+    /// failures fails, the whole batch is reverted with the CallFailed error.
+    /// @param calls The calls to execute.
+    /// @return results The results of the calls, in the same order.
+    function aggregate(
+        Call[] calldata calls
+    ) external returns (Result[] memory results);
+}
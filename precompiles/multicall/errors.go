@@ -0,0 +1,11 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package multicall
+
+const (
+	// ErrValueNotSupported is raised when value is sent to the multicall precompile.
+	ErrValueNotSupported = "multicall precompile doesn't accept value, got %s"
+	// ErrRecursiveCall is raised when a call of the batch targets the multicall precompile.
+	ErrRecursiveCall = "call %d targets the multicall precompile"
+)
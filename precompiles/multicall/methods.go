@@ -0,0 +1,83 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package multicall
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// AggregateMethod defines the ABI method name to execute a batch of calls.
+	AggregateMethod = "aggregate"
+	// CallFailedError defines the ABI error name raised when a call of the
+	// batch that doesn't allow failures fails.
+	CallFailedError = "CallFailed"
+)
+
+// Aggregate executes the calls of the batch in order on behalf of the caller of
+// the precompile and returns their results. The calls are executed through the
+// EVM, so the state changes of the stateful precompiles are journaled in the
+// same StateDB as the ones of the contracts. If a call that doesn't allow
+// failures fails, the CallFailed error is returned as revert data together with
+// the execution reverted error, so that the EVM reverts the whole batch.
+func (p Precompile) Aggregate(
+	evm *vm.EVM,
+	contract *vm.Contract,
+	readOnly bool,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if value := contract.Value(); value != nil && value.Sign() != 0 {
+		return nil, fmt.Errorf(ErrValueNotSupported, value)
+	}
+
+	calls, err := NewCalls(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	caller := vm.AccountRef(contract.CallerAddress)
+	results := make([]Result, len(calls))
+	for i, call := range calls {
+		if call.Target == p.Address() {
+			return nil, fmt.Errorf(ErrRecursiveCall, i)
+		}
+
+		// forward all but one 64th of the remaining gas, as per EIP-150
+		gas := contract.Gas - contract.Gas/64
+		ret, leftOverGas, err := evm.CallFromPrecompile(caller, call.Target, call.CallData, gas, readOnly)
+		contract.UseGas(gas - leftOverGas)
+
+		if err != nil && !call.AllowFailure {
+			return p.callFailed(i, ret)
+		}
+
+		results[i] = Result{
+			Success:    err == nil,
+			ReturnData: ret,
+		}
+	}
+
+	return method.Outputs.Pack(results)
+}
+
+// callFailed returns the revert data of the CallFailed error for the call at
+// the given index together with the execution reverted error.
+func (p Precompile) callFailed(index int, returnData []byte) ([]byte, error) {
+	callFailed := p.Errors[CallFailedError]
+	packed, err := callFailed.Inputs.Pack(big.NewInt(int64(index)), returnData)
+	if err != nil {
+		return nil, err
+	}
+
+	revertData := make([]byte, 0, 4+len(packed))
+	revertData = append(revertData, callFailed.ID[:4]...)
+	revertData = append(revertData, packed...)
+
+	return revertData, vm.ErrExecutionReverted
+}
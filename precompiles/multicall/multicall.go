@@ -0,0 +1,115 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package multicall
+
+import (
+	"embed"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+const (
+	// BaseGas is the gas charged for every call to the multicall precompile.
+	BaseGas uint64 = 2000
+	// CallGas is the gas charged for each call of the batch, on top of the gas
+	// consumed by the called contract. It matches the cost of the CALL opcode.
+	CallGas uint64 = 700
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract that executes a batch of calls
+// to other contracts and precompiles atomically.
+type Precompile struct {
+	abi.ABI
+}
+
+// NewPrecompile creates a new multicall Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile() (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		ABI: newABI,
+	}, nil
+}
+
+// Address defines the address of the multicall precompiled contract.
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(evmtypes.MulticallPrecompileAddress)
+}
+
+// RequiredGas calculates the contract gas use. The batch is charged the base
+// gas plus the call gas for each of its calls. The gas consumed by the called
+// contracts is charged as they are executed.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return BaseGas
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		return BaseGas
+	}
+
+	switch method.Name {
+	case AggregateMethod:
+		args, err := method.Inputs.Unpack(input[4:])
+		if err != nil {
+			return BaseGas
+		}
+
+		calls, err := NewCalls(method, args)
+		if err != nil {
+			return BaseGas
+		}
+		return BaseGas + uint64(len(calls))*CallGas //nolint:gosec // G115
+	default:
+		return BaseGas
+	}
+}
+
+// Run executes the precompiled contract multicall methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(contract.Input) < 4 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	methodID := contract.Input[:4]
+	// NOTE: this function iterates over the method map and returns
+	// the method with the given ID
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		return nil, err
+	}
+
+	argsBz := contract.Input[4:]
+	args, err := method.Inputs.Unpack(argsBz)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case AggregateMethod:
+		// NOTE: the revert data of the failed call is returned together with
+		// the execution reverted error
+		return p.Aggregate(evm, contract, readOnly, method, args)
+	}
+
+	return nil, nil
+}
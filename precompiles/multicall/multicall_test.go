@@ -0,0 +1,195 @@
+package multicall_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v20/precompiles/bech32"
+	distprecompile "github.com/evmos/evmos/v20/precompiles/distribution"
+	"github.com/evmos/evmos/v20/precompiles/multicall"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestAddress() {
+	s.Require().Equal(evmtypes.MulticallPrecompileAddress, s.precompile.Address().String())
+}
+
+func (s *PrecompileTestSuite) TestRequiredGas() {
+	s.Require().Equal(multicall.BaseGas, s.precompile.RequiredGas(nil))
+
+	input, err := s.precompile.Pack(multicall.AggregateMethod, []multicall.Call{
+		{Target: s.bech32.Address()},
+		{Target: s.bech32.Address()},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(multicall.BaseGas+2*multicall.CallGas, s.precompile.RequiredGas(input))
+}
+
+func (s *PrecompileTestSuite) TestAggregate() {
+	sender := s.keyring.GetAddr(0)
+	withdrawer := s.keyring.GetAccAddr(1)
+
+	hexToBech32, err := s.bech32.Pack(bech32.HexToBech32Method, sender, "evmos")
+	s.Require().NoError(err)
+	expHexToBech32, err := s.bech32.Methods[bech32.HexToBech32Method].Outputs.Pack(s.keyring.GetAccAddr(0).String())
+	s.Require().NoError(err)
+
+	invalidBech32ToHex, err := s.bech32.Pack(bech32.Bech32ToHexMethod, "invalid")
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name        string
+		calls       func(distrAddr common.Address, setWithdrawAddress []byte) []multicall.Call
+		value       *big.Int
+		expPass     bool
+		errContains string
+		postCheck   func(bz []byte, withdrawAddrSet bool)
+	}{
+		{
+			"fail - value is not supported",
+			func(common.Address, []byte) []multicall.Call { return nil },
+			big.NewInt(1),
+			false,
+			"doesn't accept value",
+			func([]byte, bool) {},
+		},
+		{
+			"fail - recursive call",
+			func(common.Address, []byte) []multicall.Call {
+				return []multicall.Call{{Target: s.precompile.Address()}}
+			},
+			nil,
+			false,
+			"targets the multicall precompile",
+			func([]byte, bool) {},
+		},
+		{
+			"fail - failed call reverts the whole batch",
+			func(distrAddr common.Address, setWithdrawAddress []byte) []multicall.Call {
+				return []multicall.Call{
+					{Target: distrAddr, CallData: setWithdrawAddress},
+					{Target: s.bech32.Address(), CallData: invalidBech32ToHex},
+				}
+			},
+			nil,
+			false,
+			vm.ErrExecutionReverted.Error(),
+			func(bz []byte, withdrawAddrSet bool) {
+				callFailed := s.precompile.Errors[multicall.CallFailedError]
+				s.Require().Equal(callFailed.ID[:4], bz[:4])
+
+				out, err := callFailed.Inputs.Unpack(bz[4:])
+				s.Require().NoError(err)
+				s.Require().Equal(int64(1), out[0].(*big.Int).Int64())
+				s.Require().False(withdrawAddrSet, "expected the withdraw address change to be reverted")
+			},
+		},
+		{
+			"pass - empty batch",
+			func(common.Address, []byte) []multicall.Call { return nil },
+			nil,
+			true,
+			"",
+			func(bz []byte, _ bool) {
+				var results []multicall.Result
+				err := s.precompile.UnpackIntoInterface(&results, multicall.AggregateMethod, bz)
+				s.Require().NoError(err)
+				s.Require().Empty(results)
+			},
+		},
+		{
+			"pass - failed call with allow failure",
+			func(distrAddr common.Address, setWithdrawAddress []byte) []multicall.Call {
+				return []multicall.Call{
+					{Target: distrAddr, CallData: setWithdrawAddress},
+					{Target: s.bech32.Address(), AllowFailure: true, CallData: invalidBech32ToHex},
+					{Target: s.bech32.Address(), CallData: hexToBech32},
+				}
+			},
+			nil,
+			true,
+			"",
+			func(bz []byte, withdrawAddrSet bool) {
+				var results []multicall.Result
+				err := s.precompile.UnpackIntoInterface(&results, multicall.AggregateMethod, bz)
+				s.Require().NoError(err)
+				s.Require().Len(results, 3)
+				s.Require().True(results[0].Success)
+				s.Require().False(results[1].Success)
+				s.Require().True(results[2].Success)
+				s.Require().Equal(expHexToBech32, results[2].ReturnData)
+				s.Require().True(withdrawAddrSet, "expected the withdraw address to be set")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+
+			distrAddr := common.HexToAddress(evmtypes.DistributionPrecompileAddress)
+			precompiles, found, err := s.network.App.EvmKeeper.GetPrecompileInstance(ctx, distrAddr)
+			s.Require().NoError(err)
+			s.Require().True(found)
+			distr, ok := precompiles.Map[distrAddr].(*distprecompile.Precompile)
+			s.Require().True(ok)
+
+			setWithdrawAddress, err := distr.Pack(distprecompile.SetWithdrawAddressMethod, sender, withdrawer.String())
+			s.Require().NoError(err)
+
+			input, err := s.precompile.Pack(multicall.AggregateMethod, tc.calls(distrAddr, setWithdrawAddress))
+			s.Require().NoError(err)
+
+			value := tc.value
+			if value == nil {
+				value = big.NewInt(0)
+			}
+
+			stateDB := statedb.New(ctx, s.network.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			evm := s.newEVM(ctx, stateDB)
+			bz, _, err := evm.Call(vm.AccountRef(sender), s.precompile.Address(), input, 1_000_000, value)
+			s.Require().NoError(stateDB.Commit())
+
+			withdrawAddr, queryErr := s.network.App.DistrKeeper.GetDelegatorWithdrawAddr(ctx, s.keyring.GetAccAddr(0))
+			s.Require().NoError(queryErr)
+			withdrawAddrSet := withdrawAddr.Equals(withdrawer)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			}
+			tc.postCheck(bz, withdrawAddrSet)
+		})
+	}
+}
+
+// newEVM returns a new EVM instance for a transaction sent to the multicall
+// precompile by the first keyring account.
+func (s *PrecompileTestSuite) newEVM(ctx sdk.Context, stateDB vm.StateDB) *vm.EVM {
+	cfg, err := s.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err, "failed to instantiate EVM config")
+
+	to := s.precompile.Address()
+	msg := ethtypes.NewMessage(
+		s.keyring.GetAddr(0),
+		&to,
+		0,
+		big.NewInt(0),
+		1_000_000,
+		big.NewInt(0),
+		big.NewInt(0),
+		big.NewInt(0),
+		nil,
+		ethtypes.AccessList{},
+		false,
+	)
+
+	return s.network.App.EvmKeeper.NewEVM(ctx, msg, cfg, nil, stateDB)
+}
@@ -0,0 +1,48 @@
+package multicall_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v20/precompiles/bech32"
+	"github.com/evmos/evmos/v20/precompiles/multicall"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/suite"
+)
+
+var s *PrecompileTestSuite
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for the
+// multicall precompile unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *multicall.Precompile
+	bech32     *bech32.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	s = new(PrecompileTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	integrationNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	s.keyring = keyring
+	s.network = integrationNetwork
+
+	precompile, err := multicall.NewPrecompile()
+	s.Require().NoError(err, "failed to create multicall precompile")
+	s.precompile = precompile
+
+	bech32Precompile, err := bech32.NewPrecompile(6000, s.network.App.EvmKeeper)
+	s.Require().NoError(err, "failed to create bech32 precompile")
+	s.bech32 = bech32Precompile
+}
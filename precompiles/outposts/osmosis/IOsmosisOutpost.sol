@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The Osmosis Outpost contract's address.
+address constant OSMOSIS_OUTPOST_ADDRESS = 0x0000000000000000000000000000000000000901;
+
+/// @dev The Osmosis Outpost contract's instance.
+IOsmosisOutpost constant OSMOSIS_OUTPOST_CONTRACT = IOsmosisOutpost(
+    OSMOSIS_OUTPOST_ADDRESS
+);
+
+/// @author Evmos Team
+/// @title Osmosis Outpost Precompiled Contract
+/// @dev The interface through which solidity contracts swap tokens on Osmosis
+/// through the cross-chain swaps (XCS) contract, receiving the output tokens back
+/// on Evmos.
+/// @custom:address 0x0000000000000000000000000000000000000901
+interface IOsmosisOutpost {
+    /// @dev Emitted when a swap is sent to Osmosis.
+    /// @param sender The address that sent the input tokens.
+    /// @param inputToken The ERC-20 address of the tokens to be swapped.
+    /// @param outputToken The ERC-20 address of the tokens received from the swap.
+    /// @param amount The amount of input tokens to be swapped.
+    /// @param receiver The bech32 address that receives the output tokens.
+    /// @param sequence The sequence of the IBC transfer packet of the swap.
+    event Swap(
+        address indexed sender,
+        address indexed inputToken,
+        address indexed outputToken,
+        uint256 amount,
+        string receiver,
+        uint64 sequence
+    );
+
+    /// @dev Swap sends the input tokens of the caller to Osmosis, where they are
+    /// swapped for the output tokens that are sent back to the receiver on Evmos.
+    /// Both tokens must be the ERC-20 representation of registered Cosmos coins.
+    /// @param inputToken The ERC-20 address of the tokens to be swapped.
+    /// @param outputToken The ERC-20 address of the tokens to receive.
+    /// @param amount The amount of input tokens to be swapped.
+    /// @param slippage The maximum slippage percentage with respect to the
+    /// time-weighted average price, between 1 and 20.
+    /// @param receiver The bech32 or hex address that receives the output tokens.
+    /// @return sequence The sequence of the IBC transfer packet of the swap.
+    function swap(
+        address inputToken,
+        address outputToken,
+        uint256 amount,
+        uint8 slippage,
+        string calldata receiver
+    ) external returns (uint64 sequence);
+
+    /// @dev IsSwapPending returns whether the swap with the given sequence is
+    /// waiting for the acknowledgement or the timeout of its IBC transfer packet.
+    /// @param sequence The sequence returned by the swap.
+    /// @return pending True if the swap is still pending.
+    function isSwapPending(
+        uint64 sequence
+    ) external view returns (bool pending);
+}
@@ -0,0 +1,19 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package osmosis
+
+const (
+	// ErrOutpostDisabled is raised when the channel to Osmosis isn't set in the EVM params.
+	ErrOutpostDisabled = "the Osmosis outpost is disabled"
+	// ErrInvalidToken is raised when the input or output token is invalid.
+	ErrInvalidToken = "invalid token: %v"
+	// ErrSameTokens is raised when the input and output tokens are the same.
+	ErrSameTokens = "the input and output tokens must be different: %s"
+	// ErrInvalidSlippage is raised when the slippage percentage is out of bounds.
+	ErrInvalidSlippage = "invalid slippage percentage %d, it must be between 1 and %d"
+	// ErrInvalidReceiver is raised when the receiver is not a valid hex or bech32 address.
+	ErrInvalidReceiver = "invalid receiver: %v"
+	// ErrInvalidSequence is raised when the packet sequence is invalid.
+	ErrInvalidSequence = "invalid sequence: %v"
+)
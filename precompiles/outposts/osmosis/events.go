@@ -0,0 +1,68 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package osmosis
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// EventTypeSwap defines the event type for the Osmosis outpost Swap transaction.
+	EventTypeSwap = "Swap"
+)
+
+// EmitSwapEvent creates a new Swap event emitted on a Swap transaction.
+func (p Precompile) EmitSwapEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	sender, inputToken, outputToken common.Address,
+	amount *big.Int,
+	receiver string,
+	sequence uint64,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeSwap]
+	topics := make([]common.Hash, 4)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	// sender, input and output tokens are indexed
+	topics[1], err = cmn.MakeTopic(sender)
+	if err != nil {
+		return err
+	}
+	topics[2], err = cmn.MakeTopic(inputToken)
+	if err != nil {
+		return err
+	}
+	topics[3], err = cmn.MakeTopic(outputToken)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data: amount, receiver, sequence
+	arguments := abi.Arguments{event.Inputs[3], event.Inputs[4], event.Inputs[5]}
+	packed, err := arguments.Pack(amount, receiver, sequence)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
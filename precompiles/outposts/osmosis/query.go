@@ -0,0 +1,46 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package osmosis
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+const (
+	// IsSwapPendingMethod defines the ABI method name for the Osmosis outpost
+	// IsSwapPending query.
+	IsSwapPendingMethod = "isSwapPending"
+)
+
+// IsSwapPending returns whether the swap sent with the given packet sequence
+// is still pending, i.e. its IBC transfer packet hasn't been acknowledged nor
+// timed out yet. The sequence is looked up on the channel to Osmosis currently
+// set in the EVM params.
+func (p Precompile) IsSwapPending(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	sequence, ok := args[0].(uint64)
+	if !ok || sequence == 0 {
+		return nil, fmt.Errorf(ErrInvalidSequence, args[0])
+	}
+
+	outpost := p.paramsKeeper.GetOsmosisOutpost(ctx)
+	if !outpost.IsEnabled() {
+		return nil, errors.New(ErrOutpostDisabled)
+	}
+
+	pending := p.channelKeeper.HasPacketCommitment(ctx, p.portID, outpost.ChannelID, sequence)
+	return method.Outputs.Pack(pending)
+}
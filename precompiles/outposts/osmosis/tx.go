@@ -0,0 +1,142 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package osmosis
+
+import (
+	"errors"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/ics20"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// SwapMethod defines the ABI method name for the Osmosis outpost Swap
+	// transaction.
+	SwapMethod = "swap"
+)
+
+// Swap sends the input tokens of the caller to the cross-chain swaps contract
+// on Osmosis, which swaps them for the output tokens and sends them back to the
+// receiver on Evmos. It returns the sequence of the IBC transfer packet, which
+// tracks the swap until the packet is acknowledged or timed out.
+func (p *Precompile) Swap(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	swapArgs, err := NewSwapArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	outpost := p.paramsKeeper.GetOsmosisOutpost(ctx)
+	if !outpost.IsEnabled() {
+		return nil, errors.New(ErrOutpostDisabled)
+	}
+
+	// NOTE: the caller swaps its own tokens, so no authorization is required
+	sender := contract.CallerAddress
+
+	inputDenom, err := p.tokenDenom(ctx, swapArgs.InputToken)
+	if err != nil {
+		return nil, err
+	}
+
+	outputDenom, err := p.tokenDenom(ctx, swapArgs.OutputToken)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, found := p.channelKeeper.GetChannel(ctx, p.portID, outpost.ChannelID)
+	if !found {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", p.portID, outpost.ChannelID)
+	}
+
+	outputDenomPath := outputDenom
+	if strings.HasPrefix(outputDenom, transfertypes.DenomPrefix+"/") {
+		outputDenomPath, err = p.transferKeeper.DenomPathFromHash(ctx, outputDenom)
+		if err != nil {
+			return nil, err
+		}
+	}
+	osmosisOutputDenom := ConvertToOsmosisDenom(
+		outputDenomPath,
+		p.portID, outpost.ChannelID,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+	)
+
+	memo, err := BuildXCSMemo(outpost.XCSContract, osmosisOutputDenom, swapArgs.Slippage, swapArgs.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	token := sdk.Coin{Denom: inputDenom, Amount: math.NewIntFromBigInt(swapArgs.Amount)}
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + transfertypes.DefaultRelativePacketTimeoutTimestamp //nolint:gosec // G115
+
+	// NOTE: the IBC hooks middleware requires the receiver of the transfer to be
+	// the contract that is called with the memo.
+	msg, err := ics20.CreateAndValidateMsgTransfer(
+		p.portID, outpost.ChannelID, token,
+		sdk.AccAddress(sender.Bytes()).String(), outpost.XCSContract,
+		clienttypes.ZeroHeight(), timeoutTimestamp, memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.transferKeeper.Transfer(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if contract.CallerAddress != origin && token.Denom == evmtypes.GetEVMCoinDenom() {
+		// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+		// when calling the precompile from another smart contract.
+		// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+		escrowHexAddr := common.BytesToAddress(transfertypes.GetEscrowAddress(p.portID, outpost.ChannelID))
+		amt := evmtypes.ConvertAmountTo18DecimalsBigInt(token.Amount.BigInt())
+		p.SetBalanceChangeEntries(
+			cmn.NewBalanceChangeEntry(sender, amt, cmn.Sub),
+			cmn.NewBalanceChangeEntry(escrowHexAddr, amt, cmn.Add),
+		)
+	}
+
+	if err := p.EmitSwapEvent(
+		ctx, stateDB,
+		sender, swapArgs.InputToken, swapArgs.OutputToken,
+		swapArgs.Amount, swapArgs.Receiver, res.Sequence,
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
+
+// tokenDenom returns the Cosmos denomination of the enabled token pair of the
+// given ERC-20 token.
+func (p Precompile) tokenDenom(ctx sdk.Context, token common.Address) (string, error) {
+	pair, found := p.erc20Keeper.GetTokenPair(ctx, p.erc20Keeper.GetTokenPairID(ctx, token.String()))
+	if !found {
+		return "", errorsmod.Wrapf(erc20types.ErrTokenPairNotFound, "token %s", token)
+	}
+	if !pair.Enabled {
+		return "", errorsmod.Wrapf(erc20types.ErrERC20TokenPairDisabled, "token %s", token)
+	}
+	return pair.Denom, nil
+}
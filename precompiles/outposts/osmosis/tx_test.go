@@ -0,0 +1,115 @@
+package osmosis_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/outposts/osmosis"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestSwap() {
+	method := s.precompile.Methods[osmosis.SwapMethod]
+	amount := big.NewInt(1e18)
+
+	var (
+		ctx         sdk.Context
+		inputToken  common.Address
+		outputToken common.Address
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - outpost disabled",
+			func() {
+				s.setOsmosisOutpost(ctx, evmtypes.OsmosisOutpost{})
+			},
+			true,
+			osmosis.ErrOutpostDisabled,
+		},
+		{
+			"fail - channel not found",
+			func() {
+				s.setOsmosisOutpost(ctx, evmtypes.OsmosisOutpost{ChannelID: "channel-99", XCSContract: xcsContract})
+			},
+			true,
+			"channel not found",
+		},
+		{
+			"fail - output token not registered",
+			func() {
+				outputToken = utiltx.GenerateAddress()
+			},
+			true,
+			erc20types.ErrTokenPairNotFound.Error(),
+		},
+		{
+			"pass - swap the EVM coin",
+			func() {},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.getContext()
+			sender := s.keyring.GetKey(0)
+			erc20Keeper := s.network.App.Erc20Keeper
+
+			inputPair, found := erc20Keeper.GetTokenPair(ctx, erc20Keeper.GetDenomMap(ctx, evmtypes.GetEVMCoinDenom()))
+			s.Require().True(found)
+			inputToken = inputPair.GetERC20Contract()
+
+			outputPair := erc20types.NewTokenPair(utiltx.GenerateAddress(), "xmpl", erc20types.OWNER_MODULE)
+			erc20Keeper.SetToken(ctx, outputPair)
+			outputToken = outputPair.GetERC20Contract()
+
+			s.setOsmosisOutpost(ctx, evmtypes.OsmosisOutpost{ChannelID: s.channelID, XCSContract: xcsContract})
+
+			tc.malleate()
+
+			prevBalance := s.network.App.BankKeeper.GetBalance(ctx, sender.AccAddr, evmtypes.GetEVMCoinDenom())
+			contract := vm.NewContract(vm.AccountRef(sender.Addr), s.precompile, big.NewInt(0), 200_000)
+			stateDB := statedb.New(ctx, s.network.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			args := []interface{}{inputToken, outputToken, amount, uint8(5), s.keyring.GetAddr(1).Hex()}
+
+			bz, err := s.precompile.Swap(ctx, sender.Addr, contract, stateDB, &method, args)
+
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			sequence, ok := out[0].(uint64)
+			s.Require().True(ok)
+			s.Require().Equal(uint64(1), sequence)
+
+			// the swap is pending until the packet is acknowledged or timed out
+			s.Require().True(s.network.App.IBCKeeper.ChannelKeeper.HasPacketCommitment(ctx, transfertypes.PortID, s.channelID, sequence))
+
+			balance := s.network.App.BankKeeper.GetBalance(ctx, sender.AccAddr, evmtypes.GetEVMCoinDenom())
+			s.Require().Equal(prevBalance.Amount.Sub(math.NewIntFromBigInt(amount)), balance.Amount)
+
+			escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, s.channelID)
+			escrowBalance := s.network.App.BankKeeper.GetBalance(ctx, escrow, evmtypes.GetEVMCoinDenom())
+			s.Require().Equal(math.NewIntFromBigInt(amount), escrowBalance.Amount)
+		})
+	}
+}
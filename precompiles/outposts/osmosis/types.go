@@ -0,0 +1,159 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package osmosis
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/ics20"
+)
+
+const (
+	// MaxSlippagePercentage is the maximum slippage percentage of a swap.
+	MaxSlippagePercentage = 20
+	// TwapWindowSeconds is the window of the time-weighted average price with
+	// respect to which the slippage of a swap is computed.
+	TwapWindowSeconds = 30
+	// onFailedDeliveryDoNothing instructs the XCS contract to keep the output
+	// tokens if they can't be delivered to the receiver.
+	onFailedDeliveryDoNothing = "do_nothing"
+)
+
+// SwapArgs defines the arguments of the swap method.
+type SwapArgs struct {
+	InputToken  common.Address
+	OutputToken common.Address
+	Amount      *big.Int
+	Slippage    uint8
+	Receiver    string
+}
+
+// NewSwapArgs parses and validates the arguments of the swap method. The
+// receiver is returned as a bech32 address.
+func NewSwapArgs(args []interface{}) (SwapArgs, error) {
+	if len(args) != 5 {
+		return SwapArgs{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	inputToken, ok := args[0].(common.Address)
+	if !ok || inputToken == (common.Address{}) {
+		return SwapArgs{}, fmt.Errorf(ErrInvalidToken, args[0])
+	}
+
+	outputToken, ok := args[1].(common.Address)
+	if !ok || outputToken == (common.Address{}) {
+		return SwapArgs{}, fmt.Errorf(ErrInvalidToken, args[1])
+	}
+
+	if inputToken == outputToken {
+		return SwapArgs{}, fmt.Errorf(ErrSameTokens, inputToken)
+	}
+
+	amount, ok := args[2].(*big.Int)
+	if !ok || amount == nil || amount.Sign() <= 0 {
+		return SwapArgs{}, fmt.Errorf(cmn.ErrInvalidAmount, args[2])
+	}
+
+	slippage, ok := args[3].(uint8)
+	if !ok || slippage == 0 || slippage > MaxSlippagePercentage {
+		return SwapArgs{}, fmt.Errorf(ErrInvalidSlippage, args[3], MaxSlippagePercentage)
+	}
+
+	receiverArg, ok := args[4].(string)
+	if !ok {
+		return SwapArgs{}, fmt.Errorf(ErrInvalidReceiver, args[4])
+	}
+
+	// NOTE: the output tokens are returned to Evmos, so the receiver must be a
+	// hex or bech32 address of this chain
+	var receiver string
+	if common.IsHexAddress(receiverArg) {
+		receiver = sdk.AccAddress(common.HexToAddress(receiverArg).Bytes()).String()
+	} else {
+		addr, err := sdk.AccAddressFromBech32(receiverArg)
+		if err != nil {
+			return SwapArgs{}, fmt.Errorf(ErrInvalidReceiver, receiverArg)
+		}
+		receiver = addr.String()
+	}
+
+	return SwapArgs{
+		InputToken:  inputToken,
+		OutputToken: outputToken,
+		Amount:      amount,
+		Slippage:    slippage,
+		Receiver:    receiver,
+	}, nil
+}
+
+// xcsMsg defines the message of the cross-chain swaps contract that swaps the
+// received tokens and sends the output tokens to the receiver.
+type xcsMsg struct {
+	OsmosisSwap osmosisSwap `json:"osmosis_swap"`
+}
+
+// osmosisSwap defines the parameters of a cross-chain swap.
+type osmosisSwap struct {
+	OutputDenom      string   `json:"output_denom"`
+	Slippage         slippage `json:"slippage"`
+	Receiver         string   `json:"receiver"`
+	OnFailedDelivery string   `json:"on_failed_delivery"`
+}
+
+// slippage defines the maximum slippage of a cross-chain swap.
+type slippage struct {
+	Twap twap `json:"twap"`
+}
+
+// twap defines a slippage with respect to the time-weighted average price.
+type twap struct {
+	SlippagePercentage string `json:"slippage_percentage"`
+	WindowSeconds      uint64 `json:"window_seconds"`
+}
+
+// BuildXCSMemo returns the IBC hooks memo that calls the cross-chain swaps
+// contract on Osmosis to swap the transferred tokens for the output denom, as
+// seen on Osmosis, and to send them to the receiver.
+func BuildXCSMemo(xcsContract, outputDenom string, slippagePercentage uint8, receiver string) (string, error) {
+	bz, err := json.Marshal(xcsMsg{
+		OsmosisSwap: osmosisSwap{
+			OutputDenom: outputDenom,
+			Slippage: slippage{
+				Twap: twap{
+					SlippagePercentage: strconv.FormatUint(uint64(slippagePercentage), 10),
+					WindowSeconds:      TwapWindowSeconds,
+				},
+			},
+			Receiver:         receiver,
+			OnFailedDelivery: onFailedDeliveryDoNothing,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return ics20.BuildWasmHookMemo(xcsContract, string(bz))
+}
+
+// ConvertToOsmosisDenom returns the denomination on Osmosis of the given full
+// denomination path on Evmos, where Osmosis is the counterparty of the given
+// channel. The vouchers of coins native to Osmosis are unwrapped, while the
+// other coins are prefixed with the counterparty channel.
+func ConvertToOsmosisDenom(denomPath, portID, channelID, counterpartyPortID, counterpartyChannelID string) string {
+	prefix := transfertypes.GetDenomPrefix(portID, channelID)
+	if strings.HasPrefix(denomPath, prefix) {
+		return transfertypes.ParseDenomTrace(strings.TrimPrefix(denomPath, prefix)).IBCDenom()
+	}
+
+	counterpartyPrefix := transfertypes.GetDenomPrefix(counterpartyPortID, counterpartyChannelID)
+	return transfertypes.ParseDenomTrace(counterpartyPrefix + denomPath).IBCDenom()
+}
@@ -0,0 +1,95 @@
+package osmosis
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// xcsContract is the address of the cross-chain swaps contract on Osmosis mainnet.
+const xcsContract = "osmo1a34wxsxjwvtz3ua4hnkh4lv3d4qrgry0fhkasppplphwu5k538tqcyms9x"
+
+func TestNewSwapArgs(t *testing.T) {
+	input := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	output := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	receiver := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	bech32Receiver := sdk.AccAddress(receiver.Bytes()).String()
+
+	testCases := []struct {
+		name   string
+		args   []interface{}
+		errMsg string
+	}{
+		{"pass - hex receiver", []interface{}{input, output, big.NewInt(1), uint8(5), receiver.Hex()}, ""},
+		{"pass - bech32 receiver", []interface{}{input, output, big.NewInt(1), uint8(20), bech32Receiver}, ""},
+		{"fail - invalid number of args", []interface{}{input, output}, "invalid number of arguments"},
+		{"fail - same tokens", []interface{}{input, input, big.NewInt(1), uint8(5), bech32Receiver}, "must be different"},
+		{"fail - zero amount", []interface{}{input, output, big.NewInt(0), uint8(5), bech32Receiver}, "invalid amount"},
+		{"fail - zero slippage", []interface{}{input, output, big.NewInt(1), uint8(0), bech32Receiver}, "invalid slippage"},
+		{"fail - slippage too high", []interface{}{input, output, big.NewInt(1), uint8(21), bech32Receiver}, "invalid slippage"},
+		{"fail - invalid receiver", []interface{}{input, output, big.NewInt(1), uint8(5), "osmo1invalid"}, "invalid receiver"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			swapArgs, err := NewSwapArgs(tc.args)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, input, swapArgs.InputToken)
+			require.Equal(t, output, swapArgs.OutputToken)
+			require.Equal(t, bech32Receiver, swapArgs.Receiver)
+		})
+	}
+}
+
+func TestBuildXCSMemo(t *testing.T) {
+	memo, err := BuildXCSMemo(xcsContract, "uosmo", 5, "evmos1receiver")
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"wasm":{"contract":"`+xcsContract+`","msg":{"osmosis_swap":{"output_denom":"uosmo",`+
+			`"slippage":{"twap":{"slippage_percentage":"5","window_seconds":30}},`+
+			`"receiver":"evmos1receiver","on_failed_delivery":"do_nothing"}}}}`,
+		memo,
+	)
+}
+
+func TestConvertToOsmosisDenom(t *testing.T) {
+	port := transfertypes.PortID
+
+	testCases := []struct {
+		name      string
+		denomPath string
+		expDenom  string
+	}{
+		{"native to osmosis", "transfer/channel-0/uosmo", "uosmo"},
+		{
+			"routed through osmosis",
+			"transfer/channel-0/transfer/channel-5/uatom",
+			transfertypes.ParseDenomTrace("transfer/channel-5/uatom").IBCDenom(),
+		},
+		{
+			"native to evmos",
+			"aevmos",
+			transfertypes.ParseDenomTrace("transfer/channel-204/aevmos").IBCDenom(),
+		},
+		{
+			"from another chain",
+			"transfer/channel-3/uatom",
+			transfertypes.ParseDenomTrace("transfer/channel-204/transfer/channel-3/uatom").IBCDenom(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			denom := ConvertToOsmosisDenom(tc.denomPath, port, "channel-0", port, "channel-204")
+			require.Equal(t, tc.expDenom, denom)
+		})
+	}
+}
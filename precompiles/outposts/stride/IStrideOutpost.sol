@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The Stride Outpost contract's address.
+address constant STRIDE_OUTPOST_ADDRESS = 0x0000000000000000000000000000000000000900;
+
+/// @dev The Stride Outpost contract's instance.
+IStrideOutpost constant STRIDE_OUTPOST_CONTRACT = IStrideOutpost(
+    STRIDE_OUTPOST_ADDRESS
+);
+
+/// @author Evmos Team
+/// @title Stride Outpost Precompiled Contract
+/// @dev The interface through which solidity contracts liquid stake the native
+/// token on Stride through its autopilot module, receiving the liquid staked
+/// tokens back on Evmos.
+/// @custom:address 0x0000000000000000000000000000000000000900
+interface IStrideOutpost {
+    /// @dev Emitted when native tokens are sent to Stride to be liquid staked.
+    /// @param sender The address that sent the native tokens.
+    /// @param receiver The bech32 address that receives the liquid staked tokens.
+    /// @param amount The amount of native tokens to be liquid staked.
+    /// @param sequence The sequence of the IBC transfer packet.
+    event LiquidStake(
+        address indexed sender,
+        string receiver,
+        uint256 amount,
+        uint64 sequence
+    );
+
+    /// @dev Emitted when liquid staked tokens are sent to Stride to be redeemed.
+    /// @param sender The address that sent the liquid staked tokens.
+    /// @param receiver The bech32 address that receives the native tokens.
+    /// @param amount The amount of liquid staked tokens to be redeemed.
+    /// @param sequence The sequence of the IBC transfer packet.
+    event Redeem(
+        address indexed sender,
+        string receiver,
+        uint256 amount,
+        uint64 sequence
+    );
+
+    /// @dev LiquidStake sends native tokens of the caller to Stride, where they
+    /// are liquid staked and the liquid staked tokens are sent back to the
+    /// receiver on Evmos.
+    /// @param amount The amount of native tokens to be liquid staked.
+    /// @param receiver The bech32 or hex address that receives the liquid staked tokens.
+    /// @return sequence The sequence of the IBC transfer packet.
+    function liquidStake(
+        uint256 amount,
+        string calldata receiver
+    ) external returns (uint64 sequence);
+
+    /// @dev Redeem sends liquid staked tokens of the caller to Stride, where
+    /// they are redeemed and the native tokens are sent back to the receiver on
+    /// Evmos once unbonded.
+    /// @param amount The amount of liquid staked tokens to be redeemed.
+    /// @param receiver The bech32 or hex address that receives the native tokens.
+    /// @return sequence The sequence of the IBC transfer packet.
+    function redeem(
+        uint256 amount,
+        string calldata receiver
+    ) external returns (uint64 sequence);
+}
@@ -0,0 +1,11 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package stride
+
+const (
+	// ErrOutpostDisabled is raised when the channel to Stride isn't set in the EVM params.
+	ErrOutpostDisabled = "the Stride outpost is disabled"
+	// ErrInvalidReceiver is raised when the receiver is not a valid hex or bech32 address.
+	ErrInvalidReceiver = "invalid receiver: %v"
+)
@@ -0,0 +1,64 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package stride
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// EventTypeLiquidStake defines the event type for the Stride outpost LiquidStake transaction.
+	EventTypeLiquidStake = "LiquidStake"
+	// EventTypeRedeem defines the event type for the Stride outpost Redeem transaction.
+	EventTypeRedeem = "Redeem"
+)
+
+// EmitAutopilotEvent creates a new LiquidStake or Redeem event, which share
+// the same arguments, emitted on the corresponding transaction.
+func (p Precompile) EmitAutopilotEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	sender common.Address,
+	receiver string,
+	amount *big.Int,
+	sequence uint64,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	// sender is indexed
+	topics[1], err = cmn.MakeTopic(sender)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data: receiver, amount, sequence
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(receiver, amount, sequence)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
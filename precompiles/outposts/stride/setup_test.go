@@ -0,0 +1,69 @@
+package stride_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/evmos/evmos/v20/precompiles/outposts/stride"
+	commonnetwork "github.com/evmos/evmos/v20/testutil/integration/common/network"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/testutil/integration/ibc/coordinator"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network     *network.UnitTestNetwork
+	keyring     testkeyring.Keyring
+	coordinator *coordinator.IntegrationCoordinator
+	// channelID is the channel of the Evmos chain to the counterparty chain
+	channelID string
+
+	precompile *stride.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	coord := coordinator.NewIntegrationCoordinator(s.T(), []commonnetwork.Network{nw})
+	conn := coord.Setup(nw.GetChainID(), coord.GetDummyChainsIDs()[0])
+
+	precompile, err := stride.NewPrecompile(
+		transfertypes.PortID,
+		nw.App.EvmKeeper,
+		nw.App.TransferKeeper,
+		nw.App.IBCKeeper.ChannelKeeper,
+	)
+	s.Require().NoError(err)
+
+	s.network = nw
+	s.keyring = keyring
+	s.coordinator = coord
+	s.channelID = conn.EndpointA.ChannelID
+	s.precompile = precompile
+}
+
+// getContext returns the context of the Evmos chain, which is committed
+// through the coordinator.
+func (s *PrecompileTestSuite) getContext() sdk.Context {
+	return s.coordinator.GetTestChain(s.network.GetChainID()).GetContext()
+}
+
+// setStrideOutpost sets the channel to Stride of the Stride outpost in the EVM
+// params.
+func (s *PrecompileTestSuite) setStrideOutpost(ctx sdk.Context, channelID string) {
+	params := s.network.App.EvmKeeper.GetParams(ctx)
+	params.StrideOutpost = evmtypes.StrideOutpost{ChannelID: channelID}
+	s.Require().NoError(s.network.App.EvmKeeper.SetParams(ctx, params))
+}
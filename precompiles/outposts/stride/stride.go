@@ -0,0 +1,146 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+//
+// The stride package contains the implementation of the Stride outpost
+// precompile, which liquid stakes the native token on Stride through its
+// autopilot module and returns the liquid staked tokens to Evmos.
+
+package stride
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	transferkeeper "github.com/evmos/evmos/v20/x/ibc/transfer/keeper"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// ParamsKeeper defines the expected interface to read the channel to Stride of
+// the outpost. It is implemented by the EVM module keeper.
+type ParamsKeeper interface {
+	GetStrideOutpost(ctx sdk.Context) evmtypes.StrideOutpost
+}
+
+// Precompile defines the Stride outpost precompile
+type Precompile struct {
+	cmn.Precompile
+	// portID is the port of the channel to Stride
+	portID         string
+	paramsKeeper   ParamsKeeper
+	transferKeeper transferkeeper.Keeper
+	channelKeeper  channelkeeper.Keeper
+}
+
+// NewPrecompile creates a new Stride outpost Precompile instance implementing
+// the PrecompiledContract interface. The tokens are sent to Stride through the
+// channel of the EVM params, so that each chain configures its own
+// counterparty through genesis or governance.
+func NewPrecompile(
+	portID string,
+	paramsKeeper ParamsKeeper,
+	transferKeeper transferkeeper.Keeper,
+	channelKeeper channelkeeper.Keeper,
+) (*Precompile, error) {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return nil, err
+	}
+
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newABI,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		portID:         portID,
+		paramsKeeper:   paramsKeeper,
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+	}
+
+	// SetAddress defines the address of the Stride outpost contract.
+	p.SetAddress(common.HexToAddress(evmtypes.StrideOutpostPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompiled contract Stride outpost methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// Stride outpost transactions
+	case LiquidStakeMethod:
+		bz, err = p.LiquidStake(ctx, evm.Origin, contract, stateDB, method, args)
+	case RedeemMethod:
+		bz, err = p.Redeem(ctx, evm.Origin, contract, stateDB, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+// It returns true since all Stride outpost methods are transactions.
+func (Precompile) IsTransaction(_ *abi.Method) bool {
+	return true
+}
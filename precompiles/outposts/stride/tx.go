@@ -0,0 +1,154 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package stride
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/ics20"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// LiquidStakeMethod defines the ABI method name for the Stride outpost
+	// LiquidStake transaction.
+	LiquidStakeMethod = "liquidStake"
+	// RedeemMethod defines the ABI method name for the Stride outpost Redeem
+	// transaction.
+	RedeemMethod = "redeem"
+)
+
+// LiquidStake sends the native tokens of the caller to Stride, which liquid
+// stakes them and sends the liquid staked tokens back to the receiver on Evmos.
+func (p *Precompile) LiquidStake(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	channelID, err := p.strideChannelID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.autopilotTransfer(
+		ctx, origin, contract, stateDB, method, args,
+		channelID, LiquidStakeAction, evmtypes.GetEVMCoinDenom(), EventTypeLiquidStake,
+	)
+}
+
+// Redeem sends the liquid staked tokens of the caller to Stride, which redeems
+// them and sends the native tokens back to the receiver on Evmos once they are
+// unbonded.
+func (p *Precompile) Redeem(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	channelID, err := p.strideChannelID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.autopilotTransfer(
+		ctx, origin, contract, stateDB, method, args,
+		channelID, RedeemStakeAction, StTokenDenom(p.portID, channelID, evmtypes.GetEVMCoinDenom()), EventTypeRedeem,
+	)
+}
+
+// strideChannelID returns the channel to Stride set in the EVM params.
+func (p *Precompile) strideChannelID(ctx sdk.Context) (string, error) {
+	outpost := p.paramsKeeper.GetStrideOutpost(ctx)
+	if !outpost.IsEnabled() {
+		return "", errors.New(ErrOutpostDisabled)
+	}
+	return outpost.ChannelID, nil
+}
+
+// autopilotTransfer transfers the given denomination from the caller to
+// Stride through the given channel with the autopilot memo of the given action,
+// and returns the sequence of the transfer packet.
+func (p *Precompile) autopilotTransfer(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+	channelID, action, denom, eventType string,
+) ([]byte, error) {
+	amount, receiver, err := NewAutopilotArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.channelKeeper.HasChannel(ctx, p.portID, channelID) {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", p.portID, channelID)
+	}
+
+	// NOTE: the caller transfers its own tokens, so no authorization is required
+	sender := contract.CallerAddress
+
+	// the autopilot module requires the receiver of the transfer to be a Stride
+	// account, which only holds the tokens during the action
+	strideReceiver, err := sdk.Bech32ifyAddressBytes(StrideBech32Prefix, sender.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	memo, err := BuildAutopilotMemo(action, strideReceiver, receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	token := sdk.Coin{Denom: denom, Amount: math.NewIntFromBigInt(amount)}
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + transfertypes.DefaultRelativePacketTimeoutTimestamp //nolint:gosec // G115
+
+	msg, err := ics20.CreateAndValidateMsgTransfer(
+		p.portID, channelID, token,
+		sdk.AccAddress(sender.Bytes()).String(), strideReceiver,
+		clienttypes.ZeroHeight(), timeoutTimestamp, memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.transferKeeper.Transfer(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if contract.CallerAddress != origin && token.Denom == evmtypes.GetEVMCoinDenom() {
+		// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+		// when calling the precompile from another smart contract.
+		// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+		escrowHexAddr := common.BytesToAddress(transfertypes.GetEscrowAddress(p.portID, channelID))
+		amt := evmtypes.ConvertAmountTo18DecimalsBigInt(token.Amount.BigInt())
+		p.SetBalanceChangeEntries(
+			cmn.NewBalanceChangeEntry(sender, amt, cmn.Sub),
+			cmn.NewBalanceChangeEntry(escrowHexAddr, amt, cmn.Add),
+		)
+	}
+
+	if err := p.EmitAutopilotEvent(ctx, stateDB, eventType, sender, receiver, amount, res.Sequence); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
@@ -0,0 +1,128 @@
+package stride_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/outposts/stride"
+	"github.com/evmos/evmos/v20/testutil"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// autopilotFn is the signature of the Stride outpost transactions.
+type autopilotFn func(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error)
+
+func (s *PrecompileTestSuite) TestLiquidStake() {
+	s.testAutopilotTransfer(stride.LiquidStakeMethod, func(string) string {
+		return evmtypes.GetEVMCoinDenom()
+	})
+}
+
+func (s *PrecompileTestSuite) TestRedeem() {
+	s.testAutopilotTransfer(stride.RedeemMethod, func(channelID string) string {
+		return stride.StTokenDenom(transfertypes.PortID, channelID, evmtypes.GetEVMCoinDenom())
+	})
+}
+
+// testAutopilotTransfer tests that the given Stride outpost transaction sends
+// the tokens of the denom returned for the channel to Stride.
+func (s *PrecompileTestSuite) testAutopilotTransfer(methodName string, denomFn func(channelID string) string) {
+	method := s.precompile.Methods[methodName]
+	amount := big.NewInt(1e18)
+
+	var ctx sdk.Context
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - outpost disabled",
+			func() {
+				s.setStrideOutpost(ctx, "")
+			},
+			true,
+			stride.ErrOutpostDisabled,
+		},
+		{
+			"fail - channel not found",
+			func() {
+				s.setStrideOutpost(ctx, "channel-99")
+			},
+			true,
+			"channel not found",
+		},
+		{
+			"pass",
+			func() {},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.getContext()
+			sender := s.keyring.GetKey(0)
+			denom := denomFn(s.channelID)
+
+			// the liquid staked tokens are received from Stride through the channel
+			if denom != evmtypes.GetEVMCoinDenom() {
+				s.network.App.TransferKeeper.SetDenomTrace(ctx, transfertypes.ParseDenomTrace(
+					transfertypes.GetPrefixedDenom(transfertypes.PortID, s.channelID, "st"+evmtypes.GetEVMCoinDenom()),
+				))
+				s.Require().NoError(testutil.FundAccount(
+					ctx, s.network.App.BankKeeper, sender.AccAddr,
+					sdk.NewCoins(sdk.NewCoin(denom, math.NewIntFromBigInt(amount))),
+				))
+			}
+
+			s.setStrideOutpost(ctx, s.channelID)
+
+			tc.malleate()
+
+			prevBalance := s.network.App.BankKeeper.GetBalance(ctx, sender.AccAddr, denom)
+			contract := vm.NewContract(vm.AccountRef(sender.Addr), s.precompile, big.NewInt(0), 200_000)
+			stateDB := statedb.New(ctx, s.network.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			args := []interface{}{amount, s.keyring.GetAddr(1).Hex()}
+
+			var fn autopilotFn = s.precompile.LiquidStake
+			if methodName == stride.RedeemMethod {
+				fn = s.precompile.Redeem
+			}
+			bz, err := fn(ctx, sender.Addr, contract, stateDB, &method, args)
+
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			sequence, ok := out[0].(uint64)
+			s.Require().True(ok)
+			s.Require().Equal(uint64(1), sequence)
+			s.Require().True(s.network.App.IBCKeeper.ChannelKeeper.HasPacketCommitment(ctx, transfertypes.PortID, s.channelID, sequence))
+
+			balance := s.network.App.BankKeeper.GetBalance(ctx, sender.AccAddr, denom)
+			s.Require().Equal(prevBalance.Amount.Sub(math.NewIntFromBigInt(amount)), balance.Amount)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package stride
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+const (
+	// StrideBech32Prefix is the bech32 prefix of the Stride accounts.
+	StrideBech32Prefix = "stride"
+	// LiquidStakeAction is the autopilot action that liquid stakes the
+	// transferred tokens.
+	LiquidStakeAction = "LiquidStake"
+	// RedeemStakeAction is the autopilot action that redeems the transferred
+	// liquid staked tokens.
+	RedeemStakeAction = "RedeemStake"
+	// stTokenPrefix is the prefix of the denominations of the liquid staked
+	// tokens on Stride.
+	stTokenPrefix = "st"
+)
+
+// NewAutopilotArgs parses and validates the arguments of the liquidStake and
+// redeem methods. The receiver is returned as a bech32 address.
+func NewAutopilotArgs(args []interface{}) (*big.Int, string, error) {
+	if len(args) != 2 {
+		return nil, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	amount, ok := args[0].(*big.Int)
+	if !ok || amount == nil || amount.Sign() <= 0 {
+		return nil, "", fmt.Errorf(cmn.ErrInvalidAmount, args[0])
+	}
+
+	receiverArg, ok := args[1].(string)
+	if !ok {
+		return nil, "", fmt.Errorf(ErrInvalidReceiver, args[1])
+	}
+
+	// NOTE: the tokens are returned to Evmos, so the receiver must be a hex or
+	// bech32 address of this chain
+	if common.IsHexAddress(receiverArg) {
+		return amount, sdk.AccAddress(common.HexToAddress(receiverArg).Bytes()).String(), nil
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(receiverArg)
+	if err != nil {
+		return nil, "", fmt.Errorf(ErrInvalidReceiver, receiverArg)
+	}
+
+	return amount, receiver.String(), nil
+}
+
+// autopilotMemo defines the memo of an ICS-20 transfer that triggers an action
+// of the Stride autopilot module.
+type autopilotMemo struct {
+	Autopilot autopilot `json:"autopilot"`
+}
+
+// autopilot defines the Stride account that receives the transfer and the
+// liquid staking action to be executed.
+type autopilot struct {
+	Receiver string   `json:"receiver"`
+	StakeIBC stakeIBC `json:"stakeibc"`
+}
+
+// stakeIBC defines a liquid staking action and the account on the host chain
+// that receives its output tokens.
+type stakeIBC struct {
+	Action      string `json:"action"`
+	IBCReceiver string `json:"ibc_receiver"`
+}
+
+// BuildAutopilotMemo returns the autopilot memo that executes the given action
+// on Stride for the tokens received by the Stride receiver, sending the output
+// tokens to the IBC receiver, i.e.
+// {"autopilot":{"receiver":"<stride receiver>","stakeibc":{"action":"<action>","ibc_receiver":"<ibc receiver>"}}}.
+func BuildAutopilotMemo(action, strideReceiver, ibcReceiver string) (string, error) {
+	bz, err := json.Marshal(autopilotMemo{
+		Autopilot: autopilot{
+			Receiver: strideReceiver,
+			StakeIBC: stakeIBC{
+				Action:      action,
+				IBCReceiver: ibcReceiver,
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+// StTokenDenom returns the denomination on Evmos of the liquid staked tokens
+// of the given denomination, received from Stride through the given channel.
+func StTokenDenom(portID, channelID, denom string) string {
+	return transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(portID, channelID, stTokenPrefix+denom),
+	).IBCDenom()
+}
@@ -0,0 +1,57 @@
+package stride
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAutopilotArgs(t *testing.T) {
+	receiver := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	bech32Receiver := sdk.AccAddress(receiver.Bytes()).String()
+
+	testCases := []struct {
+		name   string
+		args   []interface{}
+		errMsg string
+	}{
+		{"pass - hex receiver", []interface{}{big.NewInt(1), receiver.Hex()}, ""},
+		{"pass - bech32 receiver", []interface{}{big.NewInt(1), bech32Receiver}, ""},
+		{"fail - invalid number of args", []interface{}{big.NewInt(1)}, "invalid number of arguments"},
+		{"fail - zero amount", []interface{}{big.NewInt(0), bech32Receiver}, "invalid amount"},
+		{"fail - invalid receiver", []interface{}{big.NewInt(1), "stride1invalid"}, "invalid receiver"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			amount, parsedReceiver, err := NewAutopilotArgs(tc.args)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, int64(1), amount.Int64())
+			require.Equal(t, bech32Receiver, parsedReceiver)
+		})
+	}
+}
+
+func TestBuildAutopilotMemo(t *testing.T) {
+	memo, err := BuildAutopilotMemo(LiquidStakeAction, "stride1receiver", "evmos1receiver")
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"autopilot":{"receiver":"stride1receiver","stakeibc":{"action":"LiquidStake","ibc_receiver":"evmos1receiver"}}}`,
+		memo,
+	)
+}
+
+func TestStTokenDenom(t *testing.T) {
+	require.Equal(t,
+		transfertypes.ParseDenomTrace("transfer/channel-25/staevmos").IBCDenom(),
+		StTokenDenom(transfertypes.PortID, "channel-25", "aevmos"),
+	)
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IP256Batch contract's address.
+address constant P256_BATCH_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000403;
+
+/// @dev The IP256Batch contract's instance.
+IP256Batch constant P256_BATCH_CONTRACT = IP256Batch(P256_BATCH_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title P256 Batch Verification Precompiled Contract
+/// @dev The interface through which solidity contracts can verify several
+/// secp256r1 signatures in a single call. Single signatures are verified by
+/// calling the RIP-7212 precompile at 0x100 with its raw 160 bytes input.
+/// @custom:address 0x0000000000000000000000000000000000000403
+interface IP256Batch {
+    /// @dev Verifies a batch of secp256r1 signatures. Each input is encoded as in
+    /// RIP-7212: the 32 bytes hash of the signed data, followed by the 32 bytes r
+    /// and s components of the signature and the 32 bytes x and y coordinates of
+    /// the public key. The gas cost is charged for the whole batch upfront.
+    /// @param inputs The 160 bytes inputs of the signatures to be verified.
+    /// @return results Whether each signature is valid, in the order of the inputs.
+    /// Inputs with an invalid length are reported as invalid signatures.
+    function batchVerify(
+        bytes[] calldata inputs
+    ) external view returns (bool[] memory results);
+}
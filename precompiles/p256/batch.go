@@ -0,0 +1,164 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package p256
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &BatchPrecompile{}
+
+const (
+	// BatchVerifyMethod defines the ABI method name to verify a batch of signatures.
+	BatchVerifyMethod = "batchVerify"
+	// BatchVerifyBaseGas is the gas charged once for each batch verification
+	// call, which covers the call and input decoding overhead that a single
+	// verification at the RIP-7212 address pays on each call.
+	BatchVerifyBaseGas uint64 = 1000
+	// BatchVerifyInputGas is the gas charged for each signature of a batch. A
+	// batch of a single signature costs the same as a RIP-7212 verification.
+	BatchVerifyInputGas = VerifyGas - BatchVerifyBaseGas
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// BatchPrecompile defines the precompiled contract to verify several secp256r1
+// signatures in a single call. It lives on its own address so that the raw
+// input interface of the RIP-7212 precompile is left untouched.
+type BatchPrecompile struct {
+	abi.ABI
+}
+
+// LoadABI loads the ABI of the p256 batch verification precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewBatchPrecompile creates a new p256 BatchPrecompile instance as a
+// PrecompiledContract interface.
+func NewBatchPrecompile() (*BatchPrecompile, error) {
+	newABI, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	return &BatchPrecompile{
+		ABI: newABI,
+	}, nil
+}
+
+// Address defines the address of the p256 batch verification precompiled contract.
+func (BatchPrecompile) Address() common.Address {
+	return common.HexToAddress(evmtypes.P256BatchPrecompileAddress)
+}
+
+// RequiredGas returns the gas required to verify all the signatures of the
+// batch. Inputs that can't be decoded are only charged the base gas, since
+// they fail during Run.
+func (p BatchPrecompile) RequiredGas(input []byte) uint64 {
+	inputs, err := p.parseBatchVerifyInput(input)
+	if err != nil {
+		return BatchVerifyBaseGas
+	}
+
+	return BatchVerifyBaseGas + uint64(len(inputs))*BatchVerifyInputGas //nolint:gosec // G115
+}
+
+// Run executes the precompiled contract p256 batch methods defined in the ABI.
+func (p BatchPrecompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(contract.Input) < 4 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	method, err := p.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case BatchVerifyMethod:
+		bz, err = p.BatchVerify(method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// BatchVerify verifies each of the RIP-7212 encoded inputs and returns whether
+// each signature is valid. Inputs with an invalid length are reported as
+// invalid signatures.
+func (p BatchPrecompile) BatchVerify(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	inputs, err := parseBatchVerifyArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]bool, len(inputs))
+	for i, input := range inputs {
+		results[i] = verify(input)
+	}
+
+	return method.Outputs.Pack(results)
+}
+
+// parseBatchVerifyInput parses the inputs of an ABI encoded batchVerify call.
+func (p BatchPrecompile) parseBatchVerifyInput(input []byte) ([][]byte, error) {
+	if len(input) < 4 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	if method.Name != BatchVerifyMethod {
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBatchVerifyArgs(args)
+}
+
+// parseBatchVerifyArgs parses the arguments of the batchVerify method.
+func parseBatchVerifyArgs(args []interface{}) ([][]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	inputs, ok := args[0].([][]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid inputs type %T, expected [][]byte", args[0])
+	}
+
+	return inputs, nil
+}
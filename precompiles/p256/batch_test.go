@@ -0,0 +1,156 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package p256_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+
+	"github.com/evmos/evmos/v20/precompiles/p256"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestBatchAddress() {
+	s.Require().Equal(evmtypes.P256BatchPrecompileAddress, s.batchPrecompile.Address().String())
+}
+
+func (s *PrecompileTestSuite) TestBatchRequiredGas() {
+	input := signMsg([]byte("hello world"), s.p256Priv)
+
+	testCases := []struct {
+		name   string
+		input  func() []byte
+		expGas uint64
+	}{
+		{
+			"invalid input",
+			func() []byte { return input },
+			p256.BatchVerifyBaseGas,
+		},
+		{
+			"empty batch",
+			func() []byte {
+				bz, err := s.batchPrecompile.Pack(p256.BatchVerifyMethod, [][]byte{})
+				s.Require().NoError(err)
+				return bz
+			},
+			p256.BatchVerifyBaseGas,
+		},
+		{
+			"batch of a single signature",
+			func() []byte {
+				bz, err := s.batchPrecompile.Pack(p256.BatchVerifyMethod, [][]byte{input})
+				s.Require().NoError(err)
+				return bz
+			},
+			p256.VerifyGas,
+		},
+		{
+			"batch of three signatures",
+			func() []byte {
+				bz, err := s.batchPrecompile.Pack(p256.BatchVerifyMethod, [][]byte{input, input, input})
+				s.Require().NoError(err)
+				return bz
+			},
+			p256.BatchVerifyBaseGas + 3*p256.BatchVerifyInputGas,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.expGas, s.batchPrecompile.RequiredGas(tc.input()))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestBatchVerify() {
+	otherPriv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name        string
+		input       func() []byte
+		expResults  []bool
+		errContains string
+	}{
+		{
+			"pass - empty batch",
+			func() []byte {
+				bz, err := s.batchPrecompile.Pack(p256.BatchVerifyMethod, [][]byte{})
+				s.Require().NoError(err)
+				return bz
+			},
+			[]bool{},
+			"",
+		},
+		{
+			"pass - all signatures valid",
+			func() []byte {
+				inputs := [][]byte{
+					signMsg([]byte("hello world"), s.p256Priv),
+					signMsg([]byte("session key"), otherPriv),
+				}
+				bz, err := s.batchPrecompile.Pack(p256.BatchVerifyMethod, inputs)
+				s.Require().NoError(err)
+				return bz
+			},
+			[]bool{true, true},
+			"",
+		},
+		{
+			"pass - invalid signature and invalid length",
+			func() []byte {
+				invalidSig := signMsg([]byte("hello world"), s.p256Priv)
+				copy(invalidSig[96:128], otherPriv.PublicKey.X.Bytes())
+				copy(invalidSig[128:160], otherPriv.PublicKey.Y.Bytes())
+
+				inputs := [][]byte{
+					signMsg([]byte("hello world"), s.p256Priv),
+					invalidSig,
+					make([]byte, 32),
+				}
+				bz, err := s.batchPrecompile.Pack(p256.BatchVerifyMethod, inputs)
+				s.Require().NoError(err)
+				return bz
+			},
+			[]bool{true, false, false},
+			"",
+		},
+		{
+			"fail - raw RIP-7212 input",
+			func() []byte {
+				return signMsg([]byte("hello world"), s.p256Priv)
+			},
+			nil,
+			"no method with id",
+		},
+		{
+			"fail - invalid ABI encoding",
+			func() []byte {
+				bz, err := s.batchPrecompile.Pack(p256.BatchVerifyMethod, [][]byte{make([]byte, 32)})
+				s.Require().NoError(err)
+				return bz[:len(bz)-1]
+			},
+			nil,
+			"abi",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			bz, err := s.batchPrecompile.Run(nil, &vm.Contract{Input: tc.input()}, false)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var results []bool
+			err = s.batchPrecompile.UnpackIntoInterface(&results, p256.BatchVerifyMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expResults, results)
+		})
+	}
+}
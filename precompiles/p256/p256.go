@@ -61,11 +61,21 @@ func (p Precompile) RequiredGas(_ []byte) uint64 {
 // Output data: 32 bytes of result data and error
 //   - If the signature verification process succeeds, it returns 1 in 32 bytes format
 func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
-	input := contract.Input
+	if verify(contract.Input) {
+		// Signature is valid
+		return common.LeftPadBytes(common.Big1.Bytes(), 32), nil
+	}
+
+	// Signature is invalid
+	return nil, nil
+}
+
+// verify verifies the secp256r1 signature of a 160 bytes input. Inputs with an
+// invalid length are considered invalid signatures.
+func verify(input []byte) bool {
 	// Check the input length
 	if len(input) != VerifyInputLength {
-		// Input length is invalid
-		return nil, nil
+		return false
 	}
 
 	// Extract the hash, r, s, x, y from the input
@@ -74,11 +84,5 @@ func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, e
 	x, y := new(big.Int).SetBytes(input[96:128]), new(big.Int).SetBytes(input[128:160])
 
 	// Verify the secp256r1 signature
-	if secp256r1.Verify(hash, r, s, x, y) {
-		// Signature is valid
-		return common.LeftPadBytes(common.Big1.Bytes(), 32), nil
-	}
-
-	// Signature is invalid
-	return nil, nil
+	return secp256r1.Verify(hash, r, s, x, y)
 }
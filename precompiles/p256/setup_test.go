@@ -25,8 +25,9 @@ var s *PrecompileTestSuite
 
 type PrecompileTestSuite struct {
 	suite.Suite
-	p256Priv   *ecdsa.PrivateKey
-	precompile *p256.Precompile
+	p256Priv        *ecdsa.PrivateKey
+	precompile      *p256.Precompile
+	batchPrecompile *p256.BatchPrecompile
 }
 
 func TestPrecompileTestSuite(t *testing.T) {
@@ -43,6 +44,10 @@ func (s *PrecompileTestSuite) SetupTest() {
 	s.Require().NoError(err)
 	s.p256Priv = p256Priv
 	s.precompile = &p256.Precompile{}
+
+	batchPrecompile, err := p256.NewBatchPrecompile()
+	s.Require().NoError(err)
+	s.batchPrecompile = batchPrecompile
 }
 
 func signMsg(msg []byte, priv *ecdsa.PrivateKey) []byte {
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The ISchnorr contract's address.
+address constant SCHNORR_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000401;
+
+/// @dev The ISchnorr contract's instance.
+ISchnorr constant SCHNORR_CONTRACT = ISchnorr(SCHNORR_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title Schnorr Precompiled Contract
+/// @dev The interface through which solidity contracts can verify BIP-340
+/// Schnorr signatures over the secp256k1 curve, as used by Bitcoin Taproot.
+/// @custom:address 0x0000000000000000000000000000000000000401
+interface ISchnorr {
+    /// @dev Verifies a BIP-340 Schnorr signature.
+    /// @param publicKey The 32 bytes x-only public key of the signer.
+    /// @param messageHash The 32 bytes message that was signed.
+    /// @param signature The 64 bytes signature.
+    /// @return valid Whether the signature is valid. Public keys that are not on
+    /// the curve and malformed signatures are reported as invalid.
+    function verify(
+        bytes32 publicKey,
+        bytes32 messageHash,
+        bytes calldata signature
+    ) external view returns (bool valid);
+}
@@ -0,0 +1,65 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package schnorr
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+const (
+	// VerifyMethod defines the ABI method name to verify a BIP-340 Schnorr
+	// signature.
+	VerifyMethod = "verify"
+)
+
+// Verify verifies a BIP-340 Schnorr signature of the given 32 bytes message with
+// the given x-only public key. Public keys that are not on the curve and
+// signatures that can't be parsed are reported as invalid, like any other
+// signature that fails the verification. This function only fails if the
+// signature doesn't have the expected length.
+func (p Precompile) Verify(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	publicKey, ok := args[0].([32]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid public key: %v", args[0])
+	}
+
+	messageHash, ok := args[1].([32]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid message hash: %v", args[1])
+	}
+
+	signature, ok := args[2].([]byte)
+	if !ok || len(signature) != schnorr.SignatureSize {
+		return nil, fmt.Errorf("invalid signature, expected %d bytes: %v", schnorr.SignatureSize, args[2])
+	}
+
+	return method.Outputs.Pack(verify(publicKey[:], messageHash[:], signature))
+}
+
+// verify returns true if the signature is a valid BIP-340 Schnorr signature of
+// the message hash for the x-only public key.
+func verify(publicKey, messageHash, signature []byte) bool {
+	pubKey, err := schnorr.ParsePubKey(publicKey)
+	if err != nil {
+		return false
+	}
+
+	sig, err := schnorr.ParseSignature(signature)
+	if err != nil {
+		return false
+	}
+
+	return sig.Verify(messageHash, pubKey)
+}
@@ -0,0 +1,87 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package schnorr
+
+import (
+	"embed"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// VerifyGas is the BIP-340 Schnorr signature verification gas price. It matches
+// the gas price of the ecrecover precompile, which performs an equivalent amount
+// of secp256k1 curve operations.
+const VerifyGas uint64 = 3000
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for BIP-340 Schnorr signature verification.
+type Precompile struct {
+	abi.ABI
+}
+
+// NewPrecompile creates a new Schnorr Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile() (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		ABI: newABI,
+	}, nil
+}
+
+// Address defines the address of the Schnorr precompiled contract.
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(evmtypes.SchnorrPrecompileAddress)
+}
+
+// RequiredGas calculates the contract gas use.
+func (Precompile) RequiredGas(_ []byte) uint64 {
+	return VerifyGas
+}
+
+// Run executes the precompiled contract Schnorr methods defined in the ABI.
+func (p Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(contract.Input) < 4 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	methodID := contract.Input[:4]
+	// NOTE: this function iterates over the method map and returns
+	// the method with the given ID
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		return nil, err
+	}
+
+	argsBz := contract.Input[4:]
+	args, err := method.Inputs.Unpack(argsBz)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case VerifyMethod:
+		bz, err = p.Verify(method, args)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,140 @@
+package schnorr_test
+
+import (
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcschnorr "github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v20/precompiles/schnorr"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestAddress() {
+	s.Require().Equal(evmtypes.SchnorrPrecompileAddress, s.precompile.Address().String())
+}
+
+func (s *PrecompileTestSuite) TestRequiredGas() {
+	s.Require().Equal(schnorr.VerifyGas, s.precompile.RequiredGas(nil))
+}
+
+// TestRun tests the precompile's Run method.
+func (s *PrecompileTestSuite) TestRun() {
+	contract := vm.NewPrecompile(
+		vm.AccountRef(common.Address{}),
+		s.precompile,
+		big.NewInt(0),
+		uint64(1000000),
+	)
+
+	hash := crypto.Keccak256Hash([]byte("hello world"))
+
+	sign := func(privKey *btcec.PrivateKey, msg common.Hash) []byte {
+		sig, err := btcschnorr.Sign(privKey, msg.Bytes())
+		s.Require().NoError(err, "failed to sign message")
+		return sig.Serialize()
+	}
+
+	xOnlyPubKey := func(privKey *btcec.PrivateKey) common.Hash {
+		return common.BytesToHash(btcschnorr.SerializePubKey(privKey.PubKey()))
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []byte
+		expValid    bool
+		errContains string
+	}{
+		{
+			"fail - invalid method",
+			func() []byte {
+				return []byte("invalid")
+			},
+			false,
+			"no method with id",
+		},
+		{
+			"fail - error during unpack",
+			func() []byte {
+				return s.precompile.Methods[schnorr.VerifyMethod].ID
+			},
+			false,
+			"abi: attempting to unmarshall an empty string while arguments are expected",
+		},
+		{
+			"fail - invalid signature length",
+			func() []byte {
+				input, err := s.precompile.Pack(schnorr.VerifyMethod, xOnlyPubKey(s.privKey), hash, []byte{1, 2, 3})
+				s.Require().NoError(err, "failed to pack input")
+				return input
+			},
+			false,
+			"invalid signature, expected 64 bytes",
+		},
+		{
+			"pass - valid signature",
+			func() []byte {
+				input, err := s.precompile.Pack(schnorr.VerifyMethod, xOnlyPubKey(s.privKey), hash, sign(s.privKey, hash))
+				s.Require().NoError(err, "failed to pack input")
+				return input
+			},
+			true,
+			"",
+		},
+		{
+			"pass - signature of a different message",
+			func() []byte {
+				sig := sign(s.privKey, crypto.Keccak256Hash([]byte("other message")))
+				input, err := s.precompile.Pack(schnorr.VerifyMethod, xOnlyPubKey(s.privKey), hash, sig)
+				s.Require().NoError(err, "failed to pack input")
+				return input
+			},
+			false,
+			"",
+		},
+		{
+			"pass - signature of a different signer",
+			func() []byte {
+				otherKey, err := btcec.NewPrivateKey()
+				s.Require().NoError(err, "failed to generate private key")
+				input, err := s.precompile.Pack(schnorr.VerifyMethod, xOnlyPubKey(s.privKey), hash, sign(otherKey, hash))
+				s.Require().NoError(err, "failed to pack input")
+				return input
+			},
+			false,
+			"",
+		},
+		{
+			"pass - public key out of the field",
+			func() []byte {
+				input, err := s.precompile.Pack(schnorr.VerifyMethod, common.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"), hash, sign(s.privKey, hash))
+				s.Require().NoError(err, "failed to pack input")
+				return input
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			contract.Input = tc.malleate()
+
+			bz, err := s.precompile.Run(nil, contract, true)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			args, err := s.precompile.Unpack(schnorr.VerifyMethod, bz)
+			s.Require().NoError(err, "failed to unpack output")
+			s.Require().Len(args, 1)
+			valid, ok := args[0].(bool)
+			s.Require().True(ok)
+			s.Require().Equal(tc.expValid, valid)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package schnorr_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/evmos/evmos/v20/precompiles/schnorr"
+	"github.com/stretchr/testify/suite"
+)
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for the
+// Schnorr precompile unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	privKey    *btcec.PrivateKey
+	precompile *schnorr.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	privKey, err := btcec.NewPrivateKey()
+	s.Require().NoError(err, "failed to generate private key")
+
+	precompile, err := schnorr.NewPrecompile()
+	s.Require().NoError(err, "failed to create schnorr precompile")
+
+	s.privKey = privKey
+	s.precompile = precompile
+}
@@ -0,0 +1,48 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+// sdkgen generates the Run, RequiredGas, IsTransaction and HandleMethod
+// boilerplate of a precompile from its ABI. It is meant to be invoked
+// through go:generate from the precompile package, e.g.:
+//
+//	//go:generate go run github.com/evmos/evmos/v20/precompiles/sdkgen/cmd/sdkgen -abi abi.json -out precompile.gen.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/evmos/evmos/v20/precompiles/sdkgen/gen"
+)
+
+func main() {
+	abiPath := flag.String("abi", "abi.json", "path to the ABI JSON or compiled contract artifact")
+	out := flag.String("out", "precompile.gen.go", "path of the generated Go file")
+	pkg := flag.String("pkg", os.Getenv("GOPACKAGE"), "Go package name of the generated file (defaults to $GOPACKAGE)")
+	typeName := flag.String("type", gen.DefaultTypeName, "precompile type the generated methods are attached to")
+	flag.Parse()
+
+	if err := run(*abiPath, *out, *pkg, *typeName); err != nil {
+		fmt.Fprintln(os.Stderr, "sdkgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(abiPath, out, pkg, typeName string) error {
+	bz, err := os.ReadFile(abiPath)
+	if err != nil {
+		return err
+	}
+
+	src, err := gen.Generate(gen.Config{
+		Package:  pkg,
+		TypeName: typeName,
+		ABI:      bz,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(out, src, 0o600)
+}
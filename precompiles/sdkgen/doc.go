@@ -0,0 +1,26 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+// Package sdkgen generates and runs the boilerplate shared by all the
+// precompiles: the method name constants, RequiredGas, Run, IsTransaction
+// and the HandleMethod dispatcher.
+//
+// The generated code is derived from the precompile ABI. State-mutating
+// methods (nonpayable and payable) are treated as transactions and view or
+// pure methods as queries. Each ABI method is dispatched to a handler on the
+// precompile type named after the method, with the HandleFunc signature,
+// e.g. the "balanceOf" method is handled by:
+//
+//	func (p Precompile) BalanceOf(
+//		ctx sdk.Context,
+//		evm *vm.EVM,
+//		contract *vm.Contract,
+//		stateDB vm.StateDB,
+//		method *abi.Method,
+//		args []interface{},
+//	) ([]byte, error)
+//
+// Precompiles only need to embed cmn.Precompile and add the directive:
+//
+//	//go:generate go run github.com/evmos/evmos/v20/precompiles/sdkgen/cmd/sdkgen -abi abi.json -out precompile.gen.go
+package sdkgen
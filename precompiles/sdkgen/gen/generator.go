@@ -0,0 +1,266 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// DefaultTypeName is the precompile type the generated methods are attached
+// to when no type name is provided.
+const DefaultTypeName = "Precompile"
+
+// Config defines the inputs of the precompile boilerplate generator.
+type Config struct {
+	// Package is the Go package name of the generated file.
+	Package string
+	// TypeName is the precompile struct type. It must embed cmn.Precompile.
+	TypeName string
+	// ABI is either a raw ABI JSON array or a compiled contract artifact
+	// containing an "abi" field.
+	ABI []byte
+}
+
+// method is the template view of a single ABI method.
+type method struct {
+	Name        string
+	Const       string
+	Handler     string
+	Transaction bool
+}
+
+// templateData is the template view of the generated file.
+type templateData struct {
+	Package      string
+	TypeName     string
+	Transactions []method
+	Queries      []method
+}
+
+// Generate returns the gofmt'ed Go source with the method name constants,
+// IsTransaction, RequiredGas, Run and HandleMethod of the precompile
+// described by the ABI. Every ABI method must be implemented by the
+// precompile type as a handler named after the method with the signature
+// of sdkgen.HandleFunc, so that missing handlers fail at compile time.
+func Generate(cfg Config) ([]byte, error) {
+	if !token.IsIdentifier(cfg.Package) {
+		return nil, fmt.Errorf("invalid package name %q", cfg.Package)
+	}
+
+	typeName := cfg.TypeName
+	if typeName == "" {
+		typeName = DefaultTypeName
+	}
+	if !token.IsIdentifier(typeName) {
+		return nil, fmt.Errorf("invalid type name %q", typeName)
+	}
+
+	contractABI, err := ParseABI(cfg.ABI)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(contractABI.Methods) == 0 {
+		return nil, fmt.Errorf("ABI does not define any method")
+	}
+
+	data := templateData{
+		Package:  cfg.Package,
+		TypeName: typeName,
+	}
+
+	for _, name := range sortedMethodNames(contractABI) {
+		m := contractABI.Methods[name]
+		ident := exportedIdentifier(m.Name)
+		if ident == "" {
+			return nil, fmt.Errorf("method %q cannot be converted into a Go identifier", m.Name)
+		}
+
+		gm := method{
+			Name:        m.Name,
+			Const:       ident + "Method",
+			Handler:     ident,
+			Transaction: !m.IsConstant(),
+		}
+
+		if gm.Transaction {
+			data.Transactions = append(data.Transactions, gm)
+		} else {
+			data.Queries = append(data.Queries, gm)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated source: %w", err)
+	}
+
+	return src, nil
+}
+
+// ParseABI parses either a raw ABI JSON array or a compiled contract
+// artifact (e.g. Hardhat or Foundry output) that contains an "abi" field.
+func ParseABI(bz []byte) (abi.ABI, error) {
+	bz = bytes.TrimSpace(bz)
+	if len(bz) == 0 {
+		return abi.ABI{}, fmt.Errorf("empty ABI")
+	}
+
+	if bz[0] == '{' {
+		var artifact struct {
+			ABI json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal(bz, &artifact); err != nil {
+			return abi.ABI{}, fmt.Errorf("invalid contract artifact: %w", err)
+		}
+		if len(artifact.ABI) == 0 {
+			return abi.ABI{}, fmt.Errorf("contract artifact does not contain an ABI")
+		}
+		bz = artifact.ABI
+	}
+
+	contractABI, err := abi.JSON(bytes.NewReader(bz))
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("invalid ABI: %w", err)
+	}
+
+	return contractABI, nil
+}
+
+// sortedMethodNames returns the ABI method names in lexicographic order so
+// that the generated output is deterministic.
+func sortedMethodNames(contractABI abi.ABI) []string {
+	names := make([]string, 0, len(contractABI.Methods))
+	for name := range contractABI.Methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// exportedIdentifier converts an ABI method name (e.g. "balanceOf" or the
+// "transfer0" name of an overloaded method) into an exported Go identifier.
+func exportedIdentifier(name string) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return ""
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		sb.WriteRune(r)
+	}
+
+	ident := sb.String()
+	if ident == "" || !unicode.IsLetter([]rune(ident)[0]) {
+		return ""
+	}
+	return ident
+}
+
+var fileTemplate = template.Must(template.New("precompile").Parse(`// Code generated by sdkgen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/sdkgen"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+{{- range .Transactions }}
+	// {{ .Const }} defines the ABI method name for the {{ .Name }} transaction.
+	{{ .Const }} = "{{ .Name }}"
+{{- end }}
+{{- range .Queries }}
+	// {{ .Const }} defines the ABI method name for the {{ .Name }} query.
+	{{ .Const }} = "{{ .Name }}"
+{{- end }}
+)
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p {{ .TypeName }}) RequiredGas(input []byte) uint64 {
+	return sdkgen.RequiredGas(p.Precompile, input, p.IsTransaction)
+}
+
+// Run executes the precompiled contract methods defined in the ABI.
+func (p {{ .TypeName }}) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	return sdkgen.Run(&p.Precompile, evm, contract, readOnly, p.IsTransaction, p.HandleMethod)
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+{{- if .Transactions }}
+func ({{ .TypeName }}) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case {{ range $i, $m := .Transactions }}{{ if $i }},
+		{{ end }}{{ $m.Const }}{{ end }}:
+		return true
+	default:
+		return false
+	}
+}
+{{- else }}
+func ({{ .TypeName }}) IsTransaction(_ *abi.Method) bool {
+	return false
+}
+{{- end }}
+
+// HandleMethod handles the execution of each of the precompile methods.
+func (p *{{ .TypeName }}) HandleMethod(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) (bz []byte, err error) {
+	switch method.Name {
+{{- if .Transactions }}
+	// Transactions
+{{- end }}
+{{- range .Transactions }}
+	case {{ .Const }}:
+		bz, err = p.{{ .Handler }}(ctx, evm, contract, stateDB, method, args)
+{{- end }}
+{{- if .Queries }}
+	// Queries
+{{- end }}
+{{- range .Queries }}
+	case {{ .Const }}:
+		bz, err = p.{{ .Handler }}(ctx, evm, contract, stateDB, method, args)
+{{- end }}
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	return bz, err
+}
+`))
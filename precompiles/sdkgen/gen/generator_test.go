@@ -0,0 +1,101 @@
+package gen_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evmos/evmos/v20/precompiles/sdkgen/gen"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// transferABI contains the ERC-20 transfer method.
+	transferABI = `[{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}]`
+	// viewABI contains a single view method.
+	viewABI = `[{"type":"function","name":"count","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]}]`
+	// eventOnlyABI contains an event but no methods.
+	eventOnlyABI = `[{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"}]}]`
+)
+
+func TestGenerate(t *testing.T) {
+	counterABI, err := os.ReadFile(filepath.Join("testdata", "counter.abi.json"))
+	require.NoError(t, err)
+	counterGolden, err := os.ReadFile(filepath.Join("testdata", "counter.gen.go.golden"))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		cfg         gen.Config
+		expected    []byte
+		contains    []string
+		errContains string
+	}{
+		{
+			name:     "pass - contract artifact matches golden file",
+			cfg:      gen.Config{Package: "counter", ABI: counterABI},
+			expected: counterGolden,
+		},
+		{
+			name: "pass - raw ABI with custom type name",
+			cfg:  gen.Config{Package: "token", TypeName: "Token", ABI: []byte(transferABI)},
+			contains: []string{
+				`TransferMethod = "transfer"`,
+				"func (p Token) Run(",
+				"func (p *Token) HandleMethod(",
+				"bz, err = p.Transfer(ctx, evm, contract, stateDB, method, args)",
+			},
+		},
+		{
+			name: "pass - ABI without transactions",
+			cfg:  gen.Config{Package: "counter", ABI: []byte(viewABI)},
+			contains: []string{
+				"func (Precompile) IsTransaction(_ *abi.Method) bool {\n\treturn false\n}",
+				"bz, err = p.Count(ctx, evm, contract, stateDB, method, args)",
+			},
+		},
+		{
+			name:        "fail - invalid package name",
+			cfg:         gen.Config{Package: "my-pkg", ABI: []byte(transferABI)},
+			errContains: "invalid package name",
+		},
+		{
+			name:        "fail - invalid type name",
+			cfg:         gen.Config{Package: "token", TypeName: "1Token", ABI: []byte(transferABI)},
+			errContains: "invalid type name",
+		},
+		{
+			name:        "fail - empty ABI",
+			cfg:         gen.Config{Package: "token"},
+			errContains: "empty ABI",
+		},
+		{
+			name:        "fail - artifact without ABI",
+			cfg:         gen.Config{Package: "token", ABI: []byte(`{"contractName":"IToken"}`)},
+			errContains: "does not contain an ABI",
+		},
+		{
+			name:        "fail - ABI without methods",
+			cfg:         gen.Config{Package: "token", ABI: []byte(eventOnlyABI)},
+			errContains: "does not define any method",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src, err := gen.Generate(tc.cfg)
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			if tc.expected != nil {
+				require.Equal(t, string(tc.expected), string(src))
+			}
+			for _, s := range tc.contains {
+				require.Contains(t, string(src), s)
+			}
+		})
+	}
+}
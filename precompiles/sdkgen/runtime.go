@@ -0,0 +1,91 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package sdkgen
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+// IsTransactionFunc reports whether the given ABI method modifies state.
+type IsTransactionFunc func(method *abi.Method) bool
+
+// HandleFunc dispatches a decoded precompile call to the Go handler of the
+// corresponding ABI method.
+type HandleFunc func(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error)
+
+// RequiredGas returns the bare minimum gas to execute the precompile call
+// encoded in the input, using the flat KV gas costs of the common precompile.
+func RequiredGas(p cmn.Precompile, input []byte, isTransaction IsTransactionFunc) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.RequiredGas(input, isTransaction(method))
+}
+
+// Run executes a precompile call following the flow shared by all the
+// precompiles: it sets up the cache context, recovers out of gas panics,
+// dispatches the call to the handler, charges the consumed gas and adds the
+// journal entries to the EVM state. Cosmos SDK errors returned by the
+// handler revert with the CosmosError custom error.
+//
+// The precompile must be passed by reference so that the balance change
+// entries set by the handlers are visible when the journal entries are
+// added.
+func Run(
+	p *cmn.Precompile,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	readOnly bool,
+	isTransaction IsTransactionFunc,
+	handle HandleFunc,
+) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, isTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	bz, err = handle(ctx, evm, contract, stateDB, method, args)
+	if err != nil {
+		// NOTE: the gas consumed until the failure is charged here, since the
+		// CosmosError revert returns the remaining gas to the caller.
+		if !contract.UseGas(ctx.GasMeter().GasConsumed() - initialGas) {
+			return nil, vm.ErrOutOfGas
+		}
+		return cmn.RevertWithCosmosError(err)
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
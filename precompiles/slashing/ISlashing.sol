@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The ISlashing contract's address.
+address constant SLASHING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000806;
+
+/// @dev The ISlashing contract's instance.
+ISlashing constant SLASHING_CONTRACT = ISlashing(SLASHING_PRECOMPILE_ADDRESS);
+
+/// @dev SigningInfo defines the liveness information of a validator.
+struct SigningInfo {
+    // the consensus address of the validator
+    address validatorAddress;
+    // the height at which the validator started signing blocks
+    int64 startHeight;
+    // the index offset into the signed block bit array
+    int64 indexOffset;
+    // the unix timestamp until which the validator is jailed
+    int64 jailedUntil;
+    // whether the validator is tombstoned, i.e. it can't be unjailed anymore
+    bool tombstoned;
+    // the number of blocks missed in the current window
+    int64 missedBlocksCounter;
+}
+
+/// @dev Params defines the parameters of the slashing module.
+struct Params {
+    // the number of blocks over which the liveness of the validators is tracked
+    int64 signedBlocksWindow;
+    // the minimum fraction of blocks that must be signed within the window
+    Dec minSignedPerWindow;
+    // the jail duration in seconds for the validators that miss too many blocks
+    int64 downtimeJailDuration;
+    // the fraction of the stake slashed for a double sign
+    Dec slashFractionDoubleSign;
+    // the fraction of the stake slashed for downtime
+    Dec slashFractionDowntime;
+}
+
+/// @author Evmos Team
+/// @title Slashing Precompiled Contract
+/// @dev The interface through which solidity contracts and validator tooling
+/// interact with the slashing module.
+/// @custom:address 0x0000000000000000000000000000000000000806
+interface ISlashing {
+    /// @dev Emitted when a validator is unjailed.
+    /// @param validatorAddress The address of the validator operator.
+    event ValidatorUnjailed(address indexed validatorAddress);
+
+    /// @dev Unjail unjails a validator that was jailed for downtime.
+    /// @param validatorAddress The address of the validator operator.
+    /// @return success Whether the validator was unjailed.
+    function unjail(address validatorAddress) external returns (bool success);
+
+    /// @dev GetSigningInfo returns the signing information of a validator.
+    /// @param consAddress The consensus address of the validator.
+    /// @return signingInfo The signing information of the validator.
+    function getSigningInfo(
+        address consAddress
+    ) external view returns (SigningInfo memory signingInfo);
+
+    /// @dev GetSigningInfos returns the signing information of all validators.
+    /// @param pagination The pagination options.
+    /// @return signingInfos The signing information of the validators.
+    /// @return pageResponse The pagination response.
+    function getSigningInfos(
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            SigningInfo[] memory signingInfos,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev GetParams returns the parameters of the slashing module.
+    /// @return params The parameters of the slashing module.
+    function getParams() external view returns (Params memory params);
+}
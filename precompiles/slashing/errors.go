@@ -0,0 +1,12 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package slashing
+
+const (
+	// ErrDifferentOrigin is raised when the origin address is not the same as the validator address.
+	ErrDifferentOrigin = "tx origin address %s does not match the validator address %s"
+	// ErrInvalidValidatorAddress is raised when the validator address is not valid.
+	ErrInvalidValidatorAddress = "invalid validator address: %s"
+	// ErrInvalidConsAddress is raised when the consensus address is not valid.
+	ErrInvalidConsAddress = "invalid consensus address: %s"
+)
@@ -0,0 +1,143 @@
+package slashing_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/slashing"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestGetSigningInfo() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[slashing.GetSigningInfoMethod]
+	consAddr := utiltx.GenerateAddress()
+	jailedUntil := time.Unix(1_000_000, 0).UTC()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid consensus address",
+			func() []interface{} {
+				return []interface{}{common.Address{}}
+			},
+			true,
+			"invalid consensus address",
+		},
+		{
+			"fail - signing info not found",
+			func() []interface{} {
+				return []interface{}{consAddr}
+			},
+			true,
+			"SigningInfo not found",
+		},
+		{
+			"success - signing info found",
+			func() []interface{} {
+				err := s.network.App.SlashingKeeper.SetValidatorSigningInfo(
+					ctx,
+					consAddr.Bytes(),
+					slashingtypes.NewValidatorSigningInfo(sdk.ConsAddress(consAddr.Bytes()), 10, 2, jailedUntil, false, 3),
+				)
+				s.Require().NoError(err)
+				return []interface{}{consAddr}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+
+			bz, err := s.precompile.GetSigningInfo(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out slashing.SigningInfoOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, slashing.GetSigningInfoMethod, bz))
+			s.Require().Equal(slashing.SigningInfo{
+				ValidatorAddress:    consAddr,
+				StartHeight:         10,
+				IndexOffset:         2,
+				JailedUntil:         jailedUntil.Unix(),
+				Tombstoned:          false,
+				MissedBlocksCounter: 3,
+			}, out.SigningInfo)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetSigningInfos() {
+	method := s.precompile.Methods[slashing.GetSigningInfosMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+
+	var contract *vm.Contract
+	contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+
+	bz, err := s.precompile.GetSigningInfos(ctx, &method, contract, []interface{}{query.PageRequest{Limit: 10, CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out slashing.SigningInfosOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, slashing.GetSigningInfosMethod, bz))
+
+	infos, err := slashingkeeper.NewQuerier(s.network.App.SlashingKeeper).SigningInfos(ctx, &slashingtypes.QuerySigningInfosRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(out.SigningInfos, len(infos.Info))
+	s.Require().Equal(uint64(len(infos.Info)), out.PageResponse.Total)
+}
+
+func (s *PrecompileTestSuite) TestGetParams() {
+	method := s.precompile.Methods[slashing.GetParamsMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+
+	var contract *vm.Contract
+	contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+
+	bz, err := s.precompile.GetParams(ctx, &method, contract, []interface{}{})
+	s.Require().NoError(err)
+
+	var out slashing.ParamsOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, slashing.GetParamsMethod, bz))
+
+	params, err := s.network.App.SlashingKeeper.GetParams(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params.SignedBlocksWindow, out.Params.SignedBlocksWindow)
+	s.Require().Equal(params.MinSignedPerWindow.BigInt(), out.Params.MinSignedPerWindow.Value)
+	s.Require().Equal(int64(params.DowntimeJailDuration.Seconds()), out.Params.DowntimeJailDuration)
+}
@@ -0,0 +1,82 @@
+package slashing_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/slashing"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestUnjail() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[slashing.UnjailMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid validator address",
+			func() []interface{} {
+				return []interface{}{common.Address{}}
+			},
+			200000,
+			true,
+			"invalid validator address",
+		},
+		{
+			"fail - using a different validator address",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress()}
+			},
+			200000,
+			true,
+			"does not match the validator address",
+		},
+		{
+			"fail - origin is not a validator",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			200000,
+			true,
+			"address is not associated with any known validator",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			_, err := s.precompile.Unjail(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
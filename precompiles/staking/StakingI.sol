@@ -202,6 +202,35 @@ interface StakingI is authorization.AuthorizationI {
         uint256 amount
     ) external returns (int64 completionTime);
 
+    /// @dev Defines a method for performing undelegations from multiple validators
+    /// in a single transaction. The undelegations are processed atomically.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddresses The addresses of the validators to undelegate from
+    /// @param amounts The amounts of the bond denomination to be undelegated from each validator
+    /// These amounts should use the bond denomination precision stored in the bank metadata.
+    /// @return completionTimes The times when each undelegation is completed
+    function batchUndelegate(
+        address delegatorAddress,
+        string[] memory validatorAddresses,
+        uint256[] memory amounts
+    ) external returns (int64[] memory completionTimes);
+
+    /// @dev Defines a method for performing redelegations between multiple pairs of
+    /// source and destination validators in a single transaction. The redelegations
+    /// are processed atomically.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorSrcAddresses The validators from which each redelegation is initiated
+    /// @param validatorDstAddresses The validators to which each redelegation is destined
+    /// @param amounts The amounts of the bond denomination to be redelegated
+    /// These amounts should use the bond denomination precision stored in the bank metadata.
+    /// @return completionTimes The times when each redelegation is completed
+    function batchRedelegate(
+        address delegatorAddress,
+        string[] memory validatorSrcAddresses,
+        string[] memory validatorDstAddresses,
+        uint256[] memory amounts
+    ) external returns (int64[] memory completionTimes);
+
     /// @dev Allows delegators to cancel the unbondingDelegation entry
     /// and to delegate back to a previous validator.
     /// @param delegatorAddress The address of the delegator
@@ -296,6 +325,29 @@ interface StakingI is authorization.AuthorizationI {
             PageResponse calldata pageResponse
         );
 
+    /// @dev Queries the delegate authorization granted by the granter to the grantee,
+    /// so that contracts can check the grant before delegating on behalf of the granter.
+    /// @param grantee The address of the grantee.
+    /// @param granter The address of the granter.
+    /// @return found Whether a delegate authorization exists.
+    /// @return spendLimit The remaining amount that can be delegated, or the maximum uint256 value if unlimited.
+    /// @return allowedValidators The validators the grantee is allowed to delegate to (empty if not restricted).
+    /// @return deniedValidators The validators the grantee is not allowed to delegate to.
+    /// @return expiration The unix timestamp in seconds at which the authorization expires, or 0 if it does not expire.
+    function delegationAuthorization(
+        address grantee,
+        address granter
+    )
+        external
+        view
+        returns (
+            bool found,
+            uint256 spendLimit,
+            string[] calldata allowedValidators,
+            string[] calldata deniedValidators,
+            int64 expiration
+        );
+
     /// @dev CreateValidator defines an Event emitted when a create a new validator.
     /// @param validatorAddress The address of the validator
     /// @param value The amount of coin being self delegated
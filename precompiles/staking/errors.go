@@ -12,6 +12,10 @@ const (
 	ErrNoDelegationFound = "delegation with delegator %s not found for validator %s"
 	// ErrDifferentOriginFromValidator is raised when the origin address is not the same as the validator address.
 	ErrDifferentOriginFromValidator = "origin address %s is not the same as validator operator address %s"
+	// ErrInvalidBatchSize is raised when a batch is empty or contains more than the maximum number of entries.
+	ErrInvalidBatchSize = "invalid batch size: expected between 1 and %d entries, got %d"
+	// ErrBatchLengthMismatch is raised when the array arguments of a batch have different lengths.
+	ErrBatchLengthMismatch = "batch arguments length mismatch: %d != %d"
 	// ErrCannotCallFromContract is raised when a function cannot be called from a smart contract.
 	ErrCannotCallFromContract = "this method can only be called directly to the precompile, not from a smart contract"
 )
@@ -36,6 +36,9 @@ const (
 	// RedelegationsMethod defines the ABI method name for the staking
 	// Redelegations query.
 	RedelegationsMethod = "redelegations"
+	// DelegationAuthorizationMethod defines the ABI method name for the staking
+	// DelegationAuthorization query.
+	DelegationAuthorizationMethod = "delegationAuthorization"
 )
 
 // Delegation returns the delegation that a delegator has with a specific validator.
@@ -229,3 +232,33 @@ func (p Precompile) Allowance(
 
 	return method.Outputs.Pack(stakeAuthz.MaxTokens.Amount.BigInt())
 }
+
+// DelegationAuthorization returns the state of the delegate authorization granted
+// by the granter to the grantee, i.e. whether it exists, its spend limit, the allowed
+// and denied validators and its expiration. It allows contracts to check the grant
+// before delegating on behalf of the granter.
+func (p Precompile) DelegationAuthorization(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	grantee, granter, err := ParseDelegationAuthorizationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(DelegationAuthorizationOutput)
+
+	msgAuthz, expiration := p.AuthzKeeper.GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), DelegateMsg)
+	if msgAuthz == nil {
+		return out.Pack(method.Outputs)
+	}
+
+	stakeAuthz, ok := msgAuthz.(*stakingtypes.StakeAuthorization)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "staking authorization", &stakingtypes.StakeAuthorization{}, msgAuthz)
+	}
+
+	return out.FromAuthorization(stakeAuthz, expiration).Pack(method.Outputs)
+}
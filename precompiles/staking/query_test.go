@@ -787,3 +787,128 @@ func (s *PrecompileTestSuite) TestAllowance() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestDelegationAuthorization() {
+	approvedCoin := sdk.Coin{Denom: s.bondDenom, Amount: math.NewInt(1e18)}
+	granteeAddr := testutiltx.GenerateAddress()
+	method := s.precompile.Methods[staking.DelegationAuthorizationMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		gas         uint64
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			100000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					s.keyring.GetAddr(0),
+				}
+			},
+			func([]byte) {},
+			100000,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"success - authorization not found",
+			func() []interface{} {
+				return []interface{}{
+					granteeAddr,
+					s.keyring.GetAddr(0),
+				}
+			},
+			func(bz []byte) {
+				var out staking.DelegationAuthorizationOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegationAuthorizationMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().False(out.Found)
+				s.Require().Equal(int64(0), out.SpendLimit.Int64())
+				s.Require().Empty(out.AllowedValidators)
+				s.Require().Zero(out.Expiration)
+			},
+			100000,
+			false,
+			"",
+		},
+		{
+			"success - delegate authorization",
+			func() []interface{} {
+				err := s.CreateAuthorization(s.network.GetContext(), s.keyring.GetAccAddr(0), granteeAddr.Bytes(), staking.DelegateAuthz, &approvedCoin)
+				s.Require().NoError(err)
+
+				return []interface{}{
+					granteeAddr,
+					s.keyring.GetAddr(0),
+				}
+			},
+			func(bz []byte) {
+				var out staking.DelegationAuthorizationOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegationAuthorizationMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().True(out.Found)
+				s.Require().Equal(big.NewInt(1e18), out.SpendLimit, "expected different spend limit")
+				s.Require().NotEmpty(out.AllowedValidators, "expected allowed validators")
+				s.Require().Empty(out.DeniedValidators)
+				s.Require().Greater(out.Expiration, s.network.GetContext().BlockTime().Unix())
+			},
+			100000,
+			false,
+			"",
+		},
+		{
+			"success - return not found for undelegate authorization",
+			func() []interface{} {
+				err := s.CreateAuthorization(s.network.GetContext(), s.keyring.GetAccAddr(0), granteeAddr.Bytes(), staking.UndelegateAuthz, &approvedCoin)
+				s.Require().NoError(err)
+
+				return []interface{}{
+					granteeAddr,
+					s.keyring.GetAddr(0),
+				}
+			},
+			func(bz []byte) {
+				var out staking.DelegationAuthorizationOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegationAuthorizationMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().False(out.Found)
+			},
+			100000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), tc.gas)
+
+			args := tc.malleate()
+			bz, err := s.precompile.DelegationAuthorization(s.network.GetContext(), &method, contract, args)
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotNil(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
@@ -57,6 +57,15 @@ func NewPrecompile(
 			KvGasConfig:          storetypes.KVGasConfig(),
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+			ReentrancyGuard: cmn.NewReentrancyGuard(
+				CreateValidatorMethod,
+				DelegateMethod,
+				UndelegateMethod,
+				RedelegateMethod,
+				CancelUnbondingDelegationMethod,
+				BatchUndelegateMethod,
+				BatchRedelegateMethod,
+			),
 		},
 		stakingKeeper: stakingKeeper,
 	}
@@ -118,6 +127,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Redelegate(ctx, evm.Origin, contract, stateDB, method, args)
 	case CancelUnbondingDelegationMethod:
 		bz, err = p.CancelUnbondingDelegation(ctx, evm.Origin, contract, stateDB, method, args)
+	case BatchUndelegateMethod:
+		bz, err = p.BatchUndelegate(ctx, evm.Origin, contract, stateDB, method, args)
+	case BatchRedelegateMethod:
+		bz, err = p.BatchRedelegate(ctx, evm.Origin, contract, stateDB, method, args)
 	// Staking queries
 	case DelegationMethod:
 		bz, err = p.Delegation(ctx, contract, method, args)
@@ -134,6 +147,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// Authorization queries
 	case authorization.AllowanceMethod:
 		bz, err = p.Allowance(ctx, method, contract, args)
+	case DelegationAuthorizationMethod:
+		bz, err = p.DelegationAuthorization(ctx, method, contract, args)
 	}
 
 	if err != nil {
@@ -162,6 +177,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //   - Undelegate
 //   - Redelegate
 //   - CancelUnbondingDelegation
+//   - BatchUndelegate
+//   - BatchRedelegate
 //
 // Available authorization transactions are:
 //   - Approve
@@ -176,6 +193,8 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 		UndelegateMethod,
 		RedelegateMethod,
 		CancelUnbondingDelegationMethod,
+		BatchUndelegateMethod,
+		BatchRedelegateMethod,
 		authorization.ApproveMethod,
 		authorization.RevokeMethod,
 		authorization.IncreaseAllowanceMethod,
@@ -37,6 +37,12 @@ const (
 	// CancelUnbondingDelegationMethod defines the ABI method name for the staking
 	// CancelUnbondingDelegation transaction.
 	CancelUnbondingDelegationMethod = "cancelUnbondingDelegation"
+	// BatchUndelegateMethod defines the ABI method name for the staking
+	// BatchUndelegate transaction.
+	BatchUndelegateMethod = "batchUndelegate"
+	// BatchRedelegateMethod defines the ABI method name for the staking
+	// BatchRedelegate transaction.
+	BatchRedelegateMethod = "batchRedelegate"
 )
 
 const (
@@ -280,11 +286,32 @@ func (p Precompile) Undelegate(
 		),
 	)
 
+	completionTime, err := p.undelegate(ctx, origin, contract, stateDB, msg, delegatorHexAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(completionTime)
+}
+
+// undelegate executes the undelegation on behalf of the delegator and returns
+// the completion time as a unix timestamp. The authorization of the contract
+// caller is checked and updated if the caller is not the origin.
+func (p Precompile) undelegate(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	msg *stakingtypes.MsgUndelegate,
+	delegatorHexAddr common.Address,
+) (int64, error) {
 	var (
 		// stakeAuthz is the authorization grant for the caller and the delegator address
 		stakeAuthz *stakingtypes.StakeAuthorization
 		// expiration is the expiration time of the authorization grant
 		expiration *time.Time
+		// err is the error returned by the authorization checks
+		err error
 
 		// isCallerOrigin is true when the contract caller is the same as the origin
 		isCallerOrigin = contract.CallerAddress == origin
@@ -300,7 +327,7 @@ func (p Precompile) Undelegate(
 	if isCallerDelegator {
 		delegatorHexAddr = origin
 	} else if origin != delegatorHexAddr {
-		return nil, fmt.Errorf(ErrDifferentOriginFromDelegator, origin.String(), delegatorHexAddr.String())
+		return 0, fmt.Errorf(ErrDifferentOriginFromDelegator, origin.String(), delegatorHexAddr.String())
 	}
 
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
@@ -308,7 +335,7 @@ func (p Precompile) Undelegate(
 		// Check if the authorization grant exists for the caller and the origin
 		stakeAuthz, expiration, err = authorization.CheckAuthzAndAllowanceForGranter(ctx, p.AuthzKeeper, contract.CallerAddress, delegatorHexAddr, &msg.Amount, UndelegateMsg)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 	}
 
@@ -316,22 +343,22 @@ func (p Precompile) Undelegate(
 	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
 	res, err := msgSrv.Undelegate(ctx, msg)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	// Only update the authorization if the contract caller is different from the origin
 	if !isCallerOrigin {
 		if err := p.UpdateStakingAuthorization(ctx, contract.CallerAddress, delegatorHexAddr, stakeAuthz, expiration, UndelegateMsg, msg); err != nil {
-			return nil, err
+			return 0, err
 		}
 	}
 
 	// Emit the event for the undelegate transaction
 	if err = p.EmitUnbondEvent(ctx, stateDB, msg, delegatorHexAddr, res.CompletionTime.UTC().Unix()); err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return method.Outputs.Pack(res.CompletionTime.UTC().Unix())
+	return res.CompletionTime.UTC().Unix(), nil
 }
 
 // Redelegate performs a redelegation of coins for a delegate from a source validator
@@ -366,11 +393,32 @@ func (p Precompile) Redelegate(
 		),
 	)
 
+	completionTime, err := p.redelegate(ctx, origin, contract, stateDB, msg, delegatorHexAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(completionTime)
+}
+
+// redelegate executes the redelegation on behalf of the delegator and returns
+// the completion time as a unix timestamp. The authorization of the contract
+// caller is checked and updated if the caller is not the origin.
+func (p Precompile) redelegate(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	msg *stakingtypes.MsgBeginRedelegate,
+	delegatorHexAddr common.Address,
+) (int64, error) {
 	var (
 		// stakeAuthz is the authorization grant for the caller and the delegator address
 		stakeAuthz *stakingtypes.StakeAuthorization
 		// expiration is the expiration time of the authorization grant
 		expiration *time.Time
+		// err is the error returned by the authorization checks
+		err error
 
 		// isCallerOrigin is true when the contract caller is the same as the origin
 		isCallerOrigin = contract.CallerAddress == origin
@@ -386,7 +434,7 @@ func (p Precompile) Redelegate(
 	if isCallerDelegator {
 		delegatorHexAddr = origin
 	} else if origin != delegatorHexAddr {
-		return nil, fmt.Errorf(ErrDifferentOriginFromDelegator, origin.String(), delegatorHexAddr.String())
+		return 0, fmt.Errorf(ErrDifferentOriginFromDelegator, origin.String(), delegatorHexAddr.String())
 	}
 
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
@@ -394,28 +442,113 @@ func (p Precompile) Redelegate(
 		// Check if the authorization grant exists for the caller and the origin
 		stakeAuthz, expiration, err = authorization.CheckAuthzAndAllowanceForGranter(ctx, p.AuthzKeeper, contract.CallerAddress, delegatorHexAddr, &msg.Amount, RedelegateMsg)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 	}
 
 	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
 	res, err := msgSrv.BeginRedelegate(ctx, msg)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	// Only update the authorization if the contract caller is different from the origin
 	if !isCallerOrigin {
 		if err := p.UpdateStakingAuthorization(ctx, contract.CallerAddress, delegatorHexAddr, stakeAuthz, expiration, RedelegateMsg, msg); err != nil {
-			return nil, err
+			return 0, err
 		}
 	}
 
 	if err = p.EmitRedelegateEvent(ctx, stateDB, msg, delegatorHexAddr, res.CompletionTime.UTC().Unix()); err != nil {
+		return 0, err
+	}
+
+	return res.CompletionTime.UTC().Unix(), nil
+}
+
+// BatchUndelegate performs the undelegations of the given amounts from each of the
+// given validators in a single transaction. The undelegations are processed in order
+// and atomically, i.e. the whole transaction is reverted if any of them fails.
+// It returns the completion time of each undelegation.
+func (p Precompile) BatchUndelegate(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgs, delegatorHexAddr, err := NewMsgsBatchUndelegate(args, bondDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ delegator_address: %s, undelegations: %d }",
+			delegatorHexAddr,
+			len(msgs),
+		),
+	)
+
+	completionTimes := make([]int64, len(msgs))
+	for i, msg := range msgs {
+		completionTimes[i], err = p.undelegate(ctx, origin, contract, stateDB, msg, delegatorHexAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(completionTimes)
+}
+
+// BatchRedelegate performs the redelegations of the given amounts from each of the
+// given source validators to the corresponding destination validators in a single
+// transaction. The redelegations are processed in order and atomically, i.e. the whole
+// transaction is reverted if any of them fails. It returns the completion time of
+// each redelegation.
+func (p Precompile) BatchRedelegate(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
 		return nil, err
 	}
+	msgs, delegatorHexAddr, err := NewMsgsBatchRedelegate(args, bondDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ delegator_address: %s, redelegations: %d }",
+			delegatorHexAddr,
+			len(msgs),
+		),
+	)
+
+	completionTimes := make([]int64, len(msgs))
+	for i, msg := range msgs {
+		completionTimes[i], err = p.redelegate(ctx, origin, contract, stateDB, msg, delegatorHexAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	return method.Outputs.Pack(res.CompletionTime.UTC().Unix())
+	return method.Outputs.Pack(completionTimes)
 }
 
 // CancelUnbondingDelegation will cancel the unbonding of a delegation and delegate
@@ -1405,3 +1405,247 @@ func (s *PrecompileTestSuite) TestCancelUnbondingDelegation() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestBatchUndelegate() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[staking.BatchUndelegateMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(delegator testkeyring.Key, operatorAddresses []string) []interface{}
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(testkeyring.Key, []string) []interface{} {
+				return []interface{}{}
+			},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - empty batch",
+			func(delegator testkeyring.Key, _ []string) []interface{} {
+				return []interface{}{
+					delegator.Addr,
+					[]string{},
+					[]*big.Int{},
+				}
+			},
+			200000,
+			true,
+			fmt.Sprintf(staking.ErrInvalidBatchSize, staking.MaxBatchSize, 0),
+		},
+		{
+			"fail - validators and amounts length mismatch",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{
+					delegator.Addr,
+					operatorAddresses,
+					[]*big.Int{big.NewInt(1e18)},
+				}
+			},
+			200000,
+			true,
+			fmt.Sprintf(staking.ErrBatchLengthMismatch, 2, 1),
+		},
+		{
+			"fail - different origin than delegator",
+			func(_ testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{
+					evmosutiltx.GenerateAddress(),
+					operatorAddresses,
+					[]*big.Int{big.NewInt(1e18), big.NewInt(1e18)},
+				}
+			},
+			200000,
+			true,
+			"is not the same as delegator",
+		},
+		{
+			"success - undelegate from multiple validators",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{
+					delegator.Addr,
+					operatorAddresses,
+					[]*big.Int{big.NewInt(1e18), big.NewInt(1e18)},
+				}
+			},
+			400000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			delegator := s.keyring.GetKey(0)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, delegator.Addr, s.precompile, tc.gas)
+
+			operatorAddresses := []string{
+				s.network.GetValidators()[0].OperatorAddress,
+				s.network.GetValidators()[1].OperatorAddress,
+			}
+			bz, err := s.precompile.BatchUndelegate(ctx, delegator.Addr, contract, s.network.GetStateDB(), &method, tc.malleate(delegator, operatorAddresses))
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var completionTimes []int64
+			err = s.precompile.UnpackIntoInterface(&completionTimes, staking.BatchUndelegateMethod, bz)
+			s.Require().NoError(err, "failed to unpack output")
+			s.Require().Len(completionTimes, 2)
+
+			params, err := s.network.App.StakingKeeper.GetParams(ctx)
+			s.Require().NoError(err)
+			expCompletionTime := ctx.BlockTime().Add(params.UnbondingTime).UTC().Unix()
+			for _, completionTime := range completionTimes {
+				s.Require().Equal(expCompletionTime, completionTime)
+			}
+
+			undelegations, err := s.network.App.StakingKeeper.GetAllUnbondingDelegations(ctx, delegator.AccAddr)
+			s.Require().NoError(err)
+			s.Require().Len(undelegations, 2)
+			for _, undelegation := range undelegations {
+				s.Require().Contains(operatorAddresses, undelegation.ValidatorAddress)
+				s.Require().Equal(math.NewInt(1e18), undelegation.Entries[0].Balance)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestBatchRedelegate() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[staking.BatchRedelegateMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(delegator testkeyring.Key, srcOperatorAddr, dstOperatorAddr string) []interface{}
+		gas         uint64
+		expEntries  int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(testkeyring.Key, string, string) []interface{} {
+				return []interface{}{}
+			},
+			200000,
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - source and destination validators length mismatch",
+			func(delegator testkeyring.Key, srcOperatorAddr, dstOperatorAddr string) []interface{} {
+				return []interface{}{
+					delegator.Addr,
+					[]string{srcOperatorAddr, srcOperatorAddr},
+					[]string{dstOperatorAddr},
+					[]*big.Int{big.NewInt(5e17), big.NewInt(5e17)},
+				}
+			},
+			200000,
+			0,
+			true,
+			fmt.Sprintf(staking.ErrBatchLengthMismatch, 2, 1),
+		},
+		{
+			"fail - batch too large",
+			func(delegator testkeyring.Key, srcOperatorAddr, dstOperatorAddr string) []interface{} {
+				size := staking.MaxBatchSize + 1
+				srcs, dsts, amounts := make([]string, size), make([]string, size), make([]*big.Int, size)
+				for i := 0; i < size; i++ {
+					srcs[i], dsts[i], amounts[i] = srcOperatorAddr, dstOperatorAddr, big.NewInt(1)
+				}
+				return []interface{}{delegator.Addr, srcs, dsts, amounts}
+			},
+			200000,
+			0,
+			true,
+			fmt.Sprintf(staking.ErrInvalidBatchSize, staking.MaxBatchSize, staking.MaxBatchSize+1),
+		},
+		{
+			"fail - redelegation amount greater than delegation",
+			func(delegator testkeyring.Key, srcOperatorAddr, dstOperatorAddr string) []interface{} {
+				return []interface{}{
+					delegator.Addr,
+					[]string{srcOperatorAddr, srcOperatorAddr},
+					[]string{dstOperatorAddr, dstOperatorAddr},
+					[]*big.Int{big.NewInt(5e17), big.NewInt(1e18)},
+				}
+			},
+			400000,
+			0,
+			true,
+			"invalid shares amount",
+		},
+		{
+			"success - multiple redelegations",
+			func(delegator testkeyring.Key, srcOperatorAddr, dstOperatorAddr string) []interface{} {
+				return []interface{}{
+					delegator.Addr,
+					[]string{srcOperatorAddr, srcOperatorAddr},
+					[]string{dstOperatorAddr, dstOperatorAddr},
+					[]*big.Int{big.NewInt(5e17), big.NewInt(5e17)},
+				}
+			},
+			400000,
+			2,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			delegator := s.keyring.GetKey(0)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, delegator.Addr, s.precompile, tc.gas)
+
+			srcOperatorAddr := s.network.GetValidators()[0].OperatorAddress
+			dstOperatorAddr := s.network.GetValidators()[1].OperatorAddress
+			bz, err := s.precompile.BatchRedelegate(ctx, delegator.Addr, contract, s.network.GetStateDB(), &method, tc.malleate(delegator, srcOperatorAddr, dstOperatorAddr))
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var completionTimes []int64
+			err = s.precompile.UnpackIntoInterface(&completionTimes, staking.BatchRedelegateMethod, bz)
+			s.Require().NoError(err, "failed to unpack output")
+			s.Require().Len(completionTimes, tc.expEntries)
+
+			redelegations, err := s.network.App.StakingKeeper.GetRedelegations(ctx, delegator.AccAddr, 5)
+			s.Require().NoError(err)
+			s.Require().Len(redelegations, 1)
+			s.Require().Equal(srcOperatorAddr, redelegations[0].ValidatorSrcAddress)
+			s.Require().Equal(dstOperatorAddr, redelegations[0].ValidatorDstAddress)
+
+			// NOTE: entries created at the same height might be merged by the staking keeper
+			totalShares := math.LegacyZeroDec()
+			for _, entry := range redelegations[0].Entries {
+				totalShares = totalShares.Add(entry.SharesDst)
+			}
+			s.Require().Equal(math.LegacyNewDec(1e18), totalShares)
+		})
+	}
+}
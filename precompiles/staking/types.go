@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"cosmossdk.io/math"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -19,6 +20,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 )
 
@@ -27,6 +29,8 @@ const (
 	DoNotModifyCommissionRate = -1
 	// DoNotModifyMinSelfDelegation constant used in flags to indicate that min self delegation field should not be updated
 	DoNotModifyMinSelfDelegation = -1
+	// MaxBatchSize is the maximum number of entries of the batch undelegate and redelegate transactions
+	MaxBatchSize = 50
 )
 
 // EventCreateValidator defines the event data for the staking CreateValidator transaction.
@@ -305,6 +309,120 @@ func NewMsgRedelegate(args []interface{}, denom string) (*stakingtypes.MsgBeginR
 	return msg, delegatorAddr, nil
 }
 
+// NewMsgsBatchUndelegate creates the MsgUndelegate instances of a batch undelegation
+// and does sanity checks on the given arguments before populating the messages.
+func NewMsgsBatchUndelegate(args []interface{}, denom string) ([]*stakingtypes.MsgUndelegate, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	delegatorAddr, ok := args[0].(common.Address)
+	if !ok || delegatorAddr == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	validatorAddresses, ok := args[1].([]string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "validatorAddresses", []string{}, args[1])
+	}
+
+	amounts, ok := args[2].([]*big.Int)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "amounts", []*big.Int{}, args[2])
+	}
+
+	if err := validateBatchLength(len(validatorAddresses), len(amounts)); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	delegator := sdk.AccAddress(delegatorAddr.Bytes()).String() // bech32 formatted
+	msgs := make([]*stakingtypes.MsgUndelegate, len(validatorAddresses))
+	for i, validatorAddress := range validatorAddresses {
+		if amounts[i] == nil {
+			return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, amounts[i])
+		}
+
+		msgs[i] = &stakingtypes.MsgUndelegate{
+			DelegatorAddress: delegator,
+			ValidatorAddress: validatorAddress,
+			Amount: sdk.Coin{
+				Denom:  denom,
+				Amount: math.NewIntFromBigInt(amounts[i]),
+			},
+		}
+	}
+
+	return msgs, delegatorAddr, nil
+}
+
+// NewMsgsBatchRedelegate creates the MsgBeginRedelegate instances of a batch redelegation
+// and does sanity checks on the given arguments before populating the messages.
+func NewMsgsBatchRedelegate(args []interface{}, denom string) ([]*stakingtypes.MsgBeginRedelegate, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	delegatorAddr, ok := args[0].(common.Address)
+	if !ok || delegatorAddr == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	validatorSrcAddresses, ok := args[1].([]string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "validatorSrcAddresses", []string{}, args[1])
+	}
+
+	validatorDstAddresses, ok := args[2].([]string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "validatorDstAddresses", []string{}, args[2])
+	}
+
+	amounts, ok := args[3].([]*big.Int)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "amounts", []*big.Int{}, args[3])
+	}
+
+	if err := validateBatchLength(len(validatorSrcAddresses), len(validatorDstAddresses), len(amounts)); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	delegator := sdk.AccAddress(delegatorAddr.Bytes()).String() // bech32 formatted
+	msgs := make([]*stakingtypes.MsgBeginRedelegate, len(validatorSrcAddresses))
+	for i, validatorSrcAddress := range validatorSrcAddresses {
+		if amounts[i] == nil {
+			return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, amounts[i])
+		}
+
+		msgs[i] = &stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    delegator,
+			ValidatorSrcAddress: validatorSrcAddress,
+			ValidatorDstAddress: validatorDstAddresses[i],
+			Amount: sdk.Coin{
+				Denom:  denom,
+				Amount: math.NewIntFromBigInt(amounts[i]),
+			},
+		}
+	}
+
+	return msgs, delegatorAddr, nil
+}
+
+// validateBatchLength checks that the batch is not empty, does not exceed the
+// maximum batch size and that all the given argument lengths are equal.
+func validateBatchLength(length int, otherLengths ...int) error {
+	if length == 0 || length > MaxBatchSize {
+		return fmt.Errorf(ErrInvalidBatchSize, MaxBatchSize, length)
+	}
+
+	for _, l := range otherLengths {
+		if l != length {
+			return fmt.Errorf(ErrBatchLengthMismatch, length, l)
+		}
+	}
+
+	return nil
+}
+
 // NewMsgCancelUnbondingDelegation creates a new MsgCancelUnbondingDelegation instance and does sanity checks
 // on the given arguments before populating the message.
 func NewMsgCancelUnbondingDelegation(args []interface{}, denom string) (*stakingtypes.MsgCancelUnbondingDelegation, common.Address, error) {
@@ -368,6 +486,26 @@ func NewDelegationRequest(args []interface{}) (*stakingtypes.QueryDelegationRequ
 	}, nil
 }
 
+// ParseDelegationAuthorizationArgs parses the arguments of the delegationAuthorization
+// query and returns the grantee and granter addresses.
+func ParseDelegationAuthorizationArgs(args []interface{}) (grantee, granter common.Address, err error) {
+	if len(args) != 2 {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(authorization.ErrInvalidGrantee, args[0])
+	}
+
+	granter, ok = args[1].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(authorization.ErrInvalidGranter, args[1])
+	}
+
+	return grantee, granter, nil
+}
+
 // NewValidatorRequest create a new QueryValidatorRequest instance and does sanity checks
 // on the given arguments before populating the request.
 func NewValidatorRequest(args []interface{}) (*stakingtypes.QueryValidatorRequest, error) {
@@ -561,6 +699,65 @@ func (do *DelegationOutput) Pack(args abi.Arguments) ([]byte, error) {
 	return args.Pack(do.Shares, do.Balance)
 }
 
+// DelegationAuthorizationOutput is a struct to represent the state of a delegate
+// authorization. The expiration is a unix timestamp in seconds, or zero if the
+// authorization does not expire.
+type DelegationAuthorizationOutput struct {
+	Found             bool
+	SpendLimit        *big.Int
+	AllowedValidators []string
+	DeniedValidators  []string
+	Expiration        int64
+}
+
+// FromAuthorization populates the DelegationAuthorizationOutput from a StakeAuthorization
+// and its expiration.
+func (dao *DelegationAuthorizationOutput) FromAuthorization(
+	stakeAuthz *stakingtypes.StakeAuthorization,
+	expiration *time.Time,
+) *DelegationAuthorizationOutput {
+	dao.Found = true
+
+	// a nil max tokens value means that the spend limit is unlimited
+	dao.SpendLimit = abi.MaxUint256
+	if stakeAuthz.MaxTokens != nil {
+		dao.SpendLimit = stakeAuthz.MaxTokens.Amount.BigInt()
+	}
+
+	if allowList := stakeAuthz.GetAllowList(); allowList != nil {
+		dao.AllowedValidators = allowList.Address
+	}
+	if denyList := stakeAuthz.GetDenyList(); denyList != nil {
+		dao.DeniedValidators = denyList.Address
+	}
+
+	if expiration != nil {
+		dao.Expiration = expiration.Unix()
+	}
+
+	return dao
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (dao *DelegationAuthorizationOutput) Pack(args abi.Arguments) ([]byte, error) {
+	spendLimit := dao.SpendLimit
+	if spendLimit == nil {
+		spendLimit = big.NewInt(0)
+	}
+
+	allowed := dao.AllowedValidators
+	if allowed == nil {
+		allowed = []string{}
+	}
+
+	denied := dao.DeniedValidators
+	if denied == nil {
+		denied = []string{}
+	}
+
+	return args.Pack(dao.Found, spendLimit, allowed, denied, dao.Expiration)
+}
+
 // ValidatorInfo is a struct to represent the key information from
 // a validator response.
 type ValidatorInfo struct {
@@ -73,6 +73,17 @@ interface VestingI {
         address destAddress
     );
 
+    /// @dev Defines an event that is emitted when the coins of a clawback vesting account are
+    /// clawed back to the community pool.
+    /// @param funderAddress The address of the account that funded the vesting account.
+    /// @param accountAddress The address of the vesting account.
+    /// @param coins The coins clawed back to the community pool.
+    event ClawbackToCommunityPool(
+        address indexed funderAddress,
+        address indexed accountAddress,
+        Coin[] coins
+    );
+
     /// @dev Defines an event that is emitted when a vesting account's funder is updated.
     /// @param funderAddress The address of the account that funded the vesting account.
     /// @param newFunderAddress The address of the new funder of the vesting account.
@@ -89,6 +100,18 @@ interface VestingI {
         address indexed vestingAddress
     );
 
+    /// @dev Defines an event that is emitted when a periodic vesting account is created and funded.
+    /// @param funderAddress The address of the account that funded the vesting account.
+    /// @param vestingAddress The address of the created vesting account.
+    /// @param startTime The time at which the vesting account will start.
+    /// @param vestingPeriods The vesting periods of the vesting account.
+    event CreatePeriodicVestingAccount(
+        address indexed funderAddress,
+        address indexed vestingAddress,
+        uint64 startTime,
+        Period[] vestingPeriods
+    );
+
     /// @dev Approves a list of Cosmos or IBC transactions with a specific amount of tokens.
     /// @param grantee The contract address which will have an authorization to spend the origin funds.
     /// @param method The message type URL of the method to approve.
@@ -122,6 +145,22 @@ interface VestingI {
         Period[] calldata vestingPeriods
     ) external returns (bool success);
 
+    /// @dev Defines a method for creating a new vesting account funded with a vesting
+    /// schedule and no lockup, so that the coins are unlocked as soon as they vest.
+    /// The vesting account must not exist yet.
+    /// @param funderAddress The address of the account that funds the vesting account.
+    /// @param vestingAddress The address of the vesting account to create.
+    /// @param startTime The time at which the vesting account will start.
+    /// @param vestingPeriods The vesting periods of the vesting account.
+    /// @param enableGovClawback If the vesting account will be subject to governance clawback.
+    function createPeriodicVestingAccount(
+        address funderAddress,
+        address vestingAddress,
+        uint64 startTime,
+        Period[] calldata vestingPeriods,
+        bool enableGovClawback
+    ) external returns (bool success);
+
     /// @dev Defines a method for clawing back coins from a vesting account.
     /// @param funderAddress The address of the account that funded the vesting account.
     /// @param accountAddress The address of the vesting account.
@@ -132,6 +171,14 @@ interface VestingI {
         address destAddress
     ) external returns (Coin[] memory);
 
+    /// @dev Defines a method for clawing back coins from a vesting account to the community pool.
+    /// @param funderAddress The address of the account that funded the vesting account.
+    /// @param accountAddress The address of the vesting account.
+    function clawbackToCommunityPool(
+        address funderAddress,
+        address accountAddress
+    ) external returns (Coin[] memory);
+
     /// @dev Defines a method for updating the funder of a vesting account.
     /// @param funderAddress The address of the account that funded the vesting account.
     /// @param newFunderAddress The address of the new funder of the vesting account.
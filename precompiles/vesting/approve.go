@@ -22,6 +22,8 @@ var (
 	UpdateVestingFunderMsgURL = sdk.MsgTypeURL(&vestingtypes.MsgUpdateVestingFunder{})
 	// ClawbackMsgURL defines the vesting authorization type for MsgClawback
 	ClawbackMsgURL = sdk.MsgTypeURL(&vestingtypes.MsgClawback{})
+	// CreatePeriodicVestingAccountMsgURL defines the vesting authorization type for MsgCreatePeriodicVestingAccount
+	CreatePeriodicVestingAccountMsgURL = sdk.MsgTypeURL(&vestingtypes.MsgCreatePeriodicVestingAccount{})
 )
 
 // Approve is the precompile function for approving vesting transactions with a generic grant.
@@ -38,7 +40,7 @@ func (p Precompile) Approve(
 	}
 
 	switch typeURL {
-	case FundVestingAccountMsgURL, ClawbackMsgURL, UpdateVestingFunderMsgURL:
+	case FundVestingAccountMsgURL, ClawbackMsgURL, UpdateVestingFunderMsgURL, CreatePeriodicVestingAccountMsgURL:
 		if err := CreateGenericAuthz(ctx, p.AuthzKeeper, grantee, origin, typeURL); err != nil {
 			return nil, err
 		}
@@ -22,10 +22,16 @@ const (
 	EventTypeFundVestingAccount = "FundVestingAccount"
 	// EventTypeClawback defines the event type for the vesting Clawback transaction.
 	EventTypeClawback = "Clawback"
+	// EventTypeClawbackToCommunityPool defines the event type for the vesting Clawback transaction
+	// with the community pool as destination.
+	EventTypeClawbackToCommunityPool = "ClawbackToCommunityPool"
 	// EventTypeUpdateVestingFunder defines the event type for the vesting UpdateVestingFunder transaction.
 	EventTypeUpdateVestingFunder = "UpdateVestingFunder"
 	// EventTypeConvertVestingAccount defines the event type for the vesting ConvertVestingAccount transaction.
 	EventTypeConvertVestingAccount = "ConvertVestingAccount"
+	// EventTypeCreatePeriodicVestingAccount defines the event type for the vesting
+	// CreatePeriodicVestingAccount transaction.
+	EventTypeCreatePeriodicVestingAccount = "CreatePeriodicVestingAccount"
 )
 
 // EmitApprovalEvent creates a new approval event emitted on an Approve, IncreaseAllowance and DecreaseAllowance transactions.
@@ -191,6 +197,51 @@ func (p Precompile) EmitClawbackEvent(
 	return nil
 }
 
+// EmitClawbackToCommunityPoolEvent creates a new clawback to community pool event emitted on a
+// ClawbackToCommunityPool transaction. It holds the clawed back coins for the accounting of the
+// community pool.
+func (p Precompile) EmitClawbackToCommunityPoolEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funderAddr, accountAddr common.Address,
+	coins []cmn.Coin,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeClawbackToCommunityPool]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(funderAddr)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(accountAddr)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(coins)
+	if err != nil {
+		return err
+	}
+
+	// Create the event
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
 // EmitUpdateVestingFunderEvent creates a new update vesting funder event emitted on a UpdateVestingFunder transaction.
 //
 
@@ -260,3 +311,48 @@ func (p Precompile) EmitConvertVestingAccountEvent(ctx sdk.Context, stateDB vm.S
 
 	return nil
 }
+
+// EmitCreatePeriodicVestingAccountEvent creates a new create periodic vesting account event emitted
+// on a CreatePeriodicVestingAccount transaction.
+func (p Precompile) EmitCreatePeriodicVestingAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	msg *vestingtypes.MsgCreatePeriodicVestingAccount,
+	funderAddr, vestingAddr common.Address,
+	vestingPeriods *VestingPeriods,
+) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeCreatePeriodicVestingAccount]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(funderAddr)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(vestingAddr)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(uint64(msg.StartTime.Unix()), vestingPeriods.VestingPeriods) //nolint:gosec // G115
+	if err != nil {
+		return err
+	}
+
+	// Create the event
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
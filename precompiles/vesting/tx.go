@@ -14,6 +14,7 @@ import (
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	vestingtypes "github.com/evmos/evmos/v20/x/vesting/types"
 )
 
 const (
@@ -24,10 +25,16 @@ const (
 	FundVestingAccountMethod = "fundVestingAccount"
 	// ClawbackMethod defines the ABI method name for the vesting  Clawback transaction.
 	ClawbackMethod = "clawback"
+	// ClawbackToCommunityPoolMethod defines the ABI method name for the vesting Clawback transaction
+	// with the community pool as destination.
+	ClawbackToCommunityPoolMethod = "clawbackToCommunityPool"
 	// UpdateVestingFunderMethod defines the ABI method name for the vesting UpdateVestingFunder transaction.
 	UpdateVestingFunderMethod = "updateVestingFunder"
 	// ConvertVestingAccountMethod defines the ABI method name for the vesting ConvertVestingAccount transaction.
 	ConvertVestingAccountMethod = "convertVestingAccount"
+	// CreatePeriodicVestingAccountMethod defines the ABI method name for the vesting CreatePeriodicVestingAccount
+	// transaction.
+	CreatePeriodicVestingAccountMethod = "createPeriodicVestingAccount"
 )
 
 // CreateClawbackVestingAccount creates a new clawback vesting account
@@ -142,8 +149,9 @@ func (p *Precompile) FundVestingAccount(
 	return method.Outputs.Pack(true)
 }
 
-// Clawback clawbacks tokens from a clawback vesting account
-func (p *Precompile) Clawback(
+// CreatePeriodicVestingAccount creates a new clawback vesting account funded with a
+// vesting schedule and no lockup.
+func (p *Precompile) CreatePeriodicVestingAccount(
 	ctx sdk.Context,
 	contract *vm.Contract,
 	origin common.Address,
@@ -151,7 +159,7 @@ func (p *Precompile) Clawback(
 	method *abi.Method,
 	args []interface{},
 ) ([]byte, error) {
-	msg, funderAddr, accountAddr, destAddr, err := NewMsgClawback(args)
+	msg, funderAddr, vestingAddr, vestingPeriods, err := NewMsgCreatePeriodicVestingAccount(args, method)
 	if err != nil {
 		return nil, err
 	}
@@ -161,9 +169,65 @@ func (p *Precompile) Clawback(
 	// funder can only be the origin or the contract.Caller
 	isContractFunder := contract.CallerAddress == funderAddr && isContractCaller
 
-	// if caller address is origin, the funder MUST match the origin
 	if !isContractFunder && origin != funderAddr {
-		return nil, fmt.Errorf(ErrDifferentFunderOrigin, origin, funderAddr)
+		return nil, fmt.Errorf(ErrDifferentFromOrigin, origin, funderAddr)
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ from_address: %s, to_address: %s, start_time: %s, vesting_periods: %s, enable_gov_clawback: %t }",
+			msg.FunderAddress, msg.VestingAddress, msg.StartTime, msg.VestingPeriods, msg.EnableGovClawback,
+		),
+	)
+
+	// in case the contract is the funder
+	// don't check for auth.
+	// The smart contract (funder) should handle who is authorized to make this call
+	if isContractCaller && !isContractFunder {
+		// if calling from a contract and the contract is not the funder (origin == funderAddr)
+		// check that an authorization exists
+		_, _, err := authorization.CheckAuthzExists(ctx, p.AuthzKeeper, contract.CallerAddress, funderAddr, CreatePeriodicVestingAccountMsgURL)
+		if err != nil {
+			return nil, fmt.Errorf(authorization.ErrAuthzDoesNotExistOrExpired, CreatePeriodicVestingAccountMsgURL, contract.CallerAddress)
+		}
+	}
+
+	if _, err = p.vestingKeeper.CreatePeriodicVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	evmDenomAmt := msg.VestingPeriods.TotalAmount().AmountOf(evmtypes.GetEVMCoinDenom())
+	if isContractCaller && evmDenomAmt.IsPositive() {
+		// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+		// Need to scale the amount to 18 decimals for the EVM balance change entry
+		amt := evmtypes.ConvertAmountTo18DecimalsBigInt(evmDenomAmt.BigInt())
+		p.SetBalanceChangeEntries(
+			cmn.NewBalanceChangeEntry(funderAddr, amt, cmn.Sub),
+			cmn.NewBalanceChangeEntry(vestingAddr, amt, cmn.Add),
+		)
+	}
+
+	if err = p.EmitCreatePeriodicVestingAccountEvent(ctx, stateDB, msg, funderAddr, vestingAddr, vestingPeriods); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Clawback clawbacks tokens from a clawback vesting account
+func (p *Precompile) Clawback(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funderAddr, accountAddr, destAddr, err := NewMsgClawback(args)
+	if err != nil {
+		return nil, err
 	}
 
 	p.Logger(ctx).Debug(
@@ -175,6 +239,78 @@ func (p *Precompile) Clawback(
 		),
 	)
 
+	response, err := p.clawback(ctx, contract, origin, msg, funderAddr, accountAddr, destAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitClawbackEvent(ctx, stateDB, funderAddr, accountAddr, destAddr); err != nil {
+		return nil, err
+	}
+
+	out := new(ClawbackOutput).FromResponse(response)
+
+	return method.Outputs.Pack(out.Coins)
+}
+
+// ClawbackToCommunityPool clawbacks the unvested amount from a clawback vesting
+// account and funds it to the community pool.
+func (p *Precompile) ClawbackToCommunityPool(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funderAddr, accountAddr, communityPoolAddr, err := NewMsgClawbackToCommunityPool(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ funder_address: %s, account_address: %s }",
+			msg.FunderAddress, msg.AccountAddress,
+		),
+	)
+
+	response, err := p.clawback(ctx, contract, origin, msg, funderAddr, accountAddr, communityPoolAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(ClawbackOutput).FromResponse(response)
+
+	if err = p.EmitClawbackToCommunityPoolEvent(ctx, stateDB, funderAddr, accountAddr, out.Coins); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out.Coins)
+}
+
+// clawback checks that the clawback is requested by the funder, or by a contract
+// authorized by it, and clawbacks the unvested amount of the vesting account to
+// the destination address.
+func (p *Precompile) clawback(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	origin common.Address,
+	msg *vestingtypes.MsgClawback,
+	funderAddr, accountAddr, destAddr common.Address,
+) (*vestingtypes.MsgClawbackResponse, error) {
+	isContractCaller := contract.CallerAddress != origin
+
+	// funder can only be the origin or the contract.Caller
+	isContractFunder := contract.CallerAddress == funderAddr && isContractCaller
+
+	// if caller address is origin, the funder MUST match the origin
+	if !isContractFunder && origin != funderAddr {
+		return nil, fmt.Errorf(ErrDifferentFunderOrigin, origin, funderAddr)
+	}
+
 	// in case the contract is the funder
 	// don't check for auth.
 	// The smart contract (funder) should handle who is authorized to make this call
@@ -204,13 +340,7 @@ func (p *Precompile) Clawback(
 		)
 	}
 
-	if err = p.EmitClawbackEvent(ctx, stateDB, funderAddr, accountAddr, destAddr); err != nil {
-		return nil, err
-	}
-
-	out := new(ClawbackOutput).FromResponse(response)
-
-	return method.Outputs.Pack(out.Coins)
+	return response, nil
 }
 
 // UpdateVestingFunder updates the vesting funder of a clawback vesting account
@@ -13,6 +13,7 @@ import (
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/precompiles/testutil"
 	"github.com/evmos/evmos/v20/precompiles/vesting"
@@ -223,6 +224,112 @@ func (s *PrecompileTestSuite) TestFundVestingAccount() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestCreatePeriodicVestingAccount() {
+	var (
+		ctx         sdk.Context
+		vestingAddr common.Address
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		gas         uint64
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			200000,
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			name: "fail - different origin than funder address",
+			malleate: func() []interface{} {
+				differentAddr := evmosutiltx.GenerateAddress()
+				return []interface{}{
+					differentAddr,
+					vestingAddr,
+					uint64(ctx.BlockTime().Unix()), //nolint:gosec // G115
+					vestingPeriods,
+					true,
+				}
+			},
+			gas:         200000,
+			expError:    true,
+			errContains: "does not match the from address",
+		},
+		{
+			name: "fail - vesting account already exists",
+			malleate: func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					s.keyring.GetAddr(1),
+					uint64(ctx.BlockTime().Unix()), //nolint:gosec // G115
+					vestingPeriods,
+					true,
+				}
+			},
+			gas:         200000,
+			expError:    true,
+			errContains: "already exists",
+		},
+		{
+			"success",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					vestingAddr,
+					uint64(ctx.BlockTime().Unix()), //nolint:gosec // G115
+					vestingPeriods,
+					true,
+				}
+			},
+			20000,
+			func(data []byte) {
+				success, err := s.precompile.Unpack(vesting.CreatePeriodicVestingAccountMethod, data)
+				s.Require().NoError(err)
+				s.Require().Equal(success[0], true)
+
+				// Check that the vesting account was created without lockup
+				vestingAcc, err := s.network.App.VestingKeeper.Balances(ctx, &vestingtypes.QueryBalancesRequest{Address: sdk.AccAddress(vestingAddr.Bytes()).String()})
+				s.Require().NoError(err)
+				s.Require().Empty(vestingAcc.Locked)
+				s.Require().Equal(vestingAcc.Unvested, balancesSdkCoins)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest(2)
+			ctx = s.network.GetContext()
+			vestingAddr = evmosutiltx.GenerateAddress()
+			method := s.precompile.Methods[vesting.CreatePeriodicVestingAccountMethod]
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			bz, err := s.precompile.CreatePeriodicVestingAccount(ctx, contract, s.keyring.GetAddr(0), s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestClawback() {
 	var ctx sdk.Context
 
@@ -303,6 +410,100 @@ func (s *PrecompileTestSuite) TestClawback() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestClawbackToCommunityPool() {
+	var (
+		ctx        sdk.Context
+		poolBefore math.LegacyDec
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		gas         uint64
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			200000,
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			name: "fail - different origin than funder address",
+			malleate: func() []interface{} {
+				differentAddr := evmosutiltx.GenerateAddress()
+				return []interface{}{
+					differentAddr,
+					toAddr,
+				}
+			},
+			gas:         200000,
+			expError:    true,
+			errContains: "does not match the funder address",
+		},
+		{
+			"success",
+			func() []interface{} {
+				s.CreateTestClawbackVestingAccount(ctx, s.keyring.GetAddr(0), toAddr)
+				s.FundTestClawbackVestingAccount()
+
+				pool, err := s.network.App.DistrKeeper.FeePool.Get(ctx)
+				s.Require().NoError(err)
+				poolBefore = pool.CommunityPool.AmountOf(baseDenom)
+
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					toAddr,
+				}
+			},
+			20000,
+			func(data []byte) {
+				var co vesting.ClawbackOutput
+				err := s.precompile.UnpackIntoInterface(&co, vesting.ClawbackToCommunityPoolMethod, data)
+				s.Require().NoError(err, "failed to unpack clawback output")
+				s.Require().Equal(co.Coins, balances, "expected different clawed back coins")
+
+				// the clawed back coins are funded to the community pool
+				pool, err := s.network.App.DistrKeeper.FeePool.Get(ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(
+					poolBefore.Add(math.LegacyNewDecFromBigInt(balances[0].Amount)),
+					pool.CommunityPool.AmountOf(baseDenom),
+				)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest(2)
+			ctx = s.network.GetContext()
+			method := s.precompile.Methods[vesting.ClawbackToCommunityPoolMethod]
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			bz, err := s.precompile.ClawbackToCommunityPool(ctx, contract, s.keyring.GetAddr(0), s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestUpdateVestingFunder() {
 	var ctx sdk.Context
 
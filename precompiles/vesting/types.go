@@ -14,7 +14,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	cosmosvestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	vestingtypes "github.com/evmos/evmos/v20/x/vesting/types"
 )
@@ -131,6 +133,44 @@ func NewMsgFundVestingAccount(args []interface{}, method *abi.Method) (*vestingt
 	return msg, funderAddress, vestingAddress, &lockupPeriodsInput, &vestingPeriodsInput, nil
 }
 
+// NewMsgCreatePeriodicVestingAccount creates a new MsgCreatePeriodicVestingAccount instance.
+func NewMsgCreatePeriodicVestingAccount(args []interface{}, method *abi.Method) (*vestingtypes.MsgCreatePeriodicVestingAccount, common.Address, common.Address, *VestingPeriods, error) {
+	funderAddress, vestingAddress, err := validateBasicArgs(args, 5)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, nil, err
+	}
+
+	startTime, ok := args[2].(uint64)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "startTime", uint64(0), args[2])
+	}
+
+	var vestingPeriodsInput VestingPeriods
+	vestingPeriod := abi.Arguments{method.Inputs[3]}
+	if err := vestingPeriod.Copy(&vestingPeriodsInput, []interface{}{args[3]}); err != nil {
+		return nil, common.Address{}, common.Address{}, nil, fmt.Errorf("error while unpacking args to vestingPeriods struct: %s", err)
+	}
+
+	enableGovClawback, ok := args[4].(bool)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "enableGovClawback", true, args[4])
+	}
+
+	msg := &vestingtypes.MsgCreatePeriodicVestingAccount{
+		FunderAddress:     sdk.AccAddress(funderAddress.Bytes()).String(),
+		VestingAddress:    sdk.AccAddress(vestingAddress.Bytes()).String(),
+		StartTime:         time.Unix(int64(startTime), 0), //#nosec G115
+		VestingPeriods:    createCosmosPeriodsFromPeriod(vestingPeriodsInput.VestingPeriods),
+		EnableGovClawback: enableGovClawback,
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, common.Address{}, nil, err
+	}
+
+	return msg, funderAddress, vestingAddress, &vestingPeriodsInput, nil
+}
+
 // NewMsgClawback creates a new MsgClawback instance.
 func NewMsgClawback(args []interface{}) (*vestingtypes.MsgClawback, common.Address, common.Address, common.Address, error) {
 	funderAddress, accountAddress, err := validateBasicArgs(args, 3)
@@ -156,6 +196,30 @@ func NewMsgClawback(args []interface{}) (*vestingtypes.MsgClawback, common.Addre
 	return msg, funderAddress, accountAddress, destAddress, nil
 }
 
+// NewMsgClawbackToCommunityPool creates a new MsgClawback instance with the
+// community pool as destination. It returns the address of the community pool
+// as the destination address.
+func NewMsgClawbackToCommunityPool(args []interface{}) (*vestingtypes.MsgClawback, common.Address, common.Address, common.Address, error) {
+	funderAddress, accountAddress, err := validateBasicArgs(args, 2)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, common.Address{}, err
+	}
+
+	communityPoolAddress := authtypes.NewModuleAddress(distributiontypes.ModuleName)
+
+	msg := &vestingtypes.MsgClawback{
+		FunderAddress:  sdk.AccAddress(funderAddress.Bytes()).String(),
+		AccountAddress: sdk.AccAddress(accountAddress.Bytes()).String(),
+		DestAddress:    communityPoolAddress.String(),
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, common.Address{}, common.Address{}, err
+	}
+
+	return msg, funderAddress, accountAddress, common.BytesToAddress(communityPoolAddress), nil
+}
+
 // NewMsgUpdateVestingFunder creates a new MsgUpdateVestingFunder instance.
 func NewMsgUpdateVestingFunder(args []interface{}) (*vestingtypes.MsgUpdateVestingFunder, common.Address, common.Address, common.Address, error) {
 	if len(args) != 3 {
@@ -103,10 +103,14 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.FundVestingAccount(ctx, contract, evm.Origin, stateDB, method, args)
 	case ClawbackMethod:
 		bz, err = p.Clawback(ctx, contract, evm.Origin, stateDB, method, args)
+	case ClawbackToCommunityPoolMethod:
+		bz, err = p.ClawbackToCommunityPool(ctx, contract, evm.Origin, stateDB, method, args)
 	case UpdateVestingFunderMethod:
 		bz, err = p.UpdateVestingFunder(ctx, contract, evm.Origin, stateDB, method, args)
 	case ConvertVestingAccountMethod:
 		bz, err = p.ConvertVestingAccount(ctx, stateDB, method, args)
+	case CreatePeriodicVestingAccountMethod:
+		bz, err = p.CreatePeriodicVestingAccount(ctx, contract, evm.Origin, stateDB, method, args)
 	// Vesting queries
 	case BalancesMethod:
 		bz, err = p.Balances(ctx, method, args)
@@ -135,16 +139,20 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //   - CreateClawbackVestingAccount
 //   - FundVestingAccount
 //   - Clawback
+//   - ClawbackToCommunityPool
 //   - UpdateVestingFunder
 //   - ConvertVestingAccount
+//   - CreatePeriodicVestingAccount
 //   - Approve
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case CreateClawbackVestingAccountMethod,
 		FundVestingAccountMethod,
 		ClawbackMethod,
+		ClawbackToCommunityPoolMethod,
 		UpdateVestingFunderMethod,
 		ConvertVestingAccountMethod,
+		CreatePeriodicVestingAccountMethod,
 		authorization.ApproveMethod:
 		return true
 	default:
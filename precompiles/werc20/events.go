@@ -15,6 +15,12 @@ import (
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 )
 
+// NOTE: the Deposit and Withdrawal events have the same signature and topic
+// layout as the events of the canonical WETH9 contract, so that DEX routers and
+// indexers built against WETH9 can process them unmodified:
+//   - topics[0]: the event signature hash
+//   - topics[1]: the indexed account address (dst or src)
+//   - data: the ABI encoded amount (wad)
 const (
 	// EventTypeDeposit is the key of the event type for the Deposit transaction.
 	EventTypeDeposit = "Deposit"
@@ -45,7 +51,8 @@ func (p Precompile) EmitWithdrawalEvent(
 }
 
 // createWERC20Event adds to the StateDB a log representing an event for the
-// WERC20 precompile.
+// WERC20 precompile. No log is added if the event emission is disabled for the
+// token pair.
 func (p Precompile) createWERC20Event(
 	ctx sdk.Context,
 	stateDB vm.StateDB,
@@ -53,6 +60,10 @@ func (p Precompile) createWERC20Event(
 	address common.Address,
 	amount *big.Int,
 ) error {
+	if !p.EmitsEvents() {
+		return nil
+	}
+
 	// Prepare the event topics
 	topics := make([]common.Hash, 2)
 
@@ -70,7 +70,7 @@ func (s *PrecompileUnitTestSuite) SetupTest(chainID string) {
 	precompile, err := werc20.NewPrecompile(
 		tokenPair,
 		s.network.App.BankKeeper,
-		s.network.App.AuthzKeeper,
+		s.network.App.Erc20Keeper,
 		s.network.App.TransferKeeper,
 	)
 	s.Require().NoError(err, "failed to instantiate the werc20 precompile")
@@ -78,6 +78,13 @@ func (s *PrecompileUnitTestSuite) SetupTest(chainID string) {
 	s.precompile = precompile
 }
 
+var (
+	// weth9DepositTopic is the topic of the Deposit event of the canonical WETH9 contract.
+	weth9DepositTopic = common.HexToHash("0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c")
+	// weth9WithdrawalTopic is the topic of the Withdrawal event of the canonical WETH9 contract.
+	weth9WithdrawalTopic = common.HexToHash("0x7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b65")
+)
+
 type DepositEvent struct {
 	Dst common.Address
 	Wad *big.Int
@@ -130,6 +137,7 @@ func (s *PrecompileUnitTestSuite) TestEmitDepositEvent() {
 				crypto.Keccak256Hash([]byte(event.Sig)),
 				common.HexToHash(log.Topics[0].Hex()),
 			)
+			s.Require().Equal(weth9DepositTopic, log.Topics[0], "expected the WETH9 Deposit topic")
 			var adddressTopic common.Hash
 			copy(adddressTopic[common.HashLength-common.AddressLength:], caller[:])
 			s.Require().Equal(adddressTopic, log.Topics[1])
@@ -189,6 +197,7 @@ func (s *PrecompileUnitTestSuite) TestEmitWithdrawalEvent() {
 				crypto.Keccak256Hash([]byte(event.Sig)),
 				common.HexToHash(log.Topics[0].Hex()),
 			)
+			s.Require().Equal(weth9WithdrawalTopic, log.Topics[0], "expected the WETH9 Withdrawal topic")
 			var adddressTopic common.Hash
 			copy(adddressTopic[common.HashLength-common.AddressLength:], caller[:])
 			s.Require().Equal(adddressTopic, log.Topics[1])
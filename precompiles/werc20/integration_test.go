@@ -158,7 +158,7 @@ var _ = When("a user interact with the WEVMOS precompiled contract", func() {
 		precompile, err := werc20.NewPrecompile(
 			tokenPair,
 			is.network.App.BankKeeper,
-			is.network.App.AuthzKeeper,
+			is.network.App.Erc20Keeper,
 			is.network.App.TransferKeeper,
 		)
 		Expect(err).ToNot(HaveOccurred(), "failed to instantiate the werc20 precompile")
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/erc20"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
@@ -31,9 +32,23 @@ func (p Precompile) Deposit(
 	contract *vm.Contract,
 	stateDB vm.StateDB,
 ) ([]byte, error) {
+	if p.IsPaused() {
+		return nil, erc20.ErrTokenPaused
+	}
+
 	caller := contract.Caller()
 	depositedAmount := contract.Value()
 
+	// NOTE: the value of the call is in 18 decimals, so it's scaled down to the
+	// decimals of the bank coin. Deposits that can't be represented exactly are
+	// rejected so that no funds are silently lost.
+	amount, err := cmn.ScaleAmount(
+		depositedAmount, uint8(evmtypes.EighteenDecimals), uint8(evmtypes.GetEVMCoinDecimals()), cmn.RoundingRejectInexact,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	callerAccAddress := sdk.AccAddress(caller.Bytes())
 	precompileAccAddr := sdk.AccAddress(p.Address().Bytes())
 
@@ -44,12 +59,17 @@ func (p Precompile) Deposit(
 		callerAccAddress,
 		sdk.NewCoins(sdk.Coin{
 			Denom:  evmtypes.GetEVMCoinDenom(),
-			Amount: math.NewIntFromBigInt(depositedAmount),
+			Amount: math.NewIntFromBigInt(amount),
 		}),
 	); err != nil {
 		return nil, err
 	}
 
+	// NOTE: wrapping the native coin mints the wrapped tokens to the caller
+	if err := p.RunAfterMintHook(ctx, caller, amount); err != nil {
+		return nil, err
+	}
+
 	// Add the entries to the statedb journal since the function signature of
 	// the associated Solidity interface payable.
 	p.SetBalanceChangeEntries(
@@ -57,7 +77,7 @@ func (p Precompile) Deposit(
 		cmn.NewBalanceChangeEntry(p.Address(), depositedAmount, cmn.Sub),
 	)
 
-	if err := p.EmitDepositEvent(ctx, stateDB, caller, depositedAmount); err != nil {
+	if err := p.EmitDepositEvent(ctx, stateDB, caller, amount); err != nil {
 		return nil, err
 	}
 
@@ -68,6 +88,10 @@ func (p Precompile) Deposit(
 // WETH contract to support equality between the native coin and its wrapped
 // ERC-20 (e.g. EVMOS and WEVMOS).
 func (p Precompile) Withdraw(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, args []interface{}) ([]byte, error) {
+	if p.IsPaused() {
+		return nil, erc20.ErrTokenPaused
+	}
+
 	amount, ok := args[0].(*big.Int)
 	if !ok {
 		return nil, fmt.Errorf("invalid argument type: %T", args[0])
@@ -81,6 +105,11 @@ func (p Precompile) Withdraw(ctx sdk.Context, contract *vm.Contract, stateDB vm.
 		return nil, fmt.Errorf("account balance %v is lower than withdraw balance %v", nativeBalance.Amount, amountInt)
 	}
 
+	// NOTE: unwrapping the native coin burns the wrapped tokens of the caller
+	if err := p.RunAfterBurnHook(ctx, caller, amount); err != nil {
+		return nil, err
+	}
+
 	if err := p.EmitWithdrawalEvent(ctx, stateDB, caller, amount); err != nil {
 		return nil, err
 	}
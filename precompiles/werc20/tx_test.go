@@ -0,0 +1,68 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package werc20_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/testutil"
+	"github.com/evmos/evmos/v20/utils"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// recordingHooks records the erc20 hooks it receives.
+type recordingHooks struct {
+	calls *[]string
+}
+
+func (h recordingHooks) BeforeTransfer(sdk.Context, erc20types.TokenPair, common.Address, common.Address, *big.Int) error {
+	*h.calls = append(*h.calls, "BeforeTransfer")
+	return nil
+}
+
+func (h recordingHooks) AfterTransfer(sdk.Context, erc20types.TokenPair, common.Address, common.Address, *big.Int) error {
+	*h.calls = append(*h.calls, "AfterTransfer")
+	return nil
+}
+
+func (h recordingHooks) AfterMint(sdk.Context, erc20types.TokenPair, common.Address, *big.Int) error {
+	*h.calls = append(*h.calls, "AfterMint")
+	return nil
+}
+
+func (h recordingHooks) AfterBurn(sdk.Context, erc20types.TokenPair, common.Address, *big.Int) error {
+	*h.calls = append(*h.calls, "AfterBurn")
+	return nil
+}
+
+func (s *PrecompileUnitTestSuite) TestDepositWithdrawHooks() {
+	s.SetupTest(utils.MainnetChainID + "-1")
+	ctx := s.network.GetContext()
+	caller := s.keyring.GetAddr(0)
+	amount := big.NewInt(1_000)
+
+	var calls []string
+	s.precompile.SetHooks(recordingHooks{calls: &calls})
+
+	// the deposited value is transferred to the precompile before the call
+	coins := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), math.NewIntFromBigInt(amount)))
+	err := testutil.FundAccount(ctx, s.network.App.BankKeeper, s.precompile.Address().Bytes(), coins)
+	s.Require().NoError(err)
+
+	contract := vm.NewContract(vm.AccountRef(caller), s.precompile, amount, 100_000)
+	_, err = s.precompile.Deposit(ctx, contract, s.network.GetStateDB())
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"AfterMint"}, calls)
+
+	contract = vm.NewContract(vm.AccountRef(caller), s.precompile, big.NewInt(0), 100_000)
+	_, err = s.precompile.Withdraw(ctx, contract, s.network.GetStateDB(), []interface{}{amount})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"AfterMint", "AfterBurn"}, calls)
+}
@@ -6,6 +6,7 @@ package werc20
 import (
 	"embed"
 	"fmt"
+	"math/big"
 	"slices"
 
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
@@ -13,7 +14,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
-	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	erc20 "github.com/evmos/evmos/v20/precompiles/erc20"
@@ -55,7 +55,7 @@ func LoadABI() (abi.ABI, error) {
 func NewPrecompile(
 	tokenPair erc20types.TokenPair,
 	bankKeeper bankkeeper.Keeper,
-	authzKeeper authzkeeper.Keeper,
+	allowanceKeeper erc20.AllowanceKeeper,
 	transferKeeper transferkeeper.Keeper,
 ) (*Precompile, error) {
 	newABI, err := LoadABI()
@@ -63,7 +63,7 @@ func NewPrecompile(
 		return nil, fmt.Errorf("error loading the ABI: %w", err)
 	}
 
-	erc20Precompile, err := erc20.NewPrecompile(tokenPair, bankKeeper, authzKeeper, transferKeeper)
+	erc20Precompile, err := erc20.NewPrecompile(tokenPair, bankKeeper, allowanceKeeper, transferKeeper)
 	if err != nil {
 		return nil, fmt.Errorf("error instantiating the ERC20 precompile: %w", err)
 	}
@@ -95,6 +95,11 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 	methodID := input[:4]
 	method, err := p.MethodById(methodID)
 	if err != nil {
+		// NOTE: calls with an unknown method ID are handled by the fallback
+		// function, which behaves like deposit as in WETH9.
+		if p.HasFallback() {
+			return DepositRequiredGas
+		}
 		return 0
 	}
 
@@ -108,6 +113,30 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 	}
 }
 
+// GasCost returns the gas required to execute the method with the given name.
+// It returns an error if the method is unknown.
+func (p Precompile) GasCost(methodName string) (uint64, error) {
+	switch methodName {
+	case DepositMethod:
+		return DepositRequiredGas, nil
+	case WithdrawMethod:
+		return WithdrawRequiredGas, nil
+	default:
+		return p.Precompile.GasCost(methodName)
+	}
+}
+
+// EstimateFee returns the fee in native coin that is charged for the execution
+// of the method with the given name at the given gas price.
+func (p Precompile) EstimateFee(methodName string, gasPrice *big.Int) (*big.Int, error) {
+	gas, err := p.GasCost(methodName)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmn.CalculateFee(gas, gasPrice)
+}
+
 // Run executes the precompiled contract WERC20 methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
 	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
@@ -133,7 +162,13 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	}
 
 	if err != nil {
-		return nil, err
+		// NOTE: Cosmos SDK errors revert with the CosmosError custom error, which
+		// returns the remaining gas to the caller, so the gas consumed until the
+		// failure is charged here.
+		if !contract.UseGas(ctx.GasMeter().GasConsumed() - initialGas) {
+			return nil, vm.ErrOutOfGas
+		}
+		return cmn.RevertWithCosmosError(err)
 	}
 
 	cost := ctx.GasMeter().GasConsumed() - initialGas
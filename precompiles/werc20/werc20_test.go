@@ -0,0 +1,71 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package werc20_test
+
+import (
+	"math/big"
+
+	"github.com/evmos/evmos/v20/precompiles/erc20"
+	"github.com/evmos/evmos/v20/precompiles/werc20"
+	"github.com/evmos/evmos/v20/utils"
+)
+
+func (s *PrecompileUnitTestSuite) TestRequiredGas() {
+	s.SetupTest(utils.MainnetChainID + "-1")
+
+	testCases := []struct {
+		name   string
+		input  func() []byte
+		expGas uint64
+	}{
+		{
+			name:   "empty calldata - receive",
+			input:  func() []byte { return nil },
+			expGas: werc20.DepositRequiredGas,
+		},
+		{
+			name:   "short calldata - fallback",
+			input:  func() []byte { return []byte{1, 2, 3} },
+			expGas: werc20.DepositRequiredGas,
+		},
+		{
+			name:   "unknown method - fallback",
+			input:  func() []byte { return []byte("nonExistingMethod") },
+			expGas: werc20.DepositRequiredGas,
+		},
+		{
+			name: "deposit",
+			input: func() []byte {
+				bz, err := s.precompile.ABI.Pack(werc20.DepositMethod)
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: werc20.DepositRequiredGas,
+		},
+		{
+			name: "withdraw",
+			input: func() []byte {
+				bz, err := s.precompile.ABI.Pack(werc20.WithdrawMethod, big.NewInt(1))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: werc20.WithdrawRequiredGas,
+		},
+		{
+			name: "erc20 method",
+			input: func() []byte {
+				bz, err := s.precompile.ABI.Pack(erc20.BalanceOfMethod, s.keyring.GetAddr(0))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: erc20.GasBalanceOf,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.expGas, s.precompile.RequiredGas(tc.input()))
+		})
+	}
+}
@@ -11,12 +11,14 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/evmos/evmos/v20/rpc/backend"
+	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/admin"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/debug"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/eth"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/eth/filters"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/miner"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/net"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/personal"
+	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/trace"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/txpool"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/web3"
 	"github.com/evmos/evmos/v20/types"
@@ -39,6 +41,8 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
+	AdminNamespace    = "admin"
 
 	apiVersion = "1.0"
 )
@@ -115,12 +119,18 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		TxPoolNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
 					Version:   apiVersion,
-					Service:   txpool.NewPublicAPI(ctx.Logger),
+					Service:   txpool.NewPublicAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
@@ -141,6 +151,22 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewPublicAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 		MinerNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
@@ -157,6 +183,16 @@ func init() {
 				},
 			}
 		},
+		AdminNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+			return []rpc.API{
+				{
+					Namespace: AdminNamespace,
+					Version:   apiVersion,
+					Service:   admin.NewPrivateAPI(ctx, clientCtx),
+					Public:    false,
+				},
+			}
+		},
 	}
 }
 
@@ -3,6 +3,7 @@
 package backend
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"math/big"
@@ -14,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	rpctypes "github.com/evmos/evmos/v20/rpc/types"
@@ -74,10 +76,12 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	// query storage proofs
 	storageProofs := make([]rpctypes.StorageResult, len(storageKeys))
+	storeKeys := make([]hexutil.Bytes, len(storageKeys))
 
 	for i, key := range storageKeys {
 		hexKey := common.HexToHash(key)
-		valueBz, proof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+		storeKeys[i] = evmtypes.StateKey(address, hexKey.Bytes())
+		valueBz, proof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, storeKeys[i])
 		if err != nil {
 			return nil, err
 		}
@@ -101,7 +105,12 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	// query account proofs
 	accountKey := bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address.Bytes()...))
-	_, proof, err := b.queryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	accountValue, proof, err := b.queryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	appHash, err := GetProofRoot(proof, accountValue)
 	if err != nil {
 		return nil, err
 	}
@@ -111,14 +120,40 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		return nil, errors.New("invalid balance")
 	}
 
+	// query balance proof, as the balance is kept in the bank store and not in
+	// the account
+	balanceKey, err := BalanceStoreKey(address, evmtypes.GetEVMCoinDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	balanceValue, balanceProof, err := b.queryClient.GetProof(clientCtx, banktypes.StoreKey, balanceKey)
+	if err != nil {
+		return nil, err
+	}
+
 	return &rpctypes.AccountResult{
 		Address:      address,
 		AccountProof: GetHexProofs(proof),
 		Balance:      (*hexutil.Big)(balance.BigInt()),
 		CodeHash:     common.HexToHash(res.CodeHash),
 		Nonce:        hexutil.Uint64(res.Nonce),
-		StorageHash:  common.Hash{}, // NOTE: Evmos doesn't have a storage hash. TODO: implement?
+		StorageHash:  common.Hash{}, // NOTE: Evmos doesn't have a storage hash, see the envelope
 		StorageProof: storageProofs,
+		Envelope: &rpctypes.ProofEnvelope{
+			ProofType:    rpctypes.ProofTypeICS23IAVL,
+			Height:       hexutil.Uint64(height), //nolint:gosec // G115 -- height is positive
+			AppHash:      appHash,
+			AccountStore: authtypes.StoreKey,
+			AccountKey:   hexutil.Bytes(accountKey),
+			AccountValue: accountValue,
+			StorageStore: evmtypes.StoreKey,
+			StorageKeys:  storeKeys,
+			BalanceStore: banktypes.StoreKey,
+			BalanceKey:   balanceKey,
+			BalanceValue: balanceValue,
+			BalanceProof: GetHexProofs(balanceProof),
+		},
 	}, nil
 }
 
@@ -134,7 +169,11 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 		Key:     key,
 	}
 
-	res, err := b.queryClient.Storage(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	var res *evmtypes.QueryStorageResponse
+	err = b.queryState(rpctypes.ContextWithHeight(blockNum.Int64()), blockNum.Int64(), func(ctx context.Context, queryClient *rpctypes.QueryClient) (err error) {
+		res, err = queryClient.Storage(ctx, req)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +198,11 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 		return nil, err
 	}
 
-	res, err := b.queryClient.Balance(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	var res *evmtypes.QueryBalanceResponse
+	err = b.queryState(rpctypes.ContextWithHeight(blockNum.Int64()), blockNum.Int64(), func(ctx context.Context, queryClient *rpctypes.QueryClient) (err error) {
+		res, err = queryClient.Balance(ctx, req)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
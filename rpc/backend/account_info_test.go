@@ -7,6 +7,7 @@ import (
 	"github.com/cometbft/cometbft/libs/bytes"
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"google.golang.org/grpc/metadata"
@@ -80,6 +81,8 @@ func (suite *BackendTestSuite) TestGetProof() {
 	blockNrInvalid := rpctypes.NewBlockNumber(big.NewInt(1))
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(4))
 	address1 := utiltx.GenerateAddress()
+	balanceKey, err := BalanceStoreKey(address1, evmtypes.GetEVMCoinDenom())
+	suite.Require().NoError(err)
 
 	testCases := []struct {
 		name          string
@@ -147,6 +150,13 @@ func (suite *BackendTestSuite) TestGetProof() {
 					bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address1.Bytes()...)),
 					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
 				)
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/bank/key",
+					balanceKey,
+					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
 			},
 			true,
 			&rpctypes.AccountResult{
@@ -163,6 +173,19 @@ func (suite *BackendTestSuite) TestGetProof() {
 						Proof: []string{""},
 					},
 				},
+				Envelope: &rpctypes.ProofEnvelope{
+					ProofType:    rpctypes.ProofTypeICS23IAVL,
+					Height:       hexutil.Uint64(blockNr.Int64()),
+					AccountStore: authtypes.StoreKey,
+					AccountKey:   hexutil.Bytes(append(authtypes.AddressStoreKeyPrefix, address1.Bytes()...)),
+					AccountValue: []byte{2},
+					StorageStore: evmtypes.StoreKey,
+					StorageKeys:  []hexutil.Bytes{evmtypes.StateKey(address1, common.HexToHash("0x0").Bytes())},
+					BalanceStore: banktypes.StoreKey,
+					BalanceKey:   balanceKey,
+					BalanceValue: []byte{2},
+					BalanceProof: []string{""},
+				},
 			},
 		},
 	}
@@ -228,6 +251,34 @@ func (suite *BackendTestSuite) TestGetStorageAt() {
 			true,
 			hexutil.Bytes{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
 		},
+		{
+			"fail - state pruned without an archive node",
+			utiltx.GenerateAddress(),
+			"0x0",
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(addr common.Address, key string, _ string) {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterStorageAtPruned(queryClient, addr, key)
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - state pruned served by the archive node",
+			utiltx.GenerateAddress(),
+			"0x0",
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(addr common.Address, key string, storage string) {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterStorageAtPruned(queryClient, addr, key)
+
+				archiveQueryClient := mocks.NewEVMQueryClient(suite.T())
+				suite.backend.archiveQueryClient = &rpctypes.QueryClient{QueryClient: archiveQueryClient}
+				RegisterStorageAt(archiveQueryClient, addr, key, storage)
+			},
+			true,
+			hexutil.Bytes{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -432,7 +432,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, nil)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -505,3 +505,38 @@ func (suite *BackendTestSuite) TestGasPrice() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	precompileAddr := common.HexToAddress("0x0000000000000000000000000000000000000800")
+	chainID := (*hexutil.Big)(suite.backend.chainID)
+	callArgs := evmtypes.TransactionArgs{
+		To:      &toAddr,
+		ChainID: chainID,
+	}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	accessList := ethtypes.AccessList{{Address: precompileAddr, StorageKeys: []common.Hash{}}}
+
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	_, err = RegisterBlock(client, 1, bz)
+	suite.Require().NoError(err)
+	RegisterCreateAccessList(
+		queryClient,
+		&evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()},
+		&evmtypes.CreateAccessListResponse{
+			AccessList: evmtypes.NewAccessList(&accessList),
+			GasUsed:    30000,
+			VmError:    "execution reverted",
+		},
+	)
+
+	res, err := suite.backend.CreateAccessList(callArgs, rpctypes.BlockNumber(1), nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&accessList, res.AccessList)
+	suite.Require().Equal(hexutil.Uint64(30000), res.GasUsed)
+	suite.Require().Equal("execution reverted", res.Error)
+}
@@ -153,13 +153,18 @@ func (b *Backend) FeeHistory(
 	rewardPercentiles []float64, // percentiles to fetch reward
 ) (*rpctypes.FeeHistoryResult, error) {
 	blockEnd := int64(lastBlock) //#nosec G115 G701 -- checked for int overflow already
+	maxRange := int64(b.cfg.JSONRPC.FeeHistoryMaxRange)
 
-	if blockEnd < 0 {
+	var latest int64
+	if blockEnd < 0 || maxRange > 0 {
 		blockNumber, err := b.BlockNumber()
 		if err != nil {
 			return nil, err
 		}
-		blockEnd = int64(blockNumber) //#nosec G115 G701 -- checked for int overflow already
+		latest = int64(blockNumber) //#nosec G115 G701 -- checked for int overflow already
+		if blockEnd < 0 || blockEnd > latest {
+			blockEnd = latest
+		}
 	}
 
 	blocks := int64(userBlockCount)                     // #nosec G115 G701 -- checked for int overflow already
@@ -173,6 +178,11 @@ func (b *Backend) FeeHistory(
 	}
 	// Ensure not trying to retrieve before genesis.
 	blockStart := blockEnd + 1 - blocks
+
+	// Ensure not trying to retrieve blocks older than the configured range.
+	if maxRange > 0 && latest-blockStart >= maxRange {
+		return nil, fmt.Errorf("FeeHistory oldest block %d is older than the max range of %d blocks", blockStart, maxRange)
+	}
 	oldestBlock := (*hexutil.Big)(big.NewInt(blockStart))
 
 	// prepare space
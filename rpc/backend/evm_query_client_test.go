@@ -151,6 +151,13 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// Create Access List
+func RegisterCreateAccessList(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest, res *evmtypes.CreateAccessListResponse) {
+	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
+	queryClient.On("CreateAccessList", ctx, request).
+		Return(res, nil)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
@@ -244,6 +251,11 @@ func RegisterStorageAtError(queryClient *mocks.EVMQueryClient, addr common.Addre
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterStorageAtPruned(queryClient *mocks.EVMQueryClient, addr common.Address, key string) {
+	queryClient.On("Storage", rpc.ContextWithHeight(1), &evmtypes.QueryStorageRequest{Address: addr.String(), Key: key}).
+		Return(nil, status.Error(codes.InvalidArgument, "failed to load state at height 1; version does not exist (latest height: 10)"))
+}
+
 func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Account", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRequest{Address: addr.String()}).
 		Return(&evmtypes.QueryAccountResponse{
@@ -281,3 +293,14 @@ func RegisterGlobalMinGasPrice(queryClient *mocks.EVMQueryClient, height int64)
 	queryClient.On("GlobalMinGasPrice", rpc.ContextWithHeight(height), &evmtypes.QueryGlobalMinGasPriceRequest{}).
 		Return(&evmtypes.QueryGlobalMinGasPriceResponse{MinGasPrice: math.OneInt()}, nil)
 }
+
+// Receipt
+func RegisterReceipt(queryClient *mocks.EVMQueryClient, receipt evmtypes.Receipt) {
+	queryClient.On("Receipt", rpc.ContextWithHeight(0), &evmtypes.QueryReceiptRequest{TxHash: receipt.TxHash}).
+		Return(&evmtypes.QueryReceiptResponse{Receipt: receipt}, nil)
+}
+
+func RegisterReceiptNotFound(queryClient *mocks.EVMQueryClient, txHash common.Hash) {
+	queryClient.On("Receipt", rpc.ContextWithHeight(0), &evmtypes.QueryReceiptRequest{TxHash: txHash.Hex()}).
+		Return(nil, status.Error(codes.NotFound, "receipt not found"))
+}
@@ -6,6 +6,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	"github.com/evmos/evmos/v20/indexer"
+	evmostypes "github.com/evmos/evmos/v20/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -34,5 +37,33 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
-	return 4096, 0
+	bloomIndexer, ok := b.indexer.(evmostypes.EVMBloomIndexer)
+	if !ok {
+		return indexer.DefaultBloomSectionSize, 0
+	}
+
+	sections, err := bloomIndexer.BloomSections()
+	if err != nil {
+		b.logger.Debug("failed to get the number of bloom sections", "error", err.Error())
+		return bloomIndexer.BloomSectionSize(), 0
+	}
+	return bloomIndexer.BloomSectionSize(), sections
+}
+
+// HasBloomSection returns true if the bloom-bits of the section are indexed.
+func (b *Backend) HasBloomSection(section uint64) (bool, error) {
+	bloomIndexer, ok := b.indexer.(evmostypes.EVMBloomIndexer)
+	if !ok {
+		return false, nil
+	}
+	return bloomIndexer.HasBloomSection(section)
+}
+
+// BloomBits returns the bitset of the bloom bit for the blocks of an indexed section.
+func (b *Backend) BloomBits(bit uint, section uint64) ([]byte, error) {
+	bloomIndexer, ok := b.indexer.(evmostypes.EVMBloomIndexer)
+	if !ok {
+		return nil, errors.New("bloom-bits index not available")
+	}
+	return bloomIndexer.BloomBits(bit, section)
 }
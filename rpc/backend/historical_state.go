@@ -0,0 +1,62 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+)
+
+// prunedStateError is the error message returned by the node when the state at
+// the queried height is not available
+const prunedStateError = "failed to load state at height"
+
+// newArchiveQueryClient returns the query client of the archive node at the
+// given gRPC address, which serves the state pruned by the node.
+func newArchiveQueryClient(clientCtx client.Context, address string) (*rpctypes.QueryClient, error) {
+	conn, err := grpc.NewClient(
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.ForceCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpctypes.NewQueryClient(clientCtx.WithGRPCClient(conn)), nil
+}
+
+// queryState runs the query against the state at the given height, where a
+// height of 0 queries the latest state. The context must carry the height, as
+// set by rpctypes.ContextWithHeight. The query is forwarded to the archive node
+// if the node has pruned the state at the height.
+func (b *Backend) queryState(
+	ctx context.Context,
+	height int64,
+	query func(ctx context.Context, queryClient *rpctypes.QueryClient) error,
+) error {
+	err := query(ctx, b.queryClient)
+	if err == nil || height == 0 || !strings.Contains(err.Error(), prunedStateError) {
+		return err
+	}
+
+	if b.archiveQueryClient == nil {
+		return fmt.Errorf(
+			"state at height %d is not available: it was pruned by this node, use an archive node or set %q",
+			height, "json-rpc.archive-grpc-address",
+		)
+	}
+
+	b.logger.Debug("querying pruned state from the archive node", "height", height)
+	return query(ctx, b.archiveQueryClient)
+}
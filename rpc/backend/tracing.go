@@ -9,6 +9,7 @@ import (
 
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	rpctypes "github.com/evmos/evmos/v20/rpc/types"
@@ -16,6 +17,9 @@ import (
 	"github.com/pkg/errors"
 )
 
+// callTracer is the geth tracer whose results are converted to parity traces.
+const callTracer = "callTracer"
+
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
 func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
@@ -148,25 +152,7 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		return []*evmtypes.TxTraceResult{}, nil
 	}
 
-	txDecoder := b.clientCtx.TxConfig.TxDecoder()
-
-	var txsMessages []*evmtypes.MsgEthereumTx
-	for i, tx := range txs {
-		decodedTx, err := txDecoder(tx)
-		if err != nil {
-			b.logger.Error("failed to decode transaction", "hash", txs[i].Hash(), "error", err.Error())
-			continue
-		}
-
-		for _, msg := range decodedTx.GetMsgs() {
-			ethMessage, ok := msg.(*evmtypes.MsgEthereumTx)
-			if !ok {
-				// Just considers Ethereum transactions
-				continue
-			}
-			txsMessages = append(txsMessages, ethMessage)
-		}
-	}
+	txsMessages := b.tracedEthMsgs(txs)
 
 	// minus one to get the context at the beginning of the block
 	contextHeight := height - 1
@@ -195,6 +181,7 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+		Concurrency:     uint32(b.cfg.JSONRPC.TraceBlockConcurrency), // #nosec G115 -- validated to be non-negative
 	}
 
 	res, err := b.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
@@ -209,3 +196,131 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// tracedEthMsgs returns the ethereum txs of the block txs that are traced by
+// TraceBlock, in the order of their trace results.
+func (b *Backend) tracedEthMsgs(txs tmtypes.Txs) []*evmtypes.MsgEthereumTx {
+	txDecoder := b.clientCtx.TxConfig.TxDecoder()
+
+	var txsMessages []*evmtypes.MsgEthereumTx
+	for i, tx := range txs {
+		decodedTx, err := txDecoder(tx)
+		if err != nil {
+			b.logger.Error("failed to decode transaction", "hash", txs[i].Hash(), "error", err.Error())
+			continue
+		}
+
+		for _, msg := range decodedTx.GetMsgs() {
+			ethMessage, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// Just considers Ethereum transactions
+				continue
+			}
+			txsMessages = append(txsMessages, ethMessage)
+		}
+	}
+
+	return txsMessages
+}
+
+// ParityTraceTransaction returns the traces of the calls of a transaction in
+// the parity format, from the results of the callTracer.
+func (b *Backend) ParityTraceTransaction(hash common.Hash) ([]*rpctypes.ParityTrace, error) {
+	transaction, err := b.GetTxByEthHash(hash)
+	if err != nil {
+		b.logger.Debug("tx not found", "hash", hash)
+		return nil, err
+	}
+
+	blk, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(transaction.Height))
+	if err != nil {
+		b.logger.Debug("block not found", "height", transaction.Height)
+		return nil, err
+	}
+	if blk == nil {
+		return nil, fmt.Errorf("block %d not found", transaction.Height)
+	}
+
+	result, err := b.TraceTransaction(hash, &evmtypes.TraceConfig{Tracer: callTracer})
+	if err != nil {
+		return nil, err
+	}
+
+	frame, err := decodeCallFrame(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpctypes.ParityTraces(*frame, rpctypes.ParityTraceContext{
+		BlockHash:           common.BytesToHash(blk.BlockID.Hash),
+		BlockNumber:         uint64(blk.Block.Height), //#nosec G115 -- block height is not negative
+		TransactionHash:     hash,
+		TransactionPosition: uint64(transaction.EthTxIndex), //#nosec G115 -- the tx is indexed
+	}), nil
+}
+
+// ParityTraceBlock returns the traces of the calls of all the ethereum
+// transactions of a block in the parity format, from the results of the
+// callTracer.
+func (b *Backend) ParityTraceBlock(height rpctypes.BlockNumber) ([]*rpctypes.ParityTrace, error) {
+	blk, err := b.TendermintBlockByNumber(height)
+	if err != nil {
+		b.logger.Debug("block not found", "height", height)
+		return nil, err
+	}
+	if blk == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	if blk.Block.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	results, err := b.TraceBlock(rpctypes.BlockNumber(blk.Block.Height), &evmtypes.TraceConfig{Tracer: callTracer}, blk)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := b.tracedEthMsgs(blk.Block.Txs)
+	if len(results) > len(msgs) {
+		return nil, fmt.Errorf("got %d trace results for %d transactions", len(results), len(msgs))
+	}
+
+	traces := []*rpctypes.ParityTrace{}
+	for i, result := range results {
+		if result == nil {
+			continue
+		}
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %s: %s", msgs[i].Hash, result.Error)
+		}
+
+		frame, err := decodeCallFrame(result.Result)
+		if err != nil {
+			return nil, err
+		}
+
+		traces = append(traces, rpctypes.ParityTraces(*frame, rpctypes.ParityTraceContext{
+			BlockHash:           common.BytesToHash(blk.BlockID.Hash),
+			BlockNumber:         uint64(blk.Block.Height), //#nosec G115 -- block height is not negative
+			TransactionHash:     common.HexToHash(msgs[i].Hash),
+			TransactionPosition: uint64(i), //#nosec G115 -- index is not negative
+		})...)
+	}
+
+	return traces, nil
+}
+
+// decodeCallFrame decodes the top level call frame of the JSON decoded result
+// of the callTracer.
+func decodeCallFrame(result interface{}) (*rpctypes.CallFrame, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	var frame rpctypes.CallFrame
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the call tracer result")
+	}
+	return &frame, nil
+}
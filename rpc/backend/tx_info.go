@@ -143,6 +143,14 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	hexTx := hash.Hex()
 	b.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	// serve the receipt stored in the EVM module state, and fall back to the
+	// block results for the txs whose receipts are not stored or were pruned
+	storedReceipt, err := b.getStoredTransactionReceipt(hash)
+	if err == nil {
+		return storedReceipt, nil
+	}
+	b.logger.Debug("stored receipt not found", "hash", hexTx, "error", err.Error())
+
 	res, err := b.GetTxByEthHash(hash)
 	if err != nil {
 		b.logger.Debug("tx not found", "hash", hexTx, "error", err.Error())
@@ -243,25 +251,115 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		// sender and receiver (contract or EOA) addreses
 		"from": from,
 		"to":   txData.GetTo(),
-		"type": hexutil.Uint(ethMsg.AsTransaction().Type()),
+		"type": hexutil.Uint(txData.TxType()),
 	}
 
 	if logs == nil {
 		receipt["logs"] = [][]*ethtypes.Log{}
 	}
 
+	gasReport, err := GasReportFromEvents(blockRes.TxsResults[res.TxIndex].Events, hash)
+	if err != nil {
+		b.logger.Debug("failed to parse gas report", "hash", hexTx, "error", err.Error())
+	} else if gasReport != nil {
+		receipt["gasReport"] = gasReport
+	}
+
 	// If the ContractAddress is 20 0x0 bytes, assume it is not a contract creation
 	if txData.GetTo() == nil {
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
+	switch txData.(type) {
+	case *evmtypes.DynamicFeeTx, *evmtypes.SetCodeTx:
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
 			// tolerate the error for pruned node.
 			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
 		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
+			receipt["effectiveGasPrice"] = hexutil.Big(*txData.EffectiveGasPrice(baseFee))
+		}
+	}
+
+	return receipt, nil
+}
+
+// getStoredTransactionReceipt returns the receipt of the transaction with the
+// given hash from the EVM module state, without querying the block results.
+func (b *Backend) getStoredTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+	res, err := b.queryClient.Receipt(b.ctx, &evmtypes.QueryReceiptRequest{TxHash: hash.Hex()})
+	if err != nil {
+		return nil, err
+	}
+
+	stored := res.Receipt
+	logs := evmtypes.LogsToEthereum(stored.Logs)
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+
+	var to *common.Address
+	if stored.To != "" {
+		address := common.HexToAddress(stored.To)
+		to = &address
+	}
+
+	receipt := map[string]interface{}{
+		// Consensus fields: These fields are defined by the Yellow Paper
+		"status":            hexutil.Uint(stored.Status),
+		"cumulativeGasUsed": hexutil.Uint64(stored.CumulativeGasUsed),
+		"logsBloom":         ethtypes.BytesToBloom(stored.LogsBloom),
+		"logs":              logs,
+
+		// Implementation fields: These fields are added by geth when processing a transaction.
+		// They are stored in the chain database.
+		"transactionHash": hash,
+		"contractAddress": nil,
+		"gasUsed":         hexutil.Uint64(stored.GasUsed),
+
+		// Inclusion information: These fields provide information about the inclusion of the
+		// transaction corresponding to this receipt.
+		"blockHash":        stored.BlockHash,
+		"blockNumber":      hexutil.Uint64(stored.BlockNumber),
+		"transactionIndex": hexutil.Uint64(stored.TxIndex),
+
+		// sender and receiver (contract or EOA) addreses
+		"from": common.HexToAddress(stored.From),
+		"to":   to,
+		"type": hexutil.Uint(stored.TxType),
+	}
+
+	if stored.ContractAddress != "" {
+		receipt["contractAddress"] = common.HexToAddress(stored.ContractAddress)
+	}
+
+	switch stored.TxType {
+	case ethtypes.DynamicFeeTxType, evmtypes.SetCodeTxType:
+		if stored.EffectiveGasPrice != nil {
+			receipt["effectiveGasPrice"] = (*hexutil.Big)(stored.EffectiveGasPrice.BigInt())
+		}
+	}
+
+	// the gas report is a node-level setting emitted as an event, so it's only
+	// looked up in the block results when it's enabled
+	if b.cfg.EVM.GasReport {
+		height := int64(stored.BlockNumber) //#nosec G115 -- block number fits in an int64
+		blockRes, err := b.rpcClient.BlockResults(b.ctx, &height)
+		if err != nil {
+			b.logger.Debug("failed to retrieve block results", "height", height, "error", err.Error())
+			return receipt, nil
+		}
+
+		for _, txResult := range blockRes.TxsResults {
+			gasReport, err := GasReportFromEvents(txResult.Events, hash)
+			if err != nil {
+				b.logger.Debug("failed to parse gas report", "hash", hash.Hex(), "error", err.Error())
+				break
+			}
+			if gasReport != nil {
+				receipt["gasReport"] = gasReport
+				break
+			}
 		}
 	}
 
@@ -12,6 +12,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v20/indexer"
 	"github.com/evmos/evmos/v20/rpc/backend/mocks"
 	rpctypes "github.com/evmos/evmos/v20/rpc/types"
@@ -547,6 +548,7 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 	txHash := msgEthereumTx.AsTransaction().Hash()
 
 	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	recipient := common.HexToAddress("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")
 
 	testCases := []struct {
 		name         string
@@ -563,6 +565,7 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 				var header metadata.MD
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterReceiptNotFound(queryClient, txHash)
 				RegisterParams(queryClient, &header, 1)
 				_, err := RegisterBlock(client, 1, txBz)
 				suite.Require().NoError(err)
@@ -589,6 +592,57 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 			map[string]interface{}(nil),
 			false,
 		},
+		{
+			"pass - stored receipt",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterReceipt(queryClient, evmtypes.Receipt{
+					TxHash:            txHash.Hex(),
+					TxIndex:           0,
+					From:              common.BytesToAddress(msgEthereumTx.GetFrom()).Hex(),
+					To:                "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7",
+					GasUsed:           21000,
+					Status:            ethtypes.ReceiptStatusSuccessful,
+					BlockNumber:       1,
+					BlockHash:         common.BytesToHash([]byte("block")).Hex(),
+					CumulativeGasUsed: 21000,
+					TxType:            ethtypes.LegacyTxType,
+				})
+			},
+			msgEthereumTx,
+			&types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}},
+			[]*abci.ExecTxResult{
+				{
+					Code: 0,
+					Events: []abci.Event{
+						{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+							{Key: "ethereumTxHash", Value: txHash.Hex()},
+							{Key: "txIndex", Value: "0"},
+							{Key: "amount", Value: "1000"},
+							{Key: "txGasUsed", Value: "21000"},
+							{Key: "txHash", Value: ""},
+							{Key: "recipient", Value: recipient.Hex()},
+						}},
+					},
+				},
+			},
+			map[string]interface{}{
+				"status":            hexutil.Uint(ethtypes.ReceiptStatusSuccessful),
+				"cumulativeGasUsed": hexutil.Uint64(21000),
+				"logsBloom":         ethtypes.Bloom{},
+				"logs":              []*ethtypes.Log{},
+				"transactionHash":   txHash,
+				"contractAddress":   nil,
+				"gasUsed":           hexutil.Uint64(21000),
+				"blockHash":         common.BytesToHash([]byte("block")).Hex(),
+				"blockNumber":       hexutil.Uint64(1),
+				"transactionIndex":  hexutil.Uint64(0),
+				"from":              common.BytesToAddress(msgEthereumTx.GetFrom()),
+				"to":                &recipient,
+				"type":              hexutil.Uint(ethtypes.LegacyTxType),
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,117 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// TxPoolContent returns the Ethereum transactions of the mempool grouped by
+// sender and nonce. The transactions are classified per account using the
+// committed nonce of the sender: the ones with consecutive nonces starting
+// from the account nonce are pending (executable), while the ones after a
+// nonce gap are queued. Transactions with a nonce lower than the account nonce
+// are already executed and are skipped.
+func (b *Backend) TxPoolContent() (
+	pending, queued map[common.Address]map[uint64]*rpctypes.RPCTransaction,
+	err error,
+) {
+	pending = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	queued = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bySender := make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSender(b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to get sender of mempool tx", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+
+			rpcTx, err := rpctypes.NewTransactionFromMsg(ethMsg, common.Hash{}, uint64(0), uint64(0), nil, b.chainID)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if _, ok := bySender[sender]; !ok {
+				bySender[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+			}
+			// keep the first tx seen for a given nonce
+			nonce := uint64(rpcTx.Nonce)
+			if _, found := bySender[sender][nonce]; !found {
+				bySender[sender][nonce] = rpcTx
+			}
+		}
+	}
+
+	for sender, senderTxs := range bySender {
+		accountNonce, err := b.getAccountNonce(sender, false, 0, b.logger)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		nonces := make([]uint64, 0, len(senderTxs))
+		for nonce := range senderTxs {
+			nonces = append(nonces, nonce)
+		}
+
+		pendingNonces, queuedNonces := classifyTxPoolNonces(accountNonce, nonces)
+		if len(pendingNonces) > 0 {
+			pending[sender] = make(map[uint64]*rpctypes.RPCTransaction, len(pendingNonces))
+			for _, nonce := range pendingNonces {
+				pending[sender][nonce] = senderTxs[nonce]
+			}
+		}
+		if len(queuedNonces) > 0 {
+			queued[sender] = make(map[uint64]*rpctypes.RPCTransaction, len(queuedNonces))
+			for _, nonce := range queuedNonces {
+				queued[sender][nonce] = senderTxs[nonce]
+			}
+		}
+	}
+
+	return pending, queued, nil
+}
+
+// classifyTxPoolNonces splits the mempool nonces of an account into the
+// executable ones, which are consecutive starting from the account nonce,
+// and the ones queued behind a nonce gap. Nonces lower than the account nonce
+// are discarded.
+func classifyTxPoolNonces(accountNonce uint64, nonces []uint64) (pending, queued []uint64) {
+	sorted := make([]uint64, len(nonces))
+	copy(sorted, nonces)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	next := accountNonce
+	gap := false
+	for _, nonce := range sorted {
+		switch {
+		case nonce < accountNonce:
+			continue
+		case !gap && nonce == next:
+			pending = append(pending, nonce)
+			next++
+		default:
+			gap = true
+			queued = append(queued, nonce)
+		}
+	}
+
+	return pending, queued
+}
@@ -0,0 +1,55 @@
+package backend
+
+func (suite *BackendTestSuite) TestClassifyTxPoolNonces() {
+	testCases := []struct {
+		name         string
+		accountNonce uint64
+		nonces       []uint64
+		expPending   []uint64
+		expQueued    []uint64
+	}{
+		{
+			"no txs",
+			0,
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"consecutive nonces from the account nonce are pending",
+			3,
+			[]uint64{4, 3, 5},
+			[]uint64{3, 4, 5},
+			nil,
+		},
+		{
+			"nonces after a gap are queued",
+			3,
+			[]uint64{3, 6, 4, 7},
+			[]uint64{3, 4},
+			[]uint64{6, 7},
+		},
+		{
+			"all nonces are queued when the account nonce is missing",
+			3,
+			[]uint64{5, 4},
+			nil,
+			[]uint64{4, 5},
+		},
+		{
+			"nonces lower than the account nonce are skipped",
+			3,
+			[]uint64{1, 2, 3, 5},
+			[]uint64{3},
+			[]uint64{5},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			pending, queued := classifyTxPoolNonces(tc.accountNonce, tc.nonces)
+			suite.Require().Equal(tc.expPending, pending)
+			suite.Require().Equal(tc.expQueued, queued)
+		})
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"math/big"
 	"sort"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -19,7 +21,9 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/misc"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
@@ -147,15 +151,8 @@ func (b *Backend) processBlock(
 	}
 
 	gasUsedRatio := gasusedfloat / float64(gasLimitUint64)
-	blockGasUsed := gasusedfloat
 	targetOneFeeHistory.GasUsedRatio = gasUsedRatio
 
-	rewardCount := len(rewardPercentiles)
-	targetOneFeeHistory.Reward = make([]*big.Int, rewardCount)
-	for i := 0; i < rewardCount; i++ {
-		targetOneFeeHistory.Reward[i] = big.NewInt(0)
-	}
-
 	// check tendermintTxs
 	tendermintTxs := tendermintBlock.Block.Txs
 	tendermintTxResults := tendermintBlockResult.TxsResults
@@ -172,42 +169,84 @@ func (b *Backend) processBlock(
 			b.logger.Debug("failed to decode transaction in block", "height", blockHeight, "error", err.Error())
 			continue
 		}
-		txGasUsed := uint64(eachTendermintTxResult.GasUsed) //nolint:gosec // G115
-		for _, msg := range tx.GetMsgs() {
+
+		// use the gas used by each ethereum tx instead of the gas used by the
+		// whole cosmos tx, which can batch several ethereum txs
+		parsedTxs, err := types.ParseTxResult(eachTendermintTxResult, tx)
+		if err != nil {
+			b.logger.Debug("failed to parse transaction result in block", "height", blockHeight, "error", err.Error())
+			continue
+		}
+
+		for msgIndex, msg := range tx.GetMsgs() {
 			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
 			if !ok {
 				continue
 			}
-			tx := ethMsg.AsTransaction()
-			reward := tx.EffectiveGasTipValue(blockBaseFee)
-			if reward == nil {
-				reward = big.NewInt(0)
+
+			// skip the txs that were not executed (e.g. failed the ante handler)
+			parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+			if parsedTx == nil {
+				continue
 			}
-			sorter = append(sorter, txGasAndReward{gasUsed: txGasUsed, reward: reward})
+
+			sorter = append(sorter, txGasAndReward{
+				gasUsed: parsedTx.GasUsed,
+				reward:  effectiveGasTip(ethMsg.AsTransaction(), blockBaseFee),
+			})
 		}
 	}
 
-	// return an all zero row if there are no transactions to gather data from
-	ethTxCount := len(sorter)
-	if ethTxCount == 0 {
-		return nil
+	targetOneFeeHistory.Reward = rewardPercentilesFromTxs(sorter, rewardPercentiles)
+	return nil
+}
+
+// effectiveGasTip returns the tip paid to the block proposer by the tx, using
+// the base fee of the feemarket module at the height the tx was included.
+// Negative tips, which can only happen if the tx fee cap is lower than the
+// base fee, are returned as zero.
+func effectiveGasTip(tx *ethtypes.Transaction, baseFee *big.Int) *big.Int {
+	reward := tx.EffectiveGasTipValue(baseFee)
+	if reward == nil || reward.Sign() < 0 {
+		return big.NewInt(0)
+	}
+	return reward
+}
+
+// rewardPercentilesFromTxs returns the tips at the given percentiles of the
+// gas used by the ethereum txs of the block, following the go-ethereum
+// algorithm: the txs are sorted by ascending tip and the reward of each
+// percentile is the tip of the tx that makes the cumulative gas used reach
+// the percentile. It returns an all zero row if there are no txs.
+func rewardPercentilesFromTxs(txs sortGasAndReward, percentiles []float64) []*big.Int {
+	rewards := make([]*big.Int, len(percentiles))
+	if len(txs) == 0 {
+		for i := range rewards {
+			rewards[i] = big.NewInt(0)
+		}
+		return rewards
 	}
 
-	sort.Sort(sorter)
+	sort.Stable(txs)
+
+	var totalGasUsed uint64
+	for _, tx := range txs {
+		totalGasUsed += tx.gasUsed
+	}
 
 	var txIndex int
-	sumGasUsed := sorter[0].gasUsed
+	sumGasUsed := txs[0].gasUsed
 
-	for i, p := range rewardPercentiles {
-		thresholdGasUsed := uint64(blockGasUsed * p / 100) // #nosec G701
-		for sumGasUsed < thresholdGasUsed && txIndex < ethTxCount-1 {
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(totalGasUsed) * p / 100) // #nosec G701 G115
+		for sumGasUsed < thresholdGasUsed && txIndex < len(txs)-1 {
 			txIndex++
-			sumGasUsed += sorter[txIndex].gasUsed
+			sumGasUsed += txs[txIndex].gasUsed
 		}
-		targetOneFeeHistory.Reward[i] = sorter[txIndex].reward
+		rewards[i] = txs[txIndex].reward
 	}
 
-	return nil
+	return rewards
 }
 
 // AllTxLogsFromEvents parses all ethereum logs from cosmos events
@@ -264,15 +303,54 @@ func ParseTxLogsFromEvent(event abci.Event) ([]*ethtypes.Log, error) {
 	return evmtypes.LogsToEthereum(logs), nil
 }
 
+// GasReportFromEvents parses the gas report of the ethereum tx with the given
+// hash from cosmos events. It returns nil if the node didn't report the gas.
+func GasReportFromEvents(events []abci.Event, txHash common.Hash) (*types.GasReport, error) {
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeGasReport {
+			continue
+		}
+
+		values := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			values[attr.Key] = attr.Value
+		}
+		if common.HexToHash(values[evmtypes.AttributeKeyEthereumTxHash]) != txHash {
+			continue
+		}
+
+		var report types.GasReport
+		for key, field := range map[string]*hexutil.Uint64{
+			evmtypes.AttributeKeyIntrinsicGas:  &report.IntrinsicGas,
+			evmtypes.AttributeKeyExecutionGas:  &report.ExecutionGas,
+			evmtypes.AttributeKeyPrecompileGas: &report.PrecompileGas,
+			evmtypes.AttributeKeyRefundedGas:   &report.RefundedGas,
+		} {
+			value, err := strconv.ParseUint(values[key], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid gas report %s attribute: %w", key, err)
+			}
+			*field = hexutil.Uint64(value)
+		}
+		return &report, nil
+	}
+	return nil, nil
+}
+
 // ShouldIgnoreGasUsed returns true if the gasUsed in result should be ignored
 // workaround for issue: https://github.com/cosmos/cosmos-sdk/issues/10832
 func ShouldIgnoreGasUsed(res *abci.ExecTxResult) bool {
 	return res.GetCode() == 11 && strings.Contains(res.GetLog(), "no block gas left to run tx: out of gas")
 }
 
-// GetLogsFromBlockResults returns the list of event logs from the tendermint block result response
+// GetLogsFromBlockResults returns the list of event logs from the tendermint block result response.
+// The logs emitted outside of the txs, e.g. by the upgrade migrations, are returned first.
 func GetLogsFromBlockResults(blockRes *tmrpctypes.ResultBlockResults) ([][]*ethtypes.Log, error) {
-	blockLogs := [][]*ethtypes.Log{}
+	blockLogs, err := AllTxLogsFromEvents(blockRes.FinalizeBlockEvents)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, txResult := range blockRes.TxsResults {
 		logs, err := AllTxLogsFromEvents(txResult.Events)
 		if err != nil {
@@ -300,3 +378,46 @@ func GetHexProofs(proof *crypto.ProofOps) []string {
 	}
 	return proofs
 }
+
+// GetProofRoot returns the root computed by running the chain of commitment
+// proofs of a store query, which is the app hash of the queried height. The
+// value is the proven value of the key, or empty for absence proofs. It returns
+// an empty root if there is no proof.
+func GetProofRoot(proof *crypto.ProofOps, value []byte) ([]byte, error) {
+	if proof == nil || len(proof.Ops) == 0 {
+		return nil, nil
+	}
+
+	var args [][]byte
+	if len(value) > 0 {
+		args = [][]byte{value}
+	}
+
+	for _, op := range proof.Ops {
+		operator, err := storetypes.CommitmentOpDecoder(op)
+		if err != nil {
+			return nil, err
+		}
+
+		args, err = operator.Run(args)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid proof root, expected 1 value, got %d", len(args))
+	}
+
+	return args[0], nil
+}
+
+// BalanceStoreKey returns the key of the balance of the given denomination of
+// an address in the bank store.
+func BalanceStoreKey(address common.Address, denom string) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(
+		banktypes.BalancesPrefix,
+		collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
+		collections.Join(sdk.AccAddress(address.Bytes()), denom),
+	)
+}
@@ -2,8 +2,19 @@ package backend
 
 import (
 	"fmt"
+	"math/big"
 
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/ethereum/go-ethereum/common"
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 func mookProofs(num int, withData bool) *crypto.ProofOps {
@@ -50,3 +61,128 @@ func (suite *BackendTestSuite) TestGetHexProofs() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestRewardPercentilesFromTxs() {
+	percentiles := []float64{0, 25, 50, 75, 100}
+
+	testCases := []struct {
+		name     string
+		txs      sortGasAndReward
+		expected []int64
+	}{
+		{
+			"no txs - all zero row",
+			nil,
+			[]int64{0, 0, 0, 0, 0},
+		},
+		{
+			"single tx",
+			sortGasAndReward{{gasUsed: 21000, reward: big.NewInt(7)}},
+			[]int64{7, 7, 7, 7, 7},
+		},
+		{
+			"txs weighted by gas used",
+			sortGasAndReward{
+				{gasUsed: 10, reward: big.NewInt(30)},
+				{gasUsed: 70, reward: big.NewInt(10)},
+				{gasUsed: 20, reward: big.NewInt(20)},
+			},
+			// sorted by tip: 10 (70 gas), 20 (20 gas), 30 (10 gas)
+			[]int64{10, 10, 10, 20, 30},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			rewards := rewardPercentilesFromTxs(tc.txs, percentiles)
+			suite.Require().Len(rewards, len(percentiles))
+			for i, reward := range rewards {
+				suite.Require().Equal(tc.expected[i], reward.Int64(), "percentile %v", percentiles[i])
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGasReportFromEvents() {
+	txHash := common.HexToHash("0x01")
+	report := &evmtypes.GasReport{IntrinsicGas: 21000, ExecutionGas: 3000, PrecompileGas: 2000, RefundedGas: 500}
+	event := evmtypes.NewGasReportEvent(txHash.Hex(), report)
+	abciEvent := abci.Event{Type: event.Type, Attributes: event.Attributes}
+
+	testCases := []struct {
+		name     string
+		events   []abci.Event
+		txHash   common.Hash
+		expected *rpctypes.GasReport
+	}{
+		{
+			"no gas report",
+			[]abci.Event{{Type: evmtypes.EventTypeEthereumTx}},
+			txHash,
+			nil,
+		},
+		{
+			"gas report of other tx",
+			[]abci.Event{abciEvent},
+			common.HexToHash("0x02"),
+			nil,
+		},
+		{
+			"gas report",
+			[]abci.Event{{Type: evmtypes.EventTypeEthereumTx}, abciEvent},
+			txHash,
+			&rpctypes.GasReport{IntrinsicGas: 21000, ExecutionGas: 3000, PrecompileGas: 2000, RefundedGas: 500},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			gasReport, err := GasReportFromEvents(tc.events, tc.txHash)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expected, gasReport)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetProofRoot() {
+	root, err := GetProofRoot(nil, []byte("value"))
+	suite.Require().NoError(err)
+	suite.Require().Empty(root)
+
+	_, err = GetProofRoot(mookProofs(1, true), []byte("value"))
+	suite.Require().Error(err)
+
+	storeKey := storetypes.NewKVStoreKey(evmtypes.StoreKey)
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	suite.Require().NoError(ms.LoadLatestVersion())
+
+	ms.GetCommitKVStore(storeKey).Set([]byte("key"), []byte("value"))
+	commitID := ms.Commit()
+
+	testCases := []struct {
+		name  string
+		key   []byte
+		value []byte
+	}{
+		{"existence proof", []byte("key"), []byte("value")},
+		{"absence proof", []byte("other"), nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := ms.Query(&storetypes.RequestQuery{
+				Path:   fmt.Sprintf("/%s/key", evmtypes.StoreKey),
+				Data:   tc.key,
+				Height: commitID.Version,
+				Prove:  true,
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.value, res.Value)
+
+			root, err := GetProofRoot(res.ProofOps, res.Value)
+			suite.Require().NoError(err)
+			suite.Require().Equal(commitID.Hash, root)
+		})
+	}
+}
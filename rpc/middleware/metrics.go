@@ -0,0 +1,47 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package middleware
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+const (
+	// methodMetricsPrefix is the prefix of the per-method metrics.
+	methodMetricsPrefix = "rpc/methods"
+	// unknownMethod is the method label of the requests to unknown methods, so
+	// that clients cannot register arbitrary metrics.
+	unknownMethod = "unknown"
+)
+
+// rateLimitedMeter counts the requests rejected by the rate limiter.
+var rateLimitedMeter = metrics.NewRegisteredMeter("rpc/ratelimit/rejected", nil)
+
+// deniedMeter counts the requests rejected by the method permissions.
+var deniedMeter = metrics.NewRegisteredMeter("rpc/permissions/denied", nil)
+
+// recordMetrics records the request count, error count and latency of each
+// method of the served JSON-RPC message. The requests of a batch are recorded
+// with the latency of the whole batch.
+func recordMetrics(msgs []*jsonrpcMessage, errs map[string]*jsonrpcError, elapsed time.Duration) {
+	for _, msg := range msgs {
+		if msg == nil || msg.Method == "" {
+			continue
+		}
+
+		method := msg.Method
+		rpcErr, failed := errs[string(msg.ID)]
+		if failed && rpcErr.Code == errCodeMethodNotFound {
+			method = unknownMethod
+		}
+
+		metrics.GetOrRegisterCounter(fmt.Sprintf("%s/%s/requests", methodMetricsPrefix, method), nil).Inc(1)
+		if failed {
+			metrics.GetOrRegisterCounter(fmt.Sprintf("%s/%s/errors", methodMetricsPrefix, method), nil).Inc(1)
+		}
+		metrics.GetOrRegisterTimer(fmt.Sprintf("%s/%s/latency", methodMetricsPrefix, method), nil).Update(elapsed)
+	}
+}
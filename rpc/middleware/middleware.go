@@ -0,0 +1,271 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package middleware
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+const (
+	// errCodeMethodNotFound is the JSON-RPC error code of an unknown method.
+	errCodeMethodNotFound = -32601
+	// errCodeLimitExceeded is the EIP-1474 JSON-RPC error code of a request
+	// exceeding a limit.
+	errCodeLimitExceeded = -32005
+
+	// MaxRequestBodySize is the max size in bytes of a JSON-RPC request body,
+	// which matches the body limit of the go-ethereum HTTP server.
+	MaxRequestBodySize = 5 * 1024 * 1024
+
+	// forwardedHeader is the header of the requests forwarded by the WebSocket
+	// server to the HTTP server. Their rate limits are enforced by the WebSocket
+	// server with the IP of the WebSocket client.
+	forwardedHeader = "X-Evmos-Ws-Forwarded"
+)
+
+// jsonrpcMessage is the subset of a JSON-RPC request or response used by the
+// middleware.
+type jsonrpcMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Error  *jsonrpcError   `json:"error,omitempty"`
+}
+
+// jsonrpcError is a JSON-RPC error object.
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Middleware wraps the JSON-RPC HTTP handler to record per-method metrics, to
+// enforce the method permissions and the per-IP request and gas rate limits.
+type Middleware struct {
+	logger      log.Logger
+	limiter     *RateLimiter
+	permissions *Permissions
+	ipHeader    string
+	// forwardSecret is the random value of the forwarded header, so that the
+	// clients can't bypass the rate limits by setting it
+	forwardSecret string
+}
+
+// New creates a new JSON-RPC middleware. A nil limiter disables the rate
+// limits, and nil permissions permit all the methods. The ipHeader is the
+// header set by a trusted reverse proxy with the client IP; when empty, the
+// remote address of the connection is used.
+func New(logger log.Logger, limiter *RateLimiter, permissions *Permissions, ipHeader string) *Middleware {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		// NOTE: shouldn't occur as the system random generator doesn't fail
+		panic(err)
+	}
+
+	return &Middleware{
+		logger:        logger.With("module", "jsonrpc-middleware"),
+		limiter:       limiter,
+		permissions:   permissions,
+		ipHeader:      ipHeader,
+		forwardSecret: hex.EncodeToString(secret),
+	}
+}
+
+// Handler returns the http.Handler that applies the middleware to next.
+func (m *Middleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxRequestBodySize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		msgs, batch, ok := parseMessages(body)
+		if !ok {
+			// let the server reply with the parse error
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// the forwarded requests were checked by the WebSocket server
+		if m.permissions != nil && !m.isForwarded(r) {
+			if err := m.permissions.Check(ListenerHTTP, m.permissions.Trusted(r), msgs); err != nil {
+				m.logger.Debug("rejected request to a denied method", "ip", m.ClientIP(r), "error", err.Error())
+				deniedMeter.Mark(int64(len(msgs)))
+				writeErrors(w, msgs, batch, http.StatusOK, errCodeMethodNotFound, err)
+				return
+			}
+		}
+
+		if m.limiter != nil && !m.isForwarded(r) {
+			if err := m.limiter.Allow(m.ClientIP(r), msgs); err != nil {
+				m.logger.Debug("rejected rate limited request", "ip", m.ClientIP(r), "error", err.Error())
+				rateLimitedMeter.Mark(int64(len(msgs)))
+				writeErrors(w, msgs, batch, http.StatusTooManyRequests, errCodeLimitExceeded, err)
+				return
+			}
+		}
+
+		if !metrics.Enabled {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := &responseRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+		recordMetrics(msgs, parseErrors(rec.body.Bytes()), time.Since(start))
+	})
+}
+
+// ClientIP returns the IP of the client that sent the request.
+func (m *Middleware) ClientIP(r *http.Request) string {
+	return ClientIP(r, m.ipHeader)
+}
+
+// AllowRequest enforces the rate limits of the client IP for the raw JSON-RPC
+// request received by the WebSocket server. The requests that are then
+// forwarded to the HTTP server must be marked with SetForwarded, so that their
+// rate limits aren't enforced again under the local address.
+func (m *Middleware) AllowRequest(ip string, body []byte) error {
+	if m.limiter == nil {
+		return nil
+	}
+
+	msgs, _, ok := parseMessages(body)
+	if !ok {
+		return nil
+	}
+
+	if err := m.limiter.Allow(ip, msgs); err != nil {
+		m.logger.Debug("rejected rate limited websocket request", "ip", ip, "error", err.Error())
+		rateLimitedMeter.Mark(int64(len(msgs)))
+		return err
+	}
+	return nil
+}
+
+// SetForwarded marks the request as forwarded by the WebSocket server, whose
+// rate limits have already been enforced.
+func (m *Middleware) SetForwarded(r *http.Request) {
+	r.Header.Set(forwardedHeader, m.forwardSecret)
+}
+
+// isForwarded returns true if the request was forwarded by the WebSocket
+// server.
+func (m *Middleware) isForwarded(r *http.Request) bool {
+	value := r.Header.Get(forwardedHeader)
+	return value != "" && subtle.ConstantTimeCompare([]byte(value), []byte(m.forwardSecret)) == 1
+}
+
+// ClientIP returns the IP of the client that sent the request. The ipHeader is
+// the header set by a trusted reverse proxy with the client IP; when empty or
+// missing, the remote address of the connection is used.
+func ClientIP(r *http.Request, ipHeader string) string {
+	if ipHeader != "" {
+		if value := r.Header.Get(ipHeader); value != "" {
+			// proxies append the address of the peer they received the request
+			// from, so the last entry is the one set by the trusted proxy
+			entries := strings.Split(value, ",")
+			return strings.TrimSpace(entries[len(entries)-1])
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// parseMessages decodes a single or batch JSON-RPC request. It returns false
+// when the body is not a valid request.
+func parseMessages(body []byte) (msgs []*jsonrpcMessage, batch bool, ok bool) {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if len(body) > 0 && body[0] == '[' {
+		if err := json.Unmarshal(body, &msgs); err != nil {
+			return nil, true, false
+		}
+		return msgs, true, true
+	}
+
+	msg := new(jsonrpcMessage)
+	if err := json.Unmarshal(body, msg); err != nil {
+		return nil, false, false
+	}
+	return []*jsonrpcMessage{msg}, false, true
+}
+
+// parseErrors returns the errors of a single or batch JSON-RPC response keyed
+// by request id.
+func parseErrors(body []byte) map[string]*jsonrpcError {
+	msgs, _, ok := parseMessages(body)
+	if !ok {
+		return nil
+	}
+
+	errs := make(map[string]*jsonrpcError)
+	for _, msg := range msgs {
+		if msg != nil && msg.Error != nil {
+			errs[string(msg.ID)] = msg.Error
+		}
+	}
+	return errs
+}
+
+// writeErrors replies to every request of the message with the given error
+// code and HTTP status.
+func writeErrors(w http.ResponseWriter, msgs []*jsonrpcMessage, batch bool, status, code int, err error) {
+	responses := make([]map[string]interface{}, 0, len(msgs))
+	for _, msg := range msgs {
+		id := json.RawMessage("null")
+		if msg != nil && len(msg.ID) > 0 {
+			id = msg.ID
+		}
+		responses = append(responses, map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      id,
+			"error":   jsonrpcError{Code: code, Message: err.Error()},
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	var res interface{} = responses
+	if !batch && len(responses) == 1 {
+		res = responses[0]
+	}
+	_ = json.NewEncoder(w).Encode(res) // #nosec G703
+}
+
+// responseRecorder copies the response body written to the client.
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write writes the data to the client and records it.
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
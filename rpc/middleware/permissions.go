@@ -0,0 +1,138 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package middleware
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Listener is a JSON-RPC server listener.
+type Listener string
+
+// JSON-RPC server listeners
+const (
+	ListenerHTTP Listener = "http"
+	ListenerWS   Listener = "ws"
+)
+
+// AdminNamespace is the namespace whose methods are only permitted to the
+// trusted clients, regardless of the method lists.
+const AdminNamespace = "admin"
+
+// MethodPolicy defines the JSON-RPC methods permitted on a listener. A
+// 'namespace_*' entry matches all the methods of the namespace.
+type MethodPolicy struct {
+	// Allowed are the only permitted methods. An empty list permits all of them.
+	Allowed []string
+	// Denied are the rejected methods, which have precedence over the allowed ones.
+	Denied []string
+}
+
+// Permits returns true if the method is allowed and not denied.
+func (p MethodPolicy) Permits(method string) bool {
+	if len(p.Allowed) > 0 && !matchMethod(p.Allowed, method) {
+		return false
+	}
+	return !matchMethod(p.Denied, method)
+}
+
+// PermissionConfig defines the JSON-RPC methods permitted to each client.
+type PermissionConfig struct {
+	// HTTP is the policy of the remote clients of the HTTP listener.
+	HTTP MethodPolicy
+	// WS is the policy of the remote clients of the WebSocket listener.
+	WS MethodPolicy
+	// Local is the policy of the trusted clients of both listeners, which are
+	// the authenticated clients and, if enabled, the loopback ones.
+	Local MethodPolicy
+	// AuthToken authenticates the clients sending it as an 'Authorization:
+	// Bearer' header. An empty token disables the authentication.
+	AuthToken string
+	// TrustLoopback trusts the clients connected from a loopback address. It
+	// must stay disabled when a reverse proxy on the same host forwards the
+	// remote clients, which would all connect from a loopback address.
+	TrustLoopback bool
+}
+
+// Permissions enforces the JSON-RPC method policies of the clients.
+type Permissions struct {
+	cfg PermissionConfig
+}
+
+// NewPermissions creates a new permission checker with the given policies.
+func NewPermissions(cfg PermissionConfig) *Permissions {
+	return &Permissions{cfg: cfg}
+}
+
+// Trusted returns true if the client of the request is authenticated, or is
+// connected from a loopback address when the loopback clients are trusted. The
+// client IP headers are ignored, as they can be set by any client.
+func (p *Permissions) Trusted(r *http.Request) bool {
+	if p.cfg.AuthToken != "" {
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if found && subtle.ConstantTimeCompare([]byte(token), []byte(p.cfg.AuthToken)) == 1 {
+			return true
+		}
+	}
+
+	if !p.cfg.TrustLoopback {
+		return false
+	}
+
+	ip := net.ParseIP(ClientIP(r, ""))
+	return ip != nil && ip.IsLoopback()
+}
+
+// Check returns an error for the first request that isn't permitted to the
+// client on the given listener.
+func (p *Permissions) Check(listener Listener, trusted bool, msgs []*jsonrpcMessage) error {
+	policy := p.policy(listener, trusted)
+	for _, msg := range msgs {
+		if msg == nil || msg.Method == "" {
+			continue
+		}
+
+		namespace, _, _ := strings.Cut(msg.Method, "_")
+		if (namespace == AdminNamespace && !trusted) || !policy.Permits(msg.Method) {
+			return fmt.Errorf("the method %s does not exist/is not available", msg.Method)
+		}
+	}
+	return nil
+}
+
+// CheckRequest decodes the single or batch JSON-RPC request and checks its
+// methods. The invalid requests are let through for the server to reply with
+// the parse error.
+func (p *Permissions) CheckRequest(listener Listener, trusted bool, body []byte) error {
+	msgs, _, ok := parseMessages(body)
+	if !ok {
+		return nil
+	}
+	return p.Check(listener, trusted, msgs)
+}
+
+// policy returns the method policy of the clients of the listener.
+func (p *Permissions) policy(listener Listener, trusted bool) MethodPolicy {
+	switch {
+	case trusted:
+		return p.cfg.Local
+	case listener == ListenerWS:
+		return p.cfg.WS
+	default:
+		return p.cfg.HTTP
+	}
+}
+
+// matchMethod returns true if the method or its namespace is in the entries.
+func matchMethod(entries []string, method string) bool {
+	if slices.Contains(entries, method) {
+		return true
+	}
+	namespace, _, found := strings.Cut(method, "_")
+	return found && slices.Contains(entries, namespace+"_*")
+}
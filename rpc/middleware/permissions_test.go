@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMethodPolicyPermits(t *testing.T) {
+	testCases := []struct {
+		name    string
+		policy  MethodPolicy
+		method  string
+		permits bool
+	}{
+		{"empty policy permits all the methods", MethodPolicy{}, "debug_traceTransaction", true},
+		{"allowed method", MethodPolicy{Allowed: []string{"eth_call"}}, "eth_call", true},
+		{"method not allowed", MethodPolicy{Allowed: []string{"eth_call"}}, "eth_getLogs", false},
+		{"allowed namespace", MethodPolicy{Allowed: []string{"eth_*"}}, "eth_getLogs", true},
+		{"denied namespace", MethodPolicy{Denied: []string{"debug_*"}}, "debug_traceTransaction", false},
+		{"denied has precedence", MethodPolicy{Allowed: []string{"eth_*"}, Denied: []string{"eth_sendTransaction"}}, "eth_sendTransaction", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.permits, tc.policy.Permits(tc.method))
+		})
+	}
+}
+
+func TestPermissionsCheck(t *testing.T) {
+	p := NewPermissions(PermissionConfig{
+		HTTP:  MethodPolicy{Denied: []string{"debug_*"}},
+		WS:    MethodPolicy{Allowed: []string{"eth_*"}},
+		Local: MethodPolicy{Denied: []string{"personal_*"}},
+	})
+
+	testCases := []struct {
+		name     string
+		listener Listener
+		trusted  bool
+		body     string
+		expErr   bool
+	}{
+		{"http - permitted method", ListenerHTTP, false, `{"id":1,"method":"eth_blockNumber"}`, false},
+		{"http - denied method in a batch", ListenerHTTP, false, `[{"id":1,"method":"eth_blockNumber"},{"id":2,"method":"debug_traceTransaction"}]`, true},
+		{"http - admin is only permitted to trusted clients", ListenerHTTP, false, `{"id":1,"method":"admin_peers"}`, true},
+		{"ws - method not allowed", ListenerWS, false, `{"id":1,"method":"net_version"}`, true},
+		{"ws - allowed method", ListenerWS, false, `{"id":1,"method":"eth_subscribe"}`, false},
+		{"local - uses the local policy on both listeners", ListenerWS, true, `{"id":1,"method":"debug_traceTransaction"}`, false},
+		{"local - denied method", ListenerHTTP, true, `{"id":1,"method":"personal_sign"}`, true},
+		{"local - admin", ListenerHTTP, true, `{"id":1,"method":"admin_peers"}`, false},
+		{"invalid request is let through", ListenerWS, false, `{"id":1,`, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := p.CheckRequest(tc.listener, tc.trusted, []byte(tc.body))
+			if tc.expErr {
+				require.ErrorContains(t, err, "is not available")
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPermissionsTrusted(t *testing.T) {
+	p := NewPermissions(PermissionConfig{AuthToken: "secret"})
+
+	newRequest := func(remoteAddr string, headers map[string]string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/", nil)
+		r.RemoteAddr = remoteAddr
+		for key, value := range headers {
+			r.Header.Set(key, value)
+		}
+		return r
+	}
+
+	// the loopback clients are not trusted by default
+	require.False(t, p.Trusted(newRequest("127.0.0.1:1234", nil)))
+	require.False(t, p.Trusted(newRequest("10.0.0.1:1234", nil)))
+	require.True(t, p.Trusted(newRequest("10.0.0.1:1234", map[string]string{"Authorization": "Bearer secret"})))
+	require.False(t, p.Trusted(newRequest("10.0.0.1:1234", map[string]string{"Authorization": "Bearer wrong"})))
+
+	p = NewPermissions(PermissionConfig{TrustLoopback: true})
+	require.True(t, p.Trusted(newRequest("127.0.0.1:1234", nil)))
+	require.True(t, p.Trusted(newRequest("[::1]:1234", nil)))
+	require.False(t, p.Trusted(newRequest("10.0.0.1:1234", nil)))
+	// the client IP headers can't be used to claim a loopback address
+	require.False(t, p.Trusted(newRequest("10.0.0.1:1234", map[string]string{"X-Forwarded-For": "127.0.0.1"})))
+}
+
+func TestMiddlewarePermissions(t *testing.T) {
+	p := NewPermissions(PermissionConfig{HTTP: MethodPolicy{Denied: []string{"debug_*"}}})
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	})
+	m := New(log.NewNopLogger(), nil, p, "")
+	handler := m.Handler(next)
+
+	serve := func(body string, forwarded bool) string {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.RemoteAddr = "10.0.0.1:1234"
+		if forwarded {
+			m.SetForwarded(r)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		require.Equal(t, http.StatusOK, w.Code)
+		return w.Body.String()
+	}
+
+	require.Contains(t, serve(`{"id":1,"method":"eth_blockNumber"}`, false), `"result":"0x1"`)
+	require.Contains(t, serve(`{"id":1,"method":"debug_traceTransaction"}`, false), `"code":-32601`)
+	require.Contains(t, serve(`{"id":1,"method":"admin_peers"}`, false), `"code":-32601`)
+
+	// the requests forwarded by the websocket server were checked with the
+	// policy of its client
+	require.Contains(t, serve(`{"id":1,"method":"debug_traceTransaction"}`, true), `"result":"0x1"`)
+}
@@ -0,0 +1,204 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"golang.org/x/time/rate"
+)
+
+// pruneInterval is the min interval between two prunings of the idle limiters.
+const pruneInterval = time.Minute
+
+// RateLimitConfig defines the limits enforced for each client IP.
+type RateLimitConfig struct {
+	// PerIP is the number of requests per second. A value of 0 disables the limit.
+	PerIP float64
+	// Burst is the max number of requests at once. A value of 0 uses PerIP rounded up.
+	Burst int
+	// Methods is the number of requests per second for specific methods. A
+	// 'namespace_*' key matches all the methods of the namespace.
+	Methods map[string]float64
+	// GasPerIP is the gas per second that can be requested through eth_call and
+	// eth_estimateGas. A value of 0 disables the limit.
+	GasPerIP uint64
+	// GasCap is the gas counted for the calls without a gas limit, and the max
+	// gas counted for a single call. A value of 0 means no cap.
+	GasCap uint64
+}
+
+// Enabled returns true if any limit is set.
+func (c RateLimitConfig) Enabled() bool {
+	return c.PerIP > 0 || len(c.Methods) > 0 || c.GasPerIP > 0
+}
+
+// RateLimiter enforces the request and gas rate limits of each client IP
+// using token buckets.
+type RateLimiter struct {
+	cfg RateLimitConfig
+	now func() time.Time
+
+	mu        sync.Mutex
+	limiters  map[string]*rate.Limiter
+	lastPrune time.Time
+}
+
+// NewRateLimiter creates a new rate limiter with the given limits.
+func NewRateLimiter(cfg RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		cfg:      cfg,
+		now:      time.Now,
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// Allow consumes the tokens of the client IP for the given requests. It
+// returns an error without consuming any token if a limit is exceeded.
+func (rl *RateLimiter) Allow(ip string, msgs []*jsonrpcMessage) error {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := rl.now()
+	rl.prune(now)
+
+	var reservations []*rate.Reservation
+	reserve := func(key string, limit float64, burst, n int) bool {
+		if n == 0 {
+			return true
+		}
+		r := rl.limiter(key, limit, burst).ReserveN(now, n)
+		if !r.OK() || r.DelayFrom(now) > 0 {
+			r.CancelAt(now)
+			return false
+		}
+		reservations = append(reservations, r)
+		return true
+	}
+	cancel := func() {
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+	}
+
+	if rl.cfg.PerIP > 0 && !reserve(ip, rl.cfg.PerIP, rl.burst(), len(msgs)) {
+		return fmt.Errorf("request rate limit of %v per second exceeded", rl.cfg.PerIP)
+	}
+
+	methodCounts := make(map[string]int)
+	var gas uint64
+	for _, msg := range msgs {
+		if msg == nil {
+			continue
+		}
+		if key, ok := rl.methodKey(msg.Method); ok {
+			methodCounts[key]++
+		}
+		if rl.cfg.GasPerIP > 0 {
+			gas += rl.requestedGas(msg)
+		}
+	}
+
+	for key, count := range methodCounts {
+		limit := rl.cfg.Methods[key]
+		if !reserve(ip+"|"+key, limit, int(math.Ceil(limit)), count) {
+			cancel()
+			return fmt.Errorf("rate limit of %v per second exceeded for method %s", limit, key)
+		}
+	}
+
+	if gas > 0 && !reserve(ip+"|gas", float64(rl.cfg.GasPerIP), rl.gasBurst(), int(min(gas, math.MaxInt32))) {
+		cancel()
+		return fmt.Errorf("gas rate limit of %d per second exceeded", rl.cfg.GasPerIP)
+	}
+
+	return nil
+}
+
+// limiter returns the token bucket of the given key, creating it if needed.
+func (rl *RateLimiter) limiter(key string, limit float64, burst int) *rate.Limiter {
+	l, ok := rl.limiters[key]
+	if !ok {
+		l = rate.NewLimiter(rate.Limit(limit), burst)
+		rl.limiters[key] = l
+	}
+	return l
+}
+
+// prune deletes the limiters with a full bucket, which behave as new ones.
+func (rl *RateLimiter) prune(now time.Time) {
+	if now.Sub(rl.lastPrune) < pruneInterval {
+		return
+	}
+	rl.lastPrune = now
+
+	for key, l := range rl.limiters {
+		if l.TokensAt(now) >= float64(l.Burst()) {
+			delete(rl.limiters, key)
+		}
+	}
+}
+
+// burst returns the per-IP burst of requests.
+func (rl *RateLimiter) burst() int {
+	if rl.cfg.Burst > 0 {
+		return rl.cfg.Burst
+	}
+	return int(math.Ceil(rl.cfg.PerIP))
+}
+
+// gasBurst returns the per-IP burst of gas, which allows at least one call
+// with the gas cap.
+func (rl *RateLimiter) gasBurst() int {
+	return int(min(max(rl.cfg.GasPerIP, rl.cfg.GasCap), math.MaxInt32))
+}
+
+// methodKey returns the method rate limit matching the method, if any. An
+// exact match has precedence over a namespace one.
+func (rl *RateLimiter) methodKey(method string) (string, bool) {
+	if _, ok := rl.cfg.Methods[method]; ok {
+		return method, true
+	}
+	if namespace, _, found := strings.Cut(method, "_"); found {
+		key := namespace + "_*"
+		if _, ok := rl.cfg.Methods[key]; ok {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// requestedGas returns the gas counted for the request, which is the gas limit
+// of the eth_call and eth_estimateGas calls, bounded by the gas cap.
+func (rl *RateLimiter) requestedGas(msg *jsonrpcMessage) uint64 {
+	if msg.Method != "eth_call" && msg.Method != "eth_estimateGas" {
+		return 0
+	}
+
+	gas := rl.cfg.GasCap
+	// the call args are the first param, the block and overrides are ignored
+	var params []json.RawMessage
+	var args struct {
+		Gas *hexutil.Uint64 `json:"gas"`
+	}
+	if err := json.Unmarshal(msg.Params, &params); err == nil && len(params) > 0 {
+		if err := json.Unmarshal(params[0], &args); err == nil && args.Gas != nil {
+			gas = uint64(*args.Gas)
+		}
+	}
+
+	if rl.cfg.GasCap > 0 && (gas == 0 || gas > rl.cfg.GasCap) {
+		gas = rl.cfg.GasCap
+	}
+	if gas == 0 {
+		// without a gas limit nor a gas cap, the call takes the whole bucket
+		gas = rl.cfg.GasPerIP
+	}
+	return gas
+}
@@ -0,0 +1,188 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+)
+
+func newMsgs(t *testing.T, body string) []*jsonrpcMessage {
+	msgs, _, ok := parseMessages([]byte(body))
+	require.True(t, ok)
+	return msgs
+}
+
+func TestRateLimiterAllow(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cfg    RateLimitConfig
+		bodies []string
+		expErr []string
+	}{
+		{
+			"pass - no limits",
+			RateLimitConfig{},
+			[]string{
+				`{"id":1,"method":"eth_blockNumber"}`,
+				`{"id":2,"method":"eth_blockNumber"}`,
+			},
+			[]string{"", ""},
+		},
+		{
+			"fail - per IP limit counts every request of a batch",
+			RateLimitConfig{PerIP: 2},
+			[]string{
+				`[{"id":1,"method":"eth_blockNumber"},{"id":2,"method":"eth_chainId"}]`,
+				`{"id":3,"method":"eth_blockNumber"}`,
+			},
+			[]string{"", "request rate limit"},
+		},
+		{
+			"fail - namespace limit",
+			RateLimitConfig{Methods: map[string]float64{"debug_*": 1}},
+			[]string{
+				`{"id":1,"method":"debug_traceTransaction"}`,
+				`{"id":2,"method":"eth_blockNumber"}`,
+				`{"id":3,"method":"debug_traceBlockByNumber"}`,
+			},
+			[]string{"", "", "method debug_*"},
+		},
+		{
+			"pass - exact method limit has precedence over the namespace one",
+			RateLimitConfig{Methods: map[string]float64{"eth_*": 1, "eth_getLogs": 2}},
+			[]string{
+				`{"id":1,"method":"eth_getLogs"}`,
+				`{"id":2,"method":"eth_getLogs"}`,
+				`{"id":3,"method":"eth_blockNumber"}`,
+			},
+			[]string{"", "", ""},
+		},
+		{
+			"fail - rejected requests do not consume tokens",
+			RateLimitConfig{PerIP: 2, Methods: map[string]float64{"eth_getLogs": 1}},
+			[]string{
+				`{"id":1,"method":"eth_getLogs"}`,
+				`{"id":2,"method":"eth_getLogs"}`,
+				`{"id":3,"method":"eth_blockNumber"}`,
+				`{"id":4,"method":"eth_blockNumber"}`,
+			},
+			[]string{"", "method eth_getLogs", "", "request rate limit"},
+		},
+		{
+			"fail - gas limit counts the call gas bounded by the gas cap",
+			RateLimitConfig{GasPerIP: 100_000, GasCap: 60_000},
+			[]string{
+				`{"id":1,"method":"eth_call","params":[{"gas":"0xafc8"},"latest"]}`,
+				`{"id":2,"method":"eth_estimateGas","params":[{"gas":"0xffffffff"}]}`,
+				`{"id":3,"method":"eth_call","params":[{"gas":"0xc350"},"latest"]}`,
+			},
+			[]string{"", "gas rate limit", ""},
+		},
+		{
+			"fail - calls without gas count as the gas cap",
+			RateLimitConfig{GasPerIP: 100_000, GasCap: 60_000},
+			[]string{
+				`{"id":1,"method":"eth_call","params":[{},"latest"]}`,
+				`{"id":2,"method":"eth_call","params":[{},"latest"]}`,
+			},
+			[]string{"", "gas rate limit"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			now := time.Now()
+			rl := NewRateLimiter(tc.cfg)
+			rl.now = func() time.Time { return now }
+
+			for i, body := range tc.bodies {
+				err := rl.Allow("127.0.0.1", newMsgs(t, body))
+				if tc.expErr[i] == "" {
+					require.NoError(t, err, "request %d", i)
+				} else {
+					require.ErrorContains(t, err, tc.expErr[i], "request %d", i)
+				}
+			}
+
+			// other IPs have their own limits
+			require.NoError(t, rl.Allow("127.0.0.2", newMsgs(t, tc.bodies[0])))
+		})
+	}
+}
+
+func TestRateLimiterRefill(t *testing.T) {
+	now := time.Now()
+	rl := NewRateLimiter(RateLimitConfig{PerIP: 1})
+	rl.now = func() time.Time { return now }
+
+	msgs := newMsgs(t, `{"id":1,"method":"eth_blockNumber"}`)
+	require.NoError(t, rl.Allow("127.0.0.1", msgs))
+	require.Error(t, rl.Allow("127.0.0.1", msgs))
+
+	now = now.Add(time.Second)
+	require.NoError(t, rl.Allow("127.0.0.1", msgs))
+
+	// full buckets are pruned
+	now = now.Add(pruneInterval)
+	require.NoError(t, rl.Allow("127.0.0.2", msgs))
+	require.Len(t, rl.limiters, 1)
+}
+
+func TestParseErrors(t *testing.T) {
+	body, err := json.Marshal([]map[string]interface{}{
+		{"jsonrpc": "2.0", "id": 1, "result": "0x1"},
+		{"jsonrpc": "2.0", "id": 2, "error": map[string]interface{}{"code": errCodeMethodNotFound, "message": "not found"}},
+	})
+	require.NoError(t, err)
+
+	errs := parseErrors(body)
+	require.Len(t, errs, 1)
+	require.Equal(t, errCodeMethodNotFound, errs["2"].Code)
+}
+
+func TestMiddlewareRateLimit(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	})
+	m := New(log.NewNopLogger(), NewRateLimiter(RateLimitConfig{PerIP: 1}), nil, "")
+	handler := m.Handler(next)
+
+	newRequest := func(body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.RemoteAddr = "127.0.0.1:1234"
+		return r
+	}
+	serve := func(r *http.Request) int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	body := `{"id":1,"method":"eth_blockNumber"}`
+	require.Equal(t, http.StatusOK, serve(newRequest(body)))
+	require.Equal(t, http.StatusTooManyRequests, serve(newRequest(body)))
+
+	// the requests forwarded by the websocket server are limited with the IP
+	// of the websocket client instead
+	forwarded := newRequest(body)
+	m.SetForwarded(forwarded)
+	require.Equal(t, http.StatusOK, serve(forwarded))
+
+	require.NoError(t, m.AllowRequest("10.0.0.1", []byte(body)))
+	require.ErrorContains(t, m.AllowRequest("10.0.0.1", []byte(body)), "request rate limit")
+
+	// the clients can't forge the forwarded header
+	spoofed := newRequest(body)
+	spoofed.Header.Set(forwardedHeader, "spoofed")
+	require.Equal(t, http.StatusTooManyRequests, serve(spoofed))
+
+	// the request bodies are limited in size
+	large := `{"id":1,"method":"eth_blockNumber","params":["` + strings.Repeat("a", MaxRequestBodySize) + `"]}`
+	require.Equal(t, http.StatusRequestEntityTooLarge, serve(newRequest(large)))
+}
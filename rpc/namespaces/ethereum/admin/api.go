@@ -0,0 +1,74 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"cosmossdk.io/log"
+)
+
+// errInvalidClient is returned when the node client isn't a CometBFT RPC client.
+var errInvalidClient = errors.New("invalid rpc client")
+
+// API is the admin prefixed set of APIs, which exposes the node information.
+// Its methods are only permitted to the local and authenticated clients of the
+// JSON-RPC server.
+type API struct {
+	ctx      *server.Context
+	logger   log.Logger
+	tmClient rpcclient.Client
+}
+
+// NewPrivateAPI creates an instance of the Admin API.
+func NewPrivateAPI(ctx *server.Context, clientCtx client.Context) *API {
+	logger := ctx.Logger.With("api", "admin")
+
+	// the node info methods return an error without a client
+	tmClient, ok := clientCtx.Client.(rpcclient.Client)
+	if !ok {
+		logger.Error("invalid rpc client, expected: rpcclient.Client", "client", fmt.Sprintf("%T", clientCtx.Client))
+	}
+
+	return &API{
+		ctx:      ctx,
+		logger:   logger,
+		tmClient: tmClient,
+	}
+}
+
+// NodeInfo returns the CometBFT status of the node, with its node info, sync
+// info and validator info.
+func (api *API) NodeInfo() (*coretypes.ResultStatus, error) {
+	api.logger.Debug("admin_nodeInfo")
+	if api.tmClient == nil {
+		return nil, errInvalidClient
+	}
+	return api.tmClient.Status(context.Background())
+}
+
+// Peers returns the peers connected to the node.
+func (api *API) Peers() ([]coretypes.Peer, error) {
+	api.logger.Debug("admin_peers")
+	if api.tmClient == nil {
+		return nil, errInvalidClient
+	}
+	netInfo, err := api.tmClient.NetInfo(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return netInfo.Peers, nil
+}
+
+// Datadir returns the home directory of the node.
+func (api *API) Datadir() string {
+	api.logger.Debug("admin_datadir")
+	return api.ctx.Config.RootDir
+}
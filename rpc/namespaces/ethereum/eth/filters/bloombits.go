@@ -0,0 +1,73 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package filters
+
+import (
+	"math/bits"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// bloomBitIndex returns the index of the bloom bit, as stored by the
+// bloom-bits index, of the given byte index and value of a bloom.
+func bloomBitIndex(i uint, v byte) uint {
+	return (ethtypes.BloomByteLength-1-i)*8 + uint(bits.TrailingZeros8(v))
+}
+
+// matchBloomBits returns the bitset of the blocks of an indexed section whose
+// bloom matches the bloom filters. A block matches when, for each filter, the
+// bloom contains any of its values.
+func matchBloomBits(backend Backend, section uint64, bloomFilters [][]BloomIV) ([]byte, error) {
+	cache := make(map[uint][]byte)
+	bloomBits := func(bit uint) ([]byte, error) {
+		if bz, ok := cache[bit]; ok {
+			return bz, nil
+		}
+		bz, err := backend.BloomBits(bit, section)
+		if err != nil {
+			return nil, err
+		}
+		cache[bit] = bz
+		return bz, nil
+	}
+
+	var matches []byte
+	for _, filter := range bloomFilters {
+		var filterMatches []byte
+		for _, iv := range filter {
+			var valueMatches []byte
+			for k := range iv.I {
+				bz, err := bloomBits(bloomBitIndex(iv.I[k], iv.V[k]))
+				if err != nil {
+					return nil, err
+				}
+				valueMatches = andBytes(valueMatches, bz)
+			}
+			filterMatches = orBytes(filterMatches, valueMatches)
+		}
+		matches = andBytes(matches, filterMatches)
+	}
+	return matches, nil
+}
+
+// andBytes returns the bitwise AND of the bitsets. A nil dst is the identity.
+func andBytes(dst, src []byte) []byte {
+	if dst == nil {
+		return append([]byte{}, src...)
+	}
+	for i := range dst {
+		dst[i] &= src[i]
+	}
+	return dst
+}
+
+// orBytes returns the bitwise OR of the bitsets. A nil dst is the identity.
+func orBytes(dst, src []byte) []byte {
+	if dst == nil {
+		return append([]byte{}, src...)
+	}
+	for i := range dst {
+		dst[i] |= src[i]
+	}
+	return dst
+}
@@ -0,0 +1,67 @@
+package filters
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// bloomBitsBackend serves the bloom bits of a single section.
+type bloomBitsBackend struct {
+	Backend
+	gen *bloombits.Generator
+}
+
+func (b bloomBitsBackend) BloomBits(bit uint, _ uint64) ([]byte, error) {
+	return b.gen.Bitset(bit)
+}
+
+func TestMatchBloomBits(t *testing.T) {
+	addr1 := common.HexToAddress("0x01")
+	addr2 := common.HexToAddress("0x02")
+	topic := common.HexToHash("0xabc")
+
+	// the blocks 3 and 9 emit logs of addr1, the block 5 of addr2 and the
+	// blocks 9 and 12 have the topic
+	gen, err := bloombits.NewGenerator(16)
+	require.NoError(t, err)
+	for i := uint(0); i < 16; i++ {
+		var bloom ethtypes.Bloom
+		if i == 3 || i == 9 {
+			bloom.Add(addr1.Bytes())
+		}
+		if i == 5 {
+			bloom.Add(addr2.Bytes())
+		}
+		if i == 9 || i == 12 {
+			bloom.Add(topic.Bytes())
+		}
+		require.NoError(t, gen.AddBloom(i, bloom))
+	}
+	backend := bloomBitsBackend{gen: gen}
+
+	testCases := []struct {
+		name      string
+		addresses []common.Address
+		topics    [][]common.Hash
+		expected  []byte
+	}{
+		{"single address", []common.Address{addr1}, nil, []byte{0x10, 0x40}},
+		{"any of the addresses", []common.Address{addr1, addr2}, nil, []byte{0x14, 0x40}},
+		{"topic", nil, [][]common.Hash{{topic}}, []byte{0x00, 0x48}},
+		{"addresses and topic", []common.Address{addr1, addr2}, [][]common.Hash{{topic}}, []byte{0x00, 0x40}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := NewRangeFilter(log.NewNopLogger(), backend, 0, 15, tc.addresses, tc.topics)
+			matches, err := matchBloomBits(backend, 0, filter.bloomFilters)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, matches)
+		})
+	}
+}
@@ -156,30 +156,84 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
-	for height := from; height <= to; height++ {
-		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
+	sectionSize, _ := f.backend.BloomStatus()
+	for start := from; start <= to; {
+		// the range is processed by bloom-bits section, so that only the
+		// candidate blocks of the indexed sections are fetched
+		section := uint64(start) / sectionSize           // #nosec G115
+		end := min(to, int64((section+1)*sectionSize)-1) // #nosec G115
+
+		heights, err := f.candidateHeights(section, sectionSize, start, end)
 		if err != nil {
-			f.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
-			return nil, nil
+			return nil, err
 		}
 
-		bloom, err := f.backend.BlockBloom(blockRes)
-		if err != nil {
-			return nil, err
+		for _, height := range heights {
+			blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
+			if err != nil {
+				f.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
+				return nil, nil
+			}
+
+			bloom, err := f.backend.BlockBloom(blockRes)
+			if err != nil {
+				return nil, err
+			}
+
+			filtered, err := f.blockLogs(blockRes, bloom)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to fetch block by number %d", height)
+			}
+
+			// check logs limit
+			if len(logs)+len(filtered) > logLimit {
+				return nil, fmt.Errorf("query returned more than %d results", logLimit)
+			}
+			logs = append(logs, filtered...)
 		}
 
-		filtered, err := f.blockLogs(blockRes, bloom)
+		start = end + 1
+	}
+	return logs, nil
+}
+
+// candidateHeights returns the heights between start and end, both included,
+// of the blocks of the section that may contain logs matching the filter. When
+// the section is indexed, the heights are the ones matching the bloom-bits
+// index. Otherwise, all the heights are returned.
+func (f *Filter) candidateHeights(section, sectionSize uint64, start, end int64) ([]int64, error) {
+	indexed := false
+	if len(f.bloomFilters) > 0 {
+		var err error
+		indexed, err = f.backend.HasBloomSection(section)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to fetch block by number %d", height)
+			f.logger.Debug("failed to check bloom section", "section", section, "error", err.Error())
+			indexed = false
 		}
+	}
 
-		// check logs limit
-		if len(logs)+len(filtered) > logLimit {
-			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+	if !indexed {
+		heights := make([]int64, 0, end-start+1)
+		for height := start; height <= end; height++ {
+			heights = append(heights, height)
 		}
-		logs = append(logs, filtered...)
+		return heights, nil
 	}
-	return logs, nil
+
+	matches, err := matchBloomBits(f.backend, section, f.bloomFilters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to match bloom section %d", section)
+	}
+
+	sectionStart := int64(section * sectionSize) // #nosec G115
+	heights := []int64{}
+	for height := start; height <= end; height++ {
+		i := height - sectionStart
+		if matches[i/8]&(0x80>>(i%8)) != 0 {
+			heights = append(heights, height)
+		}
+	}
+	return heights, nil
 }
 
 // blockLogs returns the logs matching the filter criteria within a single block.
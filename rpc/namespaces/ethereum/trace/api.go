@@ -0,0 +1,158 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package trace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/log"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/evmos/v20/rpc/types"
+)
+
+// Backend defines the methods required by the PublicAPI to build the traces.
+type Backend interface {
+	BlockNumber() (hexutil.Uint64, error)
+	ParityTraceBlock(height types.BlockNumber) ([]*types.ParityTrace, error)
+	ParityTraceTransaction(hash common.Hash) ([]*types.ParityTrace, error)
+	RPCEVMTimeout() time.Duration
+	RPCTraceFilterBlockRangeCap() int32
+}
+
+// FilterArgs are the arguments of the trace_filter query.
+type FilterArgs struct {
+	FromBlock   *types.BlockNumber `json:"fromBlock"`
+	ToBlock     *types.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address   `json:"fromAddress"`
+	ToAddress   []common.Address   `json:"toAddress"`
+	After       *uint64            `json:"after"`
+	Count       *uint64            `json:"count"`
+}
+
+// PublicAPI offers the OpenEthereum (Parity) trace API. The traces are built
+// from the results of the geth callTracer.
+type PublicAPI struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewPublicAPI creates a new trace API instance.
+func NewPublicAPI(logger log.Logger, backend Backend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the traces of all the transactions of a block.
+func (api *PublicAPI) Block(blockNum types.BlockNumber) ([]*types.ParityTrace, error) {
+	api.logger.Debug("trace_block", "number", blockNum)
+	return api.backend.ParityTraceBlock(blockNum)
+}
+
+// Transaction returns the traces of a transaction.
+func (api *PublicAPI) Transaction(hash common.Hash) ([]*types.ParityTrace, error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+	return api.backend.ParityTraceTransaction(hash)
+}
+
+// Filter returns the traces of the blocks in the [fromBlock, toBlock] range
+// that match the sender and recipient addresses, skipping the first `after`
+// matches and returning at most `count` of them. The blocks are traced until
+// the request is canceled or the EVM timeout expires.
+func (api *PublicAPI) Filter(ctx context.Context, args FilterArgs) ([]*types.ParityTrace, error) {
+	api.logger.Debug("trace_filter", "args", args)
+
+	head, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	latest := int64(head) //#nosec G115 -- block number is not larger than int64
+	from, to := int64(1), latest
+	if args.FromBlock != nil {
+		from = resolveBlockNumber(*args.FromBlock, latest)
+	}
+	if args.ToBlock != nil {
+		to = resolveBlockNumber(*args.ToBlock, latest)
+	}
+
+	if from > to {
+		return nil, errors.New("invalid block range: fromBlock is after toBlock")
+	}
+	if to > latest {
+		return nil, fmt.Errorf("toBlock %d is after the latest block %d", to, latest)
+	}
+	if blockLimit := int64(api.backend.RPCTraceFilterBlockRangeCap()); to-from > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	if timeout := api.backend.RPCEVMTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	filter := types.ParityTraceFilter{
+		FromAddress: args.FromAddress,
+		ToAddress:   args.ToAddress,
+	}
+
+	var after uint64
+	if args.After != nil {
+		after = *args.After
+	}
+
+	traces := []*types.ParityTrace{}
+	if args.Count != nil && *args.Count == 0 {
+		return traces, nil
+	}
+
+	for height := from; height <= to; height++ {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("trace_filter aborted at block %d: %w", height, err)
+		}
+
+		blockTraces, err := api.backend.ParityTraceBlock(types.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range blockTraces {
+			if !filter.Match(trace) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) >= *args.Count {
+				return traces, nil
+			}
+		}
+	}
+
+	return traces, nil
+}
+
+// resolveBlockNumber returns the height of a block number of the filter
+// arguments, starting from the first block as genesis is not traceable.
+func resolveBlockNumber(blockNum types.BlockNumber, latest int64) int64 {
+	switch {
+	case blockNum < types.EthEarliestBlockNumber:
+		// latest and pending
+		return latest
+	case blockNum == types.EthEarliestBlockNumber:
+		return 1
+	default:
+		return blockNum.Int64()
+	}
+}
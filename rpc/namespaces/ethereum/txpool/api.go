@@ -3,51 +3,137 @@
 package txpool
 
 import (
+	"fmt"
+	"strconv"
+
 	"cosmossdk.io/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/evmos/evmos/v20/rpc/backend"
 	"github.com/evmos/evmos/v20/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// The transactions are read from the CometBFT mempool and classified per account as pending
+// (executable) or queued (behind a nonce gap) using the committed nonce of the sender.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
 // Content returns the transactions contained within the transaction pool
 func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
+		"pending": make(map[string]map[string]*types.RPCTransaction, len(pending)),
+		"queued":  make(map[string]map[string]*types.RPCTransaction, len(queued)),
+	}
+	for account, txs := range pending {
+		content["pending"][account.Hex()] = formatTxs(txs, rpcTx)
+	}
+	for account, txs := range queued {
+		content["queued"][account.Hex()] = formatTxs(txs, rpcTx)
 	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool for the given address.
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": formatTxs(pending[address], rpcTx),
+		"queued":  formatTxs(queued[address], rpcTx),
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]string{
-		"pending": make(map[string]map[string]string),
-		"queued":  make(map[string]map[string]string),
+		"pending": make(map[string]map[string]string, len(pending)),
+		"queued":  make(map[string]map[string]string, len(queued)),
+	}
+	for account, txs := range pending {
+		content["pending"][account.Hex()] = formatTxs(txs, inspectTx)
+	}
+	for account, txs := range queued {
+		content["queued"][account.Hex()] = formatTxs(txs, inspectTx)
 	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(countTxs(pending)),
+		"queued":  hexutil.Uint(countTxs(queued)),
+	}, nil
+}
+
+// formatTxs converts the transactions of an account into a map keyed by
+// the decimal nonce, as returned by go-ethereum.
+func formatTxs[T any](txs map[uint64]*types.RPCTransaction, format func(*types.RPCTransaction) T) map[string]T {
+	formatted := make(map[string]T, len(txs))
+	for nonce, tx := range txs {
+		formatted[strconv.FormatUint(nonce, 10)] = format(tx)
+	}
+	return formatted
+}
+
+// rpcTx returns the transaction as is, as returned by txpool_content.
+func rpcTx(tx *types.RPCTransaction) *types.RPCTransaction {
+	return tx
+}
+
+// inspectTx returns the go-ethereum txpool_inspect summary of a transaction.
+func inspectTx(tx *types.RPCTransaction) string {
+	if tx.To != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+}
+
+// countTxs returns the number of transactions of all the accounts.
+func countTxs(txs map[common.Address]map[uint64]*types.RPCTransaction) int {
+	count := 0
+	for _, accountTxs := range txs {
+		count += len(accountTxs)
 	}
+	return count
 }
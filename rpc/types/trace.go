@@ -0,0 +1,207 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Parity trace types
+const (
+	ParityTraceTypeCall    = "call"
+	ParityTraceTypeCreate  = "create"
+	ParityTraceTypeSuicide = "suicide"
+)
+
+// CallFrame is a call frame of the results of the geth callTracer.
+type CallFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []CallFrame     `json:"calls,omitempty"`
+}
+
+// ParityTrace is a trace of a call, contract creation or self destruct in the
+// format of the OpenEthereum (Parity) trace namespace.
+type ParityTrace struct {
+	Action              ParityTraceAction  `json:"action"`
+	BlockHash           common.Hash        `json:"blockHash"`
+	BlockNumber         uint64             `json:"blockNumber"`
+	Error               string             `json:"error,omitempty"`
+	Result              *ParityTraceResult `json:"result"`
+	Subtraces           int                `json:"subtraces"`
+	TraceAddress        []int              `json:"traceAddress"`
+	TransactionHash     common.Hash        `json:"transactionHash"`
+	TransactionPosition uint64             `json:"transactionPosition"`
+	Type                string             `json:"type"`
+}
+
+// ParityTraceAction is the action of a parity trace. The set fields depend on
+// the trace type:
+//   - call: callType, from, to, gas, input and value.
+//   - create: creationMethod, from, gas, init and value.
+//   - suicide: address, refundAddress and balance.
+type ParityTraceAction struct {
+	CallType       string          `json:"callType,omitempty"`
+	CreationMethod string          `json:"creationMethod,omitempty"`
+	From           *common.Address `json:"from,omitempty"`
+	To             *common.Address `json:"to,omitempty"`
+	Gas            *hexutil.Uint64 `json:"gas,omitempty"`
+	Input          *hexutil.Bytes  `json:"input,omitempty"`
+	Init           *hexutil.Bytes  `json:"init,omitempty"`
+	Value          *hexutil.Big    `json:"value,omitempty"`
+	Address        *common.Address `json:"address,omitempty"`
+	RefundAddress  *common.Address `json:"refundAddress,omitempty"`
+	Balance        *hexutil.Big    `json:"balance,omitempty"`
+}
+
+// ParityTraceResult is the result of a successful call or contract creation.
+type ParityTraceResult struct {
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+}
+
+// ParityTraceContext is the block and tx of the traces of a call frame.
+type ParityTraceContext struct {
+	BlockHash           common.Hash
+	BlockNumber         uint64
+	TransactionHash     common.Hash
+	TransactionPosition uint64
+}
+
+// ParityTraces flattens the call frames of a tx into parity traces, ordered
+// depth-first, with the trace address of each frame being the path of call
+// indexes from the top level call.
+func ParityTraces(frame CallFrame, traceCtx ParityTraceContext) []*ParityTrace {
+	var traces []*ParityTrace
+	appendParityTraces(&traces, frame, []int{}, traceCtx)
+	return traces
+}
+
+// appendParityTraces appends the trace of the frame and its subcalls.
+func appendParityTraces(traces *[]*ParityTrace, frame CallFrame, traceAddress []int, traceCtx ParityTraceContext) {
+	trace := &ParityTrace{
+		BlockHash:           traceCtx.BlockHash,
+		BlockNumber:         traceCtx.BlockNumber,
+		Error:               parityError(frame.Error),
+		Subtraces:           len(frame.Calls),
+		TraceAddress:        traceAddress,
+		TransactionHash:     traceCtx.TransactionHash,
+		TransactionPosition: traceCtx.TransactionPosition,
+	}
+
+	from := frame.From
+	gas := frame.Gas
+	input := frame.Input
+	output := frame.Output
+	value := frame.Value
+	if value == nil {
+		value = new(hexutil.Big)
+	}
+
+	switch opcode := strings.ToUpper(frame.Type); opcode {
+	case "CREATE", "CREATE2":
+		trace.Type = ParityTraceTypeCreate
+		trace.Action = ParityTraceAction{
+			CreationMethod: strings.ToLower(opcode),
+			From:           &from,
+			Gas:            &gas,
+			Init:           &input,
+			Value:          value,
+		}
+		if frame.Error == "" {
+			trace.Result = &ParityTraceResult{GasUsed: frame.GasUsed, Address: frame.To, Code: &output}
+		}
+	case "SELFDESTRUCT":
+		trace.Type = ParityTraceTypeSuicide
+		trace.Action = ParityTraceAction{
+			Address:       &from,
+			RefundAddress: frame.To,
+			Balance:       value,
+		}
+	default:
+		trace.Type = ParityTraceTypeCall
+		trace.Action = ParityTraceAction{
+			CallType: strings.ToLower(opcode),
+			From:     &from,
+			To:       frame.To,
+			Gas:      &gas,
+			Input:    &input,
+			Value:    value,
+		}
+		if frame.Error == "" {
+			trace.Result = &ParityTraceResult{GasUsed: frame.GasUsed, Output: &output}
+		}
+	}
+
+	*traces = append(*traces, trace)
+
+	for i, call := range frame.Calls {
+		subAddress := make([]int, len(traceAddress)+1)
+		copy(subAddress, traceAddress)
+		subAddress[len(traceAddress)] = i
+		appendParityTraces(traces, call, subAddress, traceCtx)
+	}
+}
+
+// parityError returns the parity error message of the geth VM errors that
+// parity reports with a different message.
+func parityError(vmError string) string {
+	switch vmError {
+	case "execution reverted":
+		return "Reverted"
+	case "out of gas":
+		return "Out of gas"
+	default:
+		return vmError
+	}
+}
+
+// ParityTraceFilter selects the traces of the trace_filter query by their
+// sender and recipient addresses.
+type ParityTraceFilter struct {
+	FromAddress []common.Address
+	ToAddress   []common.Address
+}
+
+// Match returns true if the trace sender is in the FromAddress list and its
+// recipient is in the ToAddress list. An empty list matches any address. The
+// sender of a self destruct is the destructed contract and its recipient is
+// the refund address, while the recipient of a contract creation is the
+// created contract.
+func (f ParityTraceFilter) Match(trace *ParityTrace) bool {
+	var from, to *common.Address
+	switch trace.Type {
+	case ParityTraceTypeSuicide:
+		from, to = trace.Action.Address, trace.Action.RefundAddress
+	case ParityTraceTypeCreate:
+		from = trace.Action.From
+		if trace.Result != nil {
+			to = trace.Result.Address
+		}
+	default:
+		from, to = trace.Action.From, trace.Action.To
+	}
+
+	return matchAddress(f.FromAddress, from) && matchAddress(f.ToAddress, to)
+}
+
+// matchAddress returns true if the list is empty or contains the address.
+func matchAddress(addresses []common.Address, address *common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	return address != nil && slices.Contains(addresses, *address)
+}
@@ -0,0 +1,163 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// callTracerResult is a callTracer result of a call that creates a contract,
+// which reverts a static call and self destructs.
+const callTracerResult = `{
+	"type": "CALL",
+	"from": "0x0000000000000000000000000000000000000001",
+	"to": "0x0000000000000000000000000000000000000002",
+	"value": "0x1",
+	"gas": "0x10000",
+	"gasUsed": "0x5000",
+	"input": "0x01",
+	"output": "0x02",
+	"calls": [
+		{
+			"type": "CREATE2",
+			"from": "0x0000000000000000000000000000000000000002",
+			"to": "0x0000000000000000000000000000000000000003",
+			"value": "0x0",
+			"gas": "0x8000",
+			"gasUsed": "0x1000",
+			"input": "0x6000",
+			"output": "0x00",
+			"calls": [
+				{
+					"type": "STATICCALL",
+					"from": "0x0000000000000000000000000000000000000003",
+					"to": "0x0000000000000000000000000000000000000004",
+					"gas": "0x100",
+					"gasUsed": "0x100",
+					"input": "0x",
+					"error": "execution reverted"
+				}
+			]
+		},
+		{
+			"type": "SELFDESTRUCT",
+			"from": "0x0000000000000000000000000000000000000002",
+			"to": "0x0000000000000000000000000000000000000005",
+			"value": "0x1",
+			"gas": "0x0",
+			"gasUsed": "0x0",
+			"input": "0x"
+		}
+	]
+}`
+
+func TestParityTraces(t *testing.T) {
+	var frame CallFrame
+	require.NoError(t, json.Unmarshal([]byte(callTracerResult), &frame))
+
+	traceCtx := ParityTraceContext{
+		BlockHash:           common.HexToHash("0xb1"),
+		BlockNumber:         10,
+		TransactionHash:     common.HexToHash("0xa1"),
+		TransactionPosition: 2,
+	}
+	traces := ParityTraces(frame, traceCtx)
+	require.Len(t, traces, 4)
+
+	for _, trace := range traces {
+		require.Equal(t, traceCtx.BlockHash, trace.BlockHash)
+		require.Equal(t, traceCtx.BlockNumber, trace.BlockNumber)
+		require.Equal(t, traceCtx.TransactionHash, trace.TransactionHash)
+		require.Equal(t, traceCtx.TransactionPosition, trace.TransactionPosition)
+	}
+
+	call := traces[0]
+	require.Equal(t, ParityTraceTypeCall, call.Type)
+	require.Equal(t, "call", call.Action.CallType)
+	require.Equal(t, []int{}, call.TraceAddress)
+	require.Equal(t, 2, call.Subtraces)
+	require.Equal(t, common.HexToAddress("0x2"), *call.Action.To)
+	require.Equal(t, uint64(0x5000), uint64(call.Result.GasUsed))
+	require.Equal(t, []byte{2}, []byte(*call.Result.Output))
+
+	create := traces[1]
+	require.Equal(t, ParityTraceTypeCreate, create.Type)
+	require.Equal(t, "create2", create.Action.CreationMethod)
+	require.Equal(t, []int{0}, create.TraceAddress)
+	require.Equal(t, []byte{0x60, 0x00}, []byte(*create.Action.Init))
+	require.Equal(t, common.HexToAddress("0x3"), *create.Result.Address)
+	require.Nil(t, create.Action.To)
+
+	staticCall := traces[2]
+	require.Equal(t, "staticcall", staticCall.Action.CallType)
+	require.Equal(t, []int{0, 0}, staticCall.TraceAddress)
+	require.Equal(t, "Reverted", staticCall.Error)
+	require.Nil(t, staticCall.Result)
+
+	suicide := traces[3]
+	require.Equal(t, ParityTraceTypeSuicide, suicide.Type)
+	require.Equal(t, []int{1}, suicide.TraceAddress)
+	require.Equal(t, common.HexToAddress("0x2"), *suicide.Action.Address)
+	require.Equal(t, common.HexToAddress("0x5"), *suicide.Action.RefundAddress)
+	require.Nil(t, suicide.Result)
+
+	bz, err := json.Marshal(call)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"traceAddress":[]`)
+	require.Contains(t, string(bz), `"blockNumber":10`)
+}
+
+func TestParityTraceFilter(t *testing.T) {
+	var frame CallFrame
+	require.NoError(t, json.Unmarshal([]byte(callTracerResult), &frame))
+	traces := ParityTraces(frame, ParityTraceContext{})
+
+	testCases := []struct {
+		name      string
+		filter    ParityTraceFilter
+		expTraces []int
+	}{
+		{
+			"empty filter matches all the traces",
+			ParityTraceFilter{},
+			[]int{0, 1, 2, 3},
+		},
+		{
+			"sender of calls, creations and self destructs",
+			ParityTraceFilter{FromAddress: []common.Address{common.HexToAddress("0x2")}},
+			[]int{1, 3},
+		},
+		{
+			"created contract",
+			ParityTraceFilter{ToAddress: []common.Address{common.HexToAddress("0x3")}},
+			[]int{1},
+		},
+		{
+			"refund address",
+			ParityTraceFilter{ToAddress: []common.Address{common.HexToAddress("0x5")}},
+			[]int{3},
+		},
+		{
+			"sender and recipient",
+			ParityTraceFilter{
+				FromAddress: []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x3")},
+				ToAddress:   []common.Address{common.HexToAddress("0x4")},
+			},
+			[]int{2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var matched []int
+			for i, trace := range traces {
+				if tc.filter.Match(trace) {
+					matched = append(matched, i)
+				}
+			}
+			require.Equal(t, tc.expTraces, matched)
+		})
+	}
+}
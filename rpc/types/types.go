@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -22,6 +23,44 @@ type AccountResult struct {
 	Nonce        hexutil.Uint64  `json:"nonce"`
 	StorageHash  common.Hash     `json:"storageHash"`
 	StorageProof []StorageResult `json:"storageProof"`
+	// Envelope is an extension of the EIP-1186 output that documents how to
+	// verify the proofs, which are not Merkle Patricia Trie proofs.
+	Envelope *ProofEnvelope `json:"envelope,omitempty"`
+}
+
+// ProofTypeICS23IAVL defines the proof type of the proofs of the IAVL stores.
+const ProofTypeICS23IAVL = "ics23-iavl"
+
+// ProofEnvelope documents how to verify the proofs of an AccountResult. Unlike
+// EIP-1186, the state isn't stored in Merkle Patricia Tries, so:
+//   - each proof is the list of hex encoded ICS-23 commitment proofs from the
+//     key in the module IAVL store up to the root of the multistore (app hash).
+//   - the account proof proves the protobuf encoded account in the auth store,
+//     at AccountKey, with the value AccountValue.
+//   - the storage proofs prove the 32 bytes values in the evm store at
+//     StorageKeys, so their values must be left padded to 32 bytes.
+//   - there are no per-account storage tries, so the storage hash is empty.
+//   - the balance isn't part of the account, so the balance proof proves the
+//     balance of the EVM denomination in the bank store, at BalanceKey, with
+//     the value BalanceValue. The value is the encoded integer amount in the
+//     bank denomination, which is scaled to 18 decimals for the EVM balance
+//     if the denomination has less decimals.
+//
+// The proofs are verified against the app hash of the state at Height, which is
+// committed in the header of the next block.
+type ProofEnvelope struct {
+	ProofType    string          `json:"proofType"`
+	Height       hexutil.Uint64  `json:"height"`
+	AppHash      hexutil.Bytes   `json:"appHash"`
+	AccountStore string          `json:"accountStore"`
+	AccountKey   hexutil.Bytes   `json:"accountKey"`
+	AccountValue hexutil.Bytes   `json:"accountValue"`
+	StorageStore string          `json:"storageStore"`
+	StorageKeys  []hexutil.Bytes `json:"storageKeys"`
+	BalanceStore string          `json:"balanceStore"`
+	BalanceKey   hexutil.Bytes   `json:"balanceKey"`
+	BalanceValue hexutil.Bytes   `json:"balanceValue"`
+	BalanceProof []string        `json:"balanceProof"`
 }
 
 // StorageResult defines the format for storage proof return
@@ -52,24 +91,44 @@ type RPCTransaction struct {
 	V                *hexutil.Big         `json:"v"`
 	R                *hexutil.Big         `json:"r"`
 	S                *hexutil.Big         `json:"s"`
+
+	AuthorizationList []RPCSetCodeAuthorization `json:"authorizationList,omitempty"`
+}
+
+// RPCSetCodeAuthorization represents an EIP-7702 authorization of a set code
+// transaction that will serialize to the RPC representation
+type RPCSetCodeAuthorization struct {
+	ChainID *hexutil.Big   `json:"chainId"`
+	Address common.Address `json:"address"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	YParity hexutil.Uint64 `json:"yParity"`
+	R       *hexutil.Big   `json:"r"`
+	S       *hexutil.Big   `json:"s"`
+}
+
+// AccessListResult represents the access list created for a call, with the gas
+// it consumes and the execution error, if any
+type AccessListResult struct {
+	AccessList *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
+// GasReport represents the breakdown of the gas consumed by a transaction that
+// is added to the receipts when the node enables the gas report
+type GasReport struct {
+	IntrinsicGas  hexutil.Uint64 `json:"intrinsicGas"`
+	ExecutionGas  hexutil.Uint64 `json:"executionGas"`
+	PrecompileGas hexutil.Uint64 `json:"precompileGas"`
+	RefundedGas   hexutil.Uint64 `json:"refundedGas"`
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
-}
+type OverrideAccount = evmtypes.OverrideAccount
 
 type FeeHistoryResult struct {
 	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
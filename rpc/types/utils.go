@@ -48,7 +48,7 @@ func RawTxToEthTx(clientCtx client.Context, txBz cmttypes.Tx) ([]*evmtypes.MsgEt
 		if !ok {
 			return nil, fmt.Errorf("invalid message type %T, expected %T", msg, &evmtypes.MsgEthereumTx{})
 		}
-		ethTx.Hash = ethTx.AsTransaction().Hash().Hex()
+		ethTx.Hash = ethTx.TxHash().Hex()
 		ethTxs[i] = ethTx
 	}
 	return ethTxs, nil
@@ -160,8 +160,47 @@ func NewTransactionFromMsg(
 	baseFee *big.Int,
 	chainID *big.Int,
 ) (*RPCTransaction, error) {
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := msg.AsTransaction()
-	return NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	result, err := NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// go-ethereum doesn't support EIP-7702, the transaction of a set code tx
+	// is its execution view, so the type, sender and hash are set from the
+	// set code tx itself
+	if setCodeTx, ok := txData.(*evmtypes.SetCodeTx); ok {
+		ethTx := setCodeTx.AsSetCodeTx()
+		from, _ := ethTx.Sender() // #nosec G703
+		result.Type = hexutil.Uint64(evmtypes.SetCodeTxType)
+		result.From = from
+		result.Hash = ethTx.Hash()
+		result.AuthorizationList = make([]RPCSetCodeAuthorization, len(ethTx.AuthList))
+		for i, auth := range ethTx.AuthList {
+			result.AuthorizationList[i] = RPCSetCodeAuthorization{
+				ChainID: (*hexutil.Big)(auth.ChainID),
+				Address: auth.Address,
+				Nonce:   hexutil.Uint64(auth.Nonce),
+				YParity: hexutil.Uint64(bigUint64(auth.V)),
+				R:       (*hexutil.Big)(auth.R),
+				S:       (*hexutil.Big)(auth.S),
+			}
+		}
+	}
+	return result, nil
+}
+
+// bigUint64 returns the value as an uint64, zero if it isn't set.
+func bigUint64(value *big.Int) uint64 {
+	if value == nil {
+		return 0
+	}
+	return value.Uint64()
 }
 
 // NewTransactionFromData returns a transaction that will serialize to the RPC
@@ -13,6 +13,8 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
@@ -30,9 +32,11 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/evmos/evmos/v20/rpc/ethereum/pubsub"
+	"github.com/evmos/evmos/v20/rpc/middleware"
 	rpcfilters "github.com/evmos/evmos/v20/rpc/namespaces/ethereum/eth/filters"
 	"github.com/evmos/evmos/v20/rpc/types"
 	"github.com/evmos/evmos/v20/server/config"
+	evmostypes "github.com/evmos/evmos/v20/types"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -68,6 +72,13 @@ type ErrorMessageJSON struct {
 	Message string   `json:"message"`
 }
 
+// wsWriteTimeout is the timeout of the writes to a WebSocket connection.
+const wsWriteTimeout = 10 * time.Second
+
+// errSlowConsumer is returned when a message is sent to a WebSocket client
+// whose send queue is full.
+var errSlowConsumer = errors.New("websocket send queue is full")
+
 type websocketsServer struct {
 	rpcAddr  string // listen address of rest-server
 	wsAddr   string // listen address of ws server
@@ -75,19 +86,50 @@ type websocketsServer struct {
 	keyFile  string
 	api      *pubSubAPI
 	logger   log.Logger
+	// permissions and rate limits are checked before serving the requests,
+	// which are then forwarded to the local HTTP server
+	permissions   *middleware.Permissions
+	rpcMiddleware *middleware.Middleware
+
+	pingInterval     time.Duration
+	pongTimeout      time.Duration
+	maxConnections   int
+	maxSubscriptions int
+	sendQueueSize    int
+	// connections is the number of open connections
+	connections atomic.Int64
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	cfg *config.Config,
+	permissions *middleware.Permissions,
+	rpcMiddleware *middleware.Middleware,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
+	// the clients only time out when they are pinged
+	pongTimeout := cfg.JSONRPC.WSPongTimeout
+	if cfg.JSONRPC.WSPingInterval == 0 {
+		pongTimeout = 0
+	}
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
 
 	return &websocketsServer{
-		rpcAddr:  "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
-		wsAddr:   cfg.JSONRPC.WsAddress,
-		certFile: cfg.TLS.CertificatePath,
-		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
-		logger:   logger,
+		rpcAddr:          "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
+		wsAddr:           cfg.JSONRPC.WsAddress,
+		certFile:         cfg.TLS.CertificatePath,
+		keyFile:          cfg.TLS.KeyPath,
+		api:              newPubSubAPI(clientCtx, logger, tmWSClient),
+		logger:           logger,
+		permissions:      permissions,
+		rpcMiddleware:    rpcMiddleware,
+		pingInterval:     cfg.JSONRPC.WSPingInterval,
+		pongTimeout:      pongTimeout,
+		maxConnections:   cfg.JSONRPC.WSMaxConnections,
+		maxSubscriptions: cfg.JSONRPC.WSMaxSubscriptions,
+		sendQueueSize:    cfg.JSONRPC.WSSendQueueSize,
 	}
 }
 
@@ -116,6 +158,13 @@ func (s *websocketsServer) Start() {
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.connections.Add(1) > int64(s.maxConnections) && s.maxConnections > 0 {
+		s.connections.Add(-1)
+		http.Error(w, "too many websocket connections", http.StatusServiceUnavailable)
+		return
+	}
+	defer s.connections.Add(-1)
+
 	upgrader := websocket.Upgrader{
 		CheckOrigin: func(_ *http.Request) bool {
 			return true
@@ -128,10 +177,14 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
-	})
+	wsConn := newWSConn(conn, s.sendQueueSize, s.logger)
+	wsConn.trusted = s.permissions != nil && s.permissions.Trusted(r)
+	if s.rpcMiddleware != nil {
+		wsConn.ip = s.rpcMiddleware.ClientIP(r)
+	}
+
+	go wsConn.writeLoop(s.pingInterval)
+	s.readLoop(wsConn)
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
@@ -147,31 +200,114 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	_ = wsConn.WriteJSON(res) // #nosec G703
 }
 
+// wsConn is a WebSocket client connection. The messages sent to the client
+// are queued and written by a single writer, so that a slow client cannot
+// block the subscriptions.
 type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
+	conn   *websocket.Conn
+	logger log.Logger
+	// queue holds the messages to be written to the connection
+	queue chan interface{}
+	// closed is closed once the connection is closed
+	closed    chan struct{}
+	closeOnce sync.Once
+	// trusted is true if the client is local or authenticated
+	trusted bool
+	// ip is the client IP the rate limits are enforced for
+	ip string
 }
 
+func newWSConn(conn *websocket.Conn, queueSize int, logger log.Logger) *wsConn {
+	return &wsConn{
+		conn:   conn,
+		logger: logger,
+		queue:  make(chan interface{}, queueSize),
+		closed: make(chan struct{}),
+	}
+}
+
+// WriteJSON queues the message to be sent to the client. The client is
+// disconnected if its queue is full.
 func (w *wsConn) WriteJSON(v interface{}) error {
-	w.mux.Lock()
-	defer w.mux.Unlock()
+	select {
+	case <-w.closed:
+		return websocket.ErrCloseSent
+	default:
+	}
 
-	return w.conn.WriteJSON(v)
+	select {
+	case w.queue <- v:
+		return nil
+	default:
+		w.logger.Debug("disconnecting slow websocket client", "remote", w.conn.RemoteAddr().String())
+		w.closeWithMessage(websocket.CloseTryAgainLater, errSlowConsumer.Error())
+		return errSlowConsumer
+	}
 }
 
+// Close closes the connection.
 func (w *wsConn) Close() error {
-	w.mux.Lock()
-	defer w.mux.Unlock()
+	w.closeWithMessage(websocket.CloseNormalClosure, "")
+	return nil
+}
 
-	return w.conn.Close()
+// closeWithMessage sends a close message with the given code and text to the
+// client and closes the connection. Only the first call has an effect.
+func (w *wsConn) closeWithMessage(code int, text string) {
+	w.closeOnce.Do(func() {
+		close(w.closed)
+		// control messages can be written concurrently with the writer
+		msg := websocket.FormatCloseMessage(code, text)
+		_ = w.conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(wsWriteTimeout)) // #nosec G703
+		_ = w.conn.Close()                                                                   // #nosec G703
+	})
 }
 
+// ReadMessage reads the next message from the connection.
 func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
-	// not protected by write mutex
-
 	return w.conn.ReadMessage()
 }
 
+// keepAlive extends the read deadline of the connection after receiving a
+// message or a pong from the client. A zero timeout disables the deadline.
+func (w *wsConn) keepAlive(pongTimeout time.Duration) {
+	if pongTimeout > 0 {
+		_ = w.conn.SetReadDeadline(time.Now().Add(pongTimeout)) // #nosec G703
+	}
+}
+
+// writeLoop writes the queued messages to the connection and pings the client
+// at the given interval, until the connection is closed. A zero interval
+// disables the pings.
+func (w *wsConn) writeLoop(pingInterval time.Duration) {
+	var ping <-chan time.Time
+	if pingInterval > 0 {
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
+		ping = ticker.C
+	}
+
+	for {
+		select {
+		case msg := <-w.queue:
+			_ = w.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout)) // #nosec G703
+			if err := w.conn.WriteJSON(msg); err != nil {
+				w.logger.Debug("failed to write websocket message", "error", err.Error())
+				_ = w.Close() // #nosec G703
+				return
+			}
+		case <-ping:
+			if err := w.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout)); err != nil {
+				w.logger.Debug("failed to ping websocket client", "error", err.Error())
+				_ = w.Close() // #nosec G703
+				return
+			}
+		case <-w.closed:
+			return
+		}
+	}
+}
+
 func (s *websocketsServer) readLoop(wsConn *wsConn) {
 	// subscriptions of current connection
 	subscriptions := make(map[rpc.ID]pubsub.UnsubscribeFunc)
@@ -183,13 +319,34 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 		}
 	}()
 
+	wsConn.keepAlive(s.pongTimeout)
+	wsConn.conn.SetPongHandler(func(string) error {
+		wsConn.keepAlive(s.pongTimeout)
+		return nil
+	})
+
 	for {
 		_, mb, err := wsConn.ReadMessage()
 		if err != nil {
 			_ = wsConn.Close() // #nosec G703
-			s.logger.Error("read message error, breaking read loop", "error", err.Error())
+			s.logger.Debug("read message error, breaking read loop", "error", err.Error())
 			return
 		}
+		wsConn.keepAlive(s.pongTimeout)
+
+		if s.permissions != nil {
+			if err := s.permissions.CheckRequest(middleware.ListenerWS, wsConn.trusted, mb); err != nil {
+				s.sendErrResponse(wsConn, err.Error())
+				continue
+			}
+		}
+
+		if s.rpcMiddleware != nil {
+			if err := s.rpcMiddleware.AllowRequest(wsConn.ip, mb); err != nil {
+				s.sendErrResponse(wsConn, err.Error())
+				continue
+			}
+		}
 
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
@@ -239,6 +396,11 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				continue
 			}
 
+			if s.maxSubscriptions > 0 && len(subscriptions) >= s.maxSubscriptions {
+				s.sendErrResponse(wsConn, fmt.Sprintf("max number of subscriptions per connection reached: %d", s.maxSubscriptions))
+				continue
+			}
+
 			subID := rpc.NewID()
 			unsubFn, err := s.api.subscribe(wsConn, subID, params)
 			if err != nil {
@@ -318,6 +480,9 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if s.rpcMiddleware != nil {
+		s.rpcMiddleware.SetForwarded(req)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -373,7 +538,14 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		fullTx := false
+		if len(params) > 1 {
+			var err error
+			if fullTx, err = parseFullTxParam(params[1]); err != nil {
+				return nil, err
+			}
+		}
+		return api.subscribePendingTransactions(wsConn, subID, fullTx)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -381,11 +553,26 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 	}
 }
 
+// newSubscriptionDone returns the unsubscribe function of a subscription,
+// which removes it from the event bus and closes the returned channel to stop
+// the goroutine that forwards its events.
+func newSubscriptionDone(unsubFn pubsub.UnsubscribeFunc) (<-chan struct{}, pubsub.UnsubscribeFunc) {
+	done := make(chan struct{})
+	var once sync.Once
+	return done, func() {
+		once.Do(func() {
+			unsubFn()
+			close(done)
+		})
+	}
+}
+
 func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
 	sub, unsubFn, err := api.events.SubscribeNewHeads()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter")
 	}
+	done, unsubFn := newSubscriptionDone(unsubFn)
 
 	// TODO: use events
 	baseFee := big.NewInt(params.InitialBaseFee)
@@ -418,43 +605,24 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 					},
 				}
 
-				err = wsConn.WriteJSON(res)
-				if err != nil {
-					api.logger.Error("error writing header, will drop peer", "error", err.Error())
-
-					try(func() {
-						if err != websocket.ErrCloseSent {
-							_ = wsConn.Close() // #nosec G703
-						}
-					}, api.logger, "closing websocket peer sub")
+				if err := wsConn.WriteJSON(res); err != nil {
+					api.logger.Debug("error writing header, dropping subscription", "subscription-id", subID, "error", err.Error())
+					return
 				}
 			case err, ok := <-errCh:
 				if !ok {
 					return
 				}
 				api.logger.Debug("dropping NewHeads WebSocket subscription", "subscription-id", subID, "error", err.Error())
-			}
-		}
-	}()
-
-	return unsubFn, nil
-}
-
-func try(fn func(), l log.Logger, desc string) {
-	defer func() {
-		if x := recover(); x != nil {
-			if err, ok := x.(error); ok {
-				// debug.PrintStack()
-				l.Debug("panic during "+desc, "error", err.Error())
+			case <-done:
+				return
+			case <-wsConn.closed:
 				return
 			}
-
-			l.Debug(fmt.Sprintf("panic during %s: %+v", desc, x))
-			return
 		}
 	}()
 
-	fn()
+	return unsubFn, nil
 }
 
 func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
@@ -562,6 +730,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		api.logger.Error("failed to subscribe logs", "error", err.Error())
 		return nil, err
 	}
+	done, unsubFn := newSubscriptionDone(unsubFn)
 
 	go func() {
 		ch := sub.Event()
@@ -582,7 +751,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 				txResponse, err := evmtypes.DecodeTxResponse(dataTx.TxResult.Result.Data)
 				if err != nil {
 					api.logger.Error("failed to decode tx response", "error", err.Error())
-					return
+					continue
 				}
 
 				logs := rpcfilters.FilterLogs(evmtypes.LogsToEthereum(txResponse.Logs), crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
@@ -600,13 +769,9 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 						},
 					}
 
-					err = wsConn.WriteJSON(res)
-					if err != nil {
-						try(func() {
-							if err != websocket.ErrCloseSent {
-								_ = wsConn.Close() // #nosec G703
-							}
-						}, api.logger, "closing websocket peer sub")
+					if err := wsConn.WriteJSON(res); err != nil {
+						api.logger.Debug("error writing log, dropping subscription", "subscription-id", subID, "error", err.Error())
+						return
 					}
 				}
 			case err, ok := <-errCh:
@@ -614,6 +779,10 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 					return
 				}
 				api.logger.Debug("dropping Logs WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			case <-done:
+				return
+			case <-wsConn.closed:
+				return
 			}
 		}
 	}()
@@ -621,57 +790,123 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+// parseFullTxParam parses the extra parameter of the newPendingTransactions
+// subscription. It accepts either a boolean, as go-ethereum does, or an object
+// with a boolean fullTransactions field.
+func parseFullTxParam(extra interface{}) (bool, error) {
+	switch param := extra.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return param, nil
+	case map[string]interface{}:
+		fullTx, ok := param["fullTransactions"]
+		if !ok || fullTx == nil {
+			return false, nil
+		}
+		isFullTx, ok := fullTx.(bool)
+		if !ok {
+			return false, errors.Errorf("invalid fullTransactions parameter: %v", fullTx)
+		}
+		return isFullTx, nil
+	default:
+		return false, errors.Errorf("invalid newPendingTransactions parameter type %T", extra)
+	}
+}
+
+// pendingTxResults returns the subscription results of the Ethereum
+// transactions contained in the given raw transaction. The results are the
+// transaction hashes, or the full transaction objects when fullTx is true.
+// An error is returned if the raw transaction isn't an Ethereum transaction.
+func pendingTxResults(clientCtx client.Context, txBz cmttypes.Tx, fullTx bool, chainID *big.Int) ([]interface{}, error) {
+	ethTxs, err := types.RawTxToEthTx(clientCtx, txBz)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]interface{}, 0, len(ethTxs))
+	for _, ethTx := range ethTxs {
+		if !fullTx {
+			results = append(results, ethTx.Hash)
+			continue
+		}
+
+		rpcTx, err := types.NewTransactionFromMsg(ethTx, common.Hash{}, uint64(0), uint64(0), nil, chainID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build rpc transaction %s", ethTx.Hash)
+		}
+		results = append(results, rpcTx)
+	}
+
+	return results, nil
+}
+
+// subscribePendingTransactions streams the Ethereum transactions that enter
+// the mempool. The notifications contain the transaction hashes, or the full
+// transaction objects when fullTx is true.
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, fullTx bool) (pubsub.UnsubscribeFunc, error) {
+	var chainID *big.Int
+	if fullTx {
+		var err error
+		chainID, err = evmostypes.ParseChainID(api.clientCtx.ChainID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse chain id")
+		}
+	}
+
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
 	}
+	done, unsubFn := newSubscriptionDone(unsubFn)
 
 	go func() {
 		txsCh := sub.Event()
 		errCh := sub.Err()
 		for {
 			select {
-			case ev := <-txsCh:
+			case ev, ok := <-txsCh:
+				if !ok {
+					return
+				}
+
 				data, ok := ev.Data.(cmttypes.EventDataTx)
 				if !ok {
 					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
 					continue
 				}
 
-				ethTxs, err := types.RawTxToEthTx(api.clientCtx, data.Tx)
+				results, err := pendingTxResults(api.clientCtx, data.Tx, fullTx, chainID)
 				if err != nil {
 					// not ethereum tx
 					continue
 				}
 
-				for _, ethTx := range ethTxs {
+				for _, result := range results {
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethTx.Hash,
+							Result:       result,
 						},
 					}
 
-					err = wsConn.WriteJSON(res)
-					if err != nil {
-						api.logger.Debug("error writing header, will drop peer", "error", err.Error())
-
-						try(func() {
-							if err != websocket.ErrCloseSent {
-								_ = wsConn.Close() // #nosec G703
-							}
-						}, api.logger, "closing websocket peer sub")
+					if err := wsConn.WriteJSON(res); err != nil {
+						api.logger.Debug("error writing transaction, dropping subscription", "subscription-id", subID, "error", err.Error())
+						return
 					}
 				}
 			case err, ok := <-errCh:
 				if !ok {
 					return
 				}
-				api.logger.Debug("dropping PendingTransactions WebSocket subscription", subID, "error", err.Error())
+				api.logger.Debug("dropping PendingTransactions WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			case <-done:
+				return
+			case <-wsConn.closed:
+				return
 			}
 		}
 	}()
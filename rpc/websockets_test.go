@@ -0,0 +1,219 @@
+package rpc
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/encoding"
+	"github.com/evmos/evmos/v20/rpc/middleware"
+	"github.com/evmos/evmos/v20/rpc/types"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// dialWS connects a WebSocket client to the test server.
+func dialWS(t *testing.T, srv *httptest.Server) (*websocket.Conn, *http.Response, error) {
+	t.Helper()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+	return websocket.DefaultDialer.Dial(url, nil)
+}
+
+func TestWSConnSlowConsumer(t *testing.T) {
+	serverConns := make(chan *websocket.Conn, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		require.NoError(t, err)
+		serverConns <- conn
+	}))
+	defer srv.Close()
+
+	client, _, err := dialWS(t, srv)
+	require.NoError(t, err)
+	defer client.Close()
+
+	// without a writer, the queue isn't drained
+	wsConn := newWSConn(<-serverConns, 1, log.NewNopLogger())
+	require.NoError(t, wsConn.WriteJSON("first"))
+	require.ErrorIs(t, wsConn.WriteJSON("second"), errSlowConsumer)
+
+	select {
+	case <-wsConn.closed:
+	default:
+		t.Fatal("expected the slow consumer to be disconnected")
+	}
+	require.ErrorIs(t, wsConn.WriteJSON("third"), websocket.ErrCloseSent)
+
+	_, _, err = client.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseTryAgainLater), "unexpected error: %v", err)
+}
+
+func TestWebsocketsServerLimits(t *testing.T) {
+	s := &websocketsServer{
+		logger:         log.NewNopLogger(),
+		pingInterval:   50 * time.Millisecond,
+		pongTimeout:    time.Second,
+		maxConnections: 1,
+		sendQueueSize:  10,
+	}
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	client, _, err := dialWS(t, srv)
+	require.NoError(t, err)
+	defer client.Close()
+
+	// the client is pinged
+	pinged := make(chan struct{}, 1)
+	client.SetPingHandler(func(string) error {
+		select {
+		case pinged <- struct{}{}:
+		default:
+		}
+		return nil
+	})
+	go func() {
+		for {
+			if _, _, err := client.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-pinged:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the client to be pinged")
+	}
+
+	_, res, err := dialWS(t, srv)
+	require.Error(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+}
+
+func TestWebsocketsServerRateLimit(t *testing.T) {
+	// the requests are forwarded to the HTTP server through the middleware
+	rpcMiddleware := middleware.New(log.NewNopLogger(), middleware.NewRateLimiter(middleware.RateLimitConfig{PerIP: 1}), nil, "")
+	rpcSrv := httptest.NewServer(rpcMiddleware.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	})))
+	defer rpcSrv.Close()
+
+	s := &websocketsServer{
+		rpcAddr:       strings.TrimPrefix(rpcSrv.URL, "http://"),
+		logger:        log.NewNopLogger(),
+		rpcMiddleware: rpcMiddleware,
+		sendQueueSize: 10,
+	}
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	client, _, err := dialWS(t, srv)
+	require.NoError(t, err)
+	defer client.Close()
+
+	request := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`)
+
+	// the first request is limited once, with the IP of the websocket client
+	require.NoError(t, client.WriteMessage(websocket.TextMessage, request))
+	_, res, err := client.ReadMessage()
+	require.NoError(t, err)
+	require.Contains(t, string(res), `"result":"0x1"`)
+
+	require.NoError(t, client.WriteMessage(websocket.TextMessage, request))
+	_, res, err = client.ReadMessage()
+	require.NoError(t, err)
+	require.Contains(t, string(res), "request rate limit")
+}
+
+func TestParseFullTxParam(t *testing.T) {
+	testCases := []struct {
+		name      string
+		extra     interface{}
+		expFullTx bool
+		expErr    bool
+	}{
+		{"nil param", nil, false, false},
+		{"boolean true", true, true, false},
+		{"boolean false", false, false, false},
+		{"object with fullTransactions true", map[string]interface{}{"fullTransactions": true}, true, false},
+		{"object with fullTransactions false", map[string]interface{}{"fullTransactions": false}, false, false},
+		{"object without fullTransactions", map[string]interface{}{}, false, false},
+		{"object with null fullTransactions", map[string]interface{}{"fullTransactions": nil}, false, false},
+		{"object with non boolean fullTransactions", map[string]interface{}{"fullTransactions": "true"}, false, true},
+		{"invalid param type", "true", false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fullTx, err := parseFullTxParam(tc.extra)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expFullTx, fullTx)
+		})
+	}
+}
+
+func TestPendingTxResults(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	evmtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	clientCtx := client.Context{}.WithTxConfig(encCfg.TxConfig)
+
+	chainID := big.NewInt(9001)
+	from, priv := utiltx.NewAddrKey()
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  chainID,
+		Nonce:    3,
+		To:       &to,
+		Amount:   big.NewInt(10),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(1),
+	})
+	msg.From = from.Hex()
+	require.NoError(t, msg.Sign(ethtypes.LatestSignerForChainID(chainID), utiltx.NewSigner(priv)))
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBz, err := encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	hash := msg.AsTransaction().Hash()
+
+	// the hashes are streamed by default
+	results, err := pendingTxResults(clientCtx, txBz, false, chainID)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{hash.Hex()}, results)
+
+	// the full transactions are streamed with fullTx
+	results, err = pendingTxResults(clientCtx, txBz, true, chainID)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	rpcTx, ok := results[0].(*types.RPCTransaction)
+	require.True(t, ok, "unexpected result type %T", results[0])
+	require.Equal(t, hash, rpcTx.Hash)
+	require.Equal(t, from, rpcTx.From)
+	require.Equal(t, &to, rpcTx.To)
+	require.Equal(t, uint64(3), uint64(rpcTx.Nonce))
+	require.Nil(t, rpcTx.BlockHash, "pending transactions aren't included in a block")
+
+	// non ethereum transactions are skipped
+	_, err = pendingTxResults(clientCtx, []byte("not a transaction"), true, chainID)
+	require.Error(t, err)
+}
@@ -6,11 +6,13 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
 
-	"github.com/cometbft/cometbft/libs/strings"
+	cmtstrings "github.com/cometbft/cometbft/libs/strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -70,6 +72,18 @@ const (
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultLiveTracerRetainBlocks is the default number of recent blocks whose call traces are
+	// kept by the EVM live tracer
+	DefaultLiveTracerRetainBlocks uint64 = 10000
+
+	// DefaultMempoolPriceBump is the default percentage by which the fees of a pending eth tx
+	// must be bumped to replace it on the app-side mempool
+	DefaultMempoolPriceBump uint64 = 10
+
+	// DefaultMempoolMaxTxs is the default max number of txs of the app-side mempool, which matches
+	// the default size of the CometBFT mempool
+	DefaultMempoolMaxTxs = 5000
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25000000
 
@@ -82,6 +96,52 @@ const (
 	// DefaultFeeHistoryCap is the default cap for total number of blocks that can be fetched
 	DefaultFeeHistoryCap int32 = 100
 
+	// DefaultFeeHistoryMaxRange is the default max distance between the latest block
+	// and the oldest block that can be fetched with 'eth_feeHistory' (0 = unlimited)
+	DefaultFeeHistoryMaxRange int32 = 0
+
+	// DefaultTraceBlockConcurrency is the default number of transactions traced concurrently
+	// by 'debug_traceBlockByNumber' and 'debug_traceBlockByHash' (0 = sequential)
+	DefaultTraceBlockConcurrency int32 = 0
+
+	// DefaultTraceFilterBlockRangeCap is the default cap of block range allowed for 'trace_filter' query
+	DefaultTraceFilterBlockRangeCap int32 = 100
+
+	// DefaultRateLimitPerIP is the default number of JSON-RPC requests per second allowed
+	// for each client IP (0 = unlimited)
+	DefaultRateLimitPerIP float64 = 0
+
+	// DefaultRateLimitBurst is the default max number of JSON-RPC requests allowed at once
+	// for each client IP (0 = the per-IP rate rounded up)
+	DefaultRateLimitBurst = 0
+
+	// DefaultRateLimitGasPerIP is the default gas per second that each client IP can request
+	// through 'eth_call' and 'eth_estimateGas' (0 = unlimited)
+	DefaultRateLimitGasPerIP uint64 = 0
+
+	// DefaultWSPingInterval is the default interval of the pings sent to the WebSocket clients
+	DefaultWSPingInterval = 30 * time.Second
+
+	// DefaultWSPongTimeout is the default time after which a WebSocket client that didn't
+	// reply to the pings nor sent any message is disconnected
+	DefaultWSPongTimeout = 60 * time.Second
+
+	// DefaultWSMaxConnections is the default max number of concurrent WebSocket
+	// connections (0 = unlimited)
+	DefaultWSMaxConnections = 0
+
+	// DefaultWSMaxSubscriptions is the default max number of subscriptions of a WebSocket
+	// connection (0 = unlimited)
+	DefaultWSMaxSubscriptions = 100
+
+	// DefaultWSSendQueueSize is the default number of messages queued for a WebSocket client
+	// before it's disconnected as a slow consumer
+	DefaultWSSendQueueSize = 1024
+
+	// DefaultBloomSectionSize is the default number of blocks of the sections of the
+	// bloom-bits index maintained by the EVM indexer
+	DefaultBloomSectionSize uint64 = 4096
+
 	// DefaultLogsCap is the default cap of results returned from single 'eth_getLogs' query
 	DefaultLogsCap int32 = 10000
 
@@ -176,6 +236,38 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// GasReport enables the breakdown of the gas consumed by each eth tx into intrinsic, EVM
+	// execution and precompile gas, reported on the tx events, receipts and eth_call responses.
+	GasReport bool `mapstructure:"gas-report"`
+	// Telemetry enables the per-block telemetry of the eth txs execution: the gas consumed by
+	// opcode class, the precompile calls and the ratio of reverted txs.
+	Telemetry bool `mapstructure:"telemetry"`
+	// LiveTracer enables the persistence of the call traces of the eth txs as the blocks
+	// execute, which are served by debug_traceTransaction without re-executing the txs.
+	LiveTracer bool `mapstructure:"live-tracer"`
+	// LiveTracerRetainBlocks defines the number of recent blocks whose call traces are kept
+	// by the live tracer. All the traces are kept if zero.
+	LiveTracerRetainBlocks uint64 `mapstructure:"live-tracer-retain-blocks"`
+	// ParallelExecutionWorkers defines the number of workers of the experimental parallel
+	// pre-execution of the eth txs of a block. It's disabled if zero, or if the telemetry or the
+	// live tracer are enabled.
+	ParallelExecutionWorkers int `mapstructure:"parallel-execution-workers"`
+	// EventStream enables the gRPC SubscribeEvents query, which streams the receipts and logs
+	// of the eth txs of the committed blocks to the subscribers.
+	EventStream bool `mapstructure:"event-stream"`
+	// Mempool enables the app-side mempool, which keeps the txs of each sender in nonce order
+	// and allows replacing a pending eth tx by a tx with the same nonce and higher fees.
+	Mempool bool `mapstructure:"mempool"`
+	// MempoolPriceBump defines the percentage by which the tip cap and fee cap of a pending eth
+	// tx must be bumped to replace it on the app-side mempool.
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
+	// MempoolMaxTxs defines the max number of txs of the app-side mempool. A value of 0 disables
+	// the limit.
+	MempoolMaxTxs int `mapstructure:"mempool-max-txs"`
+	// MempoolPriorityMsgTypes defines the type URLs of the Cosmos messages of the priority lane,
+	// whose txs are ranked above the rest of the txs, including the eth ones, on CheckTx. It
+	// requires the app-side mempool, as CometBFT ignores the CheckTx priority.
+	MempoolPriorityMsgTypes []string `mapstructure:"mempool-priority-msg-types"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -186,6 +278,21 @@ type JSONRPCConfig struct {
 	Address string `mapstructure:"address"`
 	// WsAddress defines the WebSocket server to listen on
 	WsAddress string `mapstructure:"ws-address"`
+	// WSPingInterval is the interval of the pings sent to the WebSocket clients. A value of 0
+	// disables the pings and the client timeout.
+	WSPingInterval time.Duration `mapstructure:"ws-ping-interval"`
+	// WSPongTimeout is the time after which a WebSocket client that didn't reply to the pings nor
+	// sent any message is disconnected.
+	WSPongTimeout time.Duration `mapstructure:"ws-pong-timeout"`
+	// WSMaxConnections is the max number of concurrent WebSocket connections. A value of 0 means
+	// no limit.
+	WSMaxConnections int `mapstructure:"ws-max-connections"`
+	// WSMaxSubscriptions is the max number of subscriptions of a WebSocket connection. A value of 0
+	// means no limit.
+	WSMaxSubscriptions int `mapstructure:"ws-max-subscriptions"`
+	// WSSendQueueSize is the number of messages queued for a WebSocket client. The clients that
+	// fall further behind are disconnected.
+	WSSendQueueSize int `mapstructure:"ws-send-queue-size"`
 	// GasCap is the global gas cap for eth-call variants.
 	GasCap uint64 `mapstructure:"gas-cap"`
 	// AllowInsecureUnlock toggles if account unlocking is enabled when account-related RPCs are exposed by http.
@@ -198,6 +305,51 @@ type JSONRPCConfig struct {
 	FilterCap int32 `mapstructure:"filter-cap"`
 	// FeeHistoryCap is the global cap for total number of blocks that can be fetched
 	FeeHistoryCap int32 `mapstructure:"feehistory-cap"`
+	// FeeHistoryMaxRange is the max distance between the latest block and the oldest
+	// block that can be fetched with `eth_feeHistory`. A value of 0 disables the limit.
+	FeeHistoryMaxRange int32 `mapstructure:"feehistory-max-range"`
+	// TraceBlockConcurrency is the max number of transactions of a block traced concurrently,
+	// each one on an isolated snapshot of the state. Values lower than 2 trace them sequentially.
+	TraceBlockConcurrency int32 `mapstructure:"trace-block-concurrency"`
+	// TraceFilterBlockRangeCap defines the max block range allowed for `trace_filter` query, as
+	// all the blocks of the range are re-executed.
+	TraceFilterBlockRangeCap int32 `mapstructure:"trace-filter-block-range-cap"`
+	// RateLimitPerIP is the number of requests per second allowed for each client IP.
+	// A value of 0 disables the limit.
+	RateLimitPerIP float64 `mapstructure:"rate-limit-per-ip"`
+	// RateLimitBurst is the max number of requests allowed at once for each client IP.
+	// A value of 0 uses the per-IP rate rounded up.
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// MethodRateLimits defines the requests per second allowed for each client IP on specific
+	// methods, as 'method:rate' entries. A 'namespace_*' method matches the whole namespace.
+	MethodRateLimits []string `mapstructure:"method-rate-limits"`
+	// RateLimitGasPerIP is the gas per second that each client IP can request through
+	// `eth_call` and `eth_estimateGas`. A value of 0 disables the limit.
+	RateLimitGasPerIP uint64 `mapstructure:"rate-limit-gas-per-ip"`
+	// RateLimitIPHeader is the header set by a trusted reverse proxy to identify the client IP.
+	// When empty, the remote address of the connection is used.
+	RateLimitIPHeader string `mapstructure:"rate-limit-ip-header"`
+	// HTTPAllowedMethods are the only methods permitted to the remote clients of the HTTP server.
+	// An empty list permits all of them. A 'namespace_*' method matches the whole namespace.
+	HTTPAllowedMethods []string `mapstructure:"http-allowed-methods"`
+	// HTTPDeniedMethods are the methods rejected for the remote clients of the HTTP server.
+	HTTPDeniedMethods []string `mapstructure:"http-denied-methods"`
+	// WSAllowedMethods are the only methods permitted to the remote clients of the WebSocket server.
+	// An empty list permits all of them. A 'namespace_*' method matches the whole namespace.
+	WSAllowedMethods []string `mapstructure:"ws-allowed-methods"`
+	// WSDeniedMethods are the methods rejected for the remote clients of the WebSocket server.
+	WSDeniedMethods []string `mapstructure:"ws-denied-methods"`
+	// LocalAllowedMethods are the only methods permitted to the local and authenticated clients.
+	// An empty list permits all of them. A 'namespace_*' method matches the whole namespace.
+	LocalAllowedMethods []string `mapstructure:"local-allowed-methods"`
+	// LocalDeniedMethods are the methods rejected for the local and authenticated clients.
+	LocalDeniedMethods []string `mapstructure:"local-denied-methods"`
+	// AuthToken authenticates the clients sending it as a bearer token, which are then treated
+	// as local clients. It's disabled if empty.
+	AuthToken string `mapstructure:"auth-token"`
+	// TrustLocalClients treats the clients connected from a loopback address as local clients.
+	// It must be disabled when a reverse proxy on the same host forwards the remote clients.
+	TrustLocalClients bool `mapstructure:"trust-local-clients"`
 	// Enable defines if the EVM RPC server should be enabled.
 	Enable bool `mapstructure:"enable"`
 	// LogsCap defines the max number of results can be returned from single `eth_getLogs` query.
@@ -216,10 +368,25 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// IndexerBackfill defines if the custom indexer indexes the historical blocks
+	// available in the node in background on startup.
+	IndexerBackfill bool `mapstructure:"indexer-backfill"`
+	// IndexerRetainBlocks is the number of recent blocks kept by the custom indexer.
+	// The entries of older blocks are pruned. A value of 0 keeps all the blocks.
+	IndexerRetainBlocks uint64 `mapstructure:"indexer-retain-blocks"`
+	// BloomSectionSize is the number of blocks of the sections of the bloom-bits index
+	// maintained by the custom indexer to accelerate `eth_getLogs`.
+	BloomSectionSize uint64 `mapstructure:"bloom-section-size"`
+	// BloomBackfill defines if the custom indexer builds the bloom-bits index of the
+	// past blocks on startup.
+	BloomBackfill bool `mapstructure:"bloom-backfill"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// ArchiveGRPCAddress defines the gRPC address of an archive node, which serves the
+	// state queries at the heights pruned by this node. It's disabled if empty.
+	ArchiveGRPCAddress string `mapstructure:"archive-grpc-address"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -302,17 +469,40 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:                 DefaultEVMTracer,
+		MaxTxGasWanted:         DefaultMaxTxGasWanted,
+		LiveTracerRetainBlocks: DefaultLiveTracerRetainBlocks,
+		MempoolPriceBump:       DefaultMempoolPriceBump,
+		MempoolMaxTxs:          DefaultMempoolMaxTxs,
 	}
 }
 
-// Validate returns an error if the tracer type is invalid.
+// Validate returns an error if the tracer type, the number of parallel
+// execution workers, the mempool max txs or the mempool priority msg types
+// are invalid.
 func (c EVMConfig) Validate() error {
-	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
+	if c.Tracer != "" && !cmtstrings.StringInSlice(c.Tracer, evmTracers) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.ParallelExecutionWorkers < 0 {
+		return fmt.Errorf("parallel execution workers cannot be negative: %d", c.ParallelExecutionWorkers)
+	}
+
+	if c.MempoolMaxTxs < 0 {
+		return fmt.Errorf("mempool max txs cannot be negative: %d", c.MempoolMaxTxs)
+	}
+
+	if len(c.MempoolPriorityMsgTypes) > 0 && !c.Mempool {
+		return errors.New("mempool priority msg types require the app-side mempool to be enabled")
+	}
+
+	for _, typeURL := range c.MempoolPriorityMsgTypes {
+		if !strings.HasPrefix(typeURL, "/") {
+			return fmt.Errorf("invalid mempool priority msg type %q, expected a type URL like /cosmos.gov.v1.MsgVote", typeURL)
+		}
+	}
+
 	return nil
 }
 
@@ -323,7 +513,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "admin"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -333,12 +523,31 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		API:                      GetDefaultAPINamespaces(),
 		Address:                  DefaultJSONRPCAddress,
 		WsAddress:                DefaultJSONRPCWsAddress,
+		WSPingInterval:           DefaultWSPingInterval,
+		WSPongTimeout:            DefaultWSPongTimeout,
+		WSMaxConnections:         DefaultWSMaxConnections,
+		WSMaxSubscriptions:       DefaultWSMaxSubscriptions,
+		WSSendQueueSize:          DefaultWSSendQueueSize,
 		GasCap:                   DefaultGasCap,
 		AllowInsecureUnlock:      DefaultJSONRPCAllowInsecureUnlock,
 		EVMTimeout:               DefaultEVMTimeout,
 		TxFeeCap:                 DefaultTxFeeCap,
 		FilterCap:                DefaultFilterCap,
 		FeeHistoryCap:            DefaultFeeHistoryCap,
+		FeeHistoryMaxRange:       DefaultFeeHistoryMaxRange,
+		TraceBlockConcurrency:    DefaultTraceBlockConcurrency,
+		TraceFilterBlockRangeCap: DefaultTraceFilterBlockRangeCap,
+		RateLimitPerIP:           DefaultRateLimitPerIP,
+		RateLimitBurst:           DefaultRateLimitBurst,
+		MethodRateLimits:         []string{},
+		RateLimitGasPerIP:        DefaultRateLimitGasPerIP,
+		HTTPAllowedMethods:       []string{},
+		HTTPDeniedMethods:        []string{},
+		WSAllowedMethods:         []string{},
+		WSDeniedMethods:          []string{},
+		LocalAllowedMethods:      []string{},
+		LocalDeniedMethods:       []string{},
+		TrustLocalClients:        false,
 		BlockRangeCap:            DefaultBlockRangeCap,
 		LogsCap:                  DefaultLogsCap,
 		HTTPTimeout:              DefaultHTTPTimeout,
@@ -346,6 +555,10 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
+		IndexerBackfill:          false,
+		IndexerRetainBlocks:      0,
+		BloomSectionSize:         DefaultBloomSectionSize,
+		BloomBackfill:            false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 	}
@@ -365,6 +578,70 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC feehistory-cap cannot be negative or 0")
 	}
 
+	if c.FeeHistoryMaxRange < 0 {
+		return errors.New("JSON-RPC feehistory-max-range cannot be negative")
+	}
+
+	if c.TraceBlockConcurrency < 0 {
+		return errors.New("JSON-RPC trace-block-concurrency cannot be negative")
+	}
+
+	if c.TraceFilterBlockRangeCap < 0 {
+		return errors.New("JSON-RPC trace-filter-block-range-cap cannot be negative")
+	}
+
+	if c.WSPingInterval < 0 {
+		return errors.New("JSON-RPC ws-ping-interval cannot be negative")
+	}
+
+	if c.WSPingInterval > 0 && c.WSPongTimeout <= c.WSPingInterval {
+		return errors.New("JSON-RPC ws-pong-timeout must be greater than ws-ping-interval")
+	}
+
+	if c.WSMaxConnections < 0 {
+		return errors.New("JSON-RPC ws-max-connections cannot be negative")
+	}
+
+	if c.WSMaxSubscriptions < 0 {
+		return errors.New("JSON-RPC ws-max-subscriptions cannot be negative")
+	}
+
+	if c.WSSendQueueSize <= 0 {
+		return errors.New("JSON-RPC ws-send-queue-size must be positive")
+	}
+
+	if c.RateLimitPerIP < 0 {
+		return errors.New("JSON-RPC rate-limit-per-ip cannot be negative")
+	}
+
+	if c.RateLimitBurst < 0 {
+		return errors.New("JSON-RPC rate-limit-burst cannot be negative")
+	}
+
+	if _, err := ParseMethodRateLimits(c.MethodRateLimits); err != nil {
+		return err
+	}
+
+	for _, list := range []struct {
+		name    string
+		methods []string
+	}{
+		{"http-allowed-methods", c.HTTPAllowedMethods},
+		{"http-denied-methods", c.HTTPDeniedMethods},
+		{"ws-allowed-methods", c.WSAllowedMethods},
+		{"ws-denied-methods", c.WSDeniedMethods},
+		{"local-allowed-methods", c.LocalAllowedMethods},
+		{"local-denied-methods", c.LocalDeniedMethods},
+	} {
+		if err := validateMethods(list.methods); err != nil {
+			return fmt.Errorf("JSON-RPC %s: %w", list.name, err)
+		}
+	}
+
+	if c.BloomSectionSize == 0 || c.BloomSectionSize%8 != 0 {
+		return errors.New("JSON-RPC bloom-section-size must be a positive multiple of 8")
+	}
+
 	if c.TxFeeCap < 0 {
 		return errors.New("JSON-RPC tx fee cap cannot be negative")
 	}
@@ -402,6 +679,42 @@ func (c JSONRPCConfig) Validate() error {
 	return nil
 }
 
+// ParseMethodRateLimits parses the 'method:rate' entries of the method-rate-limits
+// configuration into a map of requests per second keyed by method.
+func ParseMethodRateLimits(entries []string) (map[string]float64, error) {
+	limits := make(map[string]float64, len(entries))
+	for _, entry := range entries {
+		method, limit, found := strings.Cut(entry, ":")
+		method = strings.TrimSpace(method)
+		if !found || method == "" {
+			return nil, fmt.Errorf("invalid JSON-RPC method rate limit '%s', expected 'method:rate'", entry)
+		}
+
+		rate, err := strconv.ParseFloat(strings.TrimSpace(limit), 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid JSON-RPC method rate limit '%s', rate must be a positive number", entry)
+		}
+
+		if _, ok := limits[method]; ok {
+			return nil, fmt.Errorf("repeated JSON-RPC method rate limit for '%s'", method)
+		}
+		limits[method] = rate
+	}
+	return limits, nil
+}
+
+// validateMethods checks that the entries of a method list are 'namespace_method'
+// or 'namespace_*' methods.
+func validateMethods(methods []string) error {
+	for _, method := range methods {
+		namespace, name, found := strings.Cut(method, "_")
+		if !found || namespace == "" || name == "" || strings.ContainsAny(method, " \t") {
+			return fmt.Errorf("invalid method '%s', expected 'namespace_method' or 'namespace_*'", method)
+		}
+	}
+	return nil
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -68,3 +68,15 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestEVMConfigValidateMempool(t *testing.T) {
+	cfg := DefaultEVMConfig()
+	cfg.MempoolPriorityMsgTypes = []string{"/cosmos.gov.v1.MsgVote"}
+	require.ErrorContains(t, cfg.Validate(), "require the app-side mempool")
+
+	cfg.Mempool = true
+	require.NoError(t, cfg.Validate())
+
+	cfg.MempoolMaxTxs = -1
+	require.ErrorContains(t, cfg.Validate(), "mempool max txs cannot be negative")
+}
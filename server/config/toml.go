@@ -18,6 +18,56 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# GasReport enables the breakdown of the gas consumed by each eth tx into intrinsic, EVM execution
+# and precompile gas. The breakdown is emitted as an event, so it's not part of the consensus, and
+# it's added to the tx receipts and eth_call responses served by this node.
+gas-report = {{ .EVM.GasReport }}
+
+# Telemetry enables the per-block telemetry of the eth txs execution: the gas consumed by opcode
+# class, the number of calls to each precompile and the ratio of reverted txs. The metrics are
+# exposed with the node telemetry, so it must be enabled in the [telemetry] section as well.
+telemetry = {{ .EVM.Telemetry }}
+
+# LiveTracer enables the persistence of the call traces (callTracer format) of the eth txs to a local
+# database as the blocks execute, so that debug_traceTransaction serves them without re-executing
+# the txs. The traces of a block are persisted when it is committed. The traces aren't persisted
+# if a tracer is set above.
+live-tracer = {{ .EVM.LiveTracer }}
+
+# LiveTracerRetainBlocks defines the number of recent blocks whose call traces are kept by the live
+# tracer. All the traces are kept if zero.
+live-tracer-retain-blocks = {{ .EVM.LiveTracerRetainBlocks }}
+
+# ParallelExecutionWorkers defines the number of workers of the experimental parallel pre-execution
+# of the eth txs of a block. The result of a pre-executed tx is only used on its delivery if the
+# state it read is unchanged, otherwise the tx is executed sequentially. It's disabled if zero, or
+# if the telemetry or the live tracer are enabled, as the pre-executed txs aren't traced.
+parallel-execution-workers = {{ .EVM.ParallelExecutionWorkers }}
+
+# EventStream enables the SubscribeEvents query of the gRPC server, which streams the receipts and
+# decoded logs of the eth txs of the committed blocks to the subscribers of their addresses and
+# topics, without running an indexer on top of the JSON-RPC server.
+event-stream = {{ .EVM.EventStream }}
+
+# Mempool enables the app-side mempool, which keeps the txs of each sender in nonce order and selects
+# the txs of the block proposals by priority. A pending eth tx can be replaced by a tx of the same
+# sender and nonce that bumps its tip cap and fee cap, so that the stuck txs can be replaced.
+mempool = {{ .EVM.Mempool }}
+
+# MempoolPriceBump defines the percentage by which the tip cap and fee cap of a pending eth tx must
+# be bumped to replace it on the app-side mempool.
+mempool-price-bump = {{ .EVM.MempoolPriceBump }}
+
+# MempoolMaxTxs defines the max number of txs of the app-side mempool. The txs are evicted once their
+# nonce is lower than the account sequence of their sender. A value of 0 disables the limit.
+mempool-max-txs = {{ .EVM.MempoolMaxTxs }}
+
+# MempoolPriorityMsgTypes defines the comma separated type URLs of the Cosmos messages of the priority
+# lane (e.g. "/cosmos.gov.v1.MsgVote"). The txs whose messages are all of these types are ranked above
+# the rest of the txs, including the eth ones, so that they are selected first by the app-side mempool.
+# It requires 'mempool = true', as CometBFT ignores the priority of the txs.
+mempool-priority-msg-types = "{{range $index, $elmt := .EVM.MempoolPriorityMsgTypes}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -33,6 +83,24 @@ address = "{{ .JSONRPC.Address }}"
 # Address defines the EVM WebSocket server address to bind to.
 ws-address = "{{ .JSONRPC.WsAddress }}"
 
+# WSPingInterval sets the interval of the pings sent to the WebSocket clients to keep the
+# connections alive. A value of 0 disables the pings and the client timeout.
+ws-ping-interval = "{{ .JSONRPC.WSPingInterval }}"
+
+# WSPongTimeout sets the time after which a WebSocket client that didn't reply to the pings
+# nor sent any message is disconnected. It must be greater than the ping interval.
+ws-pong-timeout = "{{ .JSONRPC.WSPongTimeout }}"
+
+# WSMaxConnections sets the max number of concurrent WebSocket connections (0 = unlimited).
+ws-max-connections = {{ .JSONRPC.WSMaxConnections }}
+
+# WSMaxSubscriptions sets the max number of subscriptions of a WebSocket connection (0 = unlimited).
+ws-max-subscriptions = {{ .JSONRPC.WSMaxSubscriptions }}
+
+# WSSendQueueSize sets the number of messages queued for a WebSocket client. The clients that
+# don't keep up with their subscriptions are disconnected once their queue is full.
+ws-send-queue-size = {{ .JSONRPC.WSSendQueueSize }}
+
 # API defines a list of JSON-RPC namespaces that should be enabled
 # Example: "eth,txpool,personal,net,debug,web3"
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
@@ -55,6 +123,68 @@ filter-cap = {{ .JSONRPC.FilterCap }}
 # FeeHistoryCap sets the global cap for total number of blocks that can be fetched
 feehistory-cap = {{ .JSONRPC.FeeHistoryCap }}
 
+# FeeHistoryMaxRange sets the max distance between the latest block and the oldest block
+# that can be fetched with 'eth_feeHistory'. A value of 0 disables the limit.
+feehistory-max-range = {{ .JSONRPC.FeeHistoryMaxRange }}
+
+# TraceBlockConcurrency sets the max number of transactions of a block traced concurrently by
+# 'debug_traceBlockByNumber' and 'debug_traceBlockByHash'. Each transaction is traced on an isolated
+# snapshot of the state before its execution. Values lower than 2 trace them sequentially.
+trace-block-concurrency = {{ .JSONRPC.TraceBlockConcurrency }}
+
+# TraceFilterBlockRangeCap defines the max block range allowed for 'trace_filter' query. All the
+# blocks of the range are re-executed, bounded by the EVM timeout.
+trace-filter-block-range-cap = {{ .JSONRPC.TraceFilterBlockRangeCap }}
+
+# RateLimitPerIP sets the number of JSON-RPC requests per second allowed for each client IP.
+# Every request of a batch counts. A value of 0 disables the limit.
+rate-limit-per-ip = {{ .JSONRPC.RateLimitPerIP }}
+
+# RateLimitBurst sets the max number of JSON-RPC requests allowed at once for each client IP.
+# A value of 0 uses the per-IP rate rounded up.
+rate-limit-burst = {{ .JSONRPC.RateLimitBurst }}
+
+# MethodRateLimits sets the requests per second allowed for each client IP on specific methods,
+# as comma separated 'method:rate' entries. A 'namespace_*' method matches the whole namespace,
+# e.g. "debug_*:1,eth_getLogs:5".
+method-rate-limits = "{{range $index, $elmt := .JSONRPC.MethodRateLimits}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# RateLimitGasPerIP sets the gas per second that each client IP can request through 'eth_call' and
+# 'eth_estimateGas'. Calls without a gas limit count as the gas cap. A value of 0 disables the limit.
+rate-limit-gas-per-ip = {{ .JSONRPC.RateLimitGasPerIP }}
+
+# RateLimitIPHeader sets the header used to identify the client IP when the node runs behind a
+# trusted reverse proxy (e.g. "X-Forwarded-For"). When empty, the connection address is used.
+rate-limit-ip-header = "{{ .JSONRPC.RateLimitIPHeader }}"
+
+# HTTPAllowedMethods and HTTPDeniedMethods set the comma separated methods permitted to and rejected
+# for the remote clients of the HTTP server. An empty allowed list permits all the methods and the
+# denied methods have precedence. A 'namespace_*' method matches the whole namespace,
+# e.g. "debug_*,personal_*".
+http-allowed-methods = "{{range $index, $elmt := .JSONRPC.HTTPAllowedMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+http-denied-methods = "{{range $index, $elmt := .JSONRPC.HTTPDeniedMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# WSAllowedMethods and WSDeniedMethods set the methods permitted to and rejected for the remote
+# clients of the WebSocket server.
+ws-allowed-methods = "{{range $index, $elmt := .JSONRPC.WSAllowedMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+ws-denied-methods = "{{range $index, $elmt := .JSONRPC.WSDeniedMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# LocalAllowedMethods and LocalDeniedMethods set the methods permitted to and rejected for the
+# local clients of both servers, which are the authenticated clients and, if trusted, the clients
+# connected from a loopback address. The 'admin' namespace is only available to these clients.
+local-allowed-methods = "{{range $index, $elmt := .JSONRPC.LocalAllowedMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+local-denied-methods = "{{range $index, $elmt := .JSONRPC.LocalDeniedMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# AuthToken sets the token that authenticates the clients sending it in an
+# 'Authorization: Bearer <token>' header, which are then treated as local clients.
+# The authentication is disabled if empty.
+auth-token = "{{ .JSONRPC.AuthToken }}"
+
+# TrustLocalClients treats the clients connected from a loopback address as local clients, without
+# authentication. Keep it disabled when a reverse proxy on the same host forwards remote clients,
+# as they would all connect from a loopback address. The client IP headers are never trusted.
+trust-local-clients = {{ .JSONRPC.TrustLocalClients }}
+
 # LogsCap defines the max number of results can be returned from single 'eth_getLogs' query.
 logs-cap = {{ .JSONRPC.LogsCap }}
 
@@ -78,6 +208,22 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# IndexerBackfill enables indexing in background, on startup, the historical blocks available in the
+# node that are not indexed yet. The backfill resumes where it stopped on restart.
+indexer-backfill = {{ .JSONRPC.IndexerBackfill }}
+
+# IndexerRetainBlocks defines the number of recent blocks kept by the custom indexer. The entries of
+# older blocks are pruned periodically. A value of 0 keeps all the blocks.
+indexer-retain-blocks = {{ .JSONRPC.IndexerRetainBlocks }}
+
+# BloomSectionSize defines the number of blocks of the sections of the bloom-bits index maintained by
+# the custom indexer to accelerate 'eth_getLogs' over wide block ranges. It must be a multiple of 8 and
+# can't be changed once the index is built.
+bloom-section-size = {{ .JSONRPC.BloomSectionSize }}
+
+# BloomBackfill enables building the bloom-bits index of the past blocks on startup.
+bloom-backfill = {{ .JSONRPC.BloomBackfill }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -85,6 +231,11 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# ArchiveGRPCAddress defines the gRPC address of an archive node used to serve 'eth_call',
+# 'eth_getBalance' and 'eth_getStorageAt' at the heights whose state was pruned by this node.
+# These queries fail with an error at the pruned heights if it's empty.
+archive-grpc-address = "{{ .JSONRPC.ArchiveGRPCAddress }}"
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -58,12 +58,40 @@ const (
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
 	JSONRPCEnableMetrics            = "metrics"
 	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
+	// JSONRPCArchiveGRPCAddress defines the gRPC address of the archive node
+	// serving the state queries at the heights pruned by the node.
+	JSONRPCArchiveGRPCAddress = "json-rpc.archive-grpc-address"
 )
 
 // EVM flags
 const (
 	EVMTracer         = "evm.tracer"
 	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMGasReport      = "evm.gas-report"
+	EVMTelemetry      = "evm.telemetry"
+	// EVMLiveTracer defines if the call traces of the eth txs are persisted as
+	// the blocks execute.
+	EVMLiveTracer = "evm.live-tracer"
+	// EVMLiveTracerRetainBlocks defines the number of recent blocks whose call
+	// traces are kept by the live tracer.
+	EVMLiveTracerRetainBlocks = "evm.live-tracer-retain-blocks"
+	// EVMParallelExecutionWorkers defines the number of workers of the
+	// experimental parallel pre-execution of the block eth txs.
+	EVMParallelExecutionWorkers = "evm.parallel-execution-workers"
+	// EVMEventStream defines if the receipts and logs of the eth txs of the
+	// committed blocks are streamed to the gRPC subscribers.
+	EVMEventStream = "evm.event-stream"
+	// EVMMempool defines if the app-side mempool, which allows replacing the
+	// pending eth txs by fee bump, is enabled.
+	EVMMempool = "evm.mempool"
+	// EVMMempoolPriceBump defines the percentage by which the fees of a pending
+	// eth tx must be bumped to replace it.
+	EVMMempoolPriceBump = "evm.mempool-price-bump"
+	// EVMMempoolMaxTxs defines the max number of txs of the app-side mempool.
+	EVMMempoolMaxTxs = "evm.mempool-max-txs"
+	// EVMMempoolPriorityMsgTypes defines the type URLs of the Cosmos messages
+	// of the priority lane.
+	EVMMempoolPriorityMsgTypes = "evm.mempool-priority-msg-types"
 )
 
 // TLS flags
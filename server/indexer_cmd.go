@@ -4,6 +4,7 @@ package server
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -13,19 +14,31 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/evmos/evmos/v20/indexer"
+	"github.com/evmos/evmos/v20/server/config"
+)
+
+const (
+	// flagRetainHeight is the flag of the lowest height kept in the indexer
+	flagRetainHeight = "retain-height"
+
+	// progressReportInterval is the min interval between two progress reports
+	progressReportInterval = 5 * time.Second
 )
 
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "index-eth-tx [backward|forward]",
-		Short: "Index historical eth txs",
+		Use:     "index-evm-txs [backward|forward|prune]",
+		Aliases: []string{"index-eth-tx"},
+		Short:   "Index historical eth txs",
 		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
-		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
+		- backward: index the blocks from the lowest indexed block to the earliest block available in the node, or to the --retain-height if set. If indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
+		- prune: delete the indexed entries of the blocks lower than the --retain-height.
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+		The backward progress is shared with the background backfill of the node (see 'indexer-backfill' in app.toml).
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -36,8 +49,16 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 
 			direction := args[0]
-			if direction != "backward" && direction != "forward" {
-				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			if direction != "backward" && direction != "forward" && direction != "prune" {
+				return fmt.Errorf("unknown index direction, expect: backward|forward|prune, got: %s", direction)
+			}
+
+			retainHeight, err := cmd.Flags().GetInt64(flagRetainHeight)
+			if err != nil {
+				return err
+			}
+			if direction == "prune" && retainHeight <= 0 {
+				return fmt.Errorf("--%s must be positive to prune the indexer", flagRetainHeight)
 			}
 
 			cfg := serverCtx.Config
@@ -50,6 +71,23 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
 
+			appConfig, err := config.GetConfig(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			if err := idxer.SetBloomSectionSize(appConfig.JSONRPC.BloomSectionSize); err != nil {
+				return err
+			}
+
+			if direction == "prune" {
+				pruned, err := idxer.PruneBlocks(retainHeight)
+				if err != nil {
+					return err
+				}
+				cmd.Printf("pruned %d eth txs lower than height %d\n", pruned, retainHeight)
+				return nil
+			}
+
 			// open local tendermint db, because the local rpc won't be available.
 			cmtdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
 			if err != nil {
@@ -74,28 +112,38 @@ func NewIndexTxCmd() *cobra.Command {
 				if err != nil {
 					return err
 				}
-				if err := idxer.IndexBlock(blk, resBlk.TxResults); err != nil {
-					return err
-				}
-				fmt.Println(height)
-				return nil
+				return idxer.IndexBlock(blk, resBlk.TxResults)
 			}
 
 			switch args[0] {
 			case "backward":
-				first, err := idxer.FirstIndexedBlock()
+				first, err := idxer.BackfillHeight()
 				if err != nil {
 					return err
 				}
+				if first == -1 {
+					first, err = idxer.FirstIndexedBlock()
+					if err != nil {
+						return err
+					}
+				}
 				if first == -1 {
 					// start from the latest block if indexer db is empty
-					first = blockStore.Height()
+					first = blockStore.Height() + 1
 				}
-				for i := first - 1; i > 0; i-- {
+
+				last := max(blockStore.Base(), retainHeight, 1)
+				progress := newIndexProgress(cmd, first-last)
+				for i := first - 1; i >= last; i-- {
 					if err := indexBlock(i); err != nil {
 						return err
 					}
+					if err := idxer.SetBackfillHeight(i); err != nil {
+						return err
+					}
+					progress.blockIndexed(i)
 				}
+				progress.done()
 			case "forward":
 				latest, err := idxer.LastIndexedBlock()
 				if err != nil {
@@ -105,11 +153,15 @@ func NewIndexTxCmd() *cobra.Command {
 					// start from genesis if empty
 					latest = 0
 				}
+
+				progress := newIndexProgress(cmd, blockStore.Height()-latest)
 				for i := latest + 1; i <= blockStore.Height(); i++ {
 					if err := indexBlock(i); err != nil {
 						return err
 					}
+					progress.blockIndexed(i)
 				}
+				progress.done()
 			default:
 				return fmt.Errorf("unknown direction %s", args[0])
 			}
@@ -117,5 +169,45 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().Int64(flagRetainHeight, 0, "Lowest height kept in the indexer, required to prune it")
 	return cmd
 }
+
+// indexProgress reports the progress of the indexing of a range of blocks.
+type indexProgress struct {
+	cmd        *cobra.Command
+	total      int64
+	indexed    int64
+	start      time.Time
+	lastReport time.Time
+}
+
+// newIndexProgress creates a new progress reporter for the given number of blocks.
+func newIndexProgress(cmd *cobra.Command, total int64) *indexProgress {
+	now := time.Now()
+	cmd.Printf("indexing %d blocks\n", max(total, 0))
+	return &indexProgress{cmd: cmd, total: total, start: now, lastReport: now}
+}
+
+// blockIndexed records the indexing of a block, reporting the progress at
+// most every progressReportInterval.
+func (p *indexProgress) blockIndexed(height int64) {
+	p.indexed++
+	if time.Since(p.lastReport) < progressReportInterval {
+		return
+	}
+	p.lastReport = time.Now()
+
+	elapsed := time.Since(p.start)
+	rate := float64(p.indexed) / elapsed.Seconds()
+	eta := time.Duration(float64(p.total-p.indexed)/rate) * time.Second
+	p.cmd.Printf(
+		"indexed %d/%d blocks (%.1f%%), height %d, %.1f blocks/s, eta %s\n",
+		p.indexed, p.total, 100*float64(p.indexed)/float64(p.total), height, rate, eta.Round(time.Second),
+	)
+}
+
+// done reports the end of the indexing.
+func (p *indexProgress) done() {
+	p.cmd.Printf("indexed %d blocks in %s\n", p.indexed, time.Since(p.start).Round(time.Second))
+}
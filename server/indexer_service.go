@@ -6,6 +6,7 @@ import (
 	"context"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/service"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cometbft/cometbft/types"
@@ -17,6 +18,12 @@ const (
 	ServiceName = "EVMIndexerService"
 
 	NewBlockWaitTimeout = 60 * time.Second
+
+	// PruneInterval is the number of blocks between two prunings of the indexer
+	PruneInterval = 100
+
+	// progressInterval is the number of blocks between two backfill progress logs
+	progressInterval = 1000
 )
 
 // EVMIndexerService indexes transactions for json-rpc service.
@@ -25,6 +32,13 @@ type EVMIndexerService struct {
 
 	txIdxr evmostypes.EVMTxIndexer
 	client rpcclient.Client
+
+	// bloomBackfill defines if the bloom-bits index of the past blocks is built on start
+	bloomBackfill bool
+	// backfill defines if the historical blocks are indexed in background on start
+	backfill bool
+	// retainBlocks is the number of recent blocks kept in the indexer, 0 keeps all of them
+	retainBlocks uint64
 }
 
 // NewEVMIndexerService returns a new service instance.
@@ -37,6 +51,23 @@ func NewEVMIndexerService(
 	return is
 }
 
+// SetBloomBackfill sets if the bloom-bits index of the past blocks is built on start.
+func (eis *EVMIndexerService) SetBloomBackfill(enable bool) {
+	eis.bloomBackfill = enable
+}
+
+// SetBackfill sets if the historical blocks are indexed in background on start.
+func (eis *EVMIndexerService) SetBackfill(enable bool) {
+	eis.backfill = enable
+}
+
+// SetRetainBlocks sets the number of recent blocks kept in the indexer. The
+// entries of older blocks are pruned every PruneInterval blocks. A value of 0
+// keeps all the blocks.
+func (eis *EVMIndexerService) SetRetainBlocks(retainBlocks uint64) {
+	eis.retainBlocks = retainBlocks
+}
+
 // OnStart implements service.Service by subscribing for new blocks
 // and indexing them by events.
 func (eis *EVMIndexerService) OnStart() error {
@@ -75,6 +106,10 @@ func (eis *EVMIndexerService) OnStart() error {
 		}
 	}()
 
+	if bloomIdxr, ok := eis.txIdxr.(evmostypes.EVMBloomIndexer); ok && eis.bloomBackfill {
+		go eis.backfillBlooms(ctx, bloomIdxr, latestBlock)
+	}
+
 	lastBlock, err := eis.txIdxr.LastIndexedBlock()
 	if err != nil {
 		return err
@@ -82,6 +117,13 @@ func (eis *EVMIndexerService) OnStart() error {
 	if lastBlock == -1 {
 		lastBlock = latestBlock
 	}
+
+	if maintainer, ok := eis.txIdxr.(evmostypes.EVMIndexerMaintainer); ok {
+		eis.prune(maintainer, latestBlock)
+		if eis.backfill {
+			go eis.backfillBlocks(ctx, maintainer, status.SyncInfo.EarliestBlockHeight, latestBlock, lastBlock)
+		}
+	}
 	for {
 		if latestBlock <= lastBlock {
 			// nothing to index. wait for signal of new block
@@ -106,6 +148,147 @@ func (eis *EVMIndexerService) OnStart() error {
 				eis.Logger.Error("failed to index block", "height", i, "err", err)
 			}
 			lastBlock = blockResult.Height
+
+			if maintainer, ok := eis.txIdxr.(evmostypes.EVMIndexerMaintainer); ok && lastBlock%PruneInterval == 0 {
+				eis.prune(maintainer, lastBlock)
+			}
+		}
+	}
+}
+
+// retainHeight returns the lowest height kept in the indexer, 0 if all the
+// blocks are kept.
+func (eis *EVMIndexerService) retainHeight(latestBlock int64) int64 {
+	if eis.retainBlocks == 0 || uint64(latestBlock) < eis.retainBlocks { // #nosec G115
+		return 0
+	}
+	return latestBlock - int64(eis.retainBlocks) + 1 // #nosec G115
+}
+
+// prune deletes the entries of the blocks older than the retained ones.
+func (eis *EVMIndexerService) prune(maintainer evmostypes.EVMIndexerMaintainer, latestBlock int64) {
+	retainHeight := eis.retainHeight(latestBlock)
+	if retainHeight == 0 {
+		return
+	}
+
+	pruned, err := maintainer.PruneBlocks(retainHeight)
+	if err != nil {
+		eis.Logger.Error("failed to prune indexer", "retain-height", retainHeight, "err", err)
+		return
+	}
+	if pruned > 0 {
+		eis.Logger.Info("pruned indexer", "retain-height", retainHeight, "txs", pruned)
+	}
+}
+
+// backfillBlocks indexes the historical blocks in background, from the lowest
+// indexed block down to the earliest block available in the node that is
+// retained. The progress is persisted so that the backfill resumes where it
+// stopped on restart.
+func (eis *EVMIndexerService) backfillBlocks(
+	ctx context.Context,
+	maintainer evmostypes.EVMIndexerMaintainer,
+	earliestBlock, latestBlock, lastBlock int64,
+) {
+	from, err := maintainer.BackfillHeight()
+	if err != nil {
+		eis.Logger.Error("failed to get backfill height", "err", err)
+		return
+	}
+	if from == -1 {
+		// the blocks after the last indexed one are indexed by the main loop
+		from = lastBlock + 1
+		if first, err := maintainer.FirstIndexedBlock(); err == nil && first != -1 {
+			from = first
 		}
 	}
+
+	to := max(earliestBlock, eis.retainHeight(latestBlock), 1)
+	if from <= to {
+		return
+	}
+
+	eis.Logger.Info("backfilling indexer", "from", from-1, "to", to)
+	for height := from - 1; height >= to; height-- {
+		if !eis.IsRunning() {
+			return
+		}
+
+		block, err := eis.client.Block(ctx, &height)
+		if err != nil {
+			eis.Logger.Error("failed to fetch block, stopping backfill", "height", height, "err", err)
+			return
+		}
+		blockResult, err := eis.client.BlockResults(ctx, &height)
+		if err != nil {
+			eis.Logger.Error("failed to fetch block result, stopping backfill", "height", height, "err", err)
+			return
+		}
+		if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+			eis.Logger.Error("failed to index block, stopping backfill", "height", height, "err", err)
+			return
+		}
+		if err := maintainer.SetBackfillHeight(height); err != nil {
+			eis.Logger.Error("failed to set backfill height", "height", height, "err", err)
+			return
+		}
+
+		if (from-height)%progressInterval == 0 {
+			eis.Logger.Info("backfilling indexer", "height", height, "remaining", height-to)
+		}
+	}
+	eis.Logger.Info("finished backfilling indexer", "height", to)
+}
+
+// backfillBlooms indexes the bloom-bits sections that are complete at the
+// latest block and not indexed yet, from the newest to the oldest one. The
+// sections with blocks that can't be fetched, e.g. pruned ones, are skipped.
+func (eis *EVMIndexerService) backfillBlooms(ctx context.Context, bloomIdxr evmostypes.EVMBloomIndexer, latestBlock int64) {
+	sectionSize := bloomIdxr.BloomSectionSize()
+	sections := uint64(latestBlock+1) / sectionSize // #nosec G115
+
+	eis.Logger.Info("backfilling bloom-bits index", "sections", sections)
+	for section := sections; section > 0; section-- {
+		if !eis.IsRunning() {
+			return
+		}
+
+		indexed, err := bloomIdxr.HasBloomSection(section - 1)
+		if err != nil {
+			eis.Logger.Error("failed to check bloom section", "section", section-1, "err", err)
+			return
+		}
+		if indexed {
+			continue
+		}
+
+		if err := eis.backfillBloomSection(ctx, bloomIdxr, section-1); err != nil {
+			eis.Logger.Error("failed to backfill bloom section", "section", section-1, "err", err)
+		}
+	}
+	eis.Logger.Info("finished backfilling bloom-bits index")
+}
+
+// backfillBloomSection indexes a bloom-bits section from the block results of its blocks.
+func (eis *EVMIndexerService) backfillBloomSection(ctx context.Context, bloomIdxr evmostypes.EVMBloomIndexer, section uint64) error {
+	sectionSize := bloomIdxr.BloomSectionSize()
+	start := int64(section * sectionSize) // #nosec G115
+
+	txResults := make([][]*abci.ExecTxResult, sectionSize)
+	for i := range txResults {
+		height := start + int64(i)
+		if height == 0 {
+			// there is no block at height 0
+			continue
+		}
+
+		blockResult, err := eis.client.BlockResults(ctx, &height)
+		if err != nil {
+			return err
+		}
+		txResults[i] = blockResult.TxsResults
+	}
+
+	return bloomIdxr.IndexBloomSection(section, txResults)
 }
@@ -14,6 +14,7 @@ import (
 	ethlog "github.com/ethereum/go-ethereum/log"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/evmos/evmos/v20/rpc"
+	"github.com/evmos/evmos/v20/rpc/middleware"
 
 	svrconfig "github.com/evmos/evmos/v20/server/config"
 	evmostypes "github.com/evmos/evmos/v20/types"
@@ -60,8 +61,34 @@ func StartJSONRPC(ctx *server.Context,
 		}
 	}
 
+	methodRateLimits, err := svrconfig.ParseMethodRateLimits(config.JSONRPC.MethodRateLimits)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rateLimitCfg := middleware.RateLimitConfig{
+		PerIP:    config.JSONRPC.RateLimitPerIP,
+		Burst:    config.JSONRPC.RateLimitBurst,
+		Methods:  methodRateLimits,
+		GasPerIP: config.JSONRPC.RateLimitGasPerIP,
+		GasCap:   config.JSONRPC.GasCap,
+	}
+
+	var rateLimiter *middleware.RateLimiter
+	if rateLimitCfg.Enabled() {
+		rateLimiter = middleware.NewRateLimiter(rateLimitCfg)
+	}
+	permissions := middleware.NewPermissions(middleware.PermissionConfig{
+		HTTP:          middleware.MethodPolicy{Allowed: config.JSONRPC.HTTPAllowedMethods, Denied: config.JSONRPC.HTTPDeniedMethods},
+		WS:            middleware.MethodPolicy{Allowed: config.JSONRPC.WSAllowedMethods, Denied: config.JSONRPC.WSDeniedMethods},
+		Local:         middleware.MethodPolicy{Allowed: config.JSONRPC.LocalAllowedMethods, Denied: config.JSONRPC.LocalDeniedMethods},
+		AuthToken:     config.JSONRPC.AuthToken,
+		TrustLoopback: config.JSONRPC.TrustLocalClients,
+	})
+	rpcMiddleware := middleware.New(ctx.Logger, rateLimiter, permissions, config.JSONRPC.RateLimitIPHeader)
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", rpcMiddleware.Handler(rpcServer)).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -108,7 +135,7 @@ func StartJSONRPC(ctx *server.Context,
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config, permissions, rpcMiddleware)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
@@ -203,9 +203,20 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
+	cmd.Flags().String(srvflags.JSONRPCArchiveGRPCAddress, "", "the gRPC address of an archive node serving the state queries at the heights pruned by this node")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMGasReport, false, "Report the breakdown of the gas consumed by each eth tx on its events and receipt")
+	cmd.Flags().Bool(srvflags.EVMLiveTracer, false, "Persist the call traces of the eth txs as the blocks execute, to serve debug_traceTransaction without re-execution")
+	cmd.Flags().Uint64(srvflags.EVMLiveTracerRetainBlocks, config.DefaultLiveTracerRetainBlocks, "Number of recent blocks whose call traces are kept by the live tracer (0 keeps all of them)")
+	cmd.Flags().Bool(srvflags.EVMTelemetry, false, "Report the per-block gas consumed by opcode class, precompile calls and reverted txs ratio on the node telemetry")
+	cmd.Flags().Int(srvflags.EVMParallelExecutionWorkers, 0, "Number of workers of the experimental parallel pre-execution of the block eth txs (0 disables it)")
+	cmd.Flags().Bool(srvflags.EVMEventStream, false, "Stream the receipts and logs of the eth txs of the committed blocks to the gRPC SubscribeEvents subscribers")
+	cmd.Flags().Bool(srvflags.EVMMempool, false, "Enable the app-side mempool, which allows replacing the pending eth txs by fee bump")
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, config.DefaultMempoolPriceBump, "Percentage by which the tip cap and fee cap of a pending eth tx must be bumped to replace it")
+	cmd.Flags().Int(srvflags.EVMMempoolMaxTxs, config.DefaultMempoolMaxTxs, "Max number of txs of the app-side mempool (0=unlimited)")
+	cmd.Flags().StringSlice(srvflags.EVMMempoolPriorityMsgTypes, nil, "Type URLs of the Cosmos messages whose txs are ranked above the rest of the txs by the app-side mempool (requires evm.mempool)")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -431,9 +442,18 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		}
 
 		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		kvIndexer := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		if err := kvIndexer.SetBloomSectionSize(config.JSONRPC.BloomSectionSize); err != nil {
+			logger.Error("failed to set the bloom section size of the evm indexer", "error", err.Error())
+			return err
+		}
+		idxer = kvIndexer
+
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
+		indexerService.SetBloomBackfill(config.JSONRPC.BloomBackfill)
+		indexerService.SetBackfill(config.JSONRPC.IndexerBackfill)
+		indexerService.SetRetainBlocks(config.JSONRPC.IndexerRetainBlocks)
 
 		g.Go(func() error {
 			return indexerService.Start()
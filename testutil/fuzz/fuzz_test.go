@@ -0,0 +1,35 @@
+package fuzz_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v20/testutil/fuzz"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/require"
+)
+
+func newHarness(t testing.TB) *fuzz.Harness {
+	keyring := testkeyring.New(1)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	harness, err := fuzz.NewHarness(nw, keyring.GetKey(0))
+	require.NoError(t, err)
+	require.NotEmpty(t, harness.Targets())
+	return harness
+}
+
+// TestPrecompiles runs a fixed number of random inputs for each precompile, so
+// that the regressions are caught without running the fuzzing engine.
+func TestPrecompiles(t *testing.T) {
+	newHarness(t).RunTargets(t, 50, 1)
+}
+
+// FuzzPrecompiles is run with:
+//
+//	go test ./testutil/fuzz -run=^$ -fuzz=FuzzPrecompiles
+func FuzzPrecompiles(f *testing.F) {
+	newHarness(f).Fuzz(f)
+}
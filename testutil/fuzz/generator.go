@@ -0,0 +1,178 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package fuzz
+
+import (
+	"math/big"
+	"math/rand"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// maxLength is the maximum length of the generated dynamic arrays, strings and
+// bytes.
+const maxLength = 4
+
+var bigIntType = reflect.TypeOf(&big.Int{})
+
+// Generator generates the random arguments of the precompile methods from
+// their ABI. The values are drawn from the given seeds with a higher
+// probability than random ones, so that the calls reach past the input
+// validation of the precompiles.
+type Generator struct {
+	// Addresses are the well known addresses, e.g. the funded accounts and
+	// the precompiles.
+	Addresses []common.Address
+	// Strings are the well known strings, e.g. the bech32 addresses of the
+	// accounts and validators and the denominations.
+	Strings []string
+}
+
+// Args returns random arguments for the given ABI arguments.
+func (g Generator) Args(r *rand.Rand, args abi.Arguments) []interface{} {
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		values[i] = g.value(r, arg.Type).Interface()
+	}
+	return values
+}
+
+// value returns a random value of the Go type that the ABI type is unpacked to.
+func (g Generator) value(r *rand.Rand, typ abi.Type) reflect.Value {
+	rt := typ.GetType()
+
+	switch typ.T {
+	case abi.BoolTy:
+		return reflect.ValueOf(r.Intn(2) == 1)
+	case abi.IntTy, abi.UintTy:
+		return integer(r, typ, rt)
+	case abi.AddressTy:
+		if len(g.Addresses) > 0 && r.Intn(4) != 0 {
+			return reflect.ValueOf(g.Addresses[r.Intn(len(g.Addresses))])
+		}
+		var address common.Address
+		r.Read(address[:])
+		return reflect.ValueOf(address)
+	case abi.StringTy:
+		if len(g.Strings) > 0 && r.Intn(4) != 0 {
+			return reflect.ValueOf(g.Strings[r.Intn(len(g.Strings))])
+		}
+		return reflect.ValueOf(string(randomBytes(r)))
+	case abi.BytesTy:
+		return reflect.ValueOf(randomBytes(r))
+	case abi.FixedBytesTy, abi.HashTy:
+		v := reflect.New(rt).Elem()
+		reflect.Copy(v, reflect.ValueOf(randomBytesN(r, typ.Size)))
+		return v
+	case abi.SliceTy:
+		n := r.Intn(maxLength + 1)
+		v := reflect.MakeSlice(rt, n, n)
+		for i := 0; i < n; i++ {
+			v.Index(i).Set(g.value(r, *typ.Elem))
+		}
+		return v
+	case abi.ArrayTy:
+		v := reflect.New(rt).Elem()
+		for i := 0; i < typ.Size; i++ {
+			v.Index(i).Set(g.value(r, *typ.Elem))
+		}
+		return v
+	case abi.TupleTy:
+		v := reflect.New(rt).Elem()
+		for i, elem := range typ.TupleElems {
+			v.Field(i).Set(g.value(r, *elem))
+		}
+		return v
+	default:
+		return reflect.Zero(rt)
+	}
+}
+
+// integer returns a random integer of the given ABI type. The boundary values
+// of the type are returned with a higher probability.
+func integer(r *rand.Rand, typ abi.Type, rt reflect.Type) reflect.Value {
+	maxValue := new(big.Int).Lsh(common.Big1, uint(typ.Size)) //nolint:gosec // G115
+	minValue := new(big.Int)
+	if typ.T == abi.IntTy {
+		maxValue.Rsh(maxValue, 1)
+		minValue.Neg(maxValue)
+	}
+	maxValue.Sub(maxValue, common.Big1)
+
+	var n *big.Int
+	switch r.Intn(6) {
+	case 0:
+		n = new(big.Int)
+	case 1:
+		n = big.NewInt(1)
+	case 2:
+		n = maxValue
+	case 3:
+		n = minValue
+	case 4:
+		n = big.NewInt(r.Int63n(1_000_000))
+	default:
+		n = new(big.Int).Rand(r, maxValue)
+		if typ.T == abi.IntTy && r.Intn(2) == 0 {
+			n.Neg(n)
+		}
+	}
+
+	if rt == bigIntType {
+		return reflect.ValueOf(new(big.Int).Set(n))
+	}
+
+	v := reflect.New(rt).Elem()
+	if typ.T == abi.IntTy {
+		v.SetInt(n.Int64())
+	} else {
+		v.SetUint(n.Uint64())
+	}
+	return v
+}
+
+// Mutate applies a random mutation to the given call data, so that the
+// decoding of malformed inputs is exercised too.
+func Mutate(r *rand.Rand, data []byte) []byte {
+	mutated := append([]byte{}, data...)
+
+	switch r.Intn(4) {
+	case 0:
+		// flip a random byte
+		if len(mutated) > 0 {
+			mutated[r.Intn(len(mutated))] ^= byte(1 + r.Intn(math.MaxUint8))
+		}
+	case 1:
+		// truncate the input
+		if len(mutated) > 0 {
+			mutated = mutated[:r.Intn(len(mutated))]
+		}
+	case 2:
+		// append random bytes
+		mutated = append(mutated, randomBytesN(r, 1+r.Intn(64))...)
+	default:
+		// overwrite a random 32 bytes word with its maximum value
+		if words := (len(mutated) - 4) / 32; words > 0 {
+			offset := 4 + 32*r.Intn(words)
+			copy(mutated[offset:offset+32], math.MaxBig256.Bytes())
+		}
+	}
+
+	return mutated
+}
+
+// randomBytes returns a random slice of bytes of random length.
+func randomBytes(r *rand.Rand) []byte {
+	return randomBytesN(r, r.Intn(8*maxLength+1))
+}
+
+// randomBytesN returns a random slice of n bytes.
+func randomBytesN(r *rand.Rand, n int) []byte {
+	bz := make([]byte, n)
+	r.Read(bz)
+	return bz
+}
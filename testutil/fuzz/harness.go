@@ -0,0 +1,316 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package fuzz
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"path"
+	"reflect"
+	"runtime/debug"
+	"sort"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+)
+
+// gasLimits are the gas limits the generated calls are executed with, on top of
+// the gas required by the precompile.
+var gasLimits = []uint64{0, 21_000, 100_000, 1_000_000, 10_000_000}
+
+// Target is a precompile the fuzz inputs are generated for.
+type Target struct {
+	// Name is the name of the package of the precompile.
+	Name       string
+	Address    common.Address
+	Precompile vm.PrecompiledContract
+	// ABI is the ABI of the precompile. The inputs of the precompiles without
+	// an ABI are random bytes.
+	ABI *abi.ABI
+}
+
+// Input is a call to a precompile generated by the fuzzer.
+type Input struct {
+	Target Target
+	// Method is the name of the called method, if any.
+	Method string
+	Data   []byte
+	Gas    uint64
+	Value  *big.Int
+}
+
+// String implements the fmt.Stringer interface.
+func (i Input) String() string {
+	return fmt.Sprintf("%s (%s) method %q, gas %d, value %s, data %s",
+		i.Target.Name, i.Target.Address, i.Method, i.Gas, i.Value, hexutil.Encode(i.Data),
+	)
+}
+
+// Harness runs the fuzz inputs against the precompiles of an integration
+// network. Every input is executed on a cache of the network context, so that
+// the inputs are independent of each other.
+type Harness struct {
+	network   *network.UnitTestNetwork
+	sender    testkeyring.Key
+	targets   []Target
+	generator Generator
+}
+
+// NewHarness creates a new Harness for all the precompiles that are active on
+// the given network. The calls are sent by the given funded account.
+func NewHarness(nw *network.UnitTestNetwork, sender testkeyring.Key) (*Harness, error) {
+	ctx := nw.GetContext()
+
+	evmParams := nw.App.EvmKeeper.GetParams(ctx)
+	erc20Params := nw.App.Erc20Keeper.GetParams(ctx)
+
+	var addresses []string
+	addresses = append(addresses, evmParams.ActiveStaticPrecompiles...)
+	addresses = append(addresses, erc20Params.NativePrecompiles...)
+	addresses = append(addresses, erc20Params.DynamicPrecompiles...)
+
+	targets := make([]Target, 0, len(addresses))
+	for _, hexAddr := range addresses {
+		address := common.HexToAddress(hexAddr)
+		precompiles, found, err := nw.App.EvmKeeper.GetPrecompileInstance(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, fmt.Errorf("precompile %s not found", address)
+		}
+
+		precompile := precompiles.Map[address]
+		targets = append(targets, Target{
+			Name:       precompileName(precompile),
+			Address:    address,
+			Precompile: precompile,
+			ABI:        precompileABI(precompile),
+		})
+	}
+
+	generator := Generator{
+		Addresses: []common.Address{sender.Addr, {}},
+		Strings:   []string{sender.AccAddr.String(), nw.GetDenom(), ""},
+	}
+	for _, target := range targets {
+		generator.Addresses = append(generator.Addresses, target.Address)
+	}
+	for _, validator := range nw.GetValidators() {
+		generator.Strings = append(generator.Strings, validator.OperatorAddress)
+	}
+
+	return &Harness{
+		network:   nw,
+		sender:    sender,
+		targets:   targets,
+		generator: generator,
+	}, nil
+}
+
+// Targets returns the precompiles the inputs are generated for.
+func (h *Harness) Targets() []Target {
+	return h.targets
+}
+
+// Generate returns a random input for the given target. A random method of the
+// precompile is called with random arguments, and the call data is mutated at
+// random.
+func (h *Harness) Generate(r *rand.Rand, target Target) Input {
+	input := Input{
+		Target: target,
+		Value:  new(big.Int),
+	}
+
+	if target.ABI != nil && len(target.ABI.Methods) > 0 {
+		names := make([]string, 0, len(target.ABI.Methods))
+		for name := range target.ABI.Methods {
+			names = append(names, name)
+		}
+		// NOTE: the method names are sorted so that the inputs are
+		// reproducible from the seed
+		sort.Strings(names)
+
+		method := target.ABI.Methods[names[r.Intn(len(names))]]
+		args, err := method.Inputs.Pack(h.generator.Args(r, method.Inputs)...)
+		if err != nil {
+			// NOTE: the generated arguments always match the ABI types, so
+			// this can only be caused by an invalid ABI
+			panic(fmt.Errorf("failed to pack the arguments of %s: %w", method.Sig, err))
+		}
+
+		input.Method = method.Name
+		input.Data = append(append([]byte{}, method.ID...), args...)
+		if r.Intn(4) == 0 {
+			input.Data = Mutate(r, input.Data)
+		}
+	} else {
+		input.Data = randomBytes(r)
+	}
+
+	input.Gas = target.Precompile.RequiredGas(input.Data)
+	if r.Intn(8) == 0 && input.Gas > 0 {
+		// exercise the out of gas errors
+		input.Gas -= 1 + uint64(r.Int63n(int64(input.Gas))) //nolint:gosec // G115
+	} else {
+		input.Gas += gasLimits[r.Intn(len(gasLimits))]
+	}
+
+	if r.Intn(8) == 0 {
+		input.Value = big.NewInt(1 + r.Int63n(1_000_000))
+	}
+
+	return input
+}
+
+// Run executes the given input and checks that the state changes of the call
+// are consistently journaled. The errors returned by the call are expected
+// and ignored; an error is only returned if the execution panics, if the
+// StateDB can't be committed, if a failed call leaves logs or balance changes
+// behind, or if the committed balances differ from the ones seen by the EVM.
+func (h *Harness) Run(input Input) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic calling %s: %v\n%s", input, r, debug.Stack())
+		}
+	}()
+
+	ctx, _ := h.network.GetContext().CacheContext()
+	stateDB := statedb.New(ctx, h.network.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+
+	accounts := []common.Address{h.sender.Addr, input.Target.Address}
+	balances := make([]*big.Int, len(accounts))
+	for i, account := range accounts {
+		balances[i] = stateDB.GetBalance(account)
+	}
+
+	evm, err := h.newEVM(ctx, stateDB, input)
+	if err != nil {
+		return err
+	}
+
+	_, _, callErr := evm.Call(vm.AccountRef(h.sender.Addr), input.Target.Address, input.Data, input.Gas, input.Value)
+	if callErr != nil {
+		if logs := stateDB.Logs(); len(logs) > 0 {
+			return fmt.Errorf("failed call %s left %d logs: %w", input, len(logs), callErr)
+		}
+		for i, account := range accounts {
+			if balance := stateDB.GetBalance(account); balance.Cmp(balances[i]) != 0 {
+				return fmt.Errorf("failed call %s changed the balance of %s from %s to %s: %w",
+					input, account, balances[i], balance, callErr,
+				)
+			}
+		}
+	}
+
+	if err := stateDB.Commit(); err != nil {
+		return fmt.Errorf("failed to commit call %s: %w", input, err)
+	}
+
+	for _, account := range accounts {
+		balance := h.network.App.EvmKeeper.GetBalance(ctx, account)
+		if expBalance := stateDB.GetBalance(account); balance.Cmp(expBalance) != 0 {
+			return fmt.Errorf("call %s committed the balance %s of %s, the EVM expected %s",
+				input, balance, account, expBalance,
+			)
+		}
+	}
+
+	return nil
+}
+
+// Fuzz registers the fuzz target of the precompiles. The seed corpus contains
+// an entry per precompile, and the fuzzing engine mutates the index of the
+// precompile and the seed of the generated input.
+func (h *Harness) Fuzz(f *testing.F) {
+	for i := range h.targets {
+		f.Add(uint16(i), int64(i)) //nolint:gosec // G115
+	}
+
+	f.Fuzz(func(t *testing.T, index uint16, seed int64) {
+		target := h.targets[int(index)%len(h.targets)]
+		input := h.Generate(rand.New(rand.NewSource(seed)), target) //nolint:gosec // G404
+		if err := h.Run(input); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// RunTargets runs the given number of random inputs for each precompile as a
+// subtest named after the precompile.
+func (h *Harness) RunTargets(t *testing.T, iterations int, seed int64) {
+	for i, target := range h.targets {
+		t.Run(fmt.Sprintf("%s/%s", target.Name, target.Address), func(t *testing.T) {
+			r := rand.New(rand.NewSource(seed + int64(i))) //nolint:gosec // G404
+			for j := 0; j < iterations; j++ {
+				if err := h.Run(h.Generate(r, target)); err != nil {
+					t.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// newEVM returns a new EVM instance for the given input.
+func (h *Harness) newEVM(ctx sdk.Context, stateDB vm.StateDB, input Input) (*vm.EVM, error) {
+	cfg, err := h.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	to := input.Target.Address
+	msg := ethtypes.NewMessage(
+		h.sender.Addr,
+		&to,
+		0,
+		input.Value,
+		input.Gas,
+		big.NewInt(0),
+		big.NewInt(0),
+		big.NewInt(0),
+		input.Data,
+		ethtypes.AccessList{},
+		false,
+	)
+
+	return h.network.App.EvmKeeper.NewEVM(ctx, msg, cfg, nil, stateDB), nil
+}
+
+// precompileName returns the name of the package of the given precompile.
+func precompileName(precompile vm.PrecompiledContract) string {
+	rt := reflect.TypeOf(precompile)
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	return path.Base(rt.PkgPath())
+}
+
+// precompileABI returns the ABI embedded in the given precompile, or nil if it
+// doesn't have one.
+func precompileABI(precompile vm.PrecompiledContract) *abi.ABI {
+	v := reflect.Indirect(reflect.ValueOf(precompile))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	field := v.FieldByName("ABI")
+	if !field.IsValid() {
+		return nil
+	}
+
+	precompileABI, ok := field.Interface().(abi.ABI)
+	if !ok {
+		return nil
+	}
+	return &precompileABI
+}
@@ -13,6 +13,7 @@ import (
 	testutiltypes "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 )
 
@@ -31,6 +32,16 @@ type BaseTxFactory interface {
 	// CommitCosmosTx creates, signs and commits a cosmos tx
 	// (produces a block with the specified transaction)
 	CommitCosmosTx(privKey cryptotypes.PrivKey, txArgs CosmosTxArgs) (abcitypes.ExecTxResult, error)
+
+	// BuildCosmosTxWithSigner builds a Cosmos tx with the provided txArgs and signs it
+	// with the provided signer (e.g. an EIP-712 signer to reproduce the Ledger flows)
+	BuildCosmosTxWithSigner(signer keyring.Signer, txArgs CosmosTxArgs) (authsigning.Tx, error)
+	// SignCosmosTxWithSigner signs a Cosmos transaction with the provided
+	// signer and tx builder
+	SignCosmosTxWithSigner(signer keyring.Signer, txBuilder client.TxBuilder) error
+	// CommitCosmosTxWithSigner creates a cosmos tx, signs it with the provided
+	// signer and commits it (produces a block with the specified transaction)
+	CommitCosmosTxWithSigner(signer keyring.Signer, txArgs CosmosTxArgs) (abcitypes.ExecTxResult, error)
 }
 
 // baseTxFactory is the struct of the basic tx factory
@@ -55,7 +66,12 @@ func newBaseTxFactory(
 }
 
 func (tf *baseTxFactory) BuildCosmosTx(privKey cryptotypes.PrivKey, txArgs CosmosTxArgs) (authsigning.Tx, error) {
-	txBuilder, err := tf.buildTx(privKey, txArgs)
+	return tf.BuildCosmosTxWithSigner(keyring.NewDirectSigner(privKey), txArgs)
+}
+
+// BuildCosmosTxWithSigner builds a Cosmos transaction and signs it with the provided signer
+func (tf *baseTxFactory) BuildCosmosTxWithSigner(signer keyring.Signer, txArgs CosmosTxArgs) (authsigning.Tx, error) {
+	txBuilder, err := tf.buildTx(signer, txArgs)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to build tx")
 	}
@@ -80,7 +96,13 @@ func (tf *baseTxFactory) ExecuteCosmosTx(privKey cryptotypes.PrivKey, txArgs Cos
 // CommitCosmosTx creates and signs a Cosmos transaction, and then includes it in
 // a block and commits the state changes on the chain
 func (tf *baseTxFactory) CommitCosmosTx(privKey cryptotypes.PrivKey, txArgs CosmosTxArgs) (abcitypes.ExecTxResult, error) {
-	signedTx, err := tf.BuildCosmosTx(privKey, txArgs)
+	return tf.CommitCosmosTxWithSigner(keyring.NewDirectSigner(privKey), txArgs)
+}
+
+// CommitCosmosTxWithSigner creates a Cosmos transaction and signs it with the provided
+// signer, and then includes it in a block and commits the state changes on the chain
+func (tf *baseTxFactory) CommitCosmosTxWithSigner(signer keyring.Signer, txArgs CosmosTxArgs) (abcitypes.ExecTxResult, error) {
+	signedTx, err := tf.BuildCosmosTxWithSigner(signer, txArgs)
 	if err != nil {
 		return abcitypes.ExecTxResult{}, errorsmod.Wrap(err, "failed to build tx")
 	}
@@ -104,15 +126,16 @@ func (tf *baseTxFactory) CommitCosmosTx(privKey cryptotypes.PrivKey, txArgs Cosm
 // SignCosmosTx is a helper function that signs a Cosmos transaction
 // with the provided private key and transaction builder
 func (tf *baseTxFactory) SignCosmosTx(privKey cryptotypes.PrivKey, txBuilder client.TxBuilder) error {
-	txConfig := tf.ec.TxConfig
-	signMode, err := authsigning.APISignModeToInternal(txConfig.SignModeHandler().DefaultMode())
-	if err != nil {
-		return errorsmod.Wrap(err, "invalid sign mode")
-	}
-	signerData, err := tf.setSignatures(privKey, txBuilder, signMode)
+	return tf.SignCosmosTxWithSigner(keyring.NewDirectSigner(privKey), txBuilder)
+}
+
+// SignCosmosTxWithSigner is a helper function that signs a Cosmos transaction
+// with the provided signer and transaction builder
+func (tf *baseTxFactory) SignCosmosTxWithSigner(signer keyring.Signer, txBuilder client.TxBuilder) error {
+	signerData, err := tf.setSignatures(signer.PubKey(), txBuilder, signer.SignMode())
 	if err != nil {
 		return errorsmod.Wrap(err, "failed to set tx signatures")
 	}
 
-	return tf.signWithPrivKey(privKey, txBuilder, signerData, signMode)
+	return tf.signWithSigner(signer, txBuilder, signerData)
 }
@@ -9,9 +9,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
-	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
 )
 
 // EncodeTx encodes the tx using the txConfig's encoder.
@@ -24,8 +23,8 @@ func (tf *baseTxFactory) EncodeTx(tx sdktypes.Tx) ([]byte, error) {
 	return txBytes, nil
 }
 
-// buildTx builds a tx with the provided private key and txArgs
-func (tf *baseTxFactory) buildTx(privKey cryptotypes.PrivKey, txArgs CosmosTxArgs) (client.TxBuilder, error) {
+// buildTx builds a tx with the provided signer and txArgs
+func (tf *baseTxFactory) buildTx(signer keyring.Signer, txArgs CosmosTxArgs) (client.TxBuilder, error) {
 	txConfig := tf.ec.TxConfig
 	txBuilder := txConfig.NewTxBuilder()
 
@@ -37,7 +36,7 @@ func (tf *baseTxFactory) buildTx(privKey cryptotypes.PrivKey, txArgs CosmosTxArg
 		txBuilder.SetFeeGranter(txArgs.FeeGranter)
 	}
 
-	senderAddress := sdktypes.AccAddress(privKey.PubKey().Address().Bytes())
+	senderAddress := sdktypes.AccAddress(signer.PubKey().Address().Bytes())
 
 	if txArgs.FeeGranter != nil {
 		txBuilder.SetFeeGranter(txArgs.FeeGranter)
@@ -46,11 +45,7 @@ func (tf *baseTxFactory) buildTx(privKey cryptotypes.PrivKey, txArgs CosmosTxArg
 	txBuilder.SetFeePayer(senderAddress)
 
 	// need to sign the tx to simulate the tx to get the gas estimation
-	signMode, err := authsigning.APISignModeToInternal(txConfig.SignModeHandler().DefaultMode())
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "invalid sign mode")
-	}
-	signerData, err := tf.setSignatures(privKey, txBuilder, signMode)
+	signerData, err := tf.setSignatures(signer.PubKey(), txBuilder, signer.SignMode())
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to set tx signatures")
 	}
@@ -70,7 +65,7 @@ func (tf *baseTxFactory) buildTx(privKey cryptotypes.PrivKey, txArgs CosmosTxArg
 	}
 	txBuilder.SetFeeAmount(fees)
 
-	if err := tf.signWithPrivKey(privKey, txBuilder, signerData, signMode); err != nil {
+	if err := tf.signWithSigner(signer, txBuilder, signerData); err != nil {
 		return nil, errorsmod.Wrap(err, "failed to sign Cosmos Tx")
 	}
 
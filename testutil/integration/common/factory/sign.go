@@ -8,18 +8,18 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client"
-	cosmostx "github.com/cosmos/cosmos-sdk/client/tx"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
 )
 
 // setSignatures is a helper function that sets the signature for
 // the transaction in the tx builder. It returns the signerData to be used
-// when signing the transaction (e.g. when calling signWithPrivKey)
-func (tf *baseTxFactory) setSignatures(privKey cryptotypes.PrivKey, txBuilder client.TxBuilder, signMode signing.SignMode) (signerData authsigning.SignerData, err error) {
-	senderAddress := sdktypes.AccAddress(privKey.PubKey().Address().Bytes())
+// when signing the transaction (e.g. when calling signWithSigner)
+func (tf *baseTxFactory) setSignatures(pubKey cryptotypes.PubKey, txBuilder client.TxBuilder, signMode signing.SignMode) (signerData authsigning.SignerData, err error) {
+	senderAddress := sdktypes.AccAddress(pubKey.Address().Bytes())
 	account, err := tf.grpcHandler.GetAccount(senderAddress.String())
 	if err != nil {
 		return signerData, err
@@ -30,11 +30,11 @@ func (tf *baseTxFactory) setSignatures(privKey cryptotypes.PrivKey, txBuilder cl
 		AccountNumber: account.GetAccountNumber(),
 		Sequence:      sequence,
 		Address:       senderAddress.String(),
-		PubKey:        privKey.PubKey(),
+		PubKey:        pubKey,
 	}
 
 	sigsV2 := signing.SignatureV2{
-		PubKey: privKey.PubKey(),
+		PubKey: pubKey,
 		Data: &signing.SingleSignatureData{
 			SignMode:  signMode,
 			Signature: nil,
@@ -45,14 +45,30 @@ func (tf *baseTxFactory) setSignatures(privKey cryptotypes.PrivKey, txBuilder cl
 	return signerData, txBuilder.SetSignatures(sigsV2)
 }
 
-// signWithPrivKey is a helper function that signs a transaction
-// with the provided private key
-func (tf *baseTxFactory) signWithPrivKey(privKey cryptotypes.PrivKey, txBuilder client.TxBuilder, signerData authsigning.SignerData, signMode signing.SignMode) error {
-	txConfig := tf.ec.TxConfig
-	signature, err := cosmostx.SignWithPrivKey(context.TODO(), signMode, signerData, txBuilder, privKey, txConfig, signerData.Sequence)
+// signWithSigner is a helper function that signs a transaction
+// with the provided signer, using the signer's sign mode
+func (tf *baseTxFactory) signWithSigner(signer keyring.Signer, txBuilder client.TxBuilder, signerData authsigning.SignerData) error {
+	signMode := signer.SignMode()
+	signBytes, err := authsigning.GetSignBytesAdapter(
+		context.TODO(), tf.ec.TxConfig.SignModeHandler(), signMode, signerData, txBuilder.GetTx(),
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to get sign bytes")
+	}
+
+	signature, err := signer.Sign(signBytes)
 	if err != nil {
 		return errorsmod.Wrap(err, "failed to sign tx")
 	}
 
-	return txBuilder.SetSignatures(signature)
+	sigV2 := signing.SignatureV2{
+		PubKey: signer.PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: signature,
+		},
+		Sequence: signerData.Sequence,
+	}
+
+	return txBuilder.SetSignatures(sigV2)
 }
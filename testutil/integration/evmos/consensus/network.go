@@ -0,0 +1,82 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package consensus
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	testnetwork "github.com/evmos/evmos/v20/testutil/network"
+)
+
+// Network is a network of validators that run the CometBFT consensus in
+// process, each with its own node and app. Unlike the IntegrationNetwork of the
+// network package, whose blocks are produced by calling the ABCI methods of a
+// single app, the blocks are proposed, voted and committed by the validators
+// over the P2P network, so that the proposer rotation, the missed blocks and
+// the slashing of the validators can be tested.
+//
+// It's kept out of the network package, which is imported by the test suites
+// of the packages that the in-process nodes depend on.
+//
+// NOTE: only the first validator exposes the RPC client used for the queries,
+// and only a single Network can run at a time. Cleanup must be called once the
+// network is no longer needed.
+type Network struct {
+	*testnetwork.Network
+}
+
+// ConfigOption defines a function that modifies the configuration of a
+// Network, e.g. its genesis state or its block time.
+type ConfigOption func(cfg *testnetwork.Config)
+
+// NewNetwork starts a Network with the given number of validators and
+// waits for its first block. It uses the default configuration
+// of the in-process test network modified by the given options.
+func NewNetwork(t *testing.T, numValidators int, opts ...ConfigOption) (*Network, error) {
+	cfg := testnetwork.DefaultConfig()
+	cfg.NumValidators = numValidators
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	nw, err := testnetwork.New(t, t.TempDir(), cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := nw.WaitForHeight(1); err != nil {
+		nw.Cleanup()
+		return nil, err
+	}
+
+	return &Network{Network: nw}, nil
+}
+
+// GetConsAddress returns the consensus address of the validator at the given
+// index.
+func (n *Network) GetConsAddress(i int) sdktypes.ConsAddress {
+	return sdktypes.ConsAddress(n.Validators[i].PubKey.Address())
+}
+
+// GetProposer returns the consensus address of the proposer of the block at
+// the given height.
+func (n *Network) GetProposer(height int64) (sdktypes.ConsAddress, error) {
+	res, err := n.Validators[0].RPCClient.Block(context.Background(), &height)
+	if err != nil {
+		return nil, err
+	}
+	return sdktypes.ConsAddress(res.Block.ProposerAddress), nil
+}
+
+// StopValidator stops the node of the validator at the given index, so that
+// it misses the following blocks. The first validator cannot be stopped, since
+// it serves the queries of the network.
+func (n *Network) StopValidator(i int) error {
+	if i <= 0 || i >= len(n.Validators) {
+		return fmt.Errorf("cannot stop the validator at index %d", i)
+	}
+	return n.Validators[i].StopNode()
+}
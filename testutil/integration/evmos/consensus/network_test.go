@@ -0,0 +1,84 @@
+//go:build norace
+// +build norace
+
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package consensus_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/consensus"
+	testnetwork "github.com/evmos/evmos/v20/testutil/network"
+	"github.com/stretchr/testify/require"
+)
+
+// withFastBlocks shortens the block time of the network.
+func withFastBlocks(cfg *testnetwork.Config) {
+	cfg.TimeoutCommit = time.Second
+}
+
+// withSlashingWindow jails the validators that miss more than half of the
+// last 10 blocks.
+func withSlashingWindow(cfg *testnetwork.Config) {
+	var genesis slashingtypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[slashingtypes.ModuleName], &genesis)
+	genesis.Params.SignedBlocksWindow = 10
+	genesis.Params.MinSignedPerWindow = math.LegacyNewDecWithPrec(5, 1)
+	cfg.GenesisState[slashingtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&genesis)
+}
+
+func TestConsensusNetworkProposerRotation(t *testing.T) {
+	nw, err := consensus.NewNetwork(t, 4, withFastBlocks)
+	require.NoError(t, err)
+	defer nw.Cleanup()
+
+	_, err = nw.WaitForHeightWithTimeout(8, time.Minute)
+	require.NoError(t, err)
+
+	proposers := make(map[string]bool)
+	for height := int64(1); height <= 8; height++ {
+		proposer, err := nw.GetProposer(height)
+		require.NoError(t, err)
+		proposers[proposer.String()] = true
+	}
+	require.Greater(t, len(proposers), 1, "expected the proposer to rotate among the validators")
+}
+
+func TestConsensusNetworkMissedBlocks(t *testing.T) {
+	nw, err := consensus.NewNetwork(t, 4, withFastBlocks, withSlashingWindow)
+	require.NoError(t, err)
+	defer nw.Cleanup()
+
+	require.Error(t, nw.StopValidator(0), "expected the querying validator not to be stopped")
+
+	// the remaining validators hold more than 2/3 of the voting power, so that
+	// the network keeps producing blocks
+	consAddr := nw.GetConsAddress(3)
+	require.NoError(t, nw.StopValidator(3))
+	require.NoError(t, nw.WaitForNextBlock())
+
+	clientCtx := nw.Validators[0].ClientCtx
+	slashingClient := slashingtypes.NewQueryClient(clientCtx)
+	stakingClient := stakingtypes.NewQueryClient(clientCtx)
+
+	require.Eventually(t, func() bool {
+		res, err := slashingClient.SigningInfo(context.Background(), &slashingtypes.QuerySigningInfoRequest{
+			ConsAddress: consAddr.String(),
+		})
+		return err == nil && res.ValSigningInfo.MissedBlocksCounter > 0
+	}, time.Minute, time.Second, "expected the stopped validator to miss blocks")
+
+	require.Eventually(t, func() bool {
+		res, err := stakingClient.Validator(context.Background(), &stakingtypes.QueryValidatorRequest{
+			ValidatorAddr: nw.Validators[3].ValAddress.String(),
+		})
+		return err == nil && res.Validator.Jailed
+	}, time.Minute, time.Second, "expected the stopped validator to be jailed for downtime")
+}
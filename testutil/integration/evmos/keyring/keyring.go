@@ -46,6 +46,12 @@ type Keyring interface {
 
 	// Sign signs message with the specified account.
 	Sign(index int, msg []byte) ([]byte, error)
+
+	// GetSigner returns a SIGN_MODE_DIRECT signer for the account at the given keyring index.
+	GetSigner(index int) Signer
+	// GetEIP712Signer returns a signer that produces the EIP-712 signatures of the
+	// Ledger devices for the account at the given keyring index.
+	GetEIP712Signer(index int) (*EIP712Signer, error)
 }
 
 // IntegrationKeyring is a keyring designed for integration tests.
@@ -117,3 +123,13 @@ func (kr *IntegrationKeyring) Sign(index int, msg []byte) ([]byte, error) {
 	}
 	return privKey.Sign(msg)
 }
+
+// GetSigner returns a SIGN_MODE_DIRECT signer for the specified account.
+func (kr *IntegrationKeyring) GetSigner(index int) Signer {
+	return NewDirectSigner(kr.GetPrivKey(index))
+}
+
+// GetEIP712Signer returns an EIP-712 signer for the specified account.
+func (kr *IntegrationKeyring) GetEIP712Signer(index int) (*EIP712Signer, error) {
+	return NewEIP712Signer(kr.GetPrivKey(index))
+}
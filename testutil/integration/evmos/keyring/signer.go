@@ -0,0 +1,121 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keyring
+
+import (
+	"fmt"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/evmos/evmos/v20/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v20/ethereum/eip712"
+)
+
+// Signer signs the Cosmos transactions of an account. It abstracts the way
+// the signatures are produced, so that the integration tests can reproduce
+// the signing flows of the different wallets.
+type Signer interface {
+	// PubKey returns the public key of the signer.
+	PubKey() cryptotypes.PubKey
+	// SignMode returns the sign mode used to generate the sign bytes
+	// passed to Sign.
+	SignMode() signing.SignMode
+	// Sign signs the sign bytes of a transaction.
+	Sign(signBytes []byte) ([]byte, error)
+}
+
+var (
+	_ Signer = (*DirectSigner)(nil)
+	_ Signer = (*EIP712Signer)(nil)
+)
+
+// DirectSigner signs the SIGN_MODE_DIRECT sign bytes with the private key,
+// like the software keyrings do.
+type DirectSigner struct {
+	priv cryptotypes.PrivKey
+}
+
+// NewDirectSigner returns a new DirectSigner for the given private key.
+func NewDirectSigner(priv cryptotypes.PrivKey) *DirectSigner {
+	return &DirectSigner{priv: priv}
+}
+
+// PubKey returns the public key of the signer.
+func (s *DirectSigner) PubKey() cryptotypes.PubKey {
+	return s.priv.PubKey()
+}
+
+// SignMode returns SIGN_MODE_DIRECT.
+func (s *DirectSigner) SignMode() signing.SignMode {
+	return signing.SignMode_SIGN_MODE_DIRECT
+}
+
+// Sign signs the sign bytes with the private key.
+func (s *DirectSigner) Sign(signBytes []byte) ([]byte, error) {
+	return s.priv.Sign(signBytes)
+}
+
+// EIP712Signer signs the EIP-712 typed data of the SIGN_MODE_LEGACY_AMINO_JSON
+// sign bytes, in the same way as the Ethereum app of the Ledger devices does.
+// The signatures are in the [R || S || V] format, with V being 27 or 28.
+type EIP712Signer struct {
+	priv *ethsecp256k1.PrivKey
+}
+
+// NewEIP712Signer returns a new EIP712Signer for the given private key. It
+// returns an error if the key is not an eth_secp256k1 private key.
+func NewEIP712Signer(priv cryptotypes.PrivKey) (*EIP712Signer, error) {
+	ethPriv, ok := priv.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid private key type %T, expected %T", priv, &ethsecp256k1.PrivKey{})
+	}
+	return &EIP712Signer{priv: ethPriv}, nil
+}
+
+// PubKey returns the public key of the signer.
+func (s *EIP712Signer) PubKey() cryptotypes.PubKey {
+	return s.priv.PubKey()
+}
+
+// SignMode returns SIGN_MODE_LEGACY_AMINO_JSON.
+func (s *EIP712Signer) SignMode() signing.SignMode {
+	return signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+}
+
+// TypedData returns the EIP-712 typed data that is signed for the given sign
+// bytes. It can be used to validate the typed data built by external wallets
+// and SDKs.
+func (s *EIP712Signer) TypedData(signBytes []byte) (apitypes.TypedData, error) {
+	return eip712.GetEIP712TypedDataForMsg(signBytes)
+}
+
+// Sign signs the EIP-712 hash of the typed data for the given sign bytes.
+func (s *EIP712Signer) Sign(signBytes []byte) ([]byte, error) {
+	typedData, err := s.TypedData(signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash EIP-712 typed data: %w", err)
+	}
+
+	key, err := s.priv.ToECDSA()
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := crypto.Sign(hash, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// the Ledger devices return the recovery ID offset by 27
+	sig[crypto.RecoveryIDOffset] += 27
+
+	return sig, nil
+}
@@ -0,0 +1,83 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keyring_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	commonfactory "github.com/evmos/evmos/v20/testutil/integration/common/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+)
+
+func TestNewEIP712Signer(t *testing.T) {
+	_, err := testkeyring.NewEIP712Signer(secp256k1.GenPrivKey())
+	require.Error(t, err, "expected error for a non eth_secp256k1 key")
+
+	kr := testkeyring.New(1)
+	signer, err := kr.GetEIP712Signer(0)
+	require.NoError(t, err)
+	require.Equal(t, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signer.SignMode())
+	require.Equal(t, kr.GetPrivKey(0).PubKey(), signer.PubKey())
+}
+
+func TestSigners(t *testing.T) {
+	kr := testkeyring.New(2)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(kr.GetAllAccAddrs()...),
+	)
+	tf := factory.New(nw, grpc.NewIntegrationHandler(nw))
+
+	eip712Signer, err := kr.GetEIP712Signer(0)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		signer testkeyring.Signer
+	}{
+		{"direct signer", kr.GetSigner(0)},
+		{"EIP-712 signer", eip712Signer},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := &banktypes.MsgSend{
+				FromAddress: kr.GetAccAddr(0).String(),
+				ToAddress:   kr.GetAccAddr(1).String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(nw.GetDenom(), math.NewInt(1e14))),
+			}
+			txArgs := commonfactory.CosmosTxArgs{Msgs: []sdk.Msg{msg}}
+
+			tx, err := tf.BuildCosmosTxWithSigner(tc.signer, txArgs)
+			require.NoError(t, err)
+
+			sigs, err := tx.GetSignaturesV2()
+			require.NoError(t, err)
+			require.Len(t, sigs, 1)
+			data, ok := sigs[0].Data.(*signing.SingleSignatureData)
+			require.True(t, ok)
+			require.Equal(t, tc.signer.SignMode(), data.SignMode)
+
+			if tc.signer.SignMode() == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+				// the Ledger signatures are in the [R || S || V] format with V as 27 or 28
+				require.Len(t, data.Signature, crypto.SignatureLength)
+				require.Contains(t, []byte{27, 28}, data.Signature[crypto.RecoveryIDOffset])
+			}
+
+			res, err := tf.CommitCosmosTxWithSigner(tc.signer, txArgs)
+			require.NoError(t, err)
+			require.True(t, res.IsOK(), "expected tx to succeed: %s", res.Log)
+		})
+	}
+}
@@ -31,6 +31,7 @@ type Config struct {
 	otherCoinDenom     []string
 	operatorsAddrs     []sdktypes.AccAddress
 	customBaseAppOpts  []func(*baseapp.BaseApp)
+	appOpts            map[string]interface{}
 }
 
 type CustomGenesisState map[string]interface{}
@@ -152,3 +153,11 @@ func WithCustomBaseAppOpts(opts ...func(*baseapp.BaseApp)) ConfigOption {
 		cfg.customBaseAppOpts = opts
 	}
 }
+
+// WithAppOptions sets the app options, e.g. the node config flags, for the
+// network.
+func WithAppOptions(opts map[string]interface{}) ConfigOption {
+	return func(cfg *Config) {
+		cfg.appOpts = opts
+	}
+}
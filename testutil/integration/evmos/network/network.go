@@ -126,7 +126,7 @@ func (n *IntegrationNetwork) configureAndInitChain() error {
 	delegations := createDelegations(validators, genAccounts[0].GetAddress())
 
 	// Create a new EvmosApp with the following params
-	evmosApp := createEvmosApp(n.cfg.chainID, n.cfg.customBaseAppOpts...)
+	evmosApp := createEvmosApp(n.cfg.chainID, n.cfg.appOpts, n.cfg.customBaseAppOpts...)
 
 	stakingParams := StakingCustomGenesisState{
 		denom:       n.cfg.denom,
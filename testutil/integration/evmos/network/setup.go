@@ -20,6 +20,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	simutils "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -153,7 +154,7 @@ func createBalances(accounts []sdktypes.AccAddress, denoms []string) []banktypes
 }
 
 // createEvmosApp creates an evmos app
-func createEvmosApp(chainID string, customBaseAppOptions ...func(*baseapp.BaseApp)) *app.Evmos {
+func createEvmosApp(chainID string, appOpts map[string]interface{}, customBaseAppOptions ...func(*baseapp.BaseApp)) *app.Evmos {
 	// Create evmos app
 	db := dbm.NewMemDB()
 	logger := log.NewNopLogger()
@@ -161,7 +162,10 @@ func createEvmosApp(chainID string, customBaseAppOptions ...func(*baseapp.BaseAp
 	skipUpgradeHeights := map[int64]bool{}
 	homePath := app.DefaultNodeHome
 	invCheckPeriod := uint(5)
-	appOptions := simutils.NewAppOptionsWithFlagHome(app.DefaultNodeHome)
+	appOptions := simutils.AppOptionsMap{flags.FlagHome: app.DefaultNodeHome}
+	for key, value := range appOpts {
+		appOptions[key] = value
+	}
 	baseAppOptions := append(customBaseAppOptions, baseapp.SetChainID(chainID)) //nolint:gocritic
 
 	return app.NewEvmos(
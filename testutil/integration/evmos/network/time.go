@@ -0,0 +1,67 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package network
+
+import (
+	"fmt"
+	"time"
+)
+
+// AdvanceToTime produces a block with the given block time, so that the begin
+// and end blockers of the modules (e.g. epochs, inflation and feemarket) run
+// at the given time. It returns an error if the given time is not after the
+// current block time.
+//
+// NOTE: the epochs module ends at most one epoch per block, so the epochs that
+// elapsed in between end in the following blocks. Use FastForwardEpochs to end
+// a given number of epochs.
+func (n *IntegrationNetwork) AdvanceToTime(t time.Time) error {
+	blockTime := n.ctx.BlockTime()
+	if !t.After(blockTime) {
+		return fmt.Errorf("time %s is not after the current block time %s", t, blockTime)
+	}
+	return n.NextBlockAfter(t.Sub(blockTime))
+}
+
+// FastForwardEpochs ends the given number of epochs of the inflation module
+// epoch identifier, producing one block right after the end of each epoch.
+func (n *IntegrationNetwork) FastForwardEpochs(epochs int) error {
+	return n.FastForwardEpochsOf(n.app.InflationKeeper.GetEpochIdentifier(n.ctx), epochs)
+}
+
+// FastForwardEpochsOf ends the given number of epochs with the given identifier,
+// producing one block right after the end of each epoch. The epoch counting is
+// started first if it hasn't started yet.
+func (n *IntegrationNetwork) FastForwardEpochsOf(identifier string, epochs int) error {
+	epochInfo, found := n.app.EpochsKeeper.GetEpochInfo(n.ctx, identifier)
+	if !found {
+		return fmt.Errorf("epoch info not found for identifier %s", identifier)
+	}
+
+	if !epochInfo.EpochCountingStarted {
+		startTime := epochInfo.StartTime
+		if !startTime.After(n.ctx.BlockTime()) {
+			startTime = n.ctx.BlockTime().Add(time.Second)
+		}
+		if err := n.AdvanceToTime(startTime); err != nil {
+			return err
+		}
+	}
+
+	for i := 0; i < epochs; i++ {
+		epochInfo, _ = n.app.EpochsKeeper.GetEpochInfo(n.ctx, identifier)
+
+		// the epoch ends in the first block after its end time
+		epochEndTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+		blockTime := epochEndTime.Add(time.Second)
+		if !blockTime.After(n.ctx.BlockTime()) {
+			blockTime = n.ctx.BlockTime().Add(time.Second)
+		}
+
+		if err := n.AdvanceToTime(blockTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
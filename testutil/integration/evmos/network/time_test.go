@@ -0,0 +1,45 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdvanceToTime(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	blockTime := nw.GetContext().BlockTime()
+	height := nw.GetContext().BlockHeight()
+
+	require.Error(t, nw.AdvanceToTime(blockTime), "expected error when not advancing the time")
+	require.Error(t, nw.AdvanceToTime(blockTime.Add(-time.Hour)), "expected error when travelling back in time")
+
+	target := blockTime.Add(48 * time.Hour)
+	require.NoError(t, nw.AdvanceToTime(target))
+	require.True(t, target.Equal(nw.GetContext().BlockTime()))
+	require.Equal(t, height+1, nw.GetContext().BlockHeight())
+}
+
+func TestFastForwardEpochs(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	identifier := nw.App.InflationKeeper.GetEpochIdentifier(nw.GetContext())
+
+	// start the epoch counting
+	require.NoError(t, nw.FastForwardEpochs(0))
+	before, found := nw.App.EpochsKeeper.GetEpochInfo(nw.GetContext(), identifier)
+	require.True(t, found)
+	require.True(t, before.EpochCountingStarted)
+
+	require.NoError(t, nw.FastForwardEpochs(3))
+	after, found := nw.App.EpochsKeeper.GetEpochInfo(nw.GetContext(), identifier)
+	require.True(t, found)
+	require.Equal(t, before.CurrentEpoch+3, after.CurrentEpoch)
+	require.Equal(t, before.CurrentEpochStartTime.Add(3*before.Duration), after.CurrentEpochStartTime)
+
+	require.Error(t, nw.FastForwardEpochsOf("unknown", 1))
+}
@@ -605,6 +605,16 @@ func (n *Network) WaitForNextBlock() error {
 	return err
 }
 
+// StopNode stops the CometBFT node of the validator, so that it no longer
+// proposes nor signs the blocks of the network. A stopped node cannot be
+// started again.
+func (v *Validator) StopNode() error {
+	if v.tmNode == nil || !v.tmNode.IsRunning() {
+		return nil
+	}
+	return v.tmNode.Stop()
+}
+
 // Cleanup removes the root testing (temporary) directory and stops both the
 // Tendermint and API services. It allows other callers to create and start
 // test networks. This method must be called when a test is finished, typically
@@ -19,3 +19,35 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMBloomIndexer defines the interface of an eth tx indexer that also maintains
+// the bloom-bits index used to filter logs over wide block ranges. The blocks are
+// grouped in sections and, for each of the bloom bits, the index stores the
+// bitset of the blocks of a section that have the bit set.
+type EVMBloomIndexer interface {
+	// BloomSectionSize returns the number of blocks of a section.
+	BloomSectionSize() uint64
+	// BloomSections returns the number of indexed sections.
+	BloomSections() (uint64, error)
+	// HasBloomSection returns true if the section is indexed.
+	HasBloomSection(section uint64) (bool, error)
+	// BloomBits returns the bitset of the bloom bit for the blocks of the section.
+	BloomBits(bit uint, section uint64) ([]byte, error)
+	// IndexBloomSection indexes a section from the tx results of each of its blocks.
+	IndexBloomSection(section uint64, txResults [][]*abci.ExecTxResult) error
+}
+
+// EVMIndexerMaintainer defines the interface of an eth tx indexer that can be
+// backfilled with historical blocks and pruned.
+type EVMIndexerMaintainer interface {
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
+	// BackfillHeight returns the height down to which all the blocks are
+	// indexed, or -1 if it's not set.
+	BackfillHeight() (int64, error)
+	// SetBackfillHeight sets the height down to which all the blocks are indexed.
+	SetBackfillHeight(int64) error
+	// PruneBlocks deletes the entries of the blocks lower than the retain height
+	// and returns the number of deleted eth txs.
+	PruneBlocks(retainHeight int64) (uint64, error)
+}
@@ -0,0 +1,163 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// SetCodeTxType is the EIP-2718 type of the EIP-7702 set code transactions
+	SetCodeTxType = 0x04
+
+	// setCodeAuthorizationMagic is the prefix of the signing hash of the
+	// EIP-7702 authorizations
+	setCodeAuthorizationMagic = 0x05
+)
+
+// DelegationPrefix is the prefix of the code of the accounts delegated through
+// an EIP-7702 authorization, followed by the address of the delegated code.
+var DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+// AddressToDelegation returns the code that delegates an account to the address.
+func AddressToDelegation(addr common.Address) []byte {
+	return append(common.CopyBytes(DelegationPrefix), addr.Bytes()...)
+}
+
+// ParseDelegation returns the address of the delegated code if the code is an
+// EIP-7702 delegation designator.
+func ParseDelegation(code []byte) (common.Address, bool) {
+	if len(code) != len(DelegationPrefix)+common.AddressLength {
+		return common.Address{}, false
+	}
+	for i, b := range DelegationPrefix {
+		if code[i] != b {
+			return common.Address{}, false
+		}
+	}
+	return common.BytesToAddress(code[len(DelegationPrefix):]), true
+}
+
+// SetCodeAuthorization is the RLP representation of an EIP-7702 authorization.
+type SetCodeAuthorization struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+	V, R, S *big.Int
+}
+
+// SigHash returns the hash signed by the authority of the authorization.
+func (a *SetCodeAuthorization) SigHash() common.Hash {
+	bz, _ := rlp.EncodeToBytes([]interface{}{a.ChainID, a.Address, a.Nonce}) //nolint:errcheck // encoding can't fail
+	return crypto.Keccak256Hash([]byte{setCodeAuthorizationMagic}, bz)
+}
+
+// Authority returns the address of the signer of the authorization.
+func (a *SetCodeAuthorization) Authority() (common.Address, error) {
+	return recoverSigner(a.SigHash(), a.V, a.R, a.S)
+}
+
+// SetCodeTx is the RLP representation of an EIP-7702 set code transaction.
+type SetCodeTx struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+	AuthList   []SetCodeAuthorization
+
+	V, R, S *big.Int
+}
+
+// SigHash returns the hash signed by the sender of the transaction.
+func (tx *SetCodeTx) SigHash() common.Hash {
+	bz, _ := rlp.EncodeToBytes([]interface{}{ //nolint:errcheck // encoding can't fail
+		tx.ChainID,
+		tx.Nonce,
+		tx.GasTipCap,
+		tx.GasFeeCap,
+		tx.Gas,
+		tx.To,
+		tx.Value,
+		tx.Data,
+		tx.AccessList,
+		tx.AuthList,
+	})
+	return crypto.Keccak256Hash([]byte{SetCodeTxType}, bz)
+}
+
+// Hash returns the hash of the transaction.
+func (tx *SetCodeTx) Hash() common.Hash {
+	bz, _ := tx.MarshalBinary() //nolint:errcheck // encoding can't fail
+	return crypto.Keccak256Hash(bz)
+}
+
+// Sender returns the address of the signer of the transaction.
+func (tx *SetCodeTx) Sender() (common.Address, error) {
+	return recoverSigner(tx.SigHash(), tx.V, tx.R, tx.S)
+}
+
+// MarshalBinary returns the EIP-2718 encoding of the transaction.
+func (tx *SetCodeTx) MarshalBinary() ([]byte, error) {
+	bz, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{SetCodeTxType}, bz...), nil
+}
+
+// IsSetCodeTx returns true if the bytes are the EIP-2718 encoding of a set code transaction.
+func IsSetCodeTx(b []byte) bool {
+	return len(b) > 0 && b[0] == SetCodeTxType
+}
+
+// DecodeSetCodeTx decodes the EIP-2718 encoding of a set code transaction.
+func DecodeSetCodeTx(b []byte) (*SetCodeTx, error) {
+	if !IsSetCodeTx(b) {
+		return nil, fmt.Errorf("expected set code tx type %d", SetCodeTxType)
+	}
+
+	tx := new(SetCodeTx)
+	if err := rlp.DecodeBytes(b[1:], tx); err != nil {
+		return nil, fmt.Errorf("invalid set code transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// recoverSigner recovers the signer of the hash from the [0, 1] y-parity
+// signature values. The s value must be in the lower half of the curve order.
+func recoverSigner(hash common.Hash, v, r, s *big.Int) (common.Address, error) {
+	if r == nil || s == nil {
+		return common.Address{}, errors.New("missing signature values")
+	}
+	if v == nil {
+		// a zero y-parity is stored as empty bytes
+		v = new(big.Int)
+	}
+	if v.BitLen() > 8 || !crypto.ValidateSignatureValues(byte(v.Uint64()), r, s, true) {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = byte(v.Uint64())
+
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
@@ -0,0 +1,82 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegation(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	code := AddressToDelegation(addr)
+	require.Len(t, code, 23)
+
+	parsed, ok := ParseDelegation(code)
+	require.True(t, ok)
+	require.Equal(t, addr, parsed)
+
+	_, ok = ParseDelegation(code[:22])
+	require.False(t, ok)
+	_, ok = ParseDelegation(append([]byte{0xef, 0x01, 0x01}, addr.Bytes()...))
+	require.False(t, ok)
+}
+
+func TestSetCodeTxRoundTrip(t *testing.T) {
+	senderKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	authorityKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	auth := SetCodeAuthorization{
+		ChainID: big.NewInt(9001),
+		Address: common.HexToAddress("0x1000000000000000000000000000000000000001"),
+		Nonce:   3,
+	}
+	sig, err := crypto.Sign(auth.SigHash().Bytes(), authorityKey)
+	require.NoError(t, err)
+	auth.R, auth.S, auth.V = splitSignature(sig)
+
+	tx := &SetCodeTx{
+		ChainID:    big.NewInt(9001),
+		Nonce:      1,
+		GasTipCap:  big.NewInt(1),
+		GasFeeCap:  big.NewInt(10),
+		Gas:        100000,
+		To:         common.HexToAddress("0x2000000000000000000000000000000000000002"),
+		Value:      big.NewInt(0),
+		AccessList: ethtypes.AccessList{},
+		AuthList:   []SetCodeAuthorization{auth},
+	}
+	sig, err = crypto.Sign(tx.SigHash().Bytes(), senderKey)
+	require.NoError(t, err)
+	tx.R, tx.S, tx.V = splitSignature(sig)
+
+	bz, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	require.True(t, IsSetCodeTx(bz))
+
+	decoded, err := DecodeSetCodeTx(bz)
+	require.NoError(t, err)
+	require.Equal(t, tx.Hash(), decoded.Hash())
+
+	sender, err := decoded.Sender()
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(senderKey.PublicKey), sender)
+
+	require.Len(t, decoded.AuthList, 1)
+	authority, err := decoded.AuthList[0].Authority()
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(authorityKey.PublicKey), authority)
+
+	_, err = DecodeSetCodeTx(bz[1:])
+	require.Error(t, err)
+}
+
+func splitSignature(sig []byte) (r, s, v *big.Int) {
+	return new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]), new(big.Int).SetUint64(uint64(sig[64]))
+}
@@ -26,6 +26,13 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
+		GetPauseStatusCmd(),
+		GetTokenPairsHeldByCmd(),
+		GetTokenPairHoldersCmd(),
+		GetTokenPairHolderBalancesCmd(),
+		GetAutoConversionOptOutCmd(),
+		GetConversionRateLimitCmd(),
+		GetDenomGroupCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -100,6 +107,250 @@ func GetTokenPairCmd() *cobra.Command {
 	return cmd
 }
 
+// GetPauseStatusCmd queries whether a registered token pair is paused
+func GetPauseStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-status TOKEN",
+		Short: "Get the pause status of a registered token pair",
+		Long:  "Get the pause status of a registered token pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPauseStatusRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.PauseStatus(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTokenPairsHeldByCmd queries the registered token pairs in which an address holds a balance
+func GetTokenPairsHeldByCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pairs-held-by ADDRESS",
+		Short: "Gets the registered token pairs in which an address holds a balance",
+		Long:  "Gets the registered token pairs in which the given hex or bech32 address holds a non-zero balance",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTokenPairsHeldByRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TokenPairsHeldBy(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTokenPairHoldersCmd queries the accounts holding a registered token pair
+func GetTokenPairHoldersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pair-holders TOKEN",
+		Short: "Gets the accounts holding a registered token pair",
+		Long:  "Gets the accounts holding a non-zero balance of a registered token pair along with their count, where the token can be the ERC20 contract address or the Cosmos base denomination",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTokenPairHoldersRequest{
+				Token:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TokenPairHolders(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token-pair-holders")
+	return cmd
+}
+
+// GetTokenPairHolderBalancesCmd queries the balances of the accounts holding a
+// registered token pair
+func GetTokenPairHolderBalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pair-holder-balances TOKEN",
+		Short: "Gets the balances of the accounts holding a registered token pair",
+		Long:  "Gets the bank and ERC20 balances of the accounts holding a registered token pair, so that they can be migrated before the token pair is sunset, where the token can be the ERC20 contract address or the Cosmos base denomination",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTokenPairHolderBalancesRequest{
+				Token:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TokenPairHolderBalances(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token-pair-holder-balances")
+	return cmd
+}
+
+// GetAutoConversionOptOutCmd queries whether an address opted out of the automatic conversion
+func GetAutoConversionOptOutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-conversion-opt-out ADDRESS",
+		Short: "Gets whether an address opted out of the automatic conversion of the received IBC vouchers",
+		Long:  "Gets whether the given hex or bech32 address opted out of the automatic conversion of the received IBC vouchers to their ERC20 representation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAutoConversionOptOutRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.AutoConversionOptOut(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetConversionRateLimitCmd queries the conversion rate limit of a token pair
+func GetConversionRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversion-rate-limit TOKEN",
+		Short: "Gets the conversion rate limit of a registered token pair",
+		Long:  "Gets the conversion rate limit of a registered token pair and the amount converted within its current epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConversionRateLimitRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.ConversionRateLimit(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDenomGroupCmd queries the members of a denom group and their aggregate supply
+func GetDenomGroupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-group GROUP_ID",
+		Short: "Gets the denominations of a denom group and their aggregate supply",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomGroupRequest{
+				GroupId: args[0],
+			}
+
+			res, err := queryClient.DenomGroup(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParamsCmd queries erc20 module params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -5,6 +5,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -33,6 +34,8 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewConvertERC20Cmd(),
+		NewRegisterERC20Cmd(),
+		NewSetAutoConversionOptOutCmd(),
 	)
 	return txCmd
 }
@@ -83,3 +86,69 @@ func NewConvertERC20Cmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewRegisterERC20Cmd returns a CLI command handler for registering the token
+// pairs of deployed ERC20 contracts without a governance proposal. It requires
+// permissionless registration to be enabled and burns the registration fee
+// for each contract from the sender.
+func NewRegisterERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20 CONTRACT_ADDRESS...",
+		Short: "Register the token pairs of deployed ERC20 contracts, paying the registration fee for each of them",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			for _, contract := range args {
+				if err := evmostypes.ValidateAddress(contract); err != nil {
+					return fmt.Errorf("invalid ERC20 contract address %w", err)
+				}
+			}
+
+			msg := &types.MsgRegisterERC20{
+				Authority:      cliCtx.GetFromAddress().String(),
+				Erc20Addresses: args,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetAutoConversionOptOutCmd returns a CLI command handler for opting the
+// sender out of, or back into, the automatic conversion of the received IBC
+// vouchers to their ERC20 representation.
+func NewSetAutoConversionOptOutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-conversion-opt-out [true|false]",
+		Short: "Opt out of (true) or back into (false) the automatic conversion of the received IBC vouchers to ERC20",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			optOut, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid opt out value %s: %w", args[0], err)
+			}
+
+			msg := &types.MsgSetAutoConversionOptOut{
+				Sender: cliCtx.GetFromAddress().String(),
+				OptOut: optOut,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/evmos/evmos/v20/x/erc20/keeper"
 	"github.com/evmos/evmos/v20/x/erc20/types"
@@ -20,6 +21,12 @@ func InitGenesis(
 	accountKeeper authkeeper.AccountKeeper,
 	data types.GenesisState,
 ) {
+	// NOTE: the token pairs are set before the params, as the fee tokens must
+	// be the denominations of registered token pairs
+	for _, pair := range data.TokenPairs {
+		k.SetToken(ctx, pair)
+	}
+
 	err := k.SetParams(ctx, data.Params)
 	if err != nil {
 		panic(fmt.Errorf("error setting params %s", err))
@@ -31,15 +38,83 @@ func InitGenesis(
 		panic("the erc20 module account has not been set")
 	}
 
-	for _, pair := range data.TokenPairs {
-		k.SetToken(ctx, pair)
+	for _, creator := range data.TokenPairCreators {
+		id := k.GetTokenPairID(ctx, creator.Erc20Address)
+		if len(id) == 0 {
+			panic(fmt.Errorf("token pair not found for creator: %s", creator.Erc20Address))
+		}
+		k.SetTokenPairCreator(ctx, id, common.HexToAddress(creator.Creator))
+	}
+
+	for _, account := range data.AutoConversionOptOuts {
+		k.SetAutoConversionOptedOut(ctx, sdk.MustAccAddressFromBech32(account), true)
+	}
+
+	for _, rateLimit := range data.ConversionRateLimits {
+		if err := k.SetConversionRateLimit(ctx, rateLimit.Erc20Address, rateLimit.MaxAmount, rateLimit.EpochDuration); err != nil {
+			panic(fmt.Errorf("error setting conversion rate limit %s: %w", rateLimit.Erc20Address, err))
+		}
+	}
+
+	for _, blocked := range data.BlockedAccounts {
+		if err := k.SetAccountBlocked(ctx, blocked.Erc20Address, common.HexToAddress(blocked.Account), true); err != nil {
+			panic(fmt.Errorf("error blocking account %s: %w", blocked.Account, err))
+		}
+	}
+
+	for _, erc20Address := range data.EventsDisabledTokenPairs {
+		id := k.GetTokenPairID(ctx, erc20Address)
+		if len(id) == 0 {
+			panic(fmt.Errorf("token pair not found for disabled events: %s", erc20Address))
+		}
+		k.SetEmitEvents(ctx, id, false)
+	}
+
+	for _, erc20Address := range data.DeregisteredTokenPairs {
+		id := k.GetTokenPairID(ctx, erc20Address)
+		if len(id) == 0 {
+			panic(fmt.Errorf("token pair not found for deregistration: %s", erc20Address))
+		}
+		k.SetTokenPairDeregistered(ctx, id, true)
+	}
+
+	for _, features := range data.ComplianceFeatures {
+		id := k.GetTokenPairID(ctx, features.Erc20Address)
+		if len(id) == 0 {
+			panic(fmt.Errorf("token pair not found for compliance features: %s", features.Erc20Address))
+		}
+		k.SetComplianceFeatures(ctx, id, types.ComplianceFeature(features.Features))
+	}
+
+	for _, group := range data.DenomGroups {
+		if err := k.SetDenomGroup(ctx, group.GroupId, group.Denoms); err != nil {
+			panic(fmt.Errorf("error setting denom group %s: %w", group.GroupId, err))
+		}
+	}
+
+	for _, allowance := range data.Allowances {
+		erc20 := common.HexToAddress(allowance.Erc20Address)
+		owner := common.HexToAddress(allowance.Owner)
+		spender := common.HexToAddress(allowance.Spender)
+		if err := k.SetAllowance(ctx, erc20, owner, spender, allowance.Value.BigInt()); err != nil {
+			panic(fmt.Errorf("error setting allowance of %s over %s: %w", allowance.Spender, allowance.Owner, err))
+		}
 	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		TokenPairs: k.GetTokenPairs(ctx),
+		Params:                   k.GetParams(ctx),
+		TokenPairs:               k.GetTokenPairs(ctx),
+		TokenPairCreators:        k.GetTokenPairCreators(ctx),
+		BlockedAccounts:          k.GetBlockedAccounts(ctx),
+		AutoConversionOptOuts:    k.GetAutoConversionOptOuts(ctx),
+		ConversionRateLimits:     k.GetConversionRateLimits(ctx),
+		Allowances:               k.GetAllAllowances(ctx),
+		EventsDisabledTokenPairs: k.GetEventsDisabledTokenPairs(ctx),
+		DenomGroups:              k.GetDenomGroups(ctx),
+		ComplianceFeatures:       k.GetAllComplianceFeatures(ctx),
+		DeregisteredTokenPairs:   k.GetDeregisteredTokenPairs(ctx),
 	}
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/cometbft/cometbft/version"
 
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
@@ -171,3 +172,93 @@ func (suite *GenesisTestSuite) TestErc20ExportGenesis() {
 		})
 	}
 }
+
+func (suite *GenesisTestSuite) TestErc20GenesisTokenPairCreators() {
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), osmoDenomTrace.IBCDenom(), types.OWNER_MODULE)
+	legacyPair := types.NewTokenPair(utiltx.GenerateAddress(), "legacy", types.OWNER_MODULE)
+	creator := utiltx.GenerateAddress()
+
+	genesisState := types.NewGenesisState(types.DefaultParams(), []types.TokenPair{pair, legacyPair})
+	genesisState.TokenPairCreators = []types.TokenPairCreator{
+		{Erc20Address: pair.Erc20Address, Creator: creator.Hex()},
+	}
+
+	erc20.InitGenesis(suite.ctx, suite.app.Erc20Keeper, suite.app.AccountKeeper, genesisState)
+
+	suite.Require().Equal(creator, suite.app.Erc20Keeper.GetTokenPairCreator(suite.ctx, pair.GetID()))
+	// legacy pairs without a recorded creator default to the zero address
+	suite.Require().Equal(common.Address{}, suite.app.Erc20Keeper.GetTokenPairCreator(suite.ctx, legacyPair.GetID()))
+
+	genesisExported := erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper)
+	suite.Require().Equal(genesisState.TokenPairCreators, genesisExported.TokenPairCreators)
+}
+
+func (suite *GenesisTestSuite) TestErc20GenesisBlockedAccounts() {
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), osmoDenomTrace.IBCDenom(), types.OWNER_MODULE)
+	blocked := utiltx.GenerateAddress()
+
+	genesisState := types.NewGenesisState(types.DefaultParams(), []types.TokenPair{pair})
+	genesisState.BlockedAccounts = []types.BlockedAccount{
+		{Erc20Address: pair.Erc20Address, Account: blocked.Hex()},
+	}
+
+	erc20.InitGenesis(suite.ctx, suite.app.Erc20Keeper, suite.app.AccountKeeper, genesisState)
+
+	suite.Require().True(suite.app.Erc20Keeper.IsAccountBlocked(suite.ctx, pair.GetID(), blocked))
+	suite.Require().False(suite.app.Erc20Keeper.IsAccountBlocked(suite.ctx, pair.GetID(), utiltx.GenerateAddress()))
+
+	genesisExported := erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper)
+	suite.Require().Equal(genesisState.BlockedAccounts, genesisExported.BlockedAccounts)
+}
+
+func (suite *GenesisTestSuite) TestErc20GenesisEventsDisabledTokenPairs() {
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), osmoDenomTrace.IBCDenom(), types.OWNER_MODULE)
+	otherPair := types.NewTokenPair(utiltx.GenerateAddress(), "other", types.OWNER_MODULE)
+
+	genesisState := types.NewGenesisState(types.DefaultParams(), []types.TokenPair{pair, otherPair})
+	genesisState.EventsDisabledTokenPairs = []string{pair.Erc20Address}
+
+	erc20.InitGenesis(suite.ctx, suite.app.Erc20Keeper, suite.app.AccountKeeper, genesisState)
+
+	suite.Require().False(suite.app.Erc20Keeper.GetEmitEvents(suite.ctx, pair.GetID()))
+	suite.Require().True(suite.app.Erc20Keeper.GetEmitEvents(suite.ctx, otherPair.GetID()))
+
+	genesisExported := erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper)
+	suite.Require().Equal(genesisState.EventsDisabledTokenPairs, genesisExported.EventsDisabledTokenPairs)
+}
+
+func (suite *GenesisTestSuite) TestErc20GenesisDenomGroups() {
+	genesisState := types.NewGenesisState(types.DefaultParams(), []types.TokenPair{})
+	genesisState.DenomGroups = []types.DenomGroup{
+		{GroupId: "atom", Denoms: []string{"uatom", "watom"}},
+		{GroupId: "osmo", Denoms: []string{"uosmo"}},
+	}
+
+	erc20.InitGenesis(suite.ctx, suite.app.Erc20Keeper, suite.app.AccountKeeper, genesisState)
+
+	groupID, found := suite.app.Erc20Keeper.GetDenomGroupID(suite.ctx, "watom")
+	suite.Require().True(found)
+	suite.Require().Equal("atom", groupID)
+
+	genesisExported := erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper)
+	suite.Require().Equal(genesisState.DenomGroups, genesisExported.DenomGroups)
+}
+
+func (suite *GenesisTestSuite) TestErc20GenesisComplianceFeatures() {
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), osmoDenomTrace.IBCDenom(), types.OWNER_MODULE)
+
+	genesisState := types.NewGenesisState(types.DefaultParams(), []types.TokenPair{pair})
+	genesisState.ComplianceFeatures = []types.TokenPairComplianceFeatures{
+		{Erc20Address: pair.Erc20Address, Features: uint32(types.ComplianceScreening | types.ComplianceWhitelist)},
+	}
+
+	erc20.InitGenesis(suite.ctx, suite.app.Erc20Keeper, suite.app.AccountKeeper, genesisState)
+
+	suite.Require().Equal(
+		types.ComplianceScreening|types.ComplianceWhitelist,
+		suite.app.Erc20Keeper.GetComplianceFeatures(suite.ctx, pair.GetID()),
+	)
+
+	genesisExported := erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper)
+	suite.Require().Equal(genesisState.ComplianceFeatures, genesisExported.ComplianceFeatures)
+}
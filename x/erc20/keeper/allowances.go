@@ -0,0 +1,97 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// GetAllowance returns the amount of the tokens of the given ERC20 contract
+// that the spender can transfer on behalf of the owner through the ERC-20
+// precompile. It returns zero if there is no allowance.
+func (k Keeper) GetAllowance(ctx sdk.Context, erc20, owner, spender common.Address) *big.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowance)
+	bz := store.Get(types.AllowanceKey(erc20, owner, spender))
+	return new(big.Int).SetBytes(bz)
+}
+
+// SetAllowance sets the amount of the tokens of the given ERC20 contract that
+// the spender can transfer on behalf of the owner. A zero value removes the
+// allowance. Unlike the authz grants, the allowances never expire. It returns
+// an error if the value is negative or doesn't fit in a uint256, or if the
+// spender is the owner.
+func (k Keeper) SetAllowance(ctx sdk.Context, erc20, owner, spender common.Address, value *big.Int) error {
+	if owner == spender {
+		return errorsmod.Wrapf(types.ErrInvalidAllowance, "spender cannot be the owner %s", owner)
+	}
+
+	if value.Sign() < 0 || value.BitLen() > 256 {
+		return errorsmod.Wrapf(types.ErrInvalidAllowance, "value %s out of the uint256 range", value)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowance)
+	key := types.AllowanceKey(erc20, owner, spender)
+	if value.Sign() == 0 {
+		store.Delete(key)
+		return nil
+	}
+
+	store.Set(key, value.Bytes())
+	return nil
+}
+
+// GetAllowances returns the allowances of the given owner over the tokens of
+// the given ERC20 contract, ordered by spender address.
+func (k Keeper) GetAllowances(ctx sdk.Context, erc20, owner common.Address) []types.Allowance {
+	var allowances []types.Allowance
+
+	k.iterateAllowances(ctx, types.AllowancesKey(erc20, owner), func(allowance types.Allowance) (stop bool) {
+		allowances = append(allowances, allowance)
+		return false
+	})
+
+	return allowances
+}
+
+// GetAllAllowances returns the allowances of all the owners over the tokens of
+// all the ERC20 contracts.
+func (k Keeper) GetAllAllowances(ctx sdk.Context) []types.Allowance {
+	var allowances []types.Allowance
+
+	k.iterateAllowances(ctx, nil, func(allowance types.Allowance) (stop bool) {
+		allowances = append(allowances, allowance)
+		return false
+	})
+
+	return allowances
+}
+
+// iterateAllowances iterates over the allowances whose key starts with the
+// given prefix and calls the callback for each of them until it returns true.
+func (k Keeper) iterateAllowances(ctx sdk.Context, keyPrefix []byte, cb func(allowance types.Allowance) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowance)
+	iterator := prefix.NewStore(store, keyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := append(append([]byte{}, keyPrefix...), iterator.Key()...)
+		allowance := types.Allowance{
+			Erc20Address: common.BytesToAddress(key[:common.AddressLength]).Hex(),
+			Owner:        common.BytesToAddress(key[common.AddressLength : 2*common.AddressLength]).Hex(),
+			Spender:      common.BytesToAddress(key[2*common.AddressLength:]).Hex(),
+			Value:        math.NewIntFromBigInt(new(big.Int).SetBytes(iterator.Value())),
+		}
+		if cb(allowance) {
+			break
+		}
+	}
+}
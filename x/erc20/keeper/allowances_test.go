@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestSetAllowance() {
+	erc20 := utiltx.GenerateAddress()
+	owner := utiltx.GenerateAddress()
+	spender := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		owner        common.Address
+		value        *big.Int
+		expErr       error
+		expAllowance *big.Int
+	}{
+		{
+			"fail - spender is owner",
+			func() {},
+			spender,
+			big.NewInt(100),
+			types.ErrInvalidAllowance,
+			common.Big0,
+		},
+		{
+			"fail - negative value",
+			func() {},
+			owner,
+			big.NewInt(-1),
+			types.ErrInvalidAllowance,
+			common.Big0,
+		},
+		{
+			"fail - value overflows uint256",
+			func() {},
+			owner,
+			new(big.Int).Add(abi.MaxUint256, common.Big1),
+			types.ErrInvalidAllowance,
+			common.Big0,
+		},
+		{
+			"pass - set allowance",
+			func() {},
+			owner,
+			big.NewInt(100),
+			nil,
+			big.NewInt(100),
+		},
+		{
+			"pass - set max uint256 allowance",
+			func() {},
+			owner,
+			abi.MaxUint256,
+			nil,
+			abi.MaxUint256,
+		},
+		{
+			"pass - overwrite allowance",
+			func() {
+				err := suite.network.App.Erc20Keeper.SetAllowance(suite.network.GetContext(), erc20, owner, spender, big.NewInt(200))
+				suite.Require().NoError(err)
+			},
+			owner,
+			big.NewInt(100),
+			nil,
+			big.NewInt(100),
+		},
+		{
+			"pass - delete allowance with zero value",
+			func() {
+				err := suite.network.App.Erc20Keeper.SetAllowance(suite.network.GetContext(), erc20, owner, spender, big.NewInt(200))
+				suite.Require().NoError(err)
+			},
+			owner,
+			common.Big0,
+			nil,
+			common.Big0,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			tc.malleate()
+
+			err := suite.network.App.Erc20Keeper.SetAllowance(ctx, erc20, tc.owner, spender, tc.value)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			allowance := suite.network.App.Erc20Keeper.GetAllowance(ctx, erc20, tc.owner, spender)
+			suite.Require().Equal(tc.expAllowance.String(), allowance.String())
+
+			if tc.expAllowance.Sign() == 0 {
+				suite.Require().Empty(suite.network.App.Erc20Keeper.GetAllowances(ctx, erc20, tc.owner))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetAllowances() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	erc20 := utiltx.GenerateAddress()
+	otherErc20 := utiltx.GenerateAddress()
+	owner := utiltx.GenerateAddress()
+	otherOwner := utiltx.GenerateAddress()
+	spender := utiltx.GenerateAddress()
+
+	k := suite.network.App.Erc20Keeper
+	suite.Require().NoError(k.SetAllowance(ctx, erc20, owner, spender, big.NewInt(100)))
+	suite.Require().NoError(k.SetAllowance(ctx, erc20, otherOwner, spender, big.NewInt(200)))
+	suite.Require().NoError(k.SetAllowance(ctx, otherErc20, owner, spender, big.NewInt(300)))
+
+	allowances := k.GetAllowances(ctx, erc20, owner)
+	suite.Require().Len(allowances, 1)
+	suite.Require().Equal(erc20.Hex(), allowances[0].Erc20Address)
+	suite.Require().Equal(owner.Hex(), allowances[0].Owner)
+	suite.Require().Equal(spender.Hex(), allowances[0].Spender)
+	suite.Require().Equal(int64(100), allowances[0].Value.Int64())
+
+	suite.Require().Len(k.GetAllAllowances(ctx), 3)
+}
@@ -0,0 +1,46 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// IsAutoConversionOptedOut returns true if the account opted out of the
+// automatic conversion of the IBC vouchers it receives to their ERC20
+// representation.
+func (k Keeper) IsAutoConversionOptedOut(ctx sdk.Context, account sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoConversionOptOut)
+	return store.Has(account)
+}
+
+// SetAutoConversionOptedOut opts the account out of, or back into, the
+// automatic conversion of the IBC vouchers it receives.
+func (k Keeper) SetAutoConversionOptedOut(ctx sdk.Context, account sdk.AccAddress, optOut bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoConversionOptOut)
+	if optOut {
+		store.Set(account, []byte{1})
+		return
+	}
+	store.Delete(account)
+}
+
+// GetAutoConversionOptOuts returns the bech32 addresses of the accounts that
+// opted out of the automatic conversion.
+func (k Keeper) GetAutoConversionOptOuts(ctx sdk.Context) []string {
+	var accounts []string
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoConversionOptOut)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		accounts = append(accounts, sdk.AccAddress(iterator.Key()).String())
+	}
+
+	return accounts
+}
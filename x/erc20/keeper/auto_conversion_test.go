@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestAutoConversionOptOut() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.Erc20Keeper
+	account := suite.keyring.GetAccAddr(0)
+
+	suite.Require().False(k.IsAutoConversionOptedOut(ctx, account))
+	suite.Require().Empty(k.GetAutoConversionOptOuts(ctx))
+
+	// opt out through the msg server
+	_, err := k.SetAutoConversionOptOut(ctx, &types.MsgSetAutoConversionOptOut{
+		Sender: account.String(),
+		OptOut: true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(k.IsAutoConversionOptedOut(ctx, account))
+	suite.Require().Equal([]string{account.String()}, k.GetAutoConversionOptOuts(ctx))
+
+	// the opt out can be queried with the hex address
+	res, err := k.AutoConversionOptOut(ctx, &types.QueryAutoConversionOptOutRequest{
+		Address: suite.keyring.GetAddr(0).Hex(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(res.OptOut)
+
+	// opt back in
+	_, err = k.SetAutoConversionOptOut(ctx, &types.MsgSetAutoConversionOptOut{
+		Sender: account.String(),
+		OptOut: false,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(k.IsAutoConversionOptedOut(ctx, account))
+	suite.Require().Empty(k.GetAutoConversionOptOuts(ctx))
+}
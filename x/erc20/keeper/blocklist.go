@@ -0,0 +1,123 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// IsAccountBlocked returns true if the account is blocked from transferring the
+// tokens of the token pair with the given id.
+func (k Keeper) IsAccountBlocked(ctx sdk.Context, id []byte, account common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockedAccount)
+	return store.Has(types.BlockedAccountKey(id, account))
+}
+
+// SetAccountBlocked blocks or unblocks the account for the token pair of the
+// given token. Blocked accounts cannot send or receive the tokens through the
+// ERC-20 precompile. It returns an error if the token pair is not registered or
+// the account is already in the requested state.
+func (k Keeper) SetAccountBlocked(ctx sdk.Context, token string, account common.Address, blocked bool) error {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	isBlocked := k.IsAccountBlocked(ctx, id, account)
+	switch {
+	case blocked && isBlocked:
+		return errorsmod.Wrapf(types.ErrAccountBlocked, "account '%s' for token '%s'", account, token)
+	case !blocked && !isBlocked:
+		return errorsmod.Wrapf(types.ErrAccountNotBlocked, "account '%s' for token '%s'", account, token)
+	}
+
+	k.setAccountBlocked(ctx, id, account, blocked)
+
+	eventType := types.EventTypeUnblockAccount
+	if blocked {
+		eventType = types.EventTypeBlockAccount
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyAccount, account.Hex()),
+		),
+	)
+
+	return nil
+}
+
+// setAccountBlocked adds the account to or removes it from the blocklist of the
+// token pair with the given id.
+func (k Keeper) setAccountBlocked(ctx sdk.Context, id []byte, account common.Address, blocked bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockedAccount)
+	key := types.BlockedAccountKey(id, account)
+	if blocked {
+		store.Set(key, []byte{1})
+		return
+	}
+	store.Delete(key)
+}
+
+// deleteBlockedAccounts removes all the blocked accounts of the token pair with
+// the given id.
+func (k Keeper) deleteBlockedAccounts(ctx sdk.Context, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockedAccount)
+	iterator := storetypes.KVStorePrefixIterator(store, address.MustLengthPrefix(id))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetBlockedAccounts returns the blocked accounts of all the token pairs.
+func (k Keeper) GetBlockedAccounts(ctx sdk.Context) []types.BlockedAccount {
+	var blocked []types.BlockedAccount
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockedAccount)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		// NOTE: the key is the length prefixed token pair id followed by the account address
+		idLen := int(key[0])
+		pair, found := k.GetTokenPair(ctx, key[1:1+idLen])
+		if !found {
+			continue
+		}
+
+		blocked = append(blocked, types.BlockedAccount{
+			Erc20Address: pair.Erc20Address,
+			Account:      common.BytesToAddress(key[1+idLen:]).Hex(),
+		})
+	}
+
+	return blocked
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestSetAccountBlocked() {
+	var ctx sdk.Context
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	account := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		token      string
+		blocked    bool
+		expErr     error
+		expEvent   string
+		expBlocked bool
+	}{
+		{
+			"fail - token pair not registered",
+			func() {},
+			utiltx.GenerateAddress().Hex(),
+			true,
+			types.ErrTokenPairNotFound,
+			"",
+			false,
+		},
+		{
+			"fail - unblock an account that is not blocked",
+			func() {},
+			pair.Erc20Address,
+			false,
+			types.ErrAccountNotBlocked,
+			"",
+			false,
+		},
+		{
+			"fail - block an account that is already blocked",
+			func() {
+				err := suite.network.App.Erc20Keeper.SetAccountBlocked(ctx, pair.Erc20Address, account, true)
+				suite.Require().NoError(err)
+			},
+			pair.Erc20Address,
+			true,
+			types.ErrAccountBlocked,
+			"",
+			true,
+		},
+		{
+			"pass - block by erc20 address",
+			func() {},
+			pair.Erc20Address,
+			true,
+			nil,
+			types.EventTypeBlockAccount,
+			true,
+		},
+		{
+			"pass - unblock by denom",
+			func() {
+				err := suite.network.App.Erc20Keeper.SetAccountBlocked(ctx, pair.Erc20Address, account, true)
+				suite.Require().NoError(err)
+			},
+			pair.Denom,
+			false,
+			nil,
+			types.EventTypeUnblockAccount,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+			suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+
+			tc.malleate()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			err := suite.network.App.Erc20Keeper.SetAccountBlocked(ctx, tc.token, account, tc.blocked)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+
+				events := ctx.EventManager().Events()
+				suite.Require().Len(events, 1)
+				suite.Require().Equal(tc.expEvent, events[0].Type)
+			}
+
+			suite.Require().Equal(tc.expBlocked, suite.network.App.Erc20Keeper.IsAccountBlocked(ctx, pair.GetID(), account))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBlockedAccountsDeletedWithTokenPair() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	otherPair := types.NewTokenPair(utiltx.GenerateAddress(), "other", types.OWNER_MODULE)
+	account := utiltx.GenerateAddress()
+
+	for _, p := range []types.TokenPair{pair, otherPair} {
+		suite.network.App.Erc20Keeper.SetToken(ctx, p)
+		err := suite.network.App.Erc20Keeper.SetAccountBlocked(ctx, p.Erc20Address, account, true)
+		suite.Require().NoError(err)
+	}
+	suite.Require().Len(suite.network.App.Erc20Keeper.GetBlockedAccounts(ctx), 2)
+
+	suite.network.App.Erc20Keeper.DeleteTokenPair(ctx, pair)
+
+	suite.Require().False(suite.network.App.Erc20Keeper.IsAccountBlocked(ctx, pair.GetID(), account))
+	suite.Require().True(suite.network.App.Erc20Keeper.IsAccountBlocked(ctx, otherPair.GetID(), account))
+	suite.Require().Equal(
+		[]types.BlockedAccount{{Erc20Address: otherPair.Erc20Address, Account: account.Hex()}},
+		suite.network.App.Erc20Keeper.GetBlockedAccounts(ctx),
+	)
+}
+
+func (suite *KeeperTestSuite) TestUpdateBlockedAccount() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.Erc20Keeper
+
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	k.SetToken(ctx, pair)
+	account := utiltx.GenerateAddress()
+
+	// only the governance can block accounts
+	msg := &types.MsgUpdateBlockedAccount{
+		Authority: suite.keyring.GetAccAddr(0).String(),
+		Token:     pair.Denom,
+		Account:   account.Hex(),
+		Blocked:   true,
+	}
+	_, err := k.UpdateBlockedAccount(ctx, msg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// the token pair must be registered
+	msg.Authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msg.Token = utiltx.GenerateAddress().Hex()
+	_, err = k.UpdateBlockedAccount(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	msg.Token = pair.Erc20Address
+	_, err = k.UpdateBlockedAccount(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().True(k.IsAccountBlocked(ctx, pair.GetID(), account))
+
+	msg.Blocked = false
+	_, err = k.UpdateBlockedAccount(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().False(k.IsAccountBlocked(ctx, pair.GetID(), account))
+}
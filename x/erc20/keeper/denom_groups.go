@@ -0,0 +1,141 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// SetDenomGroup defines the group of related denominations (e.g. the native,
+// IBC and wrapped representations of the same asset) identified by the given
+// group id. Any previous members of the group are replaced.
+//
+// A denomination can only be a member of a single group.
+func (k Keeper) SetDenomGroup(ctx sdk.Context, groupID string, denoms []string) error {
+	group := types.DenomGroup{GroupId: groupID, Denoms: denoms}
+	if err := group.Validate(); err != nil {
+		return err
+	}
+
+	for _, denom := range denoms {
+		if existing, found := k.GetDenomGroupID(ctx, denom); found && existing != groupID {
+			return errorsmod.Wrapf(types.ErrInvalidDenomGroup, "denom %s is already a member of group %s", denom, existing)
+		}
+	}
+
+	k.DeleteDenomGroup(ctx, groupID)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomGroup)
+	byDenomStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomGroupByDenom)
+	for _, denom := range denoms {
+		store.Set(types.DenomGroupMemberKey(groupID, denom), []byte{1})
+		byDenomStore.Set([]byte(denom), []byte(groupID))
+	}
+
+	byERC1155Store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomGroupByERC1155)
+	byERC1155Store.Set(types.DenomGroupERC1155Address(groupID).Bytes(), []byte(groupID))
+
+	return nil
+}
+
+// GetDenomGroup returns the denominations of the group with the given id. The
+// returned denominations are sorted lexicographically.
+func (k Keeper) GetDenomGroup(ctx sdk.Context, groupID string) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomGroup)
+	groupKey := types.DenomGroupKey(groupID)
+	iterator := storetypes.KVStorePrefixIterator(store, groupKey)
+	defer iterator.Close()
+
+	var denoms []string
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()[len(groupKey):]))
+	}
+
+	return denoms
+}
+
+// GetDenomGroupID returns the id of the group the given denomination belongs to.
+func (k Keeper) GetDenomGroupID(ctx sdk.Context, denom string) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomGroupByDenom)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// GetDenomGroupIDByERC1155 returns the id of the denom group exposed by the
+// ERC-1155 precompile at the given address.
+func (k Keeper) GetDenomGroupIDByERC1155(ctx sdk.Context, erc1155 common.Address) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomGroupByERC1155)
+	bz := store.Get(erc1155.Bytes())
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// GetDenomGroups returns all the denom groups with their members.
+func (k Keeper) GetDenomGroups(ctx sdk.Context) []types.DenomGroup {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomGroup)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var groups []types.DenomGroup
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		// the member keys are the length prefixed group id followed by the denom
+		groupID := string(key[1 : 1+int(key[0])])
+		denom := string(key[1+int(key[0]):])
+
+		if n := len(groups); n > 0 && groups[n-1].GroupId == groupID {
+			groups[n-1].Denoms = append(groups[n-1].Denoms, denom)
+			continue
+		}
+		groups = append(groups, types.DenomGroup{GroupId: groupID, Denoms: []string{denom}})
+	}
+
+	return groups
+}
+
+// DeleteDenomGroup removes the group with the given id and all its members.
+func (k Keeper) DeleteDenomGroup(ctx sdk.Context, groupID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomGroup)
+	byDenomStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomGroupByDenom)
+
+	for _, denom := range k.GetDenomGroup(ctx, groupID) {
+		store.Delete(types.DenomGroupMemberKey(groupID, denom))
+		byDenomStore.Delete([]byte(denom))
+	}
+
+	byERC1155Store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomGroupByERC1155)
+	byERC1155Store.Delete(types.DenomGroupERC1155Address(groupID).Bytes())
+}
+
+// GroupSupply returns the aggregate supply of all the denominations that are
+// members of the group with the given id.
+//
+// NOTE: The supplies are summed up in their base units, so all the members of a
+// group are expected to share the same decimals.
+func (k Keeper) GroupSupply(ctx sdk.Context, groupID string) (math.Int, error) {
+	denoms := k.GetDenomGroup(ctx, groupID)
+	if len(denoms) == 0 {
+		return math.Int{}, errorsmod.Wrapf(types.ErrDenomGroupNotFound, "group %s", groupID)
+	}
+
+	supply := math.ZeroInt()
+	for _, denom := range denoms {
+		supply = supply.Add(k.bankKeeper.GetSupply(ctx, denom).Amount)
+	}
+
+	return supply, nil
+}
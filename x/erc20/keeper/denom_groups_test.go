@@ -0,0 +1,249 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestSetDenomGroup() {
+	var ctx sdk.Context
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		groupID     string
+		denoms      []string
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - empty group id",
+			func() {},
+			"",
+			[]string{"uatom"},
+			false,
+			"group id cannot be empty",
+		},
+		{
+			"fail - no denoms",
+			func() {},
+			"atom",
+			[]string{},
+			false,
+			"group must contain at least one denom",
+		},
+		{
+			"fail - invalid denom",
+			func() {},
+			"atom",
+			[]string{"1"},
+			false,
+			"invalid denom",
+		},
+		{
+			"fail - duplicate denom",
+			func() {},
+			"atom",
+			[]string{"uatom", "uatom"},
+			false,
+			"duplicate denom uatom",
+		},
+		{
+			"fail - denom already member of another group",
+			func() {
+				err := suite.network.App.Erc20Keeper.SetDenomGroup(ctx, "other", []string{"uatom"})
+				suite.Require().NoError(err)
+			},
+			"atom",
+			[]string{"uatom", "watom"},
+			false,
+			"denom uatom is already a member of group other",
+		},
+		{
+			"pass - new group",
+			func() {},
+			"atom",
+			[]string{"uatom", "watom"},
+			true,
+			"",
+		},
+		{
+			"pass - replace existing group members",
+			func() {
+				err := suite.network.App.Erc20Keeper.SetDenomGroup(ctx, "atom", []string{"uatom", "xatom"})
+				suite.Require().NoError(err)
+			},
+			"atom",
+			[]string{"uatom", "watom"},
+			true,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+
+			tc.malleate()
+
+			err := suite.network.App.Erc20Keeper.SetDenomGroup(ctx, tc.groupID, tc.denoms)
+			if !tc.expPass {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.denoms, suite.network.App.Erc20Keeper.GetDenomGroup(ctx, tc.groupID))
+			for _, denom := range tc.denoms {
+				groupID, found := suite.network.App.Erc20Keeper.GetDenomGroupID(ctx, denom)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.groupID, groupID)
+			}
+
+			// removed members should not be part of any group
+			_, found := suite.network.App.Erc20Keeper.GetDenomGroupID(ctx, "xatom")
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGroupSupply() {
+	var ctx sdk.Context
+	groupID := "atom"
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPass   bool
+		expSupply math.Int
+	}{
+		{
+			"fail - group not found",
+			func() {},
+			false,
+			math.Int{},
+		},
+		{
+			"pass - group without supply",
+			func() {
+				err := suite.network.App.Erc20Keeper.SetDenomGroup(ctx, groupID, []string{"uatom", "watom"})
+				suite.Require().NoError(err)
+			},
+			true,
+			math.ZeroInt(),
+		},
+		{
+			"pass - aggregate supply of the group members",
+			func() {
+				err := suite.network.App.Erc20Keeper.SetDenomGroup(ctx, groupID, []string{"uatom", "watom", "xatom"})
+				suite.Require().NoError(err)
+
+				coins := sdk.NewCoins(
+					sdk.NewInt64Coin("uatom", 100),
+					sdk.NewInt64Coin("watom", 250),
+					sdk.NewInt64Coin("xatom", 50),
+					// not a member of the group
+					sdk.NewInt64Coin("uosmo", 1000),
+				)
+				err = suite.network.App.BankKeeper.MintCoins(ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			true,
+			math.NewInt(400),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+
+			tc.malleate()
+
+			supply, err := suite.network.App.Erc20Keeper.GroupSupply(ctx, groupID)
+			if !tc.expPass {
+				suite.Require().ErrorIs(err, types.ErrDenomGroupNotFound)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().True(tc.expSupply.Equal(supply), "expected %s, got %s", tc.expSupply, supply)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetDenomGroups() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.Erc20Keeper
+
+	suite.Require().Empty(k.GetDenomGroups(ctx))
+
+	suite.Require().NoError(k.SetDenomGroup(ctx, "osmo", []string{"uosmo"}))
+	suite.Require().NoError(k.SetDenomGroup(ctx, "atom", []string{"watom", "uatom"}))
+
+	suite.Require().Equal([]types.DenomGroup{
+		{GroupId: "atom", Denoms: []string{"uatom", "watom"}},
+		{GroupId: "osmo", Denoms: []string{"uosmo"}},
+	}, k.GetDenomGroups(ctx))
+}
+
+func (suite *KeeperTestSuite) TestUpdateDenomGroup() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.Erc20Keeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// only the governance can update the denom groups
+	msg := &types.MsgUpdateDenomGroup{
+		Authority: suite.keyring.GetAccAddr(0).String(),
+		GroupId:   "atom",
+		Denoms:    []string{"uatom", "watom"},
+	}
+	_, err := k.UpdateDenomGroup(ctx, msg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	msg.Authority = authority
+	_, err = k.UpdateDenomGroup(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"uatom", "watom"}, k.GetDenomGroup(ctx, "atom"))
+
+	// a denom can only be a member of a single group
+	_, err = k.UpdateDenomGroup(ctx, &types.MsgUpdateDenomGroup{
+		Authority: authority,
+		GroupId:   "other",
+		Denoms:    []string{"uatom"},
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidDenomGroup)
+
+	// an empty slice of denoms removes the group
+	msg.Denoms = nil
+	_, err = k.UpdateDenomGroup(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Empty(k.GetDenomGroup(ctx, "atom"))
+	_, found := k.GetDenomGroupID(ctx, "uatom")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomGroup() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.Erc20Keeper
+
+	_, err := k.DenomGroup(ctx, &types.QueryDenomGroupRequest{})
+	suite.Require().ErrorContains(err, "group id cannot be empty")
+
+	_, err = k.DenomGroup(ctx, &types.QueryDenomGroupRequest{GroupId: "atom"})
+	suite.Require().ErrorContains(err, "denom group 'atom'")
+
+	suite.Require().NoError(k.SetDenomGroup(ctx, "atom", []string{"uatom", "watom"}))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin("watom", 250))
+	suite.Require().NoError(suite.network.App.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+
+	res, err := k.DenomGroup(ctx, &types.QueryDenomGroupRequest{GroupId: "atom"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"uatom", "watom"}, res.Denoms)
+	suite.Require().Equal(math.NewInt(350).String(), res.Supply.String())
+}
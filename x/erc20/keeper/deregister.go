@@ -0,0 +1,177 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/contracts"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// holdersPageLimit is the number of holders fetched per page when exporting
+// the holders of a token pair.
+const holdersPageLimit = 1_000
+
+// deregisterTokenPair disables the conversions of the given token pair, marks
+// it as deregistered and removes its precompile from the active native and
+// dynamic precompiles. The token pair and the balances of its holders are kept
+// so that they can be exported with ExportTokenPairHolders and migrated before
+// the token is sunset.
+func (k Keeper) deregisterTokenPair(ctx sdk.Context, token string) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if k.IsTokenPairDeregistered(ctx, id) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairDeregistered, "token '%s'", token)
+	}
+
+	params := k.GetParams(ctx)
+	contract := pair.GetERC20Contract()
+	if params.IsNativePrecompile(contract) || params.IsDynamicPrecompile(contract) {
+		params.NativePrecompiles = removeAddress(params.NativePrecompiles, contract)
+		params.DynamicPrecompiles = removeAddress(params.DynamicPrecompiles, contract)
+		if err := k.SetParams(ctx, params); err != nil {
+			return types.TokenPair{}, errorsmod.Wrapf(err, "failed to remove the precompile of token '%s'", token)
+		}
+	}
+
+	pair.Enabled = false
+	k.SetTokenPair(ctx, pair)
+	k.SetTokenPairDeregistered(ctx, id, true)
+	return pair, nil
+}
+
+// removeAddress returns the given hex addresses without the given address.
+func removeAddress(addresses []string, address common.Address) []string {
+	return slices.DeleteFunc(slices.Clone(addresses), func(addr string) bool {
+		return common.HexToAddress(addr) == address
+	})
+}
+
+// IsTokenPairDeregistered returns true if the token pair with the given id has
+// been deregistered. The conversions of a deregistered token pair can't be
+// enabled again.
+func (k Keeper) IsTokenPairDeregistered(ctx sdk.Context, id []byte) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairDeregistered)
+	return store.Has(id)
+}
+
+// SetTokenPairDeregistered marks or unmarks the token pair with the given id as
+// deregistered.
+func (k Keeper) SetTokenPairDeregistered(ctx sdk.Context, id []byte, deregistered bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairDeregistered)
+	if !deregistered {
+		store.Delete(id)
+		return
+	}
+	store.Set(id, []byte{1})
+}
+
+// GetDeregisteredTokenPairs returns the hex addresses of the ERC20 contracts of
+// the deregistered token pairs.
+func (k Keeper) GetDeregisteredTokenPairs(ctx sdk.Context) []string {
+	var erc20Addresses []string
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairDeregistered)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pair, found := k.GetTokenPair(ctx, iterator.Key())
+		if !found {
+			continue
+		}
+
+		erc20Addresses = append(erc20Addresses, pair.Erc20Address)
+	}
+
+	return erc20Addresses
+}
+
+// ExportTokenPairHolders returns the balances of all the accounts holding the
+// token pair of the given token, so that operators can migrate them before the
+// token is sunset. For native ERC-20 token pairs, the ERC-20 balances held on
+// the contract are included along with the bank balances.
+func (k Keeper) ExportTokenPairHolders(ctx sdk.Context, token string) ([]types.TokenPairHolderBalance, error) {
+	id := k.GetTokenPairID(ctx, token)
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	var (
+		holders []types.TokenPairHolderBalance
+		nextKey []byte
+	)
+	for {
+		balances, pageRes, err := k.GetTokenHolderBalances(ctx, pair, &query.PageRequest{Key: nextKey, Limit: holdersPageLimit})
+		if err != nil {
+			return nil, err
+		}
+
+		holders = append(holders, balances...)
+
+		if pageRes == nil || len(pageRes.NextKey) == 0 {
+			return holders, nil
+		}
+		nextKey = pageRes.NextKey
+	}
+}
+
+// GetTokenHolderBalances returns a page of the balances of the accounts holding
+// the given token pair, sorted by address. The ERC-20 balances are only queried
+// for native ERC-20 token pairs, as the ERC-20 balances of the native coin token
+// pairs are their bank balances.
+func (k Keeper) GetTokenHolderBalances(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	pageReq *query.PageRequest,
+) ([]types.TokenPairHolderBalance, *query.PageResponse, error) {
+	accounts, pageRes, err := k.GetTokenHolders(ctx, pair.Denom, pageReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	balances := make([]types.TokenPairHolderBalance, len(accounts))
+	for i, account := range accounts {
+		balances[i] = types.TokenPairHolderBalance{
+			Address:      holderAddress(account),
+			Balance:      k.bankKeeper.GetBalance(ctx, account, pair.Denom).Amount,
+			Erc20Balance: math.ZeroInt(),
+		}
+
+		if !pair.IsNativeERC20() {
+			continue
+		}
+
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		balance := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), common.BytesToAddress(account))
+		if balance != nil {
+			balances[i].Erc20Balance = math.NewIntFromBigInt(balance)
+		}
+	}
+
+	return balances, pageRes, nil
+}
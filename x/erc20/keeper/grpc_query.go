@@ -5,6 +5,7 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -12,6 +13,7 @@ import (
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
 	evmostypes "github.com/evmos/evmos/v20/types"
 
 	"github.com/evmos/evmos/v20/x/erc20/types"
@@ -47,6 +49,97 @@ func (k Keeper) TokenPairs(c context.Context, req *types.QueryTokenPairsRequest)
 	}, nil
 }
 
+// TokenPairsHeldBy returns the registered token pairs in which the given address
+// holds a non-zero balance
+func (k Keeper) TokenPairsHeldBy(c context.Context, req *types.QueryTokenPairsHeldByRequest) (*types.QueryTokenPairsHeldByResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var address sdk.AccAddress
+	if common.IsHexAddress(req.Address) {
+		address = common.HexToAddress(req.Address).Bytes()
+	} else {
+		var err error
+		address, err = sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for address %s, should be either hex ('0x...') or bech32", req.Address,
+			)
+		}
+	}
+
+	pairs, pageRes, err := k.PairsHeldBy(ctx, address, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenPairsHeldByResponse{
+		TokenPairs: pairs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TokenPairHolders returns the accounts holding a non-zero balance of a given
+// registered token pair
+func (k Keeper) TokenPairHolders(c context.Context, req *types.QueryTokenPairHoldersRequest) (*types.QueryTokenPairHoldersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res, err := k.TokenPair(c, &types.QueryTokenPairRequest{Token: req.Token})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denom := res.TokenPair.Denom
+
+	accounts, pageRes, err := k.GetTokenHolders(ctx, denom, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	holders := make([]string, len(accounts))
+	for i, account := range accounts {
+		holders[i] = holderAddress(account)
+	}
+
+	return &types.QueryTokenPairHoldersResponse{
+		Holders:      holders,
+		HoldersCount: k.GetTokenHoldersCount(ctx, denom),
+		Pagination:   pageRes,
+	}, nil
+}
+
+// TokenPairHolderBalances returns the balances of the accounts holding a given
+// registered token pair
+func (k Keeper) TokenPairHolderBalances(c context.Context, req *types.QueryTokenPairHolderBalancesRequest) (*types.QueryTokenPairHolderBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res, err := k.TokenPair(c, &types.QueryTokenPairRequest{Token: req.Token})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	balances, pageRes, err := k.GetTokenHolderBalances(ctx, res.TokenPair, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenPairHolderBalancesResponse{
+		Balances:   balances,
+		Pagination: pageRes,
+	}, nil
+}
+
 // TokenPair returns a given registered token pair
 func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (*types.QueryTokenPairResponse, error) {
 	if req == nil {
@@ -80,6 +173,96 @@ func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (
 	return &types.QueryTokenPairResponse{TokenPair: pair}, nil
 }
 
+// AutoConversionOptOut returns whether an account opted out of the automatic
+// conversion of the received IBC vouchers
+func (k Keeper) AutoConversionOptOut(c context.Context, req *types.QueryAutoConversionOptOutRequest) (*types.QueryAutoConversionOptOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var address sdk.AccAddress
+	if common.IsHexAddress(req.Address) {
+		address = common.HexToAddress(req.Address).Bytes()
+	} else {
+		var err error
+		address, err = sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for address %s, should be either hex ('0x...') or bech32", req.Address,
+			)
+		}
+	}
+
+	return &types.QueryAutoConversionOptOutResponse{
+		OptOut: k.IsAutoConversionOptedOut(ctx, address),
+	}, nil
+}
+
+// PauseStatus returns whether the token pair of the given token is paused
+func (k Keeper) PauseStatus(c context.Context, req *types.QueryPauseStatusRequest) (*types.QueryPauseStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res, err := k.TokenPair(c, &types.QueryTokenPairRequest{Token: req.Token})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPauseStatusResponse{Paused: res.TokenPair.Paused}, nil
+}
+
+// ConversionRateLimit returns the conversion rate limit of the token pair of the
+// given token and the amount converted within its current epoch
+func (k Keeper) ConversionRateLimit(c context.Context, req *types.QueryConversionRateLimitRequest) (*types.QueryConversionRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res, err := k.TokenPair(c, &types.QueryTokenPairRequest{Token: req.Token})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	id := res.TokenPair.GetID()
+	rateLimit, found := k.GetConversionRateLimit(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "conversion rate limit for token '%s'", req.Token)
+	}
+
+	return &types.QueryConversionRateLimitResponse{
+		RateLimit: rateLimit,
+		Flow:      k.GetConversionFlow(ctx, id, rateLimit),
+	}, nil
+}
+
+// DenomGroup returns the member denominations of the given denom group and
+// their aggregate supply
+func (k Keeper) DenomGroup(c context.Context, req *types.QueryDenomGroupRequest) (*types.QueryDenomGroupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.GroupId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "group id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	supply, err := k.GroupSupply(ctx, req.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "denom group '%s'", req.GroupId)
+	}
+
+	return &types.QueryDenomGroupResponse{
+		Denoms: k.GetDenomGroup(ctx, req.GroupId),
+		Supply: supply,
+	}, nil
+}
+
 // Params returns the params of the erc20 module
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -2,10 +2,14 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/evmos/evmos/v20/testutil"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	"github.com/evmos/evmos/v20/x/erc20/types"
 )
@@ -169,6 +173,78 @@ func (suite *KeeperTestSuite) TestTokenPair() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestPauseStatus() {
+	var (
+		ctx    sdk.Context
+		req    *types.QueryPauseStatusRequest
+		expRes *types.QueryPauseStatusResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid token address",
+			func() {
+				req = &types.QueryPauseStatusRequest{}
+			},
+			false,
+		},
+		{
+			"token pair not found",
+			func() {
+				req = &types.QueryPauseStatusRequest{
+					Token: utiltx.GenerateAddress().Hex(),
+				}
+			},
+			false,
+		},
+		{
+			"token pair not paused",
+			func() {
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+				suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+				req = &types.QueryPauseStatusRequest{
+					Token: pair.Erc20Address,
+				}
+				expRes = &types.QueryPauseStatusResponse{Paused: false}
+			},
+			true,
+		},
+		{
+			"token pair paused - query by denom",
+			func() {
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+				pair.Paused = true
+				suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+				req = &types.QueryPauseStatusRequest{
+					Token: pair.Denom,
+				}
+				expRes = &types.QueryPauseStatusResponse{Paused: true}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			ctx = suite.network.GetContext()
+
+			tc.malleate()
+
+			res, err := suite.queryClient.PauseStatus(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.SetupTest()
 	ctx := suite.network.GetContext()
@@ -178,3 +254,116 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestTokenPairsHeldBy() {
+	var (
+		ctx    sdk.Context
+		req    *types.QueryTokenPairsHeldByRequest
+		expRes *types.QueryTokenPairsHeldByResponse
+	)
+
+	holder := utiltx.GenerateAddress()
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	pair2 := types.NewTokenPair(utiltx.GenerateAddress(), "coin2", types.OWNER_MODULE)
+	pair3 := types.NewTokenPair(utiltx.GenerateAddress(), "coin3", types.OWNER_MODULE)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - invalid address",
+			func() {
+				req = &types.QueryTokenPairsHeldByRequest{Address: "invalid"}
+			},
+			false,
+		},
+		{
+			"pass - no balances",
+			func() {
+				req = &types.QueryTokenPairsHeldByRequest{Address: holder.Hex()}
+				expRes = &types.QueryTokenPairsHeldByResponse{}
+			},
+			true,
+		},
+		{
+			"pass - balance in two of three pairs with hex address",
+			func() {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 100), sdk.NewInt64Coin(pair3.Denom, 1))
+				err := testutil.FundAccount(ctx, suite.network.App.BankKeeper, holder.Bytes(), coins)
+				suite.Require().NoError(err)
+
+				req = &types.QueryTokenPairsHeldByRequest{Address: holder.Hex()}
+				expRes = &types.QueryTokenPairsHeldByResponse{
+					TokenPairs: []types.TokenPair{pair, pair3},
+				}
+			},
+			true,
+		},
+		{
+			"pass - balance in two of three pairs with bech32 address",
+			func() {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(pair2.Denom, 100), sdk.NewInt64Coin(pair3.Denom, 1))
+				err := testutil.FundAccount(ctx, suite.network.App.BankKeeper, holder.Bytes(), coins)
+				suite.Require().NoError(err)
+
+				req = &types.QueryTokenPairsHeldByRequest{Address: sdk.AccAddress(holder.Bytes()).String()}
+				expRes = &types.QueryTokenPairsHeldByResponse{
+					TokenPairs: []types.TokenPair{pair2, pair3},
+				}
+			},
+			true,
+		},
+		{
+			"pass - ERC-20 balance in native ERC-20 pair",
+			func() {
+				contractAddr, err := suite.DeployContract("coin", "token", erc20Decimals)
+				suite.Require().NoError(err)
+				ctx = suite.network.GetContext()
+
+				_, err = suite.MintERC20Token(contractAddr, holder, big.NewInt(100))
+				suite.Require().NoError(err)
+				ctx = suite.network.GetContext()
+
+				_, err = suite.network.App.Erc20Keeper.RegisterERC20(ctx, &types.MsgRegisterERC20{
+					Authority:      authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Erc20Addresses: []string{contractAddr.Hex()},
+				})
+				suite.Require().NoError(err)
+
+				nativePair, found := suite.network.App.Erc20Keeper.GetTokenPair(
+					ctx, suite.network.App.Erc20Keeper.GetERC20Map(ctx, contractAddr),
+				)
+				suite.Require().True(found)
+				suite.Require().True(nativePair.IsNativeERC20())
+
+				req = &types.QueryTokenPairsHeldByRequest{Address: holder.Hex()}
+				expRes = &types.QueryTokenPairsHeldByResponse{
+					TokenPairs: []types.TokenPair{nativePair},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			ctx = suite.network.GetContext()
+
+			suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+			suite.network.App.Erc20Keeper.SetToken(ctx, pair2)
+			suite.network.App.Erc20Keeper.SetToken(ctx, pair3)
+
+			tc.malleate()
+
+			res, err := suite.queryClient.TokenPairsHeldBy(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().ElementsMatch(expRes.TokenPairs, res.TokenPairs)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
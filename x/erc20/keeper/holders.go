@@ -0,0 +1,300 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v20/contracts"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// holdersBackfillLimit is the max number of bank balances read per block to
+// backfill the holders of the registered token pairs.
+const holdersBackfillLimit = 100
+
+// TrackHoldersSendRestriction is a bank send restriction that records the
+// accounts whose balance of a registered token pair denom changes, so that the
+// holders of the token pair are updated at the end of the block. It doesn't
+// restrict the sends.
+func (k Keeper) TrackHoldersSendRestriction(
+	c context.Context,
+	fromAddr, toAddr sdk.AccAddress,
+	amt sdk.Coins,
+) (sdk.AccAddress, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	for _, coin := range amt {
+		if !k.IsDenomRegistered(ctx, coin.Denom) {
+			continue
+		}
+		k.setTokenHolderUpdate(ctx, coin.Denom, fromAddr)
+		k.setTokenHolderUpdate(ctx, coin.Denom, toAddr)
+	}
+
+	return toAddr, nil
+}
+
+// TrackERC20Transfers records the accounts whose ERC-20 balance of a native
+// ERC-20 token pair changed through the Transfer events of a successful EVM
+// transaction, so that the holders of the token pair are updated at the end of
+// the block. The balances of the native coin token pairs are tracked by the
+// bank send restriction instead.
+func (k Keeper) TrackERC20Transfers(ctx sdk.Context, logs []*ethtypes.Log) {
+	transferEvent := contracts.ERC20MinterBurnerDecimalsContract.ABI.Events["Transfer"].ID
+
+	for _, log := range logs {
+		if len(log.Topics) != 3 || log.Topics[0] != transferEvent {
+			continue
+		}
+
+		id := k.GetERC20Map(ctx, log.Address)
+		if len(id) == 0 {
+			continue
+		}
+		pair, found := k.GetTokenPair(ctx, id)
+		if !found || !pair.IsNativeERC20() {
+			continue
+		}
+
+		for _, topic := range log.Topics[1:] {
+			// the zero address is the sender of the mints and the recipient of
+			// the burns
+			if account := common.BytesToAddress(topic.Bytes()); account != (common.Address{}) {
+				k.setTokenHolderUpdate(ctx, pair.Denom, account.Bytes())
+			}
+		}
+	}
+}
+
+// UpdateTokenHolders updates the holders of the token pairs with the accounts
+// whose balance changed during the block, according to their current balance,
+// and continues the backfill of the holders of the newly registered token
+// pairs. The accounts of native ERC-20 token pairs hold the token if either
+// their bank or their ERC-20 balance is positive.
+func (k Keeper) UpdateTokenHolders(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenHolderUpdate)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+
+		// NOTE: the key is the length prefixed denom followed by the account address
+		denomLen := int(key[0])
+		denom := string(key[1 : 1+denomLen])
+		account := sdk.AccAddress(key[1+denomLen:])
+
+		// the token pair could have been deleted during the block
+		pair, found := k.GetTokenPair(ctx, k.GetDenomMap(ctx, denom))
+		if !found {
+			continue
+		}
+
+		k.setTokenHolder(ctx, denom, account, k.holdsToken(ctx, pair, account))
+	}
+
+	k.backfillTokenHolders(ctx)
+}
+
+// holdsToken returns true if the account holds a positive balance of the token
+// pair, either on the bank module or, for native ERC-20 token pairs, on the
+// ERC-20 contract.
+func (k Keeper) holdsToken(ctx sdk.Context, pair types.TokenPair, account sdk.AccAddress) bool {
+	if k.bankKeeper.GetBalance(ctx, account, pair.Denom).IsPositive() {
+		return true
+	}
+
+	if !pair.IsNativeERC20() {
+		return false
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	balance := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), common.BytesToAddress(account))
+	return balance != nil && balance.Sign() > 0
+}
+
+// StartTokenHoldersBackfill schedules the backfill of the holders of the given
+// denom from the balances of the bank module. The holders are backfilled at the
+// end of the following blocks, reading at most holdersBackfillLimit balances per
+// block. The ERC-20 holders of native ERC-20 token pairs can't be enumerated, so
+// they are only recorded once their ERC-20 balance changes.
+func (k Keeper) StartTokenHoldersBackfill(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenHoldersBackfill)
+	store.Set([]byte(denom), []byte{})
+}
+
+// IsTokenHoldersBackfillPending returns true if the holders of the given denom
+// are still being backfilled.
+func (k Keeper) IsTokenHoldersBackfillPending(ctx sdk.Context, denom string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenHoldersBackfill)
+	return store.Has([]byte(denom))
+}
+
+// backfillTokenHolders reads at most holdersBackfillLimit bank balances of the
+// denoms whose holders are being backfilled and records the accounts holding a
+// positive balance. The value of each pending denom is the pagination key of
+// the next balances to read.
+func (k Keeper) backfillTokenHolders(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenHoldersBackfill)
+	iterator := store.Iterator(nil, nil)
+
+	type backfill struct {
+		denom   string
+		nextKey []byte
+	}
+	var backfills []backfill
+	for ; iterator.Valid(); iterator.Next() {
+		backfills = append(backfills, backfill{string(iterator.Key()), iterator.Value()})
+	}
+	iterator.Close()
+
+	limit := uint64(holdersBackfillLimit)
+	for _, b := range backfills {
+		if limit == 0 {
+			return
+		}
+
+		res, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+			Denom:      b.denom,
+			Pagination: &query.PageRequest{Key: b.nextKey, Limit: limit},
+		})
+		if err != nil {
+			// NOTE: shouldn't occur as the request is valid
+			k.Logger(ctx).Error("failed to backfill token holders", "denom", b.denom, "error", err.Error())
+			store.Delete([]byte(b.denom))
+			continue
+		}
+
+		for _, owner := range res.DenomOwners {
+			if owner.Balance.IsPositive() {
+				k.setTokenHolder(ctx, b.denom, sdk.MustAccAddressFromBech32(owner.Address), true)
+			}
+		}
+		limit -= min(limit, uint64(len(res.DenomOwners)))
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			store.Delete([]byte(b.denom))
+			continue
+		}
+		store.Set([]byte(b.denom), res.Pagination.NextKey)
+	}
+}
+
+// GetTokenHoldersCount returns the number of accounts holding a non-zero
+// balance of the given denom.
+func (k Keeper) GetTokenHoldersCount(ctx sdk.Context, denom string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenHoldersCount)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetTokenHolders returns a page of the accounts holding a non-zero balance of
+// the given denom, sorted by address.
+func (k Keeper) GetTokenHolders(
+	ctx sdk.Context,
+	denom string,
+	pageReq *query.PageRequest,
+) ([]sdk.AccAddress, *query.PageResponse, error) {
+	var holders []sdk.AccAddress
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenHolder)
+	store = prefix.NewStore(store, types.TokenHoldersKey(denom))
+
+	pageRes, err := query.Paginate(store, pageReq, func(key, _ []byte) error {
+		holders = append(holders, sdk.AccAddress(key))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return holders, pageRes, nil
+}
+
+// setTokenHolder adds the account to or removes it from the holders of the
+// given denom, keeping the count of holders up to date.
+func (k Keeper) setTokenHolder(ctx sdk.Context, denom string, account sdk.AccAddress, holds bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenHolder)
+	key := types.TokenHolderKey(denom, account)
+
+	count := k.GetTokenHoldersCount(ctx, denom)
+	switch isHolder := store.Has(key); {
+	case holds && !isHolder:
+		store.Set(key, []byte{1})
+		count++
+	case !holds && isHolder:
+		store.Delete(key)
+		count--
+	default:
+		return
+	}
+
+	k.setTokenHoldersCount(ctx, denom, count)
+}
+
+// setTokenHoldersCount stores the number of holders of the given denom.
+func (k Keeper) setTokenHoldersCount(ctx sdk.Context, denom string, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenHoldersCount)
+	if count == 0 {
+		store.Delete([]byte(denom))
+		return
+	}
+	store.Set([]byte(denom), sdk.Uint64ToBigEndian(count))
+}
+
+// setTokenHolderUpdate records that the balance of the given denom of the
+// account changed during the block.
+func (k Keeper) setTokenHolderUpdate(ctx sdk.Context, denom string, account sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenHolderUpdate)
+	store.Set(types.TokenHolderKey(denom, account), []byte{1})
+}
+
+// deleteTokenHolders removes the holders of the given denom along with their
+// count.
+func (k Keeper) deleteTokenHolders(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenHolder)
+	iterator := storetypes.KVStorePrefixIterator(store, types.TokenHoldersKey(denom))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	k.setTokenHoldersCount(ctx, denom, 0)
+
+	backfillStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenHoldersBackfill)
+	backfillStore.Delete([]byte(denom))
+}
+
+// holderAddress returns the address of a token holder in hex format. Accounts
+// that are not ethereum accounts, such as interchain accounts, are returned in
+// bech32 format.
+func holderAddress(account sdk.AccAddress) string {
+	if len(account) != common.AddressLength {
+		return account.String()
+	}
+	return common.BytesToAddress(account).Hex()
+}
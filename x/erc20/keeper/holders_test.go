@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v20/testutil"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestTokenHolders() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.Erc20Keeper
+	bankKeeper := suite.network.App.BankKeeper
+
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	holder := suite.keyring.GetAccAddr(0)
+	recipient := suite.keyring.GetAccAddr(1)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 100))
+
+	requireHolders := func(expHolders ...sdk.AccAddress) {
+		holders, _, err := k.GetTokenHolders(ctx, pair.Denom, nil)
+		suite.Require().NoError(err)
+		suite.Require().ElementsMatch(expHolders, holders)
+		suite.Require().Equal(uint64(len(expHolders)), k.GetTokenHoldersCount(ctx, pair.Denom))
+	}
+
+	// the holders are backfilled from the balances at the end of the blocks
+	// following the registration
+	suite.Require().NoError(testutil.FundAccount(ctx, bankKeeper, holder, coins))
+	k.SetToken(ctx, pair)
+	suite.Require().True(k.IsTokenHoldersBackfillPending(ctx, pair.Denom))
+	requireHolders()
+	k.UpdateTokenHolders(ctx)
+	suite.Require().False(k.IsTokenHoldersBackfillPending(ctx, pair.Denom))
+	requireHolders(holder)
+
+	// the holders are updated at the end of the block
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, holder, recipient, coins))
+	requireHolders(holder)
+	k.UpdateTokenHolders(ctx)
+	requireHolders(recipient)
+
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, recipient, holder, sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 40))))
+	k.UpdateTokenHolders(ctx)
+	requireHolders(holder, recipient)
+
+	// the holders are removed along with the token pair
+	k.DeleteTokenPair(ctx, pair)
+	requireHolders()
+}
+
+func (suite *KeeperTestSuite) TestTokenHoldersERC20() {
+	suite.SetupTest()
+	holder := utiltx.GenerateAddress()
+
+	contractAddr, err := suite.setupRegisterERC20Pair(contractMinterBurner)
+	suite.Require().NoError(err)
+
+	ctx := suite.network.GetContext()
+	k := suite.network.App.Erc20Keeper
+	pair, found := k.GetTokenPair(ctx, k.GetERC20Map(ctx, contractAddr))
+	suite.Require().True(found)
+
+	// the ERC-20 transfers of native ERC-20 pairs update the holders
+	_, err = suite.MintERC20Token(contractAddr, holder, big.NewInt(100))
+	suite.Require().NoError(err)
+
+	ctx = suite.network.GetContext()
+	holders, _, err := k.GetTokenHolders(ctx, pair.Denom, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.AccAddress{holder.Bytes()}, holders)
+	suite.Require().Equal(uint64(1), k.GetTokenHoldersCount(ctx, pair.Denom))
+}
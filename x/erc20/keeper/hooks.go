@@ -0,0 +1,99 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+var (
+	_ types.Erc20Hooks = MultiErc20Hooks{}
+	_ types.Erc20Hooks = Keeper{}
+)
+
+// MultiErc20Hooks combines multiple erc20 hooks, all hook functions are run in
+// array sequence and the first error aborts the execution.
+type MultiErc20Hooks []types.Erc20Hooks
+
+// NewMultiErc20Hooks combines the given erc20 hooks.
+func NewMultiErc20Hooks(hooks ...types.Erc20Hooks) MultiErc20Hooks {
+	return hooks
+}
+
+// BeforeTransfer runs the BeforeTransfer hook of every receiver.
+func (mh MultiErc20Hooks) BeforeTransfer(ctx sdk.Context, pair types.TokenPair, from, to common.Address, amount *big.Int) error {
+	for i := range mh {
+		if err := mh[i].BeforeTransfer(ctx, pair, from, to, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterTransfer runs the AfterTransfer hook of every receiver.
+func (mh MultiErc20Hooks) AfterTransfer(ctx sdk.Context, pair types.TokenPair, from, to common.Address, amount *big.Int) error {
+	for i := range mh {
+		if err := mh[i].AfterTransfer(ctx, pair, from, to, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterMint runs the AfterMint hook of every receiver.
+func (mh MultiErc20Hooks) AfterMint(ctx sdk.Context, pair types.TokenPair, to common.Address, amount *big.Int) error {
+	for i := range mh {
+		if err := mh[i].AfterMint(ctx, pair, to, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterBurn runs the AfterBurn hook of every receiver.
+func (mh MultiErc20Hooks) AfterBurn(ctx sdk.Context, pair types.TokenPair, from common.Address, amount *big.Int) error {
+	for i := range mh {
+		if err := mh[i].AfterBurn(ctx, pair, from, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BeforeTransfer executes the registered BeforeTransfer hook, if any.
+func (k Keeper) BeforeTransfer(ctx sdk.Context, pair types.TokenPair, from, to common.Address, amount *big.Int) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.BeforeTransfer(ctx, pair, from, to, amount)
+}
+
+// AfterTransfer executes the registered AfterTransfer hook, if any.
+func (k Keeper) AfterTransfer(ctx sdk.Context, pair types.TokenPair, from, to common.Address, amount *big.Int) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterTransfer(ctx, pair, from, to, amount)
+}
+
+// AfterMint executes the registered AfterMint hook, if any.
+func (k Keeper) AfterMint(ctx sdk.Context, pair types.TokenPair, to common.Address, amount *big.Int) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterMint(ctx, pair, to, amount)
+}
+
+// AfterBurn executes the registered AfterBurn hook, if any.
+func (k Keeper) AfterBurn(ctx sdk.Context, pair types.TokenPair, from common.Address, amount *big.Int) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterBurn(ctx, pair, from, amount)
+}
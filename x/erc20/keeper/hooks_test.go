@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20/keeper"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// recordingHooks records the name of every hook it receives and returns err
+// from all of them.
+type recordingHooks struct {
+	calls *[]string
+	name  string
+	err   error
+}
+
+func (h recordingHooks) record(hook string) error {
+	*h.calls = append(*h.calls, h.name+"."+hook)
+	return h.err
+}
+
+func (h recordingHooks) BeforeTransfer(sdk.Context, types.TokenPair, common.Address, common.Address, *big.Int) error {
+	return h.record("BeforeTransfer")
+}
+
+func (h recordingHooks) AfterTransfer(sdk.Context, types.TokenPair, common.Address, common.Address, *big.Int) error {
+	return h.record("AfterTransfer")
+}
+
+func (h recordingHooks) AfterMint(sdk.Context, types.TokenPair, common.Address, *big.Int) error {
+	return h.record("AfterMint")
+}
+
+func (h recordingHooks) AfterBurn(sdk.Context, types.TokenPair, common.Address, *big.Int) error {
+	return h.record("AfterBurn")
+}
+
+func TestMultiErc20Hooks(t *testing.T) {
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	from, to := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	amount := big.NewInt(100)
+
+	t.Run("runs all hooks in sequence", func(t *testing.T) {
+		var calls []string
+		hooks := keeper.NewMultiErc20Hooks(
+			recordingHooks{calls: &calls, name: "a"},
+			recordingHooks{calls: &calls, name: "b"},
+		)
+
+		require.NoError(t, hooks.BeforeTransfer(sdk.Context{}, pair, from, to, amount))
+		require.NoError(t, hooks.AfterTransfer(sdk.Context{}, pair, from, to, amount))
+		require.NoError(t, hooks.AfterMint(sdk.Context{}, pair, to, amount))
+		require.NoError(t, hooks.AfterBurn(sdk.Context{}, pair, from, amount))
+		require.Equal(t, []string{
+			"a.BeforeTransfer", "b.BeforeTransfer",
+			"a.AfterTransfer", "b.AfterTransfer",
+			"a.AfterMint", "b.AfterMint",
+			"a.AfterBurn", "b.AfterBurn",
+		}, calls)
+	})
+
+	t.Run("stops at the first error", func(t *testing.T) {
+		var calls []string
+		hookErr := errors.New("transfer rejected")
+		hooks := keeper.NewMultiErc20Hooks(
+			recordingHooks{calls: &calls, name: "a", err: hookErr},
+			recordingHooks{calls: &calls, name: "b"},
+		)
+
+		err := hooks.BeforeTransfer(sdk.Context{}, pair, from, to, amount)
+		require.ErrorIs(t, err, hookErr)
+		require.Equal(t, []string{"a.BeforeTransfer"}, calls)
+	})
+}
+
+func TestKeeperSetHooks(t *testing.T) {
+	k := keeper.Keeper{}
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+
+	// no hooks registered
+	require.NoError(t, k.AfterMint(sdk.Context{}, pair, utiltx.GenerateAddress(), big.NewInt(1)))
+
+	var calls []string
+	k.SetHooks(keeper.NewMultiErc20Hooks(recordingHooks{calls: &calls, name: "a"}))
+	require.NoError(t, k.AfterMint(sdk.Context{}, pair, utiltx.GenerateAddress(), big.NewInt(1)))
+	require.Equal(t, []string{"a.AfterMint"}, calls)
+
+	require.Panics(t, func() {
+		k.SetHooks(keeper.NewMultiErc20Hooks())
+	})
+}
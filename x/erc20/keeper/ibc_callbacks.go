@@ -34,6 +34,7 @@ import (
 // - ERC20s are disabled
 // - Denomination is native staking token
 // - The base denomination is not registered as ERC20
+// - The receiver opted out of the automatic conversion
 func (k Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -113,11 +114,16 @@ func (k Keeper) OnRecvPacket(
 	// IsNativeFromSourceChain will check if the coin is native from the source chain.
 	// If the coin denom starts with `factory/` then it is a token factory coin, and we should not convert it
 	// NOTE: Check https://docs.osmosis.zone/osmosis-core/modules/tokenfactory/ for more information
-	case !found && strings.HasPrefix(coin.Denom, "ibc/") && ibc.IsBaseDenomFromSourceChain(data.Denom):
+	// The token pair is only registered if the channel is allowed by the
+	// IBC auto registration allowlist.
+	case !found && strings.HasPrefix(coin.Denom, "ibc/") && ibc.IsBaseDenomFromSourceChain(data.Denom) &&
+		k.IsIBCAutoRegistrationAllowed(ctx, packet.DestinationChannel):
 		tokenPair, err := k.RegisterERC20Extension(ctx, coin.Denom)
 		if err != nil {
 			return channeltypes.NewErrorAcknowledgement(err)
 		}
+		// NOTE: no creator is recorded for the automatically registered token
+		// pairs, since the recipient of the first transfer didn't register it.
 
 		ctx.EventManager().EmitEvents(
 			sdk.Events{
@@ -133,8 +139,8 @@ func (k Keeper) OnRecvPacket(
 
 	// Case 2. native ERC20 token
 	case found && pair.IsNativeERC20():
-		// Token pair is disabled -> return
-		if !pair.Enabled {
+		// Token pair is disabled or the receiver keeps the bank coins -> return
+		if !pair.Enabled || k.IsAutoConversionOptedOut(ctx, recipient) {
 			return ack
 		}
 
@@ -228,6 +234,12 @@ func (k Keeper) ConvertCoinToERC20FromPacket(ctx sdk.Context, data transfertypes
 			return nil
 		}
 
+		// the refunded coins are kept as bank coins if the sender opted out of
+		// the automatic conversion
+		if k.IsAutoConversionOptedOut(ctx, sender) {
+			return nil
+		}
+
 		// Convert from Coin to ERC20
 		if err := k.ConvertCoinNativeERC20(ctx, pair, coin.Amount, common.BytesToAddress(sender), sender); err != nil {
 			// We want to record only the failed attempt to reconvert the coins during IBC.
@@ -25,6 +25,8 @@ import (
 	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
 
 	"github.com/evmos/evmos/v20/contracts"
+	erc20precompile "github.com/evmos/evmos/v20/precompiles/erc20"
+	precompiletestutil "github.com/evmos/evmos/v20/precompiles/testutil"
 	"github.com/evmos/evmos/v20/x/erc20/types"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
@@ -313,6 +315,108 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketIBCAutoRegistration() {
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	receiver := suite.keyring.GetAccAddr(0)
+	evmosChannel := "channel-0"
+	voucherDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(transfertypes.PortID, evmosChannel, "uosmo"),
+	).IBCDenom()
+
+	testCases := []struct {
+		name          string
+		allowlist     []string
+		expRegistered bool
+	}{
+		{"pass - empty allowlist", []string{}, true},
+		{"pass - channel allowlisted", []string{evmosChannel, "channel-1"}, true},
+		{"no-op - channel not allowlisted", []string{"channel-1"}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+			k := suite.network.App.Erc20Keeper
+
+			params := k.GetParams(ctx)
+			params.IbcAutoRegistrationAllowlist = tc.allowlist
+			suite.Require().NoError(k.SetParams(ctx, params))
+
+			transfer := transfertypes.NewFungibleTokenPacketData("uosmo", "100", sender.String(), receiver.String(), "")
+			bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+			packet := channeltypes.NewPacket(bz, 1, transfertypes.PortID, "channel-292", transfertypes.PortID, evmosChannel, clienttypes.NewHeight(0, 100), 0)
+
+			ack := k.OnRecvPacket(ctx, packet, ibcmock.MockAcknowledgement)
+			suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+			id := k.GetTokenPairID(ctx, voucherDenom)
+			pair, found := k.GetTokenPair(ctx, id)
+			suite.Require().Equal(tc.expRegistered, found)
+			if tc.expRegistered {
+				suite.Require().True(k.GetParams(ctx).IsDynamicPrecompile(pair.GetERC20Contract()))
+				// the recipient of the first transfer is not recorded as the creator
+				suite.Require().Equal(common.Address{}, k.GetTokenPairCreator(ctx, id))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestIBCRegisteredPairHasNoOwner() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.Erc20Keeper
+
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	receiver := suite.keyring.GetAccAddr(0)
+	evmosChannel := "channel-0"
+	voucherDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(transfertypes.PortID, evmosChannel, "uosmo"),
+	).IBCDenom()
+
+	transfer := transfertypes.NewFungibleTokenPacketData("uosmo", "100", sender.String(), receiver.String(), "")
+	bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+	packet := channeltypes.NewPacket(bz, 1, transfertypes.PortID, "channel-292", transfertypes.PortID, evmosChannel, clienttypes.NewHeight(0, 100), 0)
+
+	ack := k.OnRecvPacket(ctx, packet, ibcmock.MockAcknowledgement)
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+	id := k.GetTokenPairID(ctx, voucherDenom)
+	pair, found := k.GetTokenPair(ctx, id)
+	suite.Require().True(found)
+
+	precompile, err := k.InstantiateERC20Precompile(ctx, pair.GetERC20Contract(), false)
+	suite.Require().NoError(err)
+	erc20Precompile, ok := precompile.(*erc20precompile.Precompile)
+	suite.Require().True(ok, "expected ERC-20 precompile")
+
+	// neither the recipient of the first transfer nor any other account can
+	// pause the token pair or block accounts through the precompile
+	pauseMethod := erc20Precompile.Methods[erc20precompile.PauseMethod]
+	blockMethod := erc20Precompile.Methods[erc20precompile.BlockAccountMethod]
+	for _, caller := range []common.Address{common.BytesToAddress(receiver), common.BytesToAddress(sender)} {
+		contract, ctx := precompiletestutil.NewPrecompileContract(suite.T(), ctx, caller, erc20Precompile, 200_000)
+		_, err = erc20Precompile.Pause(ctx, contract, suite.network.GetStateDB(), &pauseMethod, []interface{}{})
+		suite.Require().ErrorIs(err, erc20precompile.ErrCallerNotOwner)
+
+		_, err = erc20Precompile.BlockAccount(ctx, contract, suite.network.GetStateDB(), &blockMethod, []interface{}{caller})
+		suite.Require().ErrorIs(err, erc20precompile.ErrCallerNotOwner)
+	}
+
+	// the token pair can only be managed through governance
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = k.UpdateTokenPairPaused(ctx, &types.MsgUpdateTokenPairPaused{
+		Authority: authority,
+		Token:     voucherDenom,
+		Paused:    true,
+	})
+	suite.Require().NoError(err)
+
+	pair, found = k.GetTokenPair(ctx, id)
+	suite.Require().True(found)
+	suite.Require().True(pair.Paused)
+}
+
 func (suite *KeeperTestSuite) TestConvertCoinToERC20FromPacket() {
 	var ctx sdk.Context
 	senderAddr := "evmos1x2w87cvt5mqjncav4lxy8yfreynn273xn5335v"
@@ -30,6 +30,7 @@ type Keeper struct {
 	stakingKeeper  types.StakingKeeper
 	authzKeeper    authzkeeper.Keeper
 	transferKeeper *transferkeeper.Keeper
+	hooks          types.Erc20Hooks
 }
 
 // NewKeeper creates new instances of the erc20 Keeper
@@ -62,6 +63,18 @@ func NewKeeper(
 	}
 }
 
+// SetHooks sets the erc20 hooks. It must be called before the keeper is copied
+// into other modules so that they share the same hooks.
+func (k *Keeper) SetHooks(hooks types.Erc20Hooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set erc20 hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -4,10 +4,19 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v20/precompiles/erc20"
 	v3 "github.com/evmos/evmos/v20/x/erc20/migrations/v3"
 	v4 "github.com/evmos/evmos/v20/x/erc20/migrations/v4"
+	v7 "github.com/evmos/evmos/v20/x/erc20/migrations/v7"
 	"github.com/evmos/evmos/v20/x/erc20/types"
 )
 
@@ -34,3 +43,111 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return v4.MigrateStore(ctx, m.keeper.storeKey)
 }
+
+// Migrate4to5 starts the backfill of the holders of the registered token pairs
+// from the bank balances, which completes over the following blocks.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.IterateTokenPairs(ctx, func(tokenPair types.TokenPair) (stop bool) {
+		m.keeper.StartTokenHoldersBackfill(ctx, tokenPair.Denom)
+		return false
+	})
+	return nil
+}
+
+// Migrate5to6 moves the allowances of the ERC-20 precompiles from the authz
+// send authorizations to the allowance store of the module. The spend limits
+// of the coins of the token pairs with an available precompile are removed
+// from the unexpired send authorizations without an allow list, which are
+// deleted if no spend limit is left. The migrated allowances no longer expire.
+// The Approval logs of the migrated allowances are emitted as block logs, so
+// the EVM indexers see the allowances of the precompiles after the upgrade.
+//
+// The settings of the token pairs are merged first, since the events disabled
+// flags are read to skip the logs of the token pairs without events.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	if err := v7.MigrateStore(ctx, m.keeper.storeKey); err != nil {
+		return err
+	}
+
+	type sendGrant struct {
+		granter, grantee sdk.AccAddress
+		authorization    *banktypes.SendAuthorization
+		expiration       *time.Time
+	}
+
+	// NOTE: the grants are collected first since the authz store cannot be
+	// modified while iterating over it.
+	var grants []sendGrant
+	m.keeper.authzKeeper.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant authz.Grant) bool {
+		if grant.Expiration != nil && !grant.Expiration.After(ctx.BlockTime()) {
+			return false
+		}
+
+		authorization, err := grant.GetAuthorization()
+		if err != nil {
+			return false
+		}
+
+		sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+		if !ok || len(sendAuthz.AllowList) > 0 {
+			return false
+		}
+
+		grants = append(grants, sendGrant{granter, grantee, sendAuthz, grant.Expiration})
+		return false
+	})
+
+	params := m.keeper.GetParams(ctx)
+	sendMsgURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	var logs []*ethtypes.Log
+	for _, grant := range grants {
+		var spendLimit sdk.Coins
+		for _, coin := range grant.authorization.SpendLimit {
+			id := m.keeper.GetDenomMap(ctx, coin.Denom)
+			pair, found := m.keeper.GetTokenPair(ctx, id)
+			if !found || !m.keeper.IsAvailableERC20Precompile(&params, pair.GetERC20Contract()) {
+				spendLimit = append(spendLimit, coin)
+				continue
+			}
+
+			owner := common.BytesToAddress(grant.granter)
+			spender := common.BytesToAddress(grant.grantee)
+			if err := m.keeper.SetAllowance(ctx, pair.GetERC20Contract(), owner, spender, coin.Amount.BigInt()); err != nil {
+				return err
+			}
+
+			if !m.keeper.GetEmitEvents(ctx, id) {
+				continue
+			}
+
+			log, err := erc20.NewApprovalLog(pair.GetERC20Contract(), owner, spender, coin.Amount.BigInt())
+			if err != nil {
+				return err
+			}
+			logs = append(logs, log)
+		}
+
+		switch {
+		case len(spendLimit) == len(grant.authorization.SpendLimit):
+			continue
+		case spendLimit.Empty():
+			if err := m.keeper.authzKeeper.DeleteGrant(ctx, grant.grantee, grant.granter, sendMsgURL); err != nil {
+				return err
+			}
+		default:
+			grant.authorization.SpendLimit = spendLimit
+			if err := m.keeper.authzKeeper.SaveGrant(ctx, grant.grantee, grant.granter, grant.authorization, grant.expiration); err != nil {
+				return err
+			}
+		}
+	}
+
+	return m.keeper.evmKeeper.EmitBlockLogs(ctx, logs)
+}
+
+// Migrate6to7 merges the per-pair flags of the token pairs into a single
+// settings record and deletes their previous store prefixes.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.storeKey)
+}
@@ -1,16 +1,27 @@
 package keeper_test
 
 import (
+	"encoding/json"
+	"math/big"
+	"time"
+
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/encoding"
+	erc20precompile "github.com/evmos/evmos/v20/precompiles/erc20"
+	evmosutil "github.com/evmos/evmos/v20/testutil"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	erc20keeper "github.com/evmos/evmos/v20/x/erc20/keeper"
 	v3types "github.com/evmos/evmos/v20/x/erc20/migrations/v3/types"
 	"github.com/evmos/evmos/v20/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 type mockSubspace struct {
@@ -66,3 +77,116 @@ func (suite *KeeperTestSuite) TestMigrations() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrate5to6() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.Erc20Keeper
+	authzKeeper := suite.network.App.AuthzKeeper
+
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	k.SetToken(ctx, pair)
+	params := k.GetParams(ctx)
+	params.NativePrecompiles = append(params.NativePrecompiles, pair.Erc20Address)
+	suite.Require().NoError(k.SetParams(ctx, params))
+
+	owner := suite.keyring.GetAccAddr(0)
+	spender := suite.keyring.GetAccAddr(1)
+	otherSpender := utiltx.GenerateAddress()
+	expiredSpender := utiltx.GenerateAddress()
+	bondDenom := suite.network.GetDenom()
+	sendMsgURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	expiration := ctx.BlockTime().Add(24 * time.Hour)
+	expiringSoon := ctx.BlockTime().Add(time.Minute)
+
+	// grant with spend limits of the token pair and of the bond denom
+	err := authzKeeper.SaveGrant(ctx, spender, owner, banktypes.NewSendAuthorization(
+		sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 100), sdk.NewInt64Coin(bondDenom, 50)), nil,
+	), &expiration)
+	suite.Require().NoError(err)
+	// grant with a spend limit of the token pair only
+	err = authzKeeper.SaveGrant(ctx, otherSpender.Bytes(), owner, banktypes.NewSendAuthorization(
+		sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 200)), nil,
+	), nil)
+	suite.Require().NoError(err)
+	// grant that is expired by the time of the migration
+	err = authzKeeper.SaveGrant(ctx, expiredSpender.Bytes(), owner, banktypes.NewSendAuthorization(
+		sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 300)), nil,
+	), &expiringSoon)
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	migrator := erc20keeper.NewMigrator(k, nil)
+	suite.Require().NoError(migrator.Migrate5to6(ctx))
+
+	erc20 := pair.GetERC20Contract()
+	ownerAddr := common.BytesToAddress(owner)
+
+	// the Approval logs of the migrated allowances are emitted
+	approvals := make(map[common.Address]*big.Int)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			var log evmtypes.Log
+			suite.Require().NoError(json.Unmarshal([]byte(attr.Value), &log))
+			suite.Require().Equal(erc20.Hex(), log.Address)
+			suite.Require().Equal(common.BytesToHash(ownerAddr.Bytes()).Hex(), log.Topics[1])
+			approvals[common.HexToAddress(log.Topics[2])] = new(big.Int).SetBytes(log.Data)
+		}
+	}
+	suite.Require().Equal(map[common.Address]*big.Int{
+		common.BytesToAddress(spender): big.NewInt(100),
+		otherSpender:                   big.NewInt(200),
+	}, approvals)
+	suite.Require().Equal(int64(100), k.GetAllowance(ctx, erc20, ownerAddr, common.BytesToAddress(spender)).Int64())
+	suite.Require().Equal(int64(200), k.GetAllowance(ctx, erc20, ownerAddr, otherSpender).Int64())
+	suite.Require().Zero(k.GetAllowance(ctx, erc20, ownerAddr, expiredSpender).Sign())
+
+	// the spend limits of the token pair are removed from the grants
+	authorization, exp := authzKeeper.GetAuthorization(ctx, spender, owner, sendMsgURL)
+	sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50)), sendAuthz.SpendLimit)
+	suite.Require().Equal(expiration.UTC(), exp.UTC())
+
+	authorization, _ = authzKeeper.GetAuthorization(ctx, otherSpender.Bytes(), owner, sendMsgURL)
+	suite.Require().Nil(authorization)
+
+	// the token pair coins can only be spent through the precompile allowance
+	coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 1000))
+	suite.Require().NoError(evmosutil.FundAccount(ctx, suite.network.App.BankKeeper, owner, coins))
+
+	execSend := func(grantee sdk.AccAddress, coin sdk.Coin) error {
+		msg := banktypes.NewMsgSend(owner, grantee, sdk.NewCoins(coin))
+		_, err := authzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{msg})
+		return err
+	}
+	suite.Require().Error(execSend(spender, sdk.NewInt64Coin(pair.Denom, 1)))
+	suite.Require().Error(execSend(otherSpender.Bytes(), sdk.NewInt64Coin(pair.Denom, 1)))
+	suite.Require().NoError(execSend(spender, sdk.NewInt64Coin(bondDenom, 50)))
+
+	erc20ABI, err := erc20precompile.LoadABI()
+	suite.Require().NoError(err)
+	transferFrom := func(amount int64) error {
+		_, err := suite.factory.ExecuteContractCall(
+			suite.keyring.GetPrivKey(1),
+			evmtypes.EvmTxArgs{To: &erc20},
+			factory.CallArgs{
+				ContractABI: erc20ABI,
+				MethodName:  erc20precompile.TransferFromMethod,
+				Args:        []interface{}{ownerAddr, common.BytesToAddress(spender), big.NewInt(amount)},
+			},
+		)
+		return err
+	}
+	suite.Require().NoError(transferFrom(100))
+	suite.Require().NoError(suite.network.NextBlock())
+	suite.Require().Error(transferFrom(1))
+
+	ctx = suite.network.GetContext()
+	suite.Require().Zero(k.GetAllowance(ctx, erc20, ownerAddr, common.BytesToAddress(spender)).Sign())
+	balance := suite.network.App.BankKeeper.GetBalance(ctx, spender, pair.Denom)
+	suite.Require().Equal(int64(100), balance.Amount.Int64())
+}
@@ -15,6 +15,7 @@ import (
 // MintingEnabled checks that:
 //   - the global parameter for erc20 conversion is enabled
 //   - minting is enabled for the given (erc20,coin) token pair
+//   - the given (erc20,coin) token pair is not paused
 //   - recipient address is not on the blocked list
 //   - bank module transfers are enabled for the Cosmos coin
 func (k Keeper) MintingEnabled(
@@ -48,6 +49,12 @@ func (k Keeper) MintingEnabled(
 		)
 	}
 
+	if pair.Paused {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairPaused, "minting token '%s' is paused", token,
+		)
+	}
+
 	if k.bankKeeper.BlockedAddr(receiver.Bytes()) {
 		return types.TokenPair{}, errorsmod.Wrapf(
 			errortypes.ErrUnauthorized, "%s is not allowed to receive transactions", receiver,
@@ -46,6 +46,18 @@ func (suite *KeeperTestSuite) TestMintingEnabled() {
 			},
 			false,
 		},
+		{
+			"token pair is paused",
+			func() {
+				expPair.Enabled = true
+				expPair.Paused = true
+				suite.network.App.Erc20Keeper.SetTokenPair(ctx, expPair)
+				suite.network.App.Erc20Keeper.SetDenomMap(ctx, expPair.Denom, id)
+				suite.network.App.Erc20Keeper.SetERC20Map(ctx, expPair.GetERC20Contract(), id)
+				expPair.Paused = false
+			},
+			false,
+		},
 		{
 			"token transfers are disabled",
 			func() {
@@ -6,6 +6,7 @@ package keeper
 import (
 	"context"
 	"math/big"
+	"strconv"
 
 	"cosmossdk.io/math"
 
@@ -135,6 +136,10 @@ func (k Keeper) convertERC20IntoCoinsForNativeToken(
 		return nil, err
 	}
 
+	if err := k.AfterMint(ctx, pair, common.BytesToAddress(receiver), msg.Amount.BigInt()); err != nil {
+		return nil, err
+	}
+
 	// Check expected receiver balance after transfer
 	balanceCoinAfter := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
 	expCoin := balanceCoin.Add(coins[0])
@@ -190,6 +195,7 @@ func (k Keeper) convertERC20IntoCoinsForNativeToken(
 
 // ConvertCoinNativeERC20 handles the coin conversion for a native ERC20 token
 // pair:
+//   - check that the token pair is not paused
 //   - escrow Coins on module account
 //   - unescrow Tokens that have been previously escrowed with ConvertERC20 and send to receiver
 //   - burn escrowed Coins
@@ -206,6 +212,12 @@ func (k Keeper) ConvertCoinNativeERC20(
 		return nil
 	}
 
+	if pair.Paused {
+		return errorsmod.Wrapf(
+			types.ErrTokenPairPaused, "burning coin '%s' is paused", pair.Denom,
+		)
+	}
+
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
 	contract := pair.GetERC20Contract()
 
@@ -257,6 +269,10 @@ func (k Keeper) ConvertCoinNativeERC20(
 		return errorsmod.Wrap(err, "failed to burn coins")
 	}
 
+	if err := k.AfterBurn(ctx, pair, common.BytesToAddress(sender), amount.BigInt()); err != nil {
+		return err
+	}
+
 	// Check for unexpected `Approval` event in logs
 	return k.monitorApprovalEvent(res)
 }
@@ -279,7 +295,9 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 // RegisterERC20 implements the gRPC MsgServer interface. After a successful governance vote
 // it updates creates the token pair for an ERC20 contract if the requested authority
-// is the Cosmos SDK governance module account
+// is the Cosmos SDK governance module account. If permissionless registration is
+// enabled, any other account can register the token pairs of contracts that are not
+// denylisted by paying the registration fee.
 func (k *Keeper) RegisterERC20(goCtx context.Context, req *types.MsgRegisterERC20) (*types.MsgRegisterERC20Response, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	// Check if the conversion is globally enabled
@@ -287,8 +305,13 @@ func (k *Keeper) RegisterERC20(goCtx context.Context, req *types.MsgRegisterERC2
 		return nil, types.ErrERC20Disabled.Wrap("registration is currently disabled by governance")
 	}
 
-	if err := k.validateAuthority(req.Authority); err != nil {
-		return nil, err
+	creator := common.BytesToAddress(k.authority)
+	if req.Authority != k.authority.String() {
+		registrant, err := k.chargeRegistrationFee(ctx, req.Authority, req.Erc20Addresses)
+		if err != nil {
+			return nil, err
+		}
+		creator = common.BytesToAddress(registrant)
 	}
 
 	for _, addr := range req.Erc20Addresses {
@@ -296,7 +319,7 @@ func (k *Keeper) RegisterERC20(goCtx context.Context, req *types.MsgRegisterERC2
 			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid ERC20 contract address: %s", addr)
 		}
 
-		pair, err := k.registerERC20(ctx, common.HexToAddress(addr))
+		pair, err := k.registerERC20(ctx, common.HexToAddress(addr), creator)
 		if err != nil {
 			return nil, err
 		}
@@ -343,6 +366,114 @@ func (k *Keeper) ToggleConversion(goCtx context.Context, req *types.MsgToggleCon
 	return &types.MsgToggleConversionResponse{}, nil
 }
 
+// UpdateTokenPairMetadata implements the gRPC MsgServer interface. After a successful governance vote
+// it updates the name, symbol and decimals of a native coin token pair if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) UpdateTokenPairMetadata(goCtx context.Context, req *types.MsgUpdateTokenPairMetadata) (*types.MsgUpdateTokenPairMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.updateTokenPairMetadata(ctx, req.Token, req.Name, req.Symbol, req.Decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateTokenPairMetadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgUpdateTokenPairMetadataResponse{}, nil
+}
+
+// DeregisterTokenPair implements the gRPC MsgServer interface. After a successful governance vote
+// it disables the token pair and removes its precompile from the active ones if the requested
+// authority is the Cosmos SDK governance module account
+func (k *Keeper) DeregisterTokenPair(goCtx context.Context, req *types.MsgDeregisterTokenPair) (*types.MsgDeregisterTokenPairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.deregisterTokenPair(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgDeregisterTokenPairResponse{}, nil
+}
+
+// SetAutoConversionOptOut implements the gRPC MsgServer interface. It opts the
+// sender out of, or back into, the automatic conversion of the IBC vouchers it
+// receives to their ERC20 representation.
+func (k *Keeper) SetAutoConversionOptOut(goCtx context.Context, req *types.MsgSetAutoConversionOptOut) (*types.MsgSetAutoConversionOptOutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender := sdk.MustAccAddressFromBech32(req.Sender)
+	k.SetAutoConversionOptedOut(ctx, sender, req.OptOut)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoConversionOptOut,
+			sdk.NewAttribute(types.AttributeKeyAccount, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyOptOut, strconv.FormatBool(req.OptOut)),
+		),
+	)
+
+	return &types.MsgSetAutoConversionOptOutResponse{}, nil
+}
+
+// UpdateConversionRateLimit implements the gRPC MsgServer interface. After a successful
+// governance vote it sets or removes the rate limit of the conversions of a token
+// pair if the requested authority is the Cosmos SDK governance module account.
+func (k *Keeper) UpdateConversionRateLimit(goCtx context.Context, req *types.MsgUpdateConversionRateLimit) (*types.MsgUpdateConversionRateLimitResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetConversionRateLimit(ctx, req.Token, req.MaxAmount, req.EpochDuration); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateConversionRateLimitResponse{}, nil
+}
+
+// SetTokenPairEmitEvents implements the gRPC MsgServer interface. After a successful
+// governance vote it enables or disables the EVM event emission of the ERC-20
+// precompile of a token pair if the requested authority is the Cosmos SDK
+// governance module account.
+func (k *Keeper) SetTokenPairEmitEvents(goCtx context.Context, req *types.MsgSetTokenPairEmitEvents) (*types.MsgSetTokenPairEmitEventsResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id := k.GetTokenPairID(ctx, req.Token)
+	if _, found := k.GetTokenPair(ctx, id); !found {
+		return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", req.Token)
+	}
+
+	k.SetEmitEvents(ctx, id, req.EmitEvents)
+	return &types.MsgSetTokenPairEmitEventsResponse{}, nil
+}
+
 // validateAuthority is a helper function to validate that the provided authority
 // is the keeper's authority address
 func (k *Keeper) validateAuthority(authority string) error {
@@ -355,3 +486,80 @@ func (k *Keeper) validateAuthority(authority string) error {
 	}
 	return nil
 }
+
+// UpdateDenomGroup implements the gRPC MsgServer interface. After a successful governance vote
+// it replaces the members of the denom group, or removes the group if no denominations
+// are given.
+func (k *Keeper) UpdateDenomGroup(goCtx context.Context, req *types.MsgUpdateDenomGroup) (*types.MsgUpdateDenomGroupResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if len(req.Denoms) == 0 {
+		k.DeleteDenomGroup(ctx, req.GroupId)
+		return &types.MsgUpdateDenomGroupResponse{}, nil
+	}
+
+	if err := k.SetDenomGroup(ctx, req.GroupId, req.Denoms); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateDenomGroupResponse{}, nil
+}
+
+// SetTokenPairComplianceFeatures implements the gRPC MsgServer interface. After a successful
+// governance vote it sets the compliance hooks that are active for the ERC-20 precompile of
+// the token pair, which add a gas surcharge to its token transfers.
+func (k *Keeper) SetTokenPairComplianceFeatures(goCtx context.Context, req *types.MsgSetTokenPairComplianceFeatures) (*types.MsgSetTokenPairComplianceFeaturesResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateComplianceFeatures(req.Features); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id := k.GetTokenPairID(ctx, req.Token)
+	if _, found := k.GetTokenPair(ctx, id); !found {
+		return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", req.Token)
+	}
+
+	k.SetComplianceFeatures(ctx, id, types.ComplianceFeature(req.Features))
+	return &types.MsgSetTokenPairComplianceFeaturesResponse{}, nil
+}
+
+// UpdateTokenPairPaused implements the gRPC MsgServer interface. After a successful
+// governance vote it pauses or unpauses the transfers of the token pair, so
+// that the token pairs without an owner, like the ones of IBC coins, can also
+// be paused.
+func (k *Keeper) UpdateTokenPairPaused(goCtx context.Context, req *types.MsgUpdateTokenPairPaused) (*types.MsgUpdateTokenPairPausedResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.SetTokenPairPaused(ctx, req.Token, req.Paused); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateTokenPairPausedResponse{}, nil
+}
+
+// UpdateBlockedAccount implements the gRPC MsgServer interface. After a
+// successful governance vote it adds the account to or removes it from the
+// blocklist of the token pair, so that the token pairs without an owner, like
+// the ones of IBC coins, can also block accounts.
+func (k *Keeper) UpdateBlockedAccount(goCtx context.Context, req *types.MsgUpdateBlockedAccount) (*types.MsgUpdateBlockedAccountResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetAccountBlocked(ctx, req.Token, common.HexToAddress(req.Account), req.Blocked); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateBlockedAccountResponse{}, nil
+}
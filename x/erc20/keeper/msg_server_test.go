@@ -7,9 +7,11 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
 	testutils "github.com/evmos/evmos/v20/testutil/integration/evmos/utils"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	"github.com/evmos/evmos/v20/x/erc20/keeper"
 	"github.com/evmos/evmos/v20/x/erc20/types"
 	erc20mocks "github.com/evmos/evmos/v20/x/erc20/types/mocks"
@@ -393,3 +395,349 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateTokenPairMetadata() {
+	var (
+		ctx  sdk.Context
+		pair types.TokenPair
+	)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	ibcDenom := "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2"
+
+	testCases := []struct {
+		name        string
+		malleate    func() *types.MsgUpdateTokenPairMetadata
+		expPass     bool
+		errContains string
+		expMetadata banktypes.Metadata
+	}{
+		{
+			"fail - invalid authority",
+			func() *types.MsgUpdateTokenPairMetadata {
+				return &types.MsgUpdateTokenPairMetadata{Authority: "foobar", Token: pair.Denom}
+			},
+			false,
+			"invalid authority",
+			banktypes.Metadata{},
+		},
+		{
+			"fail - token pair not registered",
+			func() *types.MsgUpdateTokenPairMetadata {
+				return &types.MsgUpdateTokenPairMetadata{
+					Authority: authority,
+					Token:     "ibc/unknown",
+					Name:      "Atom",
+					Symbol:    "ATOM",
+					Decimals:  6,
+				}
+			},
+			false,
+			types.ErrTokenPairNotFound.Error(),
+			banktypes.Metadata{},
+		},
+		{
+			"fail - native ERC-20 token pair",
+			func() *types.MsgUpdateTokenPairMetadata {
+				erc20Pair := types.NewTokenPair(utiltx.GenerateAddress(), "erc20/0xdAC17F958D2ee523a2206206994597C13D831ec7", types.OWNER_EXTERNAL)
+				suite.network.App.Erc20Keeper.SetToken(ctx, erc20Pair)
+				return &types.MsgUpdateTokenPairMetadata{
+					Authority: authority,
+					Token:     erc20Pair.Erc20Address,
+					Name:      "Tether",
+					Symbol:    "USDT",
+					Decimals:  6,
+				}
+			},
+			false,
+			"not a native coin",
+			banktypes.Metadata{},
+		},
+		{
+			"pass - creates the metadata if it is not registered",
+			func() *types.MsgUpdateTokenPairMetadata {
+				return &types.MsgUpdateTokenPairMetadata{
+					Authority: authority,
+					Token:     pair.Erc20Address,
+					Name:      "Cosmos Hub Atom",
+					Symbol:    "ATOM",
+					Decimals:  6,
+				}
+			},
+			true,
+			"",
+			banktypes.Metadata{
+				Base:    ibcDenom,
+				Display: "atom",
+				Name:    "Cosmos Hub Atom",
+				Symbol:  "ATOM",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: ibcDenom, Exponent: 0},
+					{Denom: "atom", Exponent: 6},
+				},
+			},
+		},
+		{
+			"pass - overwrites the registered metadata",
+			func() *types.MsgUpdateTokenPairMetadata {
+				suite.network.App.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+					Description: "IBC voucher",
+					Base:        ibcDenom,
+					Display:     "atom",
+					Name:        "wrong",
+					Symbol:      "WRONG",
+					DenomUnits: []*banktypes.DenomUnit{
+						{Denom: ibcDenom, Exponent: 0},
+						{Denom: "atom", Exponent: 18},
+					},
+				})
+				return &types.MsgUpdateTokenPairMetadata{
+					Authority: authority,
+					Token:     pair.Denom,
+					Name:      "Cosmos Hub Atom",
+					Symbol:    "ATOM",
+					Decimals:  6,
+				}
+			},
+			true,
+			"",
+			banktypes.Metadata{
+				Description: "IBC voucher",
+				Base:        ibcDenom,
+				Display:     "atom",
+				Name:        "Cosmos Hub Atom",
+				Symbol:      "ATOM",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: ibcDenom, Exponent: 0},
+					{Denom: "atom", Exponent: 6},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+
+			pair = types.NewTokenPair(utiltx.GenerateAddress(), ibcDenom, types.OWNER_MODULE)
+			suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+
+			_, err := suite.network.App.Erc20Keeper.UpdateTokenPairMetadata(ctx, tc.malleate())
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+			metadata, found := suite.network.App.BankKeeper.GetDenomMetaData(ctx, ibcDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expMetadata, metadata)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20Permissionless() {
+	var (
+		ctx      sdk.Context
+		contract common.Address
+	)
+	registrant := suite.keyring.GetAccAddr(0)
+
+	testCases := []struct {
+		name        string
+		malleate    func(params *types.Params)
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - permissionless registration disabled",
+			func(params *types.Params) {
+				params.PermissionlessRegistration = false
+			},
+			false,
+			types.ErrRegistrationDisabled.Error(),
+		},
+		{
+			"fail - contract denylisted",
+			func(params *types.Params) {
+				params.RegistrationDenylist = []string{contract.Hex()}
+			},
+			false,
+			types.ErrRegistrationDenylisted.Error(),
+		},
+		{
+			"fail - insufficient funds for the registration fee",
+			func(params *types.Params) {
+				params.RegistrationFee = sdk.NewCoins(sdk.NewCoin(suite.network.GetDenom(), math.NewIntWithDecimal(1, 40)))
+			},
+			false,
+			"failed to pay the registration fee",
+		},
+		{
+			"pass - without registration fee",
+			func(*types.Params) {},
+			true,
+			"",
+		},
+		{
+			"pass - with registration fee",
+			func(params *types.Params) {
+				params.RegistrationFee = sdk.NewCoins(sdk.NewInt64Coin(suite.network.GetDenom(), 1000))
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			var err error
+			suite.SetupTest()
+
+			contract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			suite.Require().NoError(err, "failed to deploy contract")
+			suite.Require().NoError(suite.network.NextBlock(), "failed to advance block")
+			ctx = suite.network.GetContext()
+
+			params := suite.network.App.Erc20Keeper.GetParams(ctx)
+			params.PermissionlessRegistration = true
+			tc.malleate(&params)
+			suite.Require().NoError(suite.network.App.Erc20Keeper.SetParams(ctx, params))
+
+			supplyBefore := suite.network.App.BankKeeper.GetSupply(ctx, suite.network.GetDenom())
+
+			_, err = suite.network.App.Erc20Keeper.RegisterERC20(ctx, &types.MsgRegisterERC20{
+				Authority:      registrant.String(),
+				Erc20Addresses: []string{contract.Hex()},
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+			id := suite.network.App.Erc20Keeper.GetTokenPairID(ctx, contract.Hex())
+			_, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, id)
+			suite.Require().True(found)
+
+			// the registrant is recorded as the creator of the token pair
+			creator := suite.network.App.Erc20Keeper.GetTokenPairCreator(ctx, id)
+			suite.Require().Equal(common.BytesToAddress(registrant), creator)
+
+			// the registration fee is burned
+			supplyAfter := suite.network.App.BankKeeper.GetSupply(ctx, suite.network.GetDenom())
+			fee := params.RegistrationFee.AmountOf(suite.network.GetDenom())
+			suite.Require().Equal(supplyBefore.Amount.Sub(fee), supplyAfter.Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeregisterTokenPair() {
+	var ctx sdk.Context
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name        string
+		malleate    func() *types.MsgDeregisterTokenPair
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid authority",
+			func() *types.MsgDeregisterTokenPair {
+				return &types.MsgDeregisterTokenPair{Authority: "foobar", Token: suite.network.GetDenom()}
+			},
+			false,
+			"invalid authority",
+		},
+		{
+			"fail - token pair not registered",
+			func() *types.MsgDeregisterTokenPair {
+				return &types.MsgDeregisterTokenPair{Authority: authority, Token: "ibc/unknown"}
+			},
+			false,
+			types.ErrTokenPairNotFound.Error(),
+		},
+		{
+			"fail - token pair already deregistered",
+			func() *types.MsgDeregisterTokenPair {
+				msg := &types.MsgDeregisterTokenPair{Authority: authority, Token: suite.network.GetDenom()}
+				_, err := suite.network.App.Erc20Keeper.DeregisterTokenPair(ctx, msg)
+				suite.Require().NoError(err)
+				return msg
+			},
+			false,
+			types.ErrTokenPairDeregistered.Error(),
+		},
+		{
+			"pass - disables the token pair and removes its precompile",
+			func() *types.MsgDeregisterTokenPair {
+				return &types.MsgDeregisterTokenPair{Authority: authority, Token: suite.network.GetDenom()}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+
+			_, err := suite.network.App.Erc20Keeper.DeregisterTokenPair(ctx, tc.malleate())
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+			id := suite.network.App.Erc20Keeper.GetTokenPairID(ctx, suite.network.GetDenom())
+			pair, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, id)
+			suite.Require().True(found, "expected the token pair to be kept")
+			suite.Require().False(pair.Enabled)
+
+			params := suite.network.App.Erc20Keeper.GetParams(ctx)
+			suite.Require().False(params.IsNativePrecompile(pair.GetERC20Contract()))
+			suite.Require().False(params.IsDynamicPrecompile(pair.GetERC20Contract()))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestExportTokenPairHolders() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	_, err := suite.network.App.Erc20Keeper.ExportTokenPairHolders(ctx, "ibc/unknown")
+	suite.Require().ErrorContains(err, types.ErrTokenPairNotFound.Error())
+
+	contractAddr, err := suite.setupRegisterERC20Pair(contractMinterBurner)
+	suite.Require().NoError(err)
+
+	holder := suite.keyring.GetKey(0)
+	_, err = suite.MintERC20Token(contractAddr, holder.Addr, big.NewInt(100))
+	suite.Require().NoError(err)
+
+	ctx = suite.network.GetContext()
+	_, err = suite.network.App.Erc20Keeper.ConvertERC20(ctx, types.NewMsgConvertERC20(
+		math.NewInt(40), holder.AccAddr, contractAddr, holder.Addr,
+	))
+	suite.Require().NoError(err)
+	suite.network.App.Erc20Keeper.UpdateTokenHolders(ctx)
+
+	holders, err := suite.network.App.Erc20Keeper.ExportTokenPairHolders(ctx, contractAddr.Hex())
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.TokenPairHolderBalance{{
+		Address:      holder.Addr.Hex(),
+		Balance:      math.NewInt(40),
+		Erc20Balance: math.NewInt(60),
+	}}, holders)
+
+	res, err := suite.network.App.Erc20Keeper.TokenPairHolderBalances(ctx, &types.QueryTokenPairHolderBalancesRequest{
+		Token: contractAddr.Hex(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(holders, res.Balances)
+}
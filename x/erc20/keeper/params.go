@@ -5,10 +5,13 @@ package keeper
 
 import (
 	"slices"
+	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 var isTrue = []byte("0x01")
@@ -20,7 +23,14 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	dynamicPrecompiles := k.getDynamicPrecompiles(ctx)
 	nativePrecompiles := k.getNativePrecompiles(ctx)
-	return types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
+	params = types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
+	params.SafeApprove = k.IsSafeApproveEnabled(ctx)
+	params.PermissionlessRegistration = k.IsPermissionlessRegistrationEnabled(ctx)
+	params.RegistrationFee = k.GetRegistrationFee(ctx)
+	params.RegistrationDenylist = k.getRegistrationDenylist(ctx)
+	params.FeeTokens = k.GetFeeTokens(ctx)
+	params.IbcAutoRegistrationAllowlist = k.getIBCAutoRegistrationAllowlist(ctx)
+	return params
 }
 
 // UpdateCodeHash takes in the updated parameters and
@@ -29,11 +39,20 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 //
 // If there is a diff, the ERC-20 code hash for all precompiles that are removed from the list
 // will be removed from the store. Meanwhile, for all newly added precompiles the code hash will be
-// registered.
+// registered. Newly added precompiles are checked for collisions with existing
+// precompiles before any code hash is updated.
 func (k Keeper) UpdateCodeHash(ctx sdk.Context, newParams types.Params) error {
 	oldNativePrecompiles := k.getNativePrecompiles(ctx)
 	oldDynamicPrecompiles := k.getDynamicPrecompiles(ctx)
 
+	if err := k.validateNewPrecompiles(ctx, oldDynamicPrecompiles, newParams.DynamicPrecompiles, false); err != nil {
+		return err
+	}
+
+	if err := k.validateNewPrecompiles(ctx, oldNativePrecompiles, newParams.NativePrecompiles, true); err != nil {
+		return err
+	}
+
 	if err := k.RegisterOrUnregisterERC20CodeHashes(ctx, oldDynamicPrecompiles, newParams.DynamicPrecompiles); err != nil {
 		return err
 	}
@@ -71,16 +90,45 @@ func (k Keeper) RegisterOrUnregisterERC20CodeHashes(ctx sdk.Context, oldPrecompi
 	return nil
 }
 
+// validateNewPrecompiles checks that all precompiles that are newly added to
+// the list can be registered without colliding with existing precompiles.
+func (k Keeper) validateNewPrecompiles(ctx sdk.Context, oldPrecompiles, newPrecompiles []string, hasWrappedMethods bool) error {
+	for _, precompile := range newPrecompiles {
+		if slices.Contains(oldPrecompiles, precompile) {
+			continue
+		}
+
+		if err := k.ValidatePrecompileRegistration(ctx, common.HexToAddress(precompile), hasWrappedMethods); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // SetParams sets the erc20 parameters to the param space.
 func (k Keeper) SetParams(ctx sdk.Context, newParams types.Params) error {
 	// sort to keep params equal between different executions
 	slices.Sort(newParams.DynamicPrecompiles)
 	slices.Sort(newParams.NativePrecompiles)
+	// use EIP-55 addresses on the denylist so that it is stored sorted
+	for i, contract := range newParams.RegistrationDenylist {
+		if common.IsHexAddress(contract) {
+			newParams.RegistrationDenylist[i] = common.HexToAddress(contract).Hex()
+		}
+	}
+	slices.Sort(newParams.RegistrationDenylist)
+	slices.Sort(newParams.IbcAutoRegistrationAllowlist)
 
 	if err := newParams.Validate(); err != nil {
 		return err
 	}
 
+	// NOTE: the fees can only be paid with the coins of the registered token pairs
+	if err := k.validateFeeTokens(ctx, newParams.FeeTokens); err != nil {
+		return err
+	}
+
 	if err := k.UpdateCodeHash(ctx, newParams); err != nil {
 		return err
 	}
@@ -88,6 +136,12 @@ func (k Keeper) SetParams(ctx sdk.Context, newParams types.Params) error {
 	k.setERC20Enabled(ctx, newParams.EnableErc20)
 	k.setDynamicPrecompiles(ctx, newParams.DynamicPrecompiles)
 	k.setNativePrecompiles(ctx, newParams.NativePrecompiles)
+	k.setSafeApprove(ctx, newParams.SafeApprove)
+	k.setPermissionlessRegistration(ctx, newParams.PermissionlessRegistration)
+	k.setRegistrationFee(ctx, newParams.RegistrationFee)
+	k.setRegistrationDenylist(ctx, newParams.RegistrationDenylist)
+	k.setFeeTokens(ctx, newParams.FeeTokens)
+	k.setIBCAutoRegistrationAllowlist(ctx, newParams.IbcAutoRegistrationAllowlist)
 	return nil
 }
 
@@ -107,6 +161,23 @@ func (k Keeper) setERC20Enabled(ctx sdk.Context, enable bool) {
 	store.Delete(types.ParamStoreKeyEnableErc20)
 }
 
+// IsSafeApproveEnabled returns true if the ERC-20 precompiles require the
+// current allowance to be zero before approving a new non-zero allowance.
+func (k Keeper) IsSafeApproveEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ParamStoreKeySafeApprove)
+}
+
+// setSafeApprove sets the SafeApprove param in the store
+func (k Keeper) setSafeApprove(ctx sdk.Context, enable bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enable {
+		store.Set(types.ParamStoreKeySafeApprove, isTrue)
+		return
+	}
+	store.Delete(types.ParamStoreKeySafeApprove)
+}
+
 // setDynamicPrecompiles sets the DynamicPrecompiles param in the store
 func (k Keeper) setDynamicPrecompiles(ctx sdk.Context, dynamicPrecompiles []string) {
 	store := ctx.KVStore(k.storeKey)
@@ -147,3 +218,156 @@ func (k Keeper) getNativePrecompiles(ctx sdk.Context) (nativePrecompiles []strin
 	}
 	return nativePrecompiles
 }
+
+// IsPermissionlessRegistrationEnabled returns true if any account can register
+// token pairs without a governance proposal
+func (k Keeper) IsPermissionlessRegistrationEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ParamStoreKeyPermissionlessRegistration)
+}
+
+// setPermissionlessRegistration sets the PermissionlessRegistration param in the store
+func (k Keeper) setPermissionlessRegistration(ctx sdk.Context, enable bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enable {
+		store.Set(types.ParamStoreKeyPermissionlessRegistration, isTrue)
+		return
+	}
+	store.Delete(types.ParamStoreKeyPermissionlessRegistration)
+}
+
+// GetRegistrationFee returns the RegistrationFee param from the store
+func (k Keeper) GetRegistrationFee(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyRegistrationFee)
+	if len(bz) == 0 {
+		return sdk.Coins{}
+	}
+
+	// NOTE: the fee is validated before being stored
+	fee, err := sdk.ParseCoinsNormalized(string(bz))
+	if err != nil {
+		panic(err)
+	}
+	return fee
+}
+
+// setRegistrationFee sets the RegistrationFee param in the store
+func (k Keeper) setRegistrationFee(ctx sdk.Context, fee sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	if fee.IsZero() {
+		store.Delete(types.ParamStoreKeyRegistrationFee)
+		return
+	}
+	store.Set(types.ParamStoreKeyRegistrationFee, []byte(fee.String()))
+}
+
+// setRegistrationDenylist sets the RegistrationDenylist param in the store
+func (k Keeper) setRegistrationDenylist(ctx sdk.Context, denylist []string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 0, addressLength*len(denylist))
+	for _, str := range denylist {
+		bz = append(bz, []byte(str)...)
+	}
+	store.Set(types.ParamStoreKeyRegistrationDenylist, bz)
+}
+
+// getRegistrationDenylist returns the RegistrationDenylist param from the store
+func (k Keeper) getRegistrationDenylist(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyRegistrationDenylist)
+
+	denylist := make([]string, 0, len(bz)/addressLength)
+	for i := 0; i < len(bz); i += addressLength {
+		denylist = append(denylist, string(bz[i:i+addressLength]))
+	}
+	return denylist
+}
+
+// GetFeeTokens returns the FeeTokens param from the store. No fee token is
+// returned if the stored value can't be parsed, which disables the payment of
+// the fees in tokens instead of halting the chain.
+func (k Keeper) GetFeeTokens(ctx sdk.Context) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyFeeTokens)
+	if len(bz) == 0 {
+		return sdk.DecCoins{}
+	}
+
+	feeTokens, err := sdk.ParseDecCoins(string(bz))
+	if err != nil {
+		k.Logger(ctx).Error("failed to parse the fee tokens", "fee_tokens", string(bz), "error", err.Error())
+		return sdk.DecCoins{}
+	}
+	return feeTokens
+}
+
+// GetFeeTokenPrice returns the price of one unit of the EVM coin in the fee
+// token with the given denomination, if it can be used to pay the fees of
+// ethereum transactions.
+func (k Keeper) GetFeeTokenPrice(ctx sdk.Context, denom string) (sdk.DecCoin, bool) {
+	price := k.GetFeeTokens(ctx).AmountOf(denom)
+	if !price.IsPositive() {
+		return sdk.DecCoin{}, false
+	}
+	return sdk.NewDecCoinFromDec(denom, price), true
+}
+
+// setFeeTokens sets the FeeTokens param in the store
+func (k Keeper) setFeeTokens(ctx sdk.Context, feeTokens sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	if feeTokens.IsZero() {
+		store.Delete(types.ParamStoreKeyFeeTokens)
+		return
+	}
+	store.Set(types.ParamStoreKeyFeeTokens, []byte(feeTokens.String()))
+}
+
+// validateFeeTokens checks that the fee tokens are the denominations of
+// enabled token pairs other than the EVM coin.
+func (k Keeper) validateFeeTokens(ctx sdk.Context, feeTokens sdk.DecCoins) error {
+	for _, feeToken := range feeTokens {
+		if feeToken.Denom == evmtypes.GetEVMCoinDenom() {
+			return errorsmod.Wrapf(types.ErrInvalidFeeToken, "the EVM coin %s can't be a fee token", feeToken.Denom)
+		}
+
+		pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, feeToken.Denom))
+		if !found {
+			return errorsmod.Wrapf(types.ErrTokenPairNotFound, "fee token %s", feeToken.Denom)
+		}
+		if !pair.Enabled {
+			return errorsmod.Wrapf(types.ErrERC20TokenPairDisabled, "fee token %s", feeToken.Denom)
+		}
+	}
+	return nil
+}
+
+// IsIBCAutoRegistrationAllowed returns true if the first reception of an IBC
+// voucher on the given channel registers its token pair.
+func (k Keeper) IsIBCAutoRegistrationAllowed(ctx sdk.Context, channel string) bool {
+	allowlist := k.getIBCAutoRegistrationAllowlist(ctx)
+	return len(allowlist) == 0 || slices.Contains(allowlist, channel)
+}
+
+// setIBCAutoRegistrationAllowlist sets the IBCAutoRegistrationAllowlist param in the store
+func (k Keeper) setIBCAutoRegistrationAllowlist(ctx sdk.Context, allowlist []string) {
+	store := ctx.KVStore(k.storeKey)
+	if len(allowlist) == 0 {
+		store.Delete(types.ParamStoreKeyIBCAutoRegistrationAllowlist)
+		return
+	}
+
+	// NOTE: the channel identifiers are validated before being stored, so
+	// they can't contain the separator
+	store.Set(types.ParamStoreKeyIBCAutoRegistrationAllowlist, []byte(strings.Join(allowlist, "/")))
+}
+
+// getIBCAutoRegistrationAllowlist returns the IBCAutoRegistrationAllowlist param from the store
+func (k Keeper) getIBCAutoRegistrationAllowlist(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyIBCAutoRegistrationAllowlist)
+	if len(bz) == 0 {
+		return []string{}
+	}
+	return strings.Split(string(bz), "/")
+}
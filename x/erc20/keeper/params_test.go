@@ -54,6 +54,36 @@ func (suite *KeeperTestSuite) TestParams() {
 			},
 			true,
 		},
+		{
+			"success - Checks if safe approve is set correctly",
+			func() interface{} {
+				params := types.DefaultParams()
+				params.SafeApprove = true
+				err := suite.network.App.Erc20Keeper.SetParams(ctx, params)
+				suite.Require().NoError(err)
+				return params.SafeApprove
+			},
+			func() interface{} {
+				return suite.network.App.Erc20Keeper.GetParams(ctx).SafeApprove
+			},
+			true,
+		},
+		{
+			"success - Checks if the permissionless registration params are set correctly",
+			func() interface{} {
+				params := types.DefaultParams()
+				params.PermissionlessRegistration = true
+				params.RegistrationFee = sdk.NewCoins(sdk.NewInt64Coin("aevmos", 1000))
+				params.RegistrationDenylist = []string{"0x205CF44075E77A3543abC690437F3b2819bc450a"}
+				err := suite.network.App.Erc20Keeper.SetParams(ctx, params)
+				suite.Require().NoError(err)
+				return params
+			},
+			func() interface{} {
+				return suite.network.App.Erc20Keeper.GetParams(ctx)
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,83 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// SetTokenPairPaused pauses or unpauses the token pair of the given token.
+// While a token pair is paused, its tokens cannot be transferred through the
+// ERC-20 precompile or the bank module, nor converted between their Cosmos and
+// ERC-20 representations. It returns an error if the token pair is not registered or
+// is already in the requested state.
+func (k Keeper) SetTokenPairPaused(ctx sdk.Context, token string, paused bool) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	switch {
+	case paused && pair.Paused:
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairPaused, "token '%s'", token)
+	case !paused && !pair.Paused:
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairNotPaused, "token '%s'", token)
+	}
+
+	pair.Paused = paused
+	k.SetTokenPair(ctx, pair)
+
+	eventType := types.EventTypeUnpauseTokenPair
+	if paused {
+		eventType = types.EventTypePauseTokenPair
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return pair, nil
+}
+
+// PausedSendRestriction is a bank send restriction that rejects the sends of
+// the denoms of paused token pairs, so that a paused token pair cannot be
+// transferred through the bank module either.
+func (k Keeper) PausedSendRestriction(
+	c context.Context,
+	_, toAddr sdk.AccAddress,
+	amt sdk.Coins,
+) (sdk.AccAddress, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	for _, coin := range amt {
+		id := k.GetDenomMap(ctx, coin.Denom)
+		if len(id) == 0 {
+			continue
+		}
+
+		if pair, found := k.GetTokenPair(ctx, id); found && pair.Paused {
+			return toAddr, errorsmod.Wrapf(types.ErrTokenPairPaused, "token '%s'", coin.Denom)
+		}
+	}
+
+	return toAddr, nil
+}
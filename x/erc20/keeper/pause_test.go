@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/evmos/evmos/v20/testutil"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestSetTokenPairPaused() {
+	var ctx sdk.Context
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		token     string
+		paused    bool
+		expErr    error
+		expEvent  string
+		expPaused bool
+	}{
+		{
+			"fail - token pair not registered",
+			func() {},
+			utiltx.GenerateAddress().Hex(),
+			true,
+			types.ErrTokenPairNotFound,
+			"",
+			false,
+		},
+		{
+			"fail - unpause a token pair that is not paused",
+			func() {},
+			pair.Erc20Address,
+			false,
+			types.ErrTokenPairNotPaused,
+			"",
+			false,
+		},
+		{
+			"fail - pause a token pair that is already paused",
+			func() {
+				_, err := suite.network.App.Erc20Keeper.SetTokenPairPaused(ctx, pair.Erc20Address, true)
+				suite.Require().NoError(err)
+			},
+			pair.Erc20Address,
+			true,
+			types.ErrTokenPairPaused,
+			"",
+			true,
+		},
+		{
+			"pass - pause by erc20 address",
+			func() {},
+			pair.Erc20Address,
+			true,
+			nil,
+			types.EventTypePauseTokenPair,
+			true,
+		},
+		{
+			"pass - unpause by denom",
+			func() {
+				_, err := suite.network.App.Erc20Keeper.SetTokenPairPaused(ctx, pair.Erc20Address, true)
+				suite.Require().NoError(err)
+			},
+			pair.Denom,
+			false,
+			nil,
+			types.EventTypeUnpauseTokenPair,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+			suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+
+			tc.malleate()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			res, err := suite.network.App.Erc20Keeper.SetTokenPairPaused(ctx, tc.token, tc.paused)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPaused, res.Paused)
+
+				events := ctx.EventManager().Events()
+				suite.Require().Len(events, 1)
+				suite.Require().Equal(tc.expEvent, events[0].Type)
+			}
+
+			stored, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, pair.GetID())
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expPaused, stored.Paused)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPausedSendRestriction() {
+	var ctx sdk.Context
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	sender := suite.keyring.GetAccAddr(0)
+	receiver := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 10))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"pass - token pair not paused",
+			func() {},
+			nil,
+		},
+		{
+			"pass - token pair unpaused",
+			func() {
+				_, err := suite.network.App.Erc20Keeper.SetTokenPairPaused(ctx, pair.Denom, true)
+				suite.Require().NoError(err)
+				_, err = suite.network.App.Erc20Keeper.SetTokenPairPaused(ctx, pair.Denom, false)
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"fail - token pair paused",
+			func() {
+				_, err := suite.network.App.Erc20Keeper.SetTokenPairPaused(ctx, pair.Denom, true)
+				suite.Require().NoError(err)
+			},
+			types.ErrTokenPairPaused,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+			suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+
+			err := testutil.FundAccount(ctx, suite.network.App.BankKeeper, sender, coins)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			msgServer := bankkeeper.NewMsgServerImpl(suite.network.App.BankKeeper)
+			_, err = msgServer.Send(ctx, banktypes.NewMsgSend(sender, receiver, coins))
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().True(suite.network.App.BankKeeper.GetBalance(ctx, receiver, pair.Denom).IsZero())
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(coins[0], suite.network.App.BankKeeper.GetBalance(ctx, receiver, pair.Denom))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateTokenPairPaused() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.Erc20Keeper
+
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	k.SetToken(ctx, pair)
+
+	// only the governance can pause the token pair
+	msg := &types.MsgUpdateTokenPairPaused{
+		Authority: suite.keyring.GetAccAddr(0).String(),
+		Token:     pair.Denom,
+		Paused:    true,
+	}
+	_, err := k.UpdateTokenPairPaused(ctx, msg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// the token pair must be registered
+	msg.Authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msg.Token = utiltx.GenerateAddress().Hex()
+	_, err = k.UpdateTokenPairPaused(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	msg.Token = pair.Erc20Address
+	_, err = k.UpdateTokenPairPaused(ctx, msg)
+	suite.Require().NoError(err)
+	stored, found := k.GetTokenPair(ctx, pair.GetID())
+	suite.Require().True(found)
+	suite.Require().True(stored.Paused)
+
+	msg.Paused = false
+	_, err = k.UpdateTokenPairPaused(ctx, msg)
+	suite.Require().NoError(err)
+	stored, found = k.GetTokenPair(ctx, pair.GetID())
+	suite.Require().True(found)
+	suite.Require().False(stored.Paused)
+}
@@ -8,7 +8,10 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/erc1155"
 	"github.com/evmos/evmos/v20/precompiles/erc20"
 	"github.com/evmos/evmos/v20/precompiles/werc20"
 	"github.com/evmos/evmos/v20/x/erc20/types"
@@ -16,13 +19,26 @@ import (
 )
 
 // GetERC20PrecompileInstance returns the precompile instance for the given address.
+//
+// Besides the ERC-20 precompiles of the token pairs, the dynamic precompiles
+// include the ERC-1155 precompile of every denom group.
 func (k Keeper) GetERC20PrecompileInstance(
 	ctx sdk.Context,
 	address common.Address,
 ) (contract vm.PrecompiledContract, found bool, err error) {
 	params := k.GetParams(ctx)
 	if !k.IsAvailableERC20Precompile(&params, address) {
-		return nil, false, nil
+		groupID, found := k.GetDenomGroupIDByERC1155(ctx, address)
+		if !found {
+			return nil, false, nil
+		}
+
+		precompile, err := k.InstantiateERC1155Precompile(ctx, groupID)
+		if err != nil {
+			return nil, false, errorsmod.Wrapf(err, "precompiled contract not initialized: %s", address.String())
+		}
+
+		return precompile, true, nil
 	}
 
 	isNative := params.IsNativePrecompile(address)
@@ -51,11 +67,53 @@ func (k Keeper) InstantiateERC20Precompile(ctx sdk.Context, contractAddr common.
 		return nil, fmt.Errorf("token pair not found: %s", address)
 	}
 
+	settings := k.GetTokenPairSettings(ctx, id)
+	safeApprove := k.IsSafeApproveEnabled(ctx)
+	dynamicGas := k.evmKeeper.GetParams(ctx).IsPrecompileDynamicGasActive(ctx.BlockHeight())
+
+	var denomGroup []string
+	if groupID, found := k.GetDenomGroupID(ctx, pair.Denom); found {
+		denomGroup = k.GetDenomGroup(ctx, groupID)
+	}
+
 	if hasWrappedMethods {
-		return werc20.NewPrecompile(pair, k.bankKeeper, k.authzKeeper, *k.transferKeeper)
+		precompile, err := werc20.NewPrecompile(pair, k.bankKeeper, k, *k.transferKeeper)
+		if err != nil {
+			return nil, err
+		}
+		precompile.SetTokenPairSettings(settings)
+		precompile.SetSafeApprove(safeApprove)
+		precompile.SetDenomGroup(denomGroup)
+		precompile.SetDynamicGas(dynamicGas)
+		precompile.SetTokenPairKeeper(k)
+		precompile.SetHooks(k)
+		return precompile, nil
 	}
 
-	return erc20.NewPrecompile(pair, k.bankKeeper, k.authzKeeper, *k.transferKeeper)
+	precompile, err := erc20.NewPrecompile(pair, k.bankKeeper, k, *k.transferKeeper)
+	if err != nil {
+		return nil, err
+	}
+	precompile.SetTokenPairSettings(settings)
+	precompile.SetSafeApprove(safeApprove)
+	precompile.SetDenomGroup(denomGroup)
+	precompile.SetDynamicGas(dynamicGas)
+	precompile.SetTokenPairKeeper(k)
+	precompile.SetHooks(k)
+	return precompile, nil
+}
+
+// InstantiateERC1155Precompile returns an ERC-1155 precompile instance for the
+// denom group with the given id. The token ID of each member denomination is
+// its index in the lexicographically sorted members of the group, so updating
+// the members of a group can change the token IDs.
+func (k Keeper) InstantiateERC1155Precompile(ctx sdk.Context, groupID string) (vm.PrecompiledContract, error) {
+	denoms := k.GetDenomGroup(ctx, groupID)
+	if len(denoms) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrDenomGroupNotFound, "group %s", groupID)
+	}
+
+	return erc1155.NewPrecompile(types.DenomGroupERC1155Address(groupID), denoms, k.bankKeeper)
 }
 
 // IsAvailableERC20Precompile returns true if the given precompile address
@@ -66,3 +124,48 @@ func (k Keeper) IsAvailableERC20Precompile(params *types.Params, address common.
 	return params.IsNativePrecompile(address) ||
 		params.IsDynamicPrecompile(address)
 }
+
+// ValidatePrecompileRegistration checks that an ERC-20 precompile registered
+// at the given address can be resolved unambiguously.
+//
+// Static precompiles are resolved before the ERC-20 precompiles, so an ERC-20
+// precompile at the address of an available static precompile would be
+// silently shadowed. Within a precompile, methods are resolved by their 4-byte
+// selector, so the selectors of the ERC-20 ABI (and of the WERC-20 ABI that
+// extends it, if `hasWrappedMethods` is true) must not collide, neither with
+// each other nor with the methods of the active static precompiles.
+func (k Keeper) ValidatePrecompileRegistration(ctx sdk.Context, address common.Address, hasWrappedMethods bool) error {
+	evmParams := k.evmKeeper.GetParams(ctx)
+	if k.evmKeeper.IsAvailableStaticPrecompile(&evmParams, address) {
+		return errorsmod.Wrapf(types.ErrPrecompileCollision, "address %s is already used by a static precompile", address)
+	}
+
+	erc20ABI, err := erc20.LoadABI()
+	if err != nil {
+		return err
+	}
+	abis := []abi.ABI{erc20ABI}
+
+	if hasWrappedMethods {
+		werc20ABI, err := werc20.LoadABI()
+		if err != nil {
+			return err
+		}
+		abis = append(abis, werc20ABI)
+	}
+
+	// NOTE: the selectors are also compared with the ones of the active static
+	// precompiles, so that a method selector resolves to the same method on
+	// every precompile of the chain.
+	staticABIs, err := k.evmKeeper.GetActiveStaticPrecompileABIs(ctx)
+	if err != nil {
+		return err
+	}
+	abis = append(abis, staticABIs...)
+
+	if err := cmn.CheckSelectorCollisions(abis...); err != nil {
+		return errorsmod.Wrapf(types.ErrPrecompileCollision, "precompile %s: %s", address, err)
+	}
+
+	return nil
+}
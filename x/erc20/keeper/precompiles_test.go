@@ -1,9 +1,23 @@
 package keeper_test
 
 import (
+	"math/big"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	erc1155precompile "github.com/evmos/evmos/v20/precompiles/erc1155"
+	erc20precompile "github.com/evmos/evmos/v20/precompiles/erc20"
+	"github.com/evmos/evmos/v20/testutil"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20/keeper"
 	"github.com/evmos/evmos/v20/x/erc20/types"
+	erc20mocks "github.com/evmos/evmos/v20/x/erc20/types/mocks"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	"github.com/stretchr/testify/mock"
 )
 
 func (suite *KeeperTestSuite) TestGetERC20PrecompileInstance() {
@@ -82,3 +96,180 @@ func (suite *KeeperTestSuite) TestGetERC20PrecompileInstance() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestInstantiateERC20PrecompileDynamicGas() {
+	tokenPair := types.NewTokenPair(utiltx.GenerateAddress(), "test", types.OWNER_MODULE)
+
+	testCases := []struct {
+		name          string
+		dynamicHeight int64
+		expDynamic    bool
+	}{
+		{"static gas - dynamic gas disabled", 0, false},
+		{"static gas - activation height not reached", 1_000_000, false},
+		{"dynamic gas - activation height reached", 1, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+			suite.network.App.Erc20Keeper.SetToken(ctx, tokenPair)
+
+			evmParams := suite.network.App.EvmKeeper.GetParams(ctx)
+			evmParams.PrecompileDynamicGasHeight = tc.dynamicHeight
+			err := suite.network.App.EvmKeeper.SetParams(ctx, evmParams)
+			suite.Require().NoError(err)
+
+			precompile, err := suite.network.App.Erc20Keeper.InstantiateERC20Precompile(ctx, tokenPair.GetERC20Contract(), false)
+			suite.Require().NoError(err)
+
+			erc20Precompile, ok := precompile.(*erc20precompile.Precompile)
+			suite.Require().True(ok, "expected ERC-20 precompile")
+
+			input, err := erc20Precompile.ABI.Pack(erc20precompile.TransferMethod, utiltx.GenerateAddress(), big.NewInt(1))
+			suite.Require().NoError(err)
+
+			if tc.expDynamic {
+				suite.Require().Less(erc20Precompile.RequiredGas(input), uint64(erc20precompile.GasTransfer))
+			} else {
+				suite.Require().Equal(uint64(erc20precompile.GasTransfer), erc20Precompile.RequiredGas(input))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestERC1155PrecompileEVMCall() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	groupID := "xmpl"
+	denoms := []string{"xmpla", "xmplb"}
+	holder := suite.keyring.GetAddr(0)
+	receiver := utiltx.GenerateAddress()
+
+	err := testutil.FundAccount(ctx, suite.network.App.BankKeeper, holder.Bytes(), sdk.NewCoins(
+		sdk.NewInt64Coin(denoms[0], 100),
+		sdk.NewInt64Coin(denoms[1], 50),
+	))
+	suite.Require().NoError(err)
+
+	erc1155Addr := types.DenomGroupERC1155Address(groupID)
+	_, found, err := suite.network.App.Erc20Keeper.GetERC20PrecompileInstance(ctx, erc1155Addr)
+	suite.Require().NoError(err)
+	suite.Require().False(found, "expected no ERC-1155 precompile before the group is set")
+
+	err = suite.network.App.Erc20Keeper.SetDenomGroup(ctx, groupID, denoms)
+	suite.Require().NoError(err)
+
+	erc1155ABI, err := erc1155precompile.LoadABI()
+	suite.Require().NoError(err)
+
+	// the token ID of a denom is its index in the sorted members of the group
+	res, err := suite.network.App.EvmKeeper.CallEVM(
+		ctx, erc1155ABI, holder, erc1155Addr, false, erc1155precompile.BalanceOfMethod, holder, big.NewInt(1),
+	)
+	suite.Require().NoError(err)
+	balance, err := erc1155ABI.Unpack(erc1155precompile.BalanceOfMethod, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(50), balance[0])
+
+	_, err = suite.network.App.EvmKeeper.CallEVM(
+		ctx, erc1155ABI, holder, erc1155Addr, true, erc1155precompile.SafeTransferFromMethod,
+		holder, receiver, big.NewInt(0), big.NewInt(40), []byte{},
+	)
+	suite.Require().NoError(err)
+
+	holderBalance := suite.network.App.BankKeeper.GetBalance(ctx, holder.Bytes(), denoms[0])
+	suite.Require().Equal(int64(60), holderBalance.Amount.Int64())
+	receiverBalance := suite.network.App.BankKeeper.GetBalance(ctx, receiver.Bytes(), denoms[0])
+	suite.Require().Equal(int64(40), receiverBalance.Amount.Int64())
+
+	// removing the group deregisters the ERC-1155 precompile
+	suite.network.App.Erc20Keeper.DeleteDenomGroup(ctx, groupID)
+	_, found, err = suite.network.App.Erc20Keeper.GetERC20PrecompileInstance(ctx, erc1155Addr)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestValidatePrecompileRegistration() {
+	testCases := []struct {
+		name              string
+		address           common.Address
+		hasWrappedMethods bool
+		errContains       string
+	}{
+		{
+			"pass - ERC-20 precompile",
+			utiltx.GenerateAddress(),
+			false,
+			"",
+		},
+		{
+			"pass - WERC-20 precompile",
+			utiltx.GenerateAddress(),
+			true,
+			"",
+		},
+		{
+			"fail - address of a static precompile",
+			common.HexToAddress(evmtypes.StakingPrecompileAddress),
+			false,
+			"already used by a static precompile",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			err := suite.network.App.Erc20Keeper.ValidatePrecompileRegistration(ctx, tc.address, tc.hasWrappedMethods)
+			if tc.errContains == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.errContains)
+				suite.Require().ErrorIs(err, types.ErrPrecompileCollision)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEnableDynamicPrecompilesStaticCollision() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	err := suite.network.App.Erc20Keeper.EnableDynamicPrecompiles(ctx, common.HexToAddress(evmtypes.StakingPrecompileAddress))
+	suite.Require().ErrorIs(err, types.ErrPrecompileCollision)
+
+	params := suite.network.App.Erc20Keeper.GetParams(ctx)
+	suite.Require().NotContains(params.DynamicPrecompiles, common.HexToAddress(evmtypes.StakingPrecompileAddress).Hex())
+}
+
+func (suite *KeeperTestSuite) TestValidatePrecompileRegistrationStaticSelectorCollision() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	// many_msg_babbage(bytes1) shares the 0xa9059cbb selector with the ERC-20 transfer method
+	collidingABI, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"many_msg_babbage","stateMutability":"nonpayable","inputs":[{"name":"","type":"bytes1"}],"outputs":[]}]`))
+	suite.Require().NoError(err)
+
+	mockEVMKeeper := &erc20mocks.EVMKeeper{}
+	mockEVMKeeper.On("GetParams", mock.Anything).Return(evmtypes.DefaultParams())
+	mockEVMKeeper.On("IsAvailableStaticPrecompile", mock.Anything, mock.Anything).Return(false)
+	mockEVMKeeper.On("GetActiveStaticPrecompileABIs", mock.Anything).Return([]abi.ABI{collidingABI}, nil)
+
+	erc20Keeper := keeper.NewKeeper(
+		suite.network.App.GetKey("erc20"), suite.network.App.AppCodec(),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		suite.network.App.AccountKeeper, suite.network.App.BankKeeper,
+		mockEVMKeeper, suite.network.App.StakingKeeper,
+		suite.network.App.AuthzKeeper, &suite.network.App.TransferKeeper,
+	)
+
+	err = erc20Keeper.ValidatePrecompileRegistration(ctx, utiltx.GenerateAddress(), false)
+	suite.Require().ErrorIs(err, types.ErrPrecompileCollision)
+	suite.Require().ErrorContains(err, "many_msg_babbage(bytes1)")
+
+	// the active static precompiles of the chain don't collide with the ERC-20 methods
+	err = suite.network.App.Erc20Keeper.ValidatePrecompileRegistration(ctx, utiltx.GenerateAddress(), true)
+	suite.Require().NoError(err)
+}
@@ -4,6 +4,8 @@
 package keeper
 
 import (
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -13,10 +15,12 @@ import (
 )
 
 // RegisterERC20 creates a Cosmos coin and registers the token pair between the
-// coin and the ERC20
+// coin and the ERC20. The given creator is recorded as the account that
+// registered the token pair.
 func (k Keeper) registerERC20(
 	ctx sdk.Context,
 	contract common.Address,
+	creator common.Address,
 ) (*types.TokenPair, error) {
 	// Check if ERC20 is already registered
 	if k.IsERC20Registered(ctx, contract) {
@@ -34,6 +38,7 @@ func (k Keeper) registerERC20(
 
 	pair := types.NewTokenPair(contract, metadata.Name, types.OWNER_EXTERNAL)
 	k.SetToken(ctx, pair)
+	k.SetTokenPairCreator(ctx, pair.GetID(), creator)
 	return &pair, nil
 }
 
@@ -109,7 +114,8 @@ func (k Keeper) CreateCoinMetadata(
 	return &metadata, nil
 }
 
-// toggleConversion toggles conversion for a given token pair
+// toggleConversion toggles conversion for a given token pair. The conversions
+// of a deregistered token pair can't be toggled.
 func (k Keeper) toggleConversion(
 	ctx sdk.Context,
 	token string,
@@ -128,7 +134,94 @@ func (k Keeper) toggleConversion(
 		)
 	}
 
+	if k.IsTokenPairDeregistered(ctx, id) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairDeregistered, "token '%s'", token)
+	}
+
 	pair.Enabled = !pair.Enabled
 	k.SetTokenPair(ctx, pair)
 	return pair, nil
 }
+
+// updateTokenPairMetadata overwrites the name, symbol and decimals of the bank
+// metadata of a native coin token pair. As the ERC-20 precompile of the token
+// pair reads the bank metadata on every query, the change is reflected on its
+// name, symbol and decimals methods. Native ERC-20 token pairs are rejected
+// since their metadata is derived from the contract itself.
+func (k Keeper) updateTokenPairMetadata(
+	ctx sdk.Context,
+	token, name, symbol string,
+	decimals uint32,
+) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if !pair.IsNativeCoin() {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrInvalidTokenMetadata, "token '%s' is not a native coin", token,
+		)
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, pair.Denom)
+	if !found {
+		metadata = banktypes.Metadata{
+			Base:    pair.Denom,
+			Display: pair.Denom,
+		}
+	}
+
+	metadata.Name = name
+	metadata.Symbol = symbol
+	metadata.DenomUnits, metadata.Display = buildDenomUnits(metadata, symbol, decimals)
+
+	if err := metadata.Validate(); err != nil {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrInvalidTokenMetadata, "token '%s': %s", token, err,
+		)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return pair, nil
+}
+
+// buildDenomUnits returns the denomination units and the display denomination
+// of the given metadata after setting the display exponent to decimals. The
+// base unit and the units below the new exponent are kept. If the display
+// denomination was the base denomination, the lowercase symbol is used as the
+// new display denomination.
+func buildDenomUnits(metadata banktypes.Metadata, symbol string, decimals uint32) ([]*banktypes.DenomUnit, string) {
+	base := &banktypes.DenomUnit{Denom: metadata.Base, Exponent: 0}
+	units := []*banktypes.DenomUnit{base}
+
+	if decimals == 0 {
+		return units, metadata.Base
+	}
+
+	display := metadata.Display
+	if display == metadata.Base {
+		display = strings.ToLower(symbol)
+	}
+
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Base || unit.Denom == display {
+			continue
+		}
+		if unit.Exponent > 0 && unit.Exponent < decimals {
+			units = append(units, unit)
+		}
+	}
+
+	units = append(units, &banktypes.DenomUnit{Denom: display, Exponent: decimals})
+	return units, display
+}
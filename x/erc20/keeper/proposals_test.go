@@ -183,6 +183,11 @@ func (suite *KeeperTestSuite) TestRegisterERC20() {
 				suite.Require().Equal(types.SanitizeERC20Name(erc20Name), metadata.DenomUnits[1].Denom)
 				// Custom exponent at contract creation matches coin with token
 				suite.Require().Equal(metadata.DenomUnits[1].Exponent, uint32(cosmosDecimals))
+				// The governance authority that registered the pair is recorded as its
+				// creator, which is distinct from the deployer of the contract.
+				creator := suite.network.App.Erc20Keeper.GetTokenPairCreator(ctx, pair.GetID())
+				suite.Require().Equal(common.BytesToAddress(authtypes.NewModuleAddress("gov")), creator)
+				suite.Require().NotEqual(suite.keyring.GetAddr(0), creator)
 			} else {
 				suite.Require().Error(err, tc.name)
 			}
@@ -272,6 +277,23 @@ func (suite *KeeperTestSuite) TestToggleConverision() {
 			true,
 			true,
 		},
+		{
+			"deregistered token pair - can't be enabled again",
+			func() {
+				contractAddr, err = suite.setupRegisterERC20Pair(contractMinterBurner)
+				suite.Require().NoError(err, "failed to register pair")
+				ctx = suite.network.GetContext()
+				id = suite.network.App.Erc20Keeper.GetTokenPairID(ctx, contractAddr.String())
+				_, err := suite.network.App.Erc20Keeper.DeregisterTokenPair(ctx, &types.MsgDeregisterTokenPair{
+					Authority: authtypes.NewModuleAddress("gov").String(),
+					Token:     contractAddr.String(),
+				})
+				suite.Require().NoError(err)
+				suite.Require().True(suite.network.App.Erc20Keeper.IsTokenPairDeregistered(ctx, id))
+			},
+			false,
+			false,
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -292,6 +314,7 @@ func (suite *KeeperTestSuite) TestToggleConverision() {
 				}
 			} else {
 				suite.Require().Error(err, tc.name)
+				suite.Require().False(pair.Enabled)
 			}
 		})
 	}
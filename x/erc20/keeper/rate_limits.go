@@ -0,0 +1,196 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// transferModuleAddress is the address of the IBC transfer module account,
+// that mints and burns the vouchers of the bridged coins.
+var transferModuleAddress = authtypes.NewModuleAddress(transfertypes.ModuleName)
+
+// GetConversionRateLimit returns the conversion rate limit of the token pair
+// with the given id.
+func (k Keeper) GetConversionRateLimit(ctx sdk.Context, id []byte) (types.ConversionRateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionRateLimit)
+	bz := store.Get(id)
+	if len(bz) == 0 {
+		return types.ConversionRateLimit{}, false
+	}
+
+	var rateLimit types.ConversionRateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// GetConversionRateLimits returns the conversion rate limits of all the token
+// pairs.
+func (k Keeper) GetConversionRateLimits(ctx sdk.Context) []types.ConversionRateLimit {
+	var rateLimits []types.ConversionRateLimit
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionRateLimit)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.ConversionRateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+// SetConversionRateLimit sets the maximum amount of the coins of the token pair
+// of the given token that can be converted within an epoch of the given
+// duration. A zero max amount removes the rate limit. The amount converted
+// within the current epoch is kept when the rate limit is updated. It returns
+// an error if the token pair is not registered.
+func (k Keeper) SetConversionRateLimit(ctx sdk.Context, token string, maxAmount math.Int, epochDuration time.Duration) error {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionRateLimit)
+	if maxAmount.IsZero() {
+		store.Delete(id)
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionFlow).Delete(id)
+	} else {
+		rateLimit := types.ConversionRateLimit{
+			Erc20Address:  pair.Erc20Address,
+			MaxAmount:     maxAmount,
+			EpochDuration: epochDuration,
+		}
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		store.Set(id, k.cdc.MustMarshal(&rateLimit))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetConversionRateLimit,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyMaxAmount, maxAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyEpochDuration, epochDuration.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetConversionFlow returns the amount of the coins of the token pair with the
+// given id that have been converted within the current epoch of its rate
+// limit. A new epoch starts at the current block time once the previous one
+// has elapsed.
+func (k Keeper) GetConversionFlow(ctx sdk.Context, id []byte, rateLimit types.ConversionRateLimit) types.ConversionFlow {
+	blockTime := ctx.BlockTime()
+	newEpoch := types.ConversionFlow{EpochStart: blockTime, Amount: math.ZeroInt()}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionFlow)
+	bz := store.Get(id)
+	if len(bz) == 0 {
+		return newEpoch
+	}
+
+	var flow types.ConversionFlow
+	k.cdc.MustUnmarshal(bz, &flow)
+	if !blockTime.Before(flow.EpochStart.Add(rateLimit.EpochDuration)) {
+		return newEpoch
+	}
+
+	return flow
+}
+
+// ConsumeConversionRateLimit adds the given amount to the amount of the coins
+// of the token pair converted within the current epoch. It returns an error if
+// the total exceeds the max amount of the rate limit of the token pair, if
+// any, so that the damage of a bridge exploit is bounded.
+func (k Keeper) ConsumeConversionRateLimit(ctx sdk.Context, pair types.TokenPair, amount *big.Int) error {
+	id := pair.GetID()
+	rateLimit, found := k.GetConversionRateLimit(ctx, id)
+	if !found {
+		return nil
+	}
+
+	flow := k.GetConversionFlow(ctx, id, rateLimit)
+	converted := flow.Amount.Add(math.NewIntFromBigInt(amount))
+	if converted.GT(rateLimit.MaxAmount) {
+		return errorsmod.Wrapf(
+			types.ErrRateLimitExceeded,
+			"amount %s of token '%s' exceeds the %s available in the current epoch",
+			amount, pair.Denom, rateLimit.MaxAmount.Sub(flow.Amount),
+		)
+	}
+	flow.Amount = converted
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionFlow)
+	store.Set(id, k.cdc.MustMarshal(&flow))
+
+	return nil
+}
+
+// RateLimitSendRestriction is a bank send restriction that consumes the
+// conversion rate limits of the token pairs of the coins sent into or out of
+// the erc20 or the IBC transfer module accounts, i.e. the coins converted or
+// bridged. The sends between other accounts, such as the bank or the ERC-20
+// precompile transfers, don't count towards the rate limits.
+func (k Keeper) RateLimitSendRestriction(
+	c context.Context,
+	fromAddr, toAddr sdk.AccAddress,
+	amt sdk.Coins,
+) (sdk.AccAddress, error) {
+	if !isRateLimitedFlow(fromAddr) && !isRateLimitedFlow(toAddr) {
+		return toAddr, nil
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	for _, coin := range amt {
+		id := k.GetDenomMap(ctx, coin.Denom)
+		if len(id) == 0 {
+			continue
+		}
+
+		pair, found := k.GetTokenPair(ctx, id)
+		if !found {
+			continue
+		}
+
+		if err := k.ConsumeConversionRateLimit(ctx, pair, coin.Amount.BigInt()); err != nil {
+			return toAddr, err
+		}
+	}
+
+	return toAddr, nil
+}
+
+// isRateLimitedFlow returns true if the address is the erc20 or the IBC
+// transfer module account, so that the coins sent to or from it count towards
+// the conversion rate limits.
+func isRateLimitedFlow(addr sdk.AccAddress) bool {
+	return addr.Equals(sdk.AccAddress(types.ModuleAddress.Bytes())) || addr.Equals(transferModuleAddress)
+}
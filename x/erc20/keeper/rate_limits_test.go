@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/evmos/evmos/v20/testutil"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestConversionRateLimit() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext().WithEventManager(sdk.NewEventManager())
+	k := suite.network.App.Erc20Keeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	k.SetToken(ctx, pair)
+
+	// without a rate limit any amount can be converted
+	suite.Require().NoError(k.ConsumeConversionRateLimit(ctx, pair, big.NewInt(1_000_000)))
+
+	// only the governance can set the rate limit
+	msg := &types.MsgUpdateConversionRateLimit{
+		Authority:     suite.keyring.GetAccAddr(0).String(),
+		Token:         pair.Denom,
+		MaxAmount:     math.NewInt(100),
+		EpochDuration: time.Hour,
+	}
+	_, err := k.UpdateConversionRateLimit(ctx, msg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// the token pair must be registered
+	msg.Authority = authority
+	msg.Token = utiltx.GenerateAddress().Hex()
+	_, err = k.UpdateConversionRateLimit(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	msg.Token = pair.Denom
+	_, err = k.UpdateConversionRateLimit(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.EventTypeSetConversionRateLimit, ctx.EventManager().Events()[0].Type)
+	suite.Require().Equal([]types.ConversionRateLimit{{
+		Erc20Address:  pair.Erc20Address,
+		MaxAmount:     math.NewInt(100),
+		EpochDuration: time.Hour,
+	}}, k.GetConversionRateLimits(ctx))
+
+	// the conversions are limited within the epoch
+	suite.Require().NoError(k.ConsumeConversionRateLimit(ctx, pair, big.NewInt(60)))
+	err = k.ConsumeConversionRateLimit(ctx, pair, big.NewInt(50))
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+	suite.Require().NoError(k.ConsumeConversionRateLimit(ctx, pair, big.NewInt(40)))
+
+	res, err := k.ConversionRateLimit(ctx, &types.QueryConversionRateLimitRequest{Token: pair.Erc20Address})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(100), res.RateLimit.MaxAmount)
+	suite.Require().Equal(math.NewInt(100), res.Flow.Amount)
+	suite.Require().Equal(ctx.BlockTime().UTC(), res.Flow.EpochStart.UTC())
+
+	// a new epoch starts once the previous one has elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	res, err = k.ConversionRateLimit(ctx, &types.QueryConversionRateLimitRequest{Token: pair.Denom})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Flow.Amount.IsZero())
+	suite.Require().NoError(k.ConsumeConversionRateLimit(ctx, pair, big.NewInt(100)))
+	err = k.ConsumeConversionRateLimit(ctx, pair, big.NewInt(1))
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	// a zero max amount removes the rate limit
+	msg.MaxAmount = math.ZeroInt()
+	_, err = k.UpdateConversionRateLimit(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Empty(k.GetConversionRateLimits(ctx))
+	suite.Require().NoError(k.ConsumeConversionRateLimit(ctx, pair, big.NewInt(1_000_000)))
+
+	_, err = k.ConversionRateLimit(ctx, &types.QueryConversionRateLimitRequest{Token: pair.Denom})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestRateLimitSendRestriction() {
+	var ctx sdk.Context
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "ibc/coin", types.OWNER_MODULE)
+	sender := suite.keyring.GetAccAddr(0)
+	receiver := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name    string
+		send    func(coins sdk.Coins) error
+		amount  int64
+		expFlow int64
+		expErr  error
+	}{
+		{
+			"pass - the bank sends between accounts are not limited",
+			func(coins sdk.Coins) error {
+				msgServer := bankkeeper.NewMsgServerImpl(suite.network.App.BankKeeper)
+				_, err := msgServer.Send(ctx, banktypes.NewMsgSend(sender, receiver, coins))
+				return err
+			},
+			150,
+			0,
+			nil,
+		},
+		{
+			"pass - the self transfers are not limited",
+			func(coins sdk.Coins) error {
+				return suite.network.App.BankKeeper.SendCoins(ctx, sender, sender, coins)
+			},
+			150,
+			0,
+			nil,
+		},
+		{
+			"pass - the bridged coins within the rate limit",
+			func(coins sdk.Coins) error {
+				return suite.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, receiver, coins)
+			},
+			60,
+			60,
+			nil,
+		},
+		{
+			"fail - the bridged coins exceed the rate limit",
+			func(coins sdk.Coins) error {
+				return suite.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, receiver, coins)
+			},
+			150,
+			0,
+			types.ErrRateLimitExceeded,
+		},
+		{
+			"fail - the coins sent to the bridge exceed the rate limit",
+			func(coins sdk.Coins) error {
+				return suite.network.App.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, transfertypes.ModuleName, coins)
+			},
+			150,
+			0,
+			types.ErrRateLimitExceeded,
+		},
+		{
+			"fail - the converted coins exceed the rate limit",
+			func(coins sdk.Coins) error {
+				return suite.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins)
+			},
+			150,
+			0,
+			types.ErrRateLimitExceeded,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+			k := suite.network.App.Erc20Keeper
+			k.SetToken(ctx, pair)
+			suite.Require().NoError(k.SetConversionRateLimit(ctx, pair.Denom, math.NewInt(100), time.Hour))
+
+			coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, tc.amount))
+			suite.Require().NoError(testutil.FundAccount(ctx, suite.network.App.BankKeeper, sender, coins))
+			// NOTE: the minted coins don't count towards the rate limit
+			for _, moduleName := range []string{transfertypes.ModuleName, types.ModuleName} {
+				suite.Require().NoError(suite.network.App.BankKeeper.MintCoins(ctx, moduleName, coins))
+			}
+
+			err := tc.send(coins)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			rateLimit, found := k.GetConversionRateLimit(ctx, pair.GetID())
+			suite.Require().True(found)
+			flow := k.GetConversionFlow(ctx, pair.GetID(), rateLimit)
+			suite.Require().Equal(math.NewInt(tc.expFlow), flow.Amount)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// chargeRegistrationFee checks that the given registrant can register the
+// token pairs of the given contracts without a governance proposal and burns
+// the registration fee for each of them from its balance. It returns the
+// address of the registrant.
+func (k Keeper) chargeRegistrationFee(ctx sdk.Context, registrant string, contracts []string) (sdk.AccAddress, error) {
+	registrantAddr, err := k.accountKeeper.AddressCodec().StringToBytes(registrant)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid registrant address: %s", err)
+	}
+
+	params := k.GetParams(ctx)
+	if !params.PermissionlessRegistration {
+		return nil, errorsmod.Wrapf(
+			types.ErrRegistrationDisabled, "only the governance account %s can register token pairs", k.authority,
+		)
+	}
+
+	for _, contract := range contracts {
+		if params.IsRegistrationDenylisted(common.HexToAddress(contract)) {
+			return nil, errorsmod.Wrapf(types.ErrRegistrationDenylisted, "contract %s", contract)
+		}
+	}
+
+	if params.RegistrationFee.IsZero() || len(contracts) == 0 {
+		return registrantAddr, nil
+	}
+
+	fee := params.RegistrationFee.MulInt(math.NewInt(int64(len(contracts))))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, registrantAddr, types.ModuleName, fee); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to pay the registration fee")
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to burn the registration fee")
+	}
+
+	return registrantAddr, nil
+}
@@ -8,7 +8,9 @@ import (
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/contracts"
 	"github.com/evmos/evmos/v20/utils"
 	"github.com/evmos/evmos/v20/x/erc20/types"
 )
@@ -23,11 +25,13 @@ func (k *Keeper) CreateNewTokenPair(ctx sdk.Context, denom string) (types.TokenP
 	return pair, nil
 }
 
-// SetToken stores a token pair, denom map and erc20 map.
+// SetToken stores a token pair, denom map and erc20 map, and starts the
+// backfill of the holders of the token pair from the bank balances.
 func (k *Keeper) SetToken(ctx sdk.Context, pair types.TokenPair) {
 	k.SetTokenPair(ctx, pair)
 	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
 	k.SetERC20Map(ctx, pair.GetERC20Contract(), pair.GetID())
+	k.StartTokenHoldersBackfill(ctx, pair.Denom)
 }
 
 // GetTokenPairs gets all registered token tokenPairs.
@@ -58,6 +62,50 @@ func (k Keeper) IterateTokenPairs(ctx sdk.Context, cb func(tokenPair types.Token
 	}
 }
 
+// PairsHeldBy returns the registered token pairs in which the given address
+// holds a non-zero balance. For native ERC-20 token pairs the ERC-20 balance of
+// the address is taken into account besides its bank balance.
+//
+// NOTE: The balance of the address is queried for every iterated token pair,
+// so the cost grows with the number of registered pairs. To bound it, the
+// pagination limits the number of iterated token pairs rather than the number
+// of returned ones, which means that a page can contain fewer token pairs than
+// the limit.
+func (k Keeper) PairsHeldBy(
+	ctx sdk.Context,
+	address sdk.AccAddress,
+	pageReq *query.PageRequest,
+) ([]types.TokenPair, *query.PageResponse, error) {
+	var pairs []types.TokenPair
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		var pair types.TokenPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return err
+		}
+
+		if k.bankKeeper.GetBalance(ctx, address, pair.Denom).IsPositive() {
+			pairs = append(pairs, pair)
+			return nil
+		}
+
+		if pair.IsNativeERC20() {
+			erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			balance := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), common.BytesToAddress(address))
+			if balance != nil && balance.Sign() > 0 {
+				pairs = append(pairs, pair)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pairs, pageRes, nil
+}
+
 // GetTokenPairID returns the pair id for the specified token. Hex address or Denom can be used as token argument.
 // If the token is not registered empty bytes are returned.
 func (k Keeper) GetTokenPairID(ctx sdk.Context, token string) []byte {
@@ -99,6 +147,10 @@ func (k Keeper) DeleteTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
 	k.deleteTokenPair(ctx, id)
 	k.deleteERC20Map(ctx, tokenPair.GetERC20Contract())
 	k.deleteDenomMap(ctx, tokenPair.Denom)
+	k.deleteTokenPairSettings(ctx, id)
+	k.deleteBlockedAccounts(ctx, id)
+	k.deleteTokenHolders(ctx, tokenPair.Denom)
+	k.SetTokenPairDeregistered(ctx, id, false)
 }
 
 // deleteTokenPair deletes the token pair for the given id.
@@ -107,6 +159,156 @@ func (k Keeper) deleteTokenPair(ctx sdk.Context, id []byte) {
 	store.Delete(id)
 }
 
+// GetTokenPairSettings returns the settings of the ERC-20 precompile of the
+// token pair with the given id, or the default settings if none are stored.
+func (k Keeper) GetTokenPairSettings(ctx sdk.Context, id []byte) types.TokenPairSettings {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairSettings)
+	bz := store.Get(id)
+	if len(bz) == 0 {
+		return types.DefaultTokenPairSettings()
+	}
+	return mustParseTokenPairSettings(bz)
+}
+
+// setTokenPairSettings sets the settings of the ERC-20 precompile of the token
+// pair with the given id. The default settings remove the entry of the token pair.
+func (k Keeper) setTokenPairSettings(ctx sdk.Context, id []byte, settings types.TokenPairSettings) {
+	if settings.IsDefault() {
+		k.deleteTokenPairSettings(ctx, id)
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairSettings)
+	store.Set(id, settings.Bytes())
+}
+
+// deleteTokenPairSettings deletes the settings of the token pair with the given id.
+func (k Keeper) deleteTokenPairSettings(ctx sdk.Context, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairSettings)
+	store.Delete(id)
+}
+
+// iterateTokenPairSettings iterates over the stored settings of the token pairs
+// and calls the given callback with the token pair of each of them.
+func (k Keeper) iterateTokenPairSettings(ctx sdk.Context, cb func(pair types.TokenPair, settings types.TokenPairSettings)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairSettings)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pair, found := k.GetTokenPair(ctx, iterator.Key())
+		if !found {
+			continue
+		}
+
+		cb(pair, mustParseTokenPairSettings(iterator.Value()))
+	}
+}
+
+// mustParseTokenPairSettings decodes the stored token pair settings and panics
+// if they are malformed.
+func mustParseTokenPairSettings(bz []byte) types.TokenPairSettings {
+	settings, err := types.ParseTokenPairSettings(bz)
+	if err != nil {
+		panic(err)
+	}
+	return settings
+}
+
+// GetEmitEvents returns true if the ERC-20 precompile of the token pair with
+// the given id emits EVM events. Event emission is enabled by default.
+func (k Keeper) GetEmitEvents(ctx sdk.Context, id []byte) bool {
+	return k.GetTokenPairSettings(ctx, id).EmitEvents
+}
+
+// SetEmitEvents enables or disables the EVM event emission for the ERC-20
+// precompile of the token pair with the given id.
+func (k Keeper) SetEmitEvents(ctx sdk.Context, id []byte, emit bool) {
+	settings := k.GetTokenPairSettings(ctx, id)
+	settings.EmitEvents = emit
+	k.setTokenPairSettings(ctx, id, settings)
+}
+
+// GetEventsDisabledTokenPairs returns the hex addresses of the ERC20 contracts of
+// the token pairs whose ERC-20 precompile doesn't emit EVM events.
+func (k Keeper) GetEventsDisabledTokenPairs(ctx sdk.Context) []string {
+	var erc20Addresses []string
+
+	k.iterateTokenPairSettings(ctx, func(pair types.TokenPair, settings types.TokenPairSettings) {
+		if !settings.EmitEvents {
+			erc20Addresses = append(erc20Addresses, pair.Erc20Address)
+		}
+	})
+
+	return erc20Addresses
+}
+
+// GetComplianceFeatures returns the compliance hooks that are active for the
+// ERC-20 precompile of the token pair with the given id.
+func (k Keeper) GetComplianceFeatures(ctx sdk.Context, id []byte) types.ComplianceFeature {
+	return k.GetTokenPairSettings(ctx, id).ComplianceFeatures
+}
+
+// SetComplianceFeatures sets the compliance hooks that are active for the
+// ERC-20 precompile of the token pair with the given id.
+func (k Keeper) SetComplianceFeatures(ctx sdk.Context, id []byte, features types.ComplianceFeature) {
+	settings := k.GetTokenPairSettings(ctx, id)
+	settings.ComplianceFeatures = features
+	k.setTokenPairSettings(ctx, id, settings)
+}
+
+// GetAllComplianceFeatures returns the compliance hooks of all the token pairs
+// with at least one active hook.
+func (k Keeper) GetAllComplianceFeatures(ctx sdk.Context) []types.TokenPairComplianceFeatures {
+	var features []types.TokenPairComplianceFeatures
+
+	k.iterateTokenPairSettings(ctx, func(pair types.TokenPair, settings types.TokenPairSettings) {
+		if settings.ComplianceFeatures == 0 {
+			return
+		}
+
+		features = append(features, types.TokenPairComplianceFeatures{
+			Erc20Address: pair.Erc20Address,
+			Features:     uint32(settings.ComplianceFeatures),
+		})
+	})
+
+	return features
+}
+
+// GetTokenPairCreator returns the address of the account that registered the
+// token pair with the given id. It returns the zero address for token pairs
+// that were registered before the creator was recorded.
+func (k Keeper) GetTokenPairCreator(ctx sdk.Context, id []byte) common.Address {
+	return k.GetTokenPairSettings(ctx, id).Creator
+}
+
+// SetTokenPairCreator sets the address of the account that registered the
+// token pair with the given id.
+func (k Keeper) SetTokenPairCreator(ctx sdk.Context, id []byte, creator common.Address) {
+	settings := k.GetTokenPairSettings(ctx, id)
+	settings.Creator = creator
+	k.setTokenPairSettings(ctx, id, settings)
+}
+
+// GetTokenPairCreators returns the creators of all the token pairs for which
+// the creator was recorded.
+func (k Keeper) GetTokenPairCreators(ctx sdk.Context) []types.TokenPairCreator {
+	var creators []types.TokenPairCreator
+
+	k.iterateTokenPairSettings(ctx, func(pair types.TokenPair, settings types.TokenPairSettings) {
+		if settings.Creator == (common.Address{}) {
+			return
+		}
+
+		creators = append(creators, types.TokenPairCreator{
+			Erc20Address: pair.Erc20Address,
+			Creator:      settings.Creator.Hex(),
+		})
+	})
+
+	return creators
+}
+
 // GetERC20Map returns the token pair id for the given address.
 func (k Keeper) GetERC20Map(ctx sdk.Context, erc20 common.Address) []byte {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC20)
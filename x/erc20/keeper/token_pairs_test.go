@@ -2,9 +2,14 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
+	erc20precompile "github.com/evmos/evmos/v20/precompiles/erc20"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	"github.com/evmos/evmos/v20/x/erc20/types"
 )
@@ -317,3 +322,226 @@ func (suite *KeeperTestSuite) TestGetTokenDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestEmitEvents() {
+	var ctx sdk.Context
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	id := pair.GetID()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expEmit  bool
+	}{
+		{
+			"default - events enabled",
+			func() {},
+			true,
+		},
+		{
+			"events disabled",
+			func() {
+				suite.network.App.Erc20Keeper.SetEmitEvents(ctx, id, false)
+			},
+			false,
+		},
+		{
+			"events disabled and re-enabled",
+			func() {
+				suite.network.App.Erc20Keeper.SetEmitEvents(ctx, id, false)
+				suite.network.App.Erc20Keeper.SetEmitEvents(ctx, id, true)
+			},
+			true,
+		},
+		{
+			"events disabled and token pair deleted",
+			func() {
+				suite.network.App.Erc20Keeper.SetEmitEvents(ctx, id, false)
+				suite.network.App.Erc20Keeper.DeleteTokenPair(ctx, pair)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+			suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+
+			tc.malleate()
+			emit := suite.network.App.Erc20Keeper.GetEmitEvents(ctx, id)
+			suite.Require().Equal(tc.expEmit, emit)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetTokenPairEmitEvents() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.Erc20Keeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	k.SetToken(ctx, pair)
+
+	// only the governance can toggle the event emission
+	msg := &types.MsgSetTokenPairEmitEvents{
+		Authority:  suite.keyring.GetAccAddr(0).String(),
+		Token:      pair.Denom,
+		EmitEvents: false,
+	}
+	_, err := k.SetTokenPairEmitEvents(ctx, msg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// the token pair must be registered
+	msg.Authority = authority
+	msg.Token = utiltx.GenerateAddress().Hex()
+	_, err = k.SetTokenPairEmitEvents(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	msg.Token = pair.Erc20Address
+	_, err = k.SetTokenPairEmitEvents(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().False(k.GetEmitEvents(ctx, pair.GetID()))
+	suite.Require().Equal([]string{pair.Erc20Address}, k.GetEventsDisabledTokenPairs(ctx))
+
+	msg.EmitEvents = true
+	_, err = k.SetTokenPairEmitEvents(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().True(k.GetEmitEvents(ctx, pair.GetID()))
+	suite.Require().Empty(k.GetEventsDisabledTokenPairs(ctx))
+}
+
+func (suite *KeeperTestSuite) TestSetTokenPairComplianceFeatures() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.Erc20Keeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	k.SetToken(ctx, pair)
+
+	// only the governance can set the compliance features
+	msg := &types.MsgSetTokenPairComplianceFeatures{
+		Authority: suite.keyring.GetAccAddr(0).String(),
+		Token:     pair.Denom,
+		Features:  uint32(types.ComplianceScreening),
+	}
+	_, err := k.SetTokenPairComplianceFeatures(ctx, msg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// the features must be supported
+	msg.Authority = authority
+	msg.Features = 1 << 7
+	_, err = k.SetTokenPairComplianceFeatures(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidCompliance)
+
+	// the token pair must be registered
+	msg.Features = uint32(types.ComplianceScreening)
+	msg.Token = utiltx.GenerateAddress().Hex()
+	_, err = k.SetTokenPairComplianceFeatures(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	msg.Token = pair.Erc20Address
+	_, err = k.SetTokenPairComplianceFeatures(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ComplianceScreening, k.GetComplianceFeatures(ctx, pair.GetID()))
+	suite.Require().Equal([]types.TokenPairComplianceFeatures{
+		{Erc20Address: pair.Erc20Address, Features: uint32(types.ComplianceScreening)},
+	}, k.GetAllComplianceFeatures(ctx))
+
+	// the precompile of the token pair charges the surcharge of the active features
+	precompile, err := k.InstantiateERC20Precompile(ctx, pair.GetERC20Contract(), false)
+	suite.Require().NoError(err)
+	erc20Precompile, ok := precompile.(*erc20precompile.Precompile)
+	suite.Require().True(ok)
+	input, err := erc20Precompile.ABI.Pack(erc20precompile.TransferMethod, utiltx.GenerateAddress(), big.NewInt(1))
+	suite.Require().NoError(err)
+	gasWithScreening := erc20Precompile.RequiredGas(input)
+
+	msg.Features = 0
+	_, err = k.SetTokenPairComplianceFeatures(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Empty(k.GetAllComplianceFeatures(ctx))
+
+	precompile, err = k.InstantiateERC20Precompile(ctx, pair.GetERC20Contract(), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gasWithScreening-erc20precompile.GasSurchargeScreening, precompile.RequiredGas(input))
+}
+
+func (suite *KeeperTestSuite) TestTokenPairSettings() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.Erc20Keeper
+
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	k.SetToken(ctx, pair)
+	id := pair.GetID()
+	creator := utiltx.GenerateAddress()
+
+	suite.Require().Equal(types.DefaultTokenPairSettings(), k.GetTokenPairSettings(ctx, id))
+
+	// the flags of the token pair are kept in the same record
+	k.SetTokenPairCreator(ctx, id, creator)
+	k.SetEmitEvents(ctx, id, false)
+	k.SetComplianceFeatures(ctx, id, types.ComplianceWhitelist)
+	suite.Require().Equal(types.TokenPairSettings{
+		EmitEvents:         false,
+		ComplianceFeatures: types.ComplianceWhitelist,
+		Creator:            creator,
+	}, k.GetTokenPairSettings(ctx, id))
+	suite.Require().Equal(creator, k.GetTokenPairCreator(ctx, id))
+	suite.Require().Equal([]string{pair.Erc20Address}, k.GetEventsDisabledTokenPairs(ctx))
+
+	// restoring the default settings deletes the record
+	k.SetTokenPairCreator(ctx, id, common.Address{})
+	k.SetEmitEvents(ctx, id, true)
+	k.SetComplianceFeatures(ctx, id, 0)
+	store := prefix.NewStore(ctx.KVStore(suite.network.App.GetKey(types.StoreKey)), types.KeyPrefixTokenPairSettings)
+	suite.Require().False(store.Has(id))
+	suite.Require().Empty(k.GetTokenPairCreators(ctx))
+}
+
+func (suite *KeeperTestSuite) TestTokenPairCreator() {
+	var ctx sdk.Context
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	id := pair.GetID()
+	creator := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expCreator common.Address
+	}{
+		{
+			"default - zero address for legacy pairs",
+			func() {},
+			common.Address{},
+		},
+		{
+			"creator set",
+			func() {
+				suite.network.App.Erc20Keeper.SetTokenPairCreator(ctx, id, creator)
+			},
+			creator,
+		},
+		{
+			"creator set and token pair deleted",
+			func() {
+				suite.network.App.Erc20Keeper.SetTokenPairCreator(ctx, id, creator)
+				suite.network.App.Erc20Keeper.DeleteTokenPair(ctx, pair)
+			},
+			common.Address{},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+			suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+
+			tc.malleate()
+			suite.Require().Equal(tc.expCreator, suite.network.App.Erc20Keeper.GetTokenPairCreator(ctx, id))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v7
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// Prefixes of the per-pair flags stored separately until the consensus version 6.
+var (
+	KeyPrefixTokenPairEventsDisabled     = []byte{5}
+	KeyPrefixTokenPairCreator            = []byte{8}
+	KeyPrefixTokenPairComplianceFeatures = []byte{17}
+)
+
+// MigrateStore migrates the x/erc20 module state from the consensus version 6 to
+// version 7. Specifically, it merges the events disabled flags, the creators and
+// the compliance features of the token pairs into a single settings record per
+// token pair and deletes the previous prefixes. Migrating a store without
+// entries under the previous prefixes is a no-op.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+) error {
+	store := ctx.KVStore(storeKey)
+	settingsStore := prefix.NewStore(store, types.KeyPrefixTokenPairSettings)
+
+	migrate := func(legacyPrefix []byte, update func(settings *types.TokenPairSettings, value []byte)) error {
+		legacyStore := prefix.NewStore(store, legacyPrefix)
+
+		// NOTE: the keys are collected first since the store cannot be
+		// written while iterating over it.
+		var ids, values [][]byte
+		iterator := legacyStore.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			ids = append(ids, iterator.Key())
+			values = append(values, iterator.Value())
+		}
+		if err := iterator.Close(); err != nil {
+			return err
+		}
+
+		for i, id := range ids {
+			settings := types.DefaultTokenPairSettings()
+			if bz := settingsStore.Get(id); len(bz) > 0 {
+				var err error
+				if settings, err = types.ParseTokenPairSettings(bz); err != nil {
+					return err
+				}
+			}
+
+			update(&settings, values[i])
+			if settings.IsDefault() {
+				settingsStore.Delete(id)
+			} else {
+				settingsStore.Set(id, settings.Bytes())
+			}
+
+			legacyStore.Delete(id)
+		}
+
+		return nil
+	}
+
+	if err := migrate(KeyPrefixTokenPairEventsDisabled, func(settings *types.TokenPairSettings, _ []byte) {
+		settings.EmitEvents = false
+	}); err != nil {
+		return err
+	}
+
+	if err := migrate(KeyPrefixTokenPairCreator, func(settings *types.TokenPairSettings, value []byte) {
+		settings.Creator = common.BytesToAddress(value)
+	}); err != nil {
+		return err
+	}
+
+	return migrate(KeyPrefixTokenPairComplianceFeatures, func(settings *types.TokenPairSettings, value []byte) {
+		if len(value) > 0 {
+			settings.ComplianceFeatures = types.ComplianceFeature(value[0])
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package v7_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	v7 "github.com/evmos/evmos/v20/x/erc20/migrations/v7"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func TestMigrate(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	legacyKey := func(prefix, id []byte) []byte {
+		return append(append([]byte{}, prefix...), id...)
+	}
+
+	creator := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	allIDs := [][]byte{{1}, {2}, {3}, {4}}
+
+	// token pair 1 has all the flags, 2 only a creator, 3 only the events
+	// disabled and 4 none.
+	store.Set(legacyKey(v7.KeyPrefixTokenPairEventsDisabled, allIDs[0]), []byte{1})
+	store.Set(legacyKey(v7.KeyPrefixTokenPairCreator, allIDs[0]), creator.Bytes())
+	store.Set(legacyKey(v7.KeyPrefixTokenPairComplianceFeatures, allIDs[0]), []byte{byte(types.ComplianceFeaturesMask)})
+	store.Set(legacyKey(v7.KeyPrefixTokenPairCreator, allIDs[1]), creator.Bytes())
+	store.Set(legacyKey(v7.KeyPrefixTokenPairEventsDisabled, allIDs[2]), []byte{1})
+
+	require.NoError(t, v7.MigrateStore(ctx, storeKey))
+
+	expSettings := []types.TokenPairSettings{
+		{EmitEvents: false, ComplianceFeatures: types.ComplianceFeaturesMask, Creator: creator},
+		{EmitEvents: true, Creator: creator},
+		{EmitEvents: false},
+	}
+	for i, exp := range expSettings {
+		settings, err := types.ParseTokenPairSettings(store.Get(legacyKey(types.KeyPrefixTokenPairSettings, allIDs[i])))
+		require.NoError(t, err)
+		require.Equal(t, exp, settings)
+	}
+	require.False(t, store.Has(legacyKey(types.KeyPrefixTokenPairSettings, allIDs[3])))
+
+	for _, prefix := range [][]byte{
+		v7.KeyPrefixTokenPairEventsDisabled,
+		v7.KeyPrefixTokenPairCreator,
+		v7.KeyPrefixTokenPairComplianceFeatures,
+	} {
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		require.False(t, iterator.Valid(), "entries left under the prefix %x", prefix)
+		require.NoError(t, iterator.Close())
+	}
+
+	// migrating again is a no-op
+	require.NoError(t, v7.MigrateStore(ctx, storeKey))
+	settings, err := types.ParseTokenPairSettings(store.Get(legacyKey(types.KeyPrefixTokenPairSettings, allIDs[0])))
+	require.NoError(t, err)
+	require.Equal(t, expSettings[0], settings)
+}
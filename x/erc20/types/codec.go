@@ -32,6 +32,15 @@ const (
 	updateParams     = "evmos/erc20/MsgUpdateParams"
 	registerERC20    = "evmos/erc20/MsgRegisterERC20"
 	toggleConversion = "evmos/erc20/MsgToggleConversion"
+	updateMetadata   = "evmos/erc20/MsgUpdateTokenPairMetadata"
+	deregisterPair   = "evmos/erc20/MsgDeregisterTokenPair"
+	setOptOut        = "evmos/erc20/MsgSetAutoConversionOptOut"
+	updateRateLimit  = "evmos/erc20/MsgUpdateConversionRateLimit"
+	setEmitEvents    = "evmos/erc20/MsgSetTokenPairEmitEvents"
+	updateDenomGroup = "evmos/erc20/MsgUpdateDenomGroup"
+	setCompliance    = "evmos/erc20/MsgSetTokenPairComplianceFeatures"
+	updatePaused     = "evmos/erc20/MsgUpdateTokenPairPaused"
+	updateBlocked    = "evmos/erc20/MsgUpdateBlockedAccount"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -49,6 +58,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgRegisterERC20{},
 		&MsgToggleConversion{},
+		&MsgUpdateTokenPairMetadata{},
+		&MsgDeregisterTokenPair{},
+		&MsgSetAutoConversionOptOut{},
+		&MsgUpdateConversionRateLimit{},
+		&MsgSetTokenPairEmitEvents{},
+		&MsgUpdateDenomGroup{},
+		&MsgSetTokenPairComplianceFeatures{},
+		&MsgUpdateTokenPairPaused{},
+		&MsgUpdateBlockedAccount{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -69,4 +87,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
 	cdc.RegisterConcrete(&MsgRegisterERC20{}, registerERC20, nil)
 	cdc.RegisterConcrete(&MsgToggleConversion{}, toggleConversion, nil)
+	cdc.RegisterConcrete(&MsgUpdateTokenPairMetadata{}, updateMetadata, nil)
+	cdc.RegisterConcrete(&MsgDeregisterTokenPair{}, deregisterPair, nil)
+	cdc.RegisterConcrete(&MsgSetAutoConversionOptOut{}, setOptOut, nil)
+	cdc.RegisterConcrete(&MsgUpdateConversionRateLimit{}, updateRateLimit, nil)
+	cdc.RegisterConcrete(&MsgSetTokenPairEmitEvents{}, setEmitEvents, nil)
+	cdc.RegisterConcrete(&MsgUpdateDenomGroup{}, updateDenomGroup, nil)
+	cdc.RegisterConcrete(&MsgSetTokenPairComplianceFeatures{}, setCompliance, nil)
+	cdc.RegisterConcrete(&MsgUpdateTokenPairPaused{}, updatePaused, nil)
+	cdc.RegisterConcrete(&MsgUpdateBlockedAccount{}, updateBlocked, nil)
 }
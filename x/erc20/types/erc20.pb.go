@@ -67,6 +67,9 @@ type TokenPair struct {
 	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	// contract_owner is the an ENUM specifying the type of ERC20 owner (0 invalid, 1 ModuleAccount, 2 external address)
 	ContractOwner Owner `protobuf:"varint,4,opt,name=contract_owner,json=contractOwner,proto3,enum=evmos.erc20.v1.Owner" json:"contract_owner,omitempty"`
+	// paused defines whether the transfers, mints and burns of the token pair
+	// are halted by its owner
+	Paused bool `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
 func (m *TokenPair) Reset()         { *m = TokenPair{} }
@@ -130,6 +133,13 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+func (m *TokenPair) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 // Deprecated: RegisterCoinProposal is a gov Content type to register a token pair for a
 // native Cosmos coin. We're keeping it to remove the existing proposals from
 // store. After that, remove this message.
@@ -390,39 +400,40 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
 
 var fileDescriptor_668d5dc537f45142 = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xce, 0x6c, 0xbb, 0xba, 0x9d, 0xdd, 0x0d, 0x71, 0x68, 0x21, 0x14, 0x36, 0x1b, 0x2a, 0x48,
-	0xf0, 0x90, 0xb4, 0xf1, 0x26, 0x82, 0x6c, 0xbb, 0x11, 0x56, 0x76, 0xdb, 0x92, 0xed, 0xa2, 0x78,
-	0x29, 0x93, 0x64, 0x88, 0x61, 0xdb, 0x4c, 0x99, 0x19, 0xa3, 0x1e, 0xbc, 0x7b, 0xf4, 0xe2, 0x5d,
-	0xd0, 0x3f, 0x66, 0x8f, 0x7b, 0xf4, 0x24, 0xd2, 0x5e, 0xfc, 0x33, 0x24, 0x33, 0x89, 0xb8, 0x1e,
-	0xdd, 0xcb, 0xf0, 0xbe, 0xef, 0xfd, 0xe0, 0xbd, 0xef, 0xcd, 0x83, 0x5d, 0x52, 0x2c, 0x29, 0xf7,
-	0x08, 0x8b, 0xfd, 0xbe, 0x57, 0x0c, 0x94, 0xe1, 0xae, 0x18, 0x15, 0x14, 0xe9, 0xd2, 0xe7, 0x2a,
-	0xaa, 0x18, 0x74, 0xad, 0x98, 0xf2, 0x32, 0x38, 0xc2, 0xf9, 0xa5, 0x57, 0x0c, 0x22, 0x22, 0xf0,
-	0x40, 0x02, 0x15, 0xdf, 0x6d, 0xa7, 0x34, 0xa5, 0xd2, 0xf4, 0x4a, 0x4b, 0xb1, 0xbd, 0x6f, 0x00,
-	0xb6, 0x66, 0xf4, 0x92, 0xe4, 0x53, 0x9c, 0x31, 0x74, 0x1f, 0xee, 0xcb, 0x7a, 0x73, 0x9c, 0x24,
-	0x8c, 0x70, 0x6e, 0x02, 0x1b, 0x38, 0xad, 0x70, 0x4f, 0x92, 0x47, 0x8a, 0x43, 0x6d, 0xb8, 0x9d,
-	0x90, 0x9c, 0x2e, 0xcd, 0x2d, 0xe9, 0x54, 0x00, 0x99, 0xf0, 0x2e, 0xc9, 0x71, 0xb4, 0x20, 0x89,
-	0xd9, 0xb0, 0x81, 0xb3, 0x13, 0xd6, 0x10, 0x3d, 0x81, 0x7a, 0x4c, 0x73, 0xc1, 0x70, 0x2c, 0xe6,
-	0xf4, 0x6d, 0x4e, 0x98, 0xd9, 0xb4, 0x81, 0xa3, 0xfb, 0x1d, 0xf7, 0xe6, 0x04, 0xee, 0xa4, 0x74,
-	0x86, 0xfb, 0x75, 0xb0, 0x84, 0x8f, 0x9b, 0xbf, 0xbe, 0x1c, 0x82, 0xde, 0x67, 0x00, 0xdb, 0x21,
-	0x49, 0x33, 0x2e, 0x08, 0x1b, 0xd1, 0x2c, 0x9f, 0x32, 0xba, 0xa2, 0x1c, 0x2f, 0xca, 0x66, 0x44,
-	0x26, 0x16, 0xa4, 0xea, 0x54, 0x01, 0x64, 0xc3, 0xdd, 0x84, 0xf0, 0x98, 0x65, 0x2b, 0x91, 0xd1,
-	0xbc, 0x6a, 0xf4, 0x6f, 0x0a, 0x3d, 0x85, 0x3b, 0x4b, 0x22, 0x70, 0x82, 0x05, 0x36, 0x1b, 0x76,
-	0xc3, 0xd9, 0xf5, 0x0f, 0x5c, 0x25, 0xa0, 0x2b, 0x35, 0xab, 0x04, 0x74, 0xcf, 0xaa, 0xa0, 0x61,
-	0xf3, 0xea, 0xc7, 0xa1, 0x16, 0xfe, 0x49, 0x92, 0x7d, 0x69, 0xbd, 0x73, 0x68, 0xd4, 0xad, 0xd4,
-	0x91, 0x37, 0x4a, 0x83, 0xff, 0x28, 0xdd, 0xfb, 0x00, 0x3b, 0xf5, 0xac, 0x41, 0x38, 0xf2, 0xfb,
-	0xb7, 0x1e, 0xf6, 0x01, 0xd4, 0xa5, 0xc8, 0xd5, 0x56, 0x09, 0x97, 0x23, 0xb7, 0xc2, 0x7f, 0xd8,
-	0x6a, 0x26, 0x0e, 0x0f, 0x66, 0x34, 0x4d, 0x17, 0x44, 0xfe, 0x8b, 0x11, 0xcd, 0x0b, 0xc2, 0x78,
-	0x46, 0x6f, 0xaf, 0x79, 0x99, 0x57, 0x96, 0x94, 0x1f, 0xa4, 0xcc, 0x2b, 0x81, 0x5a, 0xf0, 0xc3,
-	0xe7, 0x70, 0x5b, 0xee, 0x1b, 0x75, 0xe0, 0xbd, 0xc9, 0x8b, 0x71, 0x10, 0xce, 0x2f, 0xc6, 0xe7,
-	0xd3, 0x60, 0x74, 0xf2, 0xec, 0x24, 0x38, 0x36, 0x34, 0x64, 0xc0, 0x3d, 0x45, 0x9f, 0x4d, 0x8e,
-	0x2f, 0x4e, 0x03, 0x03, 0x20, 0x04, 0x75, 0xc5, 0x04, 0x2f, 0x67, 0x41, 0x38, 0x3e, 0x3a, 0x35,
-	0xb6, 0xba, 0xcd, 0x8f, 0x5f, 0x2d, 0x6d, 0x38, 0xbc, 0x5a, 0x5b, 0xe0, 0x7a, 0x6d, 0x81, 0x9f,
-	0x6b, 0x0b, 0x7c, 0xda, 0x58, 0xda, 0xf5, 0xc6, 0xd2, 0xbe, 0x6f, 0x2c, 0xed, 0x95, 0x93, 0x66,
-	0xe2, 0xf5, 0x9b, 0xc8, 0x8d, 0xe9, 0xd2, 0xab, 0x4e, 0x4b, 0xbe, 0x85, 0xdf, 0xf7, 0xde, 0x55,
-	0x67, 0x26, 0xde, 0xaf, 0x08, 0x8f, 0xee, 0xc8, 0xf3, 0x78, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0x17, 0xfb, 0x5c, 0x69, 0x82, 0x03, 0x00, 0x00,
+	// 516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xf6, 0x35, 0x49, 0x7f, 0xcd, 0xb5, 0x8d, 0xf2, 0x3b, 0x25, 0xc8, 0x8a, 0x54, 0x37, 0x0a,
+	0x12, 0x8a, 0x18, 0xec, 0xc4, 0x6c, 0x08, 0x09, 0x35, 0xa9, 0x91, 0x8a, 0xda, 0x24, 0x72, 0x53,
+	0x81, 0x58, 0xa2, 0xb3, 0x7d, 0x32, 0x56, 0x13, 0x9f, 0x75, 0x77, 0x35, 0x30, 0xb0, 0x33, 0xb2,
+	0xb0, 0x23, 0xf1, 0x35, 0xf8, 0x00, 0x1d, 0x3b, 0x32, 0x21, 0x94, 0x2c, 0x7c, 0x0c, 0xe4, 0xbb,
+	0x33, 0xa2, 0x8c, 0x74, 0xb1, 0xde, 0xe7, 0x79, 0xff, 0xe8, 0x7d, 0x1e, 0xdf, 0x0b, 0x3b, 0x24,
+	0x5f, 0x51, 0xee, 0x10, 0x16, 0xba, 0x03, 0x27, 0x1f, 0xaa, 0xc0, 0xce, 0x18, 0x15, 0x14, 0x35,
+	0x64, 0xce, 0x56, 0x54, 0x3e, 0xec, 0x58, 0x21, 0xe5, 0x45, 0x71, 0x80, 0xd3, 0x4b, 0x27, 0x1f,
+	0x06, 0x44, 0xe0, 0xa1, 0x04, 0xaa, 0xbe, 0xd3, 0x8a, 0x69, 0x4c, 0x65, 0xe8, 0x14, 0x91, 0x62,
+	0x7b, 0x5f, 0x01, 0xac, 0xcf, 0xe9, 0x25, 0x49, 0x67, 0x38, 0x61, 0xe8, 0x3e, 0xdc, 0x97, 0xf3,
+	0x16, 0x38, 0x8a, 0x18, 0xe1, 0xdc, 0x04, 0x5d, 0xd0, 0xaf, 0xfb, 0x7b, 0x92, 0x3c, 0x52, 0x1c,
+	0x6a, 0xc1, 0x5a, 0x44, 0x52, 0xba, 0x32, 0xb7, 0x64, 0x52, 0x01, 0x64, 0xc2, 0xff, 0x48, 0x8a,
+	0x83, 0x25, 0x89, 0xcc, 0x4a, 0x17, 0xf4, 0x77, 0xfc, 0x12, 0xa2, 0x27, 0xb0, 0x11, 0xd2, 0x54,
+	0x30, 0x1c, 0x8a, 0x05, 0x7d, 0x93, 0x12, 0x66, 0x56, 0xbb, 0xa0, 0xdf, 0x70, 0xdb, 0xf6, 0x6d,
+	0x05, 0xf6, 0xb4, 0x48, 0xfa, 0xfb, 0x65, 0xb1, 0x84, 0xe8, 0x1e, 0xdc, 0xce, 0xf0, 0x15, 0x27,
+	0x91, 0x59, 0x93, 0x63, 0x35, 0x7a, 0x5c, 0xfd, 0xf9, 0xf9, 0x10, 0xf4, 0x3e, 0x01, 0xd8, 0xf2,
+	0x49, 0x9c, 0x70, 0x41, 0xd8, 0x98, 0x26, 0xe9, 0x8c, 0xd1, 0x8c, 0x72, 0xbc, 0x2c, 0x96, 0x14,
+	0x89, 0x58, 0x12, 0xad, 0x40, 0x01, 0xd4, 0x85, 0xbb, 0x11, 0xe1, 0x21, 0x4b, 0x32, 0x91, 0xd0,
+	0x54, 0x0b, 0xf8, 0x93, 0x42, 0x4f, 0xe1, 0xce, 0x8a, 0x08, 0x1c, 0x61, 0x81, 0xcd, 0x4a, 0xb7,
+	0xd2, 0xdf, 0x75, 0x0f, 0x6c, 0x65, 0xac, 0x2d, 0xbd, 0xd4, 0xc6, 0xda, 0x67, 0xba, 0x68, 0x54,
+	0xbd, 0xfe, 0x7e, 0x68, 0xf8, 0xbf, 0x9b, 0xe4, 0x5e, 0x46, 0xef, 0x1c, 0x36, 0xcb, 0x55, 0xca,
+	0xca, 0x5b, 0xa3, 0xc1, 0x3f, 0x8c, 0xee, 0xbd, 0x87, 0xed, 0x52, 0xab, 0xe7, 0x8f, 0xdd, 0xc1,
+	0x9d, 0xc5, 0x3e, 0x80, 0x0d, 0x69, 0xbe, 0xfe, 0xdb, 0x84, 0x4b, 0xc9, 0x75, 0xff, 0x2f, 0x56,
+	0x6b, 0xe2, 0xf0, 0x60, 0x4e, 0xe3, 0x78, 0x49, 0xe4, 0x7b, 0x19, 0xd3, 0x34, 0x27, 0x8c, 0x27,
+	0xf4, 0xee, 0x9e, 0x17, 0x7d, 0xc5, 0x48, 0xb3, 0xa2, 0xfb, 0x0a, 0xa0, 0x7e, 0xf0, 0xc3, 0xe7,
+	0xb0, 0xa6, 0xde, 0x41, 0x1b, 0xfe, 0x3f, 0x7d, 0x31, 0xf1, 0xfc, 0xc5, 0xc5, 0xe4, 0x7c, 0xe6,
+	0x8d, 0x4f, 0x9e, 0x9d, 0x78, 0xc7, 0x4d, 0x03, 0x35, 0xe1, 0x9e, 0xa2, 0xcf, 0xa6, 0xc7, 0x17,
+	0xa7, 0x5e, 0x13, 0x20, 0x04, 0x1b, 0x8a, 0xf1, 0x5e, 0xce, 0x3d, 0x7f, 0x72, 0x74, 0xda, 0xdc,
+	0xea, 0x54, 0x3f, 0x7c, 0xb1, 0x8c, 0xd1, 0xe8, 0x7a, 0x6d, 0x81, 0x9b, 0xb5, 0x05, 0x7e, 0xac,
+	0x2d, 0xf0, 0x71, 0x63, 0x19, 0x37, 0x1b, 0xcb, 0xf8, 0xb6, 0xb1, 0x8c, 0x57, 0xfd, 0x38, 0x11,
+	0xaf, 0xaf, 0x02, 0x3b, 0xa4, 0x2b, 0x47, 0x9f, 0x9c, 0xfc, 0xe6, 0xee, 0xc0, 0x79, 0xab, 0xcf,
+	0x4f, 0xbc, 0xcb, 0x08, 0x0f, 0xb6, 0xe5, 0xd9, 0x3c, 0xfa, 0x35, 0x00, 0x50, 0x86, 0x9d, 0x95,
+	0x9a, 0x03, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -456,6 +467,9 @@ func (this *TokenPair) Equal(that interface{}) bool {
 	if this.ContractOwner != that1.ContractOwner {
 		return false
 	}
+	if this.Paused != that1.Paused {
+		return false
+	}
 	return true
 }
 func (this *ToggleTokenConversionProposal) Equal(that interface{}) bool {
@@ -508,6 +522,16 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.ContractOwner != 0 {
 		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
 		i--
@@ -749,6 +773,9 @@ func (m *TokenPair) Size() (n int) {
 	if m.ContractOwner != 0 {
 		n += 1 + sovErc20(uint64(m.ContractOwner))
 	}
+	if m.Paused {
+		n += 2
+	}
 	return n
 }
 
@@ -972,6 +999,26 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
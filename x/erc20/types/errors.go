@@ -24,4 +24,20 @@ var (
 	ErrInvalidIBC               = errorsmod.Register(ModuleName, 14, "invalid IBC transaction")
 	ErrTokenPairOwnedByModule   = errorsmod.Register(ModuleName, 15, "token pair owned by module")
 	ErrNativeConversionDisabled = errorsmod.Register(ModuleName, 16, "native coins manual conversion is disabled")
+	ErrDenomGroupNotFound       = errorsmod.Register(ModuleName, 17, "denom group not found")
+	ErrInvalidDenomGroup        = errorsmod.Register(ModuleName, 18, "invalid denom group")
+	ErrPrecompileCollision      = errorsmod.Register(ModuleName, 19, "precompile collision")
+	ErrTokenPairPaused          = errorsmod.Register(ModuleName, 20, "token pair is paused")
+	ErrTokenPairNotPaused       = errorsmod.Register(ModuleName, 21, "token pair is not paused")
+	ErrAccountBlocked           = errorsmod.Register(ModuleName, 22, "account is blocked")
+	ErrAccountNotBlocked        = errorsmod.Register(ModuleName, 23, "account is not blocked")
+	ErrInvalidTokenMetadata     = errorsmod.Register(ModuleName, 24, "invalid token metadata")
+	ErrRegistrationDisabled     = errorsmod.Register(ModuleName, 25, "permissionless token pair registration is disabled")
+	ErrRegistrationDenylisted   = errorsmod.Register(ModuleName, 26, "contract is denylisted for permissionless registration")
+	ErrTokenPairDeregistered    = errorsmod.Register(ModuleName, 27, "token pair is already deregistered")
+	ErrInvalidFeeToken          = errorsmod.Register(ModuleName, 28, "invalid fee token")
+	ErrRateLimitExceeded        = errorsmod.Register(ModuleName, 29, "conversion rate limit exceeded")
+	ErrInvalidRateLimit         = errorsmod.Register(ModuleName, 30, "invalid conversion rate limit")
+	ErrInvalidAllowance         = errorsmod.Register(ModuleName, 31, "invalid allowance")
+	ErrInvalidCompliance        = errorsmod.Register(ModuleName, 32, "invalid compliance features")
 )
@@ -11,15 +11,27 @@ import (
 
 // erc20 events
 const (
-	EventTypeConvertERC20           = "convert_erc20"
-	EventTypeRegisterERC20          = "register_erc20"
-	EventTypeToggleTokenConversion  = "toggle_token_conversion" // #nosec
-	EventTypeRegisterERC20Extension = "register_erc20_extension"
+	EventTypeConvertERC20            = "convert_erc20"
+	EventTypeRegisterERC20           = "register_erc20"
+	EventTypeToggleTokenConversion   = "toggle_token_conversion" // #nosec
+	EventTypeRegisterERC20Extension  = "register_erc20_extension"
+	EventTypePauseTokenPair          = "pause_token_pair"
+	EventTypeUnpauseTokenPair        = "unpause_token_pair"
+	EventTypeBlockAccount            = "block_account"
+	EventTypeUnblockAccount          = "unblock_account"
+	EventTypeUpdateTokenPairMetadata = "update_token_pair_metadata"
+	EventTypeDeregisterTokenPair     = "deregister_token_pair"
+	EventTypeSetAutoConversionOptOut = "set_auto_conversion_opt_out"
+	EventTypeSetConversionRateLimit  = "set_conversion_rate_limit"
 
 	AttributeCoinSourceChannel = "source_channel"
 	AttributeKeyCosmosCoin     = "cosmos_coin"
 	AttributeKeyERC20Token     = "erc20_token" // #nosec
 	AttributeKeyReceiver       = "receiver"
+	AttributeKeyAccount        = "account"
+	AttributeKeyOptOut         = "opt_out"
+	AttributeKeyMaxAmount      = "max_amount"
+	AttributeKeyEpochDuration  = "epoch_duration"
 )
 
 // LogTransfer Event type for Transfer(address from, address to, uint256 value)
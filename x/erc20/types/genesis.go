@@ -5,6 +5,9 @@ package types
 
 import (
 	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	evmostypes "github.com/evmos/evmos/v20/types"
 )
@@ -58,6 +61,153 @@ func (gs GenesisState) Validate() error {
 		seenDenom[b.Denom] = true
 	}
 
+	seenCreator := make(map[string]bool)
+	for _, c := range gs.TokenPairCreators {
+		if seenCreator[c.Erc20Address] {
+			return fmt.Errorf("token pair creator duplicated on genesis '%s'", c.Erc20Address)
+		}
+
+		if err := c.Validate(); err != nil {
+			return err
+		}
+
+		if !seenErc20[c.Erc20Address] {
+			return fmt.Errorf("token pair not found for creator on genesis '%s'", c.Erc20Address)
+		}
+
+		seenCreator[c.Erc20Address] = true
+	}
+
+	seenBlocked := make(map[string]bool)
+	for _, b := range gs.BlockedAccounts {
+		key := b.Erc20Address + "/" + b.Account
+		if seenBlocked[key] {
+			return fmt.Errorf("blocked account duplicated on genesis '%s'", key)
+		}
+
+		if err := b.Validate(); err != nil {
+			return err
+		}
+
+		if !seenErc20[b.Erc20Address] {
+			return fmt.Errorf("token pair not found for blocked account on genesis '%s'", b.Erc20Address)
+		}
+
+		seenBlocked[key] = true
+	}
+
+	seenOptOut := make(map[string]bool)
+	for _, account := range gs.AutoConversionOptOuts {
+		if seenOptOut[account] {
+			return fmt.Errorf("auto conversion opt out duplicated on genesis '%s'", account)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(account); err != nil {
+			return fmt.Errorf("invalid auto conversion opt out address on genesis '%s': %w", account, err)
+		}
+
+		seenOptOut[account] = true
+	}
+
+	seenRateLimit := make(map[string]bool)
+	for _, rl := range gs.ConversionRateLimits {
+		if seenRateLimit[rl.Erc20Address] {
+			return fmt.Errorf("conversion rate limit duplicated on genesis '%s'", rl.Erc20Address)
+		}
+
+		if err := rl.Validate(); err != nil {
+			return err
+		}
+
+		if !seenErc20[rl.Erc20Address] {
+			return fmt.Errorf("token pair not found for conversion rate limit on genesis '%s'", rl.Erc20Address)
+		}
+
+		seenRateLimit[rl.Erc20Address] = true
+	}
+
+	seenAllowance := make(map[string]bool)
+	for _, a := range gs.Allowances {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+
+		key := a.Erc20Address + "/" + a.Owner + "/" + a.Spender
+		if seenAllowance[key] {
+			return fmt.Errorf("allowance duplicated on genesis '%s'", key)
+		}
+
+		if !seenErc20[a.Erc20Address] {
+			return fmt.Errorf("token pair not found for allowance on genesis '%s'", a.Erc20Address)
+		}
+
+		seenAllowance[key] = true
+	}
+
+	seenEventsDisabled := make(map[string]bool)
+	for _, erc20Address := range gs.EventsDisabledTokenPairs {
+		if seenEventsDisabled[erc20Address] {
+			return fmt.Errorf("events disabled token pair duplicated on genesis '%s'", erc20Address)
+		}
+
+		if !seenErc20[erc20Address] {
+			return fmt.Errorf("token pair not found for disabled events on genesis '%s'", erc20Address)
+		}
+
+		seenEventsDisabled[erc20Address] = true
+	}
+
+	seenDeregistered := make(map[string]bool)
+	for _, erc20Address := range gs.DeregisteredTokenPairs {
+		if seenDeregistered[erc20Address] {
+			return fmt.Errorf("deregistered token pair duplicated on genesis '%s'", erc20Address)
+		}
+
+		if !seenErc20[erc20Address] {
+			return fmt.Errorf("token pair not found for deregistration on genesis '%s'", erc20Address)
+		}
+
+		seenDeregistered[erc20Address] = true
+	}
+
+	seenCompliance := make(map[string]bool)
+	for _, c := range gs.ComplianceFeatures {
+		if seenCompliance[c.Erc20Address] {
+			return fmt.Errorf("compliance features duplicated on genesis '%s'", c.Erc20Address)
+		}
+
+		if err := c.Validate(); err != nil {
+			return err
+		}
+
+		if !seenErc20[c.Erc20Address] {
+			return fmt.Errorf("token pair not found for compliance features on genesis '%s'", c.Erc20Address)
+		}
+
+		seenCompliance[c.Erc20Address] = true
+	}
+
+	seenGroup := make(map[string]bool)
+	seenGroupDenom := make(map[string]bool)
+	for _, g := range gs.DenomGroups {
+		if seenGroup[g.GroupId] {
+			return fmt.Errorf("denom group duplicated on genesis '%s'", g.GroupId)
+		}
+
+		if err := g.Validate(); err != nil {
+			return err
+		}
+
+		for _, denom := range g.Denoms {
+			if seenGroupDenom[denom] {
+				return fmt.Errorf("denom '%s' is a member of multiple denom groups on genesis", denom)
+			}
+			seenGroupDenom[denom] = true
+		}
+
+		seenGroup[g.GroupId] = true
+	}
+
 	// Check if params are valid
 	if err := gs.Params.Validate(); err != nil {
 		return fmt.Errorf("invalid params on genesis: %w", err)
@@ -71,6 +221,24 @@ func (gs GenesisState) Validate() error {
 	if err := validatePrecompiles(gs.TokenPairs, gs.Params.NativePrecompiles); err != nil {
 		return fmt.Errorf("invalid native precompiles on genesis: %w", err)
 	}
+
+	// Check if the fee tokens have a corresponding token pair
+	if err := validateFeeTokenPairs(gs.TokenPairs, gs.Params.FeeTokens); err != nil {
+		return fmt.Errorf("invalid fee tokens on genesis: %w", err)
+	}
+	return nil
+}
+
+// validateFeeTokenPairs checks if every fee token is the denomination of an
+// enabled token pair
+func validateFeeTokenPairs(tokenPairs []TokenPair, feeTokens sdk.DecCoins) error {
+	for _, feeToken := range feeTokens {
+		if !slices.ContainsFunc(tokenPairs, func(p TokenPair) bool {
+			return p.Denom == feeToken.Denom && p.Enabled
+		}) {
+			return fmt.Errorf("fee token '%s' not found in token pairs", feeToken.Denom)
+		}
+	}
 	return nil
 }
 
@@ -4,19 +4,28 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +39,29 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// token_pairs is a slice of the registered token pairs at genesis
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// token_pair_creators is a slice of the addresses that registered the token pairs at genesis
+	TokenPairCreators []TokenPairCreator `protobuf:"bytes,3,rep,name=token_pair_creators,json=tokenPairCreators,proto3" json:"token_pair_creators"`
+	// blocked_accounts is a slice of the accounts that are blocked for the token pairs at genesis
+	BlockedAccounts []BlockedAccount `protobuf:"bytes,4,rep,name=blocked_accounts,json=blockedAccounts,proto3" json:"blocked_accounts"`
+	// auto_conversion_opt_outs are the bech32 addresses of the accounts that opted out of
+	// the automatic conversion of the received IBC vouchers
+	AutoConversionOptOuts []string `protobuf:"bytes,5,rep,name=auto_conversion_opt_outs,json=autoConversionOptOuts,proto3" json:"auto_conversion_opt_outs,omitempty"`
+	// conversion_rate_limits is a slice of the rate limits of the token pair
+	// conversions at genesis
+	ConversionRateLimits []ConversionRateLimit `protobuf:"bytes,6,rep,name=conversion_rate_limits,json=conversionRateLimits,proto3" json:"conversion_rate_limits"`
+	// allowances is a slice of the allowances of the ERC-20 precompiles at genesis
+	Allowances []Allowance `protobuf:"bytes,7,rep,name=allowances,proto3" json:"allowances"`
+	// events_disabled_token_pairs are the hex addresses of the ERC20 contracts of
+	// the token pairs whose ERC-20 precompile doesn't emit EVM events
+	EventsDisabledTokenPairs []string `protobuf:"bytes,8,rep,name=events_disabled_token_pairs,json=eventsDisabledTokenPairs,proto3" json:"events_disabled_token_pairs,omitempty"`
+	// denom_groups is a slice of the groups of related denominations at genesis
+	DenomGroups []DenomGroup `protobuf:"bytes,9,rep,name=denom_groups,json=denomGroups,proto3" json:"denom_groups"`
+	// compliance_features is a slice of the compliance hooks that are active for
+	// the token pairs at genesis
+	ComplianceFeatures []TokenPairComplianceFeatures `protobuf:"bytes,10,rep,name=compliance_features,json=complianceFeatures,proto3" json:"compliance_features"`
+	// deregistered_token_pairs are the hex addresses of the ERC20 contracts of
+	// the token pairs that have been deregistered
+	DeregisteredTokenPairs []string `protobuf:"bytes,11,rep,name=deregistered_token_pairs,json=deregisteredTokenPairs,proto3" json:"deregistered_token_pairs,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +111,468 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetTokenPairCreators() []TokenPairCreator {
+	if m != nil {
+		return m.TokenPairCreators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockedAccounts() []BlockedAccount {
+	if m != nil {
+		return m.BlockedAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoConversionOptOuts() []string {
+	if m != nil {
+		return m.AutoConversionOptOuts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConversionRateLimits() []ConversionRateLimit {
+	if m != nil {
+		return m.ConversionRateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowances() []Allowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEventsDisabledTokenPairs() []string {
+	if m != nil {
+		return m.EventsDisabledTokenPairs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomGroups() []DenomGroup {
+	if m != nil {
+		return m.DenomGroups
+	}
+	return nil
+}
+
+func (m *GenesisState) GetComplianceFeatures() []TokenPairComplianceFeatures {
+	if m != nil {
+		return m.ComplianceFeatures
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeregisteredTokenPairs() []string {
+	if m != nil {
+		return m.DeregisteredTokenPairs
+	}
+	return nil
+}
+
+// TokenPairCreator defines the account that registered a token pair
+type TokenPairCreator struct {
+	// erc20_address is the hex address of the ERC20 contract of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// creator is the hex address of the account that registered the token pair
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *TokenPairCreator) Reset()         { *m = TokenPairCreator{} }
+func (m *TokenPairCreator) String() string { return proto.CompactTextString(m) }
+func (*TokenPairCreator) ProtoMessage()    {}
+func (*TokenPairCreator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f4674601b0d6987, []int{1}
+}
+func (m *TokenPairCreator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPairCreator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPairCreator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenPairCreator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPairCreator.Merge(m, src)
+}
+func (m *TokenPairCreator) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPairCreator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPairCreator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPairCreator proto.InternalMessageInfo
+
+func (m *TokenPairCreator) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *TokenPairCreator) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// BlockedAccount defines an account that is blocked from transferring the
+// tokens of a token pair
+type BlockedAccount struct {
+	// erc20_address is the hex address of the ERC20 contract of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// account is the hex address of the blocked account
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *BlockedAccount) Reset()         { *m = BlockedAccount{} }
+func (m *BlockedAccount) String() string { return proto.CompactTextString(m) }
+func (*BlockedAccount) ProtoMessage()    {}
+func (*BlockedAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f4674601b0d6987, []int{2}
+}
+func (m *BlockedAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockedAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockedAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockedAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedAccount.Merge(m, src)
+}
+func (m *BlockedAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockedAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockedAccount proto.InternalMessageInfo
+
+func (m *BlockedAccount) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *BlockedAccount) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// TokenPairComplianceFeatures defines the compliance hooks that are active for
+// the ERC-20 precompile of a token pair
+type TokenPairComplianceFeatures struct {
+	// erc20_address is the hex address of the ERC20 contract of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// features is the bit mask of the active compliance hooks
+	Features uint32 `protobuf:"varint,2,opt,name=features,proto3" json:"features,omitempty"`
+}
+
+func (m *TokenPairComplianceFeatures) Reset()         { *m = TokenPairComplianceFeatures{} }
+func (m *TokenPairComplianceFeatures) String() string { return proto.CompactTextString(m) }
+func (*TokenPairComplianceFeatures) ProtoMessage()    {}
+func (*TokenPairComplianceFeatures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f4674601b0d6987, []int{3}
+}
+func (m *TokenPairComplianceFeatures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPairComplianceFeatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPairComplianceFeatures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenPairComplianceFeatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPairComplianceFeatures.Merge(m, src)
+}
+func (m *TokenPairComplianceFeatures) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPairComplianceFeatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPairComplianceFeatures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPairComplianceFeatures proto.InternalMessageInfo
+
+func (m *TokenPairComplianceFeatures) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *TokenPairComplianceFeatures) GetFeatures() uint32 {
+	if m != nil {
+		return m.Features
+	}
+	return 0
+}
+
+// DenomGroup defines a group of related denominations, such as the native, IBC
+// and wrapped representations of the same asset, whose supplies are aggregated
+type DenomGroup struct {
+	// group_id is the identifier of the denom group
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// denoms are the member denominations of the group
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *DenomGroup) Reset()         { *m = DenomGroup{} }
+func (m *DenomGroup) String() string { return proto.CompactTextString(m) }
+func (*DenomGroup) ProtoMessage()    {}
+func (*DenomGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f4674601b0d6987, []int{4}
+}
+func (m *DenomGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomGroup.Merge(m, src)
+}
+func (m *DenomGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomGroup proto.InternalMessageInfo
+
+func (m *DenomGroup) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *DenomGroup) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+// ConversionRateLimit defines the maximum amount of the coins of a token pair
+// that can be converted between their Cosmos and ERC20 representations within
+// an epoch. The coins sent into or out of the IBC transfer module account
+// count towards the same limit.
+type ConversionRateLimit struct {
+	// erc20_address is the hex address of the ERC20 contract of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// max_amount is the maximum amount that can be converted within an epoch
+	MaxAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
+	// epoch_duration is the duration of the epochs of the rate limit
+	EpochDuration time.Duration `protobuf:"bytes,3,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration"`
+}
+
+func (m *ConversionRateLimit) Reset()         { *m = ConversionRateLimit{} }
+func (m *ConversionRateLimit) String() string { return proto.CompactTextString(m) }
+func (*ConversionRateLimit) ProtoMessage()    {}
+func (*ConversionRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f4674601b0d6987, []int{5}
+}
+func (m *ConversionRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionRateLimit.Merge(m, src)
+}
+func (m *ConversionRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionRateLimit proto.InternalMessageInfo
+
+func (m *ConversionRateLimit) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *ConversionRateLimit) GetEpochDuration() time.Duration {
+	if m != nil {
+		return m.EpochDuration
+	}
+	return 0
+}
+
+// Allowance defines the amount of the tokens of a token pair that a spender
+// can transfer on behalf of an owner through the ERC-20 precompile
+type Allowance struct {
+	// erc20_address is the hex address of the ERC20 contract of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// owner is the hex address of the account that owns the tokens
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// spender is the hex address of the account allowed to spend the tokens
+	Spender string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	// value is the amount of tokens that the spender can transfer
+	Value cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=value,proto3,customtype=cosmossdk.io/math.Int" json:"value"`
+}
+
+func (m *Allowance) Reset()         { *m = Allowance{} }
+func (m *Allowance) String() string { return proto.CompactTextString(m) }
+func (*Allowance) ProtoMessage()    {}
+func (*Allowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f4674601b0d6987, []int{6}
+}
+func (m *Allowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Allowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Allowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Allowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Allowance.Merge(m, src)
+}
+func (m *Allowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *Allowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Allowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Allowance proto.InternalMessageInfo
+
+func (m *Allowance) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *Allowance) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Allowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+// ConversionFlow defines the amount of the coins of a token pair that have
+// been converted within the current epoch of its rate limit
+type ConversionFlow struct {
+	// epoch_start is the block time at which the current epoch started
+	EpochStart time.Time `protobuf:"bytes,1,opt,name=epoch_start,json=epochStart,proto3,stdtime" json:"epoch_start"`
+	// amount is the amount converted within the current epoch
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *ConversionFlow) Reset()         { *m = ConversionFlow{} }
+func (m *ConversionFlow) String() string { return proto.CompactTextString(m) }
+func (*ConversionFlow) ProtoMessage()    {}
+func (*ConversionFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f4674601b0d6987, []int{7}
+}
+func (m *ConversionFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionFlow.Merge(m, src)
+}
+func (m *ConversionFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionFlow proto.InternalMessageInfo
+
+func (m *ConversionFlow) GetEpochStart() time.Time {
+	if m != nil {
+		return m.EpochStart
+	}
+	return time.Time{}
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <--> ERC20 tokens.
@@ -89,13 +583,35 @@ type Params struct {
 	// dynamic_precompiles defines the slice of hex addresses of the
 	// active precompiles that are used to interact with Bank coins as ERC20s
 	DynamicPrecompiles []string `protobuf:"bytes,4,rep,name=dynamic_precompiles,json=dynamicPrecompiles,proto3" json:"dynamic_precompiles,omitempty"`
+	// safe_approve defines whether the ERC-20 precompiles require the current
+	// allowance to be zero before approving a new non-zero allowance. This
+	// mitigates the approve front-running race condition.
+	SafeApprove bool `protobuf:"varint,5,opt,name=safe_approve,json=safeApprove,proto3" json:"safe_approve,omitempty"`
+	// permissionless_registration defines whether any account can register
+	// token pairs for deployed ERC-20 contracts without a governance proposal.
+	PermissionlessRegistration bool `protobuf:"varint,6,opt,name=permissionless_registration,json=permissionlessRegistration,proto3" json:"permissionless_registration,omitempty"`
+	// registration_fee defines the fee that is burned from the account that
+	// registers a token pair without a governance proposal, per contract.
+	RegistrationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=registration_fee,json=registrationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_fee"`
+	// registration_denylist defines the slice of hex addresses of the ERC-20
+	// contracts that can only be registered through a governance proposal.
+	RegistrationDenylist []string `protobuf:"bytes,8,rep,name=registration_denylist,json=registrationDenylist,proto3" json:"registration_denylist,omitempty"`
+	// fee_tokens defines the price of one unit of the EVM coin, in its original
+	// decimals, for each token pair denomination that can be used to pay the
+	// fees of ethereum transactions.
+	FeeTokens github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,9,rep,name=fee_tokens,json=feeTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fee_tokens"`
+	// ibc_auto_registration_allowlist defines the slice of channel identifiers
+	// on which the first reception of a single hop IBC voucher automatically
+	// registers its token pair and enables its ERC-20 precompile. The vouchers
+	// received on any channel are registered if the allowlist is empty.
+	IbcAutoRegistrationAllowlist []string `protobuf:"bytes,10,rep,name=ibc_auto_registration_allowlist,json=ibcAutoRegistrationAllowlist,proto3" json:"ibc_auto_registration_allowlist,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f4674601b0d6987, []int{1}
+	return fileDescriptor_2f4674601b0d6987, []int{8}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -145,37 +661,136 @@ func (m *Params) GetDynamicPrecompiles() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
-	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
-}
-
+func (m *Params) GetSafeApprove() bool {
+	if m != nil {
+		return m.SafeApprove
+	}
+	return false
+}
+
+func (m *Params) GetPermissionlessRegistration() bool {
+	if m != nil {
+		return m.PermissionlessRegistration
+	}
+	return false
+}
+
+func (m *Params) GetRegistrationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationFee
+	}
+	return nil
+}
+
+func (m *Params) GetRegistrationDenylist() []string {
+	if m != nil {
+		return m.RegistrationDenylist
+	}
+	return nil
+}
+
+func (m *Params) GetFeeTokens() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+func (m *Params) GetIbcAutoRegistrationAllowlist() []string {
+	if m != nil {
+		return m.IbcAutoRegistrationAllowlist
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
+	proto.RegisterType((*TokenPairCreator)(nil), "evmos.erc20.v1.TokenPairCreator")
+	proto.RegisterType((*BlockedAccount)(nil), "evmos.erc20.v1.BlockedAccount")
+	proto.RegisterType((*TokenPairComplianceFeatures)(nil), "evmos.erc20.v1.TokenPairComplianceFeatures")
+	proto.RegisterType((*DenomGroup)(nil), "evmos.erc20.v1.DenomGroup")
+	proto.RegisterType((*ConversionRateLimit)(nil), "evmos.erc20.v1.ConversionRateLimit")
+	proto.RegisterType((*Allowance)(nil), "evmos.erc20.v1.Allowance")
+	proto.RegisterType((*ConversionFlow)(nil), "evmos.erc20.v1.ConversionFlow")
+	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
+}
+
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x4e, 0xfa, 0x40,
-	0x10, 0xc6, 0xbb, 0x94, 0x10, 0xd8, 0x92, 0x7f, 0xfe, 0xac, 0xc6, 0x20, 0x31, 0x15, 0x39, 0x35,
-	0x26, 0x76, 0xa1, 0x9e, 0xbc, 0x92, 0x10, 0x13, 0x4f, 0x04, 0x3d, 0x79, 0x21, 0x4b, 0x9d, 0xd4,
-	0x8d, 0xb4, 0xdb, 0x74, 0xd7, 0x46, 0xde, 0x02, 0xdf, 0xc2, 0xa3, 0x8f, 0xc1, 0x91, 0xa3, 0x27,
-	0x63, 0xe0, 0xe0, 0x6b, 0x18, 0x76, 0x6b, 0x04, 0x2e, 0x93, 0xc9, 0xf7, 0xfb, 0xbe, 0x99, 0xcd,
-	0x2c, 0x3e, 0x81, 0x3c, 0x16, 0x92, 0x42, 0x16, 0x06, 0x5d, 0x9a, 0xf7, 0x68, 0x04, 0x09, 0x48,
-	0x2e, 0xfd, 0x34, 0x13, 0x4a, 0x90, 0x7f, 0x9a, 0xfa, 0x9a, 0xfa, 0x79, 0xaf, 0xd5, 0x60, 0x31,
-	0x4f, 0x04, 0xd5, 0xd5, 0x58, 0x5a, 0xad, 0xbd, 0x01, 0xc6, 0x6b, 0xd8, 0x61, 0x24, 0x22, 0xa1,
-	0x5b, 0xba, 0xe9, 0x8c, 0xda, 0x99, 0x23, 0x5c, 0xbf, 0x36, 0x6b, 0x6e, 0x15, 0x53, 0x40, 0xae,
-	0x70, 0x25, 0x65, 0x19, 0x8b, 0x65, 0x13, 0xb5, 0x91, 0xe7, 0x04, 0x47, 0xfe, 0xee, 0x5a, 0x7f,
-	0xa8, 0x69, 0xbf, 0xb6, 0xf8, 0x3c, 0xb5, 0xde, 0xbe, 0xdf, 0xcf, 0xd1, 0xa8, 0x08, 0x90, 0x01,
-	0x76, 0x94, 0x78, 0x82, 0x64, 0x9c, 0x32, 0x9e, 0xc9, 0x66, 0xa9, 0x6d, 0x7b, 0x4e, 0x70, 0xbc,
-	0x9f, 0xbf, 0xdb, 0x58, 0x86, 0x8c, 0x67, 0xdb, 0x23, 0xb0, 0xfa, 0x55, 0x65, 0xe7, 0x15, 0xe1,
-	0x8a, 0x59, 0x42, 0xce, 0x70, 0x1d, 0x12, 0x36, 0x99, 0xc2, 0x58, 0xc7, 0xf5, 0x93, 0xaa, 0x23,
-	0xc7, 0x68, 0x83, 0x8d, 0x44, 0x2e, 0x30, 0x49, 0x98, 0xe2, 0x39, 0x8c, 0xd3, 0x0c, 0x42, 0x11,
-	0xa7, 0x7c, 0x0a, 0xb2, 0x69, 0xb7, 0x6d, 0xaf, 0x36, 0x6a, 0x18, 0x32, 0xfc, 0x03, 0x84, 0xe2,
-	0x83, 0x87, 0x59, 0xc2, 0x62, 0x1e, 0xee, 0xf8, 0xcb, 0xda, 0x4f, 0x0a, 0xb4, 0x15, 0xb8, 0x29,
-	0x57, 0x4b, 0xff, 0xed, 0x7e, 0x7f, 0xb1, 0x72, 0xd1, 0x72, 0xe5, 0xa2, 0xaf, 0x95, 0x8b, 0xe6,
-	0x6b, 0xd7, 0x5a, 0xae, 0x5d, 0xeb, 0x63, 0xed, 0x5a, 0xf7, 0x5e, 0xc4, 0xd5, 0xe3, 0xf3, 0xc4,
-	0x0f, 0x45, 0x4c, 0x8b, 0xeb, 0xeb, 0x9a, 0x07, 0x5d, 0xfa, 0x52, 0xfc, 0x84, 0x9a, 0xa5, 0x20,
-	0x27, 0x15, 0x7d, 0xf1, 0xcb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34, 0x6f, 0x0a, 0xc1, 0xe6,
-	0x01, 0x00, 0x00,
+	// 1137 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0x8f, 0x49, 0x62, 0xec, 0xe7, 0x90, 0x6f, 0x98, 0x84, 0x68, 0x63, 0x90, 0x1d, 0xcc, 0x25,
+	0xfa, 0x22, 0x76, 0x49, 0x50, 0x55, 0x7a, 0xa8, 0x90, 0x8d, 0x09, 0x3f, 0x54, 0x29, 0xd4, 0xe4,
+	0xd4, 0x4a, 0x5d, 0x8d, 0x77, 0x9f, 0xcd, 0x28, 0xbb, 0x3b, 0xab, 0x9d, 0x59, 0x03, 0xea, 0x3f,
+	0xc1, 0xb1, 0xd7, 0xde, 0x50, 0x4f, 0x3d, 0xf4, 0x8f, 0xe0, 0x88, 0xaa, 0x4a, 0xad, 0x7a, 0x80,
+	0x0a, 0x54, 0xf5, 0xdf, 0xa8, 0xe6, 0xc7, 0xda, 0x6b, 0x03, 0x55, 0xd4, 0x5e, 0x9c, 0xbc, 0xf9,
+	0xbc, 0xf7, 0x99, 0xcf, 0xbc, 0xf7, 0xf6, 0xcd, 0xc0, 0x25, 0x9c, 0xc4, 0x5c, 0x78, 0x98, 0x05,
+	0x07, 0xd7, 0xbd, 0xc9, 0xbe, 0x37, 0xc6, 0x04, 0x05, 0x13, 0x6e, 0x9a, 0x71, 0xc9, 0xc9, 0xba,
+	0x46, 0x5d, 0x8d, 0xba, 0x93, 0xfd, 0xe6, 0x79, 0x1a, 0xb3, 0x84, 0x7b, 0xfa, 0xd7, 0xb8, 0x34,
+	0x5b, 0x01, 0x17, 0x8a, 0x61, 0x48, 0x05, 0x7a, 0x93, 0xfd, 0x21, 0x4a, 0xba, 0xef, 0x05, 0x9c,
+	0x25, 0x16, 0x6f, 0x2e, 0x6c, 0x60, 0xb8, 0x0c, 0xb6, 0x63, 0x62, 0x7d, 0x6d, 0x79, 0xc6, 0xb0,
+	0xd0, 0xd6, 0x98, 0x8f, 0xb9, 0x59, 0x57, 0xff, 0x15, 0x9b, 0x8d, 0x39, 0x1f, 0x47, 0xe8, 0x69,
+	0x6b, 0x98, 0x8f, 0xbc, 0x30, 0xcf, 0xa8, 0x64, 0xbc, 0xd8, 0xac, 0xbd, 0x88, 0x4b, 0x16, 0xa3,
+	0x90, 0x34, 0x4e, 0x8d, 0x43, 0xe7, 0x97, 0x2a, 0xac, 0xdd, 0x35, 0x47, 0x7c, 0x24, 0xa9, 0x44,
+	0xf2, 0x19, 0x54, 0x53, 0x9a, 0xd1, 0x58, 0x38, 0x95, 0xdd, 0xca, 0x5e, 0xe3, 0x60, 0xdb, 0x9d,
+	0x3f, 0xb2, 0xfb, 0x50, 0xa3, 0xbd, 0xfa, 0xcb, 0xd7, 0xed, 0xa5, 0x17, 0x7f, 0xfd, 0xf8, 0xff,
+	0xca, 0xc0, 0x06, 0x90, 0x3b, 0xd0, 0x90, 0xfc, 0x04, 0x13, 0x3f, 0xa5, 0x2c, 0x13, 0xce, 0x99,
+	0xdd, 0xe5, 0xbd, 0xc6, 0xc1, 0xce, 0x62, 0xfc, 0xb1, 0x72, 0x79, 0x48, 0x59, 0x56, 0xa6, 0x00,
+	0x59, 0xac, 0x0a, 0xf2, 0x35, 0x6c, 0xce, 0x68, 0xfc, 0x20, 0x43, 0x2a, 0x79, 0x26, 0x9c, 0x65,
+	0x4d, 0xb7, 0xfb, 0x51, 0xba, 0xdb, 0xc6, 0xb1, 0xcc, 0x7a, 0x5e, 0x2e, 0x80, 0x82, 0x1c, 0xc3,
+	0xc6, 0x30, 0xe2, 0xc1, 0x09, 0x86, 0x3e, 0x0d, 0x02, 0x9e, 0x27, 0x52, 0x38, 0x2b, 0x9a, 0xb9,
+	0xb5, 0xc8, 0xdc, 0x33, 0x7e, 0x5d, 0xe3, 0x56, 0xe6, 0xfd, 0xdf, 0x70, 0x0e, 0x12, 0xe4, 0x53,
+	0x70, 0x68, 0x2e, 0xb9, 0x1f, 0xf0, 0x64, 0x82, 0x99, 0x60, 0x3c, 0xf1, 0x79, 0x2a, 0x7d, 0x9e,
+	0x4b, 0xe1, 0xac, 0xee, 0x2e, 0xef, 0xd5, 0x07, 0x17, 0x14, 0x7e, 0x7b, 0x0a, 0x1f, 0xa5, 0xf2,
+	0x28, 0x97, 0x82, 0x84, 0xb0, 0x5d, 0x8a, 0xc9, 0xa8, 0x44, 0x3f, 0x62, 0x31, 0x93, 0xc2, 0xa9,
+	0x6a, 0x51, 0x57, 0x16, 0x45, 0xcd, 0x28, 0x06, 0x54, 0xe2, 0x17, 0xca, 0xb7, 0xac, 0x6c, 0x2b,
+	0x78, 0x1f, 0x17, 0xa4, 0x0f, 0x40, 0xa3, 0x88, 0x3f, 0xa1, 0x49, 0x80, 0xc2, 0x39, 0xfb, 0xe1,
+	0xba, 0x74, 0x0b, 0x8f, 0xb9, 0xba, 0xcc, 0xe2, 0xc8, 0xe7, 0x70, 0x11, 0x27, 0x98, 0x48, 0xe1,
+	0x87, 0x4c, 0xd0, 0x61, 0x84, 0xa1, 0x5f, 0x2e, 0x77, 0x4d, 0x9f, 0xd3, 0x31, 0x2e, 0x7d, 0xeb,
+	0x71, 0x3c, 0x2b, 0xeb, 0x3d, 0x58, 0x0b, 0x31, 0xe1, 0xb1, 0x3f, 0xce, 0x78, 0x9e, 0x0a, 0xa7,
+	0xae, 0x65, 0x34, 0x17, 0x65, 0xf4, 0x95, 0xcf, 0x5d, 0xe5, 0x52, 0xd6, 0xd1, 0x08, 0xa7, 0xcb,
+	0x82, 0x8c, 0x61, 0x33, 0xe0, 0x71, 0x1a, 0x31, 0xa5, 0xcb, 0x1f, 0x21, 0x95, 0x79, 0x86, 0xc2,
+	0x01, 0x4d, 0x78, 0xf5, 0xe3, 0x0d, 0x32, 0x8d, 0x39, 0xb4, 0x21, 0xe5, 0x1d, 0x48, 0xf0, 0x1e,
+	0x4c, 0x6e, 0x82, 0x13, 0x62, 0x86, 0x63, 0x26, 0x24, 0x66, 0x0b, 0xc7, 0x6d, 0xe8, 0xe3, 0x6e,
+	0x97, 0xf1, 0xd9, 0x61, 0x3b, 0x5f, 0xc2, 0xc6, 0x62, 0x63, 0x92, 0x2b, 0x70, 0x4e, 0x8b, 0xf2,
+	0x69, 0x18, 0x66, 0x28, 0xcc, 0x07, 0x56, 0x1f, 0xac, 0xe9, 0xc5, 0xae, 0x59, 0x23, 0x0e, 0x9c,
+	0xb5, 0x1d, 0xef, 0x9c, 0xd1, 0x70, 0x61, 0x76, 0x8e, 0x60, 0x7d, 0xbe, 0x23, 0x4f, 0x4d, 0x68,
+	0x1b, 0xbd, 0x20, 0xb4, 0x66, 0xe7, 0x1b, 0xb8, 0xf8, 0x0f, 0xb9, 0x39, 0x1d, 0x7b, 0x13, 0x6a,
+	0xd3, 0xfc, 0x2b, 0xfa, 0x73, 0x83, 0xa9, 0xdd, 0xb9, 0x05, 0x30, 0x2b, 0x26, 0xd9, 0x81, 0x9a,
+	0x2e, 0xbc, 0xcf, 0x42, 0xcb, 0x74, 0x56, 0xdb, 0xf7, 0x43, 0xb2, 0x0d, 0x55, 0x5d, 0x5e, 0x33,
+	0x32, 0xea, 0x03, 0x6b, 0x75, 0x7e, 0xad, 0xc0, 0xe6, 0x07, 0xfa, 0xfd, 0x74, 0xca, 0x8e, 0x00,
+	0x62, 0xfa, 0xd4, 0xa7, 0xf1, 0xec, 0xe8, 0xbd, 0xeb, 0xaa, 0xdc, 0xbf, 0xbf, 0x6e, 0x5f, 0x30,
+	0x93, 0x55, 0x84, 0x27, 0x2e, 0xe3, 0x5e, 0x4c, 0xe5, 0x63, 0xf7, 0x7e, 0x22, 0x7f, 0xfe, 0xe9,
+	0x1a, 0x18, 0x40, 0x59, 0xa6, 0x2b, 0xea, 0x31, 0x7d, 0xda, 0xd5, 0x14, 0xe4, 0x01, 0xac, 0x63,
+	0xca, 0x83, 0xc7, 0x7e, 0x31, 0x62, 0x9d, 0x65, 0x3d, 0x20, 0x77, 0x5c, 0x33, 0x63, 0xdd, 0x62,
+	0xc6, 0xba, 0x7d, 0xeb, 0xd0, 0xab, 0xa9, 0xfd, 0xbe, 0x7b, 0xd3, 0xae, 0x0c, 0xce, 0xe9, 0xd0,
+	0x02, 0xe8, 0xbc, 0xa8, 0x40, 0x7d, 0xfa, 0xbd, 0x9d, 0xee, 0x3c, 0x5b, 0xb0, 0xca, 0x9f, 0x24,
+	0x58, 0xb4, 0x85, 0x31, 0x54, 0x75, 0x45, 0x8a, 0x49, 0x88, 0x99, 0x56, 0x53, 0x1f, 0x14, 0x26,
+	0x39, 0x84, 0xd5, 0x09, 0x8d, 0x72, 0x74, 0x56, 0xfe, 0xe5, 0xd1, 0x4d, 0x78, 0xe7, 0xfb, 0x0a,
+	0xac, 0xcf, 0x8a, 0x70, 0x18, 0xf1, 0x27, 0x6a, 0xce, 0x9b, 0x4c, 0x08, 0x49, 0x33, 0x69, 0xef,
+	0x89, 0xe6, 0x7b, 0x69, 0x38, 0x2e, 0xae, 0x1a, 0x93, 0x87, 0xe7, 0x2a, 0x0f, 0xa0, 0x03, 0x1f,
+	0xa9, 0x38, 0x72, 0x0f, 0xaa, 0xff, 0xb1, 0x3a, 0x36, 0xbe, 0xf3, 0xe7, 0x0a, 0x54, 0xcd, 0xb5,
+	0x44, 0x2e, 0xc3, 0x1a, 0x26, 0x6a, 0xf4, 0xf8, 0x3a, 0x7b, 0x5a, 0x5c, 0x6d, 0xd0, 0x30, 0x6b,
+	0x77, 0xd4, 0x12, 0xb9, 0x06, 0x24, 0xa1, 0x92, 0x4d, 0xd0, 0x4f, 0x33, 0x54, 0x5f, 0x3d, 0x8b,
+	0xd0, 0x5c, 0x2f, 0xf5, 0xc1, 0x79, 0x83, 0x3c, 0x9c, 0x01, 0xc4, 0x83, 0xcd, 0xf0, 0x59, 0x42,
+	0x63, 0x16, 0xcc, 0xf9, 0xaf, 0x68, 0x7f, 0x62, 0xa1, 0x72, 0xc0, 0x65, 0x58, 0x13, 0x74, 0x84,
+	0x3e, 0x4d, 0xd3, 0x8c, 0x4f, 0xd0, 0x59, 0x35, 0x12, 0xd4, 0x5a, 0xd7, 0x2c, 0x91, 0x5b, 0x70,
+	0x31, 0xc5, 0x2c, 0x66, 0x42, 0xe5, 0x34, 0x42, 0x21, 0x7c, 0x33, 0x45, 0x6c, 0x63, 0x55, 0x75,
+	0x44, 0x73, 0xde, 0x65, 0x50, 0xf2, 0x20, 0xdf, 0xc2, 0x46, 0x39, 0xc2, 0x1f, 0x21, 0x4e, 0xe7,
+	0xba, 0xcd, 0x92, 0x7a, 0x7f, 0xb8, 0xf6, 0xfd, 0xe1, 0xde, 0xe6, 0x2c, 0xe9, 0x7d, 0xa2, 0x12,
+	0xfc, 0xc3, 0x9b, 0xf6, 0xde, 0x98, 0xc9, 0xc7, 0xf9, 0xd0, 0x0d, 0x78, 0x6c, 0xdf, 0x18, 0xf6,
+	0xcf, 0x35, 0x11, 0x9e, 0x78, 0xf2, 0x59, 0x8a, 0x42, 0x07, 0x08, 0x7b, 0xdb, 0x95, 0x77, 0x3a,
+	0x44, 0x24, 0x37, 0xe0, 0xc2, 0xdc, 0xe6, 0x21, 0x26, 0xcf, 0x22, 0x26, 0xa4, 0xbd, 0x02, 0xb6,
+	0xca, 0x60, 0xdf, 0x62, 0x24, 0x07, 0x18, 0x21, 0x9a, 0x11, 0x5a, 0x0c, 0xff, 0x4b, 0x1f, 0xd4,
+	0xda, 0xc7, 0x40, 0xcb, 0xbd, 0x69, 0xe5, 0x5e, 0x3d, 0x85, 0x5c, 0x1b, 0x63, 0x15, 0xd7, 0x47,
+	0x88, 0x7a, 0xb4, 0xa9, 0x37, 0x49, 0x9b, 0x0d, 0x03, 0x5f, 0xdf, 0xce, 0x73, 0xa2, 0xf5, 0xc5,
+	0xa6, 0x55, 0x83, 0x56, 0x7d, 0x89, 0x0d, 0x83, 0x6e, 0x2e, 0x79, 0x39, 0xcd, 0xdd, 0xc2, 0xe7,
+	0xc1, 0x4a, 0xed, 0xcc, 0xc6, 0x72, 0xaf, 0xf7, 0xf2, 0x6d, 0xab, 0xf2, 0xea, 0x6d, 0xab, 0xf2,
+	0xc7, 0xdb, 0x56, 0xe5, 0xf9, 0xbb, 0xd6, 0xd2, 0xab, 0x77, 0xad, 0xa5, 0xdf, 0xde, 0xb5, 0x96,
+	0xbe, 0x2a, 0xa7, 0xd4, 0xbe, 0xef, 0xf4, 0xef, 0xe4, 0xe0, 0xba, 0xf7, 0xd4, 0xbe, 0xf5, 0xb4,
+	0xd2, 0x61, 0x55, 0x7f, 0x1f, 0x37, 0xfe, 0x1e, 0x00, 0x16, 0x3a, 0x42, 0xee, 0x68, 0x0a, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -198,6 +813,117 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeregisteredTokenPairs) > 0 {
+		for iNdEx := len(m.DeregisteredTokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeregisteredTokenPairs[iNdEx])
+			copy(dAtA[i:], m.DeregisteredTokenPairs[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeregisteredTokenPairs[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ComplianceFeatures) > 0 {
+		for iNdEx := len(m.ComplianceFeatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ComplianceFeatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DenomGroups) > 0 {
+		for iNdEx := len(m.DenomGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.EventsDisabledTokenPairs) > 0 {
+		for iNdEx := len(m.EventsDisabledTokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EventsDisabledTokenPairs[iNdEx])
+			copy(dAtA[i:], m.EventsDisabledTokenPairs[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.EventsDisabledTokenPairs[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ConversionRateLimits) > 0 {
+		for iNdEx := len(m.ConversionRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AutoConversionOptOuts) > 0 {
+		for iNdEx := len(m.AutoConversionOptOuts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoConversionOptOuts[iNdEx])
+			copy(dAtA[i:], m.AutoConversionOptOuts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoConversionOptOuts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BlockedAccounts) > 0 {
+		for iNdEx := len(m.BlockedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TokenPairCreators) > 0 {
+		for iNdEx := len(m.TokenPairCreators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenPairCreators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -225,7 +951,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *TokenPairCreator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -235,62 +961,419 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *TokenPairCreator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TokenPairCreator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DynamicPrecompiles) > 0 {
-		for iNdEx := len(m.DynamicPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DynamicPrecompiles[iNdEx])
-			copy(dAtA[i:], m.DynamicPrecompiles[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DynamicPrecompiles[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.NativePrecompiles) > 0 {
-		for iNdEx := len(m.NativePrecompiles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.NativePrecompiles[iNdEx])
-			copy(dAtA[i:], m.NativePrecompiles[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.NativePrecompiles[iNdEx])))
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockedAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockedAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPairComplianceFeatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPairComplianceFeatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPairComplianceFeatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Features != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Features))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denoms[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	if m.EnableErc20 {
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GroupId)))
 		i--
-		if m.EnableErc20 {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20Address)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Allowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Allowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Allowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EpochStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochStart):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcAutoRegistrationAllowlist) > 0 {
+		for iNdEx := len(m.IbcAutoRegistrationAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IbcAutoRegistrationAllowlist[iNdEx])
+			copy(dAtA[i:], m.IbcAutoRegistrationAllowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.IbcAutoRegistrationAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RegistrationDenylist) > 0 {
+		for iNdEx := len(m.RegistrationDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RegistrationDenylist[iNdEx])
+			copy(dAtA[i:], m.RegistrationDenylist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RegistrationDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PermissionlessRegistration {
+		i--
+		if m.PermissionlessRegistration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SafeApprove {
+		i--
+		if m.SafeApprove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DynamicPrecompiles) > 0 {
+		for iNdEx := len(m.DynamicPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DynamicPrecompiles[iNdEx])
+			copy(dAtA[i:], m.DynamicPrecompiles[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DynamicPrecompiles[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NativePrecompiles) > 0 {
+		for iNdEx := len(m.NativePrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NativePrecompiles[iNdEx])
+			copy(dAtA[i:], m.NativePrecompiles[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.NativePrecompiles[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EnableErc20 {
+		i--
+		if m.EnableErc20 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = m.Params.Size()
@@ -301,40 +1384,1435 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.TokenPairCreators) > 0 {
+		for _, e := range m.TokenPairCreators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockedAccounts) > 0 {
+		for _, e := range m.BlockedAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoConversionOptOuts) > 0 {
+		for _, s := range m.AutoConversionOptOuts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConversionRateLimits) > 0 {
+		for _, e := range m.ConversionRateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EventsDisabledTokenPairs) > 0 {
+		for _, s := range m.EventsDisabledTokenPairs {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomGroups) > 0 {
+		for _, e := range m.DenomGroups {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ComplianceFeatures) > 0 {
+		for _, e := range m.ComplianceFeatures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeregisteredTokenPairs) > 0 {
+		for _, s := range m.DeregisteredTokenPairs {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenPairCreator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockedAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenPairComplianceFeatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Features != 0 {
+		n += 1 + sovGenesis(uint64(m.Features))
+	}
+	return n
+}
+
+func (m *DenomGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConversionRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Allowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ConversionFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochStart)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnableErc20 {
+		n += 2
+	}
+	if len(m.NativePrecompiles) > 0 {
+		for _, s := range m.NativePrecompiles {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DynamicPrecompiles) > 0 {
+		for _, s := range m.DynamicPrecompiles {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SafeApprove {
+		n += 2
+	}
+	if m.PermissionlessRegistration {
+		n += 2
+	}
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegistrationDenylist) > 0 {
+		for _, s := range m.RegistrationDenylist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcAutoRegistrationAllowlist) > 0 {
+		for _, s := range m.IbcAutoRegistrationAllowlist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairCreators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairCreators = append(m.TokenPairCreators, TokenPairCreator{})
+			if err := m.TokenPairCreators[len(m.TokenPairCreators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAccounts = append(m.BlockedAccounts, BlockedAccount{})
+			if err := m.BlockedAccounts[len(m.BlockedAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoConversionOptOuts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoConversionOptOuts = append(m.AutoConversionOptOuts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionRateLimits = append(m.ConversionRateLimits, ConversionRateLimit{})
+			if err := m.ConversionRateLimits[len(m.ConversionRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, Allowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsDisabledTokenPairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventsDisabledTokenPairs = append(m.EventsDisabledTokenPairs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomGroups = append(m.DenomGroups, DenomGroup{})
+			if err := m.DenomGroups[len(m.DenomGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceFeatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComplianceFeatures = append(m.ComplianceFeatures, TokenPairComplianceFeatures{})
+			if err := m.ComplianceFeatures[len(m.ComplianceFeatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeregisteredTokenPairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeregisteredTokenPairs = append(m.DeregisteredTokenPairs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenPairCreator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPairCreator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPairCreator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockedAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockedAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenPairComplianceFeatures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPairComplianceFeatures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPairComplianceFeatures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			m.Features = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Features |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Allowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Allowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Allowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EnableErc20 {
-		n += 2
-	}
-	if len(m.NativePrecompiles) > 0 {
-		for _, s := range m.NativePrecompiles {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.DynamicPrecompiles) > 0 {
-		for _, s := range m.DynamicPrecompiles {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *ConversionFlow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -357,15 +2835,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConversionFlow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConversionFlow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -392,15 +2870,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EpochStart, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -410,23 +2888,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPairs = append(m.TokenPairs, TokenPair{})
-			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -564,6 +3042,178 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.DynamicPrecompiles = append(m.DynamicPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SafeApprove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SafeApprove = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessRegistration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PermissionlessRegistration = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationFee = append(m.RegistrationFee, types.Coin{})
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDenylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDenylist = append(m.RegistrationDenylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, types.DecCoin{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcAutoRegistrationAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcAutoRegistrationAllowlist = append(m.IbcAutoRegistrationAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,7 +2,11 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	evmostypes "github.com/evmos/evmos/v20/types"
 	"github.com/evmos/evmos/v20/x/erc20/types"
 	"github.com/stretchr/testify/suite"
@@ -20,6 +24,7 @@ func TestGenesisTestSuite(t *testing.T) {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
+	optOut := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
 	newGen := types.NewGenesisState(types.DefaultParams(), types.DefaultTokenPairs)
 
 	testCases := []struct {
@@ -169,6 +174,493 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with token pair creator",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				TokenPairCreators: []types.TokenPairCreator{
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Creator:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - token pair creator without token pair",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				TokenPairCreators: []types.TokenPairCreator{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Creator:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid token pair creator address",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				TokenPairCreators: []types.TokenPairCreator{
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Creator:      "0xinvalidaddress",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated token pair creator",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				TokenPairCreators: []types.TokenPairCreator{
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Creator:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+					},
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Creator:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with blocked account",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				BlockedAccounts: []types.BlockedAccount{
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Account:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - blocked account without token pair",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				BlockedAccounts: []types.BlockedAccount{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Account:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid blocked account address",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				BlockedAccounts: []types.BlockedAccount{
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Account:      "0xinvalidaddress",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated blocked account",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				BlockedAccounts: []types.BlockedAccount{
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Account:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+					},
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Account:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with allowance",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				Allowances: []types.Allowance{
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Owner:        "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Spender:      "0x8FA78CEB7F04118Ec6d06AaC37Ca854691d8e963",
+						Value:        math.NewInt(100),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - allowance without token pair",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				Allowances: []types.Allowance{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Owner:        "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Spender:      "0x8FA78CEB7F04118Ec6d06AaC37Ca854691d8e963",
+						Value:        math.NewInt(100),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - allowance with spender as owner",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				Allowances: []types.Allowance{
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Owner:        "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Spender:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Value:        math.NewInt(100),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - allowance with zero value",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				Allowances: []types.Allowance{
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Owner:        "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Spender:      "0x8FA78CEB7F04118Ec6d06AaC37Ca854691d8e963",
+						Value:        math.ZeroInt(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated allowance",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				Allowances: []types.Allowance{
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Owner:        "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Spender:      "0x8FA78CEB7F04118Ec6d06AaC37Ca854691d8e963",
+						Value:        math.NewInt(100),
+					},
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						Owner:        "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Spender:      "0x8FA78CEB7F04118Ec6d06AaC37Ca854691d8e963",
+						Value:        math.NewInt(200),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with auto conversion opt outs",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				AutoConversionOptOuts: []string{optOut},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - invalid auto conversion opt out address",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				AutoConversionOptOuts: []string{"0xdac17f958d2ee523a2206206994597c13d831ec7"},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated auto conversion opt out",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				AutoConversionOptOuts: []string{optOut, optOut},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with conversion rate limit",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				ConversionRateLimits: []types.ConversionRateLimit{
+					{
+						Erc20Address:  types.WEVMOSContractMainnet,
+						MaxAmount:     math.NewInt(100),
+						EpochDuration: time.Hour,
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - conversion rate limit of unregistered token pair",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ConversionRateLimits: []types.ConversionRateLimit{
+					{
+						Erc20Address:  types.WEVMOSContractMainnet,
+						MaxAmount:     math.NewInt(100),
+						EpochDuration: time.Hour,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - conversion rate limit with zero epoch duration",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				ConversionRateLimits: []types.ConversionRateLimit{
+					{
+						Erc20Address: types.WEVMOSContractMainnet,
+						MaxAmount:    math.NewInt(100),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated conversion rate limit",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				ConversionRateLimits: []types.ConversionRateLimit{
+					{
+						Erc20Address:  types.WEVMOSContractMainnet,
+						MaxAmount:     math.NewInt(100),
+						EpochDuration: time.Hour,
+					},
+					{
+						Erc20Address:  types.WEVMOSContractMainnet,
+						MaxAmount:     math.NewInt(200),
+						EpochDuration: time.Hour,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with events disabled token pair",
+			genState: &types.GenesisState{
+				Params:                   types.DefaultParams(),
+				TokenPairs:               types.DefaultTokenPairs,
+				EventsDisabledTokenPairs: []string{types.WEVMOSContractMainnet},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - events disabled without token pair",
+			genState: &types.GenesisState{
+				Params:                   types.DefaultParams(),
+				TokenPairs:               types.DefaultTokenPairs,
+				EventsDisabledTokenPairs: []string{"0xdac17f958d2ee523a2206206994597c13d831ec7"},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated events disabled token pair",
+			genState: &types.GenesisState{
+				Params:                   types.DefaultParams(),
+				TokenPairs:               types.DefaultTokenPairs,
+				EventsDisabledTokenPairs: []string{types.WEVMOSContractMainnet, types.WEVMOSContractMainnet},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with deregistered token pair",
+			genState: &types.GenesisState{
+				Params:                 types.DefaultParams(),
+				TokenPairs:             types.DefaultTokenPairs,
+				DeregisteredTokenPairs: []string{types.WEVMOSContractMainnet},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - deregistered without token pair",
+			genState: &types.GenesisState{
+				Params:                 types.DefaultParams(),
+				TokenPairs:             types.DefaultTokenPairs,
+				DeregisteredTokenPairs: []string{"0xdac17f958d2ee523a2206206994597c13d831ec7"},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated deregistered token pair",
+			genState: &types.GenesisState{
+				Params:                 types.DefaultParams(),
+				TokenPairs:             types.DefaultTokenPairs,
+				DeregisteredTokenPairs: []string{types.WEVMOSContractMainnet, types.WEVMOSContractMainnet},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with compliance features",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				ComplianceFeatures: []types.TokenPairComplianceFeatures{
+					{Erc20Address: types.WEVMOSContractMainnet, Features: uint32(types.ComplianceScreening)},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - unsupported compliance features",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				ComplianceFeatures: []types.TokenPairComplianceFeatures{
+					{Erc20Address: types.WEVMOSContractMainnet, Features: 1 << 7},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - compliance features without token pair",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				ComplianceFeatures: []types.TokenPairComplianceFeatures{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Features: uint32(types.ComplianceScreening)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated compliance features",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				ComplianceFeatures: []types.TokenPairComplianceFeatures{
+					{Erc20Address: types.WEVMOSContractMainnet, Features: uint32(types.ComplianceScreening)},
+					{Erc20Address: types.WEVMOSContractMainnet, Features: uint32(types.ComplianceWhitelist)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with denom groups",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				DenomGroups: []types.DenomGroup{
+					{GroupId: "atom", Denoms: []string{"uatom", "watom"}},
+					{GroupId: "osmo", Denoms: []string{"uosmo"}},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated denom group",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				DenomGroups: []types.DenomGroup{
+					{GroupId: "atom", Denoms: []string{"uatom"}},
+					{GroupId: "atom", Denoms: []string{"watom"}},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - denom in multiple groups",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				DenomGroups: []types.DenomGroup{
+					{GroupId: "atom", Denoms: []string{"uatom"}},
+					{GroupId: "other", Denoms: []string{"uatom"}},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - empty denom group",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				TokenPairs:  types.DefaultTokenPairs,
+				DenomGroups: []types.DenomGroup{{GroupId: "atom"}},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - fee token of a token pair",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.FeeTokens = sdk.NewDecCoins(sdk.NewDecCoinFromDec("ibc/usdc", math.LegacyNewDecWithPrec(2, 2)))
+					return params
+				}(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address:  "0xB8f1c8ACF2D69bB2aDc1A5Ee2d7Bc2eb9F3aB2Ee",
+						Denom:         "ibc/usdc",
+						Enabled:       true,
+						ContractOwner: types.OWNER_MODULE,
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - fee token without token pair",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.FeeTokens = sdk.NewDecCoins(sdk.NewDecCoinFromDec("ibc/usdc", math.LegacyNewDecWithPrec(2, 2)))
+					return params
+				}(),
+				TokenPairs: types.DefaultTokenPairs,
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - fee token of a disabled token pair",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.FeeTokens = sdk.NewDecCoins(sdk.NewDecCoinFromDec("ibc/usdc", math.LegacyNewDecWithPrec(2, 2)))
+					return params
+				}(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address:  "0xB8f1c8ACF2D69bB2aDc1A5Ee2d7Bc2eb9F3aB2Ee",
+						Denom:         "ibc/usdc",
+						Enabled:       false,
+						ContractOwner: types.OWNER_MODULE,
+					},
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
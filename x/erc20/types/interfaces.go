@@ -5,6 +5,7 @@ package types
 
 import (
 	"context"
+	"math/big"
 
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 
 	"github.com/evmos/evmos/v20/x/evm/statedb"
@@ -40,12 +42,36 @@ type EVMKeeper interface {
 	ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
 	DeleteAccount(ctx sdk.Context, addr common.Address) error
 	IsAvailableStaticPrecompile(params *evmtypes.Params, address common.Address) bool
+	GetActiveStaticPrecompileABIs(ctx sdk.Context) ([]abi.ABI, error)
 	CallEVM(ctx sdk.Context, abi abi.ABI, from, contract common.Address, commit bool, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)
 	CallEVMWithData(ctx sdk.Context, from common.Address, contract *common.Address, data []byte, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
 	GetCode(ctx sdk.Context, hash common.Hash) []byte
 	SetCode(ctx sdk.Context, hash []byte, bytecode []byte)
 	SetAccount(ctx sdk.Context, address common.Address, account statedb.Account) error
 	GetAccount(ctx sdk.Context, address common.Address) *statedb.Account
+	EmitBlockLogs(ctx sdk.Context, logs []*ethtypes.Log) error
+}
+
+// Erc20Hooks defines the hooks that other modules can register on the erc20
+// keeper to react to the movements of the tokens of the registered token
+// pairs. The transfer hooks are invoked by the ERC-20 precompile, while the mint
+// and burn hooks are invoked by the conversions of native ERC-20 token pairs,
+// including the ones of the IBC transfers, and by the werc20 deposits and
+// withdrawals. Returning an error aborts the operation.
+//
+// NOTE: The hooks only cover the token movements executed by the erc20 module
+// and its precompiles. They are not invoked for the bank sends of the token
+// pair denoms nor for the IBC vouchers minted and burned by the transfer
+// module.
+type Erc20Hooks interface {
+	// BeforeTransfer is called before the tokens of a token pair are transferred.
+	BeforeTransfer(ctx sdk.Context, pair TokenPair, from, to common.Address, amount *big.Int) error
+	// AfterTransfer is called after the tokens of a token pair are transferred.
+	AfterTransfer(ctx sdk.Context, pair TokenPair, from, to common.Address, amount *big.Int) error
+	// AfterMint is called after the coins of a token pair are minted to the receiver.
+	AfterMint(ctx sdk.Context, pair TokenPair, to common.Address, amount *big.Int) error
+	// AfterBurn is called after the coins of a token pair escrowed from the sender are burned.
+	AfterBurn(ctx sdk.Context, pair TokenPair, from common.Address, amount *big.Int) error
 }
 
 type (
@@ -4,6 +4,8 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -33,6 +35,23 @@ const (
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
 	prefixSTRv2Addresses
+	_ // deleted token pair events disabled prefix, merged into the token pair settings in v7
+	prefixDenomGroup
+	prefixDenomGroupByDenom
+	_ // deleted token pair creator prefix, merged into the token pair settings in v7
+	prefixBlockedAccount
+	prefixTokenHolder
+	prefixTokenHoldersCount
+	prefixTokenHolderUpdate
+	prefixAutoConversionOptOut
+	prefixConversionRateLimit
+	prefixConversionFlow
+	prefixAllowance
+	_ // deleted token pair compliance features prefix, merged into the token pair settings in v7
+	prefixDenomGroupByERC1155
+	prefixTokenPairDeregistered
+	prefixTokenHoldersBackfill
+	prefixTokenPairSettings
 )
 
 // KVStore key prefixes
@@ -41,4 +60,66 @@ var (
 	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
 	KeyPrefixSTRv2Addresses   = []byte{prefixSTRv2Addresses}
+
+	KeyPrefixDenomGroup           = []byte{prefixDenomGroup}
+	KeyPrefixDenomGroupByDenom    = []byte{prefixDenomGroupByDenom}
+	KeyPrefixBlockedAccount       = []byte{prefixBlockedAccount}
+	KeyPrefixTokenHolder          = []byte{prefixTokenHolder}
+	KeyPrefixTokenHoldersCount    = []byte{prefixTokenHoldersCount}
+	KeyPrefixTokenHolderUpdate    = []byte{prefixTokenHolderUpdate}
+	KeyPrefixAutoConversionOptOut = []byte{prefixAutoConversionOptOut}
+	KeyPrefixConversionRateLimit  = []byte{prefixConversionRateLimit}
+	KeyPrefixConversionFlow       = []byte{prefixConversionFlow}
+	KeyPrefixAllowance            = []byte{prefixAllowance}
+
+	KeyPrefixDenomGroupByERC1155   = []byte{prefixDenomGroupByERC1155}
+	KeyPrefixTokenPairDeregistered = []byte{prefixTokenPairDeregistered}
+	KeyPrefixTokenHoldersBackfill  = []byte{prefixTokenHoldersBackfill}
+	KeyPrefixTokenPairSettings     = []byte{prefixTokenPairSettings}
 )
+
+// DenomGroupKey returns the key prefix for the members of the denom group with
+// the given id.
+func DenomGroupKey(groupID string) []byte {
+	return address.MustLengthPrefix([]byte(groupID))
+}
+
+// DenomGroupMemberKey returns the key for a denomination that is a member of
+// the denom group with the given id.
+func DenomGroupMemberKey(groupID, denom string) []byte {
+	return append(DenomGroupKey(groupID), []byte(denom)...)
+}
+
+// DenomGroupERC1155Address returns the address of the ERC-1155 precompile that
+// exposes the members of the denom group with the given id as token IDs.
+func DenomGroupERC1155Address(groupID string) common.Address {
+	return common.BytesToAddress(address.Module(ModuleName, []byte("erc1155"), []byte(groupID)))
+}
+
+// BlockedAccountKey returns the key for an account that is blocked for the
+// token pair with the given id.
+func BlockedAccountKey(id []byte, account common.Address) []byte {
+	return append(address.MustLengthPrefix(id), account.Bytes()...)
+}
+
+// TokenHoldersKey returns the key prefix for the holders of the given denom.
+func TokenHoldersKey(denom string) []byte {
+	return address.MustLengthPrefix([]byte(denom))
+}
+
+// TokenHolderKey returns the key for an account holding the given denom.
+func TokenHolderKey(denom string, account sdk.AccAddress) []byte {
+	return append(TokenHoldersKey(denom), account.Bytes()...)
+}
+
+// AllowancesKey returns the key prefix for the allowances of the given owner
+// over the tokens of the given ERC20 contract.
+func AllowancesKey(erc20, owner common.Address) []byte {
+	return append(erc20.Bytes(), owner.Bytes()...)
+}
+
+// AllowanceKey returns the key for the allowance of the given spender over the
+// owner's tokens of the given ERC20 contract.
+func AllowanceKey(erc20, owner, spender common.Address) []byte {
+	return append(AllowancesKey(erc20, owner), spender.Bytes()...)
+}
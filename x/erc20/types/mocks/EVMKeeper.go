@@ -10,6 +10,8 @@ import (
 
 	core "github.com/ethereum/go-ethereum/core"
 
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 
 	mock "github.com/stretchr/testify/mock"
@@ -137,6 +139,24 @@ func (_m *EVMKeeper) DeleteAccount(ctx types.Context, addr common.Address) error
 	return r0
 }
 
+// EmitBlockLogs provides a mock function with given fields: ctx, logs
+func (_m *EVMKeeper) EmitBlockLogs(ctx types.Context, logs []*ethtypes.Log) error {
+	ret := _m.Called(ctx, logs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmitBlockLogs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, []*ethtypes.Log) error); ok {
+		r0 = rf(ctx, logs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // EstimateGasInternal provides a mock function with given fields: c, req, fromType
 func (_m *EVMKeeper) EstimateGasInternal(c context.Context, req *evmtypes.EthCallRequest, fromType evmtypes.CallType) (*evmtypes.EstimateGasResponse, error) {
 	ret := _m.Called(c, req, fromType)
@@ -207,6 +227,36 @@ func (_m *EVMKeeper) GetAccountWithoutBalance(ctx types.Context, addr common.Add
 	return r0
 }
 
+// GetActiveStaticPrecompileABIs provides a mock function with given fields: ctx
+func (_m *EVMKeeper) GetActiveStaticPrecompileABIs(ctx types.Context) ([]abi.ABI, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveStaticPrecompileABIs")
+	}
+
+	var r0 []abi.ABI
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context) ([]abi.ABI, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context) []abi.ABI); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]abi.ABI)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCode provides a mock function with given fields: ctx, hash
 func (_m *EVMKeeper) GetCode(ctx types.Context, hash common.Hash) []byte {
 	ret := _m.Called(ctx, hash)
@@ -4,6 +4,9 @@
 package types
 
 import (
+	stdmath "math"
+	"strings"
+
 	protov2 "google.golang.org/protobuf/proto"
 
 	errorsmod "cosmossdk.io/errors"
@@ -22,10 +25,28 @@ var (
 	_ sdk.Msg              = &MsgUpdateParams{}
 	_ sdk.Msg              = &MsgRegisterERC20{}
 	_ sdk.Msg              = &MsgToggleConversion{}
+	_ sdk.Msg              = &MsgUpdateTokenPairMetadata{}
+	_ sdk.Msg              = &MsgDeregisterTokenPair{}
+	_ sdk.Msg              = &MsgSetAutoConversionOptOut{}
+	_ sdk.Msg              = &MsgUpdateConversionRateLimit{}
+	_ sdk.Msg              = &MsgSetTokenPairEmitEvents{}
+	_ sdk.Msg              = &MsgUpdateDenomGroup{}
+	_ sdk.Msg              = &MsgSetTokenPairComplianceFeatures{}
+	_ sdk.Msg              = &MsgUpdateTokenPairPaused{}
+	_ sdk.Msg              = &MsgUpdateBlockedAccount{}
 	_ sdk.HasValidateBasic = &MsgConvertERC20{}
 	_ sdk.HasValidateBasic = &MsgUpdateParams{}
 	_ sdk.HasValidateBasic = &MsgRegisterERC20{}
 	_ sdk.HasValidateBasic = &MsgToggleConversion{}
+	_ sdk.HasValidateBasic = &MsgUpdateTokenPairMetadata{}
+	_ sdk.HasValidateBasic = &MsgDeregisterTokenPair{}
+	_ sdk.HasValidateBasic = &MsgSetAutoConversionOptOut{}
+	_ sdk.HasValidateBasic = &MsgUpdateConversionRateLimit{}
+	_ sdk.HasValidateBasic = &MsgSetTokenPairEmitEvents{}
+	_ sdk.HasValidateBasic = &MsgUpdateDenomGroup{}
+	_ sdk.HasValidateBasic = &MsgSetTokenPairComplianceFeatures{}
+	_ sdk.HasValidateBasic = &MsgUpdateTokenPairPaused{}
+	_ sdk.HasValidateBasic = &MsgUpdateBlockedAccount{}
 )
 
 const (
@@ -108,3 +129,144 @@ func (m *MsgToggleConversion) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateTokenPairMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(ErrInvalidTokenMetadata, "token cannot be empty")
+	}
+
+	if strings.TrimSpace(m.Name) == "" {
+		return errorsmod.Wrap(ErrInvalidTokenMetadata, "name cannot be blank")
+	}
+
+	if strings.TrimSpace(m.Symbol) == "" {
+		return errorsmod.Wrap(ErrInvalidTokenMetadata, "symbol cannot be blank")
+	}
+
+	if m.Decimals > stdmath.MaxUint8 {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "decimals must be at most %d, got %d", stdmath.MaxUint8, m.Decimals)
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeregisterTokenPair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(ErrTokenPairNotFound, "token cannot be empty")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetAutoConversionOptOut) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateConversionRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(ErrTokenPairNotFound, "token cannot be empty")
+	}
+
+	if m.MaxAmount.IsNil() || m.MaxAmount.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max amount cannot be negative, got %s", m.MaxAmount)
+	}
+
+	if m.MaxAmount.IsPositive() && m.EpochDuration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "epoch duration must be positive, got %s", m.EpochDuration)
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetTokenPairEmitEvents) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(ErrTokenPairNotFound, "token cannot be empty")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateDenomGroup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if strings.TrimSpace(m.GroupId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenomGroup, "group id cannot be empty")
+	}
+
+	// an empty slice of denominations removes the group
+	if len(m.Denoms) == 0 {
+		return nil
+	}
+
+	return DenomGroup{GroupId: m.GroupId, Denoms: m.Denoms}.Validate()
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetTokenPairComplianceFeatures) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(ErrTokenPairNotFound, "token cannot be empty")
+	}
+
+	return ValidateComplianceFeatures(m.Features)
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateTokenPairPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(ErrTokenPairNotFound, "token cannot be empty")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateBlockedAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(ErrTokenPairNotFound, "token cannot be empty")
+	}
+
+	if !common.IsHexAddress(m.Account) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid account hex address '%s'", m.Account)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -166,3 +167,381 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgUpdateTokenPairMetadataValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateTokenPairMetadata
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgUpdateTokenPairMetadata{Authority: "invalid", Token: "uatom", Name: "Atom", Symbol: "ATOM", Decimals: 6},
+			false,
+		},
+		{
+			"fail - empty token",
+			&types.MsgUpdateTokenPairMetadata{Authority: authority, Name: "Atom", Symbol: "ATOM", Decimals: 6},
+			false,
+		},
+		{
+			"fail - blank name",
+			&types.MsgUpdateTokenPairMetadata{Authority: authority, Token: "uatom", Name: " ", Symbol: "ATOM", Decimals: 6},
+			false,
+		},
+		{
+			"fail - blank symbol",
+			&types.MsgUpdateTokenPairMetadata{Authority: authority, Token: "uatom", Name: "Atom", Decimals: 6},
+			false,
+		},
+		{
+			"fail - decimals overflow uint8",
+			&types.MsgUpdateTokenPairMetadata{Authority: authority, Token: "uatom", Name: "Atom", Symbol: "ATOM", Decimals: 256},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgUpdateTokenPairMetadata{Authority: authority, Token: "uatom", Name: "Atom", Symbol: "ATOM", Decimals: 6},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgDeregisterTokenPairValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgDeregisterTokenPair
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgDeregisterTokenPair{Authority: "invalid", Token: "uatom"},
+			false,
+		},
+		{
+			"fail - empty token",
+			&types.MsgDeregisterTokenPair{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgDeregisterTokenPair{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(), Token: "uatom"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetAutoConversionOptOutValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetAutoConversionOptOut
+		expPass bool
+	}{
+		{
+			"fail - invalid sender address",
+			&types.MsgSetAutoConversionOptOut{Sender: "invalid", OptOut: true},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgSetAutoConversionOptOut{Sender: sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(), OptOut: true},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateConversionRateLimitValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateConversionRateLimit
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgUpdateConversionRateLimit{Authority: "invalid", Token: "uatom", MaxAmount: math.NewInt(100), EpochDuration: time.Hour},
+			false,
+		},
+		{
+			"fail - empty token",
+			&types.MsgUpdateConversionRateLimit{Authority: authority, MaxAmount: math.NewInt(100), EpochDuration: time.Hour},
+			false,
+		},
+		{
+			"fail - negative max amount",
+			&types.MsgUpdateConversionRateLimit{Authority: authority, Token: "uatom", MaxAmount: math.NewInt(-1), EpochDuration: time.Hour},
+			false,
+		},
+		{
+			"fail - zero epoch duration",
+			&types.MsgUpdateConversionRateLimit{Authority: authority, Token: "uatom", MaxAmount: math.NewInt(100)},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgUpdateConversionRateLimit{Authority: authority, Token: "uatom", MaxAmount: math.NewInt(100), EpochDuration: time.Hour},
+			true,
+		},
+		{
+			"pass - rate limit removal",
+			&types.MsgUpdateConversionRateLimit{Authority: authority, Token: "uatom", MaxAmount: math.ZeroInt()},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetTokenPairEmitEventsValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetTokenPairEmitEvents
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgSetTokenPairEmitEvents{Authority: "invalid", Token: "uatom"},
+			false,
+		},
+		{
+			"fail - empty token",
+			&types.MsgSetTokenPairEmitEvents{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgSetTokenPairEmitEvents{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(), Token: "uatom"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateDenomGroupValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateDenomGroup
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgUpdateDenomGroup{Authority: "invalid", GroupId: "atom", Denoms: []string{"uatom"}},
+			false,
+		},
+		{
+			"fail - empty group id",
+			&types.MsgUpdateDenomGroup{Authority: authority, Denoms: []string{"uatom"}},
+			false,
+		},
+		{
+			"fail - duplicate denom",
+			&types.MsgUpdateDenomGroup{Authority: authority, GroupId: "atom", Denoms: []string{"uatom", "uatom"}},
+			false,
+		},
+		{
+			"pass - remove group",
+			&types.MsgUpdateDenomGroup{Authority: authority, GroupId: "atom"},
+			true,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgUpdateDenomGroup{Authority: authority, GroupId: "atom", Denoms: []string{"uatom", "watom"}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetTokenPairComplianceFeaturesValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetTokenPairComplianceFeatures
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgSetTokenPairComplianceFeatures{Authority: "invalid", Token: "uatom"},
+			false,
+		},
+		{
+			"fail - empty token",
+			&types.MsgSetTokenPairComplianceFeatures{Authority: authority},
+			false,
+		},
+		{
+			"fail - unsupported features",
+			&types.MsgSetTokenPairComplianceFeatures{Authority: authority, Token: "uatom", Features: 1 << 7},
+			false,
+		},
+		{
+			"pass - disable all features",
+			&types.MsgSetTokenPairComplianceFeatures{Authority: authority, Token: "uatom"},
+			true,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgSetTokenPairComplianceFeatures{
+				Authority: authority,
+				Token:     "uatom",
+				Features:  uint32(types.ComplianceScreening | types.ComplianceWhitelist),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateTokenPairPausedValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateTokenPairPaused
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgUpdateTokenPairPaused{Authority: "invalid", Token: "uatom", Paused: true},
+			false,
+		},
+		{
+			"fail - empty token",
+			&types.MsgUpdateTokenPairPaused{Authority: authority, Paused: true},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgUpdateTokenPairPaused{Authority: authority, Token: "uatom", Paused: true},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateBlockedAccountValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	account := utiltx.GenerateAddress().Hex()
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateBlockedAccount
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgUpdateBlockedAccount{Authority: "invalid", Token: "uatom", Account: account},
+			false,
+		},
+		{
+			"fail - empty token",
+			&types.MsgUpdateBlockedAccount{Authority: authority, Account: account},
+			false,
+		},
+		{
+			"fail - invalid account address",
+			&types.MsgUpdateBlockedAccount{Authority: authority, Token: "uatom", Account: "invalid"},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgUpdateBlockedAccount{Authority: authority, Token: "uatom", Account: account, Blocked: true},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"slices"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/types"
 	"github.com/evmos/evmos/v20/utils"
@@ -45,6 +47,17 @@ var (
 	ParamStoreKeyEnableErc20        = []byte("EnableErc20")
 	ParamStoreKeyDynamicPrecompiles = []byte("DynamicPrecompiles")
 	ParamStoreKeyNativePrecompiles  = []byte("NativePrecompiles")
+	ParamStoreKeySafeApprove        = []byte("SafeApprove")
+	// ParamStoreKeyPermissionlessRegistration is the store key of the PermissionlessRegistration param
+	ParamStoreKeyPermissionlessRegistration = []byte("PermissionlessRegistration")
+	// ParamStoreKeyRegistrationFee is the store key of the RegistrationFee param
+	ParamStoreKeyRegistrationFee = []byte("RegistrationFee")
+	// ParamStoreKeyRegistrationDenylist is the store key of the RegistrationDenylist param
+	ParamStoreKeyRegistrationDenylist = []byte("RegistrationDenylist")
+	// ParamStoreKeyFeeTokens is the store key of the FeeTokens param
+	ParamStoreKeyFeeTokens = []byte("FeeTokens")
+	// ParamStoreKeyIBCAutoRegistrationAllowlist is the store key of the IBCAutoRegistrationAllowlist param
+	ParamStoreKeyIBCAutoRegistrationAllowlist = []byte("IBCAutoRegistrationAllowlist")
 	// DefaultNativePrecompiles defines the default precompiles for the wrapped native coin
 	// NOTE: If you modify this, make sure you modify it on the local_node genesis script as well
 	DefaultNativePrecompiles = []string{WEVMOSContractMainnet}
@@ -72,6 +85,15 @@ func DefaultParams() Params {
 		EnableErc20:        true,
 		NativePrecompiles:  DefaultNativePrecompiles,
 		DynamicPrecompiles: DefaultDynamicPrecompiles,
+		SafeApprove:        false,
+		// NOTE: token pairs can only be registered through governance by default
+		PermissionlessRegistration: false,
+		RegistrationFee:            sdk.Coins{},
+		RegistrationDenylist:       []string{},
+		// NOTE: the fees can only be paid in the EVM coin by default
+		FeeTokens: sdk.DecCoins{},
+		// NOTE: the IBC vouchers received on any channel are registered by default
+		IbcAutoRegistrationAllowlist: []string{},
 	}
 }
 
@@ -89,6 +111,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidateBool(p.SafeApprove); err != nil {
+		return err
+	}
+
 	npAddrs, err := ValidatePrecompiles(p.NativePrecompiles)
 	if err != nil {
 		return err
@@ -101,7 +127,83 @@ func (p Params) Validate() error {
 
 	combined := dpAddrs
 	combined = append(combined, npAddrs...)
-	return validatePrecompilesUniqueness(combined)
+	if err := validatePrecompilesUniqueness(combined); err != nil {
+		return err
+	}
+
+	if err := ValidateBool(p.PermissionlessRegistration); err != nil {
+		return err
+	}
+
+	if err := p.RegistrationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid registration fee: %w", err)
+	}
+
+	if err := ValidateRegistrationDenylist(p.RegistrationDenylist); err != nil {
+		return err
+	}
+
+	if err := ValidateFeeTokens(p.FeeTokens); err != nil {
+		return err
+	}
+
+	return ValidateIBCAutoRegistrationAllowlist(p.IbcAutoRegistrationAllowlist)
+}
+
+// ValidateFeeTokens checks if the fee token prices are valid, positive, sorted
+// and unique.
+func ValidateFeeTokens(feeTokens sdk.DecCoins) error {
+	if err := feeTokens.Validate(); err != nil {
+		return fmt.Errorf("invalid fee tokens: %w", err)
+	}
+	return nil
+}
+
+// ValidateRegistrationDenylist checks if the denylisted contract addresses are
+// valid, sorted and unique.
+func ValidateRegistrationDenylist(denylist []string) error {
+	seen := make(map[common.Address]struct{}, len(denylist))
+	for _, contract := range denylist {
+		if err := types.ValidateAddress(contract); err != nil {
+			return fmt.Errorf("invalid denylisted contract %s", contract)
+		}
+
+		addr := common.HexToAddress(contract)
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate denylisted contract %s", contract)
+		}
+		seen[addr] = struct{}{}
+	}
+
+	// NOTE: Check that the contracts are sorted. This is required
+	// to ensure determinism
+	if !slices.IsSorted(denylist) {
+		return fmt.Errorf("registration denylist needs to be sorted: %s", denylist)
+	}
+	return nil
+}
+
+// ValidateIBCAutoRegistrationAllowlist checks if the allowlisted channel
+// identifiers are valid, sorted and unique.
+func ValidateIBCAutoRegistrationAllowlist(allowlist []string) error {
+	for i, channel := range allowlist {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return fmt.Errorf("invalid allowlisted channel %s: %w", channel, err)
+		}
+
+		// NOTE: Check that the channels are sorted and unique. This is
+		// required to ensure determinism
+		if i > 0 && allowlist[i-1] >= channel {
+			return fmt.Errorf("ibc auto registration allowlist needs to be sorted and unique: %s", allowlist)
+		}
+	}
+	return nil
+}
+
+// IsRegistrationDenylisted checks if the provided contract address is within
+// the registration denylist
+func (p Params) IsRegistrationDenylisted(contract common.Address) bool {
+	return isAddrIncluded(contract, p.RegistrationDenylist)
 }
 
 // ValidatePrecompiles checks if the precompile addresses are valid and unique.
@@ -4,6 +4,8 @@ import (
 	"slices"
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/x/erc20/types"
 	"github.com/stretchr/testify/require"
@@ -131,6 +133,124 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			true,
 			"precompiles need to be sorted",
 		},
+		{
+			"valid permissionless registration",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.PermissionlessRegistration = true
+				params.RegistrationFee = sdk.NewCoins(sdk.NewInt64Coin("aevmos", 1000))
+				params.RegistrationDenylist = []string{types.WEVMOSContractMainnet, types.WEVMOSContractTestnet}
+				return params
+			},
+			false,
+			"",
+		},
+		{
+			"valid ibc auto registration allowlist",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.IbcAutoRegistrationAllowlist = []string{"channel-0", "channel-1"}
+				return params
+			},
+			false,
+			"",
+		},
+		{
+			"invalid channel - ibc auto registration allowlist",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.IbcAutoRegistrationAllowlist = []string{"channel/0"}
+				return params
+			},
+			true,
+			"invalid allowlisted channel",
+		},
+		{
+			"unsorted channels - ibc auto registration allowlist",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.IbcAutoRegistrationAllowlist = []string{"channel-1", "channel-0"}
+				return params
+			},
+			true,
+			"needs to be sorted and unique",
+		},
+		{
+			"invalid registration fee",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RegistrationFee = sdk.Coins{{Denom: "aevmos", Amount: math.NewInt(-1)}}
+				return params
+			},
+			true,
+			"invalid registration fee",
+		},
+		{
+			"invalid address - registration denylist",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RegistrationDenylist = []string{"0xqq"}
+				return params
+			},
+			true,
+			"invalid denylisted contract",
+		},
+		{
+			"repeated address - registration denylist",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RegistrationDenylist = []string{"0xcc491f589b45d4a3c679016195b3fb87d7848210", "0xcc491f589B45d4a3C679016195B3FB87D7848210"}
+				return params
+			},
+			true,
+			"duplicate denylisted contract",
+		},
+		{
+			"unsorted addresses - registration denylist",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RegistrationDenylist = []string{types.WEVMOSContractTestnet, types.WEVMOSContractMainnet}
+				return params
+			},
+			true,
+			"registration denylist needs to be sorted",
+		},
+		{
+			"valid fee tokens",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.FeeTokens = sdk.NewDecCoins(
+					sdk.NewDecCoinFromDec("ibc/usdc", math.LegacyNewDecWithPrec(2, 2)),
+					sdk.NewDecCoinFromDec("ibc/atom", math.LegacyNewDecWithPrec(5, 3)),
+				)
+				return params
+			},
+			false,
+			"",
+		},
+		{
+			"non positive price - fee tokens",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.FeeTokens = sdk.DecCoins{{Denom: "ibc/usdc", Amount: math.LegacyZeroDec()}}
+				return params
+			},
+			true,
+			"invalid fee tokens",
+		},
+		{
+			"unsorted denoms - fee tokens",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.FeeTokens = sdk.DecCoins{
+					{Denom: "ibc/usdc", Amount: math.LegacyOneDec()},
+					{Denom: "ibc/atom", Amount: math.LegacyOneDec()},
+				}
+				return params
+			},
+			true,
+			"invalid fee tokens",
+		},
 	}
 
 	for _, tc := range testCases {
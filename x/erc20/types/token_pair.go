@@ -4,8 +4,13 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/ethereum/go-ethereum/common"
 	evmostypes "github.com/evmos/evmos/v20/types"
 	"github.com/evmos/evmos/v20/utils"
@@ -59,6 +64,187 @@ func (tp TokenPair) Validate() error {
 	return evmostypes.ValidateAddress(tp.Erc20Address)
 }
 
+// Validate performs a stateless validation of a TokenPairCreator
+func (c TokenPairCreator) Validate() error {
+	if err := evmostypes.ValidateAddress(c.Erc20Address); err != nil {
+		return err
+	}
+
+	return evmostypes.ValidateAddress(c.Creator)
+}
+
+// Validate performs a stateless validation of a BlockedAccount
+func (b BlockedAccount) Validate() error {
+	if err := evmostypes.ValidateAddress(b.Erc20Address); err != nil {
+		return err
+	}
+
+	return evmostypes.ValidateAddress(b.Account)
+}
+
+// Validate performs a stateless validation of a ConversionRateLimit
+func (rl ConversionRateLimit) Validate() error {
+	if err := evmostypes.ValidateAddress(rl.Erc20Address); err != nil {
+		return err
+	}
+
+	if rl.MaxAmount.IsNil() || !rl.MaxAmount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max amount must be positive, got %s", rl.MaxAmount)
+	}
+
+	if rl.EpochDuration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "epoch duration must be positive, got %s", rl.EpochDuration)
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of an Allowance
+func (a Allowance) Validate() error {
+	for _, address := range []string{a.Erc20Address, a.Owner, a.Spender} {
+		if err := evmostypes.ValidateAddress(address); err != nil {
+			return err
+		}
+	}
+
+	if common.HexToAddress(a.Owner) == common.HexToAddress(a.Spender) {
+		return errorsmod.Wrapf(ErrInvalidAllowance, "spender cannot be the owner %s", a.Owner)
+	}
+
+	if a.Value.IsNil() || !a.Value.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidAllowance, "value must be positive, got %s", a.Value)
+	}
+
+	if a.Value.BigInt().BitLen() > 256 {
+		return errorsmod.Wrapf(ErrInvalidAllowance, "value %s overflows uint256", a.Value)
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of a DenomGroup
+func (g DenomGroup) Validate() error {
+	if strings.TrimSpace(g.GroupId) == "" {
+		return errorsmod.Wrap(ErrInvalidDenomGroup, "group id cannot be empty")
+	}
+
+	if len(g.GroupId) > address.MaxAddrLen {
+		return errorsmod.Wrapf(ErrInvalidDenomGroup, "group id cannot be longer than %d bytes", address.MaxAddrLen)
+	}
+
+	if len(g.Denoms) == 0 {
+		return errorsmod.Wrap(ErrInvalidDenomGroup, "group must contain at least one denom")
+	}
+
+	seen := make(map[string]bool, len(g.Denoms))
+	for _, denom := range g.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrap(ErrInvalidDenomGroup, err.Error())
+		}
+
+		if seen[denom] {
+			return errorsmod.Wrapf(ErrInvalidDenomGroup, "duplicate denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// ComplianceFeature defines a compliance hook that can be enabled for the
+// token pair of an ERC-20 precompile. Features are combined as a bit mask.
+type ComplianceFeature uint8
+
+const (
+	// ComplianceScreening checks the sender and receiver of a transfer.
+	ComplianceScreening ComplianceFeature = 1 << iota
+	// ComplianceWhitelist restricts the receivers of a transfer to a set of addresses.
+	ComplianceWhitelist
+
+	// ComplianceFeaturesMask is the bit mask of all the supported compliance features.
+	ComplianceFeaturesMask = ComplianceScreening | ComplianceWhitelist
+)
+
+// ValidateComplianceFeatures returns an error if the bit mask contains
+// unsupported compliance features.
+func ValidateComplianceFeatures(features uint32) error {
+	if features&^uint32(ComplianceFeaturesMask) != 0 {
+		return errorsmod.Wrapf(ErrInvalidCompliance, "unsupported compliance features %#x", features)
+	}
+	return nil
+}
+
+// tokenPairSettingsLength is the length of the encoded token pair settings: a
+// byte with the flags, a byte with the compliance features and the creator.
+const tokenPairSettingsLength = 2 + common.AddressLength
+
+// eventsDisabledFlag is the flag set in the encoded token pair settings when
+// the ERC-20 precompile doesn't emit EVM events.
+const eventsDisabledFlag byte = 1
+
+// TokenPairSettings defines the per-pair settings of the ERC-20 precompile of
+// a token pair. They are stored as a single record, so that they are read once
+// when the precompile is instantiated.
+type TokenPairSettings struct {
+	// EmitEvents defines whether the precompile emits EVM events.
+	EmitEvents bool
+	// ComplianceFeatures are the compliance hooks that are active for the token pair.
+	ComplianceFeatures ComplianceFeature
+	// Creator is the address of the account that registered the token pair.
+	// It's the zero address for the token pairs without a recorded creator.
+	Creator common.Address
+}
+
+// DefaultTokenPairSettings returns the settings of the token pairs without a
+// stored record: the events are emitted and no compliance hook is active.
+func DefaultTokenPairSettings() TokenPairSettings {
+	return TokenPairSettings{EmitEvents: true}
+}
+
+// IsDefault returns true if the settings don't need to be stored.
+func (s TokenPairSettings) IsDefault() bool {
+	return s == DefaultTokenPairSettings()
+}
+
+// Bytes returns the encoded token pair settings.
+func (s TokenPairSettings) Bytes() []byte {
+	bz := make([]byte, tokenPairSettingsLength)
+	if !s.EmitEvents {
+		bz[0] |= eventsDisabledFlag
+	}
+	bz[1] = byte(s.ComplianceFeatures)
+	copy(bz[2:], s.Creator.Bytes())
+	return bz
+}
+
+// ParseTokenPairSettings decodes the token pair settings encoded by Bytes.
+func ParseTokenPairSettings(bz []byte) (TokenPairSettings, error) {
+	if len(bz) != tokenPairSettingsLength {
+		return TokenPairSettings{}, fmt.Errorf(
+			"invalid token pair settings length, expected %d, got %d", tokenPairSettingsLength, len(bz),
+		)
+	}
+
+	return TokenPairSettings{
+		EmitEvents:         bz[0]&eventsDisabledFlag == 0,
+		ComplianceFeatures: ComplianceFeature(bz[1]),
+		Creator:            common.BytesToAddress(bz[2:]),
+	}, nil
+}
+
+// Validate performs a stateless validation of a TokenPairComplianceFeatures
+func (c TokenPairComplianceFeatures) Validate() error {
+	if err := evmostypes.ValidateAddress(c.Erc20Address); err != nil {
+		return err
+	}
+
+	if c.Features == 0 {
+		return errorsmod.Wrap(ErrInvalidCompliance, "compliance features cannot be empty")
+	}
+
+	return ValidateComplianceFeatures(c.Features)
+}
+
 // IsNativeCoin returns true if the owner of the ERC20 contract is the
 // erc20 module account
 func (tp TokenPair) IsNativeCoin() bool {
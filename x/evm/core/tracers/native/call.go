@@ -45,6 +45,25 @@ type callFrame struct {
 	Output  string      `json:"output,omitempty"`
 	Error   string      `json:"error,omitempty"`
 	Calls   []callFrame `json:"calls,omitempty"`
+	// Precompile contains the decoded call when the frame targets a stateful precompile
+	Precompile *precompileFrame `json:"precompile,omitempty"`
+}
+
+// precompileFrame is the decoded representation of a stateful precompile call.
+type precompileFrame struct {
+	Method    string              `json:"method"`
+	Signature string              `json:"signature"`
+	Args      []vm.PrecompileArg  `json:"args,omitempty"`
+	GasUsed   string              `json:"gasUsed"`
+	Logs      []precompileLogItem `json:"logs,omitempty"`
+	Error     string              `json:"error,omitempty"`
+}
+
+// precompileLogItem is a log emitted during a stateful precompile call.
+type precompileLogItem struct {
+	Address string   `json:"address"`
+	Topics  []string `json:"topics"`
+	Data    string   `json:"data"`
 }
 
 type callTracer struct {
@@ -159,6 +178,40 @@ func (t *callTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
 	t.callstack[size-1].Calls = append(t.callstack[size-1].Calls, call)
 }
 
+var _ vm.PrecompileLogger = (*callTracer)(nil)
+
+// CapturePrecompile implements the PrecompileLogger interface to attach the
+// decoded stateful precompile call to the call frame that targets it.
+func (t *callTracer) CapturePrecompile(call *vm.PrecompileCall) {
+	// nested frames are not tracked when only tracing the top call
+	if t.config.OnlyTopCall && call.Depth > 0 {
+		return
+	}
+
+	frame := &precompileFrame{
+		Method:    call.Method,
+		Signature: call.Signature,
+		Args:      call.Args,
+		GasUsed:   uintToHex(call.GasUsed),
+	}
+	if call.Err != nil {
+		frame.Error = call.Err.Error()
+	}
+	for _, log := range call.Logs {
+		topics := make([]string, len(log.Topics))
+		for i, topic := range log.Topics {
+			topics[i] = topic.Hex()
+		}
+		frame.Logs = append(frame.Logs, precompileLogItem{
+			Address: addrToHex(log.Address),
+			Topics:  topics,
+			Data:    bytesToHex(log.Data),
+		})
+	}
+
+	t.callstack[len(t.callstack)-1].Precompile = frame
+}
+
 func (*callTracer) CaptureTxStart(gasLimit uint64) {}
 
 func (*callTracer) CaptureTxEnd(restGas uint64) {}
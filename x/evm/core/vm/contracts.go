@@ -26,6 +26,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/blake2b"
 	"github.com/ethereum/go-ethereum/crypto/bls12381"
@@ -96,13 +97,12 @@ var PrecompiledContractsBerlin = map[common.Address]PrecompiledContract{
 }
 
 // PrecompiledContractsBLS contains the set of pre-compiled Ethereum
-// contracts specified in EIP-2537. These are exported for testing purposes.
+// contracts specified in EIP-2537. They are only available when the EIP2537
+// extra EIP is enabled.
 var PrecompiledContractsBLS = map[common.Address]PrecompiledContract{
 	bls12381G1Add{}.Address():      &bls12381G1Add{},
-	bls12381G1Mul{}.Address():      &bls12381G1Mul{},
 	bls12381G1MultiExp{}.Address(): &bls12381G1MultiExp{},
 	bls12381G2Add{}.Address():      &bls12381G2Add{},
-	bls12381G2Mul{}.Address():      &bls12381G2Mul{},
 	bls12381G2MultiExp{}.Address(): &bls12381G2MultiExp{},
 	bls12381Pairing{}.Address():    &bls12381Pairing{},
 	bls12381MapG1{}.Address():      &bls12381MapG1{},
@@ -110,6 +110,17 @@ var PrecompiledContractsBLS = map[common.Address]PrecompiledContract{
 }
 
 var (
+	// PrecompiledAddressesBLS defines the set of pre-compiled Ethereum contract
+	// addresses specified in EIP-2537.
+	PrecompiledAddressesBLS = []common.Address{
+		bls12381G1Add{}.Address(),
+		bls12381G1MultiExp{}.Address(),
+		bls12381G2Add{}.Address(),
+		bls12381G2MultiExp{}.Address(),
+		bls12381Pairing{}.Address(),
+		bls12381MapG1{}.Address(),
+		bls12381MapG2{}.Address(),
+	}
 	// PrecompiledAddressesBerlin defines the default set of pre-compiled
 	// Ethereum contract addresses used in the Berlin release.
 	PrecompiledAddressesBerlin = []common.Address{
@@ -203,6 +214,12 @@ func (evm *EVM) Precompile(addr common.Address) (PrecompiledContract, bool) {
 	return p, ok
 }
 
+// PrecompileGasUsed returns the gas consumed by the precompiled contracts
+// called during the execution.
+func (evm *EVM) PrecompileGasUsed() uint64 {
+	return evm.precompileGasUsed
+}
+
 // WithPrecompiles sets the precompiled contracts and the slice of actives precompiles.
 // IMPORTANT: This function does NOT validate the precompiles provided to the EVM. The caller should
 // use the ValidatePrecompiles function for this purpose prior to calling WithPrecompiles.
@@ -282,6 +299,39 @@ func runPrecompiledContract(
 	inputCopy := make([]byte, len(input))
 	copy(inputCopy, input)
 
+	// notify the tracer with the decoded precompile call, if supported. The
+	// precompiles can also be run without an EVM, e.g. from the unit tests.
+	if tracer, ok := evm.precompileLogger(); ok {
+		if call := newPrecompileCall(evm, p, inputCopy, suppliedGas); call != nil {
+			logsBefore := len(stateDBLogs(evm.StateDB))
+			defer func() {
+				call.GasUsed = suppliedGas - remainingGas
+				call.Err = err
+				if logs := stateDBLogs(evm.StateDB); len(logs) > logsBefore {
+					call.Logs = append([]*types.Log(nil), logs[logsBefore:]...)
+				}
+				tracer.CapturePrecompile(call)
+			}()
+		}
+	}
+
+	// account the gas consumed by the outermost precompiled contracts, the
+	// errors other than reverts consume all the supplied gas
+	if evm != nil {
+		evm.precompileDepth++
+		defer func() {
+			evm.precompileDepth--
+			if evm.precompileDepth > 0 {
+				return
+			}
+			if err != nil && err != ErrExecutionReverted {
+				evm.precompileGasUsed += suppliedGas
+			} else {
+				evm.precompileGasUsed += suppliedGas - remainingGas
+			}
+		}()
+	}
+
 	contract := NewPrecompile(caller, AccountRef(addrCopy), value, suppliedGas)
 	contract.Input = inputCopy
 
@@ -294,6 +344,27 @@ func runPrecompiledContract(
 	return output, contract.Gas, err
 }
 
+// CallFromPrecompile executes a call made by a precompiled contract to the given
+// address. Since the precompiled contracts don't have a call frame of their
+// own, the call is made one level deeper so that the call depth limit applies
+// and the tracers capture it as a nested call. The precompiled contracts that
+// are run in read-only mode can only make static calls.
+func (evm *EVM) CallFromPrecompile(
+	caller ContractRef,
+	addr common.Address,
+	input []byte,
+	gas uint64,
+	readOnly bool,
+) (ret []byte, leftOverGas uint64, err error) {
+	evm.depth++
+	defer func() { evm.depth-- }()
+
+	if readOnly {
+		return evm.StaticCall(caller, addr, input, gas)
+	}
+	return evm.Call(caller, addr, input, gas, new(big.Int))
+}
+
 // ECRECOVER implemented as a native contract.
 type ecrecover struct{}
 
@@ -840,18 +911,42 @@ var (
 	errBLS12381G2PointSubgroup             = errors.New("g2 point is not on correct subgroup")
 )
 
+// Gas schedule of the final EIP-2537 specification. The go-ethereum params
+// still hold the prices of the superseded draft.
+const (
+	bls12381G1AddGas          uint64 = 375   // Price for BLS12-381 elliptic curve G1 point addition
+	bls12381G1MulGas          uint64 = 12000 // Price for BLS12-381 elliptic curve G1 point scalar multiplication
+	bls12381G2AddGas          uint64 = 600   // Price for BLS12-381 elliptic curve G2 point addition
+	bls12381G2MulGas          uint64 = 22500 // Price for BLS12-381 elliptic curve G2 point scalar multiplication
+	bls12381PairingBaseGas    uint64 = 37700 // Base gas price for BLS12-381 elliptic curve pairing check
+	bls12381PairingPerPairGas uint64 = 32600 // Per-point pair gas price for BLS12-381 elliptic curve pairing check
+	bls12381MapG1Gas          uint64 = 5500  // Gas price for BLS12-381 mapping field element to G1 operation
+	bls12381MapG2Gas          uint64 = 23800 // Gas price for BLS12-381 mapping field element to G2 operation
+
+	bls12381G1MSMMaxDiscount uint64 = 519 // Discount applied to the G1 MSM calls with more than 128 pairs
+	bls12381G2MSMMaxDiscount uint64 = 524 // Discount applied to the G2 MSM calls with more than 128 pairs
+)
+
+// bls12381G1MSMDiscountTable is the gas discount table for the BLS12-381 G1
+// multi exponentiation operation, indexed by the number of pairs.
+var bls12381G1MSMDiscountTable = [128]uint64{1000, 949, 848, 797, 764, 750, 738, 728, 719, 712, 705, 698, 692, 687, 682, 677, 673, 669, 665, 661, 658, 654, 651, 648, 645, 642, 640, 637, 635, 632, 630, 627, 625, 623, 621, 619, 617, 615, 613, 611, 609, 608, 606, 604, 603, 601, 599, 598, 596, 595, 593, 592, 591, 589, 588, 586, 585, 584, 582, 581, 580, 579, 577, 576, 575, 574, 573, 572, 570, 569, 568, 567, 566, 565, 564, 563, 562, 561, 560, 559, 558, 557, 556, 555, 554, 553, 552, 551, 550, 549, 548, 547, 547, 546, 545, 544, 543, 542, 541, 540, 540, 539, 538, 537, 536, 536, 535, 534, 533, 532, 532, 531, 530, 529, 528, 528, 527, 526, 525, 525, 524, 523, 522, 522, 521, 520, 520, 519}
+
+// bls12381G2MSMDiscountTable is the gas discount table for the BLS12-381 G2
+// multi exponentiation operation, indexed by the number of pairs.
+var bls12381G2MSMDiscountTable = [128]uint64{1000, 1000, 923, 884, 855, 832, 812, 796, 782, 770, 759, 749, 740, 732, 724, 717, 711, 704, 699, 693, 688, 683, 679, 674, 670, 666, 663, 659, 655, 652, 649, 646, 643, 640, 637, 634, 632, 629, 627, 624, 622, 620, 618, 615, 613, 611, 609, 607, 606, 604, 602, 600, 598, 597, 595, 593, 592, 590, 589, 587, 586, 584, 583, 582, 580, 579, 578, 576, 575, 574, 573, 571, 570, 569, 568, 567, 566, 565, 563, 562, 561, 560, 559, 558, 557, 556, 555, 554, 553, 552, 552, 551, 550, 549, 548, 547, 546, 545, 545, 544, 543, 542, 541, 541, 540, 539, 538, 537, 537, 536, 535, 535, 534, 533, 532, 532, 531, 530, 530, 529, 528, 528, 527, 526, 526, 525, 524, 524}
+
 // bls12381G1Add implements EIP-2537 G1Add precompile.
 type bls12381G1Add struct{}
 
 // Address defines the precompiled contract address. This MUST match the address
 // set in the precompiled contract map.
 func (bls12381G1Add) Address() common.Address {
-	return common.BytesToAddress([]byte{10})
+	return common.BytesToAddress([]byte{0x0b})
 }
 
 // RequiredGas returns the gas required to execute the pre-compiled contract.
 func (c *bls12381G1Add) RequiredGas(input []byte) uint64 {
-	return params.Bls12381G1AddGas
+	return bls12381G1AddGas
 }
 
 func (c *bls12381G1Add) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
@@ -884,55 +979,13 @@ func (c *bls12381G1Add) Run(evm *EVM, contract *Contract, readonly bool) ([]byte
 	return g.EncodePoint(r), nil
 }
 
-// bls12381G1Mul implements EIP-2537 G1Mul precompile.
-type bls12381G1Mul struct{}
-
-// Address defines the precompiled contract address. This MUST match the address
-// set in the precompiled contract map.
-func (bls12381G1Mul) Address() common.Address {
-	return common.BytesToAddress([]byte{11})
-}
-
-// RequiredGas returns the gas required to execute the pre-compiled contract.
-func (c *bls12381G1Mul) RequiredGas(input []byte) uint64 {
-	return params.Bls12381G1MulGas
-}
-
-func (c *bls12381G1Mul) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
-	// Implements EIP-2537 G1Mul precompile.
-	// > G1 multiplication call expects `160` bytes as an input that is interpreted as byte concatenation of encoding of G1 point (`128` bytes) and encoding of a scalar value (`32` bytes).
-	// > Output is an encoding of multiplication operation result - single G1 point (`128` bytes).
-	if len(contract.Input) != 160 {
-		return nil, errBLS12381InvalidInputLength
-	}
-	var err error
-	var p0 *bls12381.PointG1
-
-	// Initialize G1
-	g := bls12381.NewG1()
-
-	// Decode G1 point
-	if p0, err = g.DecodePoint(contract.Input[:128]); err != nil {
-		return nil, err
-	}
-	// Decode scalar value
-	e := new(big.Int).SetBytes(contract.Input[128:])
-
-	// Compute r = e * p_0
-	r := g.New()
-	g.MulScalar(r, p0, e)
-
-	// Encode the G1 point into 128 bytes
-	return g.EncodePoint(r), nil
-}
-
 // bls12381G1MultiExp implements EIP-2537 G1MultiExp precompile.
 type bls12381G1MultiExp struct{}
 
 // Address defines the precompiled contract address. This MUST match the address
 // set in the precompiled contract map.
 func (bls12381G1MultiExp) Address() common.Address {
-	return common.BytesToAddress([]byte{12})
+	return common.BytesToAddress([]byte{0x0c})
 }
 
 // RequiredGas returns the gas required to execute the pre-compiled contract.
@@ -945,19 +998,19 @@ func (c *bls12381G1MultiExp) RequiredGas(input []byte) uint64 {
 	}
 	// Lookup discount value for G1 point, scalar value pair length
 	var discount uint64
-	if dLen := len(params.Bls12381MultiExpDiscountTable); k < dLen {
-		discount = params.Bls12381MultiExpDiscountTable[k-1]
+	if dLen := len(bls12381G1MSMDiscountTable); k <= dLen {
+		discount = bls12381G1MSMDiscountTable[k-1]
 	} else {
-		discount = params.Bls12381MultiExpDiscountTable[dLen-1]
+		discount = bls12381G1MSMMaxDiscount
 	}
 	// Calculate gas and return the result
-	return (uint64(k) * params.Bls12381G1MulGas * discount) / 1000
+	return (uint64(k) * bls12381G1MulGas * discount) / 1000
 }
 
 func (c *bls12381G1MultiExp) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
 	// Implements EIP-2537 G1MultiExp precompile.
-	// G1 multiplication call expects `160*k` bytes as an input that is interpreted as byte concatenation of `k` slices each of them being a byte concatenation of encoding of G1 point (`128` bytes) and encoding of a scalar value (`32` bytes).
-	// Output is an encoding of multiexponentiation operation result - single G1 point (`128` bytes).
+	// > G1 multiplication call expects `160*k` bytes as an input that is interpreted as byte concatenation of `k` slices each of them being a byte concatenation of encoding of G1 point (`128` bytes) and encoding of a scalar value (`32` bytes).
+	// > Output is an encoding of multiexponentiation operation result - single G1 point (`128` bytes).
 	k := len(contract.Input) / 160
 	if len(contract.Input) == 0 || len(contract.Input)%160 != 0 {
 		return nil, errBLS12381InvalidInputLength
@@ -977,6 +1030,11 @@ func (c *bls12381G1MultiExp) Run(evm *EVM, contract *Contract, readonly bool) ([
 		if points[i], err = g.DecodePoint(contract.Input[t0:t1]); err != nil {
 			return nil, err
 		}
+		// 'point is on curve' check already done,
+		// Here we need to apply subgroup checks.
+		if !g.InCorrectSubgroup(points[i]) {
+			return nil, errBLS12381G1PointSubgroup
+		}
 		// Decode scalar value
 		scalars[i] = new(big.Int).SetBytes(contract.Input[t1:t2])
 	}
@@ -995,12 +1053,12 @@ type bls12381G2Add struct{}
 // Address defines the precompiled contract address. This MUST match the address
 // set in the precompiled contract map.
 func (bls12381G2Add) Address() common.Address {
-	return common.BytesToAddress([]byte{13})
+	return common.BytesToAddress([]byte{0x0d})
 }
 
 // RequiredGas returns the gas required to execute the pre-compiled contract.
 func (c *bls12381G2Add) RequiredGas(input []byte) uint64 {
-	return params.Bls12381G2AddGas
+	return bls12381G2AddGas
 }
 
 func (c *bls12381G2Add) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
@@ -1033,55 +1091,13 @@ func (c *bls12381G2Add) Run(evm *EVM, contract *Contract, readonly bool) ([]byte
 	return g.EncodePoint(r), nil
 }
 
-// bls12381G2Mul implements EIP-2537 G2Mul precompile.
-type bls12381G2Mul struct{}
-
-// Address defines the precompiled contract address. This MUST match the address
-// set in the precompiled contract map.
-func (bls12381G2Mul) Address() common.Address {
-	return common.BytesToAddress([]byte{14})
-}
-
-// RequiredGas returns the gas required to execute the pre-compiled contract.
-func (c *bls12381G2Mul) RequiredGas(input []byte) uint64 {
-	return params.Bls12381G2MulGas
-}
-
-func (c *bls12381G2Mul) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
-	// Implements EIP-2537 G2MUL precompile logic.
-	// > G2 multiplication call expects `288` bytes as an input that is interpreted as byte concatenation of encoding of G2 point (`256` bytes) and encoding of a scalar value (`32` bytes).
-	// > Output is an encoding of multiplication operation result - single G2 point (`256` bytes).
-	if len(contract.Input) != 288 {
-		return nil, errBLS12381InvalidInputLength
-	}
-	var err error
-	var p0 *bls12381.PointG2
-
-	// Initialize G2
-	g := bls12381.NewG2()
-
-	// Decode G2 point
-	if p0, err = g.DecodePoint(contract.Input[:256]); err != nil {
-		return nil, err
-	}
-	// Decode scalar value
-	e := new(big.Int).SetBytes(contract.Input[256:])
-
-	// Compute r = e * p_0
-	r := g.New()
-	g.MulScalar(r, p0, e)
-
-	// Encode the G2 point into 256 bytes
-	return g.EncodePoint(r), nil
-}
-
 // bls12381G2MultiExp implements EIP-2537 G2MultiExp precompile.
 type bls12381G2MultiExp struct{}
 
 // Address defines the precompiled contract address. This MUST match the address
 // set in the precompiled contract map.
 func (bls12381G2MultiExp) Address() common.Address {
-	return common.BytesToAddress([]byte{15})
+	return common.BytesToAddress([]byte{0x0e})
 }
 
 // RequiredGas returns the gas required to execute the pre-compiled contract.
@@ -1094,13 +1110,13 @@ func (c *bls12381G2MultiExp) RequiredGas(input []byte) uint64 {
 	}
 	// Lookup discount value for G2 point, scalar value pair length
 	var discount uint64
-	if dLen := len(params.Bls12381MultiExpDiscountTable); k < dLen {
-		discount = params.Bls12381MultiExpDiscountTable[k-1]
+	if dLen := len(bls12381G2MSMDiscountTable); k <= dLen {
+		discount = bls12381G2MSMDiscountTable[k-1]
 	} else {
-		discount = params.Bls12381MultiExpDiscountTable[dLen-1]
+		discount = bls12381G2MSMMaxDiscount
 	}
 	// Calculate gas and return the result
-	return (uint64(k) * params.Bls12381G2MulGas * discount) / 1000
+	return (uint64(k) * bls12381G2MulGas * discount) / 1000
 }
 
 func (c *bls12381G2MultiExp) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
@@ -1122,10 +1138,15 @@ func (c *bls12381G2MultiExp) Run(evm *EVM, contract *Contract, readonly bool) ([
 	for i := 0; i < k; i++ {
 		off := 288 * i
 		t0, t1, t2 := off, off+256, off+288
-		// Decode G1 point
+		// Decode G2 point
 		if points[i], err = g.DecodePoint(contract.Input[t0:t1]); err != nil {
 			return nil, err
 		}
+		// 'point is on curve' check already done,
+		// Here we need to apply subgroup checks.
+		if !g.InCorrectSubgroup(points[i]) {
+			return nil, errBLS12381G2PointSubgroup
+		}
 		// Decode scalar value
 		scalars[i] = new(big.Int).SetBytes(contract.Input[t1:t2])
 	}
@@ -1144,12 +1165,12 @@ type bls12381Pairing struct{}
 // Address defines the precompiled contract address. This MUST match the address
 // set in the precompiled contract map.
 func (bls12381Pairing) Address() common.Address {
-	return common.BytesToAddress([]byte{16})
+	return common.BytesToAddress([]byte{0x0f})
 }
 
 // RequiredGas returns the gas required to execute the pre-compiled contract.
 func (c *bls12381Pairing) RequiredGas(input []byte) uint64 {
-	return params.Bls12381PairingBaseGas + uint64(len(input)/384)*params.Bls12381PairingPerPairGas
+	return bls12381PairingBaseGas + uint64(len(input)/384)*bls12381PairingPerPairGas
 }
 
 func (c *bls12381Pairing) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
@@ -1229,12 +1250,12 @@ type bls12381MapG1 struct{}
 // Address defines the precompiled contract address. This MUST match the address
 // set in the precompiled contract map.
 func (bls12381MapG1) Address() common.Address {
-	return common.BytesToAddress([]byte{17})
+	return common.BytesToAddress([]byte{0x10})
 }
 
 // RequiredGas returns the gas required to execute the pre-compiled contract.
 func (c *bls12381MapG1) RequiredGas(input []byte) uint64 {
-	return params.Bls12381MapG1Gas
+	return bls12381MapG1Gas
 }
 
 func (c *bls12381MapG1) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
@@ -1270,12 +1291,12 @@ type bls12381MapG2 struct{}
 // Address defines the precompiled contract address. This MUST match the address
 // set in the precompiled contract map.
 func (bls12381MapG2) Address() common.Address {
-	return common.BytesToAddress([]byte{18})
+	return common.BytesToAddress([]byte{0x11})
 }
 
 // RequiredGas returns the gas required to execute the pre-compiled contract.
 func (c *bls12381MapG2) RequiredGas(input []byte) uint64 {
-	return params.Bls12381MapG2Gas
+	return bls12381MapG2Gas
 }
 
 func (c *bls12381MapG2) Run(evm *EVM, contract *Contract, readonly bool) ([]byte, error) {
@@ -26,7 +26,12 @@ import (
 	"github.com/holiman/uint256"
 )
 
+// EIP2537 defines the name of the extra EIP that enables the BLS12-381 curve
+// operations precompiles.
+const EIP2537 = "ethereum_2537"
+
 var activators = map[string]func(*JumpTable){
+	EIP2537:         enable2537,
 	"ethereum_3855": enable3855,
 	"ethereum_3529": enable3529,
 	"ethereum_3198": enable3198,
@@ -82,6 +87,12 @@ func ActivateableEips() []string {
 	return names
 }
 
+// enable2537 applies EIP-2537 (BLS12-381 curve operations precompiles).
+// The EIP doesn't modify the jump table: the precompiles listed in
+// PrecompiledAddressesBLS are made available to the EVM by the x/evm keeper
+// when the EIP is enabled.
+func enable2537(_ *JumpTable) {}
+
 // enable1884 applies EIP-1884 to the given jump table:
 // - Increase cost of BALANCE to 700
 // - Increase cost of EXTCODEHASH to 700
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
 	"github.com/holiman/uint256"
 )
 
@@ -96,6 +97,10 @@ type EVM struct {
 	precompiles map[common.Address]PrecompiledContract
 	// activePrecompiles defines the precompiles that are currently active
 	activePrecompiles []common.Address
+	// precompileGasUsed is the gas consumed by the precompiled contracts
+	precompileGasUsed uint64
+	// precompileDepth is the number of nested precompiled contract calls
+	precompileDepth int
 
 	// hooks is a set of functions that can be used to intercept and modify the
 	// behavior of the EVM when executing certain opcodes.
@@ -171,13 +176,25 @@ func (evm *EVM) WithInterpreter(interpreter Interpreter) {
 	evm.interpreter = interpreter
 }
 
+// resolveCode returns the code hash and the code to run for the address. The
+// accounts delegated through an EIP-7702 authorization run the code of their
+// delegation target.
+func (evm *EVM) resolveCode(addr common.Address) (common.Hash, []byte) {
+	code := evm.StateDB.GetCode(addr)
+	if target, ok := ethutils.ParseDelegation(code); ok {
+		return evm.StateDB.GetCodeHash(target), evm.StateDB.GetCode(target)
+	}
+	return evm.StateDB.GetCodeHash(addr), code
+}
+
 // Call executes the contract associated with the addr with the given input as
 // parameters. It also handles any necessary value transfer required and takes
 // the necessary steps to create accounts and reverses the state in case of an
 // execution error or failed value transfer.
 func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas uint64, value *big.Int) (ret []byte, leftOverGas uint64, err error) {
 	if err = evm.hooks.CallHook(evm, caller.Address(), addr); err != nil {
-		return nil, gas, err
+		ret, err = callHookError(err)
+		return ret, gas, err
 	}
 
 	// Fail if we're trying to execute above the call depth limit
@@ -232,7 +249,7 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 	} else {
 		// Initialise a new contract and set the code that is to be used by the EVM.
 		// The contract is a scoped environment for this execution context only.
-		code := evm.StateDB.GetCode(addr)
+		codeHash, code := evm.resolveCode(addr)
 		if len(code) == 0 {
 			ret, err = nil, nil // gas is unchanged
 		} else {
@@ -240,7 +257,7 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 			// If the account has no code, we can abort here
 			// The depth-check is already done, and precompiles handled above
 			contract := NewContract(caller, AccountRef(addrCopy), value, gas)
-			contract.SetCallCode(&addrCopy, evm.StateDB.GetCodeHash(addrCopy), code)
+			contract.SetCallCode(&addrCopy, codeHash, code)
 			ret, err = evm.interpreter.Run(contract, input, false)
 			gas = contract.Gas
 		}
@@ -269,7 +286,8 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 // code with the caller as context.
 func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte, gas uint64, value *big.Int) (ret []byte, leftOverGas uint64, err error) {
 	if err = evm.hooks.CallHook(evm, caller.Address(), addr); err != nil {
-		return nil, gas, err
+		ret, err = callHookError(err)
+		return ret, gas, err
 	}
 
 	// Fail if we're trying to execute above the call depth limit
@@ -301,7 +319,8 @@ func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte,
 		// Initialise a new contract and set the code that is to be used by the EVM.
 		// The contract is a scoped environment for this execution context only.
 		contract := NewContract(caller, AccountRef(caller.Address()), value, gas)
-		contract.SetCallCode(&addrCopy, evm.StateDB.GetCodeHash(addrCopy), evm.StateDB.GetCode(addrCopy))
+		codeHash, code := evm.resolveCode(addrCopy)
+		contract.SetCallCode(&addrCopy, codeHash, code)
 		ret, err = evm.interpreter.Run(contract, input, false)
 		gas = contract.Gas
 	}
@@ -321,7 +340,8 @@ func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte,
 // code with the caller as context and the caller is set to the caller of the caller.
 func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []byte, gas uint64) (ret []byte, leftOverGas uint64, err error) {
 	if err = evm.hooks.CallHook(evm, caller.Address(), addr); err != nil {
-		return nil, gas, err
+		ret, err = callHookError(err)
+		return ret, gas, err
 	}
 
 	// Fail if we're trying to execute above the call depth limit
@@ -345,7 +365,8 @@ func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []by
 		addrCopy := addr
 		// Initialise a new contract and make initialise the delegate values
 		contract := NewContract(caller, AccountRef(caller.Address()), nil, gas).AsDelegate()
-		contract.SetCallCode(&addrCopy, evm.StateDB.GetCodeHash(addrCopy), evm.StateDB.GetCode(addrCopy))
+		codeHash, code := evm.resolveCode(addrCopy)
+		contract.SetCallCode(&addrCopy, codeHash, code)
 		ret, err = evm.interpreter.Run(contract, input, false)
 		gas = contract.Gas
 	}
@@ -364,7 +385,8 @@ func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []by
 // instead of performing the modifications.
 func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte, gas uint64) (ret []byte, leftOverGas uint64, err error) {
 	if err = evm.hooks.CallHook(evm, caller.Address(), addr); err != nil {
-		return nil, gas, err
+		ret, err = callHookError(err)
+		return ret, gas, err
 	}
 
 	// Fail if we're trying to execute above the call depth limit
@@ -403,7 +425,8 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 		// Initialise a new contract and set the code that is to be used by the EVM.
 		// The contract is a scoped environment for this execution context only.
 		contract := NewContract(caller, AccountRef(addrCopy), new(big.Int), gas)
-		contract.SetCallCode(&addrCopy, evm.StateDB.GetCodeHash(addrCopy), evm.StateDB.GetCode(addrCopy))
+		codeHash, code := evm.resolveCode(addrCopy)
+		contract.SetCallCode(&addrCopy, codeHash, code)
 		// When an error was returned by the EVM or when setting the creation code
 		// above we revert to the snapshot and consume any gas remaining. Additionally
 		// when we're in Homestead this also counts for code storage gas errors.
@@ -16,7 +16,11 @@
 
 package vm
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 // OpCodeHooks is a set of hooks that can be used to intercept and modify the
 // behavior of the EVM when executing certain opcodes.
@@ -28,6 +32,28 @@ type OpCodeHooks interface {
 	CreateHook(evm *EVM, caller common.Address) error
 }
 
+// HookRevertError is returned by a CallHook to revert the call with the given
+// return data, as if the callee executed the REVERT opcode. The gas forwarded
+// to the call is returned to the caller.
+type HookRevertError struct {
+	Data []byte
+}
+
+// Error implements the error interface.
+func (e *HookRevertError) Error() string {
+	return ErrExecutionReverted.Error()
+}
+
+// callHookError returns the return data and the error of a call rejected by
+// the CallHook.
+func callHookError(err error) ([]byte, error) {
+	var revertErr *HookRevertError
+	if errors.As(err, &revertErr) {
+		return revertErr.Data, ErrExecutionReverted
+	}
+	return nil, err
+}
+
 type NoopOpCodeHooks struct{}
 
 func (NoopOpCodeHooks) CallHook(evm *EVM, caller common.Address, recipient common.Address) error {
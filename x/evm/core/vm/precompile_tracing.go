@@ -0,0 +1,177 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vm
+
+import (
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// PrecompileLogger is an optional extension of the EVMLogger. Tracers
+// implementing it are notified with the decoded details of every call into a
+// stateful precompile, right before the CaptureExit (or CaptureEnd) of the
+// call frame.
+type PrecompileLogger interface {
+	CapturePrecompile(call *PrecompileCall)
+}
+
+// ABIPrecompile is implemented by the stateful precompiles that expose their
+// ABI, which is used to decode the calls for tracing.
+type ABIPrecompile interface {
+	PrecompiledContract
+	MethodById(sigdata []byte) (*abi.Method, error)
+}
+
+// PrecompileArg is a decoded argument of a precompile call.
+type PrecompileArg struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// PrecompileCall contains the decoded information of a stateful precompile
+// call.
+type PrecompileCall struct {
+	Address   common.Address
+	Method    string
+	Signature string
+	Args      []PrecompileArg
+	Depth     int
+	Gas       uint64
+	GasUsed   uint64
+	Logs      []*types.Log
+	Err       error
+}
+
+// logsGetter is implemented by the StateDB implementations that expose the
+// logs of the current transaction.
+type logsGetter interface {
+	Logs() []*types.Log
+}
+
+// precompileLogger returns the EVM tracer if it supports the precompile calls.
+func (evm *EVM) precompileLogger() (PrecompileLogger, bool) {
+	if evm == nil {
+		return nil, false
+	}
+	tracer, ok := evm.Config.Tracer.(PrecompileLogger)
+	return tracer, ok
+}
+
+// newPrecompileCall decodes the precompile call input. It returns nil if the
+// precompile does not expose its ABI or the input cannot be decoded.
+func newPrecompileCall(evm *EVM, p PrecompiledContract, input []byte, gas uint64) *PrecompileCall {
+	ap, ok := p.(ABIPrecompile)
+	if !ok || len(input) < 4 {
+		return nil
+	}
+
+	method, err := ap.MethodById(input[:4])
+	if err != nil {
+		return nil
+	}
+
+	call := &PrecompileCall{
+		Address:   p.Address(),
+		Method:    method.Name,
+		Signature: method.Sig,
+		Depth:     evm.depth,
+		Gas:       gas,
+	}
+
+	values, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		// keep the method information even if the arguments are malformed
+		return call
+	}
+
+	call.Args = make([]PrecompileArg, len(values))
+	for i, value := range values {
+		call.Args[i] = PrecompileArg{
+			Name:  method.Inputs[i].Name,
+			Type:  method.Inputs[i].Type.String(),
+			Value: traceValue(reflect.ValueOf(value)),
+		}
+	}
+
+	return call
+}
+
+// stateDBLogs returns the logs of the transaction, if available.
+func stateDBLogs(stateDB StateDB) []*types.Log {
+	if lg, ok := stateDB.(logsGetter); ok {
+		return lg.Logs()
+	}
+	return nil
+}
+
+// traceValue converts a decoded ABI value into a JSON friendly
+// representation: integers are encoded as decimal strings to avoid losing
+// precision, byte arrays and slices as hex strings and tuples as objects.
+func traceValue(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch value := v.Interface().(type) {
+	case *big.Int:
+		if value == nil {
+			return nil
+		}
+		return value.String()
+	case common.Address:
+		return value.Hex()
+	case common.Hash:
+		return value.Hex()
+	case []byte:
+		return hexutil.Encode(value)
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return traceValue(v.Elem())
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(bz), v)
+			return hexutil.Encode(bz)
+		}
+		fallthrough
+	case reflect.Slice:
+		values := make([]interface{}, v.Len())
+		for i := range values {
+			values[i] = traceValue(v.Index(i))
+		}
+		return values
+	case reflect.Struct:
+		fields := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := field.Name
+			if tag := field.Tag.Get("json"); tag != "" && tag != "-" {
+				name = tag
+			}
+			fields[name] = traceValue(v.Field(i))
+		}
+		return fields
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	default:
+		return v.Interface()
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vm
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const tracedPrecompileABI = `[{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"},{"name":"memo","type":"bytes"}],"outputs":[{"name":"","type":"bool"}]}]`
+
+var errTracedPrecompile = errors.New("traced precompile failure")
+
+// tracedPrecompile is a stateful precompile exposing its ABI.
+type tracedPrecompile struct {
+	abi.ABI
+	fail bool
+}
+
+func (tracedPrecompile) Address() common.Address {
+	return common.HexToAddress("0x0000000000000000000000000000000000000900")
+}
+
+func (tracedPrecompile) RequiredGas([]byte) uint64 { return 100 }
+
+func (p tracedPrecompile) Run(_ *EVM, contract *Contract, _ bool) ([]byte, error) {
+	contract.UseGas(50)
+	if p.fail {
+		return nil, errTracedPrecompile
+	}
+	return common.LeftPadBytes([]byte{1}, 32), nil
+}
+
+// precompileRecorder is a tracer recording the precompile calls.
+type precompileRecorder struct {
+	calls []*PrecompileCall
+}
+
+func (*precompileRecorder) CaptureTxStart(uint64) {}
+func (*precompileRecorder) CaptureTxEnd(uint64)   {}
+func (*precompileRecorder) CaptureStart(*EVM, common.Address, common.Address, bool, []byte, uint64, *big.Int) {
+}
+func (*precompileRecorder) CaptureEnd([]byte, uint64, time.Duration, error) {}
+func (*precompileRecorder) CaptureEnter(OpCode, common.Address, common.Address, []byte, uint64, *big.Int) {
+}
+func (*precompileRecorder) CaptureExit([]byte, uint64, error) {}
+func (*precompileRecorder) CaptureState(uint64, OpCode, uint64, uint64, *ScopeContext, []byte, int, error) {
+}
+func (*precompileRecorder) CaptureFault(uint64, OpCode, uint64, uint64, *ScopeContext, int, error) {
+}
+func (r *precompileRecorder) CapturePrecompile(call *PrecompileCall) { r.calls = append(r.calls, call) }
+
+func TestRunPrecompiledContractTracing(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(tracedPrecompileABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	to := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	input, err := parsed.Pack("transfer", to, big.NewInt(1_000), []byte{0xca, 0xfe})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name  string
+		fail  bool
+		input []byte
+		calls int
+	}{
+		{"decoded call", false, input, 1},
+		{"failed call", true, input, 1},
+		{"unknown method", false, []byte{1, 2, 3, 4}, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := &precompileRecorder{}
+			evm := &EVM{Config: Config{Tracer: recorder}}
+			p := &tracedPrecompile{ABI: parsed, fail: tc.fail}
+
+			_, remaining, runErr := evm.RunPrecompiledContract(p, AccountRef(to), tc.input, 1_000, nil, false)
+			if len(recorder.calls) != tc.calls {
+				t.Fatalf("expected %d traced calls, got %d", tc.calls, len(recorder.calls))
+			}
+			if tc.calls == 0 {
+				return
+			}
+
+			call := recorder.calls[0]
+			if call.Method != "transfer" || call.Signature != "transfer(address,uint256,bytes)" {
+				t.Fatalf("unexpected method %s (%s)", call.Method, call.Signature)
+			}
+			if call.GasUsed != 1_000-remaining {
+				t.Fatalf("expected gas used %d, got %d", 1_000-remaining, call.GasUsed)
+			}
+			if !errors.Is(call.Err, runErr) {
+				t.Fatalf("expected error %v, got %v", runErr, call.Err)
+			}
+
+			bz, err := json.Marshal(call.Args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := `[{"name":"to","type":"address","value":"` + to.Hex() + `"},` +
+				`{"name":"amount","type":"uint256","value":"1000"},` +
+				`{"name":"memo","type":"bytes","value":"0xcafe"}]`
+			if string(bz) != expected {
+				t.Fatalf("unexpected args:\nwant %s\ngot  %s", expected, bz)
+			}
+		})
+	}
+}
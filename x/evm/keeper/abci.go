@@ -14,6 +14,9 @@ import (
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	logger := ctx.Logger().With("begin_block", "evm")
 
+	// discard the traces of an aborted execution of the block
+	k.resetTraceStore()
+
 	// Base fee is already set on FeeMarket BeginBlock
 	// that runs before this one
 	// We emit this event on the EVM and FeeMarket modules
@@ -35,8 +38,8 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// KVStore, and prunes the receipts out of the retention window. The EVM end block logic doesn't
+// update the validator set, thus it returns an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
@@ -44,5 +47,15 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
 
+	k.pruneReceipts(infCtx)
+
+	k.emitBlockTelemetry()
+
+	return nil
+}
+
+// Precommit persists the node-local data of the block that is being committed.
+func (k *Keeper) Precommit(ctx sdk.Context) error {
+	k.commitTraceStore(ctx)
 	return nil
 }
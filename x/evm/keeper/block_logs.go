@@ -0,0 +1,53 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// EmitBlockLogs emits the given EVM logs outside of any transaction, so that
+// the changes made to the state of the precompiled contracts by the block
+// logic, e.g. the store migrations of an upgrade, are served by the JSON-RPC
+// log filters like the ones of the ethereum txs. The logs have an empty tx
+// hash, are indexed after the logs previously emitted in the block and are
+// added to the block bloom.
+func (k Keeper) EmitBlockLogs(ctx sdk.Context, logs []*ethtypes.Log) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	txConfig := k.TxConfig(ctx, common.Hash{})
+
+	txLogAttrs := make([]sdk.Attribute, len(logs))
+	for i, log := range logs {
+		log.BlockNumber = uint64(ctx.BlockHeight()) //nolint:gosec // G115
+		log.BlockHash = txConfig.BlockHash
+		log.TxHash = txConfig.TxHash
+		log.TxIndex = txConfig.TxIndex
+		log.Index = txConfig.LogIndex + uint(i)
+
+		value, err := json.Marshal(types.NewLogFromEth(log))
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to encode log")
+		}
+		txLogAttrs[i] = sdk.NewAttribute(types.AttributeKeyTxLog, string(value))
+	}
+
+	bloom := k.GetBlockBloomTransient(ctx)
+	bloom.Or(bloom, big.NewInt(0).SetBytes(ethtypes.LogsBloom(logs)))
+	k.SetBlockBloomTransient(ctx, bloom)
+	k.SetLogSizeTransient(ctx, uint64(txConfig.LogIndex)+uint64(len(logs)))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeTxLog, txLogAttrs...))
+
+	return nil
+}
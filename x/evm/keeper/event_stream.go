@@ -0,0 +1,303 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmostypes "github.com/evmos/evmos/v20/types"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// eventStreamBuffer is the number of blocks buffered for each subscriber of
+// the event stream. The subscribers that fall further behind are disconnected.
+const eventStreamBuffer = 100
+
+var _ storetypes.ABCIListener = (*EventStream)(nil)
+
+// EventStream publishes the receipts and logs of the ethereum txs of the
+// committed blocks to the subscribers of the SubscribeEvents query. It's an
+// ABCI listener of the app streaming manager: the events of a block are
+// collected on FinalizeBlock and published once the block is committed.
+type EventStream struct {
+	txDecoder sdk.TxDecoder
+	logger    log.Logger
+
+	mu            sync.Mutex
+	subscriptions map[*eventSubscription]struct{}
+	// pending holds the events of the finalized block until it's committed
+	pending *types.QuerySubscribeEventsResponse
+}
+
+// eventSubscription is a subscriber of the event stream.
+type eventSubscription struct {
+	filter eventFilter
+	// events is closed when the subscriber is disconnected for falling behind
+	events chan *types.QuerySubscribeEventsResponse
+}
+
+// WithEventStream enables the event stream, which serves the SubscribeEvents
+// query. The stream must be added to the ABCI listeners of the app streaming
+// manager to receive the committed blocks.
+func (k *Keeper) WithEventStream(txDecoder sdk.TxDecoder, logger log.Logger) *Keeper {
+	k.eventStream = &EventStream{
+		txDecoder:     txDecoder,
+		logger:        logger.With("module", types.ModuleName, "service", "event-stream"),
+		subscriptions: make(map[*eventSubscription]struct{}),
+	}
+	return k
+}
+
+// EventStream returns the event stream, or nil if it's disabled.
+func (k Keeper) EventStream() *EventStream {
+	return k.eventStream
+}
+
+// ListenFinalizeBlock collects the receipts of the ethereum txs of the
+// finalized block. The txs that failed before their execution (e.g. on the
+// ante handler) have no receipt.
+func (s *EventStream) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	events := &types.QuerySubscribeEventsResponse{
+		Height:    req.Height,
+		BlockHash: common.BytesToHash(req.Hash).Hex(),
+	}
+
+	var txIndex uint64
+	for i, txBz := range req.Txs {
+		if i >= len(res.TxResults) || !res.TxResults[i].IsOK() {
+			continue
+		}
+
+		receipts, err := s.decodeReceipts(txBz, res.TxResults[i].Data)
+		if err != nil {
+			// the stream is not part of the consensus, so the failures are logged
+			s.logger.Error("failed to decode the tx receipts", "height", req.Height, "index", i, "error", err.Error())
+			continue
+		}
+
+		for _, receipt := range receipts {
+			receipt.TxIndex = txIndex
+			txIndex++
+			events.Receipts = append(events.Receipts, receipt)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = events
+	return nil
+}
+
+// ListenCommit publishes the events of the committed block to the subscribers.
+func (s *EventStream) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events := s.pending
+	s.pending = nil
+	if events == nil {
+		return nil
+	}
+
+	for sub := range s.subscriptions {
+		filtered := sub.filter.apply(events)
+		if filtered == nil {
+			continue
+		}
+
+		select {
+		case sub.events <- filtered:
+		default:
+			s.logger.Info("disconnecting slow subscriber", "height", events.Height)
+			delete(s.subscriptions, sub)
+			close(sub.events)
+		}
+	}
+
+	return nil
+}
+
+// subscribe adds a subscriber with the given filter to the stream.
+func (s *EventStream) subscribe(filter eventFilter) *eventSubscription {
+	sub := &eventSubscription{
+		filter: filter,
+		events: make(chan *types.QuerySubscribeEventsResponse, eventStreamBuffer),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscriptions[sub] = struct{}{}
+	return sub
+}
+
+// unsubscribe removes the subscriber from the stream.
+func (s *EventStream) unsubscribe(sub *eventSubscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subscriptions, sub)
+}
+
+// decodeReceipts returns the receipts of the ethereum txs of a delivered tx
+// from its messages and their responses.
+func (s *EventStream) decodeReceipts(txBz, data []byte) ([]*types.Receipt, error) {
+	tx, err := s.txDecoder(txBz)
+	if err != nil {
+		return nil, err
+	}
+
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(data, &txMsgData); err != nil {
+		return nil, err
+	}
+
+	var receipts []*types.Receipt
+	for i, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*types.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+		if i >= len(txMsgData.MsgResponses) {
+			return nil, fmt.Errorf("missing response of message %d", i)
+		}
+
+		var res types.MsgEthereumTxResponse
+		if err := proto.Unmarshal(txMsgData.MsgResponses[i].Value, &res); err != nil {
+			return nil, err
+		}
+
+		ethTx := ethMsg.AsTransaction()
+		from := common.BytesToAddress(ethMsg.GetFrom())
+		receipt := &types.Receipt{
+			TxHash:  res.Hash,
+			From:    from.Hex(),
+			GasUsed: res.GasUsed,
+			Status:  ethtypes.ReceiptStatusSuccessful,
+			Logs:    res.Logs,
+		}
+
+		if res.Failed() {
+			receipt.Status = ethtypes.ReceiptStatusFailed
+			receipt.VmError = res.VmError
+		}
+
+		if to := ethTx.To(); to != nil {
+			receipt.To = to.Hex()
+		} else {
+			receipt.ContractAddress = crypto.CreateAddress(from, ethTx.Nonce()).Hex()
+		}
+
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
+// eventFilter selects the logs and receipts streamed to a subscriber.
+type eventFilter struct {
+	addresses []common.Address
+	topics    [][]common.Hash
+	receipts  bool
+}
+
+// newEventFilter returns the filter of the given SubscribeEvents request.
+func newEventFilter(req *types.QuerySubscribeEventsRequest) (eventFilter, error) {
+	filter := eventFilter{receipts: req.Receipts}
+
+	for _, address := range req.Addresses {
+		if err := evmostypes.ValidateAddress(address); err != nil {
+			return eventFilter{}, err
+		}
+		filter.addresses = append(filter.addresses, common.HexToAddress(address))
+	}
+
+	for _, topicFilter := range req.Topics {
+		hashes := make([]common.Hash, 0, len(topicFilter.Topics))
+		for _, topic := range topicFilter.Topics {
+			bz, err := hexutil.Decode(topic)
+			if err != nil || len(bz) != common.HashLength {
+				return eventFilter{}, fmt.Errorf("invalid topic %s", topic)
+			}
+			hashes = append(hashes, common.BytesToHash(bz))
+		}
+		filter.topics = append(filter.topics, hashes)
+	}
+
+	return filter, nil
+}
+
+// apply returns the events of a block that match the filter, or nil if none
+// of them does.
+func (f eventFilter) apply(events *types.QuerySubscribeEventsResponse) *types.QuerySubscribeEventsResponse {
+	filtered := &types.QuerySubscribeEventsResponse{
+		Height:    events.Height,
+		BlockHash: events.BlockHash,
+	}
+
+	for _, receipt := range events.Receipts {
+		if f.receipts && f.matchReceipt(receipt) {
+			filtered.Receipts = append(filtered.Receipts, receipt)
+		}
+		for _, log := range receipt.Logs {
+			if f.matchLog(log) {
+				filtered.Logs = append(filtered.Logs, log)
+			}
+		}
+	}
+
+	if len(filtered.Logs) == 0 && len(filtered.Receipts) == 0 {
+		return nil
+	}
+	return filtered
+}
+
+// matchAddress returns true if any of the given hex addresses is subscribed.
+func (f eventFilter) matchAddress(addresses ...string) bool {
+	if len(f.addresses) == 0 {
+		return true
+	}
+
+	for _, address := range addresses {
+		if address != "" && slices.Contains(f.addresses, common.HexToAddress(address)) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchReceipt returns true if the sender, the recipient or the created
+// contract of the receipt is subscribed.
+func (f eventFilter) matchReceipt(receipt *types.Receipt) bool {
+	return f.matchAddress(receipt.From, receipt.To, receipt.ContractAddress)
+}
+
+// matchLog returns true if the log is emitted by a subscribed address and its
+// topics match the topic filters at their positions.
+func (f eventFilter) matchLog(log *types.Log) bool {
+	if !f.matchAddress(log.Address) {
+		return false
+	}
+
+	if len(f.topics) > len(log.Topics) {
+		return false
+	}
+
+	for i, hashes := range f.topics {
+		if len(hashes) > 0 && !slices.Contains(hashes, common.HexToHash(log.Topics[i])) {
+			return false
+		}
+	}
+	return true
+}
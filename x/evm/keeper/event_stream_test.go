@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc"
+
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// eventsServer is a SubscribeEvents stream that collects the sent events.
+type eventsServer struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	events chan *types.QuerySubscribeEventsResponse
+	// ready is closed once the handler waits for the events, after subscribing
+	ready     chan struct{}
+	readyOnce sync.Once
+}
+
+func newEventsServer(ctx context.Context) *eventsServer {
+	return &eventsServer{
+		ctx:    ctx,
+		events: make(chan *types.QuerySubscribeEventsResponse, 1),
+		ready:  make(chan struct{}),
+	}
+}
+
+func (s *eventsServer) Context() context.Context {
+	s.readyOnce.Do(func() { close(s.ready) })
+	return s.ctx
+}
+
+func (s *eventsServer) Send(res *types.QuerySubscribeEventsResponse) error {
+	s.events <- res
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestSubscribeEvents() {
+	suite.SetupTest()
+	k := suite.network.App.EvmKeeper
+
+	err := k.SubscribeEvents(&types.QuerySubscribeEventsRequest{}, newEventsServer(context.Background()))
+	suite.Require().ErrorContains(err, "disabled")
+
+	k.WithEventStream(suite.network.App.GetTxConfig().TxDecoder(), log.NewNopLogger())
+
+	senderKey := suite.keyring.GetKey(0)
+	recipient := suite.keyring.GetAddr(1)
+	tx, err := suite.factory.GenerateSignedEthTx(senderKey.Priv, types.EvmTxArgs{To: &recipient, Amount: big.NewInt(1)})
+	suite.Require().NoError(err)
+	txBz, err := suite.factory.EncodeTx(tx)
+	suite.Require().NoError(err)
+	msg, ok := tx.GetMsgs()[0].(*types.MsgEthereumTx)
+	suite.Require().True(ok)
+
+	contract := utiltx.GenerateAddress()
+	topic := common.BytesToHash([]byte("topic"))
+	logs := []*types.Log{
+		{Address: contract.Hex(), Topics: []string{topic.Hex()}, TxHash: msg.Hash},
+		{Address: recipient.Hex(), TxHash: msg.Hash, Index: 1},
+	}
+	msgRes, err := codectypes.NewAnyWithValue(&types.MsgEthereumTxResponse{Hash: msg.Hash, GasUsed: 21000, Logs: logs})
+	suite.Require().NoError(err)
+	data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{msgRes}})
+	suite.Require().NoError(err)
+
+	req := abci.RequestFinalizeBlock{Height: 10, Hash: common.BytesToHash([]byte("block")).Bytes(), Txs: [][]byte{txBz}}
+	res := abci.ResponseFinalizeBlock{TxResults: []*abci.ExecTxResult{{Code: 0, Data: data}}}
+
+	testCases := []struct {
+		name        string
+		req         *types.QuerySubscribeEventsRequest
+		expErr      bool
+		expLogs     []*types.Log
+		expReceipts int
+	}{
+		{
+			"fail - invalid address",
+			&types.QuerySubscribeEventsRequest{Addresses: []string{"invalid"}},
+			true, nil, 0,
+		},
+		{
+			"fail - invalid topic",
+			&types.QuerySubscribeEventsRequest{Topics: []types.TopicFilter{{Topics: []string{"0x01"}}}},
+			true, nil, 0,
+		},
+		{
+			"pass - all the logs",
+			&types.QuerySubscribeEventsRequest{},
+			false, logs, 0,
+		},
+		{
+			"pass - logs and receipts of an address",
+			&types.QuerySubscribeEventsRequest{Addresses: []string{recipient.Hex()}, Receipts: true},
+			false, logs[1:], 1,
+		},
+		{
+			"pass - logs by topic",
+			&types.QuerySubscribeEventsRequest{Topics: []types.TopicFilter{{Topics: []string{topic.Hex()}}}},
+			false, logs[:1], 0,
+		},
+		{
+			"pass - no matching events",
+			&types.QuerySubscribeEventsRequest{Addresses: []string{utiltx.GenerateAddress().Hex()}, Receipts: true},
+			false, nil, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			server := newEventsServer(ctx)
+
+			errCh := make(chan error, 1)
+			go func() { errCh <- k.SubscribeEvents(tc.req, server) }()
+
+			if tc.expErr {
+				suite.Require().Error(<-errCh)
+				return
+			}
+
+			<-server.ready
+			suite.Require().NoError(k.EventStream().ListenFinalizeBlock(ctx, req, res))
+			suite.Require().NoError(k.EventStream().ListenCommit(ctx, abci.ResponseCommit{}, nil))
+
+			if len(tc.expLogs) == 0 && tc.expReceipts == 0 {
+				cancel()
+				suite.Require().NoError(<-errCh)
+				suite.Require().Empty(server.events)
+				return
+			}
+
+			events := <-server.events
+			cancel()
+			suite.Require().NoError(<-errCh)
+
+			suite.Require().Equal(req.Height, events.Height)
+			suite.Require().Equal(common.BytesToHash(req.Hash).Hex(), events.BlockHash)
+			suite.Require().Equal(tc.expLogs, events.Logs)
+			suite.Require().Len(events.Receipts, tc.expReceipts)
+			for _, receipt := range events.Receipts {
+				suite.Require().Equal(msg.Hash, receipt.TxHash)
+				suite.Require().Equal(senderKey.Addr.Hex(), receipt.From)
+				suite.Require().Equal(recipient.Hex(), receipt.To)
+				suite.Require().Equal(uint64(1), receipt.Status)
+			}
+		})
+	}
+}
@@ -42,6 +42,31 @@ func CheckSenderBalance(
 	return nil
 }
 
+// CheckSponsoredSenderBalance validates that the sender of a sponsored
+// transaction has enough balance to transfer the tx value, as the fees are
+// paid by the fee payer.
+func CheckSponsoredSenderBalance(
+	balance sdkmath.Int,
+	txData types.TxData,
+) error {
+	value := txData.GetValue()
+
+	if value.Sign() < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidCoins,
+			"tx value (%s) is negative and invalid", value,
+		)
+	}
+
+	if balance.IsNegative() || balance.BigInt().Cmp(value) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"sender balance < tx value (%s < %s)", balance, value,
+		)
+	}
+	return nil
+}
+
 // DeductTxCostsFromUserBalance deducts the fees from the user balance.
 func (k *Keeper) DeductTxCostsFromUserBalance(
 	ctx sdk.Context,
@@ -91,6 +116,10 @@ func VerifyFee(
 		)
 	}
 
+	if setCodeTx, ok := txData.(*types.SetCodeTx); ok {
+		intrinsicGas += setCodeTx.AuthorizationGas()
+	}
+
 	// intrinsic gas verification during CheckTx
 	if isCheckTx && gasLimit < intrinsicGas {
 		return nil, errorsmod.Wrapf(
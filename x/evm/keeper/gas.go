@@ -5,6 +5,7 @@ package keeper
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -23,7 +24,17 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	homestead := cfg.IsHomestead(height)
 	istanbul := cfg.IsIstanbul(height)
 
-	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	gas, err := core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	if err != nil {
+		return 0, err
+	}
+
+	// the authorizations of the EIP-7702 set code transactions are charged
+	// as the creation of an account
+	if setCodeMsg, ok := msg.(types.SetCodeMessage); ok {
+		gas += uint64(len(setCodeMsg.AuthList)) * types.PerEmptyAccountCost
+	}
+	return gas, nil
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
@@ -31,6 +42,20 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
+	return k.refundGas(ctx, msg.From(), msg, leftoverGas, denom, nil)
+}
+
+// refundGas transfers the leftover gas of the message to the given recipient,
+// which is the account that paid the transaction fees. The refund is converted
+// into the fee token when the fees were not paid in the EVM coin.
+func (k *Keeper) refundGas(
+	ctx sdk.Context,
+	recipient common.Address,
+	msg core.Message,
+	leftoverGas uint64,
+	denom string,
+	feeToken *sdk.DecCoin,
+) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
 
@@ -40,10 +65,14 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		return errorsmod.Wrapf(types.ErrInvalidRefund, "refunded amount value cannot be negative %d", remaining.Int64())
 	case 1:
 		// positive amount refund
-		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
+		refundedCoin := sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))
+		if feeToken != nil {
+			refundedCoin = types.ConvertToFeeToken(remaining, *feeToken, false)
+		}
+		refundedCoins := sdk.Coins{refundedCoin}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
-		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		// refund to the fee payer from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipient.Bytes(), refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
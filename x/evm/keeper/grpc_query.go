@@ -24,6 +24,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 
@@ -238,6 +239,15 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	if len(req.Overrides) > 0 {
+		ctx, _ = ctx.CacheContext()
+		if err := k.ApplyStateOverrides(ctx, req.Overrides, txConfig); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
@@ -247,8 +257,6 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
-
 	// pass false to not commit StateDB
 	res, err := k.ApplyMessageWithConfig(ctx, msg, nil, false, cfg, txConfig)
 	if err != nil {
@@ -258,6 +266,85 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	return res, nil
 }
 
+// CreateAccessList implements eth_createAccessList rpc api. It executes the call
+// with an access list tracer until the access list used for the execution
+// matches the one collected by the tracer, as the access list changes the gas
+// available to the call and thus its execution.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	if len(req.Overrides) > 0 {
+		ctx, _ = ctx.CacheContext()
+		if err := k.ApplyStateOverrides(ctx, req.Overrides, txConfig); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	to := crypto.CreateAddress(from, nonce)
+	if args.To != nil {
+		to = *args.To
+	}
+
+	// NOTE: only the Ethereum precompiles are warm before the execution, so the
+	// calls to the static precompiles of the chain are kept in the access list
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil)
+	precompiles := withEIPPrecompiles(vm.DefaultActivePrecompiles(rules), cfg.Params.EIPsAt(ctx.BlockHeight()))
+
+	var prevAccessList ethtypes.AccessList
+	if args.AccessList != nil {
+		prevAccessList = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(prevAccessList, from, to, precompiles)
+
+	for {
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+		// pass false to not commit StateDB
+		res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, false, cfg, txConfig)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &types.CreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*types.EstimateGasResponse, error) {
 	return k.EstimateGasInternal(c, req, types.RPC)
@@ -316,12 +403,19 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
 
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	if len(req.Overrides) > 0 {
+		ctx, _ = ctx.CacheContext()
+		if err := k.ApplyStateOverrides(ctx, req.Overrides, txConfig); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
-	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
-
 	// convert the tx args to an ethereum message
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
 	if err != nil {
@@ -451,6 +545,16 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
 	}
 
+	// serve the call traces persisted by the live tracer without re-executing the tx
+	if req.Msg != nil && req.TraceConfig != nil && req.TraceConfig.Tracer == CallTracer &&
+		(req.TraceConfig.TracerJsonConfig == "" || req.TraceConfig.TracerJsonConfig == "{}") {
+		if trace, found := k.GetStoredTrace(req.Msg.TxHash()); found {
+			return &types.QueryTraceTxResponse{
+				Data: trace,
+			}, nil
+		}
+	}
+
 	// get the context of block beginning
 	contextHeight := req.BlockNumber
 	if contextHeight < 1 {
@@ -488,12 +592,11 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	// and avoid stacking the gas used of every predecessor in the same gas meter
 
 	for i, tx := range req.Predecessors {
-		ethTx := tx.AsTransaction()
-		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		msg, err := tx.AsMessage(signer, cfg.BaseFee)
 		if err != nil {
 			continue
 		}
-		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxHash = tx.TxHash()
 		txConfig.TxIndex = uint(i) // #nosec G115
 		// reset gas meter for each transaction
 		ctx = evmante.BuildEvmExecutionCtx(ctx).
@@ -505,8 +608,8 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		txConfig.LogIndex += uint(len(rsp.Logs))
 	}
 
-	tx := req.Msg.AsTransaction()
-	txConfig.TxHash = tx.Hash()
+	tx := req.Msg
+	txConfig.TxHash = tx.TxHash()
 	if len(req.Predecessors) > 0 {
 		txConfig.TxIndex++
 	}
@@ -579,19 +682,22 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
 
-	for i, tx := range req.Txs {
-		result := types.TxTraceResult{}
-		ethTx := tx.AsTransaction()
-		txConfig.TxHash = ethTx.Hash()
-		txConfig.TxIndex = uint(i) // #nosec G115
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
-		if err != nil {
-			result.Error = err.Error()
-		} else {
-			txConfig.LogIndex = logIndex
-			result.Result = traceResult
+	if req.Concurrency > 1 && txsLength > 1 {
+		results = k.traceTxsConcurrently(ctx, cfg, txConfig, signer, req.Txs, req.TraceConfig, int(req.Concurrency))
+	} else {
+		for i, tx := range req.Txs {
+			result := types.TxTraceResult{}
+			txConfig.TxHash = tx.TxHash()
+			txConfig.TxIndex = uint(i) // #nosec G115
+			traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, true, nil)
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				txConfig.LogIndex = logIndex
+				result.Result = traceResult
+			}
+			results = append(results, &result)
 		}
-		results = append(results, &result)
 	}
 
 	resultData, err := json.Marshal(results)
@@ -610,7 +716,7 @@ func (k *Keeper) traceTx(
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
 	signer ethtypes.Signer,
-	tx *ethtypes.Transaction,
+	tx *types.MsgEthereumTx,
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
@@ -724,3 +830,54 @@ func (k Keeper) Config(_ context.Context, _ *types.QueryConfigRequest) (*types.Q
 
 	return &types.QueryConfigResponse{Config: config}, nil
 }
+
+// Receipt implements the Query/Receipt gRPC method
+func (k Keeper) Receipt(c context.Context, req *types.QueryReceiptRequest) (*types.QueryReceiptResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	receipt, found := k.GetReceipt(ctx, common.HexToHash(req.TxHash))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "receipt of tx %s not found", req.TxHash)
+	}
+
+	return &types.QueryReceiptResponse{Receipt: receipt}, nil
+}
+
+// SubscribeEvents implements the Query/SubscribeEvents gRPC streaming method.
+// It sends the matching events of each committed block until the client
+// cancels the stream or falls too far behind.
+func (k Keeper) SubscribeEvents(req *types.QuerySubscribeEventsRequest, stream types.Query_SubscribeEventsServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if k.eventStream == nil {
+		return status.Error(codes.Unavailable, "the event stream is disabled on this node")
+	}
+
+	filter, err := newEventFilter(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sub := k.eventStream.subscribe(filter)
+	defer k.eventStream.unsubscribe(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case events, ok := <-sub.events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "subscriber disconnected for falling behind the committed blocks")
+			}
+			if err := stream.Send(events); err != nil {
+				return err
+			}
+		}
+	}
+}
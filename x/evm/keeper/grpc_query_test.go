@@ -1311,6 +1311,37 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 			expPass:       true,
 			traceResponse: "[{\"result\":{\"gas\":34780,\"failed\":false,\"returnValue\":\"0000000000000000000000000000000000000000000000000000000000000001\",\"structLogs\":[{\"pc\":0,\"op\":\"PU",
 		},
+		{
+			msg: "concurrent tracer with multiple transactions",
+			getRequest: func() types.QueryTraceBlockRequest {
+				defaultReq := getDefaultTraceBlockRequest(suite.network)
+				defaultReq.Concurrency = 4
+				return defaultReq
+			},
+			getAdditionalTxs: func() []*types.MsgEthereumTx {
+				// Create predecessor tx
+				// Use different address to avoid nonce collision
+				senderKey := suite.keyring.GetKey(1)
+				contractAddr, err := deployErc20Contract(senderKey, suite.factory)
+				suite.Require().NoError(err)
+
+				err = suite.network.NextBlock()
+				suite.Require().NoError(err)
+
+				firstTransferMessage, err := executeTransferCall(
+					transferParams{
+						senderKey:     suite.keyring.GetKey(1),
+						contractAddr:  contractAddr,
+						recipientAddr: hardcodedTransferRecipient,
+					},
+					suite.factory,
+				)
+				suite.Require().NoError(err)
+				return []*types.MsgEthereumTx{firstTransferMessage}
+			},
+			expPass:       true,
+			traceResponse: "[{\"result\":{\"gas\":34780,\"failed\":false,\"returnValue\":\"0000000000000000000000000000000000000000000000000000000000000001\",\"structLogs\":[{\"pc\":0,\"op\":\"PU",
+		},
 		{
 			msg: "invalid trace config - Negative Limit",
 			getRequest: func() types.QueryTraceBlockRequest {
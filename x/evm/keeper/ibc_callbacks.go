@@ -0,0 +1,181 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	callbacktypes "github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v20/precompiles/callbacks"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// MaxIBCCallbackGas defines the maximum gas that the contracts can use when
+// they are called back on the lifecycle of their IBC packets.
+const MaxIBCCallbackGas = uint64(1_000_000)
+
+var _ callbacktypes.ContractKeeper = (*Keeper)(nil)
+
+// IBCSendPacketCallback implements the ADR-8 ContractKeeper interface. The
+// contracts aren't called when the packet is sent, so it only checks that the
+// callback contract is the sender of the packet.
+func (k *Keeper) IBCSendPacketCallback(
+	cachedCtx sdk.Context,
+	_ string,
+	_ string,
+	_ clienttypes.Height,
+	_ uint64,
+	_ []byte,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	_, err := k.getCallbackContract(cachedCtx, contractAddress, packetSenderAddress)
+	return err
+}
+
+// IBCOnAcknowledgementPacketCallback implements the ADR-8 ContractKeeper
+// interface. It calls the onPacketAcknowledgement method of the contract that
+// sent the packet.
+func (k *Keeper) IBCOnAcknowledgementPacketCallback(
+	cachedCtx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	contract, err := k.getCallbackContract(cachedCtx, contractAddress, packetSenderAddress)
+	if err != nil {
+		return err
+	}
+
+	return k.callContract(
+		cachedCtx,
+		contract,
+		callbacks.OnPacketAcknowledgementMethod,
+		packet.SourceChannel,
+		packet.SourcePort,
+		packet.Sequence,
+		packet.Data,
+		acknowledgement,
+	)
+}
+
+// IBCOnTimeoutPacketCallback implements the ADR-8 ContractKeeper interface. It
+// calls the onPacketTimeout method of the contract that sent the packet.
+func (k *Keeper) IBCOnTimeoutPacketCallback(
+	cachedCtx sdk.Context,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	contract, err := k.getCallbackContract(cachedCtx, contractAddress, packetSenderAddress)
+	if err != nil {
+		return err
+	}
+
+	return k.callContract(
+		cachedCtx,
+		contract,
+		callbacks.OnPacketTimeoutMethod,
+		packet.SourceChannel,
+		packet.SourcePort,
+		packet.Sequence,
+		packet.Data,
+	)
+}
+
+// IBCReceivePacketCallback implements the ADR-8 ContractKeeper interface. The
+// destination callbacks aren't supported, so it always returns an error, which
+// is emitted in the callback event without affecting the acknowledgement.
+func (k *Keeper) IBCReceivePacketCallback(
+	_ sdk.Context,
+	_ ibcexported.PacketI,
+	_ ibcexported.Acknowledgement,
+	contractAddress string,
+) error {
+	return errorsmod.Wrapf(types.ErrInvalidIBCCallback, "destination callbacks are not supported: %s", contractAddress)
+}
+
+// getCallbackContract returns the address of the contract to be called back. The
+// contract must be the sender of the packet, so that the callbacks can't be
+// requested on behalf of other accounts.
+func (k *Keeper) getCallbackContract(ctx sdk.Context, contractAddress, packetSenderAddress string) (common.Address, error) {
+	if !common.IsHexAddress(contractAddress) {
+		return common.Address{}, errorsmod.Wrapf(types.ErrInvalidIBCCallback, "invalid contract address %s", contractAddress)
+	}
+	contract := common.HexToAddress(contractAddress)
+
+	sender, err := sdk.AccAddressFromBech32(packetSenderAddress)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrapf(types.ErrInvalidIBCCallback, "invalid packet sender address %s: %s", packetSenderAddress, err)
+	}
+
+	if !bytes.Equal(sender.Bytes(), contract.Bytes()) {
+		return common.Address{}, errorsmod.Wrapf(
+			types.ErrInvalidIBCCallback,
+			"contract %s is not the sender of the packet %s", contract, packetSenderAddress,
+		)
+	}
+
+	account := k.GetAccount(ctx, contract)
+	if account == nil || !account.IsContract() {
+		return common.Address{}, errorsmod.Wrapf(types.ErrInvalidIBCCallback, "account %s is not a contract", contract)
+	}
+
+	return contract, nil
+}
+
+// callContract calls the callback method of the contract from the evm module
+// account, with the gas left in the context, which is limited by the callbacks
+// middleware. The gas used by the call is consumed from the context.
+func (k *Keeper) callContract(ctx sdk.Context, contract common.Address, method string, args ...interface{}) error {
+	callbacksABI, err := callbacks.LoadABI()
+	if err != nil {
+		return err
+	}
+
+	data, err := callbacksABI.Pack(method, args...)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrABIPack, err.Error())
+	}
+
+	from := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+	msg := ethtypes.NewMessage(
+		from,
+		&contract,
+		k.GetNonce(ctx, from),
+		big.NewInt(0),                 // amount
+		ctx.GasMeter().GasRemaining(), // gasLimit
+		big.NewInt(0),                 // gasFeeCap
+		big.NewInt(0),                 // gasTipCap
+		big.NewInt(0),                 // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	res, err := k.ApplyMessage(ctx, msg, types.NewNoOpTracer(), true)
+	if err != nil {
+		return err
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "evm ibc callback")
+
+	if res.Failed() {
+		return errorsmod.Wrapf(types.ErrVMExecution, "%s callback failed on contract %s: %s", method, contract, res.VmError)
+	}
+
+	return nil
+}
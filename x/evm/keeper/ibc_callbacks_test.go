@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/evm/keeper"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestIBCOnTimeoutPacketCallback() {
+	noopContract := common.BytesToAddress([]byte("noop"))
+	revertContract := common.BytesToAddress([]byte("revert"))
+	eoa := suite.keyring.GetAddr(0)
+
+	packet := channeltypes.NewPacket(
+		[]byte("data"), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0,
+	)
+
+	testCases := []struct {
+		name        string
+		contract    string
+		sender      string
+		errContains string
+	}{
+		{
+			"fail - invalid contract address",
+			"invalid",
+			sdk.AccAddress(noopContract.Bytes()).String(),
+			"invalid contract address",
+		},
+		{
+			"fail - invalid packet sender",
+			noopContract.Hex(),
+			"invalid",
+			"invalid packet sender address",
+		},
+		{
+			"fail - contract is not the packet sender",
+			noopContract.Hex(),
+			sdk.AccAddress(eoa.Bytes()).String(),
+			"is not the sender of the packet",
+		},
+		{
+			"fail - packet sender is not a contract",
+			eoa.Hex(),
+			sdk.AccAddress(eoa.Bytes()).String(),
+			"is not a contract",
+		},
+		{
+			"fail - contract reverts",
+			revertContract.Hex(),
+			sdk.AccAddress(revertContract.Bytes()).String(),
+			evmtypes.ErrVMExecution.Error(),
+		},
+		{
+			"pass",
+			noopContract.Hex(),
+			sdk.AccAddress(noopContract.Bytes()).String(),
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			vmdb := suite.StateDB()
+			// STOP
+			vmdb.SetCode(noopContract, []byte{0x00})
+			// PUSH1 0x00 PUSH1 0x00 REVERT
+			vmdb.SetCode(revertContract, []byte{0x60, 0x00, 0x60, 0x00, 0xfd})
+			suite.Require().NoError(vmdb.Commit())
+
+			// the callbacks middleware limits the gas of the callbacks
+			ctx := suite.network.GetContext().WithGasMeter(storetypes.NewGasMeter(keeper.MaxIBCCallbackGas))
+
+			err := suite.network.App.EvmKeeper.IBCOnTimeoutPacketCallback(ctx, packet, nil, tc.contract, tc.sender)
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NotZero(ctx.GasMeter().GasConsumed())
+		})
+	}
+}
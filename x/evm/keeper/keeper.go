@@ -58,13 +58,28 @@ type Keeper struct {
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
 
+	// gasReport defines if the transaction responses include the breakdown of the consumed gas
+	gasReport bool
+
+	// telemetry collects the execution statistics of the block txs, if enabled
+	telemetry *blockTelemetry
+	// traceStore persists the call traces of the delivered txs, if enabled
+	traceStore *traceStore
+	// eventStream publishes the events of the committed blocks, if enabled
+	eventStream *EventStream
+
+	// parallelWorkers defines the number of workers of the parallel pre-execution of the block txs
+	parallelWorkers int
+	// preExecution holds the txs pre-executed for the current block
+	preExecution *preExecutionCache
+
 	// Legacy subspace
 	ss paramstypes.Subspace
 
-	// precompiles defines the map of all available precompiled smart contracts.
+	// precompiles defines the registry of all available precompiled smart contracts.
 	// Some of these precompiled contracts might not be active depending on the EVM
 	// parameters.
-	precompiles map[common.Address]vm.PrecompiledContract
+	precompiles *PrecompileRegistry
 }
 
 // NewKeeper generates new evm module keeper
@@ -109,6 +124,14 @@ func NewKeeper(
 	}
 }
 
+// WithGasReport enables the breakdown of the gas consumed by the ethereum
+// transactions. The report is a node-level setting, so it's emitted as events
+// and never included in the delivered transaction results.
+func (k *Keeper) WithGasReport(enabled bool) *Keeper {
+	k.gasReport = enabled
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
@@ -170,6 +193,50 @@ func (k Keeper) GetTxIndexTransient(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixTransientTxIndex))
 }
 
+// SetTxFeePayerTransient sets the account that paid the fees of the ethereum
+// transaction with the given hash when it's not its sender, so the leftover
+// gas is refunded to it.
+func (k Keeper) SetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash, feePayer common.Address) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	store.Set(txHash.Bytes(), feePayer.Bytes())
+}
+
+// GetTxFeePayerTransient returns the account that paid the fees of the ethereum
+// transaction with the given hash, if it's not its sender.
+func (k Keeper) GetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash) (common.Address, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
+
+// SetTxFeeTokenTransient sets the price of the EVM coin in the token used to
+// pay the fees of the ethereum transaction with the given hash, so the
+// leftover gas is refunded in the same token.
+func (k Keeper) SetTxFeeTokenTransient(ctx sdk.Context, txHash common.Hash, price sdk.DecCoin) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeeToken)
+	store.Set(txHash.Bytes(), []byte(price.String()))
+}
+
+// GetTxFeeTokenTransient returns the price of the EVM coin in the token used
+// to pay the fees of the ethereum transaction with the given hash, if the fees
+// were not paid in the EVM coin.
+func (k Keeper) GetTxFeeTokenTransient(ctx sdk.Context, txHash common.Hash) (sdk.DecCoin, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeeToken)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return sdk.DecCoin{}, false
+	}
+
+	price, err := sdk.ParseDecCoin(string(bz))
+	if err != nil {
+		panic(err)
+	}
+	return price, true
+}
+
 // ----------------------------------------------------------------------------
 // Log
 // ----------------------------------------------------------------------------
@@ -289,7 +356,7 @@ func (k Keeper) GetMinGasMultiplier(ctx sdk.Context) math.LegacyDec {
 // GetMinGasPrice returns the MinGasPrice param from the fee market module
 // adapted according to the evm denom decimals
 func (k Keeper) GetMinGasPrice(ctx sdk.Context) math.LegacyDec {
-	return k.feeMarketWrapper.GetParams(ctx).MinGasPrice
+	return k.feeMarketWrapper.GetMinGasPrice(ctx)
 }
 
 // ResetTransientGasUsed reset gas used to prepare for execution of current cosmos tx, called in ante handler.
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -17,8 +18,10 @@ import (
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/go-metrics"
 
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -35,8 +38,13 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 	tx := msg.AsTransaction()
 	txIndex := k.GetTxIndexTransient(ctx)
 
+	txData, err := types.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unpack tx data")
+	}
+
 	labels := []metrics.Label{
-		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", tx.Type())),
+		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", txData.TxType())),
 	}
 	if tx.To() == nil {
 		labels = append(labels, telemetry.NewLabel("execution", "create"))
@@ -44,11 +52,20 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		labels = append(labels, telemetry.NewLabel("execution", "call"))
 	}
 
-	response, err := k.ApplyTransaction(ctx, tx)
+	var response *types.MsgEthereumTxResponse
+	if setCodeTx, ok := txData.(*types.SetCodeTx); ok {
+		response, err = k.ApplySetCodeTransaction(ctx, setCodeTx)
+	} else {
+		response, err = k.ApplyTransaction(ctx, tx)
+	}
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
 
+	if err := k.storeReceipt(ctx, txData, common.HexToAddress(sender), txIndex, response); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to store the transaction receipt")
+	}
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{"tx", "msg", "ethereum_tx", "total"},
@@ -124,10 +141,17 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, sender),
-			sdk.NewAttribute(types.AttributeKeyTxType, fmt.Sprintf("%d", tx.Type())),
+			sdk.NewAttribute(types.AttributeKeyTxType, fmt.Sprintf("%d", txData.TxType())),
 		),
 	})
 
+	// NOTE: the gas report depends on the node configuration, so it's emitted
+	// as an event and removed from the response, which is part of the consensus
+	if response.GasReport != nil {
+		ctx.EventManager().EmitEvent(types.NewGasReportEvent(response.Hash, response.GasReport))
+		response.GasReport = nil
+	}
+
 	return response, nil
 }
 
@@ -141,9 +165,133 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// NOTE: EIPs can't be scheduled at a past height as the blocks already
+	// executed without them
+	if err := validateScheduledEIPsUpdate(k.GetParams(ctx).ScheduledEIPs, req.Params.ScheduledEIPs, ctx.BlockHeight()); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidScheduledEIP, err.Error())
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RegisterPrecompile implements the gRPC MsgServer interface. When a RegisterPrecompile
+// proposal passes, it activates the static precompile with the given address. The
+// precompile must be available in the precompile registry of the node. The update can
+// only be performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) RegisterPrecompile(goCtx context.Context, req *types.MsgRegisterPrecompile) (*types.MsgRegisterPrecompileResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	address := common.HexToAddress(req.Address)
+
+	// NOTE: instantiate the precompile to fail early if the node cannot run it
+	precompile, found, err := k.precompiles.Get(address)
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, errorsmod.Wrapf(types.ErrUnknownPrecompile, "%s", address)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if abiPrecompile, ok := precompile.(cmn.ABIPrecompile); ok {
+		abis, err := k.GetActiveStaticPrecompileABIs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := cmn.CheckSelectorCollisions(append(abis, abiPrecompile.GetABI())...); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrSelectorCollision, "precompile %s: %s", address, err)
+		}
+	}
+
+	if err := k.EnableStaticPrecompiles(ctx, address); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterPrecompileResponse{}, nil
+}
+
+// UpdateDeployers implements the gRPC MsgServer interface. When an UpdateDeployers
+// proposal passes, it adds and removes the given addresses from the access control
+// list of the contract deployments, which is the allowlist of the permissioned
+// deployment policy and the blocklist of the permissionless one. The update can
+// only be performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) UpdateDeployers(goCtx context.Context, req *types.MsgUpdateDeployers) (*types.MsgUpdateDeployersResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	removed := make(map[common.Address]struct{}, len(req.Remove))
+	for _, address := range req.Remove {
+		removed[common.HexToAddress(address)] = struct{}{}
+	}
+
+	// NOTE: the addresses are stored in their EIP-55 format, which is the one
+	// the permission policy looks up
+	deployers := slices.DeleteFunc(params.AccessControl.Create.AccessControlList, func(address string) bool {
+		_, found := removed[common.HexToAddress(address)]
+		return found
+	})
+	for _, address := range req.Add {
+		if deployer := common.HexToAddress(address).Hex(); !slices.Contains(deployers, deployer) {
+			deployers = append(deployers, deployer)
+		}
+	}
+	params.AccessControl.Create.AccessControlList = deployers
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateDeployersResponse{}, nil
+}
+
+// UpdateBlockedContracts implements the gRPC MsgServer interface. It blocks and
+// unblocks the calls to the given contracts, so that the calls to the contracts
+// that are actively exploited are reverted. The update can be performed by the
+// Cosmos SDK governance module account or by the security council set in the
+// x/evm module parameters, which allows to block a contract without waiting for
+// a governance proposal to pass.
+func (k *Keeper) UpdateBlockedContracts(goCtx context.Context, req *types.MsgUpdateBlockedContracts) (*types.MsgUpdateBlockedContractsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if k.authority.String() != req.Authority && (params.SecurityCouncil == "" || params.SecurityCouncil != req.Authority) {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s or the security council, got %s", k.authority.String(), req.Authority)
+	}
+
+	unblocked := make(map[common.Address]struct{}, len(req.Unblock))
+	for _, contract := range req.Unblock {
+		unblocked[common.HexToAddress(contract)] = struct{}{}
+	}
+
+	blocked := slices.DeleteFunc(params.BlockedContracts, func(contract string) bool {
+		_, found := unblocked[common.HexToAddress(contract)]
+		return found
+	})
+	for _, contract := range req.Block {
+		if addr := common.HexToAddress(contract).Hex(); !slices.Contains(blocked, addr) {
+			blocked = append(blocked, addr)
+		}
+	}
+	params.BlockedContracts = blocked
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("updated the blocked contracts", "authority", req.Authority, "blocked", req.Block, "unblocked", req.Unblock)
+
+	return &types.MsgUpdateBlockedContractsResponse{}, nil
+}
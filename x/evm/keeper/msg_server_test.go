@@ -3,13 +3,17 @@
 package keeper_test
 
 import (
+	"errors"
 	"math/big"
+	"slices"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/utils"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -123,3 +127,227 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		suite.Require().NoError(err)
 	}
 }
+
+func (suite *KeeperTestSuite) TestRegisterPrecompile() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name        string
+		getMsg      func() *types.MsgRegisterPrecompile
+		expectedErr error
+	}{
+		{
+			name: "fail - invalid authority",
+			getMsg: func() *types.MsgRegisterPrecompile {
+				return &types.MsgRegisterPrecompile{Authority: "foobar", Address: types.GovPrecompileAddress}
+			},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name: "fail - precompile not in registry",
+			getMsg: func() *types.MsgRegisterPrecompile {
+				return &types.MsgRegisterPrecompile{Authority: authority, Address: "0x0000000000000000000000000000000000000999"}
+			},
+			expectedErr: types.ErrUnknownPrecompile,
+		},
+		{
+			name: "fail - precompile already active",
+			getMsg: func() *types.MsgRegisterPrecompile {
+				return &types.MsgRegisterPrecompile{Authority: authority, Address: types.StakingPrecompileAddress}
+			},
+			expectedErr: errors.New("precompile already registered"),
+		},
+		{
+			name: "pass - activates the precompile",
+			getMsg: func() *types.MsgRegisterPrecompile {
+				return &types.MsgRegisterPrecompile{Authority: authority, Address: types.GovPrecompileAddress}
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			// deactivate the gov precompile
+			params := suite.network.App.EvmKeeper.GetParams(ctx)
+			params.ActiveStaticPrecompiles = slices.DeleteFunc(params.ActiveStaticPrecompiles, func(addr string) bool {
+				return addr == types.GovPrecompileAddress
+			})
+			suite.Require().NoError(suite.network.App.EvmKeeper.SetParams(ctx, params))
+
+			_, err := suite.network.App.EvmKeeper.RegisterPrecompile(ctx, tc.getMsg())
+			if tc.expectedErr != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedErr.Error())
+				return
+			}
+
+			suite.Require().NoError(err)
+			params = suite.network.App.EvmKeeper.GetParams(ctx)
+			suite.Require().Contains(params.ActiveStaticPrecompiles, types.GovPrecompileAddress)
+
+			precompile, found, err := suite.network.App.EvmKeeper.GetStaticPrecompileInstance(&params, common.HexToAddress(types.GovPrecompileAddress))
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Require().Equal(common.HexToAddress(types.GovPrecompileAddress), precompile.Address())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateDeployers() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	deployer := suite.keyring.GetAddr(0)
+	other := suite.keyring.GetAddr(1)
+
+	testCases := []struct {
+		name         string
+		getMsg       func() *types.MsgUpdateDeployers
+		expectedErr  error
+		expectedList []string
+	}{
+		{
+			name: "fail - invalid authority",
+			getMsg: func() *types.MsgUpdateDeployers {
+				return &types.MsgUpdateDeployers{Authority: "foobar", Add: []string{deployer.Hex()}}
+			},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name: "pass - adds the deployers",
+			getMsg: func() *types.MsgUpdateDeployers {
+				return &types.MsgUpdateDeployers{Authority: authority, Add: []string{deployer.Hex(), other.Hex()}}
+			},
+			expectedList: []string{other.Hex(), deployer.Hex()},
+		},
+		{
+			name: "pass - removes the deployers",
+			getMsg: func() *types.MsgUpdateDeployers {
+				return &types.MsgUpdateDeployers{Authority: authority, Remove: []string{other.Hex()}}
+			},
+			expectedList: []string{},
+		},
+		{
+			name: "pass - adds and removes the deployers",
+			getMsg: func() *types.MsgUpdateDeployers {
+				return &types.MsgUpdateDeployers{Authority: authority, Add: []string{deployer.Hex()}, Remove: []string{other.Hex()}}
+			},
+			expectedList: []string{deployer.Hex()},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			// start from a permissioned creation allowed to the other account
+			params := suite.network.App.EvmKeeper.GetParams(ctx)
+			params.AccessControl.Create.AccessType = types.AccessTypePermissioned
+			params.AccessControl.Create.AccessControlList = []string{other.Hex()}
+			suite.Require().NoError(suite.network.App.EvmKeeper.SetParams(ctx, params))
+
+			_, err := suite.network.App.EvmKeeper.UpdateDeployers(ctx, tc.getMsg())
+			if tc.expectedErr != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedErr.Error())
+				return
+			}
+
+			suite.Require().NoError(err)
+			params = suite.network.App.EvmKeeper.GetParams(ctx)
+			suite.Require().ElementsMatch(tc.expectedList, params.AccessControl.Create.AccessControlList)
+			suite.Require().Equal(types.AccessTypePermissioned, params.AccessControl.Create.AccessType)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateBlockedContracts() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	securityCouncil := suite.keyring.GetAccAddr(1).String()
+	contract := suite.keyring.GetAddr(1)
+	other := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	testCases := []struct {
+		name            string
+		securityCouncil string
+		getMsg          func() *types.MsgUpdateBlockedContracts
+		expectedErr     error
+		expectedBlocked []string
+	}{
+		{
+			name: "fail - invalid authority",
+			getMsg: func() *types.MsgUpdateBlockedContracts {
+				return &types.MsgUpdateBlockedContracts{Authority: "foobar", Block: []string{contract.Hex()}}
+			},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name: "fail - security council not set",
+			getMsg: func() *types.MsgUpdateBlockedContracts {
+				return &types.MsgUpdateBlockedContracts{Authority: securityCouncil, Block: []string{contract.Hex()}}
+			},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name: "pass - governance blocks the contracts",
+			getMsg: func() *types.MsgUpdateBlockedContracts {
+				return &types.MsgUpdateBlockedContracts{Authority: authority, Block: []string{contract.Hex(), other.Hex()}}
+			},
+			expectedBlocked: []string{contract.Hex(), other.Hex()},
+		},
+		{
+			name:            "pass - security council blocks a contract",
+			securityCouncil: securityCouncil,
+			getMsg: func() *types.MsgUpdateBlockedContracts {
+				return &types.MsgUpdateBlockedContracts{Authority: securityCouncil, Block: []string{contract.Hex()}}
+			},
+			expectedBlocked: []string{contract.Hex()},
+		},
+		{
+			name:            "pass - security council unblocks a contract",
+			securityCouncil: securityCouncil,
+			getMsg: func() *types.MsgUpdateBlockedContracts {
+				return &types.MsgUpdateBlockedContracts{Authority: securityCouncil, Block: []string{contract.Hex()}, Unblock: []string{other.Hex()}}
+			},
+			expectedBlocked: []string{contract.Hex()},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			params := suite.network.App.EvmKeeper.GetParams(ctx)
+			params.SecurityCouncil = tc.securityCouncil
+			params.BlockedContracts = []string{other.Hex()}
+			suite.Require().NoError(suite.network.App.EvmKeeper.SetParams(ctx, params))
+
+			_, err := suite.network.App.EvmKeeper.UpdateBlockedContracts(ctx, tc.getMsg())
+			if tc.expectedErr != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedErr.Error())
+				return
+			}
+
+			suite.Require().NoError(err)
+			params = suite.network.App.EvmKeeper.GetParams(ctx)
+			suite.Require().ElementsMatch(tc.expectedBlocked, params.BlockedContracts)
+
+			// the calls to the blocked contract revert with the designated reason
+			coreMsg, err := suite.factory.GenerateGethCoreMsg(
+				suite.keyring.GetPrivKey(0),
+				types.EvmTxArgs{To: &contract, Amount: big.NewInt(100)},
+			)
+			suite.Require().NoError(err)
+
+			res, err := suite.network.App.EvmKeeper.ApplyMessage(ctx, coreMsg, nil, true)
+			suite.Require().NoError(err)
+			suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+			suite.Require().Equal(types.BlockedContractRevertData(contract), res.Ret)
+		})
+	}
+}
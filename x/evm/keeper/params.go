@@ -98,3 +98,35 @@ func (k Keeper) EnableEIPs(ctx sdk.Context, eips ...string) error {
 
 	return k.SetParams(ctx, evmParams)
 }
+
+// validateScheduledEIPsUpdate checks that the scheduled EIPs that are added or
+// modified by a parameters update are enabled after the current block height.
+func validateScheduledEIPsUpdate(current, updated []types.ScheduledEIP, height int64) error {
+	for _, scheduled := range updated {
+		if slices.Contains(current, scheduled) {
+			continue
+		}
+		if scheduled.Height <= height {
+			return fmt.Errorf("EIP %s must be scheduled after the current height %d: %d", scheduled.EIP, height, scheduled.Height)
+		}
+	}
+	return nil
+}
+
+// GetBech32HRPs returns the bech32 human readable prefixes registered in the
+// EVM params for the address conversions of the bech32 precompile.
+func (k Keeper) GetBech32HRPs(ctx sdk.Context) []string {
+	return k.GetParams(ctx).Bech32HRPs
+}
+
+// GetOsmosisOutpost returns the channel to Osmosis and the cross-chain swaps
+// contract of the Osmosis outpost precompile registered in the EVM params.
+func (k Keeper) GetOsmosisOutpost(ctx sdk.Context) types.OsmosisOutpost {
+	return k.GetParams(ctx).OsmosisOutpost
+}
+
+// GetStrideOutpost returns the channel to Stride of the Stride outpost
+// precompile registered in the EVM params.
+func (k Keeper) GetStrideOutpost(ctx sdk.Context) types.StrideOutpost {
+	return k.GetParams(ctx).StrideOutpost
+}
@@ -0,0 +1,305 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmostypes "github.com/evmos/evmos/v20/types"
+	evmante "github.com/evmos/evmos/v20/x/evm/ante"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// preExecutedTx holds the result of the optimistic execution of a transaction
+// along with the state it accessed.
+type preExecutedTx struct {
+	res    *types.MsgEthereumTxResponse
+	access *stateAccessTracker
+	// cfg holds the EVM config the transaction was executed with
+	cfg *preExecutionConfig
+}
+
+// preExecutionConfig holds the configuration of the block the transactions are
+// pre-executed with, which must be the one of their delivery to reuse their
+// results.
+type preExecutionConfig struct {
+	evm *statedb.EVMConfig
+	// params holds the encoded EVM params
+	params []byte
+	// feeMarketParams holds the encoded fee market params, which are read by
+	// the EVM for the gas refunds and the base fee checks
+	feeMarketParams []byte
+}
+
+// newPreExecutionConfig returns the configuration of the block of the given
+// context for the given EVM config.
+func (k *Keeper) newPreExecutionConfig(ctx sdk.Context, cfg *statedb.EVMConfig) *preExecutionConfig {
+	feeMarketParams := k.feeMarketWrapper.GetParams(ctx)
+	return &preExecutionConfig{
+		evm:             cfg,
+		params:          k.cdc.MustMarshal(&cfg.Params),
+		feeMarketParams: k.cdc.MustMarshal(&feeMarketParams),
+	}
+}
+
+// equal returns true if both configurations are the same. The chain config is
+// derived from the EVM params and the chain ID.
+func (c *preExecutionConfig) equal(other *preExecutionConfig) bool {
+	baseFeeEqual := c.evm.BaseFee == nil && other.evm.BaseFee == nil ||
+		c.evm.BaseFee != nil && other.evm.BaseFee != nil && c.evm.BaseFee.Cmp(other.evm.BaseFee) == 0
+
+	return baseFeeEqual &&
+		c.evm.CoinBase == other.evm.CoinBase &&
+		c.evm.ChainConfig.ChainID.Cmp(other.evm.ChainConfig.ChainID) == 0 &&
+		bytes.Equal(c.params, other.params) &&
+		bytes.Equal(c.feeMarketParams, other.feeMarketParams)
+}
+
+// preExecutionCache holds the transactions pre-executed for a block.
+type preExecutionCache struct {
+	mu     sync.Mutex
+	height int64
+	txs    map[common.Hash]*preExecutedTx
+}
+
+// reset discards the pre-executed transactions and sets the ones of the block
+// at the given height.
+func (c *preExecutionCache) reset(height int64, txs map[common.Hash]*preExecutedTx) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.height = height
+	c.txs = txs
+}
+
+// take removes the pre-executed transaction with the given hash from the cache
+// and returns it, if it was executed for the block at the given height.
+func (c *preExecutionCache) take(height int64, txHash common.Hash) (*preExecutedTx, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.height != height {
+		return nil, false
+	}
+
+	tx, found := c.txs[txHash]
+	if found {
+		delete(c.txs, txHash)
+	}
+	return tx, found
+}
+
+// WithParallelExecution enables the experimental parallel pre-execution of the
+// ethereum transactions of a block using up to the given number of workers. It's
+// disabled if workers is zero.
+//
+// The pre-executed transactions run concurrently, each worker on its own branch
+// of the last committed state. When a transaction is delivered, its
+// pre-executed result is only used if the block config and the EVM state it
+// read are unchanged, which makes the block execution equivalent to the
+// sequential one. Otherwise the transaction is executed sequentially.
+func (k *Keeper) WithParallelExecution(workers int) *Keeper {
+	k.parallelWorkers = workers
+	k.preExecution = nil
+	if workers > 0 {
+		k.preExecution = &preExecutionCache{}
+	}
+	return k
+}
+
+// ParallelExecutionEnabled returns true if the parallel pre-execution of the
+// ethereum transactions is enabled.
+func (k *Keeper) ParallelExecutionEnabled() bool {
+	return k.preExecution != nil
+}
+
+// PreExecuteTxs executes the given ethereum transactions of the current block
+// concurrently on the latest version of the given committed multistore and
+// caches their results for their delivery. It must be called after the begin
+// blockers, so the transactions are executed with the base fee of the block.
+// It's a no-op if the parallel execution is disabled.
+//
+// The pre-executed transactions aren't traced, so they are executed
+// sequentially while the telemetry or the live tracer are enabled.
+func (k *Keeper) PreExecuteTxs(ctx sdk.Context, cms storetypes.MultiStore, txs []*types.MsgEthereumTx) {
+	if k.preExecution == nil {
+		return
+	}
+
+	// discard the transactions of the previous block
+	k.preExecution.reset(ctx.BlockHeight(), nil)
+	if len(txs) == 0 || k.telemetry != nil || k.traceStore != nil {
+		return
+	}
+
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress))
+	if err != nil {
+		k.Logger(ctx).Error("failed to load evm config for pre-execution", "error", err.Error())
+		return
+	}
+	preExecutionCfg := k.newPreExecutionConfig(ctx, cfg)
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+
+	workers := k.parallelWorkers
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+
+	// NOTE: the workers don't share the stores of the block context, which
+	// aren't safe for concurrent use. Instead, each one reads its own branch of
+	// the last committed state, and the state changes of the block are caught
+	// on delivery by the validation of the state read.
+	workerCtxs := make([]sdk.Context, workers)
+	for w := range workerCtxs {
+		view, err := cms.CacheMultiStoreWithVersion(cms.LatestVersion())
+		if err != nil {
+			k.Logger(ctx).Error("failed to branch the committed state for pre-execution", "error", err.Error())
+			return
+		}
+		workerCtxs[w] = ctx.WithMultiStore(view)
+	}
+
+	results := make([]*preExecutedTx, len(txs))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for _, workerCtx := range workerCtxs {
+		wg.Add(1)
+		go func(workerCtx sdk.Context) {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = k.preExecuteTx(workerCtx, cfg, signer, txs[i])
+			}
+		}(workerCtx)
+	}
+
+	for i := range txs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	preExecuted := make(map[common.Hash]*preExecutedTx, len(txs))
+	for i, result := range results {
+		if result == nil {
+			continue
+		}
+		result.cfg = preExecutionCfg
+		preExecuted[txs[i].TxHash()] = result
+	}
+
+	k.preExecution.reset(ctx.BlockHeight(), preExecuted)
+}
+
+// preExecuteTx executes the transaction on its own branch of the state of the
+// worker context. It returns nil if the result of the transaction can't be
+// reused on its delivery.
+func (k *Keeper) preExecuteTx(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	signer ethtypes.Signer,
+	tx *types.MsgEthereumTx,
+) (result *preExecutedTx) {
+	// the pre-execution is best effort, so its failures fall back to the
+	// sequential execution of the transaction
+	defer func() {
+		if r := recover(); r != nil {
+			result = nil
+		}
+	}()
+
+	// the EIP-7702 authorizations are applied outside of the StateDB keeper,
+	// so the set code transactions are always executed sequentially
+	txData, err := types.UnpackTxData(tx.Data)
+	if err != nil {
+		return nil
+	}
+	if _, ok := txData.(*types.SetCodeTx); ok {
+		return nil
+	}
+
+	ethTx := tx.AsTransaction()
+	msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil
+	}
+
+	txCtx := ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore()).WithEventManager(sdk.NewEventManager())
+	txCtx = evmante.BuildEvmExecutionCtx(txCtx).
+		WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(msg.Gas())).
+		WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// the ante handler increments the nonce of the sender and deducts the fees
+	// before the delivery, so the transaction reads the sender account after
+	// both changes
+	sender := k.GetAccount(txCtx, msg.From())
+	if sender == nil {
+		return nil
+	}
+	fees := new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasPrice())
+	if sender.Balance.Cmp(fees) < 0 {
+		return nil
+	}
+	sender.Nonce = msg.Nonce() + 1
+	sender.Balance = new(big.Int).Sub(sender.Balance, fees)
+	if err := k.SetAccount(txCtx, msg.From(), *sender); err != nil {
+		return nil
+	}
+
+	// the transaction index and log index are set on delivery
+	txConfig := statedb.NewTxConfig(common.BytesToHash(ctx.HeaderHash()), ethTx.Hash(), 0, 0)
+
+	access := newStateAccessTracker(k)
+	stateDB := statedb.New(txCtx, access, txConfig)
+	res, err := k.applyMessageWithStateDB(txCtx, msg, nil, true, cfg, txConfig, stateDB)
+	if err != nil || stateDB.HasPrecompileCalls() {
+		return nil
+	}
+
+	return &preExecutedTx{res: res, access: access}
+}
+
+// applyPreExecutedTx applies the pre-executed result of the transaction if it
+// was executed with the given EVM config and the state it read is unchanged on
+// the given context, and returns false otherwise.
+func (k *Keeper) applyPreExecutedTx(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, bool) {
+	if k.preExecution == nil || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return nil, false
+	}
+
+	tx, found := k.preExecution.take(ctx.BlockHeight(), txConfig.TxHash)
+	if !found {
+		return nil, false
+	}
+
+	if !tx.cfg.equal(k.newPreExecutionConfig(ctx, cfg)) || !tx.access.validate(ctx) {
+		return nil, false
+	}
+
+	replayCtx, write := ctx.CacheContext()
+	if err := tx.access.replay(replayCtx); err != nil {
+		return nil, false
+	}
+	write()
+
+	// the logs are indexed according to the position of the transaction
+	res := tx.res
+	for i, log := range res.Logs {
+		log.TxIndex = uint64(txConfig.TxIndex)            // #nosec G115
+		log.Index = uint64(txConfig.LogIndex) + uint64(i) // #nosec G115
+	}
+
+	return res, true
+}
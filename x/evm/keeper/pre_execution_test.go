@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestPreExecuteTxs() {
+	sender := suite.keyring.GetKey(0)
+	recipient := suite.keyring.GetAddr(1)
+	amount := big.NewInt(100)
+
+	testCases := []struct {
+		name     string
+		malleate func(ctx sdk.Context)
+		// expRecipientDelta is the change of the recipient balance after the delivery
+		expRecipientDelta *big.Int
+	}{
+		{
+			"pass - the pre-executed result is reused when the state is unchanged",
+			func(sdk.Context) {},
+			amount,
+		},
+		{
+			"pass - the tx is executed sequentially when the state it read changed",
+			func(ctx sdk.Context) {
+				balance := suite.network.App.EvmKeeper.GetBalance(ctx, recipient)
+				err := suite.network.App.EvmKeeper.SetBalance(ctx, recipient, new(big.Int).Add(balance, big.NewInt(1)))
+				suite.Require().NoError(err)
+			},
+			new(big.Int).Add(amount, big.NewInt(1)),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			k := suite.network.App.EvmKeeper.WithParallelExecution(2)
+			defer k.WithParallelExecution(0)
+
+			ctx := suite.network.GetContext().WithExecMode(sdk.ExecModeFinalize)
+			msg, err := suite.factory.GenerateSignedMsgEthereumTx(sender.Priv, types.EvmTxArgs{
+				To:       &recipient,
+				Amount:   amount,
+				GasLimit: params.TxGas,
+				GasPrice: big.NewInt(10),
+			})
+			suite.Require().NoError(err)
+
+			k.PreExecuteTxs(ctx, suite.network.App.CommitMultiStore(), []*types.MsgEthereumTx{&msg})
+
+			// apply the changes of the ante handler to the sender account
+			tx := msg.AsTransaction()
+			account := k.GetAccount(ctx, sender.Addr)
+			account.Nonce++
+			account.Balance.Sub(account.Balance, new(big.Int).Mul(tx.GasPrice(), new(big.Int).SetUint64(tx.Gas())))
+			suite.Require().NoError(k.SetAccount(ctx, sender.Addr, *account))
+
+			recipientBalance := k.GetBalance(ctx, recipient)
+			tc.malleate(ctx)
+
+			res, err := k.ApplyTransaction(ctx, tx)
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed())
+			suite.Require().Equal(params.TxGas, res.GasUsed)
+
+			expBalance := new(big.Int).Add(recipientBalance, tc.expRecipientDelta)
+			suite.Require().Equal(expBalance, k.GetBalance(ctx, recipient))
+			suite.Require().Equal(account.Nonce, k.GetNonce(ctx, sender.Addr))
+		})
+	}
+}
@@ -4,12 +4,127 @@
 package keeper
 
 import (
+	"bytes"
+	"fmt"
+	"slices"
+	"sync"
+
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/types"
 )
 
+// PrecompileConstructor instantiates a static precompiled contract.
+type PrecompileConstructor func() (vm.PrecompiledContract, error)
+
+// PrecompileRegistry holds the constructors of the static precompiled contracts
+// that are available on the node keyed by their address. The precompiles are
+// instantiated lazily the first time they are requested and cached afterwards,
+// so that activating a precompile through the EVM params doesn't require a
+// chain upgrade as long as its constructor is registered.
+type PrecompileRegistry struct {
+	mu           sync.Mutex
+	constructors map[common.Address]PrecompileConstructor
+	instances    map[common.Address]vm.PrecompiledContract
+}
+
+// NewPrecompileRegistry returns an empty precompile registry.
+func NewPrecompileRegistry() *PrecompileRegistry {
+	return &PrecompileRegistry{
+		constructors: make(map[common.Address]PrecompileConstructor),
+		instances:    make(map[common.Address]vm.PrecompiledContract),
+	}
+}
+
+// Register adds the constructor of the precompile with the given address to
+// the registry. It panics if the address is already registered.
+func (r *PrecompileRegistry) Register(address common.Address, constructor PrecompileConstructor) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, found := r.constructors[address]; found {
+		panic(fmt.Errorf("precompile already registered: %s", address))
+	}
+	r.constructors[address] = constructor
+}
+
+// RegisterInstance adds an already instantiated precompile with the given
+// address to the registry. It panics if the address is already registered.
+func (r *PrecompileRegistry) RegisterInstance(address common.Address, precompile vm.PrecompiledContract) {
+	r.Register(address, func() (vm.PrecompiledContract, error) { return precompile, nil })
+}
+
+// Has returns true if a precompile with the given address is registered.
+func (r *PrecompileRegistry) Has(address common.Address) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, found := r.constructors[address]
+	return found
+}
+
+// Addresses returns the sorted addresses of all the registered precompiles.
+func (r *PrecompileRegistry) Addresses() []common.Address {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	addresses := make([]common.Address, 0, len(r.constructors))
+	for address := range r.constructors {
+		addresses = append(addresses, address)
+	}
+	slices.SortFunc(addresses, func(a, b common.Address) int { return bytes.Compare(a.Bytes(), b.Bytes()) })
+	return addresses
+}
+
+// Get returns the instance of the precompile with the given address,
+// instantiating it if it is requested for the first time. It returns false if
+// the address is not registered.
+func (r *PrecompileRegistry) Get(address common.Address) (vm.PrecompiledContract, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if precompile, found := r.instances[address]; found {
+		return precompile, true, nil
+	}
+
+	constructor, found := r.constructors[address]
+	if !found {
+		return nil, false, nil
+	}
+
+	precompile, err := constructor()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to instantiate precompile %s: %w", address, err)
+	}
+
+	r.instances[address] = precompile
+	return precompile, true, nil
+}
+
+// ABIs returns the ABIs of the registered precompiles with the given addresses,
+// in the order of the addresses. The precompiles that don't expose an ABI, such
+// as the ones without methods, and the unregistered addresses are skipped.
+func (r *PrecompileRegistry) ABIs(addresses ...common.Address) ([]abi.ABI, error) {
+	abis := make([]abi.ABI, 0, len(addresses))
+	for _, address := range addresses {
+		precompile, found, err := r.Get(address)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+
+		if abiPrecompile, ok := precompile.(cmn.ABIPrecompile); ok {
+			abis = append(abis, abiPrecompile.GetABI())
+		}
+	}
+	return abis, nil
+}
+
 type Precompiles struct {
 	Map       map[common.Address]vm.PrecompiledContract
 	Addresses []common.Address
@@ -22,6 +137,9 @@ func (k *Keeper) GetPrecompileInstance(
 	address common.Address,
 ) (*Precompiles, bool, error) {
 	params := k.GetParams(ctx)
+	// The scheduled EIPs that are enabled at the current height can also toggle
+	// the availability of the static precompiles.
+	params.ExtraEIPs = params.EIPsAt(ctx.BlockHeight())
 	// Get the precompile from the static precompiles
 	if precompile, found, err := k.GetStaticPrecompileInstance(&params, address); err != nil {
 		return nil, false, err
@@ -0,0 +1,115 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/p256"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/keeper"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func TestPrecompileRegistry(t *testing.T) {
+	registry := keeper.NewPrecompileRegistry()
+	lazyAddr := common.HexToAddress("0x0000000000000000000000000000000000000900")
+	failingAddr := common.HexToAddress("0x0000000000000000000000000000000000000901")
+	instanceAddr := common.HexToAddress("0x0000000000000000000000000000000000000100")
+
+	calls := 0
+	registry.Register(lazyAddr, func() (vm.PrecompiledContract, error) {
+		calls++
+		return &p256.Precompile{}, nil
+	})
+	registry.Register(failingAddr, func() (vm.PrecompiledContract, error) {
+		return nil, errors.New("constructor failed")
+	})
+	registry.RegisterInstance(instanceAddr, &p256.Precompile{})
+
+	require.Equal(t, []common.Address{instanceAddr, lazyAddr, failingAddr}, registry.Addresses())
+	require.True(t, registry.Has(lazyAddr))
+	require.Zero(t, calls, "expected the precompile to be instantiated lazily")
+
+	// the precompile is instantiated once and cached
+	for i := 0; i < 2; i++ {
+		precompile, found, err := registry.Get(lazyAddr)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.NotNil(t, precompile)
+	}
+	require.Equal(t, 1, calls)
+
+	_, found, err := registry.Get(failingAddr)
+	require.ErrorContains(t, err, "constructor failed")
+	require.False(t, found)
+
+	_, found, err = registry.Get(common.HexToAddress("0x0000000000000000000000000000000000000999"))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.Panics(t, func() {
+		registry.RegisterInstance(instanceAddr, &p256.Precompile{})
+	})
+}
+
+func TestIsAvailableStaticPrecompileBLS(t *testing.T) {
+	k := keeper.Keeper{}
+	params := types.DefaultParams()
+	params.ExtraEIPs = []string{"ethereum_3855"}
+
+	for _, address := range vm.PrecompiledAddressesBLS {
+		require.False(t, k.IsAvailableStaticPrecompile(&params, address), "expected %s to be disabled", address)
+	}
+
+	params.ExtraEIPs = append(params.ExtraEIPs, vm.EIP2537)
+	require.NoError(t, params.Validate())
+	for _, address := range vm.PrecompiledAddressesBLS {
+		require.True(t, k.IsAvailableStaticPrecompile(&params, address), "expected %s to be enabled", address)
+	}
+}
+
+// abiPrecompile is a precompile that exposes the given ABI.
+type abiPrecompile struct {
+	p256.Precompile
+	abi abi.ABI
+}
+
+func (p abiPrecompile) GetABI() abi.ABI {
+	return p.abi
+}
+
+func TestPrecompileRegistryABIs(t *testing.T) {
+	// the transfer and many_msg_babbage methods share the 0xa9059cbb selector
+	transferABI, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}]`))
+	require.NoError(t, err)
+	collidingABI, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"many_msg_babbage","stateMutability":"nonpayable","inputs":[{"name":"","type":"bytes1"}],"outputs":[]}]`))
+	require.NoError(t, err)
+
+	transferAddr := common.HexToAddress("0x0000000000000000000000000000000000000900")
+	collidingAddr := common.HexToAddress("0x0000000000000000000000000000000000000901")
+	noABIAddr := common.HexToAddress("0x0000000000000000000000000000000000000902")
+
+	registry := keeper.NewPrecompileRegistry()
+	registry.RegisterInstance(transferAddr, &abiPrecompile{abi: transferABI})
+	registry.RegisterInstance(collidingAddr, &abiPrecompile{abi: collidingABI})
+	registry.RegisterInstance(noABIAddr, &p256.Precompile{})
+
+	// the precompiles without ABI and the unregistered addresses are skipped
+	abis, err := registry.ABIs(transferAddr, noABIAddr, common.HexToAddress("0x0000000000000000000000000000000000000999"))
+	require.NoError(t, err)
+	require.Equal(t, []abi.ABI{transferABI}, abis)
+	require.NoError(t, cmn.CheckSelectorCollisions(abis...))
+
+	abis, err = registry.ABIs(transferAddr, collidingAddr)
+	require.NoError(t, err)
+	require.Len(t, abis, 2)
+	require.ErrorContains(t, cmn.CheckSelectorCollisions(abis...), "method selector collision for 0xa9059cbb")
+}
@@ -0,0 +1,54 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// AddPreinstalls installs the given contracts at their addresses with their
+// predefined code. It can be called from the upgrade handlers to add the
+// well-known contracts to a running chain.
+//
+// A preinstall whose code is already deployed at its address is skipped, so
+// that a contract that was deployed through a transaction (e.g. the keyless
+// deployment of the CREATE2 deployer) is not an error. It returns an error if
+// a different contract is already deployed at the address of a preinstall.
+func (k *Keeper) AddPreinstalls(ctx sdk.Context, preinstalls []types.Preinstall) error {
+	if err := types.ValidatePreinstalls(preinstalls); err != nil {
+		return err
+	}
+
+	for _, preinstall := range preinstalls {
+		address := common.HexToAddress(preinstall.Address)
+		code, err := preinstall.GetCodeBytes()
+		if err != nil {
+			return err
+		}
+		codeHash := crypto.Keccak256Hash(code)
+
+		switch existingCodeHash := k.GetCodeHash(ctx, address); {
+		case existingCodeHash == codeHash:
+			continue
+		case !types.IsEmptyCodeHash(existingCodeHash.Bytes()):
+			return errorsmod.Wrapf(types.ErrInvalidPreinstall,
+				"preinstall %s conflicts with the contract deployed at %s", preinstall.Name, preinstall.Address,
+			)
+		}
+
+		accAddress := sdk.AccAddress(address.Bytes())
+		if k.accountKeeper.GetAccount(ctx, accAddress) == nil {
+			k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, accAddress))
+		}
+
+		k.SetCodeHash(ctx, address.Bytes(), codeHash.Bytes())
+		k.SetCode(ctx, codeHash.Bytes(), code)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestAddPreinstalls() {
+	address := "0x1111111111111111111111111111111111111111"
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		preinstalls []types.Preinstall
+		expErr      bool
+	}{
+		{
+			"pass - default preinstalls",
+			func() {},
+			types.DefaultPreinstalls,
+			false,
+		},
+		{
+			"pass - new contract",
+			func() {},
+			[]types.Preinstall{{Name: "test", Address: address, Code: "0x6001"}},
+			false,
+		},
+		{
+			"pass - same code already deployed",
+			func() {
+				vmdb := suite.StateDB()
+				vmdb.SetCode(common.HexToAddress(address), []byte{0x60, 0x01})
+				suite.Require().NoError(vmdb.Commit())
+			},
+			[]types.Preinstall{{Name: "test", Address: address, Code: "0x6001"}},
+			false,
+		},
+		{
+			"fail - different code already deployed",
+			func() {
+				vmdb := suite.StateDB()
+				vmdb.SetCode(common.HexToAddress(address), []byte{0x60, 0x02})
+				suite.Require().NoError(vmdb.Commit())
+			},
+			[]types.Preinstall{{Name: "test", Address: address, Code: "0x6001"}},
+			true,
+		},
+		{
+			"fail - invalid preinstall",
+			func() {},
+			[]types.Preinstall{{Name: "test", Address: address, Code: "0x"}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			ctx := suite.network.GetContext()
+			err := suite.network.App.EvmKeeper.AddPreinstalls(ctx, tc.preinstalls)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, types.ErrInvalidPreinstall)
+				return
+			}
+
+			suite.Require().NoError(err)
+			for _, preinstall := range tc.preinstalls {
+				address := common.HexToAddress(preinstall.Address)
+				code := common.FromHex(preinstall.Code)
+
+				suite.Require().Equal(crypto.Keccak256Hash(code), suite.network.App.EvmKeeper.GetCodeHash(ctx, address))
+				suite.Require().Equal(code, suite.network.App.EvmKeeper.GetCode(ctx, crypto.Keccak256Hash(code)))
+				suite.Require().NotNil(suite.network.App.AccountKeeper.GetAccount(ctx, address.Bytes()))
+			}
+		})
+	}
+}
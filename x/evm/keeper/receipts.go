@@ -0,0 +1,145 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// maxPrunedReceipts is the maximum number of receipts pruned in a block, so
+// that disabling or shortening the retention doesn't prune all the stored
+// receipts at once.
+const maxPrunedReceipts = 5_000
+
+// GetReceipt returns the receipt of the delivered ethereum tx with the given
+// hash, and false if it's not found.
+func (k Keeper) GetReceipt(ctx sdk.Context, txHash common.Hash) (types.Receipt, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixReceipt)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return types.Receipt{}, false
+	}
+
+	var receipt types.Receipt
+	k.cdc.MustUnmarshal(bz, &receipt)
+	return receipt, true
+}
+
+// SetReceipt stores the receipt of an ethereum tx by its hash, and indexes the
+// hash by the block number of the receipt for pruning.
+func (k Keeper) SetReceipt(ctx sdk.Context, receipt types.Receipt) {
+	txHash := common.HexToHash(receipt.TxHash)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixReceipt)
+	store.Set(txHash.Bytes(), k.cdc.MustMarshal(&receipt))
+
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixReceiptHeight)
+	heightStore.Set(types.ReceiptHeightKey(receipt.BlockNumber, txHash), []byte{1})
+}
+
+// pruneReceipts deletes the receipts that are older than the receipt retention
+// window of the params, oldest first and at most maxPrunedReceipts per call.
+// All the receipts are pruned once the retention is disabled.
+func (k Keeper) pruneReceipts(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight()) //#nosec G115 -- block height is not negative
+	retention := k.GetParams(ctx).ReceiptRetentionBlocks
+	if height < retention {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixReceipt)
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixReceiptHeight)
+
+	// the receipts of the blocks up to the cutoff height are out of the window
+	cutoff := height - retention
+	iterator := heightStore.Iterator(nil, sdk.Uint64ToBigEndian(cutoff+1))
+
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < maxPrunedReceipts; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key[8:])
+		heightStore.Delete(key)
+	}
+}
+
+// GetBlockGasUsedTransient returns the gas used by the ethereum txs of the
+// current block.
+func (k Keeper) GetBlockGasUsedTransient(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixTransientBlockGasUsed))
+}
+
+// addBlockGasUsedTransient adds the gas used by an ethereum tx to the gas used
+// by the ethereum txs of the current block, and returns the cumulative value.
+func (k Keeper) addBlockGasUsedTransient(ctx sdk.Context, gasUsed uint64) (uint64, error) {
+	result := k.GetBlockGasUsedTransient(ctx) + gasUsed
+	if result < gasUsed {
+		return 0, errorsmod.Wrap(types.ErrGasOverflow, "transient block gas used")
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientBlockGasUsed, sdk.Uint64ToBigEndian(result))
+	return result, nil
+}
+
+// storeReceipt builds and stores the receipt of the ethereum tx with the given
+// data, sender and index in the block from its execution response. The receipt
+// is not stored when the receipt retention is disabled.
+func (k Keeper) storeReceipt(
+	ctx sdk.Context,
+	txData types.TxData,
+	from common.Address,
+	txIndex uint64,
+	res *types.MsgEthereumTxResponse,
+) error {
+	if k.GetParams(ctx).ReceiptRetentionBlocks == 0 {
+		return nil
+	}
+
+	cumulativeGasUsed, err := k.addBlockGasUsedTransient(ctx, res.GasUsed)
+	if err != nil {
+		return err
+	}
+
+	effectiveGasPrice := sdkmath.NewIntFromBigInt(txData.EffectiveGasPrice(k.GetBaseFee(ctx)))
+	receipt := types.Receipt{
+		TxHash:            res.Hash,
+		TxIndex:           txIndex,
+		From:              from.Hex(),
+		GasUsed:           res.GasUsed,
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		Logs:              res.Logs,
+		BlockNumber:       uint64(ctx.BlockHeight()), //#nosec G115 -- block height is not negative
+		BlockHash:         common.BytesToHash(ctx.HeaderHash()).Hex(),
+		CumulativeGasUsed: cumulativeGasUsed,
+		LogsBloom:         ethtypes.LogsBloom(types.LogsToEthereum(res.Logs)),
+		TxType:            uint32(txData.TxType()),
+		EffectiveGasPrice: &effectiveGasPrice,
+	}
+
+	if res.Failed() {
+		receipt.Status = ethtypes.ReceiptStatusFailed
+		receipt.VmError = res.VmError
+	}
+
+	if to := txData.GetTo(); to != nil {
+		receipt.To = to.Hex()
+	} else {
+		receipt.ContractAddress = crypto.CreateAddress(from, txData.GetNonce()).Hex()
+	}
+
+	k.SetReceipt(ctx, receipt)
+	return nil
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestReceipts() {
+	suite.SetupTest()
+
+	params := suite.network.App.EvmKeeper.GetParams(suite.network.GetContext())
+	params.ReceiptRetentionBlocks = 10
+	suite.Require().NoError(suite.network.App.EvmKeeper.SetParams(suite.network.GetContext(), params))
+
+	senderKey := suite.keyring.GetKey(0)
+	recipient := suite.keyring.GetAddr(1)
+
+	testCases := []struct {
+		name   string
+		txArgs types.EvmTxArgs
+	}{
+		{
+			"pass - transfer",
+			types.EvmTxArgs{To: &recipient, Amount: big.NewInt(1)},
+		},
+		{
+			"pass - contract creation",
+			types.EvmTxArgs{Input: common.FromHex("0x600a600c600039600a6000f3602a60805260206080f3")},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			nonce := suite.network.App.EvmKeeper.GetNonce(suite.network.GetContext(), senderKey.Addr)
+			msg, err := suite.factory.GenerateSignedMsgEthereumTx(senderKey.Priv, tc.txArgs)
+			suite.Require().NoError(err)
+
+			res, err := suite.factory.ExecuteEthTx(senderKey.Priv, tc.txArgs)
+			suite.Require().NoError(err)
+			suite.Require().NoError(suite.network.NextBlock())
+
+			ethRes, err := suite.factory.GetEvmTransactionResponseFromTxResult(res)
+			suite.Require().NoError(err)
+
+			queryRes, err := suite.network.GetEvmClient().Receipt(suite.network.GetContext(), &types.QueryReceiptRequest{TxHash: msg.Hash})
+			suite.Require().NoError(err)
+
+			receipt := queryRes.Receipt
+			suite.Require().Equal(msg.Hash, receipt.TxHash)
+			suite.Require().Equal(senderKey.Addr.Hex(), receipt.From)
+			suite.Require().Equal(ethtypes.ReceiptStatusSuccessful, receipt.Status)
+			suite.Require().Equal(ethRes.GasUsed, receipt.GasUsed)
+			suite.Require().Equal(ethRes.GasUsed, receipt.CumulativeGasUsed)
+			suite.Require().Equal(uint64(0), receipt.TxIndex)
+			suite.Require().Equal(uint32(msg.AsTransaction().Type()), receipt.TxType)
+			suite.Require().NotNil(receipt.EffectiveGasPrice)
+
+			if tc.txArgs.To != nil {
+				suite.Require().Equal(recipient.Hex(), receipt.To)
+				suite.Require().Empty(receipt.ContractAddress)
+			} else {
+				suite.Require().Empty(receipt.To)
+				suite.Require().Equal(crypto.CreateAddress(senderKey.Addr, nonce).Hex(), receipt.ContractAddress)
+			}
+		})
+	}
+
+	_, err := suite.network.GetEvmClient().Receipt(suite.network.GetContext(), &types.QueryReceiptRequest{TxHash: common.Hash{}.Hex()})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestReceiptsRetentionDisabled() {
+	suite.SetupTest()
+
+	senderKey := suite.keyring.GetKey(0)
+	recipient := suite.keyring.GetAddr(1)
+	txArgs := types.EvmTxArgs{To: &recipient, Amount: big.NewInt(1)}
+
+	msg, err := suite.factory.GenerateSignedMsgEthereumTx(senderKey.Priv, txArgs)
+	suite.Require().NoError(err)
+
+	_, err = suite.factory.ExecuteEthTx(senderKey.Priv, txArgs)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.network.NextBlock())
+
+	_, found := suite.network.App.EvmKeeper.GetReceipt(suite.network.GetContext(), common.HexToHash(msg.Hash))
+	suite.Require().False(found, "expected no receipt with the default params")
+}
+
+func (suite *KeeperTestSuite) TestPruneReceipts() {
+	hashes := []common.Hash{{0x1}, {0x2}, {0x3}}
+
+	testCases := []struct {
+		name      string
+		retention uint64
+		height    int64
+		expFound  []bool
+	}{
+		{
+			"pass - nothing pruned within the window",
+			5,
+			4,
+			[]bool{true, true, true},
+		},
+		{
+			"pass - receipts out of the window pruned",
+			2,
+			4,
+			[]bool{false, false, true},
+		},
+		{
+			"pass - all receipts pruned when the retention is disabled",
+			0,
+			4,
+			[]bool{false, false, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+			evmKeeper := suite.network.App.EvmKeeper
+
+			for i, hash := range hashes {
+				evmKeeper.SetReceipt(ctx, types.Receipt{TxHash: hash.Hex(), BlockNumber: uint64(i + 1)})
+			}
+
+			params := evmKeeper.GetParams(ctx)
+			params.ReceiptRetentionBlocks = tc.retention
+			suite.Require().NoError(evmKeeper.SetParams(ctx, params))
+
+			suite.Require().NoError(evmKeeper.EndBlock(ctx.WithBlockHeight(tc.height)))
+
+			for i, hash := range hashes {
+				_, found := evmKeeper.GetReceipt(ctx, hash)
+				suite.Require().Equal(tc.expFound[i], found, "receipt at height %d", i+1)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// applyAuthorizations applies the EIP-7702 authorizations of a set code
+// transaction in order. The invalid authorizations are skipped and don't
+// fail the transaction.
+func (k *Keeper) applyAuthorizations(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	chainID *big.Int,
+	authList []ethutils.SetCodeAuthorization,
+) {
+	for i, auth := range authList {
+		if err := applyAuthorization(stateDB, chainID, auth); err != nil {
+			k.Logger(ctx).Debug("skipped invalid set code authorization", "index", i, "error", err.Error())
+		}
+	}
+}
+
+// applyAuthorization delegates the code of the authority of the authorization
+// to the authorized address, or clears the delegation if the address is zero.
+func applyAuthorization(stateDB *statedb.StateDB, chainID *big.Int, auth ethutils.SetCodeAuthorization) error {
+	if auth.ChainID.Sign() != 0 && auth.ChainID.Cmp(chainID) != 0 {
+		return fmt.Errorf("invalid chain id %s", auth.ChainID)
+	}
+	if auth.Nonce == math.MaxUint64 {
+		return errors.New("nonce overflow")
+	}
+
+	authority, err := auth.Authority()
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	stateDB.AddAddressToAccessList(authority)
+
+	// only the EOAs, delegated or not, can be delegated
+	code := stateDB.GetCode(authority)
+	if _, delegated := ethutils.ParseDelegation(code); len(code) > 0 && !delegated {
+		return fmt.Errorf("authority %s has code", authority)
+	}
+	if nonce := stateDB.GetNonce(authority); nonce != auth.Nonce {
+		return fmt.Errorf("invalid nonce for authority %s: have %d, want %d", authority, auth.Nonce, nonce)
+	}
+
+	// the authorization is charged as the creation of an account, refund the
+	// difference if the account already exists
+	if !stateDB.Empty(authority) {
+		stateDB.AddRefund(types.PerEmptyAccountCost - types.PerAuthBaseCost)
+	}
+
+	if auth.Address == (common.Address{}) {
+		stateDB.SetCode(authority, nil)
+	} else {
+		stateDB.SetCode(authority, ethutils.AddressToDelegation(auth.Address))
+	}
+	stateDB.SetNonce(authority, auth.Nonce+1)
+	return nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// signAuthorization signs the EIP-7702 authorization with the given key.
+func signAuthorization(key *ecdsa.PrivateKey, auth ethutils.SetCodeAuthorization) ethutils.SetCodeAuthorization {
+	sig, err := crypto.Sign(auth.SigHash().Bytes(), key)
+	if err != nil {
+		panic(err)
+	}
+	auth.R = new(big.Int).SetBytes(sig[:32])
+	auth.S = new(big.Int).SetBytes(sig[32:64])
+	auth.V = big.NewInt(int64(sig[64]))
+	return auth
+}
+
+func (suite *KeeperTestSuite) TestApplyAuthorizations() {
+	var (
+		authorityKey *ecdsa.PrivateKey
+		authority    common.Address
+	)
+	chainID := types.GetEthChainConfig().ChainID
+	target := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		auth     func() ethutils.SetCodeAuthorization
+		expCode  func() []byte
+		expNonce uint64
+	}{
+		{
+			"pass - delegates the code of the authority",
+			func() {},
+			func() ethutils.SetCodeAuthorization {
+				return ethutils.SetCodeAuthorization{ChainID: chainID, Address: target, Nonce: 0}
+			},
+			func() []byte { return ethutils.AddressToDelegation(target) },
+			1,
+		},
+		{
+			"pass - the zero chain id is valid on any chain",
+			func() {},
+			func() ethutils.SetCodeAuthorization {
+				return ethutils.SetCodeAuthorization{ChainID: big.NewInt(0), Address: target, Nonce: 0}
+			},
+			func() []byte { return ethutils.AddressToDelegation(target) },
+			1,
+		},
+		{
+			"pass - the zero address clears the delegation",
+			func() {
+				stateDB := suite.network.GetStateDB()
+				stateDB.SetCode(authority, ethutils.AddressToDelegation(target))
+				stateDB.SetNonce(authority, 1)
+				suite.Require().NoError(stateDB.Commit())
+			},
+			func() ethutils.SetCodeAuthorization {
+				return ethutils.SetCodeAuthorization{ChainID: chainID, Address: common.Address{}, Nonce: 1}
+			},
+			func() []byte { return nil },
+			2,
+		},
+		{
+			"skip - invalid chain id",
+			func() {},
+			func() ethutils.SetCodeAuthorization {
+				return ethutils.SetCodeAuthorization{ChainID: big.NewInt(1234), Address: target, Nonce: 0}
+			},
+			func() []byte { return nil },
+			0,
+		},
+		{
+			"skip - invalid nonce",
+			func() {},
+			func() ethutils.SetCodeAuthorization {
+				return ethutils.SetCodeAuthorization{ChainID: chainID, Address: target, Nonce: 1}
+			},
+			func() []byte { return nil },
+			0,
+		},
+		{
+			"skip - authority with code",
+			func() {
+				stateDB := suite.network.GetStateDB()
+				stateDB.SetCode(authority, []byte{0x60, 0x00})
+				suite.Require().NoError(stateDB.Commit())
+			},
+			func() ethutils.SetCodeAuthorization {
+				return ethutils.SetCodeAuthorization{ChainID: chainID, Address: target, Nonce: 0}
+			},
+			func() []byte { return []byte{0x60, 0x00} },
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			var err error
+			authorityKey, err = crypto.GenerateKey()
+			suite.Require().NoError(err)
+			authority = crypto.PubkeyToAddress(authorityKey.PublicKey)
+
+			tc.malleate()
+
+			sender := suite.keyring.GetAddr(0)
+			recipient := suite.keyring.GetAddr(1)
+			msg := types.SetCodeMessage{
+				Message: gethtypes.NewMessage(
+					sender,
+					&recipient,
+					0,
+					big.NewInt(0),
+					100_000,
+					big.NewInt(0),
+					big.NewInt(0),
+					big.NewInt(0),
+					nil,
+					nil,
+					false,
+				),
+				AuthList: []ethutils.SetCodeAuthorization{signAuthorization(authorityKey, tc.auth())},
+			}
+
+			// the invalid authorizations are skipped without failing the transaction
+			res, err := suite.network.App.EvmKeeper.ApplyMessage(suite.network.GetContext(), msg, nil, true)
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed(), res.VmError)
+
+			stateDB := suite.network.GetStateDB()
+			suite.Require().Equal(tc.expCode(), stateDB.GetCode(authority))
+			suite.Require().Equal(tc.expNonce, stateDB.GetNonce(authority))
+		})
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+)
+
+var (
+	_ statedb.Keeper      = &stateAccessTracker{}
+	_ statedb.BatchKeeper = &stateAccessTracker{}
+)
+
+// storageSlot identifies a storage slot of a contract.
+type storageSlot struct {
+	addr common.Address
+	key  common.Hash
+}
+
+// stateAccessTracker is a StateDB keeper that records the EVM state read by a
+// transaction and the writes it commits. The reads are validated against the
+// state in which the transaction is delivered, and the writes are replayed on
+// it if they are still valid.
+type stateAccessTracker struct {
+	keeper *Keeper
+
+	// accounts holds the first value read of each account, nil if it didn't exist
+	accounts map[common.Address]*statedb.Account
+	// storage holds the first value read of each storage slot
+	storage map[storageSlot]common.Hash
+	// untracked is true if the transaction read state that can't be validated
+	untracked bool
+
+	// writes holds the writes committed by the transaction, in order
+	writes []func(ctx sdk.Context) error
+}
+
+// newStateAccessTracker returns a tracker reading the state through the keeper.
+func newStateAccessTracker(k *Keeper) *stateAccessTracker {
+	return &stateAccessTracker{
+		keeper:   k,
+		accounts: make(map[common.Address]*statedb.Account),
+		storage:  make(map[storageSlot]common.Hash),
+	}
+}
+
+// GetAccount implements statedb.Keeper and records the account read.
+func (t *stateAccessTracker) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account {
+	account := t.keeper.GetAccount(ctx, addr)
+	if _, found := t.accounts[addr]; !found {
+		t.accounts[addr] = copyAccount(account)
+	}
+	return account
+}
+
+// GetState implements statedb.Keeper and records the storage slot read.
+func (t *stateAccessTracker) GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash {
+	value := t.keeper.GetState(ctx, addr, key)
+	slot := storageSlot{addr: addr, key: key}
+	if _, found := t.storage[slot]; !found {
+		t.storage[slot] = value
+	}
+	return value
+}
+
+// GetCode implements statedb.Keeper. The code is not recorded, as it's
+// addressed by its hash.
+func (t *stateAccessTracker) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
+	return t.keeper.GetCode(ctx, codeHash)
+}
+
+// ForEachStorage implements statedb.Keeper. The iteration of the storage of an
+// account can't be validated, so the transaction is marked as untracked.
+func (t *stateAccessTracker) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
+	t.untracked = true
+	t.keeper.ForEachStorage(ctx, addr, cb)
+}
+
+// SetAccount implements statedb.Keeper and records the write.
+func (t *stateAccessTracker) SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error {
+	written := *copyAccount(&account)
+	t.writes = append(t.writes, func(ctx sdk.Context) error {
+		return t.keeper.SetAccount(ctx, addr, *copyAccount(&written))
+	})
+	return t.keeper.SetAccount(ctx, addr, account)
+}
+
+// DeleteState implements statedb.Keeper and records the write.
+func (t *stateAccessTracker) DeleteState(ctx sdk.Context, addr common.Address, key common.Hash) {
+	t.writes = append(t.writes, func(ctx sdk.Context) error {
+		t.keeper.DeleteState(ctx, addr, key)
+		return nil
+	})
+	t.keeper.DeleteState(ctx, addr, key)
+}
+
+// SetState implements statedb.Keeper and records the write.
+func (t *stateAccessTracker) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	written := common.CopyBytes(value)
+	t.writes = append(t.writes, func(ctx sdk.Context) error {
+		t.keeper.SetState(ctx, addr, key, written)
+		return nil
+	})
+	t.keeper.SetState(ctx, addr, key, value)
+}
+
+// SetStorage implements statedb.BatchKeeper and records the write.
+func (t *stateAccessTracker) SetStorage(ctx sdk.Context, addr common.Address, writes []statedb.StorageWrite) {
+	written := make([]statedb.StorageWrite, len(writes))
+	for i, write := range writes {
+		written[i] = statedb.StorageWrite{Key: write.Key, Value: common.CopyBytes(write.Value)}
+	}
+	t.writes = append(t.writes, func(ctx sdk.Context) error {
+		t.keeper.SetStorage(ctx, addr, written)
+		return nil
+	})
+	t.keeper.SetStorage(ctx, addr, writes)
+}
+
+// DeleteCode implements statedb.Keeper and records the write.
+func (t *stateAccessTracker) DeleteCode(ctx sdk.Context, codeHash []byte) {
+	written := common.CopyBytes(codeHash)
+	t.writes = append(t.writes, func(ctx sdk.Context) error {
+		t.keeper.DeleteCode(ctx, written)
+		return nil
+	})
+	t.keeper.DeleteCode(ctx, codeHash)
+}
+
+// SetCode implements statedb.Keeper and records the write.
+func (t *stateAccessTracker) SetCode(ctx sdk.Context, codeHash []byte, code []byte) {
+	writtenHash, writtenCode := common.CopyBytes(codeHash), common.CopyBytes(code)
+	t.writes = append(t.writes, func(ctx sdk.Context) error {
+		t.keeper.SetCode(ctx, writtenHash, writtenCode)
+		return nil
+	})
+	t.keeper.SetCode(ctx, codeHash, code)
+}
+
+// DeleteAccount implements statedb.Keeper and records the write. The deletion
+// reads the bank balances of the account, which can't be validated, so the
+// transaction is marked as untracked.
+func (t *stateAccessTracker) DeleteAccount(ctx sdk.Context, addr common.Address) error {
+	t.untracked = true
+	t.writes = append(t.writes, func(ctx sdk.Context) error {
+		return t.keeper.DeleteAccount(ctx, addr)
+	})
+	return t.keeper.DeleteAccount(ctx, addr)
+}
+
+// validate returns true if the state read by the transaction is unchanged on
+// the given context.
+func (t *stateAccessTracker) validate(ctx sdk.Context) bool {
+	if t.untracked {
+		return false
+	}
+
+	for addr, read := range t.accounts {
+		if !equalAccounts(read, t.keeper.GetAccount(ctx, addr)) {
+			return false
+		}
+	}
+
+	for slot, read := range t.storage {
+		if t.keeper.GetState(ctx, slot.addr, slot.key) != read {
+			return false
+		}
+	}
+
+	return true
+}
+
+// replay applies the writes committed by the transaction on the given context.
+func (t *stateAccessTracker) replay(ctx sdk.Context) error {
+	for _, write := range t.writes {
+		if err := write(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyAccount returns a deep copy of the account.
+func copyAccount(account *statedb.Account) *statedb.Account {
+	if account == nil {
+		return nil
+	}
+
+	cpy := &statedb.Account{
+		Nonce:    account.Nonce,
+		CodeHash: common.CopyBytes(account.CodeHash),
+	}
+	if account.Balance != nil {
+		cpy.Balance = new(big.Int).Set(account.Balance)
+	}
+	return cpy
+}
+
+// equalAccounts returns true if both accounts are nil or have the same fields.
+func equalAccounts(a, b *statedb.Account) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.Nonce == b.Nonce &&
+		bytes.Equal(a.CodeHash, b.CodeHash) &&
+		a.Balance.Cmp(b.Balance) == 0
+}
@@ -0,0 +1,88 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// DumpState inserts the EVM state of every account into the collector, in the
+// format of the go-ethereum state dumps. As the EVM state is not stored in a
+// trie, the given root is reported as the state root, while the storage root
+// of each account is computed from its storage as go-ethereum does.
+func (k *Keeper) DumpState(ctx sdk.Context, root common.Hash, c state.DumpCollector) {
+	c.OnRoot(root)
+
+	k.accountKeeper.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+		addr := common.BytesToAddress(account.GetAddress())
+		codeHash := k.GetCodeHash(ctx, addr)
+
+		dumpAccount := state.DumpAccount{
+			Balance:  k.GetBalance(ctx, addr).String(),
+			Nonce:    account.GetSequence(),
+			CodeHash: codeHash.Bytes(),
+			Address:  &addr,
+		}
+
+		if !types.IsEmptyCodeHash(codeHash.Bytes()) {
+			dumpAccount.Code = k.GetCode(ctx, codeHash)
+		}
+
+		storage := k.GetAccountStorage(ctx, addr)
+		dumpAccount.Root = storageRoot(storage).Bytes()
+		if len(storage) > 0 {
+			dumpAccount.Storage = make(map[common.Hash]string, len(storage))
+			for _, slot := range storage {
+				value := common.HexToHash(slot.Value)
+				dumpAccount.Storage[common.HexToHash(slot.Key)] = common.Bytes2Hex(common.TrimLeftZeroes(value.Bytes()))
+			}
+		}
+
+		c.OnAccount(addr, dumpAccount)
+		return false
+	})
+}
+
+// storageRoot returns the root of the go-ethereum storage trie holding the
+// given storage, where the keys are hashed and the values are RLP encoded.
+func storageRoot(storage types.Storage) common.Hash {
+	type trieEntry struct {
+		key, value []byte
+	}
+
+	entries := make([]trieEntry, 0, len(storage))
+	for _, slot := range storage {
+		value := common.TrimLeftZeroes(common.HexToHash(slot.Value).Bytes())
+		if len(value) == 0 {
+			continue
+		}
+
+		// the encoding of a byte slice doesn't fail
+		encoded, _ := rlp.EncodeToBytes(value)
+		entries = append(entries, trieEntry{
+			key:   crypto.Keccak256(common.HexToHash(slot.Key).Bytes()),
+			value: encoded,
+		})
+	}
+
+	// the stack trie requires the keys to be inserted in order
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+
+	stackTrie := trie.NewStackTrie(nil)
+	for _, entry := range entries {
+		stackTrie.Update(entry.key, entry.value)
+	}
+	return stackTrie.Hash()
+}
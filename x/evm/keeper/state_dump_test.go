@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+type mapDumpCollector struct {
+	root     common.Hash
+	accounts map[common.Address]state.DumpAccount
+}
+
+func (c *mapDumpCollector) OnRoot(root common.Hash) {
+	c.root = root
+}
+
+func (c *mapDumpCollector) OnAccount(addr common.Address, account state.DumpAccount) {
+	c.accounts[addr] = account
+}
+
+func (suite *KeeperTestSuite) TestDumpState() {
+	suite.SetupTest()
+
+	contract := common.BytesToAddress([]byte("contract"))
+	code := []byte("code")
+	storage := map[common.Hash]common.Hash{
+		common.BytesToHash([]byte("key1")): common.BytesToHash([]byte("value1")),
+		common.BytesToHash([]byte("key2")): common.BigToHash(common.Big1),
+	}
+
+	vmdb := suite.StateDB()
+	vmdb.SetCode(contract, code)
+	for key, value := range storage {
+		vmdb.SetState(contract, key, value)
+	}
+	suite.Require().NoError(vmdb.Commit())
+
+	// the storage root computed by go-ethereum for the same storage
+	storageTrie, err := trie.NewStateTrie(common.Hash{}, common.Hash{}, trie.NewDatabase(rawdb.NewMemoryDatabase()))
+	suite.Require().NoError(err)
+	for key, value := range storage {
+		encoded, err := rlp.EncodeToBytes(common.TrimLeftZeroes(value.Bytes()))
+		suite.Require().NoError(err)
+		suite.Require().NoError(storageTrie.TryUpdate(key.Bytes(), encoded))
+	}
+
+	ctx := suite.network.GetContext()
+	root := common.BytesToHash([]byte("root"))
+	collector := &mapDumpCollector{accounts: make(map[common.Address]state.DumpAccount)}
+	suite.network.App.EvmKeeper.DumpState(ctx, root, collector)
+
+	suite.Require().Equal(root, collector.root)
+
+	contractAccount, found := collector.accounts[contract]
+	suite.Require().True(found)
+	suite.Require().Equal(contract, *contractAccount.Address)
+	suite.Require().Equal([]byte(contractAccount.Code), code)
+	suite.Require().Equal(crypto.Keccak256(code), []byte(contractAccount.CodeHash))
+	suite.Require().Equal(storageTrie.Hash().Bytes(), []byte(contractAccount.Root))
+	suite.Require().Equal(map[common.Hash]string{
+		common.BytesToHash([]byte("key1")): common.Bytes2Hex([]byte("value1")),
+		common.BytesToHash([]byte("key2")): "01",
+	}, contractAccount.Storage)
+
+	sender := suite.keyring.GetAddr(0)
+	senderAccount, found := collector.accounts[sender]
+	suite.Require().True(found)
+	suite.Require().Equal(suite.network.App.EvmKeeper.GetBalance(ctx, sender).String(), senderAccount.Balance)
+	suite.Require().Equal(suite.network.App.EvmKeeper.GetNonce(ctx, sender), senderAccount.Nonce)
+	suite.Require().Equal(ethtypes.EmptyRootHash.Bytes(), []byte(senderAccount.Root))
+	suite.Require().Empty(senderAccount.Code)
+	suite.Require().Empty(senderAccount.Storage)
+}
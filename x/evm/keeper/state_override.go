@@ -0,0 +1,98 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// ApplyStateOverrides applies the JSON encoded state override set to the
+// given context, following the go-ethereum eth_call semantics. Balances are
+// backed by the bank module, so the overrides mint or burn the EVM denom of
+// the account, which also applies to precompile addresses. Storage overrides
+// are applied to the EVM store of the account, while code overrides are not
+// allowed on precompiles since their code is native.
+//
+// CONTRACT: the context must be a cached or query context whose changes are
+// discarded after the call.
+func (k *Keeper) ApplyStateOverrides(ctx sdk.Context, bz []byte, txConfig statedb.TxConfig) error {
+	if len(bz) == 0 {
+		return nil
+	}
+
+	var overrides types.StateOverride
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return errorsmod.Wrap(err, "invalid state override")
+	}
+
+	if err := overrides.Validate(); err != nil {
+		return err
+	}
+
+	addresses := overrides.Addresses()
+
+	for _, addr := range addresses {
+		account := overrides[addr]
+		if account.Code != nil {
+			if _, found, err := k.GetPrecompileInstance(ctx, addr); err != nil {
+				return err
+			} else if found {
+				return errorsmod.Wrapf(types.ErrInvalidStateOverride, "cannot override the code of precompile %s", addr)
+			}
+		}
+
+		// replace the whole storage of the account
+		if account.State != nil {
+			var keys []common.Hash
+			k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			})
+			for _, key := range keys {
+				k.DeleteState(ctx, addr, key)
+			}
+		}
+	}
+
+	stateDB := statedb.New(ctx, k, txConfig)
+
+	for _, addr := range addresses {
+		account := overrides[addr]
+		if account.Nonce != nil {
+			stateDB.SetNonce(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			stateDB.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			balance := (*account.Balance).ToInt()
+			delta := new(big.Int).Sub(balance, stateDB.GetBalance(addr))
+			switch delta.Sign() {
+			case 1:
+				stateDB.AddBalance(addr, delta)
+			case -1:
+				stateDB.SubBalance(addr, new(big.Int).Neg(delta))
+			}
+		}
+
+		state := account.State
+		if state == nil {
+			state = account.StateDiff
+		}
+		if state != nil {
+			for key, value := range *state {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+	}
+
+	return stateDB.Commit()
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestApplyStateOverrides() {
+	stakingPrecompile := common.HexToAddress(types.StakingPrecompileAddress)
+	key := common.BytesToHash([]byte("key"))
+	otherKey := common.BytesToHash([]byte("other key"))
+	value := common.BytesToHash([]byte("value"))
+
+	testCases := []struct {
+		name        string
+		overrides   func() types.StateOverride
+		expectedErr string
+		postCheck   func()
+	}{
+		{
+			name: "fail - both state and stateDiff",
+			overrides: func() types.StateOverride {
+				state := map[common.Hash]common.Hash{key: value}
+				return types.StateOverride{
+					suite.keyring.GetAddr(0): {State: &state, StateDiff: &state},
+				}
+			},
+			expectedErr: "has both 'state' and 'stateDiff'",
+		},
+		{
+			name: "fail - code override on a precompile",
+			overrides: func() types.StateOverride {
+				code := hexutil.Bytes{0x60, 0x00}
+				return types.StateOverride{
+					stakingPrecompile: {Code: &code},
+				}
+			},
+			expectedErr: "cannot override the code of precompile",
+		},
+		{
+			name: "pass - override balance, nonce and code of an account",
+			overrides: func() types.StateOverride {
+				balance := (*hexutil.Big)(big.NewInt(1_000))
+				nonce := hexutil.Uint64(7)
+				code := hexutil.Bytes{0x60, 0x00}
+				return types.StateOverride{
+					suite.keyring.GetAddr(0): {Balance: &balance, Nonce: &nonce, Code: &code},
+				}
+			},
+			postCheck: func() {
+				ctx := suite.network.GetContext()
+				addr := suite.keyring.GetAddr(0)
+				account := suite.network.App.EvmKeeper.GetAccount(ctx, addr)
+				suite.Require().Equal(big.NewInt(1_000), account.Balance)
+				suite.Require().Equal(uint64(7), account.Nonce)
+				suite.Require().Equal([]byte{0x60, 0x00}, suite.network.App.EvmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash)))
+			},
+		},
+		{
+			name: "pass - override balance and storage of a precompile",
+			overrides: func() types.StateOverride {
+				balance := (*hexutil.Big)(big.NewInt(5))
+				diff := map[common.Hash]common.Hash{key: value}
+				return types.StateOverride{
+					stakingPrecompile: {Balance: &balance, StateDiff: &diff},
+				}
+			},
+			postCheck: func() {
+				ctx := suite.network.GetContext()
+				suite.Require().Equal(big.NewInt(5), suite.network.App.EvmKeeper.GetAccount(ctx, stakingPrecompile).Balance)
+				suite.Require().Equal(value, suite.network.App.EvmKeeper.GetState(ctx, stakingPrecompile, key))
+			},
+		},
+		{
+			name: "pass - state replaces the whole storage",
+			overrides: func() types.StateOverride {
+				ctx := suite.network.GetContext()
+				suite.network.App.EvmKeeper.SetState(ctx, stakingPrecompile, otherKey, value.Bytes())
+
+				state := map[common.Hash]common.Hash{key: value}
+				return types.StateOverride{
+					stakingPrecompile: {State: &state},
+				}
+			},
+			postCheck: func() {
+				ctx := suite.network.GetContext()
+				suite.Require().Equal(value, suite.network.App.EvmKeeper.GetState(ctx, stakingPrecompile, key))
+				suite.Require().Equal(common.Hash{}, suite.network.App.EvmKeeper.GetState(ctx, stakingPrecompile, otherKey))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			bz, err := json.Marshal(tc.overrides())
+			suite.Require().NoError(err)
+
+			txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+			err = suite.network.App.EvmKeeper.ApplyStateOverrides(ctx, bz, txConfig)
+			if tc.expectedErr != "" {
+				suite.Require().ErrorContains(err, tc.expectedErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.postCheck()
+		})
+	}
+}
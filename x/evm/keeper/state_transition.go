@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	evmostypes "github.com/evmos/evmos/v20/types"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
 	"github.com/evmos/evmos/v20/x/evm/statedb"
 	"github.com/evmos/evmos/v20/x/evm/types"
 
@@ -20,6 +21,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	evmoscore "github.com/evmos/evmos/v20/x/evm/core/core"
+	"github.com/evmos/evmos/v20/x/evm/core/tracers"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 )
 
@@ -69,6 +71,9 @@ func (k *Keeper) NewEVM(
 		accessControl.GetCallHook(signer),
 		k.GetPrecompilesCallHook(ctx),
 	)
+	if blockedContractsHook := types.GetBlockedContractsCallHook(cfg.Params.BlockedContracts); blockedContractsHook != nil {
+		evmHooks.AddCallHooks(blockedContractsHook)
+	}
 	return vm.NewEVMWithHooks(evmHooks, blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
 }
 
@@ -146,13 +151,10 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
 func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*types.MsgEthereumTxResponse, error) {
-	var bloom *big.Int
-
 	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress))
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
 	}
-	txConfig := k.TxConfig(ctx, tx.Hash())
 
 	// get the signer according to the chain rules from the config and block height
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
@@ -161,13 +163,75 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
 	}
 
+	return k.applyTransaction(ctx, cfg, tx.Hash(), msg)
+}
+
+// ApplySetCodeTransaction runs and attempts to perform a state transition with
+// the given EIP-7702 set code transaction, like ApplyTransaction does for the
+// transaction types supported by go-ethereum.
+func (k *Keeper) ApplySetCodeTransaction(ctx sdk.Context, tx *types.SetCodeTx) (*types.MsgEthereumTxResponse, error) {
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	msg, err := tx.AsMessage(cfg.BaseFee)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to return set code transaction as core message")
+	}
+
+	return k.applyTransaction(ctx, cfg, tx.AsSetCodeTx().Hash(), msg)
+}
+
+// applyTransaction applies the core message of the transaction with the given
+// hash and updates the transient block state.
+func (k *Keeper) applyTransaction(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txHash common.Hash,
+	msg core.Message,
+) (*types.MsgEthereumTxResponse, error) {
+	var bloom *big.Int
+
+	txConfig := k.TxConfig(ctx, txHash)
+
 	// Create a cache context to revert state. The cache context is only committed when both tx and hooks executed successfully.
 	// Didn't use `Snapshot` because the context stack has exponential complexity on certain operations,
 	// thus restricted to be used only inside `ApplyMessage`.
 	tmpCtx, commit := ctx.CacheContext()
 
-	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	// reuse the result of the parallel pre-execution of the tx if the state it
+	// read is unchanged, otherwise execute it sequentially
+	res, found := k.applyPreExecutedTx(tmpCtx, cfg, txConfig)
+
+	var (
+		err             error
+		telemetryTracer *types.TelemetryTracer
+		liveTracer      tracers.Tracer
+	)
+	if !found {
+		// collect the execution statistics and the call trace of the tx if
+		// the telemetry and the live tracer are enabled
+		var txTracers types.MultiTracer
+		if telemetryTracer = k.telemetryTracer(ctx); telemetryTracer != nil {
+			txTracers = append(txTracers, telemetryTracer)
+		}
+		if liveTracer = k.liveTracer(ctx, txConfig); liveTracer != nil {
+			txTracers = append(txTracers, liveTracer)
+		}
+
+		var tracer vm.EVMLogger
+		switch len(txTracers) {
+		case 0:
+		case 1:
+			tracer = txTracers[0]
+		default:
+			tracer = txTracers
+		}
+
+		// pass true to commit the StateDB
+		res, err = k.ApplyMessageWithConfig(tmpCtx, msg, tracer, true, cfg, txConfig)
+	}
 	if err != nil {
 		// when a transaction contains multiple msg, as long as one of the msg fails
 		// all gas will be deducted. so is not msg.Gas()
@@ -184,14 +248,32 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	}
 
 	if !res.Failed() {
+		// record the ERC-20 holders whose balance changed before committing the
+		// cached context
+		k.erc20Keeper.TrackERC20Transfers(tmpCtx, logs)
 		commit()
 	}
 
+	k.recordTxTelemetry(ctx, res, telemetryTracer)
+	k.storeTxTrace(ctx, txHash, liveTracer)
+
 	evmDenom := types.GetEVMCoinDenom()
 
+	// the leftover gas is refunded to the sponsor of the transaction, if any
+	refundRecipient := msg.From()
+	if feePayer, found := k.GetTxFeePayerTransient(ctx, txHash); found {
+		refundRecipient = feePayer
+	}
+
+	// the leftover gas is refunded in the token used to pay the fees, if any
+	var feeToken *sdk.DecCoin
+	if price, found := k.GetTxFeeTokenTransient(ctx, txHash); found {
+		feeToken = &price
+	}
+
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, evmDenom); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
+	if err = k.refundGas(ctx, refundRecipient, msg, msg.Gas()-res.GasUsed, evmDenom, feeToken); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to %s", refundRecipient)
 	}
 
 	if len(logs) > 0 {
@@ -268,13 +350,27 @@ func (k *Keeper) ApplyMessageWithConfig(
 	commit bool,
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, error) {
+	stateDB := statedb.New(ctx, k, txConfig)
+	return k.applyMessageWithStateDB(ctx, msg, tracer, commit, cfg, txConfig, stateDB)
+}
+
+// applyMessageWithStateDB applies the message against the given StateDB, which
+// allows to track the state accessed by the message through its keeper.
+func (k *Keeper) applyMessageWithStateDB(
+	ctx sdk.Context,
+	msg core.Message,
+	tracer vm.EVMLogger,
+	commit bool,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	stateDB *statedb.StateDB,
 ) (*types.MsgEthereumTxResponse, error) {
 	var (
 		ret   []byte // return bytes from evm execution
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
 	)
 
-	stateDB := statedb.New(ctx, k, txConfig)
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	leftoverGas := msg.Gas()
@@ -308,7 +404,21 @@ func (k *Keeper) ApplyMessageWithConfig(
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
 	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil); rules.IsBerlin {
-		stateDB.PrepareAccessList(msg.From(), msg.To(), evm.ActivePrecompiles(rules), msg.AccessList())
+		precompiles := withEIPPrecompiles(evm.ActivePrecompiles(rules), cfg.Params.EIPsAt(ctx.BlockHeight()))
+		stateDB.PrepareAccessList(msg.From(), msg.To(), precompiles, msg.AccessList())
+	}
+
+	// apply the EIP-7702 authorizations before the execution, once the sender
+	// nonce is incremented by the ante handler
+	if setCodeMsg, ok := msg.(types.SetCodeMessage); ok {
+		k.applyAuthorizations(ctx, stateDB, cfg.ChainConfig.ChainID, setCodeMsg.AuthList)
+	}
+
+	// warm the delegation target of the recipient, as its code is the one executed
+	if to := msg.To(); to != nil {
+		if target, ok := ethutils.ParseDelegation(stateDB.GetCode(*to)); ok {
+			stateDB.AddAddressToAccessList(target)
+		}
 	}
 
 	if contractCreation {
@@ -335,6 +445,8 @@ func (k *Keeper) ApplyMessageWithConfig(
 	}
 	// refund gas
 	temporaryGasUsed := msg.Gas() - leftoverGas
+	// the gas consumed before the refund, excluding the intrinsic gas
+	executionGas := temporaryGasUsed - intrinsicGas
 	refund := GasToRefund(stateDB.GetRefund(), temporaryGasUsed, refundQuotient)
 
 	// update leftoverGas and temporaryGasUsed with refund amount
@@ -373,11 +485,17 @@ func (k *Keeper) ApplyMessageWithConfig(
 	// reset leftoverGas, to be used by the tracer
 	leftoverGas = msg.Gas() - gasUsed
 
-	return &types.MsgEthereumTxResponse{
+	res := &types.MsgEthereumTxResponse{
 		GasUsed: gasUsed,
 		VmError: vmError,
 		Ret:     ret,
 		Logs:    types.NewLogsFromEth(stateDB.Logs()),
 		Hash:    txConfig.TxHash.Hex(),
-	}, nil
+	}
+
+	if k.gasReport {
+		res.GasReport = types.NewGasReport(intrinsicGas, executionGas, evm.PrecompileGasUsed(), refund)
+	}
+
+	return res, nil
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/utils"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/keeper"
 	"github.com/evmos/evmos/v20/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
@@ -597,6 +598,53 @@ func (suite *KeeperTestSuite) TestApplyMessage() {
 	suite.Require().Equal(expectedGasUsed, res.GasUsed)
 }
 
+// TestApplyMessageWarmBLSPrecompiles checks that the EIP-2537 precompiles are
+// warm before the execution, like the rest of the Ethereum precompiles.
+func (suite *KeeperTestSuite) TestApplyMessageWarmBLSPrecompiles() {
+	suite.SetupTest()
+
+	params := suite.network.App.EvmKeeper.GetParams(suite.network.GetContext())
+	params.ExtraEIPs = append(params.ExtraEIPs, vm.EIP2537)
+	suite.Require().NoError(suite.network.App.EvmKeeper.SetParams(suite.network.GetContext(), params))
+
+	// callGasUsed returns the gas used by a contract that calls the given
+	// precompile without gas, so only the account access is charged.
+	callGasUsed := func(precompile common.Address) uint64 {
+		contract := utiltx.GenerateAddress()
+		// STATICCALL(0, precompile, 0, 0, 0, 0) and STOP
+		code := append([]byte{0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x73}, precompile.Bytes()...)
+		code = append(code, 0x60, 0x00, 0xfa, 0x00)
+
+		stateDB := suite.network.GetStateDB()
+		stateDB.SetCode(contract, code)
+		suite.Require().NoError(stateDB.Commit())
+
+		msg := gethtypes.NewMessage(
+			suite.keyring.GetAddr(0),
+			&contract,
+			0,
+			big.NewInt(0),
+			100_000,
+			big.NewInt(0),
+			big.NewInt(0),
+			big.NewInt(0),
+			nil,
+			nil,
+			false,
+		)
+		res, err := suite.network.App.EvmKeeper.ApplyMessage(suite.network.GetContext(), msg, nil, true)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed(), res.VmError)
+		return res.GasUsed
+	}
+
+	// the ecrecover precompile is always warm
+	expGasUsed := callGasUsed(common.BytesToAddress([]byte{0x01}))
+	for _, address := range vm.PrecompiledAddressesBLS {
+		suite.Require().Equal(expGasUsed, callGasUsed(address), "expected %s to be warm", address)
+	}
+}
+
 func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 	suite.enableFeemarket = true
 	defer func() { suite.enableFeemarket = false }()
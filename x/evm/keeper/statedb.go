@@ -15,7 +15,10 @@ import (
 	"github.com/evmos/evmos/v20/x/evm/types"
 )
 
-var _ statedb.Keeper = &Keeper{}
+var (
+	_ statedb.Keeper      = &Keeper{}
+	_ statedb.BatchKeeper = &Keeper{}
+)
 
 // ----------------------------------------------------------------------------
 // StateDB Keeper implementation
@@ -175,6 +178,25 @@ func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash,
 	)
 }
 
+// SetStorage writes the given slots of the contract storage in a single batch,
+// deleting the slots with an empty value. It implements statedb.BatchKeeper.
+func (k *Keeper) SetStorage(ctx sdk.Context, addr common.Address, writes []statedb.StorageWrite) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	for _, write := range writes {
+		if len(write.Value) == 0 {
+			store.Delete(write.Key.Bytes())
+		} else {
+			store.Set(write.Key.Bytes(), write.Value)
+		}
+	}
+
+	k.Logger(ctx).Debug(
+		"storage updated",
+		"ethereum-address", addr.Hex(),
+		"slots", len(writes),
+	)
+}
+
 // DeleteState deletes the entry for the given key in the contract storage
 // at the defined contract address.
 func (k *Keeper) DeleteState(ctx sdk.Context, addr common.Address, key common.Hash) {
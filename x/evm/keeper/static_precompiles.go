@@ -5,21 +5,38 @@ package keeper
 
 import (
 	"fmt"
-	"maps"
 	"slices"
 
+	errorsmod "cosmossdk.io/errors"
+	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	authzprecompile "github.com/evmos/evmos/v20/precompiles/authz"
 	bankprecompile "github.com/evmos/evmos/v20/precompiles/bank"
 	"github.com/evmos/evmos/v20/precompiles/bech32"
 	distprecompile "github.com/evmos/evmos/v20/precompiles/distribution"
+	evidenceprecompile "github.com/evmos/evmos/v20/precompiles/evidence"
+	feegrantprecompile "github.com/evmos/evmos/v20/precompiles/feegrant"
 	govprecompile "github.com/evmos/evmos/v20/precompiles/gov"
 	ics20precompile "github.com/evmos/evmos/v20/precompiles/ics20"
+	"github.com/evmos/evmos/v20/precompiles/multicall"
+	osmosisoutpost "github.com/evmos/evmos/v20/precompiles/outposts/osmosis"
+	strideoutpost "github.com/evmos/evmos/v20/precompiles/outposts/stride"
 	"github.com/evmos/evmos/v20/precompiles/p256"
+	"github.com/evmos/evmos/v20/precompiles/schnorr"
+	slashingprecompile "github.com/evmos/evmos/v20/precompiles/slashing"
 	stakingprecompile "github.com/evmos/evmos/v20/precompiles/staking"
 	vestingprecompile "github.com/evmos/evmos/v20/precompiles/vesting"
 	erc20Keeper "github.com/evmos/evmos/v20/x/erc20/keeper"
@@ -32,9 +49,19 @@ import (
 
 const bech32PrecompileBaseGas = 6_000
 
-// AvailableStaticPrecompiles returns the list of all available static precompiled contracts.
+// PrecompileParamsKeeper defines the expected interface of the static
+// precompiles to read the EVM params. It is implemented by the EVM module
+// keeper.
+type PrecompileParamsKeeper interface {
+	bech32.HRPKeeper
+	osmosisoutpost.ParamsKeeper
+	strideoutpost.ParamsKeeper
+}
+
+// NewStaticPrecompileRegistry returns the registry of all available static precompiled contracts.
+// The stateful precompiles are only instantiated the first time they are needed.
 // NOTE: this should only be used during initialization of the Keeper.
-func NewAvailableStaticPrecompiles(
+func NewStaticPrecompileRegistry(
 	stakingKeeper stakingkeeper.Keeper,
 	distributionKeeper distributionkeeper.Keeper,
 	bankKeeper bankkeeper.Keeper,
@@ -43,104 +70,205 @@ func NewAvailableStaticPrecompiles(
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
+	rateLimitKeeper ratelimitkeeper.Keeper,
 	govKeeper govkeeper.Keeper,
-) map[common.Address]vm.PrecompiledContract {
-	// Clone the mapping from the latest EVM fork.
-	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
-
-	// secp256r1 precompile as per EIP-7212
-	p256Precompile := &p256.Precompile{}
+	slashingKeeper slashingkeeper.Keeper,
+	evidenceKeeper evidencekeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
+	paramsKeeper PrecompileParamsKeeper,
+	cdc codec.Codec,
+) *PrecompileRegistry {
+	registry := NewPrecompileRegistry()
 
-	bech32Precompile, err := bech32.NewPrecompile(bech32PrecompileBaseGas)
-	if err != nil {
-		panic(fmt.Errorf("failed to instantiate bech32 precompile: %w", err))
+	// Register the precompiles from the latest EVM fork.
+	for address, precompile := range vm.PrecompiledContractsBerlin {
+		registry.RegisterInstance(address, precompile)
 	}
 
-	stakingPrecompile, err := stakingprecompile.NewPrecompile(stakingKeeper, authzKeeper)
-	if err != nil {
-		panic(fmt.Errorf("failed to instantiate staking precompile: %w", err))
-	}
-
-	distributionPrecompile, err := distprecompile.NewPrecompile(
-		distributionKeeper,
-		stakingKeeper,
-		authzKeeper,
-	)
-	if err != nil {
-		panic(fmt.Errorf("failed to instantiate distribution precompile: %w", err))
+	// BLS12-381 precompiles as per EIP-2537, only available when the EIP is enabled
+	for address, precompile := range vm.PrecompiledContractsBLS {
+		registry.RegisterInstance(address, precompile)
 	}
 
-	ibcTransferPrecompile, err := ics20precompile.NewPrecompile(
-		stakingKeeper,
-		transferKeeper,
-		channelKeeper,
-		authzKeeper,
-	)
-	if err != nil {
-		panic(fmt.Errorf("failed to instantiate ICS20 precompile: %w", err))
-	}
+	// Stateless precompiles
+	// secp256r1 precompile as per EIP-7212
+	registry.RegisterInstance(common.HexToAddress(types.P256PrecompileAddress), &p256.Precompile{})
+	registry.Register(common.HexToAddress(types.Bech32PrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return bech32.NewPrecompile(bech32PrecompileBaseGas, paramsKeeper)
+	})
+	registry.Register(common.HexToAddress(types.SchnorrPrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return schnorr.NewPrecompile()
+	})
+	registry.Register(common.HexToAddress(types.MulticallPrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return multicall.NewPrecompile()
+	})
+	registry.Register(common.HexToAddress(types.P256BatchPrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return p256.NewBatchPrecompile()
+	})
 
-	vestingPrecompile, err := vestingprecompile.NewPrecompile(vestingKeeper, authzKeeper)
-	if err != nil {
-		panic(fmt.Errorf("failed to instantiate vesting precompile: %w", err))
-	}
+	// Stateful precompiles
+	registry.Register(common.HexToAddress(types.StakingPrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return stakingprecompile.NewPrecompile(stakingKeeper, authzKeeper)
+	})
+	registry.Register(common.HexToAddress(types.DistributionPrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return distprecompile.NewPrecompile(distributionKeeper, stakingKeeper, bankKeeper, authzKeeper)
+	})
+	registry.Register(common.HexToAddress(types.ICS20PrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return ics20precompile.NewPrecompile(stakingKeeper, transferKeeper, channelKeeper, rateLimitKeeper, bankKeeper, authzKeeper)
+	})
+	registry.Register(common.HexToAddress(types.VestingPrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return vestingprecompile.NewPrecompile(vestingKeeper, authzKeeper)
+	})
+	registry.Register(common.HexToAddress(types.BankPrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return bankprecompile.NewPrecompile(bankKeeper, erc20Keeper)
+	})
+	registry.Register(common.HexToAddress(types.GovPrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return govprecompile.NewPrecompile(govKeeper, bankKeeper, authzKeeper, cdc)
+	})
+	registry.Register(common.HexToAddress(types.SlashingPrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return slashingprecompile.NewPrecompile(slashingKeeper, authzKeeper)
+	})
+	registry.Register(common.HexToAddress(types.EvidencePrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return evidenceprecompile.NewPrecompile(evidenceKeeper, authzKeeper, cdc)
+	})
+	registry.Register(common.HexToAddress(types.FeegrantPrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return feegrantprecompile.NewPrecompile(feegrantKeeper, authzKeeper)
+	})
+	registry.Register(common.HexToAddress(types.AuthzPrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return authzprecompile.NewPrecompile(authzKeeper)
+	})
 
-	bankPrecompile, err := bankprecompile.NewPrecompile(bankKeeper, erc20Keeper)
-	if err != nil {
-		panic(fmt.Errorf("failed to instantiate bank precompile: %w", err))
-	}
+	// Outposts
+	registry.Register(common.HexToAddress(types.StrideOutpostPrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return strideoutpost.NewPrecompile(
+			transfertypes.PortID,
+			paramsKeeper,
+			transferKeeper,
+			channelKeeper,
+		)
+	})
+	registry.Register(common.HexToAddress(types.OsmosisOutpostPrecompileAddress), func() (vm.PrecompiledContract, error) {
+		return osmosisoutpost.NewPrecompile(
+			transfertypes.PortID,
+			paramsKeeper,
+			erc20Keeper,
+			transferKeeper,
+			channelKeeper,
+		)
+	})
 
-	govPrecompile, err := govprecompile.NewPrecompile(govKeeper, authzKeeper)
-	if err != nil {
-		panic(fmt.Errorf("failed to instantiate gov precompile: %w", err))
-	}
+	return registry
+}
 
-	// Stateless precompiles
-	precompiles[bech32Precompile.Address()] = bech32Precompile
-	precompiles[p256Precompile.Address()] = p256Precompile
+// AvailableStaticPrecompiles returns the list of all available static precompiled contracts.
+// Unlike NewStaticPrecompileRegistry, all the precompiles are instantiated eagerly.
+// NOTE: this should only be used during initialization of the Keeper.
+func NewAvailableStaticPrecompiles(
+	stakingKeeper stakingkeeper.Keeper,
+	distributionKeeper distributionkeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+	erc20Keeper erc20Keeper.Keeper,
+	vestingKeeper vestingkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	transferKeeper transferkeeper.Keeper,
+	channelKeeper channelkeeper.Keeper,
+	rateLimitKeeper ratelimitkeeper.Keeper,
+	govKeeper govkeeper.Keeper,
+	slashingKeeper slashingkeeper.Keeper,
+	evidenceKeeper evidencekeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
+	paramsKeeper PrecompileParamsKeeper,
+	cdc codec.Codec,
+) map[common.Address]vm.PrecompiledContract {
+	registry := NewStaticPrecompileRegistry(
+		stakingKeeper, distributionKeeper, bankKeeper, erc20Keeper, vestingKeeper,
+		authzKeeper, transferKeeper, channelKeeper, rateLimitKeeper, govKeeper, slashingKeeper, evidenceKeeper, feegrantKeeper,
+		paramsKeeper, cdc,
+	)
 
-	// Stateful precompiles
-	precompiles[stakingPrecompile.Address()] = stakingPrecompile
-	precompiles[distributionPrecompile.Address()] = distributionPrecompile
-	precompiles[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
-	precompiles[vestingPrecompile.Address()] = vestingPrecompile
-	precompiles[bankPrecompile.Address()] = bankPrecompile
-	precompiles[govPrecompile.Address()] = govPrecompile
+	precompiles := make(map[common.Address]vm.PrecompiledContract, len(registry.Addresses()))
+	for _, address := range registry.Addresses() {
+		precompile, _, err := registry.Get(address)
+		if err != nil {
+			panic(fmt.Errorf("failed to instantiate precompile %s: %w", address, err))
+		}
+		precompiles[address] = precompile
+	}
 	return precompiles
 }
 
 // WithStaticPrecompiles sets the available static precompiled contracts.
 func (k *Keeper) WithStaticPrecompiles(precompiles map[common.Address]vm.PrecompiledContract) *Keeper {
+	if len(precompiles) == 0 {
+		panic("empty precompiled contract map")
+	}
+
+	registry := NewPrecompileRegistry()
+	for address, precompile := range precompiles {
+		registry.RegisterInstance(address, precompile)
+	}
+	return k.WithPrecompileRegistry(registry)
+}
+
+// WithPrecompileRegistry sets the registry of the available static precompiled contracts.
+func (k *Keeper) WithPrecompileRegistry(registry *PrecompileRegistry) *Keeper {
 	if k.precompiles != nil {
-		panic("available precompiles map already set")
+		panic("available precompiles registry already set")
 	}
 
-	if len(precompiles) == 0 {
-		panic("empty precompiled contract map")
+	if registry == nil || len(registry.Addresses()) == 0 {
+		panic("empty precompiled contract registry")
 	}
 
-	k.precompiles = precompiles
+	k.precompiles = registry
 	return k
 }
 
 // GetStaticPrecompileInstance returns the instance of the given static precompile address.
 func (k *Keeper) GetStaticPrecompileInstance(params *types.Params, address common.Address) (vm.PrecompiledContract, bool, error) {
-	if k.IsAvailableStaticPrecompile(params, address) {
-		precompile, found := k.precompiles[address]
-		// If the precompile is within params but not found in the precompiles map it means we have memory
-		// corruption.
-		if !found {
-			panic(fmt.Errorf("precompiled contract not stored in memory: %s", address))
-		}
-		return precompile, true, nil
+	if !k.IsAvailableStaticPrecompile(params, address) {
+		return nil, false, nil
+	}
+
+	precompile, found, err := k.precompiles.Get(address)
+	if err != nil {
+		return nil, false, err
+	}
+	// If the precompile is within params but not found in the registry it means the node
+	// does not support it.
+	if !found {
+		return nil, false, errorsmod.Wrapf(types.ErrUnknownPrecompile, "%s", address)
+	}
+	return precompile, true, nil
+}
+
+// GetActiveStaticPrecompileABIs returns the ABIs of the active static precompiles
+// that expose one, sorted by the precompile address.
+func (k Keeper) GetActiveStaticPrecompileABIs(ctx sdk.Context) ([]abi.ABI, error) {
+	params := k.GetParams(ctx)
+	addresses := make([]common.Address, len(params.ActiveStaticPrecompiles))
+	for i, address := range params.ActiveStaticPrecompiles {
+		addresses[i] = common.HexToAddress(address)
 	}
-	return nil, false, nil
+	return k.precompiles.ABIs(addresses...)
 }
 
 // IsAvailablePrecompile returns true if the given static precompile address is contained in the
 // EVM keeper's available precompiles map.
-// This function assumes that the Berlin precompiles cannot be disabled.
+// This function assumes that the Berlin precompiles cannot be disabled, while the EIP-2537
+// precompiles are only available when the EIP is included in the extra EIPs.
 func (k Keeper) IsAvailableStaticPrecompile(params *types.Params, address common.Address) bool {
 	return slices.Contains(params.ActiveStaticPrecompiles, address.String()) ||
-		slices.Contains(vm.PrecompiledAddressesBerlin, address)
+		slices.Contains(vm.PrecompiledAddressesBerlin, address) ||
+		(slices.Contains(vm.PrecompiledAddressesBLS, address) && slices.Contains(params.ExtraEIPs, vm.EIP2537))
+}
+
+// withEIPPrecompiles appends to the given active Ethereum precompiles the ones
+// enabled through the EIPs, so that they are also warm before the execution as
+// per EIP-2929.
+func withEIPPrecompiles(precompiles []common.Address, eips []string) []common.Address {
+	if !slices.Contains(eips, vm.EIP2537) {
+		return precompiles
+	}
+	return slices.Concat(precompiles, vm.PrecompiledAddressesBLS)
 }
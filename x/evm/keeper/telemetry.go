@@ -0,0 +1,155 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// blockTelemetry collects the execution statistics of the ethereum txs of the
+// current block, which are reported as gauges at the end of the block.
+type blockTelemetry struct {
+	mu sync.Mutex
+
+	txs      uint64
+	reverted uint64
+	gasUsed  uint64
+
+	opCodeGas       map[string]uint64
+	precompileCalls map[common.Address]uint64
+	// reportedPrecompiles are the precompiles with a reported gauge
+	reportedPrecompiles map[common.Address]struct{}
+}
+
+// opCodeClasses are the opcode classes reported by the block telemetry.
+var opCodeClasses = []string{
+	types.OpCodeClassArithmetic,
+	types.OpCodeClassBitwise,
+	types.OpCodeClassHash,
+	types.OpCodeClassEnvironment,
+	types.OpCodeClassBlock,
+	types.OpCodeClassStack,
+	types.OpCodeClassMemory,
+	types.OpCodeClassStorage,
+	types.OpCodeClassControl,
+	types.OpCodeClassLog,
+	types.OpCodeClassCall,
+	types.OpCodeClassCreate,
+	types.OpCodeClassPrecompile,
+}
+
+// newBlockTelemetry returns an empty block telemetry collector.
+func newBlockTelemetry() *blockTelemetry {
+	return &blockTelemetry{
+		opCodeGas:           make(map[string]uint64),
+		precompileCalls:     make(map[common.Address]uint64),
+		reportedPrecompiles: make(map[common.Address]struct{}),
+	}
+}
+
+// WithTelemetry enables the per-block telemetry of the ethereum txs execution:
+// the gas consumed by class of opcode, the number of calls to each precompiled
+// contract and the ratio of reverted txs. The metrics are reported through the
+// node telemetry, so they're only exposed if it's enabled.
+func (k *Keeper) WithTelemetry(enabled bool) *Keeper {
+	if enabled {
+		k.telemetry = newBlockTelemetry()
+	} else {
+		k.telemetry = nil
+	}
+	return k
+}
+
+// telemetryTracer returns the tracer that collects the opcode and precompile
+// statistics of a delivered tx, or nil if the telemetry is disabled. The
+// statistics aren't collected when the node traces the txs execution.
+func (k *Keeper) telemetryTracer(ctx sdk.Context) *types.TelemetryTracer {
+	if k.telemetry == nil || k.tracer != "" || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return nil
+	}
+	return types.NewTelemetryTracer()
+}
+
+// recordTxTelemetry adds the result of a delivered tx to the block telemetry.
+// The tracer is nil when the node traces the txs execution, so that they only
+// count towards the gas used and the reverted txs.
+func (k *Keeper) recordTxTelemetry(ctx sdk.Context, res *types.MsgEthereumTxResponse, tracer *types.TelemetryTracer) {
+	if k.telemetry == nil || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return
+	}
+
+	t := k.telemetry
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.txs++
+	t.gasUsed += res.GasUsed
+	if res.Failed() {
+		t.reverted++
+	}
+
+	if tracer == nil {
+		return
+	}
+	for class, gas := range tracer.OpCodeGas {
+		t.opCodeGas[class] += gas
+	}
+	for addr, calls := range tracer.PrecompileCalls {
+		t.precompileCalls[addr] += calls
+	}
+}
+
+// emitBlockTelemetry reports the telemetry of the current block and resets the
+// collector for the next one.
+func (k *Keeper) emitBlockTelemetry() {
+	if k.telemetry == nil {
+		return
+	}
+
+	t := k.telemetry
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	telemetry.SetGauge(float32(t.txs), types.ModuleName, "block", "txs")
+	telemetry.SetGauge(float32(t.gasUsed), types.ModuleName, "block", "gas_used")
+
+	var revertedRatio float32
+	if t.txs > 0 {
+		revertedRatio = float32(t.reverted) / float32(t.txs)
+	}
+	telemetry.SetGauge(revertedRatio, types.ModuleName, "block", "reverted_ratio")
+
+	// every class is reported so that the gauges of the classes unused in the
+	// block don't keep the value of a previous block
+	for _, class := range opCodeClasses {
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, "block", "opcode_gas"},
+			float32(t.opCodeGas[class]),
+			[]metrics.Label{telemetry.NewLabel("class", class)},
+		)
+	}
+
+	// the precompiles called in a previous block are reported as well, for
+	// the same reason
+	for addr := range t.precompileCalls {
+		t.reportedPrecompiles[addr] = struct{}{}
+	}
+	for addr := range t.reportedPrecompiles {
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, "block", "precompile_calls"},
+			float32(t.precompileCalls[addr]),
+			[]metrics.Label{telemetry.NewLabel("precompile", addr.Hex())},
+		)
+	}
+
+	t.txs, t.reverted, t.gasUsed = 0, 0, 0
+	t.opCodeGas = make(map[string]uint64)
+	t.precompileCalls = make(map[common.Address]uint64)
+}
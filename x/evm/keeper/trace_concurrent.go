@@ -0,0 +1,117 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmostypes "github.com/evmos/evmos/v20/types"
+	evmante "github.com/evmos/evmos/v20/x/evm/ante"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// txTraceTask holds the isolated state in which a transaction is traced.
+type txTraceTask struct {
+	ctx      sdk.Context
+	txConfig statedb.TxConfig
+}
+
+// traceTxsConcurrently traces the block transactions using up to
+// concurrency workers. The block is first replayed sequentially without
+// tracers to build a snapshot of the state before each transaction, where
+// each snapshot is a cache context branched from the previous one. The
+// transactions are then traced concurrently on their own branch of the
+// snapshot, which leaves the snapshots untouched. The results keep the order
+// of the transactions.
+func (k *Keeper) traceTxsConcurrently(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	signer ethtypes.Signer,
+	txs []*types.MsgEthereumTx,
+	traceConfig *types.TraceConfig,
+	concurrency int,
+) []*types.TxTraceResult {
+	tasks := make([]txTraceTask, len(txs))
+
+	txCtx := ctx
+	for i, tx := range txs {
+		txConfig.TxHash = tx.TxHash()
+		txConfig.TxIndex = uint(i) // #nosec G115
+		tasks[i] = txTraceTask{ctx: txCtx, txConfig: txConfig}
+
+		// the state before the next tx is branched so that the snapshot of
+		// the current one is left untouched
+		txCtx, _ = txCtx.CacheContext()
+		logIndex, err := k.replayTx(txCtx, cfg, txConfig, signer, tx)
+		if err != nil {
+			// the trace of the tx will report the error
+			continue
+		}
+		txConfig.LogIndex = logIndex
+	}
+
+	if concurrency > len(txs) {
+		concurrency = len(txs)
+	}
+
+	results := make([]*types.TxTraceResult, len(txs))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				task := tasks[i]
+				taskCtx, _ := task.ctx.CacheContext()
+
+				result := types.TxTraceResult{}
+				traceResult, _, err := k.traceTx(taskCtx, cfg, task.txConfig, signer, txs[i], traceConfig, true, nil)
+				if err != nil {
+					result.Error = err.Error()
+				} else {
+					result.Result = traceResult
+				}
+				results[i] = &result
+			}
+		}()
+	}
+
+	for i := range txs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// replayTx applies the transaction to the given context without tracing it.
+// It returns the log index of the next transaction.
+func (k *Keeper) replayTx(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	signer ethtypes.Signer,
+	tx *types.MsgEthereumTx,
+) (uint, error) {
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx = evmante.BuildEvmExecutionCtx(ctx).
+		WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(msg.Gas()))
+	res, err := k.ApplyMessageWithConfig(ctx, msg, nil, true, cfg, txConfig)
+	if err != nil {
+		return 0, err
+	}
+
+	return txConfig.LogIndex + uint(len(res.Logs)), nil
+}
@@ -0,0 +1,195 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"encoding/json"
+
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/evm/core/tracers"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+)
+
+// CallTracer is the name of the tracer whose results are persisted by the
+// trace store.
+const CallTracer = "callTracer"
+
+// prefix bytes of the trace store
+const (
+	prefixTrace = iota + 1
+	prefixTraceHeight
+)
+
+// traceStore persists the call traces of the delivered ethereum txs to a
+// node-local database. The traces are stored by tx hash and indexed by block
+// height, so that the ones of the blocks older than the retained blocks are
+// pruned.
+//
+// The traces of a block are buffered while it executes and only persisted
+// when it is committed, so that a block execution that is aborted, e.g. by
+// the optimistic execution, doesn't leave stale traces.
+type traceStore struct {
+	db           dbm.DB
+	retainBlocks uint64
+	// pending holds the traces of the block being executed.
+	pending []pendingTrace
+}
+
+// pendingTrace is the trace of a delivered tx that is not committed yet.
+type pendingTrace struct {
+	txHash common.Hash
+	result json.RawMessage
+}
+
+// WithTraceStore enables the live tracer, which persists the callTracer traces
+// of the delivered ethereum txs to the given database as the blocks execute.
+// The traces of the last retainBlocks blocks are kept, or all of them if zero,
+// and served by the TraceTx query without re-executing the txs.
+func (k *Keeper) WithTraceStore(db dbm.DB, retainBlocks uint64) *Keeper {
+	k.traceStore = nil
+	if db != nil {
+		k.traceStore = &traceStore{db: db, retainBlocks: retainBlocks}
+	}
+	return k
+}
+
+// liveTracer returns the call tracer of a delivered tx, or nil if the trace
+// store is disabled. The txs aren't traced when the node uses its own tracer.
+func (k *Keeper) liveTracer(ctx sdk.Context, txConfig statedb.TxConfig) tracers.Tracer {
+	if k.traceStore == nil || k.tracer != "" || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return nil
+	}
+
+	tracer, err := tracers.New(CallTracer, &tracers.Context{
+		BlockHash: txConfig.BlockHash,
+		TxIndex:   int(txConfig.TxIndex), //#nosec G115
+		TxHash:    txConfig.TxHash,
+	}, nil)
+	if err != nil {
+		k.Logger(ctx).Error("failed to create the live tracer", "error", err.Error())
+		return nil
+	}
+
+	return tracer
+}
+
+// storeTxTrace buffers the result of the call tracer of a delivered tx until
+// the block is committed. The trace store is not part of the consensus state,
+// so the failures are logged.
+func (k *Keeper) storeTxTrace(ctx sdk.Context, txHash common.Hash, tracer tracers.Tracer) {
+	if k.traceStore == nil || tracer == nil {
+		return
+	}
+
+	result, err := tracer.GetResult()
+	if err != nil {
+		k.Logger(ctx).Error("failed to get the live tracer result", "hash", txHash.Hex(), "error", err.Error())
+		return
+	}
+
+	k.traceStore.pending = append(k.traceStore.pending, pendingTrace{txHash: txHash, result: result})
+}
+
+// resetTraceStore discards the traces buffered by a previous execution of the
+// block that was not committed.
+func (k *Keeper) resetTraceStore() {
+	if k.traceStore == nil {
+		return
+	}
+	k.traceStore.pending = nil
+}
+
+// commitTraceStore persists the traces of the committed block and deletes the
+// traces of the blocks that are no longer retained.
+func (k *Keeper) commitTraceStore(ctx sdk.Context) {
+	if k.traceStore == nil {
+		return
+	}
+
+	pending := k.traceStore.pending
+	k.traceStore.pending = nil
+
+	height := uint64(ctx.BlockHeight()) //#nosec G115
+
+	batch := k.traceStore.db.NewBatch()
+	defer batch.Close()
+
+	for _, trace := range pending {
+		if err := batch.Set(traceKey(trace.txHash), trace.result); err != nil {
+			k.Logger(ctx).Error("failed to store the tx trace", "hash", trace.txHash.Hex(), "error", err.Error())
+			return
+		}
+		if err := batch.Set(traceHeightKey(sdk.Uint64ToBigEndian(height), trace.txHash), []byte{}); err != nil {
+			k.Logger(ctx).Error("failed to store the tx trace", "hash", trace.txHash.Hex(), "error", err.Error())
+			return
+		}
+	}
+
+	if err := k.pruneTraceStore(batch, height); err != nil {
+		k.Logger(ctx).Error("failed to prune the trace store", "error", err.Error())
+		return
+	}
+
+	if err := batch.Write(); err != nil {
+		k.Logger(ctx).Error("failed to store the block traces", "height", height, "error", err.Error())
+	}
+}
+
+// GetStoredTrace returns the stored call trace of the tx with the given hash.
+func (k Keeper) GetStoredTrace(txHash common.Hash) (json.RawMessage, bool) {
+	if k.traceStore == nil {
+		return nil, false
+	}
+
+	result, err := k.traceStore.db.Get(traceKey(txHash))
+	if err != nil || result == nil {
+		return nil, false
+	}
+
+	return result, true
+}
+
+// pruneTraceStore adds to the batch the deletion of the traces of the blocks
+// that are no longer retained at the given height.
+func (k *Keeper) pruneTraceStore(batch dbm.Batch, height uint64) error {
+	if k.traceStore.retainBlocks == 0 || height <= k.traceStore.retainBlocks {
+		return nil
+	}
+
+	end := traceHeightKey(sdk.Uint64ToBigEndian(height-k.traceStore.retainBlocks+1), common.Hash{})
+	iterator, err := k.traceStore.db.Iterator([]byte{prefixTraceHeight}, end)
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		txHash := common.BytesToHash(key[len(key)-common.HashLength:])
+		if err := batch.Delete(traceKey(txHash)); err != nil {
+			return err
+		}
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return iterator.Error()
+}
+
+// traceKey returns the key of the trace of the tx with the given hash.
+func traceKey(txHash common.Hash) []byte {
+	return append([]byte{prefixTrace}, txHash.Bytes()...)
+}
+
+// traceHeightKey returns the key that indexes the trace of the tx with the
+// given hash by the height of its block.
+func traceHeightKey(height []byte, txHash common.Hash) []byte {
+	key := make([]byte, 0, 1+len(height)+common.HashLength)
+	key = append(key, prefixTraceHeight)
+	key = append(key, height...)
+	return append(key, txHash.Bytes()...)
+}
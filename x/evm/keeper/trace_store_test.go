@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/evmos/evmos/v20/x/evm/keeper"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestTraceStore() {
+	suite.SetupTest()
+	// keep the traces of the last block only
+	suite.network.App.EvmKeeper.WithTraceStore(dbm.NewMemDB(), 1)
+	defer suite.network.App.EvmKeeper.WithTraceStore(nil, 0)
+	// the txs are traced even if the parallel execution is enabled
+	suite.network.App.EvmKeeper.WithParallelExecution(2)
+	defer suite.network.App.EvmKeeper.WithParallelExecution(0)
+
+	senderKey := suite.keyring.GetKey(0)
+	recipient := suite.keyring.GetAddr(1)
+	txArgs := types.EvmTxArgs{To: &recipient, Amount: big.NewInt(1)}
+
+	// the traces of a block that is executed but not committed are discarded
+	msg, err := suite.factory.GenerateSignedMsgEthereumTx(senderKey.Priv, txArgs)
+	suite.Require().NoError(err)
+	_, err = suite.factory.ExecuteEthTx(senderKey.Priv, txArgs)
+	suite.Require().NoError(err)
+
+	_, found := suite.network.App.EvmKeeper.GetStoredTrace(msg.TxHash())
+	suite.Require().False(found)
+	suite.Require().NoError(suite.network.NextBlock())
+	_, found = suite.network.App.EvmKeeper.GetStoredTrace(msg.TxHash())
+	suite.Require().False(found)
+
+	// the traces are persisted once the block is committed
+	tx, err := suite.factory.GenerateSignedEthTx(senderKey.Priv, txArgs)
+	suite.Require().NoError(err)
+	txBytes, err := suite.factory.EncodeTx(tx)
+	suite.Require().NoError(err)
+	msg = *tx.GetMsgs()[0].(*types.MsgEthereumTx)
+
+	_, err = suite.network.NextBlockWithTxs(txBytes)
+	suite.Require().NoError(err)
+
+	trace, found := suite.network.App.EvmKeeper.GetStoredTrace(msg.TxHash())
+	suite.Require().True(found)
+
+	var callFrame map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(trace, &callFrame))
+	suite.Require().Equal("CALL", callFrame["type"])
+	suite.Require().Equal(strings.ToLower(recipient.Hex()), callFrame["to"])
+
+	// the stored trace is served without re-executing the tx
+	res, err := suite.network.App.EvmKeeper.TraceTx(suite.network.GetContext(), &types.QueryTraceTxRequest{
+		Msg:         &msg,
+		TraceConfig: &types.TraceConfig{Tracer: keeper.CallTracer},
+	})
+	suite.Require().NoError(err)
+	suite.Require().JSONEq(string(trace), string(res.Data))
+
+	// the traces of the blocks that are no longer retained are pruned
+	suite.Require().NoError(suite.network.NextBlock())
+	_, found = suite.network.App.EvmKeeper.GetStoredTrace(msg.TxHash())
+	suite.Require().False(found)
+}
@@ -36,6 +36,7 @@ var (
 
 	_ appmodule.HasBeginBlocker = AppModule{}
 	_ appmodule.HasEndBlocker   = AppModule{}
+	_ appmodule.HasPrecommit    = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the evm module.
@@ -155,6 +156,13 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	return am.keeper.EndBlock(c)
 }
 
+// Precommit persists the node-local data of the evm module for the block that
+// is being committed.
+func (am AppModule) Precommit(ctx context.Context) error {
+	c := sdk.UnwrapSDKContext(ctx)
+	return am.keeper.Precommit(c)
+}
+
 // InitGenesis performs genesis initialization for the evm module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
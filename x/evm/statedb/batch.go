@@ -0,0 +1,85 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package statedb
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// StorageWrite defines the write of a storage slot. The slot is deleted if the
+// value is empty.
+type StorageWrite struct {
+	Key   common.Hash
+	Value []byte
+}
+
+// CommitStats holds the number of writes flushed by the commit of a StateDB,
+// which measures its write amplification.
+type CommitStats struct {
+	// Accounts is the number of dirty accounts written
+	Accounts int
+	// DirtySlots is the number of storage slots modified by the transaction,
+	// which are all written to the store
+	DirtySlots int
+	// UnchangedSlots is the number of dirty storage slots written with their
+	// committed value
+	UnchangedSlots int
+}
+
+// storageWrites returns the sorted writes of the dirty storage of the object
+// and the number of them that hold their committed value.
+//
+// NOTE: the slots holding their committed value are written too, as the store
+// may no longer hold it after a precompile call, and skipping the writes would
+// change the app hash, which includes the versions of the IAVL nodes.
+func (s *stateObject) storageWrites() (writes []StorageWrite, unchanged int) {
+	writes = make([]StorageWrite, 0, len(s.dirtyStorage))
+	for _, key := range s.dirtyStorage.SortedKeys() {
+		value := s.dirtyStorage[key]
+		if origin, found := s.originStorage[key]; found && origin == value {
+			unchanged++
+		}
+
+		writes = append(writes, StorageWrite{Key: key, Value: value.Bytes()})
+	}
+	return writes, unchanged
+}
+
+// writeStorage flushes the storage writes of the account, in a single batch if
+// the keeper supports it.
+func (s *StateDB) writeStorage(ctx sdk.Context, addr common.Address, writes []StorageWrite) {
+	if len(writes) == 0 {
+		return
+	}
+
+	if batchKeeper, ok := s.keeper.(BatchKeeper); ok {
+		batchKeeper.SetStorage(ctx, addr, writes)
+		return
+	}
+
+	for _, write := range writes {
+		if len(write.Value) == 0 {
+			s.keeper.DeleteState(ctx, addr, write.Key)
+		} else {
+			s.keeper.SetState(ctx, addr, write.Key, write.Value)
+		}
+	}
+}
+
+// emitCommitMetrics reports the write amplification of the commit.
+func emitCommitMetrics(stats CommitStats) {
+	telemetry.IncrCounter(float32(stats.Accounts), types.ModuleName, "statedb", "commit", "accounts")
+	telemetry.IncrCounter(float32(stats.DirtySlots), types.ModuleName, "statedb", "commit", "dirty_slots")
+	telemetry.IncrCounter(float32(stats.UnchangedSlots), types.ModuleName, "statedb", "commit", "unchanged_slots")
+
+	if stats.DirtySlots > 0 {
+		telemetry.SetGauge(
+			float32(stats.UnchangedSlots)/float32(stats.DirtySlots),
+			types.ModuleName, "statedb", "commit", "unchanged_slots", "per", "dirty_slots",
+		)
+	}
+}
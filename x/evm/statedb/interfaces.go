@@ -35,3 +35,12 @@ type Keeper interface {
 	SetCode(ctx sdk.Context, codeHash []byte, code []byte)
 	DeleteAccount(ctx sdk.Context, addr common.Address) error
 }
+
+// BatchKeeper is an optional extension of the Keeper that writes the dirty
+// storage slots of an account in a single batch when the StateDB is committed,
+// instead of calling SetState or DeleteState for each slot.
+type BatchKeeper interface {
+	// SetStorage writes the given slots of the account storage, deleting the
+	// ones with an empty value. The writes are sorted by key.
+	SetStorage(ctx sdk.Context, addr common.Address, writes []StorageWrite)
+}
@@ -63,6 +63,9 @@ type StateDB struct {
 
 	// The count of calls to precompiles
 	precompileCallsCounter uint8
+
+	// The writes flushed by the last commit
+	commitStats CommitStats
 }
 
 // New creates a new state from a given trie.
@@ -115,6 +118,13 @@ func (s *StateDB) MultiStoreSnapshot() storetypes.CacheMultiStore {
 	return snapshot
 }
 
+// HasPrecompileCalls returns true if a precompile was called, in which case the
+// state changes include writes to the Cosmos SDK stores that are not performed
+// through the StateDB keeper.
+func (s *StateDB) HasPrecompileCalls() bool {
+	return s.writeCache != nil
+}
+
 // cache creates the stateDB cache context
 func (s *StateDB) cache() error {
 	if s.ctx.MultiStore() == nil {
@@ -511,7 +521,17 @@ func (s *StateDB) Commit() error {
 	if s.writeCache != nil {
 		s.writeCache()
 	}
-	return s.commitWithCtx(s.ctx)
+	if err := s.commitWithCtx(s.ctx); err != nil {
+		return err
+	}
+
+	emitCommitMetrics(s.commitStats)
+	return nil
+}
+
+// CommitStats returns the number of writes flushed by the last commit.
+func (s *StateDB) CommitStats() CommitStats {
+	return s.commitStats
 }
 
 // CommitWithCacheCtx writes the dirty states to keeper using the cacheCtx.
@@ -524,6 +544,7 @@ func (s *StateDB) CommitWithCacheCtx() error {
 // commitWithCtx writes the dirty states to keeper
 // using the provided context
 func (s *StateDB) commitWithCtx(ctx sdk.Context) error {
+	stats := CommitStats{}
 	for _, addr := range s.journal.sortedDirties() {
 		obj := s.stateObjects[addr]
 		if obj.suicided {
@@ -542,15 +563,15 @@ func (s *StateDB) commitWithCtx(ctx sdk.Context) error {
 				return errorsmod.Wrap(err, "failed to set account")
 			}
 
-			for _, key := range obj.dirtyStorage.SortedKeys() {
-				valueBytes := obj.dirtyStorage[key].Bytes()
-				if len(valueBytes) == 0 {
-					s.keeper.DeleteState(ctx, obj.Address(), key)
-				} else {
-					s.keeper.SetState(ctx, obj.Address(), key, valueBytes)
-				}
-			}
+			writes, unchanged := obj.storageWrites()
+			s.writeStorage(ctx, obj.Address(), writes)
+
+			stats.DirtySlots += len(writes)
+			stats.UnchangedSlots += unchanged
 		}
+		stats.Accounts++
 	}
+
+	s.commitStats = stats
 	return nil
 }
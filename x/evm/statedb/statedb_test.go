@@ -246,6 +246,30 @@ func (suite *StateDBTestSuite) TestState() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestCommitStats() {
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key1, value1)
+	suite.Require().NoError(db.Commit())
+	suite.Require().Equal(statedb.CommitStats{Accounts: 1, DirtySlots: 1}, db.CommitStats())
+
+	// the slots set back to their committed value are written too
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key1, value2)
+	db.SetState(address, key1, value1)
+	db.SetState(address, key2, value2)
+	db.SetState(address2, key1, value1)
+	suite.Require().NoError(db.Commit())
+	suite.Require().Equal(statedb.CommitStats{Accounts: 2, DirtySlots: 3, UnchangedSlots: 1}, db.CommitStats())
+	suite.Require().Equal(statedb.Storage{key1: value1, key2: value2}, keeper.accounts[address].states)
+	suite.Require().Equal(statedb.Storage{key1: value1}, keeper.accounts[address2].states)
+}
+
 func (suite *StateDBTestSuite) TestCode() {
 	code := []byte("hello world")
 	codeHash := crypto.Keccak256Hash(code)
@@ -0,0 +1,111 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// BlobTxType is the EIP-2718 type of the EIP-4844 blob transactions
+const BlobTxType = 0x03
+
+// BlobTx is the decoded payload of an EIP-4844 blob transaction. Blob
+// transactions are not supported by the EVM, they are only decoded to be
+// rejected with a meaningful error.
+type BlobTx struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+	BlobFeeCap *big.Int
+	BlobHashes []common.Hash
+
+	V, R, S *big.Int
+}
+
+// blobTxWithBlobs is the network encoding of a blob transaction, that wraps
+// the transaction with its blobs, commitments and proofs.
+type blobTxWithBlobs struct {
+	Tx          BlobTx
+	Blobs       [][]byte
+	Commitments [][]byte
+	Proofs      [][]byte
+}
+
+// IsBlobTx returns true if the bytes are the EIP-2718 encoding of a blob transaction.
+func IsBlobTx(b []byte) bool {
+	return len(b) > 0 && b[0] == BlobTxType
+}
+
+// DecodeBlobTx decodes the canonical or the network encoding of a blob
+// transaction and returns it along with its hash.
+func DecodeBlobTx(b []byte) (*BlobTx, common.Hash, error) {
+	if !IsBlobTx(b) {
+		return nil, common.Hash{}, errorsmod.Wrapf(ethtypes.ErrTxTypeNotSupported, "expected blob tx type %d", BlobTxType)
+	}
+
+	content, _, err := rlp.SplitList(b[1:])
+	if err != nil {
+		return nil, common.Hash{}, errorsmod.Wrap(err, "invalid blob transaction")
+	}
+
+	// the network encoding starts with the transaction list, while the
+	// canonical one starts with the chain id
+	kind, _, _, err := rlp.Split(content)
+	if err != nil {
+		return nil, common.Hash{}, errorsmod.Wrap(err, "invalid blob transaction")
+	}
+
+	if kind != rlp.List {
+		tx := new(BlobTx)
+		if err := rlp.DecodeBytes(b[1:], tx); err != nil {
+			return nil, common.Hash{}, errorsmod.Wrap(err, "invalid blob transaction")
+		}
+		return tx, crypto.Keccak256Hash(b), nil
+	}
+
+	var wrapper blobTxWithBlobs
+	if err := rlp.DecodeBytes(b[1:], &wrapper); err != nil {
+		return nil, common.Hash{}, errorsmod.Wrap(err, "invalid blob transaction")
+	}
+
+	// the hash only covers the canonical encoding of the transaction
+	bz, err := rlp.EncodeToBytes(&wrapper.Tx)
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+	return &wrapper.Tx, crypto.Keccak256Hash([]byte{BlobTxType}, bz), nil
+}
+
+// UnmarshalEthereumTx decodes the canonical encoding of an ethereum
+// transaction. Blob transactions are decoded and rejected with
+// ErrBlobTxNotSupported instead of an RLP decoding error.
+func UnmarshalEthereumTx(b []byte) (*ethtypes.Transaction, error) {
+	if IsBlobTx(b) {
+		tx, hash, err := DecodeBlobTx(b)
+		if err != nil {
+			return nil, errorsmod.Wrap(ErrBlobTxNotSupported, err.Error())
+		}
+		return nil, errorsmod.Wrapf(
+			ErrBlobTxNotSupported,
+			"tx %s with %d blob versioned hashes", hash.Hex(), len(tx.BlobHashes),
+		)
+	}
+
+	tx := &ethtypes.Transaction{}
+	if err := tx.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
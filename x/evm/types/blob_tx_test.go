@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/evmos/evmos/v20/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeBlobTx(t *testing.T) {
+	blobTx := types.BlobTx{
+		ChainID:    big.NewInt(9001),
+		Nonce:      1,
+		GasTipCap:  big.NewInt(1),
+		GasFeeCap:  big.NewInt(10),
+		Gas:        21000,
+		To:         common.HexToAddress("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"),
+		Value:      big.NewInt(100),
+		AccessList: ethtypes.AccessList{},
+		BlobFeeCap: big.NewInt(1),
+		BlobHashes: []common.Hash{{0x01}, {0x01, 0x02}},
+		V:          big.NewInt(0),
+		R:          big.NewInt(1),
+		S:          big.NewInt(1),
+	}
+	bz, err := rlp.EncodeToBytes(&blobTx)
+	require.NoError(t, err)
+	canonical := append([]byte{types.BlobTxType}, bz...)
+	expHash := crypto.Keccak256Hash(canonical)
+
+	bz, err = rlp.EncodeToBytes([]interface{}{&blobTx, [][]byte{{0x01}}, [][]byte{{0x02}}, [][]byte{{0x03}}})
+	require.NoError(t, err)
+	network := append([]byte{types.BlobTxType}, bz...)
+
+	testCases := []struct {
+		name   string
+		bz     []byte
+		expErr bool
+	}{
+		{"pass - canonical encoding", canonical, false},
+		{"pass - network encoding", network, false},
+		{"fail - truncated", canonical[:len(canonical)-2], true},
+		{"fail - not a blob tx", bz, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, hash, err := types.DecodeBlobTx(tc.bz)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, expHash, hash)
+			require.Equal(t, blobTx.BlobHashes, tx.BlobHashes)
+			require.Equal(t, blobTx.To, tx.To)
+
+			_, err = types.UnmarshalEthereumTx(tc.bz)
+			require.ErrorIs(t, err, types.ErrBlobTxNotSupported)
+			require.ErrorContains(t, err, expHash.Hex())
+
+			var msg types.MsgEthereumTx
+			require.ErrorIs(t, msg.UnmarshalBinary(tc.bz), types.ErrBlobTxNotSupported)
+		})
+	}
+}
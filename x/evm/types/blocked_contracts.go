@@ -0,0 +1,49 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+// revertSelector is the selector of the Error(string) revert reason.
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// BlockedContractRevertData returns the return data of the reverted calls to a
+// blocked contract, which is the ABI encoded Error(string) revert reason.
+func BlockedContractRevertData(contract common.Address) []byte {
+	stringType, _ := abi.NewType("string", "", nil)
+	reason, err := abi.Arguments{{Type: stringType}}.Pack(fmt.Sprintf("%s: %s", ErrContractBlocked.Error(), contract))
+	if err != nil {
+		// packing a string doesn't fail
+		panic(err)
+	}
+	return append(slices.Clone(revertSelector), reason...)
+}
+
+// GetBlockedContractsCallHook returns a CallHook that reverts the calls to the
+// given blocked contracts, including the delegate calls that run their code.
+// It returns nil if there are no blocked contracts.
+func GetBlockedContractsCallHook(blockedContracts []string) CallHook {
+	if len(blockedContracts) == 0 {
+		return nil
+	}
+
+	blocked := make(map[common.Address]struct{}, len(blockedContracts))
+	for _, contract := range blockedContracts {
+		blocked[common.HexToAddress(contract)] = struct{}{}
+	}
+
+	return func(_ *vm.EVM, _, recipient common.Address) error {
+		if _, found := blocked[recipient]; found {
+			return &vm.HookRevertError{Data: BlockedContractRevertData(recipient)}
+		}
+		return nil
+	}
+}
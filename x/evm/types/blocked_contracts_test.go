@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func TestGetBlockedContractsCallHook(t *testing.T) {
+	blocked := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	require.Nil(t, GetBlockedContractsCallHook(nil))
+
+	hook := GetBlockedContractsCallHook([]string{"0x1000000000000000000000000000000000000001"})
+	require.NotNil(t, hook)
+
+	require.NoError(t, hook(nil, blocked, other))
+
+	err := hook(nil, other, blocked)
+	var revertErr *vm.HookRevertError
+	require.ErrorAs(t, err, &revertErr)
+	require.Equal(t, vm.ErrExecutionReverted.Error(), err.Error())
+
+	reason, err := abi.UnpackRevert(revertErr.Data)
+	require.NoError(t, err)
+	require.Equal(t, "contract is blocked: "+blocked.Hex(), reason)
+}
@@ -25,7 +25,10 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName       = "ethermint/MsgUpdateParams"
+	registerPrecompileName = "ethermint/MsgRegisterPrecompile"
+	updateDeployersName    = "ethermint/MsgUpdateDeployers"
+	updateBlockedName      = "ethermint/MsgUpdateBlockedContracts"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -44,6 +47,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgRegisterPrecompile{},
+		&MsgUpdateDeployers{},
+		&MsgUpdateBlockedContracts{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -51,6 +57,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&DynamicFeeTx{},
 		&AccessListTx{},
 		&LegacyTx{},
+		&SetCodeTx{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -91,4 +98,7 @@ func UnpackTxData(anyTxData *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgRegisterPrecompile{}, registerPrecompileName, nil)
+	cdc.RegisterConcrete(&MsgUpdateDeployers{}, updateDeployersName, nil)
+	cdc.RegisterConcrete(&MsgUpdateBlockedContracts{}, updateBlockedName, nil)
 }
@@ -32,6 +32,19 @@ const (
 	codeErrInactivePrecompile
 	codeErrABIPack
 	codeErrABIUnpack
+	codeErrUnknownPrecompile
+	codeErrInvalidStateOverride
+	codeErrBlobTxNotSupported
+	codeErrInvalidScheduledEIP
+	codeErrInvalidSponsorship
+	codeErrInvalidIBCCallback
+	codeErrCreateNotPermitted
+	codeErrContractBlocked
+	codeErrInvalidPreinstall
+	codeErrReplaceUnderpriced
+	codeErrTxReplaced
+	codeErrSelectorCollision
+	codeErrInvalidFeeOptions
 )
 
 var (
@@ -88,6 +101,45 @@ var (
 
 	// ErrABIUnpack returns an error if the contract ABI unpacking fails
 	ErrABIUnpack = errorsmod.Register(ModuleName, codeErrABIUnpack, "contract ABI unpack failed")
+
+	// ErrUnknownPrecompile returns an error if a precompile is not available in the precompile registry
+	ErrUnknownPrecompile = errorsmod.Register(ModuleName, codeErrUnknownPrecompile, "precompile not found in registry")
+
+	// ErrInvalidStateOverride returns an error if the eth_call state override set is invalid
+	ErrInvalidStateOverride = errorsmod.Register(ModuleName, codeErrInvalidStateOverride, "invalid state override")
+
+	// ErrBlobTxNotSupported returns an error if an EIP-4844 blob transaction is submitted
+	ErrBlobTxNotSupported = errorsmod.Register(ModuleName, codeErrBlobTxNotSupported, "blob transactions (EIP-4844) are not supported")
+
+	// ErrInvalidScheduledEIP returns an error if an extra EIP is scheduled at an invalid height
+	ErrInvalidScheduledEIP = errorsmod.Register(ModuleName, codeErrInvalidScheduledEIP, "invalid scheduled EIP")
+
+	// ErrInvalidSponsorship returns an error if the fee sponsorship of an ethereum tx is invalid
+	ErrInvalidSponsorship = errorsmod.Register(ModuleName, codeErrInvalidSponsorship, "invalid fee sponsorship")
+
+	// ErrInvalidIBCCallback returns an error if an IBC callback can't be executed on a contract
+	ErrInvalidIBCCallback = errorsmod.Register(ModuleName, codeErrInvalidIBCCallback, "invalid IBC callback")
+
+	// ErrCreateNotPermitted returns an error if the sender is not permitted to deploy contracts by the deployment policy.
+	ErrCreateNotPermitted = errorsmod.Register(ModuleName, codeErrCreateNotPermitted, "contract deployment not permitted")
+
+	// ErrContractBlocked returns an error if a call is made to a contract that is blocked
+	ErrContractBlocked = errorsmod.Register(ModuleName, codeErrContractBlocked, "contract is blocked")
+
+	// ErrInvalidPreinstall returns an error if a preinstalled contract is invalid or conflicts with the existing state
+	ErrInvalidPreinstall = errorsmod.Register(ModuleName, codeErrInvalidPreinstall, "invalid preinstall")
+
+	// ErrReplaceUnderpriced returns an error if an ethereum tx doesn't bump the fees of the pending tx with the same nonce enough to replace it
+	ErrReplaceUnderpriced = errorsmod.Register(ModuleName, codeErrReplaceUnderpriced, "replacement transaction underpriced")
+
+	// ErrTxReplaced returns an error if a pending ethereum tx has been replaced by another tx with the same nonce
+	ErrTxReplaced = errorsmod.Register(ModuleName, codeErrTxReplaced, "transaction replaced")
+
+	// ErrSelectorCollision returns an error if a method selector of a precompile collides with a different method of another precompile
+	ErrSelectorCollision = errorsmod.Register(ModuleName, codeErrSelectorCollision, "precompile method selector collision")
+
+	// ErrInvalidFeeOptions returns an error if the fee options of an ethereum tx aren't signed by its sender
+	ErrInvalidFeeOptions = errorsmod.Register(ModuleName, codeErrInvalidFeeOptions, "invalid fee options")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
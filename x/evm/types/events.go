@@ -8,6 +8,7 @@ const (
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeTxLog      = "tx_log"
 	EventTypeFeeMarket  = "evm_fee_market"
+	EventTypeGasReport  = "ethereum_tx_gas_report"
 
 	AttributeKeyBaseFee         = "base_fee"
 	AttributeKeyContractAddress = "contract"
@@ -18,6 +19,10 @@ const (
 	AttributeKeyTxGasUsed       = "txGasUsed"
 	AttributeKeyTxType          = "txType"
 	AttributeKeyTxLog           = "txLog"
+	AttributeKeyIntrinsicGas    = "intrinsicGas"
+	AttributeKeyExecutionGas    = "executionGas"
+	AttributeKeyPrecompileGas   = "precompileGas"
+	AttributeKeyRefundedGas     = "refundedGas"
 
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
@@ -71,6 +71,36 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the precompiled
 	// contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,10,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// precompile_dynamic_gas_height defines the block height from which the ERC-20
+	// precompiles charge gas based on the executed store operations instead of the
+	// static gas schedule. A value of zero keeps the static gas schedule, so that
+	// historical blocks are executed with their original costs.
+	PrecompileDynamicGasHeight int64 `protobuf:"varint,11,opt,name=precompile_dynamic_gas_height,json=precompileDynamicGasHeight,proto3" json:"precompile_dynamic_gas_height,omitempty"`
+	// scheduled_eips defines the additional EIPs for the vm.Config that are
+	// enabled from a given block height
+	ScheduledEIPs []ScheduledEIP `protobuf:"bytes,12,rep,name=scheduled_eips,json=scheduledEips,proto3" json:"scheduled_eips"`
+	// bech32_hrps defines the registry of bech32 human readable prefixes (HRPs)
+	// of the Cosmos chains that contracts translate addresses for through the
+	// bech32 precompile
+	Bech32HRPs []string `protobuf:"bytes,13,rep,name=bech32_hrps,json=bech32Hrps,proto3" json:"bech32_hrps,omitempty"`
+	// blocked_contracts defines the hex addresses of the contracts whose calls
+	// are reverted, to contain the contracts that are actively exploited
+	BlockedContracts []string `protobuf:"bytes,14,rep,name=blocked_contracts,json=blockedContracts,proto3" json:"blocked_contracts,omitempty"`
+	// security_council defines the bech32 address of the account that can block
+	// and unblock contracts in addition to the governance module. An empty value
+	// leaves the blocked contracts to the governance only.
+	SecurityCouncil string `protobuf:"bytes,15,opt,name=security_council,json=securityCouncil,proto3" json:"security_council,omitempty"`
+	// osmosis_outpost defines the configuration of the Osmosis outpost
+	// precompile. The outpost rejects the swaps while its channel is empty.
+	OsmosisOutpost OsmosisOutpost `protobuf:"bytes,16,opt,name=osmosis_outpost,json=osmosisOutpost,proto3" json:"osmosis_outpost"`
+	// stride_outpost defines the configuration of the Stride outpost precompile.
+	// The outpost rejects the liquid stakes and redeems while its channel is
+	// empty.
+	StrideOutpost StrideOutpost `protobuf:"bytes,17,opt,name=stride_outpost,json=strideOutpost,proto3" json:"stride_outpost"`
+	// receipt_retention_blocks defines the number of blocks the receipts of the
+	// ethereum txs are kept in the state. The receipts are not stored when it's
+	// zero.
+	ReceiptRetentionBlocks uint64 `protobuf:"varint,18,opt,name=receipt_retention_blocks,json=receiptRetentionBlocks,proto3" json:"receipt_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -141,6 +171,221 @@ func (m *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetPrecompileDynamicGasHeight() int64 {
+	if m != nil {
+		return m.PrecompileDynamicGasHeight
+	}
+	return 0
+}
+
+func (m *Params) GetScheduledEIPs() []ScheduledEIP {
+	if m != nil {
+		return m.ScheduledEIPs
+	}
+	return nil
+}
+
+func (m *Params) GetBech32HRPs() []string {
+	if m != nil {
+		return m.Bech32HRPs
+	}
+	return nil
+}
+
+func (m *Params) GetBlockedContracts() []string {
+	if m != nil {
+		return m.BlockedContracts
+	}
+	return nil
+}
+
+func (m *Params) GetSecurityCouncil() string {
+	if m != nil {
+		return m.SecurityCouncil
+	}
+	return ""
+}
+
+func (m *Params) GetOsmosisOutpost() OsmosisOutpost {
+	if m != nil {
+		return m.OsmosisOutpost
+	}
+	return OsmosisOutpost{}
+}
+
+func (m *Params) GetStrideOutpost() StrideOutpost {
+	if m != nil {
+		return m.StrideOutpost
+	}
+	return StrideOutpost{}
+}
+
+func (m *Params) GetReceiptRetentionBlocks() uint64 {
+	if m != nil {
+		return m.ReceiptRetentionBlocks
+	}
+	return 0
+}
+
+// OsmosisOutpost defines the channel to Osmosis and the cross-chain swaps
+// contract that the Osmosis outpost precompile sends the swaps to
+type OsmosisOutpost struct {
+	// channel_id defines the identifier of the transfer channel to Osmosis
+	ChannelID string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// xcs_contract defines the bech32 address of the cross-chain swaps (XCS)
+	// contract on Osmosis
+	XCSContract string `protobuf:"bytes,2,opt,name=xcs_contract,json=xcsContract,proto3" json:"xcs_contract,omitempty"`
+}
+
+func (m *OsmosisOutpost) Reset()         { *m = OsmosisOutpost{} }
+func (m *OsmosisOutpost) String() string { return proto.CompactTextString(m) }
+func (*OsmosisOutpost) ProtoMessage()    {}
+func (*OsmosisOutpost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *OsmosisOutpost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OsmosisOutpost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OsmosisOutpost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OsmosisOutpost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OsmosisOutpost.Merge(m, src)
+}
+func (m *OsmosisOutpost) XXX_Size() int {
+	return m.Size()
+}
+func (m *OsmosisOutpost) XXX_DiscardUnknown() {
+	xxx_messageInfo_OsmosisOutpost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OsmosisOutpost proto.InternalMessageInfo
+
+func (m *OsmosisOutpost) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *OsmosisOutpost) GetXCSContract() string {
+	if m != nil {
+		return m.XCSContract
+	}
+	return ""
+}
+
+// StrideOutpost defines the channel to Stride that the Stride outpost
+// precompile sends the autopilot transfers through
+type StrideOutpost struct {
+	// channel_id defines the identifier of the transfer channel to Stride
+	ChannelID string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *StrideOutpost) Reset()         { *m = StrideOutpost{} }
+func (m *StrideOutpost) String() string { return proto.CompactTextString(m) }
+func (*StrideOutpost) ProtoMessage()    {}
+func (*StrideOutpost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+}
+func (m *StrideOutpost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StrideOutpost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StrideOutpost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StrideOutpost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StrideOutpost.Merge(m, src)
+}
+func (m *StrideOutpost) XXX_Size() int {
+	return m.Size()
+}
+func (m *StrideOutpost) XXX_DiscardUnknown() {
+	xxx_messageInfo_StrideOutpost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StrideOutpost proto.InternalMessageInfo
+
+func (m *StrideOutpost) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+// ScheduledEIP defines an additional EIP that is enabled from a block height
+type ScheduledEIP struct {
+	// eip defines the name of the EIP, as registered in the vm activators
+	EIP string `protobuf:"bytes,1,opt,name=eip,proto3" json:"eip,omitempty"`
+	// height defines the block height from which the EIP is enabled
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ScheduledEIP) Reset()         { *m = ScheduledEIP{} }
+func (m *ScheduledEIP) String() string { return proto.CompactTextString(m) }
+func (*ScheduledEIP) ProtoMessage()    {}
+func (*ScheduledEIP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+}
+func (m *ScheduledEIP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledEIP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledEIP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledEIP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledEIP.Merge(m, src)
+}
+func (m *ScheduledEIP) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledEIP) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledEIP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledEIP proto.InternalMessageInfo
+
+func (m *ScheduledEIP) GetEIP() string {
+	if m != nil {
+		return m.EIP
+	}
+	return ""
+}
+
+func (m *ScheduledEIP) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -154,7 +399,7 @@ func (m *AccessControl) Reset()         { *m = AccessControl{} }
 func (m *AccessControl) String() string { return proto.CompactTextString(m) }
 func (*AccessControl) ProtoMessage()    {}
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
 }
 func (m *AccessControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,7 +457,7 @@ func (m *AccessControlType) Reset()         { *m = AccessControlType{} }
 func (m *AccessControlType) String() string { return proto.CompactTextString(m) }
 func (*AccessControlType) ProtoMessage()    {}
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *AccessControlType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,7 +554,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +630,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,7 +687,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +763,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,6 +855,174 @@ func (m *Log) GetRemoved() bool {
 	return false
 }
 
+// Receipt defines the receipt of an ethereum tx included in a block. The
+// receipts of the delivered txs are stored in the module state by tx hash for
+// the receipt retention window of the module params.
+type Receipt struct {
+	// tx_hash is the hex encoded ethereum tx hash
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// tx_index of the ethereum tx in the block
+	TxIndex uint64 `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// from is the hex address of the sender
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the hex address of the recipient, empty for contract creations
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// contract_address is the hex address of the created contract, if any
+	ContractAddress string `protobuf:"bytes,5,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// gas_used is the gas consumed by the tx
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// status is 1 if the tx succeeded and 0 if the execution failed
+	Status uint64 `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	// vm_error is the error returned by the EVM, if the execution failed
+	VmError string `protobuf:"bytes,8,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+	// logs emitted by the tx
+	Logs []*Log `protobuf:"bytes,9,rep,name=logs,proto3" json:"logs,omitempty"`
+	// block_number of the block in which the tx was included
+	BlockNumber uint64 `protobuf:"varint,10,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash is the hex encoded hash of the block in which the tx was included
+	BlockHash string `protobuf:"bytes,11,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// cumulative_gas_used is the gas consumed by the ethereum txs of the block up
+	// to this one, included
+	CumulativeGasUsed uint64 `protobuf:"varint,12,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	// logs_bloom is the bloom filter of the logs of the tx
+	LogsBloom []byte `protobuf:"bytes,13,opt,name=logs_bloom,json=logsBloom,proto3" json:"logs_bloom,omitempty"`
+	// tx_type is the type of the ethereum tx
+	TxType uint32 `protobuf:"varint,14,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	// effective_gas_price is the gas price paid by the sender per unit of gas
+	EffectiveGasPrice *cosmossdk_io_math.Int `protobuf:"bytes,15,opt,name=effective_gas_price,json=effectiveGasPrice,proto3,customtype=cosmossdk.io/math.Int" json:"effective_gas_price,omitempty"`
+}
+
+func (m *Receipt) Reset()         { *m = Receipt{} }
+func (m *Receipt) String() string { return proto.CompactTextString(m) }
+func (*Receipt) ProtoMessage()    {}
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
+}
+func (m *Receipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Receipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Receipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Receipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Receipt.Merge(m, src)
+}
+func (m *Receipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *Receipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_Receipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Receipt proto.InternalMessageInfo
+
+func (m *Receipt) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *Receipt) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *Receipt) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *Receipt) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *Receipt) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *Receipt) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *Receipt) GetStatus() uint64 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Receipt) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
+func (m *Receipt) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *Receipt) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *Receipt) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *Receipt) GetCumulativeGasUsed() uint64 {
+	if m != nil {
+		return m.CumulativeGasUsed
+	}
+	return 0
+}
+
+func (m *Receipt) GetLogsBloom() []byte {
+	if m != nil {
+		return m.LogsBloom
+	}
+	return nil
+}
+
+func (m *Receipt) GetTxType() uint32 {
+	if m != nil {
+		return m.TxType
+	}
+	return 0
+}
+
 // TxResult stores results of Tx execution.
 type TxResult struct {
 	// contract_address contains the ethereum address of the created contract (if
@@ -633,7 +1046,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{11}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +1087,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{8}
+	return fileDescriptor_d21ecc92c8c8583e, []int{12}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,7 +1147,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{9}
+	return fileDescriptor_d21ecc92c8c8583e, []int{13}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -843,12 +1256,16 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 func init() {
 	proto.RegisterEnum("ethermint.evm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*OsmosisOutpost)(nil), "ethermint.evm.v1.OsmosisOutpost")
+	proto.RegisterType((*StrideOutpost)(nil), "ethermint.evm.v1.StrideOutpost")
+	proto.RegisterType((*ScheduledEIP)(nil), "ethermint.evm.v1.ScheduledEIP")
 	proto.RegisterType((*AccessControl)(nil), "ethermint.evm.v1.AccessControl")
 	proto.RegisterType((*AccessControlType)(nil), "ethermint.evm.v1.AccessControlType")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
 	proto.RegisterType((*Log)(nil), "ethermint.evm.v1.Log")
+	proto.RegisterType((*Receipt)(nil), "ethermint.evm.v1.Receipt")
 	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
 	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
@@ -857,128 +1274,160 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1932 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xcd, 0x6e, 0x23, 0xc7,
-	0x11, 0x16, 0xa5, 0x91, 0x34, 0x6c, 0x52, 0xe4, 0xa8, 0xf5, 0xb3, 0x5c, 0xae, 0xa3, 0x51, 0x26,
-	0x41, 0xa0, 0x2c, 0x1c, 0x69, 0x57, 0x6b, 0x25, 0xc2, 0x3a, 0x7f, 0xa2, 0x96, 0x4e, 0xc4, 0x68,
-	0xd7, 0x42, 0x53, 0x8e, 0xe1, 0x20, 0xc1, 0xa0, 0x39, 0xd3, 0x26, 0xc7, 0x9a, 0x99, 0x26, 0xa6,
-	0x9b, 0x5c, 0x32, 0x4f, 0x60, 0xec, 0xc9, 0x79, 0x80, 0x0d, 0x0c, 0xe4, 0x92, 0xa3, 0x1f, 0x21,
-	0x47, 0xc3, 0x27, 0x1f, 0x83, 0x00, 0x19, 0x04, 0xdc, 0x83, 0x01, 0x1d, 0xf5, 0x04, 0x41, 0xff,
-	0xf0, 0x57, 0x5a, 0x45, 0xb9, 0x50, 0x5d, 0xd5, 0x55, 0xdf, 0x57, 0x55, 0x5d, 0x3d, 0xdd, 0x2d,
-	0x50, 0x26, 0xbc, 0x45, 0x92, 0x28, 0x88, 0xf9, 0x1e, 0xe9, 0x46, 0x7b, 0xdd, 0xc7, 0xe2, 0xcf,
-	0x6e, 0x3b, 0xa1, 0x9c, 0x42, 0x6b, 0x34, 0xb7, 0x2b, 0x94, 0xdd, 0xc7, 0xe5, 0x55, 0x1c, 0x05,
-	0x31, 0xdd, 0x93, 0xbf, 0xca, 0xa8, 0xbc, 0xde, 0xa4, 0x4d, 0x2a, 0x87, 0x7b, 0x62, 0xa4, 0xb4,
-	0xce, 0x5f, 0x17, 0xc0, 0xd2, 0x19, 0x4e, 0x70, 0xc4, 0xe0, 0x11, 0x00, 0xa4, 0xc7, 0x13, 0xec,
-	0x92, 0xa0, 0xcd, 0x4a, 0xc6, 0xf6, 0xc2, 0x4e, 0xb6, 0xe2, 0x0c, 0x52, 0x3b, 0x5b, 0x15, 0xda,
-	0xea, 0xc9, 0x19, 0xbb, 0x4a, 0xed, 0xd5, 0x3e, 0x8e, 0xc2, 0xa7, 0xce, 0xd8, 0xd0, 0x41, 0x59,
-	0x29, 0x54, 0x83, 0x36, 0x83, 0xfb, 0x60, 0x03, 0x87, 0x21, 0x7d, 0xe9, 0x76, 0x62, 0x01, 0x4f,
-	0x3c, 0x4e, 0x7c, 0x97, 0xf7, 0x58, 0x69, 0x69, 0x3b, 0xb3, 0x63, 0xa2, 0x35, 0x39, 0xf9, 0xd1,
-	0x78, 0xee, 0xbc, 0x27, 0x7c, 0xf2, 0xa4, 0x1b, 0xb9, 0x5e, 0x0b, 0xc7, 0x31, 0x09, 0x59, 0xc9,
-	0x94, 0xc4, 0xc5, 0x41, 0x6a, 0xe7, 0xaa, 0xbf, 0x7f, 0x7e, 0xac, 0xd5, 0x28, 0x47, 0xba, 0xd1,
-	0x50, 0x80, 0x7f, 0x02, 0x05, 0xec, 0x79, 0x84, 0x31, 0xd7, 0xa3, 0x31, 0x4f, 0x68, 0x58, 0xca,
-	0x6e, 0x67, 0x76, 0x72, 0xfb, 0xf6, 0xee, 0x6c, 0x25, 0x76, 0x8f, 0xa4, 0xdd, 0xb1, 0x32, 0xab,
-	0x6c, 0x7c, 0x9d, 0xda, 0x73, 0x83, 0xd4, 0x5e, 0x99, 0x52, 0xa3, 0x15, 0x3c, 0x29, 0xc2, 0xa7,
-	0xe0, 0x3e, 0xf6, 0x78, 0xd0, 0x25, 0x2e, 0xe3, 0x98, 0x07, 0x9e, 0xdb, 0x4e, 0x88, 0x47, 0xa3,
-	0x76, 0x10, 0x12, 0x56, 0x02, 0x22, 0x3e, 0x74, 0x4f, 0x19, 0xd4, 0xe5, 0xfc, 0xd9, 0x78, 0xfa,
-	0xe9, 0xbd, 0x57, 0xdf, 0x7d, 0xf5, 0x10, 0x92, 0x6e, 0x44, 0xd9, 0x5e, 0x4f, 0x2e, 0x95, 0x2a,
-	0x6f, 0xcd, 0x30, 0x33, 0xd6, 0x7c, 0xcd, 0x30, 0xe7, 0xad, 0x85, 0x9a, 0x61, 0x2e, 0x58, 0x46,
-	0xcd, 0x30, 0x17, 0xad, 0xa5, 0x9a, 0x61, 0x2e, 0x5b, 0x26, 0xca, 0x8a, 0x1a, 0xf8, 0x24, 0xa6,
-	0x11, 0xca, 0x7b, 0x2d, 0x1c, 0xc4, 0x22, 0xb3, 0x4f, 0x83, 0xa6, 0xf3, 0x97, 0x0c, 0x98, 0x0e,
-	0x16, 0x1e, 0x81, 0x25, 0x2f, 0x21, 0x98, 0x93, 0x52, 0x46, 0x26, 0xfd, 0x83, 0xff, 0x91, 0xf4,
-	0x79, 0xbf, 0x4d, 0x2a, 0x86, 0x48, 0x1c, 0x69, 0x47, 0xf8, 0x0b, 0x60, 0x78, 0x38, 0x0c, 0x4b,
-	0xf3, 0xff, 0x2f, 0x80, 0x74, 0x73, 0xfe, 0x9d, 0x01, 0xab, 0xd7, 0x2c, 0xa0, 0x07, 0x72, 0x7a,
-	0x51, 0x78, 0xbf, 0xad, 0x82, 0x2b, 0xec, 0xbf, 0xf3, 0x36, 0x6c, 0x09, 0xfa, 0xc3, 0x41, 0x6a,
-	0x83, 0xb1, 0x7c, 0x95, 0xda, 0x50, 0xf5, 0xd7, 0x04, 0x90, 0x83, 0x00, 0x1e, 0x59, 0x40, 0x0f,
-	0xac, 0x4d, 0xaf, 0xbc, 0x1b, 0x06, 0x8c, 0x97, 0xe6, 0x65, 0xd3, 0x3c, 0x19, 0xa4, 0xf6, 0x74,
-	0x60, 0xa7, 0x01, 0xe3, 0x57, 0xa9, 0x5d, 0x9e, 0x42, 0x9d, 0xf4, 0x74, 0xd0, 0x2a, 0x9e, 0x75,
-	0x70, 0xbe, 0x29, 0x82, 0xdc, 0xb1, 0x58, 0x84, 0x63, 0xb9, 0x06, 0xf0, 0x8f, 0xa0, 0xd8, 0xa2,
-	0x11, 0x61, 0x9c, 0x60, 0xdf, 0x6d, 0x84, 0xd4, 0xbb, 0x90, 0xd9, 0x65, 0x2b, 0x4f, 0xfe, 0x95,
-	0xda, 0x1b, 0x1e, 0x65, 0x11, 0x65, 0xcc, 0xbf, 0xd8, 0x0d, 0xe8, 0x5e, 0x84, 0x79, 0x6b, 0xf7,
-	0x24, 0x16, 0xa4, 0x9b, 0x8a, 0x74, 0xc6, 0xd3, 0x41, 0x85, 0x91, 0xa6, 0x22, 0x14, 0xb0, 0x05,
-	0x0a, 0x3e, 0xa6, 0xee, 0xa7, 0x34, 0xb9, 0xd0, 0xe0, 0xf3, 0x12, 0xbc, 0xf2, 0x56, 0xf0, 0x41,
-	0x6a, 0xe7, 0x9f, 0x1d, 0x7d, 0xf8, 0x01, 0x4d, 0x2e, 0x24, 0xc4, 0x55, 0x6a, 0x6f, 0x28, 0xb2,
-	0x69, 0x20, 0x07, 0xe5, 0x7d, 0x4c, 0x47, 0x66, 0xf0, 0x63, 0x60, 0x8d, 0x0c, 0x58, 0xa7, 0xdd,
-	0xa6, 0x09, 0x2f, 0x2d, 0x88, 0x9d, 0x59, 0xf9, 0xc9, 0x20, 0xb5, 0x0b, 0x1a, 0xb2, 0xae, 0x66,
-	0xae, 0x52, 0xfb, 0xde, 0x0c, 0xa8, 0xf6, 0x71, 0x50, 0x41, 0xc3, 0x6a, 0x53, 0xd8, 0x00, 0x79,
-	0x12, 0xb4, 0x1f, 0x1f, 0x3c, 0xd2, 0x09, 0x18, 0x32, 0x81, 0x5f, 0xdd, 0x96, 0x40, 0xae, 0x7a,
-	0x72, 0xf6, 0xf8, 0xe0, 0xd1, 0x30, 0xfe, 0x35, 0xfd, 0x5d, 0x99, 0x40, 0x71, 0x50, 0x4e, 0x89,
-	0x2a, 0xf8, 0x13, 0xa0, 0x45, 0xb7, 0x85, 0x59, 0xab, 0xb4, 0x28, 0x29, 0x76, 0x44, 0x03, 0x29,
-	0xa4, 0xdf, 0x62, 0xd6, 0x1a, 0x57, 0xbd, 0xd1, 0xff, 0x33, 0x8e, 0x79, 0xd0, 0x89, 0x86, 0x58,
-	0x40, 0x39, 0x0b, 0xab, 0x51, 0xb8, 0x07, 0x3a, 0xdc, 0xa5, 0xbb, 0x86, 0x7b, 0x70, 0x53, 0xb8,
-	0x07, 0xd3, 0xe1, 0x2a, 0x9b, 0x11, 0xc7, 0xa1, 0xe6, 0x58, 0xbe, 0x2b, 0xc7, 0xe1, 0x4d, 0x1c,
-	0x87, 0xd3, 0x1c, 0xca, 0x46, 0xf4, 0xe5, 0x4c, 0x9e, 0x25, 0xf3, 0xce, 0x7d, 0x79, 0xad, 0x42,
-	0x85, 0x91, 0x46, 0xa1, 0x5f, 0x80, 0x75, 0x8f, 0xc6, 0x8c, 0x0b, 0x5d, 0x4c, 0xdb, 0x21, 0xd1,
-	0x14, 0x59, 0x49, 0x71, 0x78, 0x1b, 0xc5, 0x03, 0x45, 0x71, 0x93, 0xbb, 0x83, 0xd6, 0xa6, 0xd5,
-	0x8a, 0xcc, 0x05, 0x56, 0x9b, 0x70, 0x92, 0xb0, 0x46, 0x27, 0x69, 0x6a, 0x22, 0x20, 0x89, 0xde,
-	0xbb, 0x8d, 0x48, 0x77, 0xe8, 0xac, 0xab, 0x83, 0x8a, 0x63, 0x95, 0x22, 0xf8, 0x04, 0x14, 0x02,
-	0xc1, 0xda, 0xe8, 0x84, 0x1a, 0x3e, 0x27, 0xe1, 0xf7, 0x6f, 0x83, 0xd7, 0xbb, 0x6a, 0xda, 0xd1,
-	0x41, 0x2b, 0x43, 0x85, 0x82, 0xf6, 0x01, 0x8c, 0x3a, 0x41, 0xe2, 0x36, 0x43, 0xec, 0x05, 0x24,
-	0xd1, 0xf0, 0x79, 0x09, 0xff, 0xd3, 0xdb, 0xe0, 0xef, 0x2b, 0xf8, 0xeb, 0xce, 0x0e, 0xb2, 0x84,
-	0xf2, 0x37, 0x4a, 0xa7, 0x58, 0xea, 0x20, 0xdf, 0x20, 0x49, 0x18, 0xc4, 0x1a, 0x7f, 0x45, 0xe2,
-	0x3f, 0xba, 0x0d, 0x5f, 0x77, 0xd0, 0xa4, 0x9b, 0x83, 0x72, 0x4a, 0x1c, 0x81, 0x86, 0x34, 0xf6,
-	0xe9, 0x10, 0x74, 0xf5, 0xce, 0xa0, 0x93, 0x6e, 0x0e, 0xca, 0x29, 0x51, 0x81, 0x36, 0xc1, 0x1a,
-	0x4e, 0x12, 0xfa, 0x72, 0xa6, 0x20, 0x50, 0x62, 0xff, 0xec, 0x36, 0xec, 0xe1, 0x77, 0xfa, 0xba,
-	0xb7, 0xf8, 0x4e, 0x0b, 0xed, 0x54, 0x49, 0x7c, 0x00, 0x9b, 0x09, 0xee, 0xcf, 0xf0, 0xac, 0xdf,
-	0xb9, 0xf0, 0xd7, 0x9d, 0x1d, 0x64, 0x09, 0xe5, 0x14, 0xcb, 0x67, 0x60, 0x3d, 0x22, 0x49, 0x93,
-	0xb8, 0x31, 0xe1, 0xac, 0x1d, 0x06, 0x5c, 0xf3, 0x6c, 0xdc, 0x79, 0x1f, 0xdc, 0xe4, 0xee, 0x20,
-	0x28, 0xd5, 0x2f, 0xb4, 0x76, 0xd4, 0xa5, 0xac, 0x85, 0xe3, 0x66, 0x0b, 0x07, 0x9a, 0x65, 0xf3,
-	0xce, 0x5d, 0x3a, 0xed, 0xe8, 0xa0, 0x95, 0xa1, 0x62, 0xb4, 0xd4, 0x1e, 0x8e, 0xbd, 0xce, 0x70,
-	0xa9, 0xef, 0xdd, 0x79, 0xa9, 0x27, 0xdd, 0x1c, 0x94, 0x53, 0xa2, 0x02, 0xbd, 0x0f, 0x4c, 0x75,
-	0x5b, 0x09, 0xfc, 0x52, 0x69, 0x3b, 0xb3, 0x63, 0xa0, 0x65, 0x29, 0x9f, 0xf8, 0x70, 0x1d, 0x2c,
-	0xca, 0xfb, 0x4c, 0xe9, 0xbe, 0x20, 0x42, 0x4a, 0x80, 0x65, 0x60, 0xfa, 0xc4, 0x0b, 0x22, 0x1c,
-	0xb2, 0x52, 0x59, 0x3a, 0x8c, 0xe4, 0x9a, 0x61, 0x16, 0xac, 0x62, 0xcd, 0x30, 0x8b, 0x96, 0x55,
-	0x33, 0x4c, 0xcb, 0x5a, 0xad, 0x19, 0xe6, 0x9a, 0xb5, 0x8e, 0x56, 0xfa, 0x34, 0xa4, 0x6e, 0xf7,
-	0x89, 0x8a, 0x00, 0xe5, 0xc8, 0x4b, 0xcc, 0xf4, 0x57, 0x0b, 0x15, 0x3c, 0xcc, 0x71, 0xd8, 0x67,
-	0xba, 0xaa, 0xc8, 0x52, 0xb5, 0x9e, 0x38, 0x03, 0xf7, 0xc0, 0xa2, 0xb8, 0xa5, 0x11, 0x68, 0x81,
-	0x85, 0x0b, 0xd2, 0x57, 0x27, 0x37, 0x12, 0x43, 0x11, 0x62, 0x17, 0x87, 0x1d, 0xa2, 0x0e, 0x5c,
-	0xa4, 0x04, 0xe7, 0x0c, 0x14, 0xcf, 0x13, 0x1c, 0x33, 0x71, 0xc3, 0xa3, 0xf1, 0x29, 0x6d, 0x32,
-	0x08, 0x81, 0x21, 0x0f, 0x1d, 0xe5, 0x2b, 0xc7, 0xf0, 0xc7, 0xc0, 0x08, 0x69, 0x93, 0xc9, 0xab,
-	0x47, 0x6e, 0x7f, 0xe3, 0xfa, 0x3d, 0xe7, 0x94, 0x36, 0x91, 0x34, 0x71, 0xbe, 0x99, 0x07, 0x0b,
-	0xa7, 0xb4, 0x09, 0x4b, 0x60, 0x19, 0xfb, 0x7e, 0x42, 0x18, 0xd3, 0x48, 0x43, 0x11, 0x6e, 0x82,
-	0x25, 0x4e, 0xdb, 0x81, 0xa7, 0xe0, 0xb2, 0x48, 0x4b, 0x82, 0xd8, 0xc7, 0x1c, 0xcb, 0x53, 0x3a,
-	0x8f, 0xe4, 0x58, 0x5c, 0x98, 0x65, 0x66, 0x6e, 0xdc, 0x89, 0x1a, 0x24, 0x91, 0x87, 0xad, 0x51,
-	0x29, 0x5e, 0xa6, 0x76, 0x4e, 0xea, 0x5f, 0x48, 0x35, 0x9a, 0x14, 0xe0, 0xbb, 0x60, 0x99, 0xf7,
-	0x26, 0x0f, 0xce, 0xb5, 0xcb, 0xd4, 0x2e, 0xf2, 0x71, 0x9a, 0xe2, 0x5c, 0x44, 0x4b, 0xbc, 0x27,
-	0xcf, 0xc7, 0x3d, 0x60, 0xf2, 0x9e, 0x1b, 0xc4, 0x3e, 0xe9, 0xc9, 0xb3, 0xd1, 0xa8, 0xac, 0x5f,
-	0xa6, 0xb6, 0x35, 0x61, 0x7e, 0x22, 0xe6, 0xd0, 0x32, 0xef, 0xc9, 0x01, 0x7c, 0x17, 0x00, 0x15,
-	0x92, 0x64, 0x50, 0x47, 0xdd, 0xca, 0x65, 0x6a, 0x67, 0xa5, 0x56, 0x62, 0x8f, 0x87, 0xd0, 0x01,
-	0x8b, 0x0a, 0xdb, 0x94, 0xd8, 0xf9, 0xcb, 0xd4, 0x36, 0x43, 0xda, 0x54, 0x98, 0x6a, 0x4a, 0x94,
-	0x2a, 0x21, 0x11, 0xed, 0x12, 0x5f, 0x9e, 0x37, 0x26, 0x1a, 0x8a, 0xce, 0x17, 0xf3, 0xc0, 0x3c,
-	0xef, 0x21, 0xc2, 0x3a, 0x21, 0x87, 0x1f, 0x00, 0x4b, 0xde, 0xe6, 0xb0, 0xc7, 0xdd, 0xa9, 0xd2,
-	0x56, 0x1e, 0x8c, 0x4f, 0x87, 0x59, 0x0b, 0x07, 0x15, 0x87, 0xaa, 0x23, 0x5d, 0xff, 0x75, 0xb0,
-	0xd8, 0x08, 0x29, 0x8d, 0x64, 0x27, 0xe4, 0x91, 0x12, 0xe0, 0xc7, 0xb2, 0x6a, 0x72, 0x95, 0x17,
-	0xe4, 0x4d, 0xf9, 0xfb, 0xd7, 0x57, 0x79, 0xa6, 0x55, 0x2a, 0x0f, 0xc4, 0x3d, 0xf9, 0x2a, 0xb5,
-	0x0b, 0x8a, 0x5b, 0xfb, 0x3b, 0x7f, 0xff, 0xee, 0xab, 0x87, 0x19, 0x51, 0x60, 0xd9, 0x4f, 0x16,
-	0x58, 0x48, 0x08, 0x97, 0x2b, 0x97, 0x47, 0x62, 0x28, 0xf6, 0x45, 0x42, 0xba, 0x24, 0xe1, 0xc4,
-	0x97, 0x2b, 0x64, 0xa2, 0x91, 0x2c, 0x36, 0x59, 0x13, 0x33, 0xb7, 0xc3, 0x88, 0xaf, 0x96, 0x03,
-	0x2d, 0x37, 0x31, 0xfb, 0x88, 0x11, 0xff, 0xa9, 0xf1, 0xf9, 0x97, 0xf6, 0x9c, 0x83, 0x41, 0x4e,
-	0x5f, 0xa2, 0x3b, 0xed, 0x90, 0xdc, 0xd2, 0x66, 0xfb, 0x20, 0xcf, 0x38, 0x4d, 0x70, 0x93, 0xb8,
-	0x17, 0xa4, 0xaf, 0x9b, 0x4d, 0xb5, 0x8e, 0xd6, 0xff, 0x8e, 0xf4, 0x19, 0x9a, 0x14, 0x34, 0xc5,
-	0x97, 0x06, 0xc8, 0x9d, 0x27, 0xd8, 0x23, 0xfa, 0x4a, 0x2c, 0x1a, 0x56, 0x88, 0x89, 0xa6, 0xd0,
-	0x92, 0xe0, 0xe6, 0x41, 0x44, 0x68, 0x87, 0xeb, 0x4d, 0x35, 0x14, 0x85, 0x47, 0x42, 0x48, 0x8f,
-	0x78, 0xb2, 0x96, 0x06, 0xd2, 0x12, 0x3c, 0x00, 0x2b, 0x7e, 0xc0, 0x70, 0x23, 0x94, 0xaf, 0x2d,
-	0xef, 0x42, 0xa5, 0x5f, 0xb1, 0x2e, 0x53, 0x3b, 0xaf, 0x27, 0xea, 0x42, 0x8f, 0xa6, 0x24, 0xf8,
-	0x3e, 0x28, 0x8e, 0xdd, 0x64, 0xb4, 0xea, 0x91, 0x59, 0x81, 0x97, 0xa9, 0x5d, 0x18, 0x99, 0xca,
-	0x19, 0x34, 0x23, 0xab, 0x6f, 0x53, 0xa3, 0xd3, 0x94, 0x1d, 0x68, 0x22, 0x25, 0x08, 0x6d, 0x18,
-	0x44, 0x01, 0x97, 0x1d, 0xb7, 0x88, 0x94, 0x00, 0xdf, 0x07, 0x59, 0xda, 0x25, 0x49, 0x12, 0xf8,
-	0xf2, 0xf1, 0x27, 0xda, 0xe0, 0x7b, 0xd7, 0xdb, 0x60, 0xe2, 0xb9, 0x80, 0xc6, 0xf6, 0x22, 0x39,
-	0x12, 0xcb, 0x20, 0x23, 0x12, 0xd1, 0xa4, 0x2f, 0x2f, 0x1d, 0x3a, 0x39, 0x35, 0xf1, 0x5c, 0xea,
-	0xd1, 0x94, 0x04, 0x2b, 0x00, 0x6a, 0xb7, 0x84, 0xf0, 0x4e, 0x12, 0xbb, 0xf2, 0x23, 0x90, 0x97,
-	0xbe, 0x72, 0x2b, 0xaa, 0x59, 0x24, 0x27, 0x9f, 0x61, 0x8e, 0xd1, 0x35, 0x0d, 0xfc, 0x25, 0x80,
-	0x6a, 0x4d, 0xdc, 0xcf, 0x18, 0x1d, 0x3e, 0x27, 0xf5, 0xad, 0x41, 0xf2, 0xab, 0x59, 0x1d, 0xb3,
-	0xa5, 0xa4, 0x1a, 0xa3, 0x3a, 0x8b, 0x9a, 0x61, 0x1a, 0xd6, 0xa2, 0x7e, 0x9d, 0x0e, 0xeb, 0xa7,
-	0xb3, 0x40, 0x6b, 0x43, 0x79, 0x22, 0xbc, 0x87, 0xff, 0xc8, 0x80, 0x89, 0xb7, 0x1c, 0xfc, 0x39,
-	0x28, 0x1f, 0x1d, 0x1f, 0x57, 0xeb, 0x75, 0xf7, 0xfc, 0x93, 0xb3, 0xaa, 0x7b, 0x56, 0x45, 0xcf,
-	0x4f, 0xea, 0xf5, 0x93, 0x0f, 0x5f, 0x9c, 0x56, 0xeb, 0x75, 0x6b, 0xae, 0xfc, 0xce, 0xab, 0xd7,
-	0xdb, 0xa5, 0xb1, 0xfd, 0x99, 0xa8, 0x27, 0x63, 0x01, 0x8d, 0x43, 0xd1, 0xa9, 0xef, 0x81, 0xcd,
-	0x49, 0x6f, 0x54, 0xad, 0x9f, 0xa3, 0x93, 0xe3, 0xf3, 0xea, 0x33, 0x2b, 0x53, 0x2e, 0xbd, 0x7a,
-	0xbd, 0xbd, 0x3e, 0xf6, 0x44, 0x84, 0xf1, 0x24, 0xf0, 0xc4, 0x4e, 0x39, 0x04, 0xa5, 0x9b, 0x39,
-	0xab, 0xcf, 0xac, 0xf9, 0x72, 0xf9, 0xd5, 0xeb, 0xed, 0xcd, 0x9b, 0x18, 0x89, 0x5f, 0x36, 0x3e,
-	0xff, 0xdb, 0xd6, 0x5c, 0xe5, 0xd7, 0x5f, 0x0f, 0xb6, 0x32, 0xdf, 0x0e, 0xb6, 0x32, 0xff, 0x19,
-	0x6c, 0x65, 0xbe, 0x78, 0xb3, 0x35, 0xf7, 0xed, 0x9b, 0xad, 0xb9, 0x7f, 0xbe, 0xd9, 0x9a, 0xfb,
-	0xc3, 0x8f, 0x9a, 0x01, 0x6f, 0x75, 0x1a, 0xbb, 0x1e, 0x8d, 0xf6, 0xd4, 0xe3, 0x5e, 0xfd, 0x76,
-	0xf7, 0x1f, 0xe9, 0x67, 0xbe, 0x78, 0xab, 0xb2, 0xc6, 0x92, 0xfc, 0xb7, 0xca, 0x93, 0xff, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0x1d, 0xfd, 0x0a, 0x4c, 0xaf, 0x11, 0x00, 0x00,
+	// 2442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0x17, 0xc5, 0x95, 0x44, 0x0e, 0xbf, 0x56, 0x23, 0xd9, 0x5e, 0x31, 0xb1, 0x96, 0xd9, 0x16,
+	0x85, 0x92, 0xa6, 0x92, 0x2d, 0xc7, 0xad, 0xe1, 0x34, 0x6d, 0x45, 0x99, 0xb1, 0xa9, 0x3a, 0xb1,
+	0x30, 0x54, 0x9a, 0xa6, 0x48, 0xb1, 0x18, 0xed, 0x8e, 0xc9, 0x8d, 0x76, 0x77, 0x88, 0x9d, 0x21,
+	0x43, 0xf6, 0x2f, 0x08, 0x7c, 0x4a, 0xff, 0x80, 0x00, 0x29, 0x7a, 0xe9, 0x31, 0x7f, 0x42, 0x8f,
+	0x41, 0x4e, 0x39, 0x16, 0x05, 0x4a, 0x14, 0xca, 0x21, 0x80, 0x8e, 0x3a, 0xf6, 0x54, 0xcc, 0xc7,
+	0xf2, 0x43, 0x94, 0x15, 0xe5, 0x42, 0xee, 0x7b, 0xf3, 0xde, 0xef, 0xcd, 0xfb, 0x9a, 0x2f, 0x50,
+	0x25, 0xbc, 0x43, 0x92, 0x28, 0x88, 0xf9, 0x0e, 0xe9, 0x47, 0x3b, 0xfd, 0xbb, 0xe2, 0x6f, 0xbb,
+	0x9b, 0x50, 0x4e, 0xa1, 0x39, 0x1e, 0xdb, 0x16, 0xcc, 0xfe, 0xdd, 0xea, 0x2a, 0x8e, 0x82, 0x98,
+	0xee, 0xc8, 0x5f, 0x25, 0x54, 0x5d, 0x6f, 0xd3, 0x36, 0x95, 0x9f, 0x3b, 0xe2, 0x4b, 0x71, 0x9d,
+	0xbf, 0xad, 0x80, 0xe5, 0x43, 0x9c, 0xe0, 0x88, 0xc1, 0x3d, 0x00, 0xc8, 0x80, 0x27, 0xd8, 0x25,
+	0x41, 0x97, 0x59, 0x46, 0x2d, 0xbb, 0x95, 0xaf, 0x3b, 0xa7, 0x23, 0x3b, 0xdf, 0x10, 0xdc, 0x46,
+	0xf3, 0x90, 0x9d, 0x8f, 0xec, 0xd5, 0x21, 0x8e, 0xc2, 0x87, 0xce, 0x44, 0xd0, 0x41, 0x79, 0x49,
+	0x34, 0x82, 0x2e, 0x83, 0xbb, 0xe0, 0x06, 0x0e, 0x43, 0xfa, 0xa9, 0xdb, 0x8b, 0x05, 0x3c, 0xf1,
+	0x38, 0xf1, 0x5d, 0x3e, 0x60, 0xd6, 0x72, 0x2d, 0xb3, 0x95, 0x43, 0x6b, 0x72, 0xf0, 0x83, 0xc9,
+	0xd8, 0xd1, 0x40, 0xe8, 0x14, 0x49, 0x3f, 0x72, 0xbd, 0x0e, 0x8e, 0x63, 0x12, 0x32, 0x2b, 0x27,
+	0x0d, 0x57, 0x4e, 0x47, 0x76, 0xa1, 0xf1, 0x87, 0xf7, 0xf6, 0x35, 0x1b, 0x15, 0x48, 0x3f, 0x4a,
+	0x09, 0xf8, 0x67, 0x50, 0xc6, 0x9e, 0x47, 0x18, 0x73, 0x3d, 0x1a, 0xf3, 0x84, 0x86, 0x56, 0xbe,
+	0x96, 0xd9, 0x2a, 0xec, 0xda, 0xdb, 0x17, 0x23, 0xb1, 0xbd, 0x27, 0xe5, 0xf6, 0x95, 0x58, 0xfd,
+	0xc6, 0xd7, 0x23, 0x7b, 0xe1, 0x74, 0x64, 0x97, 0x66, 0xd8, 0xa8, 0x84, 0xa7, 0x49, 0xf8, 0x10,
+	0x6c, 0x60, 0x8f, 0x07, 0x7d, 0xe2, 0x32, 0x8e, 0x79, 0xe0, 0xb9, 0xdd, 0x84, 0x78, 0x34, 0xea,
+	0x06, 0x21, 0x61, 0x16, 0x10, 0xf3, 0x43, 0xb7, 0x94, 0x40, 0x4b, 0x8e, 0x1f, 0x4e, 0x86, 0xe1,
+	0x1e, 0xb8, 0x3d, 0x91, 0x76, 0xfd, 0x61, 0x8c, 0xa3, 0xc0, 0x73, 0xdb, 0x98, 0xb9, 0x1d, 0x12,
+	0xb4, 0x3b, 0xdc, 0x2a, 0xd4, 0x32, 0x5b, 0x59, 0x54, 0x9d, 0x08, 0x3d, 0x52, 0x32, 0x8f, 0x31,
+	0x7b, 0x22, 0x25, 0xe0, 0xc7, 0xa0, 0xcc, 0xbc, 0x0e, 0xf1, 0x7b, 0x21, 0xf1, 0x55, 0x32, 0x8a,
+	0xb5, 0xec, 0x56, 0x61, 0x77, 0x73, 0xde, 0xbb, 0x56, 0x2a, 0xd7, 0x68, 0x1e, 0x4e, 0x9c, 0x9b,
+	0xe6, 0x32, 0x54, 0x1a, 0x83, 0xc9, 0x1c, 0xed, 0x80, 0xc2, 0x31, 0xf1, 0x3a, 0xf7, 0x76, 0xdd,
+	0x4e, 0xd2, 0x65, 0x56, 0x49, 0x86, 0xbb, 0x7c, 0x3a, 0xb2, 0x41, 0x5d, 0xb2, 0x9f, 0xa0, 0x43,
+	0x86, 0x80, 0x12, 0x79, 0x92, 0x74, 0x19, 0xfc, 0x39, 0x58, 0x3d, 0x0e, 0xa9, 0x77, 0x42, 0x7c,
+	0x15, 0x6d, 0xec, 0x71, 0x66, 0x95, 0x65, 0x14, 0x4c, 0x3d, 0xb0, 0x9f, 0xf2, 0xe1, 0xeb, 0xc0,
+	0x64, 0xc4, 0xeb, 0x25, 0x01, 0x1f, 0xba, 0x1e, 0xed, 0xc5, 0x5e, 0x10, 0x5a, 0x95, 0x5a, 0x66,
+	0x2b, 0x8f, 0x2a, 0x29, 0x7f, 0x5f, 0xb1, 0xe1, 0x33, 0x50, 0xa1, 0x2c, 0xa2, 0x2c, 0x60, 0x2e,
+	0xed, 0xf1, 0x2e, 0x65, 0xdc, 0x32, 0x65, 0x16, 0x6b, 0xf3, 0x7e, 0x3e, 0x53, 0x82, 0xcf, 0x94,
+	0x5c, 0xdd, 0x10, 0x9e, 0xa2, 0x32, 0x9d, 0xe1, 0xc2, 0xa7, 0xa0, 0xcc, 0x78, 0x12, 0xf8, 0x64,
+	0x8c, 0xb7, 0xfa, 0xb2, 0xaa, 0x68, 0x49, 0xb9, 0x59, 0xb8, 0x12, 0x9b, 0x66, 0xc2, 0x07, 0xc0,
+	0x4a, 0x88, 0x47, 0x82, 0x2e, 0x77, 0x13, 0xc2, 0x49, 0xcc, 0x03, 0x1a, 0xbb, 0xd2, 0x5f, 0x66,
+	0xc1, 0x5a, 0x66, 0xcb, 0x40, 0x37, 0xf5, 0x38, 0x4a, 0x87, 0xeb, 0x72, 0xf4, 0xe1, 0xad, 0x17,
+	0xdf, 0x7f, 0xf5, 0x06, 0x24, 0xfd, 0x88, 0xb2, 0x9d, 0x81, 0xec, 0x56, 0xd5, 0x61, 0x07, 0x46,
+	0x2e, 0x63, 0x2e, 0x1e, 0x18, 0xb9, 0x45, 0x33, 0x7b, 0x60, 0xe4, 0xb2, 0xa6, 0x71, 0x60, 0xe4,
+	0x96, 0xcc, 0xe5, 0x03, 0x23, 0xb7, 0x62, 0xe6, 0x50, 0x5e, 0xb4, 0x81, 0x4f, 0x62, 0x1a, 0xa1,
+	0xa2, 0xd7, 0xc1, 0x41, 0x2c, 0xc2, 0xfd, 0x3c, 0x68, 0x3b, 0x09, 0x28, 0xcf, 0xfa, 0x0f, 0xdf,
+	0x04, 0x40, 0xf7, 0x8b, 0x1b, 0xf8, 0x56, 0x46, 0xc4, 0xb7, 0x5e, 0x12, 0xad, 0xaa, 0x3b, 0xa4,
+	0xf9, 0x08, 0xe5, 0xb5, 0x40, 0xd3, 0x17, 0x1d, 0x36, 0xf0, 0xd8, 0x38, 0x79, 0xd6, 0x62, 0x2d,
+	0x93, 0x76, 0xd8, 0x1f, 0xf7, 0x5b, 0x69, 0xee, 0x50, 0x61, 0xe0, 0xb1, 0x94, 0x70, 0xde, 0x01,
+	0xa5, 0x99, 0x18, 0xfd, 0x38, 0x93, 0xce, 0x1e, 0x28, 0x4e, 0x17, 0x21, 0xdc, 0x00, 0x59, 0x12,
+	0x74, 0xb5, 0xda, 0xca, 0xe9, 0xc8, 0xce, 0x36, 0x9a, 0x87, 0x48, 0xf0, 0xe0, 0x4d, 0xb0, 0xac,
+	0x3b, 0x63, 0x51, 0x76, 0x86, 0xa6, 0x9c, 0xbf, 0x66, 0xc0, 0x6c, 0x97, 0xc2, 0x3d, 0xb0, 0xec,
+	0x25, 0x04, 0x73, 0x22, 0x71, 0x0a, 0xbb, 0x3f, 0xf9, 0x81, 0x6e, 0x3f, 0x1a, 0x76, 0x89, 0xce,
+	0xad, 0x56, 0x84, 0xef, 0x00, 0xc3, 0xc3, 0x61, 0x68, 0x2d, 0xfe, 0x58, 0x00, 0xa9, 0xe6, 0xfc,
+	0x27, 0x03, 0x56, 0xe7, 0x24, 0xa0, 0x07, 0x0a, 0x7a, 0x35, 0xe2, 0xc3, 0xae, 0x9a, 0x5c, 0x79,
+	0xf7, 0xd5, 0x97, 0x61, 0x4b, 0xd0, 0x9f, 0x8a, 0x7e, 0x9b, 0xd0, 0xe7, 0x23, 0x1b, 0xaa, 0x85,
+	0x75, 0x0a, 0xc8, 0x41, 0x00, 0x8f, 0x25, 0xa0, 0x07, 0xd6, 0x66, 0x97, 0x3c, 0x37, 0x0c, 0x98,
+	0x88, 0x99, 0x68, 0xdf, 0x7b, 0xa7, 0x23, 0x7b, 0x76, 0x62, 0x4f, 0x03, 0xc6, 0xcf, 0x47, 0x76,
+	0x75, 0x06, 0x75, 0x5a, 0xd3, 0x41, 0xab, 0xf8, 0xa2, 0x82, 0xf3, 0x4d, 0x05, 0x14, 0xf6, 0x45,
+	0xe9, 0xed, 0xcb, 0xca, 0x83, 0x1f, 0x83, 0x4a, 0x87, 0x46, 0x84, 0x71, 0x82, 0x7d, 0x55, 0xfb,
+	0x3a, 0x85, 0xf7, 0xfe, 0x3d, 0xb2, 0x6f, 0x78, 0xb2, 0xff, 0x98, 0x7f, 0xb2, 0x1d, 0xd0, 0x9d,
+	0x08, 0xf3, 0xce, 0x76, 0x33, 0x16, 0x46, 0x6f, 0x2a, 0xa3, 0x17, 0x34, 0x1d, 0x54, 0x1e, 0x73,
+	0x64, 0xa3, 0xc0, 0x0e, 0x28, 0xfb, 0x98, 0xba, 0xcf, 0x69, 0x72, 0xa2, 0xc1, 0x55, 0x65, 0xd6,
+	0x5f, 0x0a, 0x7e, 0x3a, 0xb2, 0x8b, 0x8f, 0xf6, 0x9e, 0xbd, 0x4b, 0x93, 0x13, 0x09, 0x71, 0x3e,
+	0xb2, 0x6f, 0x28, 0x63, 0xb3, 0x40, 0x0e, 0x2a, 0xfa, 0x98, 0x8e, 0xc5, 0xe0, 0x87, 0xc0, 0x1c,
+	0x0b, 0xb0, 0x5e, 0xb7, 0x4b, 0x13, 0x6e, 0x65, 0xc5, 0x96, 0x54, 0xff, 0xc5, 0xe9, 0xc8, 0x2e,
+	0x6b, 0xc8, 0x96, 0x1a, 0x39, 0x1f, 0xd9, 0xb7, 0x2e, 0x80, 0x6a, 0x1d, 0x07, 0x95, 0x35, 0xac,
+	0x16, 0x85, 0xc7, 0xa0, 0x48, 0x82, 0xee, 0xdd, 0xfb, 0x77, 0xb4, 0x03, 0x86, 0x74, 0xe0, 0xb7,
+	0x57, 0x39, 0x50, 0x68, 0x34, 0x0f, 0xef, 0xde, 0xbf, 0x93, 0xce, 0x7f, 0x4d, 0x99, 0x9a, 0x46,
+	0x71, 0x50, 0x41, 0x91, 0x6a, 0xf2, 0x4d, 0xa0, 0x49, 0xb7, 0x83, 0x59, 0xc7, 0x5a, 0x92, 0x26,
+	0xb6, 0x44, 0x01, 0x29, 0xa4, 0x27, 0x98, 0x75, 0x26, 0x51, 0x3f, 0x1e, 0xfe, 0x05, 0xc7, 0x3c,
+	0xe8, 0x45, 0x29, 0x16, 0x50, 0xca, 0x42, 0x6a, 0x3c, 0xdd, 0xfb, 0x7a, 0xba, 0xcb, 0xd7, 0x9d,
+	0xee, 0xfd, 0xcb, 0xa6, 0x7b, 0x7f, 0x76, 0xba, 0x4a, 0x66, 0x6c, 0xe3, 0x81, 0xb6, 0xb1, 0x72,
+	0x5d, 0x1b, 0x0f, 0x2e, 0xb3, 0xf1, 0x60, 0xd6, 0x86, 0x92, 0x11, 0x75, 0x79, 0xc1, 0x4f, 0x2b,
+	0x77, 0xed, 0xba, 0x9c, 0x8b, 0x50, 0x79, 0xcc, 0x51, 0xe8, 0x27, 0x60, 0xdd, 0xa3, 0x31, 0xe3,
+	0x82, 0x17, 0xd3, 0x6e, 0x48, 0xb4, 0x89, 0xbc, 0x34, 0xf1, 0xe0, 0x2a, 0x13, 0xaf, 0x28, 0x13,
+	0x97, 0xa9, 0x3b, 0x68, 0x6d, 0x96, 0xad, 0x8c, 0xb9, 0xc0, 0xec, 0x12, 0x4e, 0x12, 0x76, 0xdc,
+	0x4b, 0xda, 0xda, 0x10, 0x90, 0x86, 0xde, 0xba, 0xca, 0x90, 0xae, 0xd0, 0x8b, 0xaa, 0x0e, 0xaa,
+	0x4c, 0x58, 0xca, 0xc0, 0x47, 0xa0, 0x1c, 0x08, 0xab, 0xc7, 0xbd, 0x50, 0xc3, 0x17, 0x24, 0xfc,
+	0xee, 0x55, 0xf0, 0xba, 0xab, 0x66, 0x15, 0x1d, 0x54, 0x4a, 0x19, 0x0a, 0xda, 0x07, 0x30, 0xea,
+	0x05, 0x89, 0xdb, 0x0e, 0xb1, 0x17, 0x90, 0x44, 0xc3, 0x17, 0x25, 0xfc, 0x2f, 0xaf, 0x82, 0xdf,
+	0x50, 0xf0, 0xf3, 0xca, 0x0e, 0x32, 0x05, 0xf3, 0xb1, 0xe2, 0x29, 0x2b, 0x2d, 0x50, 0x3c, 0x26,
+	0x49, 0x18, 0xe8, 0xdd, 0xd7, 0x2a, 0x49, 0xfc, 0x3b, 0x57, 0xe1, 0xeb, 0x0a, 0x9a, 0x56, 0x73,
+	0x50, 0x41, 0x91, 0x63, 0xd0, 0x90, 0xc6, 0x7e, 0xba, 0xa5, 0x5b, 0xab, 0xd7, 0x06, 0x9d, 0x56,
+	0x73, 0x50, 0x41, 0x91, 0x0a, 0xb4, 0x0d, 0xd6, 0x70, 0x92, 0xd0, 0x4f, 0x2f, 0x04, 0x04, 0x4a,
+	0xec, 0x5f, 0x5d, 0x85, 0x9d, 0xae, 0xd3, 0xf3, 0xda, 0x62, 0x9d, 0x16, 0xdc, 0x99, 0x90, 0xf8,
+	0x00, 0xb6, 0x13, 0x3c, 0xbc, 0x60, 0x67, 0xfd, 0xda, 0x81, 0x9f, 0x57, 0x76, 0x90, 0x29, 0x98,
+	0x33, 0x56, 0x3e, 0x01, 0xeb, 0x11, 0x49, 0xda, 0xc4, 0x8d, 0x09, 0x67, 0xdd, 0x30, 0xe0, 0xda,
+	0xce, 0x8d, 0x6b, 0xf7, 0xc1, 0x65, 0xea, 0x0e, 0x82, 0x92, 0xfd, 0xbe, 0xe6, 0x8e, 0xab, 0x94,
+	0x75, 0x70, 0xdc, 0xee, 0xe0, 0x40, 0x5b, 0xb9, 0x79, 0xed, 0x2a, 0x9d, 0x55, 0x74, 0x50, 0x29,
+	0x65, 0x8c, 0x53, 0xed, 0xe1, 0xd8, 0xeb, 0xa5, 0xa9, 0xbe, 0x75, 0xed, 0x54, 0x4f, 0xab, 0x39,
+	0xa8, 0xa0, 0x48, 0x05, 0xba, 0x01, 0x72, 0xea, 0x8c, 0x16, 0xf8, 0x96, 0x25, 0x4f, 0x83, 0x2b,
+	0x92, 0x6e, 0xfa, 0x70, 0x1d, 0x2c, 0xc9, 0x53, 0x9c, 0xb5, 0x21, 0xcf, 0xbd, 0x8a, 0x80, 0x55,
+	0x90, 0xf3, 0x89, 0x17, 0x44, 0x38, 0x64, 0x56, 0x55, 0x2a, 0x8c, 0xe9, 0x03, 0x23, 0x57, 0x36,
+	0x2b, 0x07, 0x46, 0xae, 0x62, 0x9a, 0x07, 0x46, 0xce, 0x34, 0x57, 0x0f, 0x8c, 0xdc, 0x9a, 0xb9,
+	0x8e, 0x4a, 0x43, 0x1a, 0x52, 0xb7, 0x7f, 0x4f, 0xcd, 0x00, 0x15, 0xc8, 0xa7, 0x98, 0xe9, 0x55,
+	0x0b, 0x95, 0x3d, 0xcc, 0x71, 0x38, 0x64, 0x3a, 0xaa, 0xc8, 0x54, 0xb1, 0x9e, 0xda, 0x03, 0x77,
+	0xc0, 0x92, 0xb8, 0x9e, 0x10, 0x68, 0x82, 0xec, 0x09, 0x19, 0xaa, 0x9d, 0x1b, 0x89, 0x4f, 0x31,
+	0xc5, 0x3e, 0x0e, 0x7b, 0x44, 0x6d, 0xb8, 0x48, 0x11, 0xce, 0x21, 0xa8, 0x1c, 0x25, 0x38, 0x66,
+	0xe2, 0x6a, 0x43, 0xe3, 0xa7, 0xb4, 0xcd, 0x20, 0x04, 0x86, 0xdc, 0x74, 0x94, 0xae, 0xfc, 0x86,
+	0xaf, 0x03, 0x23, 0xa4, 0x6d, 0x26, 0x8f, 0x1e, 0x85, 0xdd, 0x1b, 0xf3, 0xe7, 0x9c, 0xa7, 0xb4,
+	0x8d, 0xa4, 0x88, 0xf3, 0xcd, 0x22, 0xc8, 0x3e, 0xa5, 0x6d, 0x68, 0x81, 0x15, 0xec, 0xfb, 0x09,
+	0x61, 0x4c, 0x23, 0xa5, 0xa4, 0x38, 0xfd, 0x71, 0xda, 0x0d, 0x3c, 0x05, 0x97, 0x47, 0x9a, 0x12,
+	0x86, 0x7d, 0xcc, 0xb1, 0xdc, 0xa5, 0x8b, 0x48, 0x7e, 0x8b, 0x73, 0xac, 0xf4, 0xcc, 0x8d, 0x7b,
+	0xd1, 0x31, 0x49, 0xe4, 0x66, 0x6b, 0xd4, 0x2b, 0x67, 0x23, 0xbb, 0x20, 0xf9, 0xef, 0x4b, 0x36,
+	0x9a, 0x26, 0xe0, 0x9b, 0x60, 0x85, 0x0f, 0xa6, 0x37, 0xce, 0xb5, 0xb3, 0x91, 0x5d, 0xe1, 0x13,
+	0x37, 0xc5, 0xbe, 0x88, 0x96, 0xf9, 0x40, 0xfc, 0xc3, 0x1d, 0x90, 0xe3, 0x03, 0x37, 0x88, 0x7d,
+	0x32, 0x90, 0x7b, 0xa3, 0x51, 0x5f, 0x3f, 0x1b, 0xd9, 0xe6, 0x94, 0x78, 0x53, 0x8c, 0xa1, 0x15,
+	0x3e, 0x90, 0x1f, 0xe2, 0x54, 0xac, 0xa6, 0x24, 0x2d, 0xa8, 0xad, 0xae, 0x74, 0x36, 0xb2, 0xf3,
+	0x92, 0x2b, 0xb1, 0x27, 0x9f, 0xd0, 0x01, 0x4b, 0x0a, 0x3b, 0x27, 0xb1, 0x8b, 0x67, 0x23, 0x3b,
+	0x17, 0xd2, 0xb6, 0xc2, 0x54, 0x43, 0x22, 0x54, 0x09, 0x89, 0x68, 0x9f, 0xf8, 0x72, 0xbf, 0xc9,
+	0xa1, 0x94, 0x74, 0xfe, 0x97, 0x05, 0x2b, 0x48, 0xdd, 0x38, 0xe0, 0xad, 0x89, 0x5b, 0x2a, 0xa0,
+	0xa9, 0x07, 0x1b, 0x53, 0x1e, 0x2c, 0xaa, 0xba, 0x4c, 0xe7, 0x0a, 0x81, 0xf1, 0x3c, 0xa1, 0x91,
+	0x0c, 0x69, 0x1e, 0xc9, 0x6f, 0x58, 0x06, 0x8b, 0x9c, 0xaa, 0x53, 0x0b, 0x5a, 0xe4, 0x54, 0x5c,
+	0xdf, 0xd2, 0x6b, 0x82, 0x9b, 0x66, 0x6c, 0x49, 0x5d, 0xdf, 0x52, 0xfe, 0x9e, 0xce, 0xdc, 0x06,
+	0xc8, 0x89, 0x5b, 0x6d, 0x8f, 0x11, 0x5f, 0xc5, 0x0a, 0xad, 0xb4, 0x31, 0xfb, 0x80, 0x11, 0x5f,
+	0x24, 0x95, 0x71, 0xcc, 0x7b, 0x4c, 0x46, 0xc4, 0x40, 0x9a, 0x12, 0x2a, 0xfd, 0xc8, 0x25, 0x49,
+	0x42, 0x13, 0xb5, 0x5f, 0xa3, 0x95, 0x7e, 0xd4, 0x10, 0xe4, 0xb8, 0xa8, 0xf2, 0x3f, 0x58, 0x54,
+	0xf0, 0xb5, 0x0b, 0x65, 0x00, 0xa4, 0x8d, 0x99, 0xac, 0xdf, 0x9e, 0x49, 0x8b, 0xdc, 0xef, 0xa6,
+	0xf3, 0xb0, 0x0d, 0xd6, 0xbc, 0x5e, 0xd4, 0x0b, 0xb1, 0xbc, 0xe3, 0x8f, 0xbd, 0x28, 0x4a, 0xa0,
+	0xd5, 0xc9, 0xd0, 0x63, 0xed, 0xcf, 0x6d, 0x00, 0x84, 0x65, 0xd1, 0x57, 0x34, 0x92, 0xfb, 0x4f,
+	0x11, 0xe5, 0x05, 0xa7, 0x2e, 0x18, 0x3a, 0x19, 0xf2, 0xec, 0x5f, 0xae, 0x65, 0xb6, 0x4a, 0x22,
+	0x19, 0xf2, 0xcc, 0xde, 0x04, 0x6b, 0xe4, 0xf9, 0x73, 0xe2, 0x8d, 0xcd, 0x74, 0x93, 0xc0, 0x23,
+	0xea, 0x3e, 0x5c, 0xdf, 0x78, 0xe9, 0x02, 0x84, 0x56, 0xc7, 0x5a, 0x8f, 0x31, 0x3b, 0x14, 0x3a,
+	0xce, 0xe7, 0x8b, 0x20, 0x77, 0x34, 0x40, 0x84, 0xf5, 0x42, 0x0e, 0xdf, 0xbd, 0x24, 0x4b, 0xea,
+	0x5c, 0xfe, 0xca, 0xe4, 0x68, 0x70, 0x51, 0xc2, 0x99, 0x4f, 0xe1, 0x3a, 0x58, 0x52, 0x2e, 0x2d,
+	0x4a, 0x97, 0x14, 0x01, 0x3f, 0x94, 0xee, 0xc8, 0x6c, 0x64, 0xe5, 0x35, 0xe9, 0xb5, 0xf9, 0x6c,
+	0x5c, 0x58, 0x27, 0xea, 0xaf, 0x88, 0x4b, 0xd2, 0xf9, 0xc8, 0x2e, 0x2b, 0xdb, 0x5a, 0xdf, 0xf9,
+	0xc7, 0xf7, 0x5f, 0xbd, 0x91, 0x11, 0xe1, 0x90, 0x8b, 0x89, 0x09, 0xb2, 0x09, 0xe1, 0xb2, 0xda,
+	0x8a, 0x48, 0x7c, 0x8a, 0x45, 0x31, 0x21, 0x7d, 0x92, 0x70, 0xe2, 0xcb, 0x32, 0xcb, 0xa1, 0x31,
+	0x7d, 0x45, 0x7d, 0x3d, 0x34, 0x3e, 0xfb, 0xd2, 0x5e, 0x70, 0x30, 0x28, 0xe8, 0x1b, 0x54, 0xaf,
+	0x1b, 0x92, 0x2b, 0xd6, 0x98, 0x5d, 0x50, 0x64, 0x9c, 0x26, 0xb8, 0x4d, 0xdc, 0x13, 0x32, 0xd4,
+	0x2b, 0x8d, 0x5a, 0x37, 0x34, 0xff, 0xf7, 0x64, 0xc8, 0xd0, 0x34, 0xa1, 0x4d, 0x7c, 0x69, 0x80,
+	0xc2, 0x51, 0x82, 0x3d, 0xa2, 0xef, 0x43, 0x62, 0xb5, 0x12, 0x64, 0x32, 0xee, 0x3a, 0x49, 0x09,
+	0xdb, 0x3c, 0x88, 0x08, 0xed, 0xe9, 0xcb, 0x35, 0x4a, 0x49, 0xa1, 0x91, 0x10, 0x32, 0x20, 0x9e,
+	0x8c, 0xa5, 0x81, 0x34, 0x05, 0xef, 0x83, 0x92, 0x1f, 0x30, 0x7c, 0x1c, 0xca, 0x37, 0x26, 0xef,
+	0x44, 0xb9, 0x5f, 0x37, 0xcf, 0x46, 0x76, 0x51, 0x0f, 0xb4, 0x04, 0x1f, 0xcd, 0x50, 0xf0, 0x6d,
+	0x50, 0x99, 0xa8, 0xc9, 0xd9, 0xaa, 0xa7, 0xb5, 0x3a, 0x3c, 0x1b, 0xd9, 0xe5, 0xb1, 0xa8, 0x1c,
+	0x41, 0x17, 0x68, 0xb5, 0x31, 0x1d, 0xf7, 0xda, 0xb2, 0xf7, 0x72, 0x48, 0x11, 0x82, 0x1b, 0x06,
+	0x51, 0xc0, 0xe5, 0x72, 0xb3, 0x84, 0x14, 0x01, 0xdf, 0x06, 0x79, 0xda, 0x27, 0x89, 0x78, 0x01,
+	0x60, 0xb2, 0xc3, 0x0a, 0xbb, 0xb7, 0xe7, 0xcb, 0x60, 0xea, 0xae, 0x88, 0x26, 0xf2, 0xc2, 0x39,
+	0x12, 0xcb, 0x49, 0x46, 0x24, 0xa2, 0xc9, 0xd0, 0x2a, 0x4c, 0x9c, 0x53, 0x03, 0xef, 0x49, 0x3e,
+	0x9a, 0xa1, 0x60, 0x1d, 0x40, 0xad, 0x96, 0x10, 0xde, 0x4b, 0x62, 0x57, 0xee, 0x00, 0x45, 0xa9,
+	0x2b, 0xd7, 0x61, 0x35, 0x8a, 0xe4, 0xe0, 0x23, 0xcc, 0x31, 0x9a, 0xe3, 0xc0, 0xdf, 0x00, 0xa8,
+	0x72, 0xe2, 0x7e, 0xc2, 0x68, 0xfa, 0x82, 0xa2, 0x8f, 0x8c, 0xd2, 0xbe, 0x1a, 0xd5, 0x73, 0x36,
+	0x15, 0x75, 0xc0, 0xa8, 0xf6, 0xe2, 0xc0, 0xc8, 0x19, 0xe6, 0x92, 0x7e, 0x90, 0x49, 0xe3, 0xa7,
+	0xbd, 0x40, 0x6b, 0x29, 0x3d, 0x35, 0xbd, 0x37, 0xfe, 0x99, 0x01, 0x53, 0x17, 0x79, 0xf8, 0x6b,
+	0x50, 0xdd, 0xdb, 0xdf, 0x6f, 0xb4, 0x5a, 0xee, 0xd1, 0x47, 0x87, 0x0d, 0xf7, 0xb0, 0x81, 0xde,
+	0x6b, 0xb6, 0x5a, 0xcd, 0x67, 0xef, 0x3f, 0x6d, 0xb4, 0x5a, 0xe6, 0x42, 0xf5, 0xd5, 0x17, 0x5f,
+	0xd4, 0xac, 0x89, 0xfc, 0xa1, 0x88, 0x27, 0x63, 0x01, 0x8d, 0x43, 0x51, 0xa9, 0x6f, 0x81, 0x9b,
+	0xd3, 0xda, 0xa8, 0xd1, 0x3a, 0x42, 0xcd, 0xfd, 0xa3, 0xc6, 0x23, 0x33, 0x53, 0xb5, 0x5e, 0x7c,
+	0x51, 0x5b, 0x9f, 0x68, 0x22, 0xc2, 0x78, 0x12, 0x88, 0x47, 0x54, 0xf1, 0x52, 0x75, 0xb9, 0xcd,
+	0xc6, 0x23, 0x73, 0xb1, 0x5a, 0x7d, 0xf1, 0x45, 0xed, 0xe6, 0x65, 0x16, 0x89, 0x5f, 0x35, 0x3e,
+	0xfb, 0xfb, 0xe6, 0x42, 0xfd, 0x77, 0x5f, 0x9f, 0x6e, 0x66, 0xbe, 0x3d, 0xdd, 0xcc, 0xfc, 0xf7,
+	0x74, 0x33, 0xf3, 0xf9, 0x77, 0x9b, 0x0b, 0xdf, 0x7e, 0xb7, 0xb9, 0xf0, 0xaf, 0xef, 0x36, 0x17,
+	0xfe, 0xf4, 0xb3, 0x76, 0xc0, 0x3b, 0xbd, 0xe3, 0x6d, 0x8f, 0x46, 0x3b, 0xea, 0x3d, 0x4b, 0xfd,
+	0xf6, 0x77, 0xef, 0xe8, 0x97, 0x2d, 0xb1, 0xea, 0xb1, 0xe3, 0x65, 0xf9, 0x98, 0x7c, 0xef, 0xff,
+	0x03, 0x00, 0xab, 0x97, 0xfc, 0xad, 0xa5, 0x16, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1001,17 +1450,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.ActiveStaticPrecompiles) > 0 {
-		for iNdEx := len(m.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ActiveStaticPrecompiles[iNdEx])
-			copy(dAtA[i:], m.ActiveStaticPrecompiles[iNdEx])
-			i = encodeVarintEvm(dAtA, i, uint64(len(m.ActiveStaticPrecompiles[iNdEx])))
-			i--
-			dAtA[i] = 0x52
-		}
+	if m.ReceiptRetentionBlocks != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ReceiptRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
 	{
-		size, err := m.AccessControl.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.StrideOutpost.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1019,11 +1466,88 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEvm(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x4a
-	if len(m.EVMChannels) > 0 {
-		for iNdEx := len(m.EVMChannels) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.EVMChannels[iNdEx])
-			copy(dAtA[i:], m.EVMChannels[iNdEx])
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size, err := m.OsmosisOutpost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.SecurityCouncil) > 0 {
+		i -= len(m.SecurityCouncil)
+		copy(dAtA[i:], m.SecurityCouncil)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.SecurityCouncil)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.BlockedContracts) > 0 {
+		for iNdEx := len(m.BlockedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedContracts[iNdEx])
+			copy(dAtA[i:], m.BlockedContracts[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Bech32HRPs) > 0 {
+		for iNdEx := len(m.Bech32HRPs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Bech32HRPs[iNdEx])
+			copy(dAtA[i:], m.Bech32HRPs[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Bech32HRPs[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ScheduledEIPs) > 0 {
+		for iNdEx := len(m.ScheduledEIPs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledEIPs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.PrecompileDynamicGasHeight != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.PrecompileDynamicGasHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ActiveStaticPrecompiles) > 0 {
+		for iNdEx := len(m.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActiveStaticPrecompiles[iNdEx])
+			copy(dAtA[i:], m.ActiveStaticPrecompiles[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.ActiveStaticPrecompiles[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.AccessControl.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.EVMChannels) > 0 {
+		for iNdEx := len(m.EVMChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EVMChannels[iNdEx])
+			copy(dAtA[i:], m.EVMChannels[iNdEx])
 			i = encodeVarintEvm(dAtA, i, uint64(len(m.EVMChannels[iNdEx])))
 			i--
 			dAtA[i] = 0x42
@@ -1051,6 +1575,108 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OsmosisOutpost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OsmosisOutpost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OsmosisOutpost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.XCSContract) > 0 {
+		i -= len(m.XCSContract)
+		copy(dAtA[i:], m.XCSContract)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.XCSContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StrideOutpost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StrideOutpost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StrideOutpost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledEIP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledEIP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledEIP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EIP) > 0 {
+		i -= len(m.EIP)
+		copy(dAtA[i:], m.EIP)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.EIP)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AccessControl) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1576,6 +2202,134 @@ func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Receipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveGasPrice != nil {
+		{
+			size := m.EffectiveGasPrice.Size()
+			i -= size
+			if _, err := m.EffectiveGasPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.TxType != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.TxType))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.LogsBloom) > 0 {
+		i -= len(m.LogsBloom)
+		copy(dAtA[i:], m.LogsBloom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.LogsBloom)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.CumulativeGasUsed != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.CumulativeGasUsed))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TxResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1840,32 +2594,110 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	if m.PrecompileDynamicGasHeight != 0 {
+		n += 1 + sovEvm(uint64(m.PrecompileDynamicGasHeight))
+	}
+	if len(m.ScheduledEIPs) > 0 {
+		for _, e := range m.ScheduledEIPs {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.Bech32HRPs) > 0 {
+		for _, s := range m.Bech32HRPs {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.BlockedContracts) > 0 {
+		for _, s := range m.BlockedContracts {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	l = len(m.SecurityCouncil)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = m.OsmosisOutpost.Size()
+	n += 2 + l + sovEvm(uint64(l))
+	l = m.StrideOutpost.Size()
+	n += 2 + l + sovEvm(uint64(l))
+	if m.ReceiptRetentionBlocks != 0 {
+		n += 2 + sovEvm(uint64(m.ReceiptRetentionBlocks))
+	}
 	return n
 }
 
-func (m *AccessControl) Size() (n int) {
+func (m *OsmosisOutpost) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Create.Size()
-	n += 1 + l + sovEvm(uint64(l))
-	l = m.Call.Size()
-	n += 1 + l + sovEvm(uint64(l))
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.XCSContract)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
-func (m *AccessControlType) Size() (n int) {
+func (m *StrideOutpost) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.AccessType != 0 {
-		n += 1 + sovEvm(uint64(m.AccessType))
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
 	}
-	if len(m.AccessControlList) > 0 {
+	return n
+}
+
+func (m *ScheduledEIP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EIP)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvm(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *AccessControl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Create.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.Call.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *AccessControlType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccessType != 0 {
+		n += 1 + sovEvm(uint64(m.AccessType))
+	}
+	if len(m.AccessControlList) > 0 {
 		for _, s := range m.AccessControlList {
 			l = len(s)
 			n += 1 + l + sovEvm(uint64(l))
@@ -2047,6 +2879,71 @@ func (m *Log) Size() (n int) {
 	return n
 }
 
+func (m *Receipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovEvm(uint64(m.TxIndex))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvm(uint64(m.GasUsed))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvm(uint64(m.Status))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovEvm(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.CumulativeGasUsed != 0 {
+		n += 1 + sovEvm(uint64(m.CumulativeGasUsed))
+	}
+	l = len(m.LogsBloom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.TxType != 0 {
+		n += 1 + sovEvm(uint64(m.TxType))
+	}
+	if m.EffectiveGasPrice != nil {
+		l = m.EffectiveGasPrice.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
 func (m *TxResult) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2325,59 +3222,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AccessControl) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvm
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileDynamicGasHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PrecompileDynamicGasHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrecompileDynamicGasHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEIPs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2404,15 +3270,16 @@ func (m *AccessControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScheduledEIPs = append(m.ScheduledEIPs, ScheduledEIP{})
+			if err := m.ScheduledEIPs[len(m.ScheduledEIPs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32HRPs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2422,80 +3289,29 @@ func (m *AccessControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bech32HRPs = append(m.Bech32HRPs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AccessControlType) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvm
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AccessControlType: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessControlType: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedContracts", wireType)
 			}
-			m.AccessType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2505,14 +3321,27 @@ func (m *AccessControlType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccessType |= AccessType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedContracts = append(m.BlockedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityCouncil", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2540,19 +3369,104 @@ func (m *AccessControlType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessControlList = append(m.AccessControlList, string(dAtA[iNdEx:postIndex]))
+			m.SecurityCouncil = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmosisOutpost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmosisOutpost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrideOutpost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StrideOutpost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptRetentionBlocks", wireType)
+			}
+			m.ReceiptRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -2563,7 +3477,7 @@ func (m *AccessControlType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainConfig) Unmarshal(dAtA []byte) error {
+func (m *OsmosisOutpost) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2586,15 +3500,833 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: OsmosisOutpost: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OsmosisOutpost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XCSContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XCSContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StrideOutpost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StrideOutpost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StrideOutpost: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledEIP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledEIP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledEIP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessControlType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessControlType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessControlType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+			}
+			m.AccessType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessType |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessControlList = append(m.AccessControlList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.HomesteadBlock = &v
+			if err := m.HomesteadBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.DAOForkBlock = &v
+			if err := m.DAOForkBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkSupport", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DAOForkSupport = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Block", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.EIP150Block = &v
+			if err := m.EIP150Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EIP150Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP155Block", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.EIP155Block = &v
+			if err := m.EIP155Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP158Block", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.EIP158Block = &v
+			if err := m.EIP158Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByzantiumBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ByzantiumBlock = &v
+			if err := m.ByzantiumBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantinopleBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ConstantinopleBlock = &v
+			if err := m.ConstantinopleBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PetersburgBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2623,14 +4355,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.HomesteadBlock = &v
-			if err := m.HomesteadBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PetersburgBlock = &v
+			if err := m.PetersburgBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IstanbulBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2659,16 +4391,16 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.DAOForkBlock = &v
-			if err := m.DAOForkBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IstanbulBlock = &v
+			if err := m.IstanbulBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkSupport", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MuirGlacierBlock", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2678,15 +4410,31 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DAOForkSupport = bool(v != 0)
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MuirGlacierBlock = &v
+			if err := m.MuirGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BerlinBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2715,14 +4463,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.EIP150Block = &v
-			if err := m.EIP150Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BerlinBlock = &v
+			if err := m.BerlinBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LondonBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2750,11 +4498,15 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EIP150Hash = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.Int
+			m.LondonBlock = &v
+			if err := m.LondonBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP155Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrowGlacierBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2783,14 +4535,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.EIP155Block = &v
-			if err := m.EIP155Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ArrowGlacierBlock = &v
+			if err := m.ArrowGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP158Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrayGlacierBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2819,14 +4571,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.EIP158Block = &v
-			if err := m.EIP158Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GrayGlacierBlock = &v
+			if err := m.GrayGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByzantiumBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeNetsplitBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2855,14 +4607,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.ByzantiumBlock = &v
-			if err := m.ByzantiumBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MergeNetsplitBlock = &v
+			if err := m.MergeNetsplitBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConstantinopleBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShanghaiBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2891,14 +4643,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.ConstantinopleBlock = &v
-			if err := m.ConstantinopleBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ShanghaiBlock = &v
+			if err := m.ShanghaiBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PetersburgBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancunBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2927,14 +4679,33 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.PetersburgBlock = &v
-			if err := m.PetersburgBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CancunBlock = &v
+			if err := m.CancunBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IstanbulBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2962,15 +4733,80 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.IstanbulBlock = &v
-			if err := m.IstanbulBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 12:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *State) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MuirGlacierBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2998,15 +4834,11 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.MuirGlacierBlock = &v
-			if err := m.MuirGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BerlinBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3031,18 +4863,64 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BerlinBlock = &v
-			if err := m.BerlinBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 17:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionLogs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionLogs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LondonBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3070,17 +4948,13 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.LondonBlock = &v
-			if err := m.LondonBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArrowGlacierBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3090,67 +4964,79 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.ArrowGlacierBlock = &v
-			if err := m.ArrowGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GrayGlacierBlock", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Log) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.GrayGlacierBlock = &v
-			if err := m.GrayGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 21:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Log: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeNetsplitBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3178,15 +5064,11 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.MergeNetsplitBlock = &v
-			if err := m.MergeNetsplitBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShanghaiBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3214,17 +5096,13 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.ShanghaiBlock = &v
-			if err := m.ShanghaiBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 23:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancunBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3234,33 +5112,31 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.CancunBlock = &v
-			if err := m.CancunBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 24:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			m.ChainId = 0
+			m.BlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3270,14 +5146,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				m.BlockNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 25:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3305,13 +5181,13 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
 			}
-			m.Decimals = 0
+			m.TxIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3321,64 +5197,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= uint64(b&0x7F) << shift
+				m.TxIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *State) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvm
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: State: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3406,13 +5232,32 @@ func (m *State) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3422,24 +5267,12 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Removed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -3461,7 +5294,7 @@ func (m *State) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
+func (m *Receipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3484,15 +5317,15 @@ func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionLogs: wiretype end group for non-group")
+			return fmt.Errorf("proto: Receipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionLogs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Receipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3520,13 +5353,32 @@ func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3536,79 +5388,59 @@ func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvm
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Log) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvm
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Log: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3636,11 +5468,49 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3668,13 +5538,13 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3684,27 +5554,27 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
@@ -3723,9 +5593,9 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3753,13 +5623,13 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeGasUsed", wireType)
 			}
-			m.TxIndex = 0
+			m.CumulativeGasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3769,16 +5639,16 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxIndex |= uint64(b&0x7F) << shift
+				m.CumulativeGasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogsBloom", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3788,29 +5658,31 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			m.LogsBloom = append(m.LogsBloom[:0], dAtA[iNdEx:postIndex]...)
+			if m.LogsBloom == nil {
+				m.LogsBloom = []byte{}
+			}
 			iNdEx = postIndex
-		case 8:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
 			}
-			m.Index = 0
+			m.TxType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3820,16 +5692,16 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.TxType |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveGasPrice", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3839,12 +5711,28 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Removed = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.EffectiveGasPrice = &v
+			if err := m.EffectiveGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
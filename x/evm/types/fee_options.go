@@ -0,0 +1,94 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	// FeeOptionsDomainName is the name of the EIP-712 domain of the fee options
+	FeeOptionsDomainName = "Evmos Fee Options"
+	// FeeOptionsDomainVersion is the version of the EIP-712 domain of the fee options
+	FeeOptionsDomainVersion = "1"
+)
+
+// FeeOptionsTypedData returns the EIP-712 typed data that the sender signs to
+// pay the fees of the ethereum transaction with the given hash through the
+// feegrant allowance of the given fee granter and in the given fee denom. Both
+// are empty when not used.
+func FeeOptionsTypedData(chainID *big.Int, txHash common.Hash, feeGranter, feeDenom string) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"FeeOptions": {
+				{Name: "txHash", Type: "bytes32"},
+				{Name: "feeGranter", Type: "string"},
+				{Name: "feeDenom", Type: "string"},
+			},
+		},
+		PrimaryType: "FeeOptions",
+		Domain: apitypes.TypedDataDomain{
+			Name:    FeeOptionsDomainName,
+			Version: FeeOptionsDomainVersion,
+			ChainId: (*math.HexOrDecimal256)(chainID),
+		},
+		Message: apitypes.TypedDataMessage{
+			"txHash":     txHash.Hex(),
+			"feeGranter": feeGranter,
+			"feeDenom":   feeDenom,
+		},
+	}
+}
+
+// FeeOptionsHash returns the EIP-712 hash that the sender signs to pay the fees
+// of the ethereum transaction with the given hash through the feegrant
+// allowance of the given fee granter and in the given fee denom.
+func FeeOptionsHash(chainID *big.Int, txHash common.Hash, feeGranter, feeDenom string) (common.Hash, error) {
+	hash, _, err := apitypes.TypedDataAndHash(FeeOptionsTypedData(chainID, txHash, feeGranter, feeDenom))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// VerifyFeeOptions checks that the fee options of the ethereum transaction with
+// the given hash are signed by its sender, so they can't be changed by a third
+// party that rewraps the signed transaction.
+func VerifyFeeOptions(
+	chainID *big.Int,
+	txHash common.Hash,
+	sender common.Address,
+	feeGranter, feeDenom string,
+	signature []byte,
+) error {
+	if len(signature) != crypto.SignatureLength {
+		return errorsmod.Wrapf(ErrInvalidFeeOptions, "invalid signature length %d", len(signature))
+	}
+
+	hash, err := FeeOptionsHash(chainID, txHash, feeGranter, feeDenom)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeOptions, err.Error())
+	}
+
+	signer, err := recoverTypedDataSigner(hash, signature)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidFeeOptions, "failed to recover sender: %s", err)
+	}
+
+	if signer != sender {
+		return errorsmod.Wrapf(ErrInvalidFeeOptions, "signer %s doesn't match the sender %s", signer, sender)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func TestVerifyFeeOptions(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	chainID := big.NewInt(9001)
+	txHash := common.HexToHash("0x01")
+	feeGranter := "evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqkzm3h3"
+	feeDenom := "ibc/usdc"
+
+	sign := func(chainID *big.Int, txHash common.Hash, feeGranter, feeDenom string) []byte {
+		hash, err := types.FeeOptionsHash(chainID, txHash, feeGranter, feeDenom)
+		require.NoError(t, err)
+		sig, err := crypto.Sign(hash.Bytes(), key)
+		require.NoError(t, err)
+		return sig
+	}
+
+	testCases := []struct {
+		name      string
+		signature func() []byte
+		sender    common.Address
+		expPass   bool
+	}{
+		{
+			"pass - signed by the sender",
+			func() []byte { return sign(chainID, txHash, feeGranter, feeDenom) },
+			sender,
+			true,
+		},
+		{
+			"pass - signature with recovery offset",
+			func() []byte {
+				sig := sign(chainID, txHash, feeGranter, feeDenom)
+				sig[crypto.RecoveryIDOffset] += 27
+				return sig
+			},
+			sender,
+			true,
+		},
+		{
+			"fail - no signature",
+			func() []byte { return nil },
+			sender,
+			false,
+		},
+		{
+			"fail - signed by another account",
+			func() []byte { return sign(chainID, txHash, feeGranter, feeDenom) },
+			common.HexToAddress("0x02"),
+			false,
+		},
+		{
+			"fail - signed for another fee granter",
+			func() []byte { return sign(chainID, txHash, "evmos1", feeDenom) },
+			sender,
+			false,
+		},
+		{
+			"fail - signed for another fee denom",
+			func() []byte { return sign(chainID, txHash, feeGranter, "ibc/usdt") },
+			sender,
+			false,
+		},
+		{
+			"fail - signed for another transaction",
+			func() []byte { return sign(chainID, common.HexToHash("0x02"), feeGranter, feeDenom) },
+			sender,
+			false,
+		},
+		{
+			"fail - signed for another chain",
+			func() []byte { return sign(big.NewInt(1), txHash, feeGranter, feeDenom) },
+			sender,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			signature := tc.signature()
+			original := common.CopyBytes(signature)
+
+			err := types.VerifyFeeOptions(chainID, txHash, tc.sender, feeGranter, feeDenom, signature)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidFeeOptions)
+			}
+			// the signature of the transaction must not be modified
+			require.Equal(t, original, signature)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConvertToFeeToken converts the given amount of the EVM coin, in its 18
+// decimals representation, into the fee token with the given price of one unit
+// of the EVM coin in its original decimals. The fees are rounded up and the
+// refunds are rounded down, so that the conversion never favours the payer.
+func ConvertToFeeToken(amount *big.Int, price sdk.DecCoin, roundUp bool) sdk.Coin {
+	converted := ConvertBigIntFrom18DecimalsToLegacyDec(amount).Mul(price.Amount)
+	if roundUp {
+		return sdk.NewCoin(price.Denom, converted.Ceil().TruncateInt())
+	}
+	return sdk.NewCoin(price.Denom, converted.TruncateInt())
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertToFeeToken(t *testing.T) {
+	// one unit of the EVM coin is worth 0.02 of the fee token
+	price := sdk.NewDecCoinFromDec("ibc/usdc", math.LegacyNewDecWithPrec(2, 2))
+
+	testCases := []struct {
+		name        string
+		evmCoinInfo evmtypes.EvmCoinInfo
+		amount      *big.Int
+		roundUp     bool
+		expCoin     sdk.Coin
+	}{
+		{
+			name:        "exact conversion with 18 decimals",
+			evmCoinInfo: evmtypes.EvmCoinInfo{Denom: types.BaseDenom, Decimals: evmtypes.EighteenDecimals},
+			amount:      big.NewInt(1000),
+			roundUp:     true,
+			expCoin:     sdk.NewInt64Coin("ibc/usdc", 20),
+		},
+		{
+			name:        "fees are rounded up",
+			evmCoinInfo: evmtypes.EvmCoinInfo{Denom: types.BaseDenom, Decimals: evmtypes.EighteenDecimals},
+			amount:      big.NewInt(1010),
+			roundUp:     true,
+			expCoin:     sdk.NewInt64Coin("ibc/usdc", 21),
+		},
+		{
+			name:        "refunds are rounded down",
+			evmCoinInfo: evmtypes.EvmCoinInfo{Denom: types.BaseDenom, Decimals: evmtypes.EighteenDecimals},
+			amount:      big.NewInt(1010),
+			roundUp:     false,
+			expCoin:     sdk.NewInt64Coin("ibc/usdc", 20),
+		},
+		{
+			name:        "conversion with 6 decimals",
+			evmCoinInfo: evmtypes.EvmCoinInfo{Denom: types.BaseDenom, Decimals: evmtypes.SixDecimals},
+			amount:      big.NewInt(1000e12),
+			roundUp:     true,
+			expCoin:     sdk.NewInt64Coin("ibc/usdc", 20),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configurator := evmtypes.NewEVMConfigurator()
+			configurator.ResetTestConfig()
+			require.NoError(t, configurator.WithEVMCoinInfo(tc.evmCoinInfo.Denom, uint8(tc.evmCoinInfo.Decimals)).Configure())
+
+			coin := evmtypes.ConvertToFeeToken(tc.amount, price, tc.roundUp)
+			require.Equal(t, tc.expCoin, coin)
+		})
+	}
+}
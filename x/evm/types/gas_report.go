@@ -0,0 +1,40 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGasReport returns the breakdown of the gas consumed by a transaction from
+// the intrinsic gas, the gas consumed by the execution, which includes the one
+// consumed by the precompiled contracts, and the refunded gas.
+func NewGasReport(intrinsicGas, executedGas, precompileGas, refundedGas uint64) *GasReport {
+	// NOTE: the precompiles can't consume more gas than the whole execution,
+	// check it anyway to avoid underflows
+	if precompileGas > executedGas {
+		precompileGas = executedGas
+	}
+
+	return &GasReport{
+		IntrinsicGas:  intrinsicGas,
+		ExecutionGas:  executedGas - precompileGas,
+		PrecompileGas: precompileGas,
+		RefundedGas:   refundedGas,
+	}
+}
+
+// NewGasReportEvent returns the event that reports the gas breakdown of the
+// ethereum transaction with the given hash.
+func NewGasReportEvent(txHash string, report *GasReport) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeGasReport,
+		sdk.NewAttribute(AttributeKeyEthereumTxHash, txHash),
+		sdk.NewAttribute(AttributeKeyIntrinsicGas, strconv.FormatUint(report.IntrinsicGas, 10)),
+		sdk.NewAttribute(AttributeKeyExecutionGas, strconv.FormatUint(report.ExecutionGas, 10)),
+		sdk.NewAttribute(AttributeKeyPrecompileGas, strconv.FormatUint(report.PrecompileGas, 10)),
+		sdk.NewAttribute(AttributeKeyRefundedGas, strconv.FormatUint(report.RefundedGas, 10)),
+	)
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGasReport(t *testing.T) {
+	report := NewGasReport(21000, 5000, 2000, 500)
+	require.Equal(t, &GasReport{IntrinsicGas: 21000, ExecutionGas: 3000, PrecompileGas: 2000, RefundedGas: 500}, report)
+
+	// the precompile gas is capped to the executed gas
+	report = NewGasReport(21000, 1000, 2000, 0)
+	require.Equal(t, &GasReport{IntrinsicGas: 21000, ExecutionGas: 0, PrecompileGas: 1000}, report)
+}
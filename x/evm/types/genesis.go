@@ -4,6 +4,9 @@ package types
 
 import (
 	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/evmos/evmos/v20/types"
 )
@@ -16,12 +19,13 @@ func (ga GenesisAccount) Validate() error {
 	return ga.Storage.Validate()
 }
 
-// DefaultGenesisState sets default evm genesis state with empty accounts and default params and
-// chain config values.
+// DefaultGenesisState sets default evm genesis state with empty accounts, default params and
+// chain config values and the default preinstalls.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Accounts: []GenesisAccount{},
-		Params:   DefaultParams(),
+		Accounts:    []GenesisAccount{},
+		Params:      DefaultParams(),
+		Preinstalls: DefaultPreinstalls,
 	}
 }
 
@@ -47,5 +51,17 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	if err := ValidatePreinstalls(gs.Preinstalls); err != nil {
+		return err
+	}
+
+	for _, preinstall := range gs.Preinstalls {
+		if slices.ContainsFunc(gs.Accounts, func(acc GenesisAccount) bool {
+			return common.HexToAddress(acc.Address) == common.HexToAddress(preinstall.Address)
+		}) {
+			return fmt.Errorf("preinstall %s conflicts with the genesis account %s", preinstall.Name, preinstall.Address)
+		}
+	}
+
 	return gs.Params.Validate()
 }
@@ -30,6 +30,9 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// preinstalls is an array of well-known contracts to be installed at their
+	// canonical addresses with predefined code.
+	Preinstalls []Preinstall `protobuf:"bytes,3,rep,name=preinstalls,proto3" json:"preinstalls"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +82,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPreinstalls() []Preinstall {
+	if m != nil {
+		return m.Preinstalls
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -145,36 +155,104 @@ func (m *GenesisAccount) GetStorage() Storage {
 	return nil
 }
 
+// Preinstall defines a contract that is installed at a predefined address with
+// predefined code, without a deployment transaction.
+type Preinstall struct {
+	// name defines the name of the contract
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address defines the ethereum hex formated address of the contract
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// code defines the hex bytes of the contract runtime code
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *Preinstall) Reset()         { *m = Preinstall{} }
+func (m *Preinstall) String() string { return proto.CompactTextString(m) }
+func (*Preinstall) ProtoMessage()    {}
+func (*Preinstall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bcdec50cc9d156d, []int{2}
+}
+func (m *Preinstall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Preinstall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Preinstall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Preinstall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Preinstall.Merge(m, src)
+}
+func (m *Preinstall) XXX_Size() int {
+	return m.Size()
+}
+func (m *Preinstall) XXX_DiscardUnknown() {
+	xxx_messageInfo_Preinstall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Preinstall proto.InternalMessageInfo
+
+func (m *Preinstall) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Preinstall) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Preinstall) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ethermint.evm.v1.GenesisState")
 	proto.RegisterType((*GenesisAccount)(nil), "ethermint.evm.v1.GenesisAccount")
+	proto.RegisterType((*Preinstall)(nil), "ethermint.evm.v1.Preinstall")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x2d, 0xcb, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xcb, 0xeb, 0xa5,
-	0x96, 0xe5, 0xea, 0x95, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88,
-	0x22, 0x29, 0x29, 0x0c, 0x43, 0x40, 0x6a, 0x21, 0x72, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6,
-	0x3e, 0x88, 0x05, 0x11, 0x55, 0x9a, 0xc2, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x28, 0xb8, 0x24, 0xb1,
-	0x24, 0x55, 0xc8, 0x9d, 0x8b, 0x23, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0xa4, 0x58, 0x82, 0x51,
-	0x81, 0x59, 0x83, 0xdb, 0x48, 0x41, 0x0f, 0xdd, 0x6a, 0x3d, 0xa8, 0x0e, 0x47, 0x88, 0x42, 0x27,
-	0xce, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd7, 0x2c, 0x64, 0xcd,
-	0xc5, 0x56, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0x81,
-	0x69, 0x4c, 0x00, 0x58, 0x1e, 0x59, 0x3b, 0x54, 0x8b, 0x52, 0x3b, 0x23, 0x17, 0x1f, 0xaa, 0x25,
-	0x42, 0x12, 0x5c, 0xec, 0x89, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0x20, 0x77, 0x31, 0x6a, 0x70, 0x06,
-	0xc1, 0xb8, 0x42, 0x42, 0x5c, 0x2c, 0xc9, 0xf9, 0x29, 0xa9, 0x60, 0x7b, 0x38, 0x83, 0xc0, 0x6c,
-	0x21, 0x77, 0x2e, 0xf6, 0xe2, 0x92, 0xfc, 0xa2, 0xc4, 0xf4, 0x54, 0x09, 0x66, 0xb0, 0x2f, 0xc4,
-	0x31, 0xad, 0x07, 0x7b, 0xd8, 0x49, 0x04, 0x64, 0xfb, 0xaa, 0xfb, 0xf2, 0xec, 0xc1, 0x10, 0xf5,
-	0x10, 0x87, 0xc0, 0x74, 0x3b, 0x39, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83,
-	0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43,
-	0x94, 0x5a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x28, 0x98, 0xf3, 0x8b,
-	0xa1, 0x64, 0x99, 0x91, 0x81, 0x7e, 0x05, 0x38, 0xfc, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
-	0xc0, 0x21, 0x6d, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xca, 0x72, 0xe8, 0xe2, 0x01, 0x00,
-	0x00,
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xb1, 0x6e, 0xea, 0x30,
+	0x18, 0x85, 0x63, 0xb8, 0x82, 0x8b, 0xb9, 0xba, 0xba, 0xd7, 0x42, 0x6a, 0x84, 0x2a, 0x83, 0x18,
+	0x2a, 0xd4, 0x21, 0x2e, 0x74, 0xec, 0xd2, 0xb2, 0xa0, 0x2e, 0x55, 0x05, 0x5b, 0x37, 0x13, 0xac,
+	0x10, 0x09, 0xc7, 0x51, 0x6c, 0xa2, 0xf6, 0x09, 0xba, 0xf6, 0x31, 0xaa, 0x4e, 0x7d, 0x0c, 0x46,
+	0xc6, 0x4e, 0xa5, 0x82, 0xa1, 0xaf, 0x51, 0xd9, 0x0e, 0x90, 0x16, 0x16, 0xeb, 0x24, 0xff, 0x39,
+	0xe7, 0xff, 0x12, 0x19, 0x62, 0xa6, 0x26, 0x2c, 0xe1, 0x61, 0xa4, 0x08, 0x4b, 0x39, 0x49, 0x3b,
+	0x24, 0x60, 0x11, 0x93, 0xa1, 0xf4, 0xe2, 0x44, 0x28, 0x81, 0xfe, 0x6d, 0xe7, 0x1e, 0x4b, 0xb9,
+	0x97, 0x76, 0xea, 0xff, 0x29, 0x0f, 0x23, 0x41, 0xcc, 0x69, 0x4d, 0xf5, 0xfa, 0x5e, 0x89, 0xf6,
+	0xda, 0x59, 0x2d, 0x10, 0x81, 0x30, 0x92, 0x68, 0x65, 0xdf, 0xb6, 0x96, 0x00, 0xfe, 0xe9, 0xdb,
+	0x45, 0x43, 0x45, 0x15, 0x43, 0x7d, 0xf8, 0x9b, 0xfa, 0xbe, 0x98, 0x45, 0x4a, 0xba, 0xa0, 0x59,
+	0x6c, 0x57, 0xbb, 0x4d, 0xef, 0xe7, 0x6a, 0x2f, 0x4b, 0x5c, 0x59, 0x63, 0xaf, 0x32, 0x7f, 0x6f,
+	0x38, 0xcf, 0x9f, 0xaf, 0xa7, 0x60, 0xb0, 0x0d, 0xa3, 0x0b, 0x58, 0x8a, 0x69, 0x42, 0xb9, 0x74,
+	0x0b, 0x4d, 0xd0, 0xae, 0x76, 0xdd, 0xfd, 0x9a, 0x5b, 0x33, 0xcf, 0xc7, 0xb3, 0x08, 0xba, 0x86,
+	0xd5, 0x38, 0x61, 0x61, 0x24, 0x15, 0x9d, 0x4e, 0xa5, 0x5b, 0x34, 0x20, 0xc7, 0x07, 0x1a, 0xb6,
+	0xa6, 0x7c, 0x4b, 0x3e, 0xdb, 0x7a, 0x04, 0xf0, 0xef, 0x77, 0x5e, 0xe4, 0xc2, 0x32, 0x1d, 0x8f,
+	0x13, 0x26, 0xf5, 0x27, 0x82, 0x76, 0x65, 0xb0, 0x79, 0x44, 0x08, 0xfe, 0xf2, 0xc5, 0x98, 0x19,
+	0xe4, 0xca, 0xc0, 0x68, 0xd4, 0x87, 0x65, 0xa9, 0x44, 0x42, 0x03, 0x96, 0x71, 0x1c, 0xed, 0x73,
+	0x98, 0x7f, 0xd7, 0xab, 0x69, 0x84, 0x97, 0x65, 0xa3, 0x3c, 0xb4, 0x7e, 0x4b, 0xb3, 0x49, 0xb7,
+	0x6e, 0x20, 0xdc, 0xf1, 0xea, 0x55, 0x11, 0xe5, 0x2c, 0x23, 0x30, 0x3a, 0x0f, 0x56, 0x38, 0x0c,
+	0x56, 0xdc, 0x81, 0xf5, 0x2e, 0xe7, 0x2b, 0x0c, 0x16, 0x2b, 0x0c, 0x3e, 0x56, 0x18, 0x3c, 0xad,
+	0xb1, 0xb3, 0x58, 0x63, 0xe7, 0x6d, 0x8d, 0x9d, 0xbb, 0x93, 0x20, 0x54, 0x93, 0xd9, 0xc8, 0xf3,
+	0x05, 0xd7, 0x37, 0x40, 0xc8, 0xec, 0x4c, 0xbb, 0x67, 0xe4, 0x5e, 0x6b, 0xa2, 0x1e, 0x62, 0x26,
+	0x47, 0x25, 0x73, 0x09, 0xce, 0xbf, 0x06, 0x00, 0xdf, 0x05, 0x43, 0xdd, 0x7d, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -197,6 +275,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Preinstalls) > 0 {
+		for iNdEx := len(m.Preinstalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Preinstalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -275,6 +367,50 @@ func (m *GenesisAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Preinstall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Preinstall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Preinstall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -300,6 +436,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Preinstalls) > 0 {
+		for _, e := range m.Preinstalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -326,6 +468,27 @@ func (m *GenesisAccount) Size() (n int) {
 	return n
 }
 
+func (m *Preinstall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -428,6 +591,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preinstalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preinstalls = append(m.Preinstalls, Preinstall{})
+			if err := m.Preinstalls[len(m.Preinstalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -597,6 +794,152 @@ func (m *GenesisAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Preinstall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Preinstall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Preinstall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,122 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// gethDump is the collected format of a go-ethereum state dump, as output by
+// 'geth dump'. The accounts are keyed by address, or by the hash of the
+// address when its preimage is unknown.
+type gethDump struct {
+	Root     string                       `json:"root"`
+	Accounts map[string]state.DumpAccount `json:"accounts"`
+}
+
+// ParseGethStateDump parses the accounts of a go-ethereum state dump, either
+// in the collected format of 'geth dump' or in the line-by-line format of
+// 'geth dump --iterative'. The accounts are returned sorted by address, with
+// their Address field set.
+func ParseGethStateDump(r io.Reader) ([]state.DumpAccount, error) {
+	dec := json.NewDecoder(r)
+
+	// both formats start with an object holding the state root, which also
+	// holds the accounts in the collected format
+	var dump gethDump
+	if err := dec.Decode(&dump); err != nil {
+		return nil, fmt.Errorf("failed to decode the state dump root: %w", err)
+	}
+
+	accounts := make([]state.DumpAccount, 0, len(dump.Accounts))
+	for key, account := range dump.Accounts {
+		if common.IsHexAddress(key) {
+			addr := common.HexToAddress(key)
+			account.Address = &addr
+		}
+		accounts = append(accounts, account)
+	}
+
+	for {
+		var account state.DumpAccount
+		err := dec.Decode(&account)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the state dump account %d: %w", len(accounts), err)
+		}
+		accounts = append(accounts, account)
+	}
+
+	seen := make(map[common.Address]bool, len(accounts))
+	for _, account := range accounts {
+		if account.Address == nil {
+			return nil, fmt.Errorf("the address preimage of the account with key %s is missing from the dump", account.SecureKey)
+		}
+		if seen[*account.Address] {
+			return nil, fmt.Errorf("duplicated account %s in the dump", account.Address)
+		}
+		seen[*account.Address] = true
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		return bytes.Compare(accounts[i].Address[:], accounts[j].Address[:]) < 0
+	})
+
+	return accounts, nil
+}
+
+// ParseDumpAccountBalance returns the balance in wei of the account of a
+// go-ethereum state dump.
+func ParseDumpAccountBalance(account state.DumpAccount) (*big.Int, error) {
+	balance, ok := new(big.Int).SetString(account.Balance, 10)
+	if !ok || balance.Sign() < 0 {
+		return nil, fmt.Errorf("invalid balance %q of account %s", account.Balance, account.Address)
+	}
+	return balance, nil
+}
+
+// GenesisAccountFromDump returns the EVM genesis account holding the code and
+// storage of the account of a go-ethereum state dump. The code is checked
+// against the code hash of the account when both are present.
+func GenesisAccountFromDump(account state.DumpAccount) (GenesisAccount, error) {
+	if account.Address == nil {
+		return GenesisAccount{}, errors.New("missing address of the dump account")
+	}
+
+	if len(account.Code) > 0 && len(account.CodeHash) > 0 &&
+		!bytes.Equal(crypto.Keccak256(account.Code), account.CodeHash) {
+		return GenesisAccount{}, fmt.Errorf("the code of account %s doesn't match its code hash %s", account.Address, account.CodeHash)
+	}
+
+	storage := make(Storage, 0, len(account.Storage))
+	for key, value := range account.Storage {
+		// the values of the dump are hex encoded without leading zeros
+		storage = append(storage, NewState(key, common.HexToHash(value)))
+	}
+	sort.Slice(storage, func(i, j int) bool {
+		return storage[i].Key < storage[j].Key
+	})
+
+	genAccount := GenesisAccount{
+		Address: account.Address.Hex(),
+		Code:    common.Bytes2Hex(account.Code),
+		Storage: storage,
+	}
+	if err := genAccount.Validate(); err != nil {
+		return GenesisAccount{}, fmt.Errorf("invalid dump account %s: %w", account.Address, err)
+	}
+
+	return genAccount, nil
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func (suite *GenesisTestSuite) TestParseGethStateDump() {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	eoa := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	code := []byte{1, 2, 3}
+	codeHash := crypto.Keccak256Hash(code)
+	emptyCodeHash := crypto.Keccak256Hash(nil)
+
+	collected := `{
+  "root": "0000000000000000000000000000000000000000000000000000000000000001",
+  "accounts": {
+    "` + eoa.Hex() + `": {"balance": "10", "nonce": 2, "root": "0x", "codeHash": "` + emptyCodeHash.Hex() + `"},
+    "` + contract.Hex() + `": {"balance": "0", "nonce": 1, "root": "0x", "codeHash": "` + codeHash.Hex() + `", "code": "0x010203", "storage": {"0x0000000000000000000000000000000000000000000000000000000000000001": "02"}}
+  }
+}`
+
+	iterative := `{"root": "0000000000000000000000000000000000000000000000000000000000000001"}
+{"balance": "10", "nonce": 2, "root": "0x", "codeHash": "` + emptyCodeHash.Hex() + `", "address": "` + eoa.Hex() + `"}
+{"balance": "0", "nonce": 1, "root": "0x", "codeHash": "` + codeHash.Hex() + `", "code": "0x010203", "storage": {"0x0000000000000000000000000000000000000000000000000000000000000001": "02"}, "address": "` + contract.Hex() + `"}
+`
+
+	testCases := []struct {
+		name    string
+		dump    string
+		expPass bool
+	}{
+		{"pass - collected dump", collected, true},
+		{"pass - iterative dump", iterative, true},
+		{
+			"fail - missing address preimage",
+			`{"root": "00"}
+{"balance": "10", "nonce": 2, "root": "0x", "codeHash": "0x", "key": "0x01"}`,
+			false,
+		},
+		{
+			"fail - duplicated account",
+			`{"root": "00"}
+{"balance": "10", "nonce": 2, "root": "0x", "codeHash": "0x", "address": "` + eoa.Hex() + `"}
+{"balance": "10", "nonce": 2, "root": "0x", "codeHash": "0x", "address": "` + eoa.Hex() + `"}`,
+			false,
+		},
+		{"fail - invalid json", `{"root": `, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			accounts, err := ParseGethStateDump(strings.NewReader(tc.dump))
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(accounts, 2)
+
+			// the accounts are sorted by address
+			suite.Require().Equal(contract, *accounts[0].Address)
+			suite.Require().Equal(eoa, *accounts[1].Address)
+			suite.Require().Equal(uint64(2), accounts[1].Nonce)
+
+			balance, err := ParseDumpAccountBalance(accounts[1])
+			suite.Require().NoError(err)
+			suite.Require().Equal(int64(10), balance.Int64())
+
+			genAccount, err := GenesisAccountFromDump(accounts[0])
+			suite.Require().NoError(err)
+			suite.Require().Equal(contract.Hex(), genAccount.Address)
+			suite.Require().Equal(common.Bytes2Hex(code), genAccount.Code)
+			suite.Require().Equal(Storage{
+				NewState(common.BigToHash(common.Big1), common.BigToHash(common.Big2)),
+			}, genAccount.Storage)
+		})
+	}
+}
+
+func (suite *GenesisTestSuite) TestGenesisAccountFromDumpCodeHashMismatch() {
+	accounts, err := ParseGethStateDump(strings.NewReader(`{"root": "00"}
+{"balance": "0", "nonce": 1, "root": "0x", "codeHash": "` + common.Hash{}.Hex() + `", "code": "0x010203", "address": "` + suite.address + `"}`))
+	suite.Require().NoError(err)
+
+	_, err = GenesisAccountFromDump(accounts[0])
+	suite.Require().ErrorContains(err, "doesn't match its code hash")
+}
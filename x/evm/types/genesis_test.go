@@ -114,6 +114,28 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			genState: NewGenesisState(DefaultGenesisState().Params, DefaultGenesisState().Accounts),
 			expPass:  true,
 		},
+		{
+			name: "invalid preinstall",
+			genState: &GenesisState{
+				Params:      DefaultParams(),
+				Preinstalls: []Preinstall{{Name: "test", Address: suite.address}},
+			},
+			expPass: false,
+		},
+		{
+			name: "preinstall conflicts with genesis account",
+			genState: &GenesisState{
+				Accounts: []GenesisAccount{
+					{
+						Address: suite.address,
+						Code:    suite.code,
+					},
+				},
+				Params:      DefaultParams(),
+				Preinstalls: []Preinstall{{Name: "test", Address: suite.address, Code: suite.code}},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid genesis account",
 			genState: &GenesisState{
@@ -3,6 +3,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -29,6 +30,8 @@ const (
 	prefixStorage
 	prefixParams
 	prefixCodeHash
+	prefixReceipt
+	prefixReceiptHeight
 )
 
 // prefix bytes for the EVM transient store
@@ -37,22 +40,30 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeePayer
+	prefixTransientFeeToken
+	prefixTransientBlockGasUsed
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixCode     = []byte{prefixCode}
-	KeyPrefixStorage  = []byte{prefixStorage}
-	KeyPrefixParams   = []byte{prefixParams}
-	KeyPrefixCodeHash = []byte{prefixCodeHash}
+	KeyPrefixCode          = []byte{prefixCode}
+	KeyPrefixStorage       = []byte{prefixStorage}
+	KeyPrefixParams        = []byte{prefixParams}
+	KeyPrefixCodeHash      = []byte{prefixCodeHash}
+	KeyPrefixReceipt       = []byte{prefixReceipt}
+	KeyPrefixReceiptHeight = []byte{prefixReceiptHeight}
 )
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom        = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex      = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize      = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed      = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientFeePayer     = []byte{prefixTransientFeePayer}
+	KeyPrefixTransientFeeToken     = []byte{prefixTransientFeeToken}
+	KeyPrefixTransientBlockGasUsed = []byte{prefixTransientBlockGasUsed}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -64,3 +75,9 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// ReceiptHeightKey defines the key under which the hash of an ethereum tx is
+// indexed by the height of the block that includes its receipt.
+func ReceiptHeightKey(height uint64, txHash common.Hash) []byte {
+	return append(sdk.Uint64ToBigEndian(height), txHash.Bytes()...)
+}
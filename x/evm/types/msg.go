@@ -24,10 +24,12 @@ import (
 
 	evmapi "github.com/evmos/evmos/v20/api/ethermint/evm/v1"
 	"github.com/evmos/evmos/v20/types"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 var (
@@ -35,6 +37,9 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgRegisterPrecompile{}
+	_ sdk.Msg    = &MsgUpdateDeployers{}
+	_ sdk.Msg    = &MsgUpdateBlockedContracts{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -129,7 +134,7 @@ func newMsgEthereumTx(
 	}
 
 	msg := MsgEthereumTx{Data: dataAny}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
+	msg.Hash = TxHash(txData).Hex()
 	return &msg
 }
 
@@ -150,6 +155,23 @@ func (msg *MsgEthereumTx) FromEthereumTx(tx *ethtypes.Transaction) error {
 	return nil
 }
 
+// FromSetCodeTx populates the message fields from the given EIP-7702 set code transaction
+func (msg *MsgEthereumTx) FromSetCodeTx(tx *ethutils.SetCodeTx) error {
+	txData, err := NewSetCodeTx(tx)
+	if err != nil {
+		return err
+	}
+
+	anyTxData, err := PackTxData(txData)
+	if err != nil {
+		return err
+	}
+
+	msg.Data = anyTxData
+	msg.Hash = tx.Hash().Hex()
+	return nil
+}
+
 // Route returns the route value of an MsgEthereumTx.
 func (msg MsgEthereumTx) Route() string { return RouterKey }
 
@@ -192,7 +214,7 @@ func (msg MsgEthereumTx) ValidateBasic() error {
 	}
 
 	// Validate Hash field after validated txData to avoid panic
-	txHash := msg.AsTransaction().Hash().Hex()
+	txHash := TxHash(txData).Hex()
 	if msg.Hash != txHash {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid tx hash %s, expected: %s", msg.Hash, txHash)
 	}
@@ -231,6 +253,14 @@ func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.
 		return fmt.Errorf("sender address not defined for message")
 	}
 
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return err
+	}
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		return msg.signSetCodeTx(setCodeTx, keyringSigner)
+	}
+
 	tx := msg.AsTransaction()
 	txHash := ethSigner.Hash(tx)
 
@@ -247,6 +277,23 @@ func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.
 	return msg.FromEthereumTx(tx)
 }
 
+// signSetCodeTx signs the EIP-7702 signing hash of the set code transaction.
+func (msg *MsgEthereumTx) signSetCodeTx(txData *SetCodeTx, keyringSigner keyring.Signer) error {
+	tx := txData.AsSetCodeTx()
+	sig, _, err := keyringSigner.SignByAddress(msg.GetFrom(), tx.SigHash().Bytes(), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+	if err != nil {
+		return err
+	}
+	if len(sig) != crypto.SignatureLength {
+		return fmt.Errorf("wrong size for signature: got %d, want %d", len(sig), crypto.SignatureLength)
+	}
+
+	tx.R = new(big.Int).SetBytes(sig[:32])
+	tx.S = new(big.Int).SetBytes(sig[32:64])
+	tx.V = new(big.Int).SetBytes(sig[64:])
+	return msg.FromSetCodeTx(tx)
+}
+
 // GetGas implements the GasTx interface. It returns the GasLimit of the transaction.
 func (msg MsgEthereumTx) GetGas() uint64 {
 	txData, err := UnpackTxData(msg.Data)
@@ -284,7 +331,10 @@ func (msg *MsgEthereumTx) GetFrom() sdk.AccAddress {
 	return common.HexToAddress(msg.From).Bytes()
 }
 
-// AsTransaction creates an Ethereum Transaction type from the msg fields
+// AsTransaction creates an Ethereum Transaction type from the msg fields.
+//
+// NOTE: go-ethereum doesn't support EIP-7702, the transaction of a set code tx
+// is its DynamicFeeTx execution view, which has a different hash and sender.
 func (msg MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
 	txData, err := UnpackTxData(msg.Data)
 	if err != nil {
@@ -294,15 +344,43 @@ func (msg MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
 	return ethtypes.NewTx(txData.AsEthereumData())
 }
 
+// TxHash returns the Ethereum hash of the transaction of the msg, falling back
+// to the hash field if the transaction data can't be unpacked.
+func (msg MsgEthereumTx) TxHash() common.Hash {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return common.HexToHash(msg.Hash)
+	}
+
+	return TxHash(txData)
+}
+
 // AsMessage creates an Ethereum core.Message from the msg fields
 func (msg MsgEthereumTx) AsMessage(signer ethtypes.Signer, baseFee *big.Int) (core.Message, error) {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		return setCodeTx.AsMessage(baseFee)
+	}
 	return msg.AsTransaction().AsMessage(signer, baseFee)
 }
 
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func (msg *MsgEthereumTx) GetSender(chainID *big.Int) (common.Address, error) {
-	signer := ethtypes.LatestSignerForChainID(chainID)
-	from, err := signer.Sender(msg.AsTransaction())
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	var from common.Address
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		from, err = setCodeTx.Sender(chainID)
+	} else {
+		signer := ethtypes.LatestSignerForChainID(chainID)
+		from, err = signer.Sender(msg.AsTransaction())
+	}
 	if err != nil {
 		return common.Address{}, err
 	}
@@ -318,8 +396,16 @@ func (msg MsgEthereumTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 
 // UnmarshalBinary decodes the canonical encoding of transactions.
 func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
-	tx := &ethtypes.Transaction{}
-	if err := tx.UnmarshalBinary(b); err != nil {
+	if ethutils.IsSetCodeTx(b) {
+		tx, err := ethutils.DecodeSetCodeTx(b)
+		if err != nil {
+			return err
+		}
+		return msg.FromSetCodeTx(tx)
+	}
+
+	tx, err := UnmarshalEthereumTx(b)
+	if err != nil {
 		return err
 	}
 	return msg.FromEthereumTx(tx)
@@ -376,3 +462,83 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterPrecompile) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return types.ValidateAddress(m.Address)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterPrecompile) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateDeployers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.Add) == 0 && len(m.Remove) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "no deployers to add or remove")
+	}
+
+	seen := make(map[common.Address]struct{}, len(m.Add)+len(m.Remove))
+	for _, addresses := range [][]string{m.Add, m.Remove} {
+		for _, address := range addresses {
+			if err := types.ValidateAddress(address); err != nil {
+				return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid deployer address %s", address)
+			}
+
+			addr := common.HexToAddress(address)
+			if _, found := seen[addr]; found {
+				return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicate deployer address %s", address)
+			}
+			seen[addr] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateDeployers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check for the provided data
+func (m *MsgUpdateBlockedContracts) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.Block) == 0 && len(m.Unblock) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "no contracts to block or unblock")
+	}
+
+	seen := make(map[common.Address]struct{}, len(m.Block)+len(m.Unblock))
+	for _, contracts := range [][]string{m.Block, m.Unblock} {
+		for _, contract := range contracts {
+			if err := types.ValidateAddress(contract); err != nil {
+				return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract address %s", contract)
+			}
+
+			addr := common.HexToAddress(contract)
+			if _, found := seen[addr]; found {
+				return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicate contract address %s", contract)
+			}
+			seen[addr] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateBlockedContracts) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -8,6 +8,8 @@ import (
 	"slices"
 
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 
@@ -17,6 +19,14 @@ import (
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 )
 
+// maxBech32HRPLength is the maximum length of a bech32 human readable prefix as
+// defined in BIP-173.
+const maxBech32HRPLength = 83
+
+// MaxReceiptRetentionBlocks is the maximum number of blocks the receipts of the
+// ethereum txs can be kept in the state.
+const MaxReceiptRetentionBlocks = 1_000_000
+
 var (
 	// DefaultAllowUnprotectedTxs rejects all unprotected txs (i.e false)
 	DefaultAllowUnprotectedTxs = false
@@ -24,12 +34,19 @@ var (
 	DefaultStaticPrecompiles = []string{
 		P256PrecompileAddress,         // P256 precompile
 		Bech32PrecompileAddress,       // Bech32 precompile
+		SchnorrPrecompileAddress,      // Schnorr precompile
+		MulticallPrecompileAddress,    // Multicall precompile
+		P256BatchPrecompileAddress,    // P256 batch verification precompile
 		StakingPrecompileAddress,      // Staking precompile
 		DistributionPrecompileAddress, // Distribution precompile
 		ICS20PrecompileAddress,        // ICS20 transfer precompile
 		VestingPrecompileAddress,      // Vesting precompile
 		BankPrecompileAddress,         // Bank precompile
 		GovPrecompileAddress,          // Gov precompile
+		SlashingPrecompileAddress,     // Slashing precompile
+		EvidencePrecompileAddress,     // Evidence precompile
+		FeegrantPrecompileAddress,     // Feegrant precompile
+		AuthzPrecompileAddress,        // Authz precompile
 	}
 	// DefaultExtraEIPs defines the default extra EIPs to be included
 	// On v15, EIP 3855 was enabled
@@ -105,6 +122,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateScheduledEIPs(p.ExtraEIPs, p.ScheduledEIPs); err != nil {
+		return err
+	}
+
 	if err := validateBool(p.AllowUnprotectedTxs); err != nil {
 		return err
 	}
@@ -117,6 +138,39 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if p.PrecompileDynamicGasHeight < 0 {
+		return fmt.Errorf("precompile dynamic gas height cannot be negative: %d", p.PrecompileDynamicGasHeight)
+	}
+
+	if p.ReceiptRetentionBlocks > MaxReceiptRetentionBlocks {
+		return fmt.Errorf(
+			"receipt retention blocks cannot be greater than %d: %d",
+			MaxReceiptRetentionBlocks, p.ReceiptRetentionBlocks,
+		)
+	}
+
+	if err := validateBech32HRPs(p.Bech32HRPs); err != nil {
+		return err
+	}
+
+	if err := validateBlockedContracts(p.BlockedContracts); err != nil {
+		return err
+	}
+
+	if p.SecurityCouncil != "" {
+		if _, err := sdk.AccAddressFromBech32(p.SecurityCouncil); err != nil {
+			return fmt.Errorf("invalid security council address %s: %w", p.SecurityCouncil, err)
+		}
+	}
+
+	if err := p.OsmosisOutpost.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.StrideOutpost.Validate(); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -127,6 +181,18 @@ func (p Params) EIPs() []string {
 	return eips
 }
 
+// EIPsAt returns the ExtraEIPs together with the ScheduledEIPs that are
+// enabled at the given block height.
+func (p Params) EIPsAt(height int64) []string {
+	eips := p.EIPs()
+	for _, scheduled := range p.ScheduledEIPs {
+		if height >= scheduled.Height {
+			eips = append(eips, scheduled.EIP)
+		}
+	}
+	return eips
+}
+
 // GetActiveStaticPrecompilesAddrs is a util function that the Active Precompiles
 // as a slice of addresses.
 func (p Params) GetActiveStaticPrecompilesAddrs() []common.Address {
@@ -137,12 +203,64 @@ func (p Params) GetActiveStaticPrecompilesAddrs() []common.Address {
 	return precompiles
 }
 
+// IsPrecompileDynamicGasActive returns true if the ERC-20 precompiles charge
+// gas based on the executed store operations at the given block height.
+func (p Params) IsPrecompileDynamicGasActive(height int64) bool {
+	return p.PrecompileDynamicGasHeight > 0 && height >= p.PrecompileDynamicGasHeight
+}
+
 // IsEVMChannel returns true if the channel provided is in the list of
 // EVM channels
 func (p Params) IsEVMChannel(channel string) bool {
 	return slices.Contains(p.EVMChannels, channel)
 }
 
+// IsEnabled returns true if the channel to Osmosis of the Osmosis outpost is
+// set.
+func (o OsmosisOutpost) IsEnabled() bool {
+	return o.ChannelID != ""
+}
+
+// Validate checks that the Osmosis outpost is either disabled or has a valid
+// channel and cross-chain swaps contract.
+func (o OsmosisOutpost) Validate() error {
+	if !o.IsEnabled() {
+		if o.XCSContract != "" {
+			return fmt.Errorf("osmosis outpost XCS contract %s requires a channel", o.XCSContract)
+		}
+		return nil
+	}
+
+	if err := host.ChannelIdentifierValidator(o.ChannelID); err != nil {
+		return errorsmod.Wrap(channeltypes.ErrInvalidChannelIdentifier, err.Error())
+	}
+
+	if _, _, err := bech32.DecodeAndConvert(o.XCSContract); err != nil {
+		return fmt.Errorf("invalid osmosis outpost XCS contract address %s: %w", o.XCSContract, err)
+	}
+
+	return nil
+}
+
+// IsEnabled returns true if the channel to Stride of the Stride outpost is set.
+func (o StrideOutpost) IsEnabled() bool {
+	return o.ChannelID != ""
+}
+
+// Validate checks that the Stride outpost is either disabled or has a valid
+// channel.
+func (o StrideOutpost) Validate() error {
+	if !o.IsEnabled() {
+		return nil
+	}
+
+	if err := host.ChannelIdentifierValidator(o.ChannelID); err != nil {
+		return errorsmod.Wrap(channeltypes.ErrInvalidChannelIdentifier, err.Error())
+	}
+
+	return nil
+}
+
 func (ac AccessControl) Validate() error {
 	if err := ac.Create.Validate(); err != nil {
 		return err
@@ -229,6 +347,65 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+// validateBech32HRPs checks that the registered bech32 human readable prefixes
+// are unique, lowercase and only contain the characters allowed by BIP-173.
+func validateBech32HRPs(hrps []string) error {
+	seenHRPs := make(map[string]struct{})
+	for _, hrp := range hrps {
+		if len(hrp) == 0 || len(hrp) > maxBech32HRPLength {
+			return fmt.Errorf("bech32 HRP %q length must be between 1 and %d", hrp, maxBech32HRPLength)
+		}
+
+		for _, c := range hrp {
+			if c < 33 || c > 126 || (c >= 'A' && c <= 'Z') {
+				return fmt.Errorf("bech32 HRP %q contains an invalid character %q", hrp, c)
+			}
+		}
+
+		if _, ok := seenHRPs[hrp]; ok {
+			return fmt.Errorf("duplicate bech32 HRP %s", hrp)
+		}
+		seenHRPs[hrp] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateBlockedContracts checks that the blocked contracts are unique hex
+// addresses.
+func validateBlockedContracts(contracts []string) error {
+	seenContracts := make(map[common.Address]struct{})
+	for _, contract := range contracts {
+		if err := types.ValidateAddress(contract); err != nil {
+			return fmt.Errorf("invalid blocked contract address %s", contract)
+		}
+
+		addr := common.HexToAddress(contract)
+		if _, ok := seenContracts[addr]; ok {
+			return fmt.Errorf("duplicate blocked contract %s", contract)
+		}
+		seenContracts[addr] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateScheduledEIPs checks that the scheduled EIPs can be activated, have a
+// positive height and are not already enabled by the extra EIPs.
+func validateScheduledEIPs(extraEIPs []string, scheduledEIPs []ScheduledEIP) error {
+	eips := make([]string, len(extraEIPs), len(extraEIPs)+len(scheduledEIPs))
+	copy(eips, extraEIPs)
+
+	for _, scheduled := range scheduledEIPs {
+		if scheduled.Height <= 0 {
+			return fmt.Errorf("EIP %s scheduled height must be positive: %d", scheduled.EIP, scheduled.Height)
+		}
+		eips = append(eips, scheduled.EIP)
+	}
+
+	return validateEIPs(eips)
+}
+
 // ValidatePrecompiles checks if the precompile addresses are valid and unique.
 func ValidatePrecompiles(i interface{}) error {
 	precompiles, ok := i.([]string)
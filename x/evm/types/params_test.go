@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/stretchr/testify/require"
@@ -45,6 +47,160 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "negative precompile dynamic gas height",
+			params: Params{
+				PrecompileDynamicGasHeight: -1,
+			},
+			errContains: "precompile dynamic gas height cannot be negative",
+		},
+		{
+			name: "receipt retention over the maximum",
+			params: Params{
+				ReceiptRetentionBlocks: MaxReceiptRetentionBlocks + 1,
+			},
+			errContains: "receipt retention blocks cannot be greater than",
+		},
+		{
+			name: "valid scheduled eip",
+			params: Params{
+				ExtraEIPs:     []string{"ethereum_3855"},
+				ScheduledEIPs: []ScheduledEIP{{EIP: "ethereum_1884", Height: 100}},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid scheduled eip",
+			params: Params{
+				ScheduledEIPs: []ScheduledEIP{{EIP: "os_1000000", Height: 100}},
+			},
+			errContains: "EIP os_1000000 is not activateable, valid EIPs are",
+		},
+		{
+			name: "scheduled eip already enabled",
+			params: Params{
+				ExtraEIPs:     []string{"ethereum_3855"},
+				ScheduledEIPs: []ScheduledEIP{{EIP: "ethereum_3855", Height: 100}},
+			},
+			errContains: "duplicate EIP: ethereum_3855",
+		},
+		{
+			name: "non-positive scheduled eip height",
+			params: Params{
+				ScheduledEIPs: []ScheduledEIP{{EIP: "ethereum_1884", Height: 0}},
+			},
+			errContains: "EIP ethereum_1884 scheduled height must be positive",
+		},
+		{
+			name: "valid bech32 hrps",
+			params: Params{
+				Bech32HRPs: []string{"cosmos", "osmo", "stride"},
+			},
+			expPass: true,
+		},
+		{
+			name: "empty bech32 hrp",
+			params: Params{
+				Bech32HRPs: []string{""},
+			},
+			errContains: "length must be between 1 and 83",
+		},
+		{
+			name: "uppercase bech32 hrp",
+			params: Params{
+				Bech32HRPs: []string{"Cosmos"},
+			},
+			errContains: "contains an invalid character",
+		},
+		{
+			name: "duplicate bech32 hrp",
+			params: Params{
+				Bech32HRPs: []string{"osmo", "osmo"},
+			},
+			errContains: "duplicate bech32 HRP osmo",
+		},
+		{
+			name: "valid blocked contracts and security council",
+			params: Params{
+				BlockedContracts: []string{"0x1000000000000000000000000000000000000001"},
+				SecurityCouncil:  sdk.AccAddress(common.HexToAddress("0x2000000000000000000000000000000000000002").Bytes()).String(),
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid blocked contract",
+			params: Params{
+				BlockedContracts: []string{"0x1234"},
+			},
+			errContains: "invalid blocked contract address 0x1234",
+		},
+		{
+			name: "duplicate blocked contract",
+			params: Params{
+				BlockedContracts: []string{
+					"0x1000000000000000000000000000000000000001",
+					"0x1000000000000000000000000000000000000001",
+				},
+			},
+			errContains: "duplicate blocked contract",
+		},
+		{
+			name: "invalid security council",
+			params: Params{
+				SecurityCouncil: "0x1000000000000000000000000000000000000001",
+			},
+			errContains: "invalid security council address",
+		},
+		{
+			name: "valid osmosis outpost",
+			params: Params{
+				OsmosisOutpost: OsmosisOutpost{
+					ChannelID:   "channel-0",
+					XCSContract: "osmo1a34wxsxjwvtz3ua4hnkh4lv3d4qrgry0fhkasppplphwu5k538tqcyms9x",
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid osmosis outpost channel",
+			params: Params{
+				OsmosisOutpost: OsmosisOutpost{
+					ChannelID:   "channel",
+					XCSContract: "osmo1a34wxsxjwvtz3ua4hnkh4lv3d4qrgry0fhkasppplphwu5k538tqcyms9x",
+				},
+			},
+			errContains: "invalid channel identifier",
+		},
+		{
+			name: "invalid osmosis outpost XCS contract",
+			params: Params{
+				OsmosisOutpost: OsmosisOutpost{ChannelID: "channel-0", XCSContract: "osmo1invalid"},
+			},
+			errContains: "invalid osmosis outpost XCS contract address",
+		},
+		{
+			name: "osmosis outpost XCS contract without channel",
+			params: Params{
+				OsmosisOutpost: OsmosisOutpost{
+					XCSContract: "osmo1a34wxsxjwvtz3ua4hnkh4lv3d4qrgry0fhkasppplphwu5k538tqcyms9x",
+				},
+			},
+			errContains: "requires a channel",
+		},
+		{
+			name: "valid stride outpost",
+			params: Params{
+				StrideOutpost: StrideOutpost{ChannelID: "channel-25"},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid stride outpost channel",
+			params: Params{
+				StrideOutpost: StrideOutpost{ChannelID: "channel"},
+			},
+			errContains: "invalid channel identifier",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -79,6 +235,29 @@ func TestParamsEIPs(t *testing.T) {
 	require.Equal(t, []string{"ethereum_2929", "ethereum_1884", "ethereum_1344"}, actual)
 }
 
+func TestParamsEIPsAt(t *testing.T) {
+	params := NewParams(false, []string{"ethereum_3855"}, nil, nil, DefaultAccessControl)
+	params.ScheduledEIPs = []ScheduledEIP{
+		{EIP: "ethereum_1884", Height: 10},
+		{EIP: "ethereum_1344", Height: 20},
+	}
+
+	require.Equal(t, []string{"ethereum_3855"}, params.EIPsAt(9))
+	require.Equal(t, []string{"ethereum_3855", "ethereum_1884"}, params.EIPsAt(10))
+	require.Equal(t, []string{"ethereum_3855", "ethereum_1884", "ethereum_1344"}, params.EIPsAt(20))
+	require.Equal(t, []string{"ethereum_3855"}, params.EIPs(), "expected scheduled EIPs to be excluded")
+}
+
+func TestIsPrecompileDynamicGasActive(t *testing.T) {
+	params := DefaultParams()
+	require.False(t, params.IsPrecompileDynamicGasActive(100), "expected dynamic gas to be disabled by default")
+
+	params.PrecompileDynamicGasHeight = 10
+	require.False(t, params.IsPrecompileDynamicGasActive(9))
+	require.True(t, params.IsPrecompileDynamicGasActive(10))
+	require.True(t, params.IsPrecompileDynamicGasActive(11))
+}
+
 func TestParamsValidatePriv(t *testing.T) {
 	require.Error(t, validateBool(""))
 	require.NoError(t, validateBool(true))
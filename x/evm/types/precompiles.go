@@ -4,8 +4,11 @@
 package types
 
 const (
-	P256PrecompileAddress   = "0x0000000000000000000000000000000000000100"
-	Bech32PrecompileAddress = "0x0000000000000000000000000000000000000400"
+	P256PrecompileAddress      = "0x0000000000000000000000000000000000000100"
+	Bech32PrecompileAddress    = "0x0000000000000000000000000000000000000400"
+	SchnorrPrecompileAddress   = "0x0000000000000000000000000000000000000401"
+	MulticallPrecompileAddress = "0x0000000000000000000000000000000000000402"
+	P256BatchPrecompileAddress = "0x0000000000000000000000000000000000000403"
 )
 
 const (
@@ -15,6 +18,15 @@ const (
 	VestingPrecompileAddress      = "0x0000000000000000000000000000000000000803"
 	BankPrecompileAddress         = "0x0000000000000000000000000000000000000804"
 	GovPrecompileAddress          = "0x0000000000000000000000000000000000000805"
+	SlashingPrecompileAddress     = "0x0000000000000000000000000000000000000806"
+	EvidencePrecompileAddress     = "0x0000000000000000000000000000000000000807"
+	FeegrantPrecompileAddress     = "0x0000000000000000000000000000000000000808"
+	AuthzPrecompileAddress        = "0x0000000000000000000000000000000000000809"
+)
+
+const (
+	StrideOutpostPrecompileAddress  = "0x0000000000000000000000000000000000000900"
+	OsmosisOutpostPrecompileAddress = "0x0000000000000000000000000000000000000901"
 )
 
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
@@ -24,10 +36,19 @@ const (
 var AvailableStaticPrecompiles = []string{
 	P256PrecompileAddress,
 	Bech32PrecompileAddress,
+	SchnorrPrecompileAddress,
+	MulticallPrecompileAddress,
+	P256BatchPrecompileAddress,
 	StakingPrecompileAddress,
 	DistributionPrecompileAddress,
 	ICS20PrecompileAddress,
 	VestingPrecompileAddress,
 	BankPrecompileAddress,
 	GovPrecompileAddress,
+	SlashingPrecompileAddress,
+	EvidencePrecompileAddress,
+	FeegrantPrecompileAddress,
+	AuthzPrecompileAddress,
+	StrideOutpostPrecompileAddress,
+	OsmosisOutpostPrecompileAddress,
 }
@@ -0,0 +1,90 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/types"
+)
+
+// Create2DeployerAddress is the canonical address of the deterministic
+// deployment proxy, which deploys the contracts with CREATE2.
+const Create2DeployerAddress = "0x4e59b44847b379578588920cA78FbF26c0B4956C"
+
+// create2DeployerCode is the runtime code of the deterministic deployment proxy.
+const create2DeployerCode = "0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe03601600081602082378035828234f58015156039578182fd5b8082525050506014600cf3"
+
+// DefaultPreinstalls defines the well-known contracts with a predefined code
+// that is shipped with the binary. Other contracts, such as Multicall3 or
+// Permit2, are installed by providing their runtime code at their canonical
+// addresses in the genesis state or in an upgrade handler.
+var DefaultPreinstalls = []Preinstall{
+	{
+		Name:    "Create2Deployer",
+		Address: Create2DeployerAddress,
+		Code:    create2DeployerCode,
+	},
+}
+
+// GetCodeBytes returns the decoded runtime code of the preinstall.
+func (p Preinstall) GetCodeBytes() ([]byte, error) {
+	code, err := hex.DecodeString(strings.TrimPrefix(p.Code, "0x"))
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidPreinstall, "invalid code of preinstall %s: %s", p.Name, err)
+	}
+	return code, nil
+}
+
+// Validate performs a stateless validation of the preinstall.
+func (p Preinstall) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return errorsmod.Wrapf(ErrInvalidPreinstall, "empty name for preinstall at %s", p.Address)
+	}
+
+	if err := types.ValidateNonZeroAddress(p.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPreinstall, "invalid address of preinstall %s: %s", p.Name, err)
+	}
+
+	if slices.ContainsFunc(AvailableStaticPrecompiles, func(precompile string) bool {
+		return common.HexToAddress(precompile) == common.HexToAddress(p.Address)
+	}) {
+		return errorsmod.Wrapf(ErrInvalidPreinstall, "preinstall %s at the address of the precompile %s", p.Name, p.Address)
+	}
+
+	code, err := p.GetCodeBytes()
+	if err != nil {
+		return err
+	}
+
+	if len(code) == 0 {
+		return errorsmod.Wrapf(ErrInvalidPreinstall, "empty code for preinstall %s", p.Name)
+	}
+
+	return nil
+}
+
+// ValidatePreinstalls validates the given preinstalls and checks that there is
+// at most one preinstall per address.
+func ValidatePreinstalls(preinstalls []Preinstall) error {
+	seen := make(map[common.Address]bool, len(preinstalls))
+	for _, preinstall := range preinstalls {
+		if err := preinstall.Validate(); err != nil {
+			return err
+		}
+
+		address := common.HexToAddress(preinstall.Address)
+		if seen[address] {
+			return fmt.Errorf("duplicated preinstall %s", preinstall.Address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePreinstalls(t *testing.T) {
+	address := "0x1111111111111111111111111111111111111111"
+
+	testCases := []struct {
+		name        string
+		preinstalls []Preinstall
+		expErr      bool
+	}{
+		{
+			"pass - default preinstalls",
+			DefaultPreinstalls,
+			false,
+		},
+		{
+			"pass - code without 0x prefix",
+			[]Preinstall{{Name: "test", Address: address, Code: "6001"}},
+			false,
+		},
+		{
+			"fail - empty name",
+			[]Preinstall{{Address: address, Code: "0x6001"}},
+			true,
+		},
+		{
+			"fail - invalid address",
+			[]Preinstall{{Name: "test", Address: "0xinvalid", Code: "0x6001"}},
+			true,
+		},
+		{
+			"fail - zero address",
+			[]Preinstall{{Name: "test", Address: "0x0000000000000000000000000000000000000000", Code: "0x6001"}},
+			true,
+		},
+		{
+			"fail - address of a static precompile",
+			[]Preinstall{{Name: "test", Address: StakingPrecompileAddress, Code: "0x6001"}},
+			true,
+		},
+		{
+			"fail - invalid code",
+			[]Preinstall{{Name: "test", Address: address, Code: "0xzz"}},
+			true,
+		},
+		{
+			"fail - empty code",
+			[]Preinstall{{Name: "test", Address: address, Code: "0x"}},
+			true,
+		},
+		{
+			"fail - duplicated address",
+			[]Preinstall{
+				{Name: "test", Address: address, Code: "0x6001"},
+				{Name: "other", Address: address, Code: "0x6002"},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidatePreinstalls(tc.preinstalls)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -798,6 +798,9 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides uses the same json format as the json rpc api state override
+	// set, applied before executing the call.
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -861,6 +864,13 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -926,6 +936,71 @@ func (m *EstimateGasResponse) GetVmError() string {
 	return ""
 }
 
+// CreateAccessListResponse defines CreateAccessList response
+type CreateAccessListResponse struct {
+	// access_list is the list of addresses and storage keys accessed by the call,
+	// excluding the sender, the recipient and the warm precompiled contracts
+	AccessList []AccessTuple `protobuf:"bytes,1,rep,name=access_list,json=accessList,proto3" json:"access_list"`
+	// gas_used is the gas consumed by the call with the access list
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// vm_error is the error returned by vm execution with the access list
+	VmError string `protobuf:"bytes,3,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (m *CreateAccessListResponse) Reset()         { *m = CreateAccessListResponse{} }
+func (m *CreateAccessListResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAccessListResponse) ProtoMessage()    {}
+func (*CreateAccessListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{18}
+}
+func (m *CreateAccessListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateAccessListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateAccessListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateAccessListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccessListResponse.Merge(m, src)
+}
+func (m *CreateAccessListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateAccessListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccessListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccessListResponse proto.InternalMessageInfo
+
+func (m *CreateAccessListResponse) GetAccessList() []AccessTuple {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *CreateAccessListResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *CreateAccessListResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	// msg is the MsgEthereumTx for the requested transaction
@@ -953,7 +1028,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{18}
+	return fileDescriptor_e15a877459347994, []int{19}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1055,7 +1130,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{19}
+	return fileDescriptor_e15a877459347994, []int{20}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1109,13 +1184,17 @@ type QueryTraceBlockRequest struct {
 	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	// block_max_gas of the traced block
 	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+	// concurrency is the max number of transactions traced concurrently, each
+	// one on an isolated snapshot of the state before its execution. Values
+	// lower than 2 trace the transactions sequentially.
+	Concurrency uint32 `protobuf:"varint,11,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
 }
 
 func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{} }
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{20}
+	return fileDescriptor_e15a877459347994, []int{21}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1200,6 +1279,13 @@ func (m *QueryTraceBlockRequest) GetBlockMaxGas() int64 {
 	return 0
 }
 
+func (m *QueryTraceBlockRequest) GetConcurrency() uint32 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
 // QueryTraceBlockResponse defines TraceBlock response
 type QueryTraceBlockResponse struct {
 	// data is the response serialized in bytes
@@ -1210,7 +1296,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{21}
+	return fileDescriptor_e15a877459347994, []int{22}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1255,7 +1341,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+	return fileDescriptor_e15a877459347994, []int{23}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1294,7 +1380,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
+	return fileDescriptor_e15a877459347994, []int{24}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1331,7 +1417,7 @@ func (m *QueryGlobalMinGasPriceRequest) Reset()         { *m = QueryGlobalMinGas
 func (m *QueryGlobalMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceRequest) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{24}
+	return fileDescriptor_e15a877459347994, []int{25}
 }
 func (m *QueryGlobalMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1370,7 +1456,7 @@ func (m *QueryGlobalMinGasPriceResponse) Reset()         { *m = QueryGlobalMinGa
 func (m *QueryGlobalMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceResponse) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{25}
+	return fileDescriptor_e15a877459347994, []int{26}
 }
 func (m *QueryGlobalMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1407,7 +1493,7 @@ func (m *QueryConfigRequest) Reset()         { *m = QueryConfigRequest{} }
 func (m *QueryConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConfigRequest) ProtoMessage()    {}
 func (*QueryConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{26}
+	return fileDescriptor_e15a877459347994, []int{27}
 }
 func (m *QueryConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1446,7 +1532,7 @@ func (m *QueryConfigResponse) Reset()         { *m = QueryConfigResponse{} }
 func (m *QueryConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConfigResponse) ProtoMessage()    {}
 func (*QueryConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{27}
+	return fileDescriptor_e15a877459347994, []int{28}
 }
 func (m *QueryConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1482,6 +1568,288 @@ func (m *QueryConfigResponse) GetConfig() *ChainConfig {
 	return nil
 }
 
+// QueryReceiptRequest defines the request type for the Query/Receipt RPC method.
+type QueryReceiptRequest struct {
+	// tx_hash is the hex encoded hash of the ethereum tx
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *QueryReceiptRequest) Reset()         { *m = QueryReceiptRequest{} }
+func (m *QueryReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiptRequest) ProtoMessage()    {}
+func (*QueryReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{29}
+}
+func (m *QueryReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiptRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiptRequest.Merge(m, src)
+}
+func (m *QueryReceiptRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReceiptRequest proto.InternalMessageInfo
+
+func (m *QueryReceiptRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// QueryReceiptResponse defines the response type for the Query/Receipt RPC method.
+type QueryReceiptResponse struct {
+	// receipt of the ethereum tx
+	Receipt Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt"`
+}
+
+func (m *QueryReceiptResponse) Reset()         { *m = QueryReceiptResponse{} }
+func (m *QueryReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiptResponse) ProtoMessage()    {}
+func (*QueryReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{30}
+}
+func (m *QueryReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiptResponse.Merge(m, src)
+}
+func (m *QueryReceiptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReceiptResponse proto.InternalMessageInfo
+
+func (m *QueryReceiptResponse) GetReceipt() Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return Receipt{}
+}
+
+// QuerySubscribeEventsRequest defines the request type for the
+// Query/SubscribeEvents RPC method.
+type QuerySubscribeEventsRequest struct {
+	// addresses are the ethereum hex addresses to subscribe to. A log matches if
+	// it's emitted by one of the addresses, and a receipt if one of them is the
+	// sender, the recipient or the created contract. Empty matches all of them.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// topics filters the logs by their topics, with the same positional semantics
+	// as the eth_getLogs filters. Empty matches all the logs.
+	Topics []TopicFilter `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics"`
+	// receipts defines if the receipts of the matching txs are streamed.
+	Receipts bool `protobuf:"varint,3,opt,name=receipts,proto3" json:"receipts,omitempty"`
+}
+
+func (m *QuerySubscribeEventsRequest) Reset()         { *m = QuerySubscribeEventsRequest{} }
+func (m *QuerySubscribeEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscribeEventsRequest) ProtoMessage()    {}
+func (*QuerySubscribeEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{31}
+}
+func (m *QuerySubscribeEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubscribeEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubscribeEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubscribeEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscribeEventsRequest.Merge(m, src)
+}
+func (m *QuerySubscribeEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubscribeEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscribeEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubscribeEventsRequest proto.InternalMessageInfo
+
+func (m *QuerySubscribeEventsRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QuerySubscribeEventsRequest) GetTopics() []TopicFilter {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *QuerySubscribeEventsRequest) GetReceipts() bool {
+	if m != nil {
+		return m.Receipts
+	}
+	return false
+}
+
+// TopicFilter defines the alternative topics that match the log topic at a
+// given position. Empty matches any topic.
+type TopicFilter struct {
+	// topics are the hex encoded topic hashes
+	Topics []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (m *TopicFilter) Reset()         { *m = TopicFilter{} }
+func (m *TopicFilter) String() string { return proto.CompactTextString(m) }
+func (*TopicFilter) ProtoMessage()    {}
+func (*TopicFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{32}
+}
+func (m *TopicFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicFilter.Merge(m, src)
+}
+func (m *TopicFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicFilter proto.InternalMessageInfo
+
+func (m *TopicFilter) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+// QuerySubscribeEventsResponse defines the response type for the
+// Query/SubscribeEvents RPC method. It's sent once per committed block with
+// matching events.
+type QuerySubscribeEventsResponse struct {
+	// height of the committed block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// block_hash is the hex encoded hash of the committed block
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// logs are the decoded logs that match the filter
+	Logs []*Log `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs,omitempty"`
+	// receipts are the receipts of the txs that match the filter, if requested
+	Receipts []*Receipt `protobuf:"bytes,4,rep,name=receipts,proto3" json:"receipts,omitempty"`
+}
+
+func (m *QuerySubscribeEventsResponse) Reset()         { *m = QuerySubscribeEventsResponse{} }
+func (m *QuerySubscribeEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscribeEventsResponse) ProtoMessage()    {}
+func (*QuerySubscribeEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{33}
+}
+func (m *QuerySubscribeEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubscribeEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubscribeEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubscribeEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscribeEventsResponse.Merge(m, src)
+}
+func (m *QuerySubscribeEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubscribeEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscribeEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubscribeEventsResponse proto.InternalMessageInfo
+
+func (m *QuerySubscribeEventsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QuerySubscribeEventsResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QuerySubscribeEventsResponse) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *QuerySubscribeEventsResponse) GetReceipts() []*Receipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "ethermint.evm.v1.QueryAccountRequest")
 	proto.RegisterType((*QueryAccountResponse)(nil), "ethermint.evm.v1.QueryAccountResponse")
@@ -1501,6 +1869,7 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.evm.v1.QueryParamsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "ethermint.evm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "ethermint.evm.v1.EstimateGasResponse")
+	proto.RegisterType((*CreateAccessListResponse)(nil), "ethermint.evm.v1.CreateAccessListResponse")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "ethermint.evm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
@@ -1511,115 +1880,140 @@ func init() {
 	proto.RegisterType((*QueryGlobalMinGasPriceResponse)(nil), "ethermint.evm.v1.QueryGlobalMinGasPriceResponse")
 	proto.RegisterType((*QueryConfigRequest)(nil), "ethermint.evm.v1.QueryConfigRequest")
 	proto.RegisterType((*QueryConfigResponse)(nil), "ethermint.evm.v1.QueryConfigResponse")
+	proto.RegisterType((*QueryReceiptRequest)(nil), "ethermint.evm.v1.QueryReceiptRequest")
+	proto.RegisterType((*QueryReceiptResponse)(nil), "ethermint.evm.v1.QueryReceiptResponse")
+	proto.RegisterType((*QuerySubscribeEventsRequest)(nil), "ethermint.evm.v1.QuerySubscribeEventsRequest")
+	proto.RegisterType((*TopicFilter)(nil), "ethermint.evm.v1.TopicFilter")
+	proto.RegisterType((*QuerySubscribeEventsResponse)(nil), "ethermint.evm.v1.QuerySubscribeEventsResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x13, 0x47,
-	0x1b, 0xce, 0xc6, 0x4e, 0xec, 0x8c, 0x13, 0x08, 0x43, 0x00, 0xc7, 0x24, 0x76, 0x58, 0xc8, 0x0f,
-	0xf8, 0x60, 0x37, 0xc9, 0xf7, 0x7d, 0x48, 0xdf, 0xd7, 0x43, 0x49, 0xa2, 0x10, 0x28, 0x50, 0xd1,
-	0x6d, 0xd4, 0x43, 0xa5, 0xca, 0x1a, 0xaf, 0x87, 0xf5, 0x2a, 0xde, 0x1d, 0xb3, 0x33, 0xb6, 0x1c,
-	0x10, 0x87, 0xa2, 0xaa, 0x2d, 0xea, 0x05, 0xa9, 0xb7, 0xf6, 0xc2, 0xb1, 0x52, 0x2f, 0xbd, 0xf1,
-	0x2f, 0x70, 0x44, 0xed, 0xa5, 0xea, 0x81, 0x56, 0x50, 0xa9, 0xfd, 0x1b, 0x7a, 0xaa, 0xe6, 0xc7,
-	0xda, 0xbb, 0xb6, 0x37, 0x0e, 0x15, 0xbd, 0xf5, 0x92, 0xec, 0xcc, 0xbc, 0xef, 0xfb, 0x3c, 0xf3,
-	0xce, 0x3b, 0xf3, 0x3e, 0x06, 0x73, 0x98, 0xd5, 0x70, 0xe0, 0xb9, 0x3e, 0x33, 0x71, 0xcb, 0x33,
-	0x5b, 0x6b, 0xe6, 0xdd, 0x26, 0x0e, 0xf6, 0x8d, 0x46, 0x40, 0x18, 0x81, 0xd3, 0x9d, 0x55, 0x03,
-	0xb7, 0x3c, 0xa3, 0xb5, 0x56, 0x38, 0x86, 0x3c, 0xd7, 0x27, 0xa6, 0xf8, 0x2b, 0x8d, 0x0a, 0x17,
-	0x6c, 0x42, 0x3d, 0x42, 0xcd, 0x0a, 0xa2, 0x58, 0x7a, 0x9b, 0xad, 0xb5, 0x0a, 0x66, 0x68, 0xcd,
-	0x6c, 0x20, 0xc7, 0xf5, 0x11, 0x73, 0x89, 0xaf, 0x6c, 0x0b, 0x7d, 0x70, 0x3c, 0xae, 0x5c, 0x9b,
-	0xed, 0x5b, 0x63, 0x6d, 0xb5, 0x34, 0xe3, 0x10, 0x87, 0x88, 0x4f, 0x93, 0x7f, 0xa9, 0xd9, 0x39,
-	0x87, 0x10, 0xa7, 0x8e, 0x4d, 0xd4, 0x70, 0x4d, 0xe4, 0xfb, 0x84, 0x09, 0x24, 0xaa, 0x56, 0x4b,
-	0x6a, 0x55, 0x8c, 0x2a, 0xcd, 0x3b, 0x26, 0x73, 0x3d, 0x4c, 0x19, 0xf2, 0x1a, 0xd2, 0x40, 0xff,
-	0x1f, 0x38, 0xfe, 0x1e, 0x67, 0xbb, 0x61, 0xdb, 0xa4, 0xe9, 0x33, 0x0b, 0xdf, 0x6d, 0x62, 0xca,
-	0x60, 0x1e, 0x64, 0x50, 0xb5, 0x1a, 0x60, 0x4a, 0xf3, 0xda, 0x82, 0xb6, 0x32, 0x61, 0x85, 0xc3,
-	0xff, 0x67, 0x3f, 0x7f, 0x52, 0x1a, 0xf9, 0xfd, 0x49, 0x69, 0x44, 0xb7, 0xc1, 0x4c, 0xdc, 0x95,
-	0x36, 0x88, 0x4f, 0x31, 0xf7, 0xad, 0xa0, 0x3a, 0xf2, 0x6d, 0x1c, 0xfa, 0xaa, 0x21, 0x3c, 0x0d,
-	0x26, 0x6c, 0x52, 0xc5, 0xe5, 0x1a, 0xa2, 0xb5, 0xfc, 0xa8, 0x58, 0xcb, 0xf2, 0x89, 0x6b, 0x88,
-	0xd6, 0xe0, 0x0c, 0x18, 0xf3, 0x09, 0x77, 0x4a, 0x2d, 0x68, 0x2b, 0x69, 0x4b, 0x0e, 0xf4, 0xb7,
-	0xc1, 0xac, 0x00, 0xd9, 0x12, 0xe9, 0xfd, 0x0b, 0x2c, 0x3f, 0xd5, 0x40, 0x61, 0x50, 0x04, 0x45,
-	0x76, 0x11, 0x1c, 0x91, 0x27, 0x57, 0x8e, 0x47, 0x9a, 0x92, 0xb3, 0x1b, 0x72, 0x12, 0x16, 0x40,
-	0x96, 0x72, 0x50, 0xce, 0x6f, 0x54, 0xf0, 0xeb, 0x8c, 0x79, 0x08, 0x24, 0xa3, 0x96, 0xfd, 0xa6,
-	0x57, 0xc1, 0x81, 0xda, 0xc1, 0x94, 0x9a, 0x7d, 0x57, 0x4c, 0xea, 0x37, 0xc0, 0x9c, 0xe0, 0xf1,
-	0x01, 0xaa, 0xbb, 0x55, 0xc4, 0x48, 0xd0, 0xb3, 0x99, 0x33, 0x60, 0xd2, 0x26, 0x7e, 0x2f, 0x8f,
-	0x1c, 0x9f, 0xdb, 0xe8, 0xdb, 0xd5, 0x17, 0x1a, 0x98, 0x4f, 0x88, 0xa6, 0x36, 0xb6, 0x0c, 0x8e,
-	0x86, 0xac, 0xe2, 0x11, 0x43, 0xb2, 0x6f, 0x70, 0x6b, 0x61, 0x11, 0x6d, 0xca, 0x73, 0x7e, 0x9d,
-	0xe3, 0x59, 0x55, 0x45, 0xd4, 0x71, 0x1d, 0x56, 0x44, 0xfa, 0x0d, 0x05, 0xf6, 0x3e, 0x23, 0x01,
-	0x72, 0x86, 0x83, 0xc1, 0x69, 0x90, 0xda, 0xc3, 0xfb, 0xaa, 0xde, 0xf8, 0x67, 0x04, 0xfe, 0xa2,
-	0x82, 0xef, 0x04, 0x53, 0xf0, 0x33, 0x60, 0xac, 0x85, 0xea, 0xcd, 0x10, 0x5c, 0x0e, 0xf4, 0xcb,
-	0x60, 0x5a, 0x95, 0x52, 0xf5, 0xb5, 0x36, 0xb9, 0x0c, 0x8e, 0x45, 0xfc, 0x14, 0x04, 0x04, 0x69,
-	0x5e, 0xfb, 0xc2, 0x6b, 0xd2, 0x12, 0xdf, 0xfa, 0x3d, 0x00, 0x85, 0xe1, 0x6e, 0xfb, 0x26, 0x71,
-	0x68, 0x08, 0x01, 0x41, 0x5a, 0xdc, 0x18, 0x19, 0x5f, 0x7c, 0xc3, 0xab, 0x00, 0x74, 0xdf, 0x15,
-	0xb1, 0xb7, 0xdc, 0xfa, 0x92, 0x21, 0x8b, 0xd6, 0xe0, 0x8f, 0x90, 0x21, 0x9f, 0x30, 0xf5, 0x08,
-	0x19, 0xb7, 0xbb, 0xa9, 0xb2, 0x22, 0x9e, 0x11, 0x92, 0x8f, 0x34, 0x95, 0xd8, 0x10, 0x5c, 0xf1,
-	0x3c, 0x0f, 0xd2, 0x75, 0xe2, 0xf0, 0xdd, 0xa5, 0x56, 0x72, 0xeb, 0x27, 0x8c, 0xde, 0xd7, 0xd0,
-	0xb8, 0x49, 0x1c, 0x4b, 0x98, 0xc0, 0x9d, 0x01, 0xa4, 0x96, 0x87, 0x92, 0x92, 0x38, 0x51, 0x56,
-	0xfa, 0x8c, 0xca, 0xc3, 0x6d, 0x14, 0x20, 0x2f, 0xcc, 0x83, 0x6e, 0x29, 0x82, 0xe1, 0xac, 0x22,
-	0xf8, 0x16, 0x18, 0x6f, 0x88, 0x19, 0x91, 0xa0, 0xdc, 0x7a, 0xbe, 0x9f, 0xa2, 0xf4, 0xd8, 0x9c,
-	0x78, 0xf6, 0xa2, 0x34, 0xf2, 0xcd, 0x6f, 0xdf, 0x5d, 0xd0, 0x2c, 0xe5, 0xa2, 0x3f, 0xd5, 0xc0,
-	0x91, 0x6d, 0x56, 0xdb, 0x42, 0xf5, 0x7a, 0x24, 0xdd, 0x28, 0x70, 0x68, 0x78, 0x30, 0xfc, 0x1b,
-	0x9e, 0x02, 0x19, 0x07, 0xd1, 0xb2, 0x8d, 0x1a, 0xea, 0x8e, 0x8c, 0x3b, 0x88, 0x6e, 0xa1, 0x06,
-	0xfc, 0x08, 0x4c, 0x37, 0x02, 0xd2, 0x20, 0x14, 0x07, 0x9d, 0x7b, 0xc6, 0xef, 0xc8, 0xe4, 0xe6,
-	0xfa, 0x1f, 0x2f, 0x4a, 0x86, 0xe3, 0xb2, 0x5a, 0xb3, 0x62, 0xd8, 0xc4, 0x33, 0x55, 0x83, 0x90,
-	0xff, 0x2e, 0xd1, 0xea, 0x9e, 0xc9, 0xf6, 0x1b, 0x98, 0x1a, 0x5b, 0xdd, 0x0b, 0x6e, 0x1d, 0x0d,
-	0x63, 0x85, 0x97, 0x73, 0x16, 0x64, 0xed, 0x1a, 0x72, 0xfd, 0xb2, 0x5b, 0xcd, 0xa7, 0x17, 0xb4,
-	0x95, 0x94, 0x95, 0x11, 0xe3, 0xeb, 0x55, 0x7d, 0x17, 0x1c, 0xdf, 0xa6, 0xcc, 0xf5, 0x10, 0xc3,
-	0x3b, 0xa8, 0x9b, 0x8d, 0x69, 0x90, 0x72, 0x90, 0x24, 0x9f, 0xb6, 0xf8, 0x27, 0x9f, 0x09, 0x30,
-	0x13, 0xbc, 0x27, 0x2d, 0xfe, 0xc9, 0xa3, 0xb6, 0xbc, 0x32, 0x0e, 0x02, 0x22, 0x2f, 0xf4, 0x84,
-	0x95, 0x69, 0x79, 0xdb, 0x7c, 0xa8, 0x3f, 0x4a, 0x87, 0x55, 0x10, 0x20, 0x1b, 0xef, 0xb6, 0xc3,
-	0xa4, 0xac, 0x81, 0x94, 0x47, 0x1d, 0x95, 0xe1, 0x52, 0x7f, 0x86, 0x6f, 0x51, 0x67, 0x9b, 0xcf,
-	0xe1, 0xa6, 0xb7, 0xdb, 0xb6, 0xb8, 0x2d, 0xbc, 0x02, 0x26, 0x19, 0x0f, 0x52, 0xb6, 0x89, 0x7f,
-	0xc7, 0x75, 0x04, 0x52, 0x6e, 0x7d, 0xbe, 0xdf, 0x57, 0x40, 0x6d, 0x09, 0x23, 0x2b, 0xc7, 0xba,
-	0x03, 0xb8, 0x05, 0x26, 0x1b, 0x01, 0xae, 0x62, 0x1b, 0x53, 0x4a, 0x02, 0x9a, 0x4f, 0x8b, 0x12,
-	0x1c, 0x8a, 0x1e, 0x73, 0xe2, 0xef, 0x6a, 0xa5, 0x4e, 0xec, 0xbd, 0xf0, 0x05, 0x1b, 0x13, 0x69,
-	0xcc, 0x89, 0x39, 0xf9, 0x7e, 0xc1, 0x79, 0x00, 0xa4, 0x89, 0xb8, 0x66, 0xe3, 0x22, 0x23, 0x13,
-	0x62, 0x46, 0x74, 0xa6, 0x6b, 0xe1, 0x32, 0x6f, 0x9e, 0xf9, 0x8c, 0xd8, 0x46, 0xc1, 0x90, 0x9d,
-	0xd5, 0x08, 0x3b, 0xab, 0xb1, 0x1b, 0x76, 0xd6, 0xcd, 0x29, 0x5e, 0x66, 0x8f, 0x7f, 0x2e, 0x69,
-	0xb2, 0xd4, 0x64, 0x24, 0xbe, 0x3c, 0xb0, 0x5a, 0xb2, 0x7f, 0x4f, 0xb5, 0x4c, 0xc4, 0xaa, 0x05,
-	0xea, 0x60, 0x4a, 0xee, 0xc1, 0x43, 0xed, 0x32, 0x2f, 0x10, 0x10, 0x49, 0xc3, 0x2d, 0xd4, 0xde,
-	0x41, 0xf4, 0x9d, 0x74, 0x76, 0x74, 0x3a, 0x65, 0x65, 0x59, 0xbb, 0xec, 0xfa, 0x55, 0xdc, 0xd6,
-	0x2f, 0xa8, 0xc7, 0xb1, 0x53, 0x0a, 0xdd, 0x97, 0xab, 0x8a, 0x18, 0x0a, 0x2f, 0x08, 0xff, 0xd6,
-	0x9f, 0xa6, 0xc0, 0xc9, 0xae, 0xf1, 0x26, 0x8f, 0x1a, 0x29, 0x1d, 0xd6, 0x0e, 0xdf, 0x8f, 0xe1,
-	0xa5, 0xc3, 0xda, 0xf4, 0x0d, 0x94, 0xce, 0x3f, 0xa7, 0x7e, 0xc8, 0x53, 0xd7, 0x2f, 0x81, 0x53,
-	0x7d, 0x07, 0x77, 0xc0, 0x41, 0x9f, 0xe8, 0xf4, 0x7a, 0x8a, 0xaf, 0xe2, 0xb0, 0xa7, 0xe8, 0x37,
-	0x3b, 0x7d, 0x5c, 0x4d, 0xab, 0x10, 0xff, 0x01, 0x59, 0xfe, 0xf0, 0x97, 0xef, 0x60, 0xd5, 0x4b,
-	0x37, 0x67, 0x7f, 0x7a, 0x51, 0x3a, 0x21, 0x77, 0x48, 0xab, 0x7b, 0x86, 0x4b, 0x4c, 0x0f, 0xb1,
-	0x9a, 0x71, 0xdd, 0x67, 0xbc, 0xc7, 0x0b, 0x6f, 0xbd, 0xa4, 0xd4, 0xcd, 0x4e, 0x9d, 0x54, 0x50,
-	0xfd, 0x96, 0xeb, 0xef, 0x20, 0x7a, 0x3b, 0x70, 0x3b, 0xd2, 0x42, 0xb7, 0x41, 0x31, 0xc9, 0x40,
-	0x01, 0x6f, 0x80, 0x29, 0xcf, 0xf5, 0xf9, 0xa6, 0xcb, 0x0d, 0xbe, 0xa0, 0xd0, 0xe7, 0xf9, 0x29,
-	0x25, 0x33, 0xc8, 0x79, 0xdd, 0x50, 0x9d, 0x2e, 0xa4, 0xea, 0xab, 0xb3, 0xd3, 0xe3, 0xb1, 0x59,
-	0x85, 0xf7, 0x5f, 0x30, 0xae, 0x8a, 0x55, 0x4b, 0x2a, 0xd6, 0x2d, 0x7e, 0x2a, 0xca, 0x4d, 0x19,
-	0xaf, 0x7f, 0x7f, 0x04, 0x8c, 0x89, 0x70, 0xf0, 0x63, 0x0d, 0x64, 0x94, 0x88, 0x83, 0x8b, 0xfd,
-	0xce, 0x03, 0x54, 0x7a, 0x61, 0x69, 0x98, 0x99, 0xe4, 0xa6, 0x2f, 0x3f, 0xfc, 0xe1, 0xd7, 0x2f,
-	0x47, 0xcf, 0xc0, 0x12, 0xff, 0x4d, 0x41, 0x68, 0xf8, 0xcb, 0x42, 0x89, 0x38, 0xf3, 0xbe, 0x2a,
-	0xca, 0x07, 0xf0, 0x2b, 0x0d, 0x4c, 0xc5, 0x74, 0x32, 0xfc, 0x57, 0x02, 0xc4, 0x20, 0x3d, 0x5e,
-	0xb8, 0x78, 0x38, 0x63, 0xc5, 0xca, 0x10, 0xac, 0x56, 0xe0, 0x52, 0x9c, 0x55, 0x28, 0xc7, 0xfb,
-	0xc8, 0x7d, 0xab, 0x81, 0xe9, 0x5e, 0xb9, 0x0b, 0x8d, 0x04, 0xc8, 0x04, 0x95, 0x5d, 0x30, 0x0f,
-	0x6d, 0xaf, 0x58, 0x5e, 0x16, 0x2c, 0x57, 0xa1, 0x11, 0x67, 0xd9, 0x0a, 0xed, 0xbb, 0x44, 0xa3,
-	0xea, 0xfd, 0x01, 0x7c, 0xa8, 0x81, 0x8c, 0x12, 0xb5, 0x89, 0xc7, 0x19, 0xd7, 0xcb, 0x89, 0xc7,
-	0xd9, 0xa3, 0x8d, 0xf5, 0x15, 0x41, 0x49, 0x87, 0x0b, 0x71, 0x4a, 0x4a, 0x20, 0xd3, 0x48, 0xca,
-	0x3e, 0xd3, 0x40, 0x46, 0x49, 0xdb, 0x44, 0x12, 0x71, 0x1d, 0x9d, 0x48, 0xa2, 0x47, 0x21, 0xeb,
-	0x97, 0x04, 0x89, 0x65, 0xb8, 0x18, 0x27, 0x41, 0xa5, 0x59, 0x97, 0x83, 0x79, 0x7f, 0x0f, 0xef,
-	0x3f, 0x80, 0x2d, 0x90, 0xe6, 0xea, 0x17, 0xea, 0x89, 0x25, 0xd2, 0x91, 0xd4, 0x85, 0xb3, 0x07,
-	0xda, 0x28, 0xfc, 0x45, 0x81, 0x5f, 0x82, 0xf3, 0xbd, 0xd5, 0x53, 0x8d, 0x65, 0x80, 0x82, 0x71,
-	0x29, 0xfe, 0xe0, 0xb9, 0x84, 0xa8, 0x31, 0x8d, 0x59, 0x58, 0x1c, 0x62, 0xa5, 0xd0, 0xe7, 0x04,
-	0xfa, 0x49, 0x38, 0x13, 0x47, 0x97, 0xa2, 0x12, 0x32, 0x90, 0x51, 0x9a, 0x12, 0x2e, 0xf4, 0xc7,
-	0x8b, 0xcb, 0xcd, 0xc2, 0xf2, 0xb0, 0x8e, 0x18, 0x62, 0x16, 0x05, 0x66, 0x1e, 0x9e, 0x8c, 0x63,
-	0x62, 0x56, 0x2b, 0xdb, 0x1c, 0xea, 0x1e, 0xc8, 0x45, 0x04, 0xe1, 0x21, 0x90, 0x07, 0xec, 0x75,
-	0x80, 0xa2, 0xd4, 0x75, 0x81, 0x3b, 0x07, 0x0b, 0x3d, 0xb8, 0xca, 0x94, 0x3f, 0xb1, 0xb0, 0x0d,
-	0x32, 0x4a, 0x25, 0x24, 0xd6, 0x59, 0x5c, 0x50, 0x26, 0xd6, 0x59, 0x8f, 0xd8, 0x48, 0xda, 0xb5,
-	0x94, 0x07, 0xac, 0x0d, 0x3f, 0xd1, 0x00, 0xe8, 0xb6, 0x2e, 0xb8, 0x72, 0x50, 0xd8, 0xa8, 0x2c,
-	0x29, 0x9c, 0x3f, 0x84, 0xa5, 0xe2, 0x70, 0x46, 0x70, 0x38, 0x0d, 0x67, 0x07, 0x71, 0x10, 0xbd,
-	0x94, 0x27, 0x40, 0xb5, 0xbe, 0x03, 0x6e, 0x7b, 0xb4, 0x63, 0x1e, 0x70, 0xdb, 0x63, 0x1d, 0x34,
-	0x29, 0x01, 0x61, 0x57, 0x85, 0x5f, 0x6b, 0xe0, 0x58, 0x5f, 0x1b, 0x84, 0x49, 0xef, 0x5c, 0x52,
-	0x47, 0x2d, 0xac, 0x1e, 0xde, 0x41, 0x11, 0x3b, 0x2b, 0x88, 0xcd, 0xc3, 0xd3, 0x71, 0x62, 0xb1,
-	0xae, 0xcb, 0xef, 0x9f, 0x52, 0x64, 0xe7, 0x12, 0x6f, 0x75, 0xa4, 0xbb, 0x26, 0xde, 0xbf, 0x78,
-	0xb7, 0x4d, 0xba, 0x7f, 0xb2, 0xa9, 0x6e, 0x5e, 0x79, 0xf6, 0xb2, 0xa8, 0x3d, 0x7f, 0x59, 0xd4,
-	0x7e, 0x79, 0x59, 0xd4, 0x1e, 0xbf, 0x2a, 0x8e, 0x3c, 0x7f, 0x55, 0x1c, 0xf9, 0xf1, 0x55, 0x71,
-	0xe4, 0xc3, 0xa5, 0x88, 0xd8, 0xea, 0x78, 0x12, 0x6a, 0xb6, 0xd6, 0x57, 0xcd, 0xb6, 0x88, 0x22,
-	0x04, 0x57, 0x65, 0x5c, 0x08, 0xbc, 0x7f, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x02, 0xd3, 0xdf,
-	0xf6, 0x1a, 0x14, 0x00, 0x00,
+	// 1964 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4f, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xc7, 0x9e, 0x38, 0x79, 0x4e, 0x76, 0x32, 0x15, 0x4f, 0xc6, 0xe9, 0x49, 0xe2, 0x4c,
+	0xef, 0xc6, 0xc9, 0x0e, 0x3b, 0xdd, 0x49, 0x60, 0x57, 0x5a, 0x38, 0xb0, 0x49, 0xc8, 0x64, 0x97,
+	0x9d, 0x41, 0xb3, 0x4d, 0xe0, 0x80, 0x84, 0xac, 0x72, 0xbb, 0xa6, 0xdd, 0x8a, 0xbb, 0xdb, 0xdb,
+	0x55, 0xb6, 0x9c, 0x1d, 0xcd, 0x81, 0x15, 0x02, 0x16, 0x2e, 0x2b, 0x81, 0x38, 0xc0, 0x65, 0x8f,
+	0x48, 0x5c, 0xb8, 0xf1, 0x15, 0xf6, 0xb8, 0x12, 0x42, 0x5a, 0x38, 0x0c, 0x68, 0x06, 0x09, 0x3e,
+	0x03, 0x27, 0x54, 0x7f, 0xda, 0xee, 0xb6, 0xdd, 0xb6, 0x17, 0x2d, 0x37, 0x2e, 0x76, 0x57, 0xd5,
+	0xab, 0xf7, 0xfb, 0xbd, 0x57, 0xaf, 0x5e, 0xbd, 0x07, 0x9b, 0x84, 0x35, 0x49, 0xe4, 0x7b, 0x01,
+	0xb3, 0x48, 0xd7, 0xb7, 0xba, 0x87, 0xd6, 0xfb, 0x1d, 0x12, 0x5d, 0x99, 0xed, 0x28, 0x64, 0x21,
+	0x5a, 0xed, 0xaf, 0x9a, 0xa4, 0xeb, 0x9b, 0xdd, 0x43, 0xfd, 0x06, 0xf6, 0xbd, 0x20, 0xb4, 0xc4,
+	0xaf, 0x14, 0xd2, 0xef, 0x3a, 0x21, 0xf5, 0x43, 0x6a, 0xd5, 0x31, 0x25, 0x72, 0xb7, 0xd5, 0x3d,
+	0xac, 0x13, 0x86, 0x0f, 0xad, 0x36, 0x76, 0xbd, 0x00, 0x33, 0x2f, 0x0c, 0x94, 0xac, 0x3e, 0x02,
+	0xc7, 0xf5, 0xca, 0xb5, 0x8d, 0x91, 0x35, 0xd6, 0x53, 0x4b, 0x25, 0x37, 0x74, 0x43, 0xf1, 0x69,
+	0xf1, 0x2f, 0x35, 0xbb, 0xe9, 0x86, 0xa1, 0xdb, 0x22, 0x16, 0x6e, 0x7b, 0x16, 0x0e, 0x82, 0x90,
+	0x09, 0x24, 0xaa, 0x56, 0x2b, 0x6a, 0x55, 0x8c, 0xea, 0x9d, 0xc7, 0x16, 0xf3, 0x7c, 0x42, 0x19,
+	0xf6, 0xdb, 0x52, 0xc0, 0x78, 0x13, 0xd6, 0xde, 0xe3, 0x6c, 0x8f, 0x1d, 0x27, 0xec, 0x04, 0xcc,
+	0x26, 0xef, 0x77, 0x08, 0x65, 0xa8, 0x0c, 0x05, 0xdc, 0x68, 0x44, 0x84, 0xd2, 0xb2, 0xb6, 0xa3,
+	0xed, 0x2f, 0xd9, 0xf1, 0xf0, 0xeb, 0x8b, 0x3f, 0xfb, 0xa4, 0x32, 0xf7, 0xaf, 0x4f, 0x2a, 0x73,
+	0x86, 0x03, 0xa5, 0xf4, 0x56, 0xda, 0x0e, 0x03, 0x4a, 0xf8, 0xde, 0x3a, 0x6e, 0xe1, 0xc0, 0x21,
+	0xf1, 0x5e, 0x35, 0x44, 0xb7, 0x61, 0xc9, 0x09, 0x1b, 0xa4, 0xd6, 0xc4, 0xb4, 0x59, 0x9e, 0x17,
+	0x6b, 0x8b, 0x7c, 0xe2, 0x6d, 0x4c, 0x9b, 0xa8, 0x04, 0xd7, 0x82, 0x90, 0x6f, 0xca, 0xed, 0x68,
+	0xfb, 0x79, 0x5b, 0x0e, 0x8c, 0x6f, 0xc2, 0x86, 0x00, 0x39, 0x15, 0xee, 0xfd, 0x2f, 0x58, 0xfe,
+	0x44, 0x03, 0x7d, 0x9c, 0x06, 0x45, 0x76, 0x17, 0x5e, 0x92, 0x27, 0x57, 0x4b, 0x6b, 0x5a, 0x91,
+	0xb3, 0xc7, 0x72, 0x12, 0xe9, 0xb0, 0x48, 0x39, 0x28, 0xe7, 0x37, 0x2f, 0xf8, 0xf5, 0xc7, 0x5c,
+	0x05, 0x96, 0x5a, 0x6b, 0x41, 0xc7, 0xaf, 0x93, 0x48, 0x59, 0xb0, 0xa2, 0x66, 0xbf, 0x23, 0x26,
+	0x8d, 0x77, 0x61, 0x53, 0xf0, 0xf8, 0x3e, 0x6e, 0x79, 0x0d, 0xcc, 0xc2, 0x68, 0xc8, 0x98, 0x3b,
+	0xb0, 0xec, 0x84, 0xc1, 0x30, 0x8f, 0x22, 0x9f, 0x3b, 0x1e, 0xb1, 0xea, 0x17, 0x1a, 0x6c, 0x65,
+	0x68, 0x53, 0x86, 0xed, 0xc1, 0xf5, 0x98, 0x55, 0x5a, 0x63, 0x4c, 0xf6, 0x4b, 0x34, 0x2d, 0x0e,
+	0xa2, 0x13, 0x79, 0xce, 0x5f, 0xe4, 0x78, 0x0e, 0xa0, 0x94, 0xde, 0x3a, 0x2d, 0x88, 0x8c, 0x77,
+	0x15, 0xd8, 0x77, 0x59, 0x18, 0x61, 0x77, 0x3a, 0x18, 0x5a, 0x85, 0xdc, 0x25, 0xb9, 0x52, 0xf1,
+	0xc6, 0x3f, 0x13, 0xf0, 0xaf, 0x41, 0x29, 0xad, 0x4c, 0xc1, 0x97, 0xe0, 0x5a, 0x17, 0xb7, 0x3a,
+	0x31, 0xb8, 0x1c, 0x18, 0x6f, 0xc0, 0xaa, 0x0a, 0xa5, 0xc6, 0x17, 0x32, 0x72, 0x0f, 0x6e, 0x24,
+	0xf6, 0x29, 0x08, 0x04, 0x79, 0x1e, 0xfb, 0x62, 0xd7, 0xb2, 0x2d, 0xbe, 0x8d, 0x0f, 0x00, 0x09,
+	0xc1, 0x8b, 0xde, 0x83, 0xd0, 0xa5, 0x31, 0x04, 0x82, 0xbc, 0xb8, 0x31, 0x52, 0xbf, 0xf8, 0x46,
+	0xf7, 0x01, 0x06, 0x79, 0x45, 0xd8, 0x56, 0x3c, 0xaa, 0x9a, 0x32, 0x68, 0x4d, 0x9e, 0x84, 0x4c,
+	0x99, 0xc2, 0x54, 0x12, 0x32, 0x1f, 0x0d, 0x5c, 0x65, 0x27, 0x76, 0x26, 0x48, 0x7e, 0xa4, 0xc1,
+	0x5a, 0x0a, 0x5c, 0xf1, 0x7c, 0x15, 0xf2, 0xad, 0xd0, 0xe5, 0xd6, 0xe5, 0xf6, 0x8b, 0x47, 0x37,
+	0xcd, 0xe1, 0x6c, 0x68, 0x3e, 0x08, 0x5d, 0x5b, 0x88, 0xa0, 0xf3, 0x31, 0xa4, 0xf6, 0xa6, 0x92,
+	0x92, 0x38, 0x49, 0x56, 0x46, 0x49, 0xf9, 0xe1, 0x11, 0x8e, 0xb0, 0x1f, 0xfb, 0xc1, 0xb0, 0x61,
+	0x2d, 0x35, 0xab, 0x08, 0x7e, 0x03, 0x16, 0xda, 0x62, 0x46, 0x38, 0xa8, 0x78, 0x54, 0x1e, 0xa5,
+	0x28, 0x77, 0x9c, 0x2c, 0x7d, 0xfa, 0xac, 0x32, 0xf7, 0xbb, 0x7f, 0xfe, 0xe1, 0xae, 0x66, 0xab,
+	0x2d, 0xc6, 0x9f, 0x35, 0x78, 0xe9, 0x8c, 0x35, 0x4f, 0x71, 0xab, 0x95, 0x70, 0x37, 0x8e, 0x5c,
+	0x1a, 0x1f, 0x0c, 0xff, 0x46, 0xb7, 0xa0, 0xe0, 0x62, 0x5a, 0x73, 0x70, 0x5b, 0xdd, 0x91, 0x05,
+	0x17, 0xd3, 0x53, 0xdc, 0x46, 0x3f, 0x84, 0xd5, 0x76, 0x14, 0xb6, 0x43, 0x4a, 0xa2, 0xfe, 0x3d,
+	0xe3, 0x77, 0x64, 0xf9, 0xe4, 0xe8, 0xdf, 0xcf, 0x2a, 0xa6, 0xeb, 0xb1, 0x66, 0xa7, 0x6e, 0x3a,
+	0xa1, 0x6f, 0xa9, 0x07, 0x42, 0xfe, 0xdd, 0xa3, 0x8d, 0x4b, 0x8b, 0x5d, 0xb5, 0x09, 0x35, 0x4f,
+	0x07, 0x17, 0xdc, 0xbe, 0x1e, 0xeb, 0x8a, 0x2f, 0xe7, 0x06, 0x2c, 0x3a, 0x4d, 0xec, 0x05, 0x35,
+	0xaf, 0x51, 0xce, 0xef, 0x68, 0xfb, 0x39, 0xbb, 0x20, 0xc6, 0xef, 0x34, 0xd0, 0x26, 0x2c, 0x85,
+	0x5d, 0x12, 0x45, 0x5e, 0x83, 0xd0, 0xf2, 0x35, 0xc1, 0x75, 0x30, 0x61, 0x5c, 0xc0, 0xda, 0x19,
+	0x65, 0x9e, 0x8f, 0x19, 0x39, 0xc7, 0x03, 0x5f, 0xad, 0x42, 0xce, 0xc5, 0xd2, 0xb4, 0xbc, 0xcd,
+	0x3f, 0xf9, 0x4c, 0x44, 0x98, 0xb0, 0x6a, 0xd9, 0xe6, 0x9f, 0x1c, 0xb3, 0xeb, 0xd7, 0x48, 0x14,
+	0x85, 0xf2, 0xba, 0x2f, 0xd9, 0x85, 0xae, 0x7f, 0xc6, 0x87, 0xc6, 0xaf, 0x35, 0x28, 0x9f, 0x46,
+	0x04, 0x33, 0x72, 0xec, 0x38, 0x84, 0xd2, 0x07, 0x1e, 0x1d, 0x64, 0x9c, 0x6f, 0x41, 0x11, 0x8b,
+	0xd9, 0x5a, 0xcb, 0xa3, 0x4c, 0xc5, 0xcb, 0xd6, 0xe8, 0x61, 0xc8, 0xad, 0x17, 0x9d, 0x76, 0x8b,
+	0x9c, 0xe4, 0xf9, 0x89, 0xd8, 0x80, 0xfb, 0xda, 0x38, 0x3a, 0xf7, 0x74, 0x87, 0x92, 0x86, 0x72,
+	0x35, 0xf7, 0xfc, 0xf7, 0x28, 0x69, 0x4c, 0x22, 0xf6, 0x51, 0x3e, 0x0e, 0xde, 0x08, 0x3b, 0xe4,
+	0xa2, 0x17, 0x9f, 0xe5, 0x21, 0xe4, 0x7c, 0xea, 0xaa, 0xc0, 0xa8, 0x8c, 0x72, 0x79, 0x48, 0xdd,
+	0x33, 0x3e, 0x47, 0x3a, 0xfe, 0x45, 0xcf, 0xe6, 0xb2, 0xe8, 0x2d, 0x58, 0x66, 0x5c, 0x49, 0xcd,
+	0x09, 0x83, 0xc7, 0x9e, 0x2b, 0x90, 0xc6, 0xda, 0x21, 0xa0, 0x4e, 0x85, 0x90, 0x5d, 0x64, 0x83,
+	0x01, 0x3a, 0x85, 0xe5, 0x76, 0x44, 0x1a, 0x84, 0xdb, 0x14, 0x46, 0xb4, 0x9c, 0xdf, 0xc9, 0xcd,
+	0x82, 0x9e, 0xda, 0xc4, 0x9f, 0x83, 0x7a, 0x2b, 0x74, 0x2e, 0xe3, 0xc4, 0x7b, 0x4d, 0x9c, 0x7e,
+	0x51, 0xcc, 0xc9, 0xb4, 0x8b, 0xb6, 0x00, 0xa4, 0x88, 0xc8, 0x0e, 0x0b, 0xc2, 0x23, 0x4b, 0x62,
+	0x46, 0x3c, 0xa8, 0x6f, 0xc7, 0xcb, 0xfc, 0xcd, 0x2f, 0x17, 0x84, 0x19, 0xba, 0x29, 0x0b, 0x02,
+	0x33, 0x2e, 0x08, 0xcc, 0x8b, 0xb8, 0x20, 0x38, 0x59, 0xe1, 0x67, 0xf1, 0xf1, 0xdf, 0x2a, 0x9a,
+	0xbc, 0x21, 0x52, 0x13, 0x5f, 0x1e, 0x1b, 0xe4, 0x8b, 0xff, 0x9b, 0x20, 0x5f, 0x4a, 0x07, 0xb9,
+	0x01, 0x2b, 0xd2, 0x06, 0x1f, 0xf7, 0x6a, 0x3c, 0x72, 0x21, 0xe1, 0x86, 0x87, 0xb8, 0x77, 0x8e,
+	0xe9, 0xb7, 0xf3, 0x8b, 0xf3, 0xab, 0x39, 0x7b, 0x91, 0xf5, 0x6a, 0x5e, 0xd0, 0x20, 0x3d, 0xe3,
+	0xae, 0xca, 0xe9, 0xfd, 0x50, 0x18, 0x24, 0xdc, 0x06, 0x66, 0x38, 0xbe, 0xd7, 0xfc, 0xdb, 0xf8,
+	0x4b, 0x0e, 0xd6, 0x07, 0xc2, 0x27, 0x5c, 0x6b, 0x22, 0x74, 0x58, 0x2f, 0x4e, 0x7b, 0xd3, 0x43,
+	0x87, 0xf5, 0xe8, 0x97, 0x10, 0x3a, 0xff, 0x3f, 0xf5, 0x19, 0x4f, 0x1d, 0xed, 0x00, 0x2f, 0x8d,
+	0x9c, 0x4e, 0x14, 0x91, 0xc0, 0xb9, 0x2a, 0x17, 0x77, 0xb4, 0xfd, 0x15, 0x3b, 0x39, 0x65, 0xdc,
+	0x83, 0x5b, 0x23, 0x47, 0x3b, 0x21, 0x14, 0x6e, 0xf6, 0x8b, 0x18, 0x4a, 0xee, 0x93, 0xf8, 0xb1,
+	0x34, 0x1e, 0x40, 0x29, 0x3d, 0xad, 0x54, 0x7c, 0x0d, 0x16, 0xf9, 0x8b, 0x56, 0x7b, 0x4c, 0x54,
+	0x91, 0x70, 0xb2, 0xf1, 0xd7, 0x67, 0x95, 0x9b, 0xd2, 0x07, 0xb4, 0x71, 0x69, 0x7a, 0xa1, 0xe5,
+	0x63, 0xd6, 0x34, 0xdf, 0x09, 0x18, 0x2f, 0x5e, 0xc4, 0x6e, 0xa3, 0xa2, 0xca, 0xb6, 0xf3, 0x56,
+	0x58, 0xc7, 0xad, 0x87, 0x5e, 0x70, 0x8e, 0xe9, 0xa3, 0xc8, 0xeb, 0xd7, 0x4c, 0x86, 0x03, 0xdb,
+	0x59, 0x02, 0x0a, 0xf8, 0x18, 0x56, 0x7c, 0x2f, 0xe0, 0x6e, 0xa9, 0xb5, 0xf9, 0x82, 0x42, 0xdf,
+	0xe2, 0xe7, 0x98, 0xcd, 0xa0, 0xe8, 0x0f, 0x54, 0xf5, 0x9f, 0x57, 0x15, 0x81, 0x7d, 0x4b, 0xd7,
+	0x52, 0xb3, 0x0a, 0xef, 0x75, 0x58, 0x50, 0xe1, 0xac, 0x65, 0x85, 0xf3, 0x29, 0x3f, 0x37, 0xb5,
+	0x4d, 0x09, 0x1b, 0xa6, 0xd2, 0x66, 0x13, 0x87, 0x78, 0xed, 0x7e, 0x95, 0x7b, 0x0b, 0x0a, 0xac,
+	0x57, 0x4b, 0x94, 0x33, 0x0b, 0xac, 0xc7, 0xe3, 0xd6, 0x78, 0x0f, 0x4a, 0x69, 0x79, 0x05, 0xff,
+	0x26, 0x14, 0x22, 0x39, 0xa5, 0xf0, 0x37, 0x46, 0xf1, 0xd5, 0x1e, 0xf5, 0x9a, 0xc4, 0xf2, 0xc6,
+	0xaf, 0x34, 0xb8, 0x2d, 0xab, 0xbb, 0x4e, 0x9d, 0x3a, 0x91, 0x57, 0x27, 0x67, 0x5d, 0x12, 0xb0,
+	0x7e, 0x5d, 0xb5, 0x09, 0x4b, 0x2a, 0xae, 0x89, 0xbc, 0xe7, 0x4b, 0xf6, 0x60, 0x82, 0x97, 0x15,
+	0x2c, 0x6c, 0x7b, 0x0e, 0x2d, 0xcf, 0x67, 0xbd, 0x64, 0x17, 0x7c, 0xfd, 0xbe, 0xd7, 0x62, 0x24,
+	0x52, 0xd8, 0x6a, 0x0b, 0x2f, 0xaa, 0x15, 0x0b, 0x59, 0x0e, 0x2c, 0xda, 0xfd, 0xb1, 0xb1, 0x0b,
+	0xc5, 0xc4, 0x46, 0xb4, 0xde, 0xc7, 0x91, 0x14, 0xd4, 0xc8, 0xf8, 0xa3, 0x06, 0x9b, 0xe3, 0xd9,
+	0x2b, 0xcf, 0xac, 0xc3, 0x42, 0x93, 0x78, 0x6e, 0x53, 0x3a, 0x26, 0x67, 0xab, 0xd1, 0x50, 0x82,
+	0x98, 0x1f, 0x4e, 0x10, 0x71, 0x3d, 0x97, 0x9b, 0x5e, 0xcf, 0xbd, 0x9e, 0xb0, 0x42, 0x3e, 0x62,
+	0xd9, 0xce, 0x1f, 0x18, 0x78, 0xf4, 0xf9, 0x0d, 0xb8, 0x26, 0x98, 0xa3, 0x1f, 0x69, 0x50, 0x50,
+	0x8d, 0x09, 0xda, 0x1d, 0xdd, 0x3a, 0xa6, 0xf3, 0xd4, 0xab, 0xd3, 0xc4, 0xa4, 0xf5, 0xc6, 0xde,
+	0x87, 0x7f, 0xfa, 0xc7, 0x2f, 0xe7, 0xef, 0xa0, 0x0a, 0xef, 0x93, 0x43, 0x1a, 0x77, 0xcb, 0xaa,
+	0x31, 0xb1, 0x9e, 0xa8, 0x83, 0x7c, 0x8a, 0x7e, 0xa3, 0xc1, 0x4a, 0xaa, 0xf7, 0x43, 0x5f, 0xc9,
+	0x80, 0x18, 0xd7, 0x63, 0xea, 0xaf, 0xcd, 0x26, 0xac, 0x58, 0x99, 0x82, 0xd5, 0x3e, 0xaa, 0xa6,
+	0x59, 0xc5, 0x2d, 0xe6, 0x08, 0xb9, 0xdf, 0x6b, 0xb0, 0x3a, 0xdc, 0xc2, 0x21, 0x33, 0x03, 0x32,
+	0xa3, 0x73, 0xd4, 0xad, 0x99, 0xe5, 0x15, 0xcb, 0x37, 0x04, 0xcb, 0x03, 0x64, 0xa6, 0x59, 0x76,
+	0x63, 0xf9, 0x01, 0xd1, 0x64, 0x47, 0xfa, 0x14, 0x7d, 0xa8, 0x41, 0x41, 0x35, 0x6a, 0x99, 0xc7,
+	0x99, 0xee, 0x01, 0xf5, 0xea, 0x34, 0x31, 0x45, 0x69, 0x5f, 0x50, 0x32, 0xd0, 0x4e, 0x9a, 0x92,
+	0x6a, 0xfa, 0x68, 0xc2, 0x65, 0x3f, 0xd5, 0xa0, 0xa0, 0xda, 0xb5, 0x4c, 0x12, 0xe9, 0xde, 0x50,
+	0xaf, 0x4e, 0x13, 0x53, 0x24, 0xee, 0x09, 0x12, 0x7b, 0x68, 0x37, 0x4d, 0x82, 0x4a, 0xb1, 0x01,
+	0x07, 0xeb, 0xc9, 0x25, 0xb9, 0x7a, 0x8a, 0xba, 0x90, 0xe7, 0x1d, 0x1d, 0x32, 0x32, 0x43, 0xa4,
+	0xdf, 0x26, 0xea, 0x2f, 0x4f, 0x94, 0x51, 0xf8, 0xbb, 0x02, 0xbf, 0x82, 0xb6, 0x86, 0xa3, 0xa7,
+	0x91, 0xf2, 0x00, 0x85, 0x05, 0xd9, 0xd0, 0xa0, 0x57, 0x32, 0xb4, 0xa6, 0xfa, 0x26, 0x7d, 0x77,
+	0x8a, 0x94, 0x42, 0xdf, 0x14, 0xe8, 0xeb, 0xa8, 0x94, 0x46, 0x97, 0x8d, 0x12, 0x62, 0x50, 0x50,
+	0x7d, 0x12, 0xda, 0x19, 0xd5, 0x97, 0x6e, 0xa1, 0xf4, 0xbd, 0x69, 0xe5, 0x52, 0x8c, 0xb9, 0x2d,
+	0x30, 0xcb, 0x68, 0x3d, 0x8d, 0x49, 0x58, 0xb3, 0xe6, 0x70, 0xa8, 0x0f, 0xa0, 0x98, 0x68, 0x63,
+	0x66, 0x40, 0x1e, 0x63, 0xeb, 0x98, 0x3e, 0xc8, 0x30, 0x04, 0xee, 0x26, 0xd2, 0x87, 0x70, 0x95,
+	0x28, 0x7f, 0x5d, 0xd1, 0xcf, 0x35, 0x58, 0x1d, 0x6e, 0x76, 0x66, 0x60, 0x70, 0x77, 0xcc, 0xfb,
+	0x98, 0xd1, 0x32, 0x65, 0x45, 0xbd, 0x23, 0xe4, 0x6b, 0x89, 0x6e, 0x0a, 0xf5, 0xa0, 0xa0, 0xea,
+	0xd9, 0xcc, 0xa0, 0x4f, 0xb7, 0x3e, 0x7a, 0x75, 0x9a, 0xd8, 0xe4, 0x23, 0x90, 0x85, 0x2c, 0xeb,
+	0xa1, 0x1f, 0x6b, 0x00, 0x83, 0x12, 0x0a, 0xed, 0x4f, 0x52, 0x9b, 0x2c, 0xa0, 0xf5, 0x57, 0x67,
+	0x90, 0x54, 0x1c, 0xee, 0x08, 0x0e, 0xb7, 0xd1, 0xc6, 0x38, 0x0e, 0xe2, 0xe9, 0xe2, 0x0e, 0x50,
+	0x25, 0xd8, 0x84, 0xd4, 0x93, 0xac, 0xdc, 0xf4, 0xea, 0x34, 0xb1, 0xc9, 0x0e, 0x88, 0xab, 0x3b,
+	0xf4, 0x5b, 0x0d, 0x6e, 0x8c, 0x94, 0x63, 0x28, 0x2b, 0xe9, 0x66, 0x55, 0x76, 0xfa, 0xc1, 0xec,
+	0x1b, 0x14, 0xb1, 0x97, 0x05, 0xb1, 0x2d, 0x74, 0x3b, 0x4d, 0x2c, 0x55, 0xfd, 0xf1, 0x64, 0xa0,
+	0x7a, 0x87, 0x57, 0x32, 0x53, 0x4c, 0xa2, 0xca, 0xd3, 0x77, 0xa7, 0x48, 0x4d, 0x4e, 0x06, 0xb2,
+	0xb8, 0x13, 0x0f, 0x81, 0x7a, 0xf7, 0x33, 0x4f, 0x23, 0x5d, 0xf8, 0xe9, 0xd5, 0x69, 0x62, 0x93,
+	0xaf, 0x44, 0x5c, 0x5c, 0x58, 0x4f, 0x54, 0xf9, 0xf8, 0x14, 0x45, 0x70, 0x7d, 0xa8, 0x34, 0x42,
+	0xf7, 0xb2, 0x12, 0xfd, 0xd8, 0x02, 0x50, 0x37, 0x67, 0x15, 0x97, 0xdc, 0x0e, 0xb4, 0x93, 0xb7,
+	0x3e, 0x7d, 0xbe, 0xad, 0x7d, 0xf6, 0x7c, 0x5b, 0xfb, 0xfb, 0xf3, 0x6d, 0xed, 0xe3, 0x17, 0xdb,
+	0x73, 0x9f, 0xbd, 0xd8, 0x9e, 0xfb, 0xfc, 0xc5, 0xf6, 0xdc, 0x0f, 0xaa, 0x89, 0x7e, 0xa8, 0xcf,
+	0x3c, 0xa4, 0x56, 0xf7, 0xe8, 0xc0, 0xea, 0x09, 0x2b, 0x44, 0x4f, 0x54, 0x5f, 0x10, 0x3d, 0xd8,
+	0x57, 0xff, 0x33, 0x00, 0x53, 0xd3, 0xaf, 0xf4, 0x74, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1654,6 +2048,8 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
@@ -1668,6 +2064,12 @@ type QueryClient interface {
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
 	// Config queries the EVM configuration
 	Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error)
+	// Receipt queries the receipt of a delivered ethereum tx by its hash.
+	Receipt(ctx context.Context, in *QueryReceiptRequest, opts ...grpc.CallOption) (*QueryReceiptResponse, error)
+	// SubscribeEvents streams the receipts and the decoded logs of the ethereum
+	// txs of the committed blocks that match the given filter. It's served by the
+	// nodes that enable the EVM event stream.
+	SubscribeEvents(ctx context.Context, in *QuerySubscribeEventsRequest, opts ...grpc.CallOption) (Query_SubscribeEventsClient, error)
 }
 
 type queryClient struct {
@@ -1759,6 +2161,15 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error) {
+	out := new(CreateAccessListResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/CreateAccessList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceTx", in, out, opts...)
@@ -1804,6 +2215,47 @@ func (c *queryClient) Config(ctx context.Context, in *QueryConfigRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Receipt(ctx context.Context, in *QueryReceiptRequest, opts ...grpc.CallOption) (*QueryReceiptResponse, error) {
+	out := new(QueryReceiptResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Receipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubscribeEvents(ctx context.Context, in *QuerySubscribeEventsRequest, opts ...grpc.CallOption) (Query_SubscribeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/ethermint.evm.v1.Query/SubscribeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &querySubscribeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_SubscribeEventsClient interface {
+	Recv() (*QuerySubscribeEventsResponse, error)
+	grpc.ClientStream
+}
+
+type querySubscribeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *querySubscribeEventsClient) Recv() (*QuerySubscribeEventsResponse, error) {
+	m := new(QuerySubscribeEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account queries an Ethereum account.
@@ -1826,6 +2278,8 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(context.Context, *EthCallRequest) (*CreateAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
@@ -1840,6 +2294,12 @@ type QueryServer interface {
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
 	// Config queries the EVM configuration
 	Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error)
+	// Receipt queries the receipt of a delivered ethereum tx by its hash.
+	Receipt(context.Context, *QueryReceiptRequest) (*QueryReceiptResponse, error)
+	// SubscribeEvents streams the receipts and the decoded logs of the ethereum
+	// txs of the committed blocks that match the given filter. It's served by the
+	// nodes that enable the EVM event stream.
+	SubscribeEvents(*QuerySubscribeEventsRequest, Query_SubscribeEventsServer) error
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1873,6 +2333,9 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) CreateAccessList(ctx context.Context, req *EthCallRequest) (*CreateAccessListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessList not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
@@ -1888,6 +2351,12 @@ func (*UnimplementedQueryServer) GlobalMinGasPrice(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryConfigRequest) (*QueryConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
 }
+func (*UnimplementedQueryServer) Receipt(ctx context.Context, req *QueryReceiptRequest) (*QueryReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Receipt not implemented")
+}
+func (*UnimplementedQueryServer) SubscribeEvents(req *QuerySubscribeEventsRequest, srv Query_SubscribeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2055,7 +2524,25 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Query_CreateAccessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreateAccessList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/CreateAccessList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreateAccessList(ctx, req.(*EthCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -2145,6 +2632,45 @@ func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Receipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Receipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/Receipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Receipt(ctx, req.(*QueryReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QuerySubscribeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).SubscribeEvents(m, &querySubscribeEventsServer{stream})
+}
+
+type Query_SubscribeEventsServer interface {
+	Send(*QuerySubscribeEventsResponse) error
+	grpc.ServerStream
+}
+
+type querySubscribeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *querySubscribeEventsServer) Send(m *QuerySubscribeEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2185,6 +2711,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "CreateAccessList",
+			Handler:    _Query_CreateAccessList_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -2205,8 +2735,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Config",
 			Handler:    _Query_Config_Handler,
 		},
+		{
+			MethodName: "Receipt",
+			Handler:    _Query_Receipt_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _Query_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/query.proto",
 }
 
@@ -2776,6 +3316,13 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2845,6 +3392,55 @@ func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CreateAccessListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateAccessListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateAccessListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2993,6 +3589,11 @@ func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Concurrency != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Concurrency))
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.BlockMaxGas != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
 		i--
@@ -3261,105 +3862,319 @@ func (m *QueryConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryReceiptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryReceiptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryReceiptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCosmosAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReceiptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCosmosAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySubscribeEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubscribeEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubscribeEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CosmosAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Receipts {
+		i--
+		if m.Receipts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovQuery(uint64(m.AccountNumber))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TopicFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySubscribeEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubscribeEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubscribeEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AccountAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryCosmosAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCosmosAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if m.Sequence != 0 {
 		n += 1 + sovQuery(uint64(m.Sequence))
 	}
@@ -3527,6 +4342,10 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3550,6 +4369,28 @@ func (m *EstimateGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *CreateAccessListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTraceTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3640,6 +4481,9 @@ func (m *QueryTraceBlockRequest) Size() (n int) {
 	if m.BlockMaxGas != 0 {
 		n += 1 + sovQuery(uint64(m.BlockMaxGas))
 	}
+	if m.Concurrency != 0 {
+		n += 1 + sovQuery(uint64(m.Concurrency))
+	}
 	return n
 }
 
@@ -3720,6 +4564,97 @@ func (m *QueryConfigResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryReceiptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReceiptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Receipt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySubscribeEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Receipts {
+		n += 2
+	}
+	return n
+}
+
+func (m *TopicFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySubscribeEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5377,17 +6312,51 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -5533,6 +6502,141 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CreateAccessListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateAccessListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateAccessListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, AccessTuple{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6184,6 +7288,25 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Concurrency |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6695,6 +7818,558 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryReceiptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReceiptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReceiptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReceiptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReceiptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubscribeEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubscribeEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubscribeEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, TopicFilter{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Receipts = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubscribeEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubscribeEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubscribeEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, &Receipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,464 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"errors"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v20/types"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
+)
+
+const (
+	// SetCodeTxType is the EIP-2718 type of the EIP-7702 set code transactions
+	SetCodeTxType = ethutils.SetCodeTxType
+
+	// PerEmptyAccountCost is the intrinsic gas charged for each authorization
+	// of a set code transaction
+	PerEmptyAccountCost uint64 = 25000
+	// PerAuthBaseCost is the gas cost of an authorization of an account that
+	// already exists, the difference with PerEmptyAccountCost is refunded
+	PerAuthBaseCost uint64 = 12500
+)
+
+// NewSetCodeTx returns the tx data of the RLP decoded set code transaction.
+func NewSetCodeTx(tx *ethutils.SetCodeTx) (*SetCodeTx, error) {
+	txData := &SetCodeTx{
+		Nonce:    tx.Nonce,
+		Data:     common.CopyBytes(tx.Data),
+		GasLimit: tx.Gas,
+		To:       tx.To.Hex(),
+	}
+
+	if tx.Value != nil {
+		amountInt, err := types.SafeNewIntFromBigInt(tx.Value)
+		if err != nil {
+			return nil, err
+		}
+		txData.Amount = &amountInt
+	}
+
+	if tx.GasFeeCap != nil {
+		gasFeeCapInt, err := types.SafeNewIntFromBigInt(tx.GasFeeCap)
+		if err != nil {
+			return nil, err
+		}
+		txData.GasFeeCap = &gasFeeCapInt
+	}
+
+	if tx.GasTipCap != nil {
+		gasTipCapInt, err := types.SafeNewIntFromBigInt(tx.GasTipCap)
+		if err != nil {
+			return nil, err
+		}
+		txData.GasTipCap = &gasTipCapInt
+	}
+
+	if tx.AccessList != nil {
+		txData.Accesses = NewAccessList(&tx.AccessList)
+	}
+
+	txData.Authorizations = make([]SetCodeAuthorization, len(tx.AuthList))
+	for i, auth := range tx.AuthList {
+		chainID, err := types.SafeNewIntFromBigInt(auth.ChainID)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid chain id of authorization %d", i)
+		}
+		txData.Authorizations[i] = SetCodeAuthorization{
+			ChainID: chainID,
+			Address: auth.Address.Hex(),
+			Nonce:   auth.Nonce,
+			V:       bigBytes(auth.V),
+			R:       bigBytes(auth.R),
+			S:       bigBytes(auth.S),
+		}
+	}
+
+	txData.SetSignatureValues(tx.ChainID, tx.V, tx.R, tx.S)
+	return txData, nil
+}
+
+// TxType returns the tx type
+func (tx *SetCodeTx) TxType() uint8 {
+	return SetCodeTxType
+}
+
+// Copy returns an instance with the same field values
+func (tx *SetCodeTx) Copy() TxData {
+	authorizations := make([]SetCodeAuthorization, len(tx.Authorizations))
+	copy(authorizations, tx.Authorizations)
+
+	return &SetCodeTx{
+		ChainID:        tx.ChainID,
+		Nonce:          tx.Nonce,
+		GasTipCap:      tx.GasTipCap,
+		GasFeeCap:      tx.GasFeeCap,
+		GasLimit:       tx.GasLimit,
+		To:             tx.To,
+		Amount:         tx.Amount,
+		Data:           common.CopyBytes(tx.Data),
+		Accesses:       tx.Accesses,
+		Authorizations: authorizations,
+		V:              common.CopyBytes(tx.V),
+		R:              common.CopyBytes(tx.R),
+		S:              common.CopyBytes(tx.S),
+	}
+}
+
+// GetChainID returns the chain id field from the SetCodeTx
+func (tx *SetCodeTx) GetChainID() *big.Int {
+	if tx.ChainID == nil {
+		return nil
+	}
+
+	return tx.ChainID.BigInt()
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *SetCodeTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	return *tx.Accesses.ToEthAccessList()
+}
+
+// GetData returns the a copy of the input data bytes.
+func (tx *SetCodeTx) GetData() []byte {
+	return common.CopyBytes(tx.Data)
+}
+
+// GetGas returns the gas limit.
+func (tx *SetCodeTx) GetGas() uint64 {
+	return tx.GasLimit
+}
+
+// GetGasPrice returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasPrice() *big.Int {
+	return tx.GetGasFeeCap()
+}
+
+// GetGasTipCap returns the gas tip cap field.
+func (tx *SetCodeTx) GetGasTipCap() *big.Int {
+	if tx.GasTipCap == nil {
+		return nil
+	}
+	return tx.GasTipCap.BigInt()
+}
+
+// GetGasFeeCap returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasFeeCap() *big.Int {
+	if tx.GasFeeCap == nil {
+		return nil
+	}
+	return tx.GasFeeCap.BigInt()
+}
+
+// GetValue returns the tx amount.
+func (tx *SetCodeTx) GetValue() *big.Int {
+	if tx.Amount == nil {
+		return nil
+	}
+
+	return tx.Amount.BigInt()
+}
+
+// GetNonce returns the account sequence for the transaction.
+func (tx *SetCodeTx) GetNonce() uint64 { return tx.Nonce }
+
+// GetTo returns the pointer to the recipient address.
+func (tx *SetCodeTx) GetTo() *common.Address {
+	if tx.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(tx.To)
+	return &to
+}
+
+// GetAuthList returns the authorizations of the transaction.
+func (tx *SetCodeTx) GetAuthList() []ethutils.SetCodeAuthorization {
+	authList := make([]ethutils.SetCodeAuthorization, len(tx.Authorizations))
+	for i, auth := range tx.Authorizations {
+		authList[i] = auth.AsEthereumData()
+	}
+	return authList
+}
+
+// AsEthereumData returns the execution view of the set code transaction, a
+// DynamicFeeTx with the same fields but the authorizations. The go-ethereum
+// transaction types don't support EIP-7702, so the hash and the sender of the
+// set code transactions are computed from AsSetCodeTx instead.
+func (tx *SetCodeTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	return &ethtypes.DynamicFeeTx{
+		ChainID:    tx.GetChainID(),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  tx.GetGasTipCap(),
+		GasFeeCap:  tx.GetGasFeeCap(),
+		Gas:        tx.GetGas(),
+		To:         tx.GetTo(),
+		Value:      tx.GetValue(),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		V:          v,
+		R:          r,
+		S:          s,
+	}
+}
+
+// AsSetCodeTx returns the RLP representation of the set code transaction.
+func (tx *SetCodeTx) AsSetCodeTx() *ethutils.SetCodeTx {
+	v, r, s := tx.GetRawSignatureValues()
+	setCodeTx := &ethutils.SetCodeTx{
+		ChainID:    tx.GetChainID(),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  tx.GetGasTipCap(),
+		GasFeeCap:  tx.GetGasFeeCap(),
+		Gas:        tx.GetGas(),
+		Value:      tx.GetValue(),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		AuthList:   tx.GetAuthList(),
+		V:          v,
+		R:          r,
+		S:          s,
+	}
+	if to := tx.GetTo(); to != nil {
+		setCodeTx.To = *to
+	}
+	return setCodeTx
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *SetCodeTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return ethutils.RawSignatureValues(tx.V, tx.R, tx.S)
+}
+
+// SetSignatureValues sets the signature values to the transaction.
+func (tx *SetCodeTx) SetSignatureValues(chainID, v, r, s *big.Int) {
+	if v != nil {
+		tx.V = v.Bytes()
+	}
+	if r != nil {
+		tx.R = r.Bytes()
+	}
+	if s != nil {
+		tx.S = s.Bytes()
+	}
+	if chainID != nil {
+		chainIDInt := sdkmath.NewIntFromBigInt(chainID)
+		tx.ChainID = &chainIDInt
+	}
+}
+
+// Validate performs a stateless validation of the tx fields.
+func (tx SetCodeTx) Validate() error {
+	if tx.GasTipCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas tip cap cannot nil")
+	}
+
+	if tx.GasFeeCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas fee cap cannot nil")
+	}
+
+	if tx.GasTipCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas tip cap cannot be negative %s", tx.GasTipCap)
+	}
+
+	if tx.GasFeeCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas fee cap cannot be negative %s", tx.GasFeeCap)
+	}
+
+	if !types.IsValidInt256(tx.GetGasTipCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if !types.IsValidInt256(tx.GetGasFeeCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if tx.GasFeeCap.LT(*tx.GasTipCap) {
+		return errorsmod.Wrapf(
+			ErrInvalidGasCap, "max priority fee per gas higher than max fee per gas (%s > %s)",
+			tx.GasTipCap, tx.GasFeeCap,
+		)
+	}
+
+	if !types.IsValidInt256(tx.Fee()) {
+		return errorsmod.Wrap(ErrInvalidGasFee, "out of bound")
+	}
+
+	amount := tx.GetValue()
+	// Amount can be 0
+	if amount != nil && amount.Sign() == -1 {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount cannot be negative %s", amount)
+	}
+	if !types.IsValidInt256(amount) {
+		return errorsmod.Wrap(ErrInvalidAmount, "out of bound")
+	}
+
+	// set code transactions can't create contracts
+	if err := types.ValidateAddress(tx.To); err != nil {
+		return errorsmod.Wrap(err, "invalid to address")
+	}
+
+	if tx.GetChainID() == nil {
+		return errorsmod.Wrap(
+			errortypes.ErrInvalidChainID,
+			"chain ID must be present on SetCode txs",
+		)
+	}
+
+	if len(tx.Authorizations) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "set code tx must have at least one authorization")
+	}
+
+	for i, auth := range tx.Authorizations {
+		if err := auth.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid authorization %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Fee returns gasprice * gaslimit.
+func (tx SetCodeTx) Fee() *big.Int {
+	return fee(tx.GetGasFeeCap(), tx.GetGas())
+}
+
+// Cost returns amount + gasprice * gaslimit.
+func (tx SetCodeTx) Cost() *big.Int {
+	return cost(tx.Fee(), tx.GetValue())
+}
+
+// EffectiveGasPrice returns the effective gas price
+func (tx *SetCodeTx) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return EffectiveGasPrice(baseFee, tx.GasFeeCap.BigInt(), tx.GasTipCap.BigInt())
+}
+
+// EffectiveFee returns effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveFee(baseFee *big.Int) *big.Int {
+	return fee(tx.EffectiveGasPrice(baseFee), tx.GetGas())
+}
+
+// EffectiveCost returns amount + effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveCost(baseFee *big.Int) *big.Int {
+	return cost(tx.EffectiveFee(baseFee), tx.GetValue())
+}
+
+// AuthorizationGas returns the intrinsic gas of the authorizations.
+func (tx SetCodeTx) AuthorizationGas() uint64 {
+	return uint64(len(tx.Authorizations)) * PerEmptyAccountCost
+}
+
+// AsEthereumData returns the RLP representation of the authorization.
+func (auth SetCodeAuthorization) AsEthereumData() ethutils.SetCodeAuthorization {
+	v, r, s := ethutils.RawSignatureValues(auth.V, auth.R, auth.S)
+	return ethutils.SetCodeAuthorization{
+		ChainID: auth.ChainID.BigInt(),
+		Address: common.HexToAddress(auth.Address),
+		Nonce:   auth.Nonce,
+		V:       v,
+		R:       r,
+		S:       s,
+	}
+}
+
+// Validate performs a stateless validation of the authorization fields. The
+// signature is only checked when the authorization is applied, as invalid
+// authorizations are skipped rather than failing the transaction.
+func (auth SetCodeAuthorization) Validate() error {
+	if auth.ChainID.IsNil() || auth.ChainID.IsNegative() || !types.IsValidInt256(auth.ChainID.BigInt()) {
+		return errorsmod.Wrap(errortypes.ErrInvalidChainID, "invalid authorization chain ID")
+	}
+
+	if err := types.ValidateAddress(auth.Address); err != nil {
+		return errorsmod.Wrap(err, "invalid delegation address")
+	}
+
+	if len(auth.R) == 0 || len(auth.S) == 0 {
+		return errors.New("missing authorization signature")
+	}
+
+	return nil
+}
+
+// bigBytes returns the big-endian bytes of the value, nil if it isn't set.
+func bigBytes(value *big.Int) []byte {
+	if value == nil {
+		return nil
+	}
+	return value.Bytes()
+}
+
+// SetCodeMessage is the core message of a set code transaction, it carries the
+// authorizations of the transaction to the state transition.
+type SetCodeMessage struct {
+	ethtypes.Message
+
+	AuthList []ethutils.SetCodeAuthorization
+}
+
+// AsMessage returns the core message of the set code transaction, the sender
+// is recovered from the EIP-7702 signature of the transaction.
+func (tx *SetCodeTx) AsMessage(baseFee *big.Int) (SetCodeMessage, error) {
+	from, err := tx.AsSetCodeTx().Sender()
+	if err != nil {
+		return SetCodeMessage{}, err
+	}
+
+	value := tx.GetValue()
+	if value == nil {
+		value = new(big.Int)
+	}
+
+	gasPrice := tx.GetGasFeeCap()
+	if baseFee != nil {
+		gasPrice = tx.EffectiveGasPrice(baseFee)
+	}
+
+	msg := ethtypes.NewMessage(
+		from,
+		tx.GetTo(),
+		tx.GetNonce(),
+		value,
+		tx.GetGas(),
+		gasPrice,
+		tx.GetGasFeeCap(),
+		tx.GetGasTipCap(),
+		tx.GetData(),
+		tx.GetAccessList(),
+		false,
+	)
+	return SetCodeMessage{Message: msg, AuthList: tx.GetAuthList()}, nil
+}
+
+// Sender returns the signer of the set code transaction, the transaction must
+// be signed for the given chain ID.
+func (tx *SetCodeTx) Sender(chainID *big.Int) (common.Address, error) {
+	if txChainID := tx.GetChainID(); chainID != nil && (txChainID == nil || txChainID.Cmp(chainID) != 0) {
+		return common.Address{}, errorsmod.Wrapf(
+			ethtypes.ErrInvalidChainId,
+			"have %s want %s", txChainID, chainID,
+		)
+	}
+	return tx.AsSetCodeTx().Sender()
+}
+
+// TxHash returns the ethereum hash of the transaction data, computed from the
+// EIP-7702 encoding for set code transactions.
+func TxHash(txData TxData) common.Hash {
+	if setCodeTx, ok := txData.(*SetCodeTx); ok {
+		return setCodeTx.AsSetCodeTx().Hash()
+	}
+	return ethtypes.NewTx(txData.AsEthereumData()).Hash()
+}
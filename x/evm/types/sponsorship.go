@@ -0,0 +1,107 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	// SponsorshipDomainName is the name of the EIP-712 domain of the fee sponsorships
+	SponsorshipDomainName = "Evmos Fee Sponsorship"
+	// SponsorshipDomainVersion is the version of the EIP-712 domain of the fee sponsorships
+	SponsorshipDomainVersion = "1"
+)
+
+// SponsorshipTypedData returns the EIP-712 typed data that the fee payer signs
+// to sponsor the ethereum transaction with the given hash.
+func SponsorshipTypedData(chainID *big.Int, txHash common.Hash) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"FeeSponsorship": {
+				{Name: "txHash", Type: "bytes32"},
+			},
+		},
+		PrimaryType: "FeeSponsorship",
+		Domain: apitypes.TypedDataDomain{
+			Name:    SponsorshipDomainName,
+			Version: SponsorshipDomainVersion,
+			ChainId: (*math.HexOrDecimal256)(chainID),
+		},
+		Message: apitypes.TypedDataMessage{
+			"txHash": txHash.Hex(),
+		},
+	}
+}
+
+// SponsorshipHash returns the EIP-712 hash that the fee payer signs to sponsor
+// the ethereum transaction with the given hash.
+func SponsorshipHash(chainID *big.Int, txHash common.Hash) (common.Hash, error) {
+	hash, _, err := apitypes.TypedDataAndHash(SponsorshipTypedData(chainID, txHash))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// FeePayerAddress returns the address of the account that pays the fees.
+func (s FeeSponsorship) FeePayerAddress() common.Address {
+	return common.HexToAddress(s.FeePayer)
+}
+
+// Verify checks that the sponsorship is signed by its fee payer for the
+// ethereum transaction with the given hash, and returns the fee payer address.
+func (s FeeSponsorship) Verify(chainID *big.Int, txHash common.Hash) (common.Address, error) {
+	if !common.IsHexAddress(s.FeePayer) {
+		return common.Address{}, errorsmod.Wrapf(ErrInvalidSponsorship, "invalid fee payer address %s", s.FeePayer)
+	}
+
+	if len(s.Signature) != crypto.SignatureLength {
+		return common.Address{}, errorsmod.Wrapf(ErrInvalidSponsorship, "invalid signature length %d", len(s.Signature))
+	}
+
+	hash, err := SponsorshipHash(chainID, txHash)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrap(ErrInvalidSponsorship, err.Error())
+	}
+
+	signer, err := recoverTypedDataSigner(hash, s.Signature)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrapf(ErrInvalidSponsorship, "failed to recover fee payer: %s", err)
+	}
+
+	feePayer := s.FeePayerAddress()
+	if signer != feePayer {
+		return common.Address{}, errorsmod.Wrapf(ErrInvalidSponsorship, "signer %s doesn't match the fee payer %s", signer, feePayer)
+	}
+
+	return feePayer, nil
+}
+
+// recoverTypedDataSigner returns the address of the signer of the given
+// EIP-712 hash.
+func recoverTypedDataSigner(hash common.Hash, signature []byte) (common.Address, error) {
+	// NOTE: copy the signature to remove the recovery offset used by wallets
+	// (ie. Metamask) without modifying the transaction
+	sig := common.CopyBytes(signature)
+	if sig[crypto.RecoveryIDOffset] == 27 || sig[crypto.RecoveryIDOffset] == 28 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
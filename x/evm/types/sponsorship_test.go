@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func TestFeeSponsorshipVerify(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	feePayer := crypto.PubkeyToAddress(key.PublicKey)
+
+	chainID := big.NewInt(9001)
+	txHash := common.HexToHash("0x01")
+
+	sign := func(chainID *big.Int, txHash common.Hash) []byte {
+		hash, err := types.SponsorshipHash(chainID, txHash)
+		require.NoError(t, err)
+		sig, err := crypto.Sign(hash.Bytes(), key)
+		require.NoError(t, err)
+		return sig
+	}
+
+	testCases := []struct {
+		name        string
+		sponsorship func() types.FeeSponsorship
+		expPass     bool
+	}{
+		{
+			"pass - signed by the fee payer",
+			func() types.FeeSponsorship {
+				return types.FeeSponsorship{FeePayer: feePayer.Hex(), Signature: sign(chainID, txHash)}
+			},
+			true,
+		},
+		{
+			"pass - signature with recovery offset",
+			func() types.FeeSponsorship {
+				sig := sign(chainID, txHash)
+				sig[crypto.RecoveryIDOffset] += 27
+				return types.FeeSponsorship{FeePayer: feePayer.Hex(), Signature: sig}
+			},
+			true,
+		},
+		{
+			"fail - invalid fee payer address",
+			func() types.FeeSponsorship {
+				return types.FeeSponsorship{FeePayer: "evmos", Signature: sign(chainID, txHash)}
+			},
+			false,
+		},
+		{
+			"fail - invalid signature length",
+			func() types.FeeSponsorship {
+				return types.FeeSponsorship{FeePayer: feePayer.Hex(), Signature: []byte{1, 2, 3}}
+			},
+			false,
+		},
+		{
+			"fail - signed by another account",
+			func() types.FeeSponsorship {
+				return types.FeeSponsorship{FeePayer: common.HexToAddress("0x02").Hex(), Signature: sign(chainID, txHash)}
+			},
+			false,
+		},
+		{
+			"fail - signed for another transaction",
+			func() types.FeeSponsorship {
+				return types.FeeSponsorship{FeePayer: feePayer.Hex(), Signature: sign(chainID, common.HexToHash("0x02"))}
+			},
+			false,
+		},
+		{
+			"fail - signed for another chain",
+			func() types.FeeSponsorship {
+				return types.FeeSponsorship{FeePayer: feePayer.Hex(), Signature: sign(big.NewInt(1), txHash)}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sponsorship := tc.sponsorship()
+			signature := common.CopyBytes(sponsorship.Signature)
+
+			address, err := sponsorship.Verify(chainID, txHash)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, feePayer, address)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidSponsorship)
+			}
+			// the signature of the transaction must not be modified
+			require.Equal(t, signature, sponsorship.Signature)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+// Opcode classes reported by the EVM telemetry.
+const (
+	OpCodeClassArithmetic  = "arithmetic"
+	OpCodeClassBitwise     = "bitwise"
+	OpCodeClassHash        = "hash"
+	OpCodeClassEnvironment = "environment"
+	OpCodeClassBlock       = "block"
+	OpCodeClassStack       = "stack"
+	OpCodeClassMemory      = "memory"
+	OpCodeClassStorage     = "storage"
+	OpCodeClassControl     = "control"
+	OpCodeClassLog         = "log"
+	OpCodeClassCall        = "call"
+	OpCodeClassCreate      = "create"
+	// OpCodeClassPrecompile is the class of the gas consumed by the precompiled
+	// contracts, which is not charged by any opcode.
+	OpCodeClassPrecompile = "precompile"
+)
+
+// OpCodeClass returns the telemetry class of the given opcode.
+func OpCodeClass(op vm.OpCode) string {
+	switch {
+	case op >= vm.ADD && op <= vm.SIGNEXTEND:
+		return OpCodeClassArithmetic
+	case op >= vm.LT && op <= vm.SAR:
+		return OpCodeClassBitwise
+	case op == vm.KECCAK256:
+		return OpCodeClassHash
+	case op >= vm.ADDRESS && op <= vm.EXTCODEHASH:
+		return OpCodeClassEnvironment
+	case op >= vm.BLOCKHASH && op <= vm.BASEFEE:
+		return OpCodeClassBlock
+	case op == vm.POP || (op >= vm.PUSH0 && op <= vm.SWAP16):
+		return OpCodeClassStack
+	case op == vm.MLOAD || op == vm.MSTORE || op == vm.MSTORE8 || op == vm.MSIZE:
+		return OpCodeClassMemory
+	case op == vm.SLOAD || op == vm.SSTORE:
+		return OpCodeClassStorage
+	case op >= vm.LOG0 && op <= vm.LOG4:
+		return OpCodeClassLog
+	case op == vm.CALL || op == vm.CALLCODE || op == vm.DELEGATECALL || op == vm.STATICCALL:
+		return OpCodeClassCall
+	case op == vm.CREATE || op == vm.CREATE2:
+		return OpCodeClassCreate
+	default:
+		return OpCodeClassControl
+	}
+}
+
+var _ vm.EVMLogger = &TelemetryTracer{}
+
+// TelemetryTracer is a vm.EVMLogger that collects the gas consumed by class of
+// opcode and the number of calls to each precompiled contract during the
+// execution of a transaction.
+//
+// The gas forwarded by the calls is attributed to the code executed by the
+// callee, so that the call class only includes the overhead of the calls.
+type TelemetryTracer struct {
+	NoOpTracer
+
+	env *vm.EVM
+
+	// OpCodeGas is the gas consumed by class of opcode.
+	OpCodeGas map[string]uint64
+	// PrecompileCalls is the number of calls to each precompiled contract.
+	PrecompileCalls map[common.Address]uint64
+}
+
+// NewTelemetryTracer creates a new TelemetryTracer.
+func NewTelemetryTracer() *TelemetryTracer {
+	return &TelemetryTracer{
+		OpCodeGas:       make(map[string]uint64),
+		PrecompileCalls: make(map[common.Address]uint64),
+	}
+}
+
+// CaptureStart implements vm.Tracer interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *TelemetryTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.env = env
+	if !create {
+		t.capturePrecompileCall(to)
+	}
+}
+
+// CaptureState implements vm.Tracer interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *TelemetryTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	t.OpCodeGas[OpCodeClass(op)] += cost
+}
+
+// CaptureEnd implements vm.Tracer interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *TelemetryTracer) CaptureEnd(output []byte, gasUsed uint64, tm time.Duration, err error) {
+	if t.env != nil {
+		t.OpCodeGas[OpCodeClassPrecompile] += t.env.PrecompileGasUsed()
+	}
+}
+
+// CaptureEnter implements vm.Tracer interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *TelemetryTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if typ == vm.CREATE || typ == vm.CREATE2 {
+		// the gas forwarded to the init code is not part of the create opcode cost
+		return
+	}
+
+	// the cost of the call opcodes includes the gas forwarded to the callee,
+	// except for the stipend of the calls that transfer value
+	forwarded := gas
+	if (typ == vm.CALL || typ == vm.CALLCODE) && value != nil && value.Sign() > 0 && forwarded >= params.CallStipend {
+		forwarded -= params.CallStipend
+	}
+	if callGas := t.OpCodeGas[OpCodeClassCall]; callGas >= forwarded {
+		t.OpCodeGas[OpCodeClassCall] = callGas - forwarded
+	} else {
+		t.OpCodeGas[OpCodeClassCall] = 0
+	}
+
+	t.capturePrecompileCall(to)
+}
+
+// capturePrecompileCall increases the calls of the given address if it's a
+// precompiled contract.
+func (t *TelemetryTracer) capturePrecompileCall(addr common.Address) {
+	if t.env == nil {
+		return
+	}
+	if _, found := t.env.Precompile(addr); found {
+		t.PrecompileCalls[addr]++
+	}
+}
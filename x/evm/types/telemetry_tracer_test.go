@@ -0,0 +1,61 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpCodeClass(t *testing.T) {
+	testCases := []struct {
+		op       vm.OpCode
+		expClass string
+	}{
+		{vm.STOP, OpCodeClassControl},
+		{vm.ADD, OpCodeClassArithmetic},
+		{vm.SIGNEXTEND, OpCodeClassArithmetic},
+		{vm.SAR, OpCodeClassBitwise},
+		{vm.KECCAK256, OpCodeClassHash},
+		{vm.EXTCODEHASH, OpCodeClassEnvironment},
+		{vm.BASEFEE, OpCodeClassBlock},
+		{vm.PUSH0, OpCodeClassStack},
+		{vm.SWAP16, OpCodeClassStack},
+		{vm.MSTORE8, OpCodeClassMemory},
+		{vm.SSTORE, OpCodeClassStorage},
+		{vm.JUMPDEST, OpCodeClassControl},
+		{vm.LOG4, OpCodeClassLog},
+		{vm.DELEGATECALL, OpCodeClassCall},
+		{vm.CREATE2, OpCodeClassCreate},
+		{vm.REVERT, OpCodeClassControl},
+		{vm.SELFDESTRUCT, OpCodeClassControl},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expClass, OpCodeClass(tc.op), tc.op.String())
+	}
+}
+
+func TestTelemetryTracer(t *testing.T) {
+	tracer := NewTelemetryTracer()
+	to := common.BytesToAddress([]byte("to"))
+
+	tracer.CaptureState(0, vm.SSTORE, 0, 20000, nil, nil, 1, nil)
+	tracer.CaptureState(1, vm.ADD, 0, 3, nil, nil, 1, nil)
+	// the call cost includes the forwarded gas, but not the stipend
+	tracer.CaptureState(2, vm.CALL, 0, 9000+2600+1000, nil, nil, 1, nil)
+	tracer.CaptureEnter(vm.CALL, common.Address{}, to, nil, 1000+params.CallStipend, big.NewInt(1))
+	tracer.CaptureState(0, vm.STOP, 0, 0, nil, nil, 2, nil)
+
+	require.Equal(t, map[string]uint64{
+		OpCodeClassStorage:    20000,
+		OpCodeClassArithmetic: 3,
+		OpCodeClassCall:       9000 + 2600,
+		OpCodeClassControl:    0,
+	}, tracer.OpCodeGas)
+	// without an EVM, no address is a precompile
+	require.Empty(t, tracer.PrecompileCalls)
+}
@@ -109,3 +109,65 @@ func (dt NoOpTracer) CaptureTxStart(gasLimit uint64) {}
 //
 //nolint:revive // allow unused parameters to indicate expected signature
 func (dt NoOpTracer) CaptureTxEnd(restGas uint64) {}
+
+var _ vm.EVMLogger = MultiTracer{}
+
+// MultiTracer is a vm.Tracer that forwards the execution events to each of
+// its tracers, in order.
+type MultiTracer []vm.EVMLogger
+
+// CaptureStart implements vm.Tracer interface
+func (mt MultiTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	for _, t := range mt {
+		t.CaptureStart(env, from, to, create, input, gas, value)
+	}
+}
+
+// CaptureState implements vm.Tracer interface
+func (mt MultiTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	for _, t := range mt {
+		t.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+	}
+}
+
+// CaptureFault implements vm.Tracer interface
+func (mt MultiTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	for _, t := range mt {
+		t.CaptureFault(pc, op, gas, cost, scope, depth, err)
+	}
+}
+
+// CaptureEnd implements vm.Tracer interface
+func (mt MultiTracer) CaptureEnd(output []byte, gasUsed uint64, tm time.Duration, err error) {
+	for _, t := range mt {
+		t.CaptureEnd(output, gasUsed, tm, err)
+	}
+}
+
+// CaptureEnter implements vm.Tracer interface
+func (mt MultiTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	for _, t := range mt {
+		t.CaptureEnter(typ, from, to, input, gas, value)
+	}
+}
+
+// CaptureExit implements vm.Tracer interface
+func (mt MultiTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	for _, t := range mt {
+		t.CaptureExit(output, gasUsed, err)
+	}
+}
+
+// CaptureTxStart implements vm.Tracer interface
+func (mt MultiTracer) CaptureTxStart(gasLimit uint64) {
+	for _, t := range mt {
+		t.CaptureTxStart(gasLimit)
+	}
+}
+
+// CaptureTxEnd implements vm.Tracer interface
+func (mt MultiTracer) CaptureTxEnd(restGas uint64) {
+	for _, t := range mt {
+		t.CaptureTxEnd(restGas)
+	}
+}
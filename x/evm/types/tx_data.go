@@ -5,6 +5,7 @@ package types
 import (
 	"math/big"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
@@ -13,6 +14,7 @@ var (
 	_ TxData = &LegacyTx{}
 	_ TxData = &AccessListTx{}
 	_ TxData = &DynamicFeeTx{}
+	_ TxData = &SetCodeTx{}
 )
 
 // TxData implements the Ethereum transaction tx structure. It is used
@@ -64,8 +66,12 @@ func NewTxDataFromTx(tx *ethtypes.Transaction) (TxData, error) {
 		txData, err = NewDynamicFeeTx(tx)
 	case ethtypes.AccessListTxType:
 		txData, err = newAccessListTx(tx)
-	default:
+	case ethtypes.LegacyTxType:
 		txData, err = NewLegacyTx(tx)
+	case BlobTxType:
+		return nil, errorsmod.Wrapf(ErrBlobTxNotSupported, "tx %s", tx.Hash().Hex())
+	default:
+		return nil, errorsmod.Wrapf(ethtypes.ErrTxTypeNotSupported, "tx type %d", tx.Type())
 	}
 	if err != nil {
 		return nil, err
@@ -6,6 +6,7 @@ package wrappers
 import (
 	"math/big"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v20/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
@@ -56,3 +57,8 @@ func (w FeeMarketWrapper) GetParams(ctx sdk.Context) feemarkettypes.Params {
 	params.MinGasPrice = types.ConvertAmountTo18DecimalsLegacy(params.MinGasPrice)
 	return params
 }
+
+// GetMinGasPrice returns the min gas price converted to 18 decimals.
+func (w FeeMarketWrapper) GetMinGasPrice(ctx sdk.Context) math.LegacyDec {
+	return types.ConvertAmountTo18DecimalsLegacy(w.FeeMarketKeeper.GetMinGasPrice(ctx))
+}
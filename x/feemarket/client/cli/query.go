@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
+		GetNextBaseFeeCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -116,3 +117,33 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetNextBaseFeeCmd queries the projected base fee of the next block
+func GetNextBaseFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-base-fee",
+		Short: "Get the projected base fee amount of the next block",
+		Long: `Get the projected base fee amount of the block after a given height.
+If the height is not provided, it will use the latest height from context.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ctx := cmd.Context()
+			res, err := queryClient.NextBaseFee(ctx, &types.QueryNextBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -7,17 +7,45 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/evmos/evmos/v20/x/feemarket/types"
 )
 
 // CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
 // block during BeginBlock. If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
 // NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
 // chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
+//
+// The base fee is bounded by the base fee floor and ceiling parameters, and it's
+// adjusted with the average gas wanted of the blocks on the smoothing window.
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
+	return k.calculateBaseFee(ctx, ctx.BlockHeight())
+}
+
+// CalculateNextBaseFee calculates the projected base fee of the block after the
+// current one, from the current base fee and block gas wanted.
+func (k Keeper) CalculateNextBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
+	return k.calculateBaseFee(ctx, ctx.BlockHeight()+1)
+}
+
+// calculateBaseFee calculates the base fee of the block at the given height,
+// which must be the one after the block whose values are stored.
+func (k Keeper) calculateBaseFee(ctx sdk.Context, height int64) sdkmath.LegacyDec {
 	params := k.GetParams(ctx)
 
+	baseFee := k.adjustBaseFee(ctx, params, height)
+	if baseFee.IsNil() {
+		return baseFee
+	}
+
+	return params.ApplyBaseFeeBounds(baseFee)
+}
+
+// adjustBaseFee adjusts the parent block base fee according to the gas wanted
+// of the blocks on the smoothing window.
+func (k Keeper) adjustBaseFee(ctx sdk.Context, params types.Params, height int64) sdkmath.LegacyDec {
 	// Ignore the calculation if not enabled
-	if !params.IsBaseFeeEnabled(ctx.BlockHeight()) {
+	if !params.IsBaseFeeEnabled(height) {
 		return sdkmath.LegacyDec{}
 	}
 
@@ -26,7 +54,7 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 	// If the current block is the first EIP-1559 block, return the base fee
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
 	// governance).
-	if ctx.BlockHeight() == params.EnableHeight {
+	if height == params.EnableHeight {
 		return params.BaseFee
 	}
 
@@ -39,7 +67,12 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 		return sdkmath.LegacyDec{}
 	}
 
+	// NOTE: the average gas wanted of the last blocks is used when the
+	// smoothing window is enabled, to reduce the volatility on bursty load
 	parentGasUsed := k.GetBlockGasWanted(ctx)
+	if params.SmoothingWindow > 1 {
+		parentGasUsed = k.GetSmoothedBlockGasWanted(ctx)
+	}
 
 	gasLimit := sdkmath.NewIntFromUint64(math.MaxUint64)
 
@@ -91,5 +124,5 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 
 	// Set global min gas price as lower bound of the base fee, transactions below
 	// the min gas price don't even reach the mempool.
-	return sdkmath.LegacyMaxDec(parentBaseFee.Sub(baseFeeDelta), params.MinGasPrice)
+	return sdkmath.LegacyMaxDec(parentBaseFee.Sub(baseFeeDelta), k.getMinGasPrice(ctx, params, height))
 }
@@ -7,6 +7,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -127,3 +128,87 @@ func TestCalculateBaseFee(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateBaseFeeBoundsAndSmoothing(t *testing.T) {
+	var (
+		nw             *network.UnitTestNetwork
+		ctx            sdk.Context
+		initialBaseFee math.LegacyDec
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func(params *types.Params)
+		expFee   func() math.LegacyDec
+	}{
+		{
+			"base fee increase is bounded by the ceiling",
+			func(params *types.Params) {
+				params.BaseFeeCeiling = initialBaseFee.Add(math.LegacyNewDec(1000))
+				nw.App.FeeMarketKeeper.SetBlockGasWanted(ctx, 100)
+			},
+			func() math.LegacyDec { return initialBaseFee.Add(math.LegacyNewDec(1000)) },
+		},
+		{
+			"base fee decrease is bounded by the floor",
+			func(params *types.Params) {
+				params.BaseFeeFloor = initialBaseFee.Sub(math.LegacyNewDec(1000))
+				nw.App.FeeMarketKeeper.SetBlockGasWanted(ctx, 25)
+			},
+			func() math.LegacyDec { return initialBaseFee.Sub(math.LegacyNewDec(1000)) },
+		},
+		{
+			"base fee is adjusted with the average gas wanted of the smoothing window",
+			func(params *types.Params) {
+				params.SmoothingWindow = 3
+				nw.App.FeeMarketKeeper.SetBlockGasWantedHistory(ctx, 1, 100, 3)
+				nw.App.FeeMarketKeeper.SetBlockGasWantedHistory(ctx, 2, 25, 3)
+				nw.App.FeeMarketKeeper.SetBlockGasWantedHistory(ctx, 3, 25, 3)
+				nw.App.FeeMarketKeeper.SetBlockGasWanted(ctx, 25)
+			},
+			// the average gas wanted is the same as the target
+			func() math.LegacyDec { return initialBaseFee },
+		},
+		{
+			"blocks out of the smoothing window are pruned",
+			func(params *types.Params) {
+				params.SmoothingWindow = 2
+				nw.App.FeeMarketKeeper.SetBlockGasWantedHistory(ctx, 1, 0, 2)
+				nw.App.FeeMarketKeeper.SetBlockGasWantedHistory(ctx, 2, 100, 2)
+				nw.App.FeeMarketKeeper.SetBlockGasWantedHistory(ctx, 3, 100, 2)
+				nw.App.FeeMarketKeeper.SetBlockGasWanted(ctx, 100)
+			},
+			func() math.LegacyDec { return initialBaseFee.Add(math.LegacyNewDec(109375000)) },
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// reset network and context
+			nw = network.NewUnitTestNetwork()
+			ctx = nw.GetContext().WithBlockHeight(4)
+
+			params := nw.App.FeeMarketKeeper.GetParams(ctx)
+			params.MinGasPrice = math.LegacyZeroDec()
+			initialBaseFee = params.BaseFee
+
+			tc.malleate(&params)
+			require.NoError(t, nw.App.FeeMarketKeeper.SetParams(ctx, params))
+
+			// Set next block target/gasLimit through Consensus Param MaxGas
+			blockParams := tmproto.BlockParams{
+				MaxGas:   100,
+				MaxBytes: 10,
+			}
+			consParams := tmproto.ConsensusParams{Block: &blockParams}
+			ctx = ctx.WithConsensusParams(consParams)
+
+			fee := nw.App.FeeMarketKeeper.CalculateBaseFee(ctx)
+			require.Equal(t, tc.expFee(), fee, tc.name)
+
+			// the projected base fee of the next block is calculated from the
+			// values of the previous one
+			nextFee := nw.App.FeeMarketKeeper.CalculateNextBaseFee(ctx.WithBlockHeight(3))
+			require.Equal(t, fee, nextFee, tc.name)
+		})
+	}
+}
@@ -35,6 +35,19 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// NextBaseFee implements the Query/NextBaseFee gRPC method
+func (k Keeper) NextBaseFee(c context.Context, _ *types.QueryNextBaseFeeRequest) (*types.QueryNextBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryNextBaseFeeResponse{}
+	baseFee := k.CalculateNextBaseFee(ctx)
+	if !baseFee.IsNil() {
+		res.BaseFee = &baseFee
+	}
+
+	return res, nil
+}
+
 // BlockGas implements the Query/BlockGas gRPC method
 func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*types.QueryBlockGasResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -6,6 +6,8 @@ import (
 	"math/big"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,6 +30,8 @@ type Keeper struct {
 	authority sdk.AccAddress
 	// Legacy subspace
 	ss paramstypes.Subspace
+	// minGasPriceOracle is the optional source of the min gas price
+	minGasPriceOracle types.MinGasPriceOracle
 }
 
 // NewKeeper generates new fee market module keeper
@@ -48,6 +52,14 @@ func NewKeeper(
 	}
 }
 
+// WithMinGasPriceOracle sets the oracle used to source the min gas price. The
+// min gas price defined on the params is used when the oracle price is not
+// available.
+func (k Keeper) WithMinGasPriceOracle(oracle types.MinGasPriceOracle) Keeper {
+	k.minGasPriceOracle = oracle
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
@@ -72,6 +84,58 @@ func (k Keeper) GetBlockGasWanted(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixBlockGasWanted))
 }
 
+// SetBlockGasWantedHistory records the gas wanted of the block at the given
+// height on the smoothing window of the base fee, and prunes the blocks that
+// fall out of it.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetBlockGasWantedHistory(ctx sdk.Context, height int64, gas uint64, window uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockGasWantedHistory)
+
+	// NOTE: the window is checked on every block so that the blocks recorded
+	// before governance shrinks or disables it are pruned
+	cutoff := height - int64(window) + 1
+	if cutoff < 0 {
+		cutoff = 0
+	}
+
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(cutoff))) //nolint:gosec // G115
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	if window > 1 {
+		store.Set(sdk.Uint64ToBigEndian(uint64(height)), sdk.Uint64ToBigEndian(gas)) //nolint:gosec // G115
+	}
+}
+
+// GetSmoothedBlockGasWanted returns the average gas wanted of the blocks
+// recorded on the smoothing window of the base fee, or the last block gas
+// wanted if there are none.
+func (k Keeper) GetSmoothedBlockGasWanted(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockGasWantedHistory)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	total := sdkmath.ZeroInt()
+	count := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		total = total.Add(sdkmath.NewIntFromUint64(sdk.BigEndianToUint64(iterator.Value())))
+		count++
+	}
+
+	if count == 0 {
+		return k.GetBlockGasWanted(ctx)
+	}
+
+	return total.QuoRaw(count).Uint64()
+}
+
 // GetTransientGasWanted returns the gas wanted in the current block from transient store.
 func (k Keeper) GetTransientGasWanted(ctx sdk.Context) uint64 {
 	store := ctx.TransientStore(k.transientKey)
@@ -31,6 +31,29 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return
 }
 
+// GetMinGasPrice returns the min gas price of the current block. It's sourced
+// from the oracle if it's set and available, then from the min gas price
+// schedule if it has started, and otherwise from the MinGasPrice param.
+func (k Keeper) GetMinGasPrice(ctx sdk.Context) math.LegacyDec {
+	return k.getMinGasPrice(ctx, k.GetParams(ctx), ctx.BlockHeight())
+}
+
+// getMinGasPrice returns the min gas price at the given height.
+func (k Keeper) getMinGasPrice(ctx sdk.Context, params types.Params, height int64) math.LegacyDec {
+	if k.minGasPriceOracle != nil {
+		price, ok := k.minGasPriceOracle.GetMinGasPrice(ctx)
+		if ok && !price.IsNil() && !price.IsNegative() {
+			return price
+		}
+	}
+
+	if price, ok := params.ScheduledMinGasPrice(height); ok {
+		return price
+	}
+
+	return params.MinGasPrice
+}
+
 // SetParams sets the fee market params in a single key
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	store := ctx.KVStore(k.storeKey)
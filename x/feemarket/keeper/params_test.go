@@ -4,6 +4,8 @@ import (
 	"reflect"
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	"github.com/evmos/evmos/v20/x/feemarket/types"
 	"github.com/stretchr/testify/require"
@@ -90,3 +92,40 @@ func TestSetGetParams(t *testing.T) {
 		})
 	}
 }
+
+type mockMinGasPriceOracle struct {
+	price math.LegacyDec
+	ok    bool
+}
+
+func (m mockMinGasPriceOracle) GetMinGasPrice(_ sdk.Context) (math.LegacyDec, bool) {
+	return m.price, m.ok
+}
+
+func TestGetMinGasPrice(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	params := types.DefaultParams()
+	params.MinGasPrice = math.LegacyNewDec(10)
+	params.MinGasPriceSchedule = []types.MinGasPricePoint{
+		{Height: ctx.BlockHeight() + 10, MinGasPrice: math.LegacyNewDec(20)},
+	}
+	err := nw.App.FeeMarketKeeper.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	// the schedule has not started yet
+	k := nw.App.FeeMarketKeeper
+	require.Equal(t, math.LegacyNewDec(10), k.GetMinGasPrice(ctx))
+
+	// the schedule has started
+	scheduledCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.Equal(t, math.LegacyNewDec(20), k.GetMinGasPrice(scheduledCtx))
+
+	// the oracle price takes precedence when it's available
+	k = k.WithMinGasPriceOracle(mockMinGasPriceOracle{price: math.LegacyNewDec(30), ok: true})
+	require.Equal(t, math.LegacyNewDec(30), k.GetMinGasPrice(scheduledCtx))
+
+	k = k.WithMinGasPriceOracle(mockMinGasPriceOracle{ok: false})
+	require.Equal(t, math.LegacyNewDec(20), k.GetMinGasPrice(scheduledCtx))
+}
@@ -44,6 +44,21 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// base_fee_floor defines the minimum value of the base fee. A zero value
+	// disables the floor.
+	BaseFeeFloor cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=base_fee_floor,json=baseFeeFloor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_floor"`
+	// base_fee_ceiling defines the maximum value of the base fee. A zero value
+	// disables the ceiling.
+	BaseFeeCeiling cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=base_fee_ceiling,json=baseFeeCeiling,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_ceiling"`
+	// smoothing_window defines the number of blocks whose average gas wanted is
+	// used to adjust the base fee. A value lower than 2 adjusts the base fee
+	// with the gas wanted of the parent block only. It cannot be greater than 100.
+	SmoothingWindow uint32 `protobuf:"varint,11,opt,name=smoothing_window,json=smoothingWindow,proto3" json:"smoothing_window,omitempty"`
+	// min_gas_price_schedule defines a curve of min gas prices scheduled at
+	// block heights, which is linearly interpolated between its points. It
+	// replaces the min_gas_price from the height of its first point, unless the
+	// min gas price is sourced from an oracle.
+	MinGasPriceSchedule []MinGasPricePoint `protobuf:"bytes,12,rep,name=min_gas_price_schedule,json=minGasPriceSchedule,proto3" json:"min_gas_price_schedule"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -107,8 +122,71 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetSmoothingWindow() uint32 {
+	if m != nil {
+		return m.SmoothingWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMinGasPriceSchedule() []MinGasPricePoint {
+	if m != nil {
+		return m.MinGasPriceSchedule
+	}
+	return nil
+}
+
+// MinGasPricePoint defines the min gas price scheduled at a block height
+type MinGasPricePoint struct {
+	// height is the block height at which the min gas price is reached
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// min_gas_price is the min gas price at the given height
+	MinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price"`
+}
+
+func (m *MinGasPricePoint) Reset()         { *m = MinGasPricePoint{} }
+func (m *MinGasPricePoint) String() string { return proto.CompactTextString(m) }
+func (*MinGasPricePoint) ProtoMessage()    {}
+func (*MinGasPricePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *MinGasPricePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinGasPricePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinGasPricePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinGasPricePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinGasPricePoint.Merge(m, src)
+}
+func (m *MinGasPricePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinGasPricePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinGasPricePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinGasPricePoint proto.InternalMessageInfo
+
+func (m *MinGasPricePoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*MinGasPricePoint)(nil), "ethermint.feemarket.v1.MinGasPricePoint")
 }
 
 func init() {
@@ -116,34 +194,42 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x5d, 0xb3, 0xed, 0x76, 0xeb, 0xb2, 0xd2, 0x62, 0x15, 0x14, 0xb5, 0x22, 0x5d, 0x81, 0x84,
-	0xa2, 0x0a, 0x25, 0x94, 0xde, 0x90, 0xb8, 0x6c, 0xab, 0x82, 0x50, 0x91, 0xaa, 0x1c, 0x38, 0x70,
-	0xb1, 0x9c, 0x74, 0x9a, 0x8c, 0x1a, 0xdb, 0xab, 0xd8, 0x8d, 0xd8, 0x5f, 0xe0, 0xc4, 0x67, 0x70,
-	0xec, 0x67, 0xf4, 0xd8, 0x23, 0xe2, 0x50, 0xa1, 0xcd, 0xa1, 0xbf, 0x81, 0x1a, 0xd3, 0xa4, 0x1c,
-	0xf7, 0x32, 0x1a, 0xbf, 0xf7, 0xe6, 0x79, 0xec, 0x19, 0xfa, 0x0a, 0x6c, 0x0e, 0xa5, 0x44, 0x65,
-	0xa3, 0x33, 0x00, 0x29, 0xca, 0x73, 0xb0, 0x51, 0xb5, 0xd7, 0x1d, 0xc2, 0x59, 0xa9, 0xad, 0x66,
-	0xcf, 0x5a, 0x5d, 0xd8, 0x51, 0xd5, 0xde, 0xd6, 0x13, 0x21, 0x51, 0xe9, 0xa8, 0x89, 0x4e, 0xba,
-	0xb5, 0x99, 0xe9, 0x4c, 0x37, 0x69, 0x74, 0x97, 0x39, 0xf4, 0x45, 0xdd, 0xa7, 0x83, 0x13, 0x51,
-	0x0a, 0x69, 0x98, 0x4f, 0x37, 0x94, 0xe6, 0x89, 0x30, 0xc0, 0xcf, 0x00, 0x3c, 0x32, 0x21, 0xc1,
-	0x30, 0x5e, 0x57, 0x7a, 0x2a, 0x0c, 0x1c, 0x01, 0xb0, 0xf7, 0x74, 0xfb, 0x9e, 0xe4, 0x69, 0x2e,
-	0x54, 0x06, 0xfc, 0x14, 0x94, 0x96, 0xa8, 0x84, 0xd5, 0xa5, 0xf7, 0x68, 0x42, 0x82, 0x51, 0xec,
-	0x25, 0x4e, 0x7d, 0xd0, 0x08, 0x0e, 0x3b, 0x9e, 0xed, 0xd3, 0xa7, 0x50, 0x08, 0x63, 0x31, 0x45,
-	0x3b, 0xe7, 0xf2, 0xa2, 0xb0, 0x38, 0x2b, 0x10, 0x4a, 0xaf, 0xdf, 0x14, 0x6e, 0x76, 0xe4, 0xe7,
-	0x96, 0x63, 0x2f, 0xe9, 0x08, 0x94, 0x48, 0x0a, 0xe0, 0x39, 0x60, 0x96, 0x5b, 0x6f, 0x75, 0x42,
-	0x82, 0x7e, 0xfc, 0xd8, 0x81, 0x1f, 0x1b, 0x8c, 0x1d, 0xd0, 0x61, 0xdb, 0xf5, 0x60, 0x42, 0x82,
-	0xf5, 0x69, 0x70, 0x75, 0xb3, 0xd3, 0xfb, 0x7d, 0xb3, 0xb3, 0x9d, 0x6a, 0x23, 0xb5, 0x31, 0xa7,
-	0xe7, 0x21, 0xea, 0x48, 0x0a, 0x9b, 0x87, 0xc7, 0x90, 0x89, 0x74, 0x7e, 0x08, 0xe9, 0xcf, 0xdb,
-	0xcb, 0x5d, 0x12, 0xaf, 0xfd, 0xeb, 0x97, 0x1d, 0xd3, 0x91, 0x44, 0xc5, 0x33, 0x61, 0xf8, 0xac,
-	0xc4, 0x14, 0xbc, 0xb5, 0x25, 0x9d, 0x36, 0x24, 0xaa, 0x0f, 0xc2, 0x9c, 0xdc, 0x15, 0xb3, 0x2f,
-	0x94, 0xdd, 0xbb, 0x3d, 0x78, 0xe9, 0x70, 0x49, 0xcb, 0xb1, 0xb3, 0xec, 0xfe, 0xe3, 0xdd, 0xf3,
-	0xef, 0xb7, 0x97, 0xbb, 0x1e, 0x54, 0x52, 0x9b, 0xe8, 0xdb, 0x83, 0xd5, 0x70, 0x23, 0xfc, 0xb4,
-	0x32, 0x5c, 0x19, 0xaf, 0xc6, 0x63, 0x54, 0x68, 0x51, 0x14, 0xed, 0x2c, 0xa7, 0x47, 0x57, 0x0b,
-	0x9f, 0x5c, 0x2f, 0x7c, 0xf2, 0x67, 0xe1, 0x93, 0x1f, 0xb5, 0xdf, 0xbb, 0xae, 0xfd, 0xde, 0xaf,
-	0xda, 0xef, 0x7d, 0x7d, 0x9d, 0xa1, 0xcd, 0x2f, 0x92, 0x30, 0xd5, 0x32, 0x72, 0xb6, 0x2e, 0x56,
-	0x6f, 0xdf, 0xfc, 0x77, 0x81, 0x9d, 0xcf, 0xc0, 0x24, 0x83, 0x66, 0x69, 0xf6, 0xff, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0x0f, 0xe9, 0x83, 0xfe, 0x9f, 0x02, 0x00, 0x00,
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xfb, 0x93, 0x26, 0x9b, 0xa4, 0x98, 0xa5, 0x44, 0x56, 0x2b, 0xdc, 0xa8, 0x48, 0xc8,
+	0x54, 0xc8, 0xa6, 0xed, 0x0d, 0x89, 0x4b, 0x5a, 0x05, 0x84, 0x5a, 0x14, 0x19, 0x09, 0x24, 0x2e,
+	0xd6, 0xc6, 0x99, 0xd8, 0xab, 0x7a, 0x77, 0x23, 0xef, 0x26, 0x6d, 0x5e, 0x81, 0x13, 0x0f, 0xc0,
+	0x03, 0x70, 0xec, 0x63, 0xf4, 0xd8, 0x23, 0xe2, 0x50, 0xa1, 0xe4, 0xd0, 0xd7, 0x40, 0xb1, 0x13,
+	0x3b, 0x05, 0x2e, 0x11, 0x97, 0xd5, 0xee, 0xfc, 0x7c, 0x3b, 0x33, 0xdf, 0x37, 0xe8, 0x19, 0xa8,
+	0x10, 0x62, 0x46, 0xb9, 0x72, 0x7a, 0x00, 0x8c, 0xc4, 0xe7, 0xa0, 0x9c, 0xe1, 0x41, 0xfe, 0xb0,
+	0xfb, 0xb1, 0x50, 0x02, 0xd7, 0xb3, 0x38, 0x3b, 0x77, 0x0d, 0x0f, 0xb6, 0x1f, 0x12, 0x46, 0xb9,
+	0x70, 0x92, 0x33, 0x0d, 0xdd, 0xde, 0x0a, 0x44, 0x20, 0x92, 0xab, 0x33, 0xbd, 0xa5, 0xd6, 0xbd,
+	0x6f, 0x45, 0x54, 0x6c, 0x93, 0x98, 0x30, 0x89, 0x4d, 0x54, 0xe1, 0xc2, 0xeb, 0x10, 0x09, 0x5e,
+	0x0f, 0xc0, 0xd0, 0x1a, 0x9a, 0x55, 0x72, 0xcb, 0x5c, 0x34, 0x89, 0x84, 0x16, 0x00, 0x7e, 0x8d,
+	0x76, 0xe6, 0x4e, 0xcf, 0x0f, 0x09, 0x0f, 0xc0, 0xeb, 0x02, 0x17, 0x8c, 0x72, 0xa2, 0x44, 0x6c,
+	0xac, 0x34, 0x34, 0xab, 0xe6, 0x1a, 0x9d, 0x34, 0xfa, 0x38, 0x09, 0x38, 0xc9, 0xfd, 0xf8, 0x08,
+	0x3d, 0x86, 0x88, 0x48, 0x45, 0x7d, 0xaa, 0x46, 0x1e, 0x1b, 0x44, 0x8a, 0xf6, 0x23, 0x0a, 0xb1,
+	0xb1, 0x9a, 0x24, 0x6e, 0xe5, 0xce, 0xb3, 0xcc, 0x87, 0x9f, 0xa2, 0x1a, 0x70, 0xd2, 0x89, 0xc0,
+	0x0b, 0x81, 0x06, 0xa1, 0x32, 0xd6, 0x1b, 0x9a, 0xb5, 0xea, 0x56, 0x53, 0xe3, 0xdb, 0xc4, 0x86,
+	0x8f, 0x51, 0x29, 0xab, 0xba, 0xd8, 0xd0, 0xac, 0x72, 0xd3, 0xba, 0xbe, 0xdd, 0x2d, 0xfc, 0xbc,
+	0xdd, 0xdd, 0xf1, 0x85, 0x64, 0x42, 0xca, 0xee, 0xb9, 0x4d, 0x85, 0xc3, 0x88, 0x0a, 0xed, 0x53,
+	0x08, 0x88, 0x3f, 0x3a, 0x01, 0xff, 0xfb, 0xdd, 0xd5, 0xbe, 0xe6, 0x6e, 0xcc, 0xea, 0xc5, 0xa7,
+	0xa8, 0xc6, 0x28, 0xf7, 0x02, 0x22, 0xbd, 0x7e, 0x4c, 0x7d, 0x30, 0x36, 0x96, 0x44, 0xaa, 0x30,
+	0xca, 0xdf, 0x10, 0xd9, 0x9e, 0x26, 0xe3, 0x8f, 0x08, 0xcf, 0xd1, 0x16, 0x3a, 0x2d, 0x2d, 0x09,
+	0xa9, 0xa7, 0x90, 0x0b, 0xf3, 0x78, 0x8f, 0x36, 0x33, 0x0e, 0x7a, 0x91, 0x10, 0xb1, 0x51, 0x5e,
+	0x12, 0xb3, 0x3a, 0x6b, 0xb8, 0x35, 0xcd, 0xc6, 0x2e, 0xd2, 0x73, 0x4e, 0x81, 0x46, 0x94, 0x07,
+	0x06, 0x5a, 0x12, 0x71, 0x73, 0x4e, 0x79, 0x9a, 0x8f, 0x9f, 0x23, 0x5d, 0x32, 0x21, 0x54, 0x48,
+	0x79, 0xe0, 0x5d, 0x50, 0xde, 0x15, 0x17, 0x46, 0x25, 0xe1, 0xf8, 0x41, 0x66, 0xff, 0x94, 0x98,
+	0x71, 0x88, 0xea, 0xf7, 0x86, 0xee, 0x49, 0x3f, 0x84, 0xee, 0x20, 0x02, 0xa3, 0xda, 0x58, 0xb5,
+	0x2a, 0x87, 0x96, 0xfd, 0x6f, 0x7d, 0xdb, 0x67, 0xf9, 0xac, 0xdb, 0x82, 0x72, 0xd5, 0x2c, 0x4f,
+	0xcb, 0x4d, 0xeb, 0x79, 0xb4, 0x40, 0xc4, 0x87, 0x19, 0xde, 0xab, 0x27, 0x5f, 0xee, 0xae, 0xf6,
+	0x0d, 0x18, 0x32, 0x21, 0x9d, 0xcb, 0x85, 0x9d, 0x4a, 0xb5, 0xff, 0x6e, 0xad, 0xb4, 0xa6, 0xaf,
+	0xbb, 0x3a, 0xe5, 0x54, 0x51, 0x12, 0x65, 0x4b, 0xb0, 0x77, 0x89, 0xf4, 0x3f, 0xbf, 0xc2, 0x75,
+	0x54, 0x9c, 0x89, 0x51, 0x4b, 0xc4, 0x38, 0x7b, 0xfd, 0xad, 0xa0, 0x95, 0xff, 0x50, 0x50, 0xb3,
+	0x75, 0x3d, 0x36, 0xb5, 0x9b, 0xb1, 0xa9, 0xfd, 0x1a, 0x9b, 0xda, 0xd7, 0x89, 0x59, 0xb8, 0x99,
+	0x98, 0x85, 0x1f, 0x13, 0xb3, 0xf0, 0xf9, 0x45, 0x40, 0x55, 0x38, 0xe8, 0xd8, 0xbe, 0x60, 0x4e,
+	0xda, 0x50, 0x7a, 0x0e, 0x0f, 0x5f, 0xde, 0x6b, 0x4d, 0x8d, 0xfa, 0x20, 0x3b, 0xc5, 0x64, 0xcf,
+	0x8f, 0x7e, 0x0f, 0x00, 0x9f, 0x82, 0xeb, 0xe6, 0x52, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -166,6 +252,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinGasPriceSchedule) > 0 {
+		for iNdEx := len(m.MinGasPriceSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPriceSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.SmoothingWindow != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.SmoothingWindow))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.BaseFeeCeiling.Size()
+		i -= size
+		if _, err := m.BaseFeeCeiling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.BaseFeeFloor.Size()
+		i -= size
+		if _, err := m.BaseFeeFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -224,6 +349,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MinGasPricePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinGasPricePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinGasPricePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -259,6 +422,33 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.BaseFeeFloor.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.BaseFeeCeiling.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.SmoothingWindow != 0 {
+		n += 1 + sovFeemarket(uint64(m.SmoothingWindow))
+	}
+	if len(m.MinGasPriceSchedule) > 0 {
+		for _, e := range m.MinGasPriceSchedule {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinGasPricePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
 	return n
 }
 
@@ -476,6 +666,230 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothingWindow", wireType)
+			}
+			m.SmoothingWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SmoothingWindow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPriceSchedule = append(m.MinGasPriceSchedule, MinGasPricePoint{})
+			if err := m.MinGasPriceSchedule[len(m.MinGasPriceSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinGasPricePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinGasPricePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinGasPricePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -3,6 +3,7 @@
 package types
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -15,3 +16,11 @@ type (
 		GetParamSetIfExists(ctx sdk.Context, ps LegacyParams)
 	}
 )
+
+// MinGasPriceOracle defines an on-chain source of the min gas price, such as an
+// oracle module that tracks the price of the EVM coin.
+type MinGasPriceOracle interface {
+	// GetMinGasPrice returns the min gas price in the original decimals of the
+	// EVM coin, or false if it's not available.
+	GetMinGasPrice(ctx sdk.Context) (math.LegacyDec, bool)
+}
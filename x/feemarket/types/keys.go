@@ -22,6 +22,7 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBlockGasWantedHistory
 )
 
 const (
@@ -30,7 +31,8 @@ const (
 
 // KVStore key prefixes
 var (
-	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasWanted        = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasWantedHistory = []byte{prefixBlockGasWantedHistory}
 )
 
 // Transient Store key prefixes
@@ -21,8 +21,18 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeFloor is 0 (i.e disabled)
+	DefaultBaseFeeFloor = math.LegacyZeroDec()
+	// DefaultBaseFeeCeiling is 0 (i.e disabled)
+	DefaultBaseFeeCeiling = math.LegacyZeroDec()
+	// DefaultSmoothingWindow is 0 (i.e the base fee is adjusted with the parent block only)
+	DefaultSmoothingWindow = uint32(0)
 )
 
+// MaxSmoothingWindow is the max number of blocks of the smoothing window, which
+// bounds the gas wanted history read and pruned on every block.
+const MaxSmoothingWindow = uint32(100)
+
 // Parameter keys
 var (
 	ParamsKey                             = []byte("Params")
@@ -84,6 +94,10 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BaseFeeFloor:             DefaultBaseFeeFloor,
+		BaseFeeCeiling:           DefaultBaseFeeCeiling,
+		SmoothingWindow:          DefaultSmoothingWindow,
+		MinGasPriceSchedule:      []MinGasPricePoint{},
 	}
 }
 
@@ -105,9 +119,107 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateBaseFeeBounds(p.BaseFeeFloor, p.BaseFeeCeiling); err != nil {
+		return err
+	}
+
+	if p.SmoothingWindow > MaxSmoothingWindow {
+		return fmt.Errorf("smoothing window cannot be greater than %d: %d", MaxSmoothingWindow, p.SmoothingWindow)
+	}
+
+	if err := validateMinGasPriceSchedule(p.MinGasPriceSchedule); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
+// validateBaseFeeBounds checks that the base fee floor and ceiling are not
+// negative and that the ceiling is not lower than the floor when both are
+// enabled.
+// NOTE: the bounds are nil on the params stored before they were introduced,
+// which disables them.
+func validateBaseFeeBounds(floor, ceiling math.LegacyDec) error {
+	if !floor.IsNil() && floor.IsNegative() {
+		return fmt.Errorf("base fee floor cannot be negative: %s", floor)
+	}
+
+	if !ceiling.IsNil() && ceiling.IsNegative() {
+		return fmt.Errorf("base fee ceiling cannot be negative: %s", ceiling)
+	}
+
+	if isBoundEnabled(floor) && isBoundEnabled(ceiling) && ceiling.LT(floor) {
+		return fmt.Errorf("base fee ceiling %s cannot be lower than the floor %s", ceiling, floor)
+	}
+
+	return nil
+}
+
+// ApplyBaseFeeBounds returns the given base fee bounded by the base fee floor
+// and ceiling, if they are enabled.
+func (p Params) ApplyBaseFeeBounds(baseFee math.LegacyDec) math.LegacyDec {
+	if isBoundEnabled(p.BaseFeeFloor) {
+		baseFee = math.LegacyMaxDec(baseFee, p.BaseFeeFloor)
+	}
+
+	if isBoundEnabled(p.BaseFeeCeiling) {
+		baseFee = math.LegacyMinDec(baseFee, p.BaseFeeCeiling)
+	}
+
+	return baseFee
+}
+
+// validateMinGasPriceSchedule checks that the points of the min gas price
+// schedule have valid prices and strictly increasing positive heights.
+func validateMinGasPriceSchedule(schedule []MinGasPricePoint) error {
+	for i, point := range schedule {
+		if point.Height <= 0 {
+			return fmt.Errorf("min gas price schedule height must be positive: %d", point.Height)
+		}
+
+		if i > 0 && point.Height <= schedule[i-1].Height {
+			return fmt.Errorf("min gas price schedule heights must be strictly increasing: %d after %d", point.Height, schedule[i-1].Height)
+		}
+
+		if err := validateMinGasPrice(point.MinGasPrice); err != nil {
+			return fmt.Errorf("invalid min gas price at height %d: %w", point.Height, err)
+		}
+	}
+	return nil
+}
+
+// ScheduledMinGasPrice returns the min gas price of the schedule at the given
+// height, interpolating linearly between its points, and false if the height
+// is before the first point.
+func (p Params) ScheduledMinGasPrice(height int64) (math.LegacyDec, bool) {
+	schedule := p.MinGasPriceSchedule
+	if len(schedule) == 0 || height < schedule[0].Height {
+		return math.LegacyDec{}, false
+	}
+
+	for i := len(schedule) - 1; i >= 0; i-- {
+		start := schedule[i]
+		if height < start.Height {
+			continue
+		}
+
+		// the last price of the schedule is kept after its last point
+		if i == len(schedule)-1 {
+			return start.MinGasPrice, true
+		}
+
+		end := schedule[i+1]
+		elapsed := math.LegacyNewDec(height - start.Height).QuoInt64(end.Height - start.Height)
+		return start.MinGasPrice.Add(end.MinGasPrice.Sub(start.MinGasPrice).Mul(elapsed)), true
+	}
+
+	return math.LegacyDec{}, false
+}
+
+func isBoundEnabled(bound math.LegacyDec) bool {
+	return !bound.IsNil() && bound.IsPositive()
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -115,3 +115,133 @@ func (suite *ParamsTestSuite) TestParamsValidateMinGasPrice() {
 		}
 	}
 }
+
+func (suite *ParamsTestSuite) TestParamsValidateBaseFeeBounds() {
+	testCases := []struct {
+		name     string
+		floor    math.LegacyDec
+		ceiling  math.LegacyDec
+		expError bool
+	}{
+		{"default", DefaultBaseFeeFloor, DefaultBaseFeeCeiling, false},
+		{"valid - not set", math.LegacyDec{}, math.LegacyDec{}, false},
+		{"valid - floor only", math.LegacyNewDec(10), math.LegacyZeroDec(), false},
+		{"valid - ceiling only", math.LegacyZeroDec(), math.LegacyNewDec(10), false},
+		{"valid - equal bounds", math.LegacyNewDec(10), math.LegacyNewDec(10), false},
+		{"invalid - negative floor", math.LegacyNewDec(-1), math.LegacyZeroDec(), true},
+		{"invalid - negative ceiling", math.LegacyZeroDec(), math.LegacyNewDec(-1), true},
+		{"invalid - ceiling lower than floor", math.LegacyNewDec(10), math.LegacyNewDec(5), true},
+	}
+
+	for _, tc := range testCases {
+		err := validateBaseFeeBounds(tc.floor, tc.ceiling)
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestApplyBaseFeeBounds() {
+	params := DefaultParams()
+	suite.Require().Equal(math.LegacyNewDec(100), params.ApplyBaseFeeBounds(math.LegacyNewDec(100)))
+
+	params.BaseFeeFloor = math.LegacyNewDec(50)
+	params.BaseFeeCeiling = math.LegacyNewDec(80)
+	suite.Require().Equal(math.LegacyNewDec(50), params.ApplyBaseFeeBounds(math.LegacyNewDec(10)))
+	suite.Require().Equal(math.LegacyNewDec(60), params.ApplyBaseFeeBounds(math.LegacyNewDec(60)))
+	suite.Require().Equal(math.LegacyNewDec(80), params.ApplyBaseFeeBounds(math.LegacyNewDec(100)))
+
+	// the params stored before the bounds were introduced don't define them
+	params.BaseFeeFloor = math.LegacyDec{}
+	params.BaseFeeCeiling = math.LegacyDec{}
+	suite.Require().Equal(math.LegacyNewDec(100), params.ApplyBaseFeeBounds(math.LegacyNewDec(100)))
+}
+
+func (suite *ParamsTestSuite) TestParamsValidateMinGasPriceSchedule() {
+	testCases := []struct {
+		name     string
+		schedule []MinGasPricePoint
+		expError bool
+	}{
+		{"default", DefaultParams().MinGasPriceSchedule, false},
+		{"valid - single point", []MinGasPricePoint{{Height: 10, MinGasPrice: math.LegacyNewDec(5)}}, false},
+		{"valid - multiple points", []MinGasPricePoint{{Height: 10, MinGasPrice: math.LegacyNewDec(5)}, {Height: 20, MinGasPrice: math.LegacyZeroDec()}}, false},
+		{"invalid - zero height", []MinGasPricePoint{{Height: 0, MinGasPrice: math.LegacyNewDec(5)}}, true},
+		{"invalid - unordered heights", []MinGasPricePoint{{Height: 20, MinGasPrice: math.LegacyNewDec(5)}, {Height: 10, MinGasPrice: math.LegacyNewDec(5)}}, true},
+		{"invalid - repeated heights", []MinGasPricePoint{{Height: 10, MinGasPrice: math.LegacyNewDec(5)}, {Height: 10, MinGasPrice: math.LegacyNewDec(5)}}, true},
+		{"invalid - negative price", []MinGasPricePoint{{Height: 10, MinGasPrice: math.LegacyNewDec(-5)}}, true},
+		{"invalid - nil price", []MinGasPricePoint{{Height: 10}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := validateMinGasPriceSchedule(tc.schedule)
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestScheduledMinGasPrice() {
+	params := DefaultParams()
+	_, ok := params.ScheduledMinGasPrice(100)
+	suite.Require().False(ok)
+
+	params.MinGasPriceSchedule = []MinGasPricePoint{
+		{Height: 10, MinGasPrice: math.LegacyNewDec(100)},
+		{Height: 20, MinGasPrice: math.LegacyNewDec(200)},
+		{Height: 30, MinGasPrice: math.LegacyNewDec(50)},
+	}
+
+	testCases := []struct {
+		height   int64
+		expOk    bool
+		expPrice math.LegacyDec
+	}{
+		{9, false, math.LegacyDec{}},
+		{10, true, math.LegacyNewDec(100)},
+		{15, true, math.LegacyNewDec(150)},
+		{20, true, math.LegacyNewDec(200)},
+		{26, true, math.LegacyNewDec(110)},
+		{30, true, math.LegacyNewDec(50)},
+		{1000, true, math.LegacyNewDec(50)},
+	}
+
+	for _, tc := range testCases {
+		price, ok := params.ScheduledMinGasPrice(tc.height)
+		suite.Require().Equal(tc.expOk, ok, tc.height)
+		if tc.expOk {
+			suite.Require().Equal(tc.expPrice, price, tc.height)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsValidateSmoothingWindow() {
+	testCases := []struct {
+		name     string
+		window   uint32
+		expError bool
+	}{
+		{"disabled", 0, false},
+		{"parent block only", 1, false},
+		{"max window", MaxSmoothingWindow, false},
+		{"greater than the max window", MaxSmoothingWindow + 1, true},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		params.SmoothingWindow = tc.window
+		err := params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
@@ -275,6 +275,84 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryNextBaseFeeRequest defines the request type for querying the projected
+// EIP1559 base fee of the next block.
+type QueryNextBaseFeeRequest struct {
+}
+
+func (m *QueryNextBaseFeeRequest) Reset()         { *m = QueryNextBaseFeeRequest{} }
+func (m *QueryNextBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextBaseFeeRequest) ProtoMessage()    {}
+func (*QueryNextBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryNextBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryNextBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextBaseFeeRequest proto.InternalMessageInfo
+
+// QueryNextBaseFeeResponse returns the projected EIP1559 base fee of the next
+// block.
+type QueryNextBaseFeeResponse struct {
+	// base_fee is the projected EIP1559 base fee of the next block
+	BaseFee *cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee,omitempty"`
+}
+
+func (m *QueryNextBaseFeeResponse) Reset()         { *m = QueryNextBaseFeeResponse{} }
+func (m *QueryNextBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextBaseFeeResponse) ProtoMessage()    {}
+func (*QueryNextBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryNextBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryNextBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextBaseFeeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -282,6 +360,8 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryNextBaseFeeRequest)(nil), "ethermint.feemarket.v1.QueryNextBaseFeeRequest")
+	proto.RegisterType((*QueryNextBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryNextBaseFeeResponse")
 }
 
 func init() {
@@ -289,37 +369,40 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x18, 0x4c, 0xdb, 0xf1, 0xa2, 0x63, 0x5a, 0x64, 0x8d, 0x1b, 0x59, 0x54, 0xb4, 0xd6,
-	0x1d, 0x1b, 0x6f, 0xde, 0x0c, 0x52, 0x2f, 0x1e, 0x74, 0x4f, 0xe2, 0xa5, 0x4c, 0xd6, 0xd7, 0xcd,
-	0x92, 0xce, 0xbe, 0xed, 0xce, 0x24, 0x98, 0xab, 0xe0, 0xc5, 0x83, 0x08, 0xfe, 0x09, 0x2f, 0x82,
-	0x3f, 0xa3, 0xc7, 0x82, 0x17, 0xf1, 0x50, 0x24, 0x11, 0xfc, 0x1b, 0x92, 0x99, 0xd9, 0xe8, 0x6a,
-	0xa2, 0xb9, 0x0c, 0x8f, 0xb7, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0x8f, 0xa5, 0x01, 0xe8, 0x3e, 0x14,
-	0x32, 0xcd, 0x34, 0x3f, 0x00, 0x90, 0xa2, 0x18, 0x80, 0xe6, 0xa3, 0x5d, 0x7e, 0x34, 0x84, 0x62,
-	0x1c, 0xe6, 0x05, 0x6a, 0x64, 0x5b, 0x73, 0x4c, 0x38, 0xc7, 0x84, 0xa3, 0x5d, 0xef, 0x82, 0x90,
-	0x69, 0x86, 0xdc, 0xbc, 0x16, 0xea, 0xdd, 0x58, 0x42, 0xf7, 0x6b, 0xce, 0xe2, 0x9a, 0x09, 0x26,
-	0x68, 0x4a, 0x3e, 0xab, 0x5c, 0xb7, 0x95, 0x20, 0x26, 0x87, 0xc0, 0x45, 0x9e, 0x72, 0x91, 0x65,
-	0xa8, 0x85, 0x4e, 0x31, 0x53, 0xf6, 0x6b, 0xd0, 0xa4, 0xec, 0xe9, 0xcc, 0xd5, 0x13, 0x51, 0x08,
-	0xa9, 0x22, 0x38, 0x1a, 0x82, 0xd2, 0xc1, 0x33, 0x7a, 0xb1, 0xd2, 0x55, 0x39, 0x66, 0x0a, 0xd8,
-	0x03, 0xda, 0xc8, 0x4d, 0xe7, 0x12, 0xb9, 0x4a, 0x6e, 0x9e, 0xeb, 0xf8, 0xe1, 0xe2, 0x25, 0x42,
-	0x3b, 0xd7, 0xdd, 0x38, 0x3e, 0x6d, 0xd7, 0x3e, 0xfc, 0xf8, 0xb4, 0x4d, 0x22, 0x37, 0x18, 0x6c,
-	0x3a, 0xe6, 0xae, 0x50, 0xb0, 0x07, 0x50, 0x0a, 0x46, 0xb4, 0x59, 0x6d, 0x3b, 0xc5, 0xfb, 0x74,
-	0xbd, 0x27, 0x14, 0xec, 0x1f, 0x00, 0x18, 0xcd, 0x8d, 0x6e, 0xfb, 0xeb, 0x69, 0xfb, 0x72, 0x8c,
-	0x4a, 0xa2, 0x52, 0x2f, 0x06, 0x61, 0x8a, 0x5c, 0x0a, 0xdd, 0x0f, 0x1f, 0x43, 0x22, 0xe2, 0xf1,
-	0x43, 0x88, 0xa3, 0xb5, 0x9e, 0xe5, 0x08, 0xb6, 0x4a, 0xce, 0x43, 0x8c, 0x07, 0x8f, 0xc4, 0x7c,
-	0xb9, 0x5b, 0x74, 0xf3, 0x8f, 0xbe, 0x13, 0x3b, 0x4f, 0xeb, 0x89, 0xb0, 0xbb, 0xd5, 0xa3, 0x59,
-	0xd9, 0xf9, 0x58, 0xa7, 0x67, 0x0d, 0x96, 0xbd, 0x26, 0xb4, 0x61, 0xb7, 0x62, 0xdb, 0xcb, 0xb6,
-	0xfe, 0x3b, 0x48, 0xef, 0xf6, 0x4a, 0x58, 0xab, 0x1f, 0x04, 0xaf, 0x3e, 0x7f, 0x7f, 0x7f, 0xa6,
-	0xc5, 0x3c, 0x0e, 0x23, 0x89, 0xaa, 0x7a, 0x6c, 0x9b, 0x1f, 0x7b, 0x43, 0xe8, 0x9a, 0x0b, 0x89,
-	0xfd, 0x9b, 0xbc, 0x9a, 0xb0, 0xb7, 0xb3, 0x1a, 0xd8, 0x59, 0xb9, 0x66, 0xac, 0xf8, 0xac, 0xb5,
-	0xc8, 0x4a, 0x79, 0x11, 0xf6, 0x96, 0xd0, 0xf5, 0x32, 0x45, 0xf6, 0x1f, 0x81, 0xea, 0x11, 0xbc,
-	0x3b, 0x2b, 0xa2, 0x9d, 0x9f, 0xeb, 0xc6, 0x4f, 0x9b, 0x5d, 0x59, 0xe8, 0x67, 0x86, 0xde, 0x4f,
-	0x84, 0xea, 0xee, 0x1d, 0x4f, 0x7c, 0x72, 0x32, 0xf1, 0xc9, 0xb7, 0x89, 0x4f, 0xde, 0x4d, 0xfd,
-	0xda, 0xc9, 0xd4, 0xaf, 0x7d, 0x99, 0xfa, 0xb5, 0xe7, 0x3b, 0x49, 0xaa, 0xfb, 0xc3, 0x5e, 0x18,
-	0xa3, 0x74, 0x14, 0xf6, 0x1d, 0x75, 0xee, 0xf2, 0x97, 0xbf, 0xd1, 0xe9, 0x71, 0x0e, 0xaa, 0xd7,
-	0x30, 0x3f, 0xc7, 0xbd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x44, 0x02, 0x20, 0xd0, 0xc9, 0x03,
-	0x00, 0x00,
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x14, 0xd2, 0xf6, 0xba, 0xc0, 0x91, 0x96, 0x62, 0x82, 0x03, 0xe6, 0x87, 0x68,
+	0x29, 0xbe, 0x36, 0x6c, 0x6c, 0x44, 0xa8, 0x2c, 0x08, 0x81, 0x07, 0x84, 0x58, 0xa2, 0x8b, 0x79,
+	0x75, 0xac, 0xd4, 0x3e, 0xd7, 0x77, 0x89, 0x92, 0x15, 0x89, 0x85, 0x01, 0x21, 0x31, 0xf2, 0x0f,
+	0x30, 0xf2, 0x67, 0x54, 0x62, 0xa9, 0xc4, 0x82, 0x18, 0x2a, 0x94, 0x20, 0xf1, 0x6f, 0xa0, 0xdc,
+	0x9d, 0x43, 0xdd, 0xa4, 0xc5, 0x12, 0x8b, 0x75, 0x7a, 0xf7, 0x7d, 0xdf, 0xf7, 0x79, 0xef, 0x9d,
+	0x8c, 0x1d, 0x90, 0x6d, 0x48, 0xa3, 0x30, 0x96, 0x74, 0x07, 0x20, 0x62, 0x69, 0x07, 0x24, 0xed,
+	0x6d, 0xd1, 0xbd, 0x2e, 0xa4, 0x03, 0x37, 0x49, 0xb9, 0xe4, 0x64, 0x65, 0xa2, 0x71, 0x27, 0x1a,
+	0xb7, 0xb7, 0x65, 0x5d, 0x60, 0x51, 0x18, 0x73, 0xaa, 0xbe, 0x5a, 0x6a, 0xdd, 0x3e, 0xc1, 0xee,
+	0x6f, 0x9e, 0xd6, 0x55, 0x02, 0x1e, 0x70, 0x75, 0xa4, 0xe3, 0x93, 0x89, 0x56, 0x03, 0xce, 0x83,
+	0x5d, 0xa0, 0x2c, 0x09, 0x29, 0x8b, 0x63, 0x2e, 0x99, 0x0c, 0x79, 0x2c, 0xf4, 0xad, 0x53, 0xc1,
+	0xe4, 0xf9, 0x98, 0xea, 0x19, 0x4b, 0x59, 0x24, 0x3c, 0xd8, 0xeb, 0x82, 0x90, 0xce, 0x4b, 0x7c,
+	0x31, 0x17, 0x15, 0x09, 0x8f, 0x05, 0x90, 0x87, 0xb8, 0x9c, 0xa8, 0xc8, 0x2a, 0xba, 0x86, 0xee,
+	0x2c, 0xd5, 0x6d, 0x77, 0x76, 0x13, 0xae, 0xce, 0x6b, 0x2c, 0xee, 0x1f, 0xd6, 0x4a, 0x9f, 0x7f,
+	0x7f, 0x59, 0x47, 0x9e, 0x49, 0x74, 0x96, 0x8d, 0x73, 0x83, 0x09, 0xd8, 0x06, 0xc8, 0x0a, 0x7a,
+	0xb8, 0x92, 0x0f, 0x9b, 0x8a, 0x0f, 0xf0, 0x42, 0x8b, 0x09, 0x68, 0xee, 0x00, 0xa8, 0x9a, 0x8b,
+	0x8d, 0xda, 0x8f, 0xc3, 0xda, 0x15, 0x9f, 0x8b, 0x88, 0x0b, 0xf1, 0xba, 0xe3, 0x86, 0x9c, 0x46,
+	0x4c, 0xb6, 0xdd, 0x27, 0x10, 0x30, 0x7f, 0xf0, 0x08, 0x7c, 0x6f, 0xbe, 0xa5, 0x3d, 0x9c, 0x95,
+	0xcc, 0x73, 0x97, 0xfb, 0x9d, 0xc7, 0x6c, 0xd2, 0xdc, 0x1a, 0x5e, 0x3e, 0x16, 0x37, 0xc5, 0xce,
+	0xe3, 0xb9, 0x80, 0xe9, 0xde, 0xe6, 0xbc, 0xf1, 0xd1, 0xb9, 0x8c, 0x2f, 0x29, 0xe9, 0x53, 0xe8,
+	0xcb, 0x63, 0xc4, 0x2f, 0xf0, 0xea, 0xf4, 0xd5, 0xff, 0x53, 0xd7, 0xbf, 0x9e, 0xc5, 0xe7, 0x94,
+	0x31, 0x79, 0x8b, 0x70, 0x59, 0x0f, 0x92, 0xac, 0x9f, 0x34, 0xe8, 0xe9, 0xdd, 0x59, 0x77, 0x0b,
+	0x69, 0x35, 0xa9, 0xe3, 0xbc, 0xf9, 0xf6, 0xeb, 0xe3, 0x99, 0x2a, 0xb1, 0x28, 0xf4, 0x22, 0x2e,
+	0xf2, 0xef, 0x4b, 0xaf, 0x8c, 0xbc, 0x43, 0x78, 0xde, 0x74, 0x48, 0x4e, 0x37, 0xcf, 0x8f, 0xc8,
+	0xda, 0x28, 0x26, 0x36, 0x28, 0x37, 0x15, 0x8a, 0x4d, 0xaa, 0xb3, 0x50, 0xb2, 0x71, 0x92, 0xf7,
+	0x08, 0x2f, 0x64, 0x8b, 0x23, 0xff, 0x28, 0x90, 0xdf, 0xbb, 0x75, 0xaf, 0xa0, 0xda, 0xf0, 0xdc,
+	0x52, 0x3c, 0x35, 0x72, 0x75, 0x26, 0xcf, 0x58, 0xdd, 0x0c, 0x98, 0x20, 0x9f, 0x10, 0x5e, 0x3a,
+	0xf2, 0x06, 0x08, 0x3d, 0xb5, 0xca, 0xf4, 0x43, 0xb2, 0x36, 0x8b, 0x27, 0x18, 0xb2, 0x35, 0x45,
+	0x76, 0x83, 0x5c, 0x9f, 0x45, 0x16, 0x43, 0x5f, 0x36, 0xb3, 0x71, 0x35, 0xb6, 0xf7, 0x87, 0x36,
+	0x3a, 0x18, 0xda, 0xe8, 0xe7, 0xd0, 0x46, 0x1f, 0x46, 0x76, 0xe9, 0x60, 0x64, 0x97, 0xbe, 0x8f,
+	0xec, 0xd2, 0xab, 0x8d, 0x20, 0x94, 0xed, 0x6e, 0xcb, 0xf5, 0x79, 0x64, 0x6c, 0xf4, 0xb7, 0x57,
+	0xdf, 0xa4, 0xfd, 0x23, 0x96, 0x72, 0x90, 0x80, 0x68, 0x95, 0xd5, 0xdf, 0xe2, 0xfe, 0x9f, 0x01,
+	0x00, 0x34, 0xc1, 0xe1, 0x53, 0xda, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -340,6 +423,8 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// NextBaseFee queries the projected base fee of the next block.
+	NextBaseFee(ctx context.Context, in *QueryNextBaseFeeRequest, opts ...grpc.CallOption) (*QueryNextBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -377,6 +462,15 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) NextBaseFee(ctx context.Context, in *QueryNextBaseFeeRequest, opts ...grpc.CallOption) (*QueryNextBaseFeeResponse, error) {
+	out := new(QueryNextBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/NextBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -385,6 +479,8 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// NextBaseFee queries the projected base fee of the next block.
+	NextBaseFee(context.Context, *QueryNextBaseFeeRequest) (*QueryNextBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -400,6 +496,9 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) NextBaseFee(ctx context.Context, req *QueryNextBaseFeeRequest) (*QueryNextBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextBaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -459,6 +558,24 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NextBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/NextBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextBaseFee(ctx, req.(*QueryNextBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -475,6 +592,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "NextBaseFee",
+			Handler:    _Query_NextBaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -645,6 +766,64 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryNextBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -719,6 +898,28 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryNextBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1113,6 +1314,142 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryNextBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -45,6 +45,7 @@ func NewTxCmd() *cobra.Command {
 		NewMsgClawbackCmd(),
 		NewMsgUpdateVestingFunderCmd(),
 		NewMsgConvertVestingAccountCmd(),
+		NewMsgCreatePeriodicVestingAccountCmd(),
 	)
 
 	return txCmd
@@ -267,3 +268,64 @@ func NewMsgConvertVestingAccountCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewMsgCreatePeriodicVestingAccountCmd returns a CLI command handler for creating and
+// funding a clawback vesting account with a vesting schedule and no lockup.
+func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account TO_ADDRESS VESTING_PERIODS_FILE ENABLE_GOV_CLAWBACK",
+		Short: "Create a new vesting account funded with a vesting schedule and no lockup.",
+		Long: `A new clawback vesting account is created at the given address, which must not exist yet,
+and funded by the --from address with the vesting schedule of the periods file. The coins are
+unlocked as soon as they vest. Unvested coins may be "clawed back" by the funder with the clawback
+command. Clawback via governance is enabled through the third argument.
+
+A periods file is a JSON object describing a sequence of vesting events, with a start time
+and an array of coins strings and durations relative to the start or previous event.`,
+		Example: `Sample period file contents:
+{
+  "start_time": 1625204910,
+  "periods": [
+    {
+      "coins": "10test",
+      "length_seconds": 2592000 //30 days
+    },
+    {
+      "coins": "10test",
+      "length_seconds": 2592000 //30 days
+    }
+  ]
+}`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			vestingStart, vestingPeriods, err := ReadScheduleFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			enableGovClawback, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(
+				clientCtx.GetFromAddress(), toAddr, time.Unix(vestingStart, 0), vestingPeriods, enableGovClawback,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -185,8 +185,91 @@ func (k Keeper) FundVestingAccount(goCtx context.Context, msg *types.MsgFundVest
 	return &types.MsgFundVestingAccountResponse{}, nil
 }
 
+// CreatePeriodicVestingAccount creates a new ClawbackVestingAccount funded with
+// a vesting schedule and no lockup, so that the tokens are unlocked as soon as
+// they are vested. Unlike CreateClawbackVestingAccount, it's signed by the funder
+// and can only be executed for accounts that don't exist yet.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder and vesting addresses are correct bech32 format
+//   - vesting address is not the zero address
+//   - vesting periods are non-empty and contain valid amounts and lengths
+func (k Keeper) CreatePeriodicVestingAccount(
+	goCtx context.Context,
+	msg *types.MsgCreatePeriodicVestingAccount,
+) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := k.accountKeeper
+	bk := k.bankKeeper
+
+	// Error checked during msg validation
+	funderAddr := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	vestingAddr := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	if bk.BlockedAddr(vestingAddr) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"%s is not allowed to receive funds", msg.VestingAddress,
+		)
+	}
+
+	// NOTE: existing accounts must consent to become vesting accounts through
+	// CreateClawbackVestingAccount, as the funder is the only signer of this msg
+	if ak.GetAccount(ctx, vestingAddr) != nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+			"account %s already exists", msg.VestingAddress,
+		)
+	}
+
+	baseAcc, ok := ak.NewAccountWithAddress(ctx, vestingAddr).(*authtypes.BaseAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+			"account %s could not be created as a base account", msg.VestingAddress,
+		)
+	}
+
+	// the tokens are unlocked at the start time, so that the vesting schedule is
+	// the only restriction on them
+	vestingCoins := msg.VestingPeriods.TotalAmount()
+	lockupPeriods := sdkvesting.Periods{
+		{Length: 0, Amount: vestingCoins},
+	}
+
+	vestingAcc := types.NewClawbackVestingAccount(
+		baseAcc, funderAddr, vestingCoins, msg.StartTime, lockupPeriods, msg.VestingPeriods,
+	)
+	ak.SetAccount(ctx, vestingAcc)
+
+	if !msg.EnableGovClawback {
+		k.SetGovClawbackDisabled(ctx, vestingAddr)
+	}
+
+	// Send coins from the funder to vesting account
+	if err := bk.SendCoins(ctx, funderAddr, vestingAddr, vestingCoins); err != nil {
+		return nil, err
+	}
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "create_periodic_vesting_account", "gas_used",
+	)
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCreatePeriodicVestingAccount,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, vestingCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyStartTime, msg.StartTime.String()),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+			),
+		},
+	)
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
 // Clawback removes the unvested amount from a ClawbackVestingAccount.
-// The destination defaults to the funder address, but can be overridden.
+// The destination defaults to the funder address, but can be overridden,
+// including with the community pool address.
 //
 // Checks performed on the ValidateBasic include:
 //   - funder and vesting addresses are correct bech32 format
@@ -221,7 +304,10 @@ func (k Keeper) Clawback(
 		// NOTE: we check the destination address only for the case where it's not sent from the
 		// authority account, because in that case the destination address is hardcored to the
 		// community pool address anyway (see further below).
-		if bk.BlockedAddr(dest) {
+		//
+		// The funder can also send the clawed back coins to the community pool, in which case
+		// they are funded to it instead of being sent to the blocked distribution module account.
+		if bk.BlockedAddr(dest) && !dest.Equals(ak.GetModuleAddress(distributiontypes.ModuleName)) {
 			return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
 				"%s is a blocked address and not allowed to receive funds", msg.DestAddress,
 			)
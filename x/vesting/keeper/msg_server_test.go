@@ -11,6 +11,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/evmos/evmos/v20/contracts"
@@ -433,6 +434,74 @@ func TestMsgCreateClawbackVestingAccount(t *testing.T) {
 	}
 }
 
+func TestMsgCreatePeriodicVestingAccount(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context, nw *network.UnitTestNetwork, vestingAddr sdk.AccAddress)
+		enableGov   bool
+		expPass     bool
+		errContains string
+	}{
+		{
+			name: "fail - account already exists",
+			malleate: func(ctx sdk.Context, nw *network.UnitTestNetwork, vestingAddr sdk.AccAddress) {
+				acc := nw.App.AccountKeeper.NewAccountWithAddress(ctx, vestingAddr)
+				nw.App.AccountKeeper.SetAccount(ctx, acc)
+			},
+			expPass:     false,
+			errContains: "already exists",
+		},
+		{
+			name:      "pass - with gov clawback",
+			malleate:  func(sdk.Context, *network.UnitTestNetwork, sdk.AccAddress) {},
+			enableGov: true,
+			expPass:   true,
+		},
+		{
+			name:     "pass - without gov clawback",
+			malleate: func(sdk.Context, *network.UnitTestNetwork, sdk.AccAddress) {},
+			expPass:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+			vestingAddr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+			err := testutil.FundAccount(ctx, nw.App.BankKeeper, funder, balances)
+			require.NoError(t, err, "failed to fund funder account")
+
+			tc.malleate(ctx, nw, vestingAddr)
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(funder, vestingAddr, now, vestingPeriods, tc.enableGov)
+			res, err := nw.App.VestingKeeper.CreatePeriodicVestingAccount(ctx, msg)
+			if !tc.expPass {
+				require.ErrorContains(t, err, tc.errContains)
+				require.Nil(t, res)
+				return
+			}
+			require.NoError(t, err)
+
+			vestingAcc, err := nw.App.VestingKeeper.GetClawbackVestingAccount(ctx, vestingAddr)
+			require.NoError(t, err)
+			require.Equal(t, funder.String(), vestingAcc.FunderAddress)
+			require.Equal(t, vestingPeriods, vestingAcc.VestingPeriods)
+
+			// the coins are locked only by the vesting schedule
+			require.Equal(t, balances, vestingAcc.GetVestingCoins(now.Add(time.Second)))
+			require.Empty(t, vestingAcc.GetLockedUpCoins(now.Add(time.Second)))
+			require.Equal(t, quarter, vestingAcc.GetUnlockedVestedCoins(now.Add(2000*time.Second)))
+
+			require.Equal(t, balances, nw.App.BankKeeper.GetAllBalances(ctx, vestingAddr))
+			require.Equal(t, !tc.enableGov, nw.App.VestingKeeper.HasGovClawbackDisabled(ctx, vestingAddr))
+		})
+	}
+}
+
 func TestMsgClawback(t *testing.T) {
 	var (
 		ctx sdk.Context
@@ -547,6 +616,17 @@ func TestMsgClawback(t *testing.T) {
 			initVesting:  true,
 			expPass:      true,
 		},
+		{
+			name:         "pass - with community pool as clawback destination",
+			malleate:     func() {},
+			funder:       funder,
+			vestingAddr:  vestingAddr,
+			clawbackDest: authtypes.NewModuleAddress(distributiontypes.ModuleName),
+			startTime:    now,
+			initClawback: true,
+			initVesting:  true,
+			expPass:      true,
+		},
 		{
 			name:         "pass - without clawback destination",
 			malleate:     func() {},
@@ -593,15 +673,18 @@ func TestMsgClawback(t *testing.T) {
 
 			tc.malleate()
 
+			clawbackDest := tc.clawbackDest
+			if len(clawbackDest) == 0 {
+				clawbackDest = tc.funder
+			}
+			balanceClawBefore := nw.App.BankKeeper.GetBalance(ctx, clawbackDest, baseDenom)
+
 			// Perform clawback
 			msg := types.NewMsgClawback(tc.funder, vestingAddr, tc.clawbackDest)
 			res, err := nw.App.VestingKeeper.Clawback(ctx, msg)
 
 			balanceVestingAcc := nw.App.BankKeeper.GetBalance(ctx, vestingAddr, baseDenom)
-			balanceClaw := nw.App.BankKeeper.GetBalance(ctx, tc.clawbackDest, baseDenom)
-			if len(tc.clawbackDest) == 0 {
-				balanceClaw = nw.App.BankKeeper.GetBalance(ctx, tc.funder, baseDenom)
-			}
+			balanceClaw := nw.App.BankKeeper.GetBalance(ctx, clawbackDest, baseDenom)
 
 			if tc.expPass {
 				require.NoError(t, err)
@@ -609,7 +692,7 @@ func TestMsgClawback(t *testing.T) {
 				expRes := &types.MsgClawbackResponse{Coins: balances}
 				require.Equal(t, expRes, res, "expected full balances to be clawed back")
 				require.Equal(t, sdk.NewInt64Coin(baseDenom, 0), balanceVestingAcc)
-				require.Equal(t, balances[0], balanceClaw)
+				require.Equal(t, balanceClawBefore.Add(balances[0]), balanceClaw)
 			} else {
 				require.Error(t, err)
 				require.ErrorContains(t, err, tc.errContains)
@@ -9,6 +9,7 @@ const (
 	EventTypeFundVestingAccount           = "fund_vesting_account"
 	EventTypeClawback                     = "clawback"
 	EventTypeUpdateVestingFunder          = "update_vesting_funder"
+	EventTypeCreatePeriodicVestingAccount = "create_periodic_vesting_account"
 
 	AttributeKeyCoins       = "coins"
 	AttributeKeyStartTime   = "start_time"
@@ -20,6 +20,7 @@ var (
 	_ sdk.Msg = &MsgClawback{}
 	_ sdk.Msg = &MsgConvertVestingAccount{}
 	_ sdk.Msg = &MsgUpdateVestingFunder{}
+	_ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
 )
 
 const (
@@ -28,6 +29,7 @@ const (
 	TypeMsgClawback                     = "clawback"
 	TypeMsgUpdateVestingFunder          = "update_vesting_funder"
 	TypeMsgConvertVestingAccount        = "convert_vesting_account"
+	TypeMsgCreatePeriodicVestingAccount = "create_periodic_vesting_account"
 )
 
 // NewMsgCreateClawbackVestingAccount creates new instance of MsgCreateClawbackVestingAccount
@@ -273,3 +275,61 @@ func (msg MsgConvertVestingAccount) ValidateBasic() error {
 func (msg *MsgConvertVestingAccount) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
 }
+
+// NewMsgCreatePeriodicVestingAccount creates new instance of MsgCreatePeriodicVestingAccount
+func NewMsgCreatePeriodicVestingAccount(
+	funderAddr, vestingAddr sdk.AccAddress,
+	startTime time.Time,
+	vestingPeriods sdkvesting.Periods,
+	enableGovClawback bool,
+) *MsgCreatePeriodicVestingAccount {
+	return &MsgCreatePeriodicVestingAccount{
+		FunderAddress:     funderAddr.String(),
+		VestingAddress:    vestingAddr.String(),
+		StartTime:         startTime,
+		VestingPeriods:    vestingPeriods,
+		EnableGovClawback: enableGovClawback,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgCreatePeriodicVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreatePeriodicVestingAccount
+func (msg MsgCreatePeriodicVestingAccount) Type() string { return TypeMsgCreatePeriodicVestingAccount }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	vestingAddr, err := sdk.AccAddressFromBech32(msg.VestingAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+
+	if equal := bytes.Compare(vestingAddr.Bytes(), common.Address{}.Bytes()); equal == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "vesting address cannot be the zero address")
+	}
+
+	if len(msg.VestingPeriods) == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting schedule must be present")
+	}
+
+	for i, period := range msg.VestingPeriods {
+		if period.Length < 1 {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+		if !period.Amount.IsValid() || period.Amount.IsZero() {
+			return errortypes.ErrInvalidCoins.Wrap(period.Amount.String())
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCreatePeriodicVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
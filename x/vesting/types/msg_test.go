@@ -488,3 +488,98 @@ func (suite *MsgsTestSuite) TestMsgConvertVestingAccount() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgCreatePeriodicVestingAccountGetters() {
+	msgInvalid := types.MsgCreatePeriodicVestingAccount{}
+	msg := types.NewMsgCreatePeriodicVestingAccount(
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		time.Unix(100200300, 0),
+		sdkvesting.Periods{{Length: 300000, Amount: sdk.Coins{sdk.NewInt64Coin("atom", 10000000)}}},
+		true,
+	)
+	suite.Require().Equal(types.RouterKey, msg.Route())
+	suite.Require().Equal(types.TypeMsgCreatePeriodicVestingAccount, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+}
+
+func (suite *MsgsTestSuite) TestMsgCreatePeriodicVestingAccount() {
+	testCases := []struct {
+		msg            string
+		funderAddr     string
+		vestingAddr    string
+		vestingPeriods sdkvesting.Periods
+		expPass        bool
+	}{
+		{
+			"msg create periodic vesting account - invalid from address",
+			"foo",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdkvesting.Periods{{Length: 300000, Amount: sdk.Coins{sdk.NewInt64Coin("atom", 10000000)}}},
+			false,
+		},
+		{
+			"msg create periodic vesting account - invalid to address",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			"foo",
+			sdkvesting.Periods{{Length: 300000, Amount: sdk.Coins{sdk.NewInt64Coin("atom", 10000000)}}},
+			false,
+		},
+		{
+			"msg create periodic vesting account - zero to address",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(common.Address{}.Bytes()).String(),
+			sdkvesting.Periods{{Length: 300000, Amount: sdk.Coins{sdk.NewInt64Coin("atom", 10000000)}}},
+			false,
+		},
+		{
+			"msg create periodic vesting account - empty vesting periods",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdkvesting.Periods{},
+			false,
+		},
+		{
+			"msg create periodic vesting account - invalid vesting period length",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdkvesting.Periods{{Length: 0, Amount: sdk.Coins{sdk.NewInt64Coin("atom", 10000000)}}},
+			false,
+		},
+		{
+			"msg create periodic vesting account - zero vesting period amount",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdkvesting.Periods{{Length: 300000, Amount: sdk.Coins{}}},
+			false,
+		},
+		{
+			"msg create periodic vesting account - monthly vesting",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdkvesting.Periods{
+				{Length: 2592000, Amount: sdk.Coins{sdk.NewInt64Coin("atom", 10000000)}},
+				{Length: 2592000, Amount: sdk.Coins{sdk.NewInt64Coin("atom", 10000000)}},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tx := types.MsgCreatePeriodicVestingAccount{
+				FunderAddress:  tc.funderAddr,
+				VestingAddress: tc.vestingAddr,
+				StartTime:      time.Unix(100200300, 0),
+				VestingPeriods: tc.vestingPeriods,
+			}
+			err := tx.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err, "failed to validate message")
+			} else {
+				suite.Require().Error(err, "expected message validation to fail")
+			}
+		})
+	}
+}
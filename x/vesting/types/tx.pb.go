@@ -565,6 +565,130 @@ func (m *MsgConvertVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertVestingAccountResponse proto.InternalMessageInfo
 
+// MsgCreatePeriodicVestingAccount defines a message that enables the funder to
+// create and fund a ClawbackVestingAccount with a pure vesting schedule, whose
+// tokens are unlocked as soon as they are vested.
+type MsgCreatePeriodicVestingAccount struct {
+	// funder_address specifies the account that funds the vesting account
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address specifies the new account that receives the funds
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// start_time defines the time at which the vesting period begins
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// vesting_periods defines the vesting schedule relative to the start_time
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+	// enable_gov_clawback specifies whether the governance module can clawback this account
+	EnableGovClawback bool `protobuf:"varint,5,opt,name=enable_gov_clawback,json=enableGovClawback,proto3" json:"enable_gov_clawback,omitempty"`
+}
+
+func (m *MsgCreatePeriodicVestingAccount) Reset()         { *m = MsgCreatePeriodicVestingAccount{} }
+func (m *MsgCreatePeriodicVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePeriodicVestingAccount) ProtoMessage()    {}
+func (*MsgCreatePeriodicVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{10}
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePeriodicVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePeriodicVestingAccount.Merge(m, src)
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePeriodicVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePeriodicVestingAccount proto.InternalMessageInfo
+
+func (m *MsgCreatePeriodicVestingAccount) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgCreatePeriodicVestingAccount) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *MsgCreatePeriodicVestingAccount) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreatePeriodicVestingAccount) GetVestingPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+func (m *MsgCreatePeriodicVestingAccount) GetEnableGovClawback() bool {
+	if m != nil {
+		return m.EnableGovClawback
+	}
+	return false
+}
+
+// MsgCreatePeriodicVestingAccountResponse defines the
+// MsgCreatePeriodicVestingAccount response type.
+type MsgCreatePeriodicVestingAccountResponse struct {
+}
+
+func (m *MsgCreatePeriodicVestingAccountResponse) Reset() {
+	*m = MsgCreatePeriodicVestingAccountResponse{}
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePeriodicVestingAccountResponse) ProtoMessage()    {}
+func (*MsgCreatePeriodicVestingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{11}
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse.Merge(m, src)
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "evmos.vesting.v2.MsgCreateClawbackVestingAccount")
 	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "evmos.vesting.v2.MsgCreateClawbackVestingAccountResponse")
@@ -576,70 +700,76 @@ func init() {
 	proto.RegisterType((*MsgUpdateVestingFunderResponse)(nil), "evmos.vesting.v2.MsgUpdateVestingFunderResponse")
 	proto.RegisterType((*MsgConvertVestingAccount)(nil), "evmos.vesting.v2.MsgConvertVestingAccount")
 	proto.RegisterType((*MsgConvertVestingAccountResponse)(nil), "evmos.vesting.v2.MsgConvertVestingAccountResponse")
+	proto.RegisterType((*MsgCreatePeriodicVestingAccount)(nil), "evmos.vesting.v2.MsgCreatePeriodicVestingAccount")
+	proto.RegisterType((*MsgCreatePeriodicVestingAccountResponse)(nil), "evmos.vesting.v2.MsgCreatePeriodicVestingAccountResponse")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v2/tx.proto", fileDescriptor_a372bb0b868e4c86) }
 
 var fileDescriptor_a372bb0b868e4c86 = []byte{
-	// 918 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4d, 0x6b, 0x24, 0x45,
-	0x18, 0x9e, 0xda, 0xd9, 0x2c, 0xbb, 0x15, 0x13, 0x37, 0x1d, 0x57, 0xb3, 0x6d, 0xd2, 0x33, 0x36,
-	0x86, 0x4c, 0xc6, 0xd8, 0xb5, 0xd3, 0x1b, 0x95, 0x0d, 0x5e, 0x76, 0x22, 0xd1, 0x4b, 0x40, 0x82,
-	0x7a, 0xf0, 0x32, 0xd4, 0x74, 0x57, 0x7a, 0x9b, 0x64, 0xba, 0x9a, 0xa9, 0xea, 0x4e, 0x04, 0x05,
-	0xd9, 0x93, 0x08, 0xe2, 0x82, 0x7f, 0x40, 0x0f, 0x82, 0x28, 0x42, 0x7e, 0xc6, 0x22, 0x22, 0x0b,
-	0x5e, 0x3c, 0xb9, 0x92, 0x28, 0xf1, 0x67, 0x48, 0x7d, 0x74, 0xcd, 0x3a, 0xa9, 0x7c, 0xec, 0x41,
-	0x2f, 0xf3, 0x51, 0xef, 0x53, 0xef, 0xfb, 0xd4, 0xf3, 0xbc, 0xf5, 0x16, 0xbc, 0x49, 0xca, 0x01,
-	0x65, 0xa8, 0x24, 0x8c, 0xa7, 0x59, 0x82, 0xca, 0x10, 0xf1, 0xfd, 0x20, 0x1f, 0x52, 0x4e, 0x9d,
-	0xeb, 0x32, 0x14, 0xe8, 0x50, 0x50, 0x86, 0xee, 0x0c, 0x1e, 0xa4, 0x19, 0x45, 0xf2, 0x53, 0x81,
-	0x5c, 0x2f, 0xa2, 0x4c, 0x24, 0xe8, 0x63, 0x46, 0x50, 0xd9, 0xe9, 0x13, 0x8e, 0x3b, 0x28, 0xa2,
-	0x69, 0xa6, 0xe3, 0x2f, 0xe8, 0xf8, 0x80, 0x25, 0xa8, 0xec, 0x88, 0x2f, 0x1d, 0x78, 0x59, 0x07,
-	0x4c, 0x65, 0xbd, 0xb7, 0x2a, 0xa7, 0x50, 0xcf, 0x25, 0x34, 0xa1, 0xf2, 0x27, 0x12, 0xbf, 0xf4,
-	0xea, 0x7c, 0x42, 0x69, 0xb2, 0x4b, 0x10, 0xce, 0x53, 0x84, 0xb3, 0x8c, 0x72, 0xcc, 0x53, 0x9a,
-	0x31, 0x1d, 0x6d, 0xe8, 0xa8, 0xfc, 0xd7, 0x2f, 0xb6, 0x11, 0x4f, 0x07, 0x84, 0x71, 0x3c, 0xc8,
-	0x15, 0xc0, 0xff, 0x0b, 0xc0, 0xc6, 0x26, 0x4b, 0xd6, 0x87, 0x04, 0x73, 0xb2, 0xbe, 0x8b, 0xf7,
-	0xfa, 0x38, 0xda, 0xf9, 0x40, 0xd5, 0xbd, 0x1b, 0x45, 0xb4, 0xc8, 0xb8, 0xb3, 0x08, 0xa7, 0xb7,
-	0x8b, 0x2c, 0x26, 0xc3, 0x1e, 0x8e, 0xe3, 0x21, 0x61, 0x6c, 0x0e, 0x34, 0x41, 0xeb, 0xda, 0xd6,
-	0x94, 0x5a, 0xbd, 0xab, 0x16, 0x9d, 0x25, 0xf8, 0xac, 0x26, 0x6c, 0x70, 0x97, 0x24, 0x6e, 0x5a,
-	0x2f, 0x57, 0xc0, 0x00, 0xce, 0x92, 0x0c, 0xf7, 0x77, 0x49, 0x2f, 0xa1, 0x65, 0x2f, 0xd2, 0x45,
-	0xe7, 0xea, 0x4d, 0xd0, 0xba, 0xba, 0x35, 0xa3, 0x42, 0x6f, 0xd3, 0xb2, 0x62, 0xb3, 0xd6, 0xfd,
-	0xfb, 0xeb, 0x46, 0xed, 0xfe, 0xf1, 0x41, 0x7b, 0x3c, 0xff, 0xe7, 0xc7, 0x07, 0xed, 0x45, 0x65,
-	0xda, 0x39, 0x67, 0xf0, 0x97, 0xe1, 0xd2, 0x39, 0x90, 0x2d, 0xc2, 0x72, 0x9a, 0x31, 0xe2, 0x7f,
-	0x76, 0x19, 0xde, 0xd8, 0x64, 0xc9, 0x46, 0x91, 0xc5, 0xff, 0xb1, 0x10, 0xef, 0x40, 0xc8, 0x38,
-	0x1e, 0xf2, 0x9e, 0x70, 0x45, 0x9e, 0x7f, 0x32, 0x74, 0x03, 0x65, 0x59, 0x50, 0x59, 0x16, 0xbc,
-	0x57, 0x59, 0xd6, 0x9d, 0x7a, 0xf8, 0x7b, 0xa3, 0xf6, 0xe0, 0x71, 0x03, 0x7c, 0x77, 0x7c, 0xd0,
-	0x06, 0x5b, 0xd7, 0xe4, 0x66, 0x11, 0x76, 0xbe, 0x00, 0x70, 0x7a, 0x97, 0x46, 0x3b, 0x45, 0xde,
-	0xcb, 0xc9, 0x30, 0xa5, 0x31, 0x9b, 0xbb, 0xdc, 0xac, 0xb7, 0x26, 0x43, 0x2f, 0x50, 0xbd, 0x35,
-	0x6a, 0x5d, 0xd5, 0x5b, 0xc1, 0xbb, 0x12, 0xd6, 0xdd, 0x10, 0x29, 0xbf, 0x7f, 0xdc, 0xb8, 0x93,
-	0xa4, 0xfc, 0x5e, 0xd1, 0x0f, 0x22, 0x3a, 0x40, 0xba, 0x1b, 0xd5, 0xd7, 0xab, 0x2c, 0xde, 0x41,
-	0xfb, 0x08, 0x17, 0xfc, 0x9e, 0xe9, 0x4f, 0xfe, 0x51, 0x4e, 0x98, 0xce, 0xc0, 0x14, 0x97, 0x29,
-	0x55, 0x5d, 0xaf, 0x39, 0x5f, 0x82, 0x91, 0x06, 0x15, 0xa1, 0x89, 0xff, 0x95, 0x50, 0xa5, 0xb5,
-	0x5e, 0x5c, 0xbb, 0x2d, 0x1a, 0x68, 0xcc, 0x3e, 0xd1, 0x3f, 0x2f, 0x9a, 0xfe, 0x39, 0x69, 0xb8,
-	0xdf, 0x80, 0x0b, 0xd6, 0x80, 0xe9, 0x95, 0x1f, 0x00, 0x9c, 0x14, 0x7d, 0xa5, 0x3b, 0xea, 0x29,
-	0x3a, 0x04, 0xab, 0x4c, 0xe3, 0x1d, 0xa2, 0x97, 0x2b, 0xe0, 0x4b, 0xf0, 0x99, 0x98, 0xb0, 0x11,
-	0xaa, 0x2e, 0x51, 0x93, 0x62, 0x4d, 0x43, 0xd6, 0x96, 0x4f, 0x39, 0xd8, 0xcc, 0xe8, 0x62, 0x68,
-	0x76, 0xfe, 0x27, 0x70, 0xf6, 0x89, 0xbf, 0xd5, 0x21, 0x9c, 0x6d, 0x38, 0x21, 0xa6, 0x94, 0xe0,
-	0x2a, 0x1c, 0xba, 0x59, 0x39, 0x24, 0xe6, 0x98, 0xb1, 0x67, 0x9d, 0xa6, 0x59, 0xf7, 0x35, 0x6d,
-	0x4e, 0xeb, 0x4c, 0x73, 0x94, 0x1b, 0x62, 0x83, 0xf6, 0x42, 0xa5, 0xf7, 0x7f, 0x06, 0xf0, 0xf9,
-	0x4d, 0x96, 0xbc, 0x9f, 0xc7, 0x98, 0x13, 0x2d, 0xe8, 0x86, 0xe4, 0x7d, 0x51, 0xdd, 0x56, 0xa0,
-	0x93, 0x91, 0xbd, 0xde, 0x18, 0x54, 0x49, 0x77, 0x3d, 0x23, 0x7b, 0x1b, 0xe7, 0xdd, 0xc3, 0xba,
-	0xed, 0x1e, 0xae, 0xad, 0x9e, 0x22, 0xe1, 0xbc, 0x91, 0xd0, 0xc2, 0xd9, 0x6f, 0x42, 0xcf, 0x1e,
-	0x31, 0xdd, 0xf1, 0x31, 0x9c, 0x13, 0x7a, 0xd3, 0xac, 0x24, 0x43, 0x3e, 0x36, 0x4b, 0x2c, 0xe4,
-	0x80, 0x95, 0xdc, 0x1b, 0xa7, 0x4d, 0x3e, 0x6f, 0x64, 0xb0, 0xad, 0x82, 0xef, 0xc3, 0xe6, 0x69,
-	0xb1, 0x8a, 0x61, 0xf8, 0xcb, 0x15, 0x58, 0xdf, 0x64, 0x89, 0xf3, 0x13, 0x80, 0xf3, 0x67, 0xbe,
-	0x01, 0x9d, 0x60, 0xfc, 0x05, 0x0c, 0xce, 0x99, 0xa7, 0xee, 0x9d, 0xa7, 0xde, 0x62, 0x84, 0x7b,
-	0xf3, 0xfe, 0xaf, 0x7f, 0x7e, 0x75, 0xe9, 0x75, 0x67, 0x15, 0x59, 0x9e, 0x64, 0x14, 0xc9, 0x14,
-	0xe6, 0xe1, 0xe8, 0x19, 0x6d, 0x34, 0xd7, 0x6f, 0x00, 0x74, 0x2c, 0xd3, 0x7b, 0xc9, 0xca, 0xe7,
-	0x24, 0xd0, 0x45, 0x17, 0x04, 0x1a, 0xba, 0x1d, 0x49, 0xf7, 0x15, 0x67, 0xd9, 0x4a, 0x57, 0xf4,
-	0xd5, 0x09, 0x8e, 0x7b, 0xf0, 0xaa, 0x19, 0x1a, 0x0b, 0x76, 0xa1, 0x74, 0xd8, 0x5d, 0x3c, 0x33,
-	0x6c, 0x48, 0x2c, 0x4a, 0x12, 0x0d, 0x67, 0xc1, 0xae, 0x59, 0x55, 0xec, 0x5b, 0x00, 0x67, 0x6d,
-	0x37, 0xb0, 0x65, 0xad, 0x62, 0x41, 0xba, 0xb7, 0x2e, 0x8a, 0x34, 0xd4, 0x42, 0x49, 0x6d, 0xc5,
-	0x69, 0x5b, 0xa9, 0x15, 0x72, 0xa7, 0x51, 0x48, 0x5d, 0x43, 0xe7, 0x47, 0x00, 0x6f, 0xd8, 0x6f,
-	0x4e, 0xdb, 0xae, 0x87, 0x0d, 0xeb, 0x86, 0x17, 0xc7, 0x1a, 0xb6, 0xab, 0x92, 0x6d, 0xe0, 0xac,
-	0xd8, 0x85, 0x54, 0x7b, 0xc7, 0x0d, 0x75, 0x27, 0x3e, 0x15, 0xa3, 0xae, 0xfb, 0xd6, 0xc3, 0x43,
-	0x0f, 0x3c, 0x3a, 0xf4, 0xc0, 0x1f, 0x87, 0x1e, 0x78, 0x70, 0xe4, 0xd5, 0x1e, 0x1d, 0x79, 0xb5,
-	0xdf, 0x8e, 0xbc, 0xda, 0x87, 0xed, 0x27, 0x66, 0xa6, 0x4a, 0xac, 0xd3, 0x87, 0xb7, 0xd0, 0xfe,
-	0xbf, 0x5f, 0xb2, 0xfe, 0x15, 0xf9, 0xf8, 0xdf, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x56, 0xd4,
-	0x08, 0x47, 0x92, 0x0a, 0x00, 0x00,
+	// 983 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x6b, 0x24, 0x45,
+	0x14, 0x9e, 0xda, 0x49, 0x24, 0x5b, 0x31, 0x71, 0xd3, 0x71, 0x35, 0xdb, 0x26, 0x3d, 0x63, 0x63,
+	0xc8, 0x64, 0x8c, 0x5d, 0x3b, 0xb3, 0x51, 0x49, 0xd0, 0xc3, 0x4e, 0x24, 0x7a, 0x09, 0x48, 0x50,
+	0x0f, 0x5e, 0x86, 0x9a, 0x9e, 0x4a, 0x6f, 0x93, 0x4c, 0x57, 0x33, 0x55, 0xd3, 0x89, 0xa0, 0x20,
+	0x7b, 0x12, 0x41, 0x5c, 0xf0, 0xe8, 0x45, 0x0f, 0x82, 0x28, 0x42, 0xfe, 0x00, 0xff, 0x80, 0x45,
+	0x3c, 0x2c, 0x78, 0xf1, 0xe4, 0x4a, 0xa2, 0xc4, 0x3f, 0x43, 0xea, 0x47, 0xd7, 0x24, 0x93, 0x9a,
+	0x24, 0x73, 0x50, 0xf1, 0x32, 0xd3, 0x5d, 0xef, 0xab, 0x7a, 0x5f, 0xbd, 0xef, 0xab, 0x57, 0x0d,
+	0x6f, 0x91, 0xac, 0x43, 0x19, 0xca, 0x08, 0xe3, 0x71, 0x12, 0xa1, 0xac, 0x8e, 0xf8, 0x41, 0x90,
+	0x76, 0x29, 0xa7, 0xce, 0x0d, 0x19, 0x0a, 0x74, 0x28, 0xc8, 0xea, 0xee, 0x0c, 0xee, 0xc4, 0x09,
+	0x45, 0xf2, 0x57, 0x81, 0x5c, 0x2f, 0xa4, 0x4c, 0x2c, 0xd0, 0xc2, 0x8c, 0xa0, 0xac, 0xd6, 0x22,
+	0x1c, 0xd7, 0x50, 0x48, 0xe3, 0x44, 0xc7, 0x9f, 0xd5, 0xf1, 0x0e, 0x8b, 0x50, 0x56, 0x13, 0x7f,
+	0x3a, 0xf0, 0x82, 0x0e, 0x98, 0xcc, 0x7a, 0x6e, 0x9e, 0x4e, 0xa1, 0x9e, 0x8e, 0x68, 0x44, 0xe5,
+	0x23, 0x12, 0x4f, 0x7a, 0x74, 0x3e, 0xa2, 0x34, 0xda, 0x23, 0x08, 0xa7, 0x31, 0xc2, 0x49, 0x42,
+	0x39, 0xe6, 0x31, 0x4d, 0x98, 0x8e, 0x96, 0x74, 0x54, 0xbe, 0xb5, 0x7a, 0x3b, 0x88, 0xc7, 0x1d,
+	0xc2, 0x38, 0xee, 0xa4, 0x0a, 0xe0, 0xff, 0x09, 0x60, 0x69, 0x8b, 0x45, 0x1b, 0x5d, 0x82, 0x39,
+	0xd9, 0xd8, 0xc3, 0xfb, 0x2d, 0x1c, 0xee, 0xbe, 0xa7, 0xf2, 0xde, 0x0d, 0x43, 0xda, 0x4b, 0xb8,
+	0xb3, 0x08, 0xa7, 0x77, 0x7a, 0x49, 0x9b, 0x74, 0x9b, 0xb8, 0xdd, 0xee, 0x12, 0xc6, 0xe6, 0x40,
+	0x19, 0x54, 0xae, 0x6f, 0x4f, 0xa9, 0xd1, 0xbb, 0x6a, 0xd0, 0x59, 0x82, 0x4f, 0x69, 0xc2, 0x06,
+	0x77, 0x4d, 0xe2, 0xa6, 0xf5, 0x70, 0x0e, 0x0c, 0xe0, 0x2c, 0x49, 0x70, 0x6b, 0x8f, 0x34, 0x23,
+	0x9a, 0x35, 0x43, 0x9d, 0x74, 0xae, 0x58, 0x06, 0x95, 0x89, 0xed, 0x19, 0x15, 0x7a, 0x93, 0x66,
+	0x39, 0x9b, 0xf5, 0xc6, 0x5f, 0x5f, 0x95, 0x0a, 0xf7, 0x4f, 0x0e, 0xab, 0x83, 0xeb, 0x7f, 0x7a,
+	0x72, 0x58, 0x5d, 0x54, 0xa2, 0x5d, 0xb2, 0x07, 0x7f, 0x19, 0x2e, 0x5d, 0x02, 0xd9, 0x26, 0x2c,
+	0xa5, 0x09, 0x23, 0xfe, 0x27, 0x63, 0xf0, 0xe6, 0x16, 0x8b, 0x36, 0x7b, 0x49, 0xfb, 0x1f, 0x2e,
+	0xc4, 0x5b, 0x10, 0x32, 0x8e, 0xbb, 0xbc, 0x29, 0x54, 0x91, 0xfb, 0x9f, 0xac, 0xbb, 0x81, 0x92,
+	0x2c, 0xc8, 0x25, 0x0b, 0xde, 0xc9, 0x25, 0x6b, 0x4c, 0x3d, 0xfc, 0xad, 0x54, 0x78, 0xf0, 0xb8,
+	0x04, 0xbe, 0x3d, 0x39, 0xac, 0x82, 0xed, 0xeb, 0x72, 0xb2, 0x08, 0x3b, 0x9f, 0x01, 0x38, 0xbd,
+	0x47, 0xc3, 0xdd, 0x5e, 0xda, 0x4c, 0x49, 0x37, 0xa6, 0x6d, 0x36, 0x37, 0x56, 0x2e, 0x56, 0x26,
+	0xeb, 0x5e, 0xa0, 0xbc, 0xd5, 0xb7, 0xae, 0xf2, 0x56, 0xf0, 0xb6, 0x84, 0x35, 0x36, 0xc5, 0x92,
+	0xdf, 0x3d, 0x2e, 0xad, 0x45, 0x31, 0xbf, 0xd7, 0x6b, 0x05, 0x21, 0xed, 0x20, 0xed, 0x46, 0xf5,
+	0xf7, 0x12, 0x6b, 0xef, 0xa2, 0x03, 0x84, 0x7b, 0xfc, 0x9e, 0xf1, 0x27, 0xff, 0x20, 0x25, 0x4c,
+	0xaf, 0xc0, 0x14, 0x97, 0x29, 0x95, 0x5d, 0x8f, 0x39, 0x9f, 0x83, 0x7e, 0x0d, 0x72, 0x42, 0xe3,
+	0xff, 0x2a, 0xa1, 0xbc, 0xd6, 0x7a, 0x70, 0xfd, 0x8e, 0x30, 0xd0, 0x80, 0x7c, 0xc2, 0x3f, 0xcf,
+	0x19, 0xff, 0x9c, 0x17, 0xdc, 0x2f, 0xc1, 0x05, 0x6b, 0xc0, 0x78, 0xe5, 0x7b, 0x00, 0x27, 0x85,
+	0xaf, 0xb4, 0xa3, 0x46, 0x70, 0x08, 0x56, 0x2b, 0x0d, 0x3a, 0x44, 0x0f, 0xe7, 0xc0, 0xe7, 0xe1,
+	0x93, 0x6d, 0xc2, 0xfa, 0xa8, 0xa2, 0x44, 0x4d, 0x8a, 0x31, 0x0d, 0x59, 0x5f, 0x1e, 0xb2, 0xb1,
+	0x99, 0xfe, 0xc1, 0xd0, 0xec, 0xfc, 0x8f, 0xe0, 0xec, 0xa9, 0xd7, 0x7c, 0x13, 0xce, 0x0e, 0x1c,
+	0x17, 0x5d, 0x4a, 0x70, 0x15, 0x0a, 0xdd, 0xca, 0x15, 0x12, 0x7d, 0xcc, 0xc8, 0xb3, 0x41, 0xe3,
+	0xa4, 0xf1, 0xb2, 0x16, 0xa7, 0x72, 0xa1, 0x38, 0x4a, 0x0d, 0x31, 0x41, 0x6b, 0xa1, 0x96, 0xf7,
+	0x7f, 0x06, 0xf0, 0x99, 0x2d, 0x16, 0xbd, 0x9b, 0xb6, 0x31, 0x27, 0xba, 0xa0, 0x9b, 0x92, 0xf7,
+	0x55, 0xeb, 0xb6, 0x02, 0x9d, 0x84, 0xec, 0x37, 0x07, 0xa0, 0xaa, 0x74, 0x37, 0x12, 0xb2, 0xbf,
+	0x79, 0xd9, 0x39, 0x2c, 0xda, 0xce, 0xe1, 0xfa, 0xea, 0x90, 0x12, 0xce, 0x9b, 0x12, 0x5a, 0x38,
+	0xfb, 0x65, 0xe8, 0xd9, 0x23, 0xc6, 0x1d, 0x1f, 0xc2, 0x39, 0x51, 0x6f, 0x9a, 0x64, 0xa4, 0xcb,
+	0x07, 0x7a, 0x89, 0x85, 0x1c, 0xb0, 0x92, 0x7b, 0x75, 0x58, 0xe7, 0xf3, 0xfa, 0x02, 0xdb, 0x32,
+	0xf8, 0x3e, 0x2c, 0x0f, 0x8b, 0x19, 0x86, 0x3f, 0x16, 0x4f, 0xb5, 0x7f, 0x75, 0x54, 0xe2, 0xf0,
+	0x7f, 0xd3, 0xf5, 0x6c, 0x5d, 0x66, 0xec, 0xbf, 0xec, 0x32, 0xc3, 0xae, 0xb6, 0xf1, 0x61, 0x57,
+	0xdb, 0xeb, 0x43, 0x9c, 0x37, 0x78, 0xab, 0xd9, 0xa5, 0x39, 0x73, 0xab, 0xd9, 0x21, 0xb9, 0xd2,
+	0xf5, 0x2f, 0x27, 0x60, 0x71, 0x8b, 0x45, 0xce, 0x4f, 0x00, 0xce, 0x5f, 0x78, 0xdb, 0xd7, 0x82,
+	0xc1, 0x6f, 0x9d, 0xe0, 0x92, 0x9b, 0xd3, 0x5d, 0x1b, 0x79, 0x8a, 0x31, 0xe0, 0x6b, 0xf7, 0x7f,
+	0xf9, 0xe3, 0x8b, 0x6b, 0xaf, 0x38, 0xab, 0xc8, 0xf2, 0xf1, 0x85, 0x42, 0xb9, 0x84, 0xa9, 0x63,
+	0xd3, 0x18, 0x4c, 0x73, 0xfd, 0x1a, 0x40, 0xc7, 0x72, 0x4f, 0x2f, 0x59, 0xf9, 0x9c, 0x07, 0xba,
+	0xe8, 0x8a, 0x40, 0x43, 0xb7, 0x26, 0xe9, 0xbe, 0xe8, 0x2c, 0x5b, 0xe9, 0x0a, 0x1d, 0xcf, 0x71,
+	0xdc, 0x87, 0x13, 0xe6, 0x7a, 0x58, 0xb0, 0x17, 0x4a, 0x87, 0xdd, 0xc5, 0x0b, 0xc3, 0x86, 0xc4,
+	0xa2, 0x24, 0x51, 0x72, 0x16, 0xec, 0x35, 0xcb, 0x93, 0x7d, 0x03, 0xe0, 0xac, 0xad, 0xd7, 0x56,
+	0xac, 0x59, 0x2c, 0x48, 0xf7, 0xf6, 0x55, 0x91, 0x86, 0x5a, 0x5d, 0x52, 0x5b, 0x71, 0xaa, 0x56,
+	0x6a, 0x3d, 0x39, 0xd3, 0x54, 0x48, 0xd9, 0xde, 0xf9, 0x01, 0xc0, 0x9b, 0xf6, 0x1e, 0x59, 0xb5,
+	0xd7, 0xc3, 0x86, 0x75, 0xeb, 0x57, 0xc7, 0x1a, 0xb6, 0xab, 0x92, 0x6d, 0xe0, 0xac, 0xd8, 0x0b,
+	0xa9, 0xe6, 0x9e, 0x13, 0xb4, 0x7f, 0x82, 0x86, 0x34, 0xcc, 0x8b, 0x4e, 0x90, 0x7d, 0x8a, 0xbb,
+	0x36, 0xf2, 0x94, 0xd1, 0x4e, 0x50, 0xaa, 0xd7, 0x18, 0xdc, 0x8c, 0x3b, 0xfe, 0xb1, 0xe8, 0x63,
+	0x8d, 0x37, 0x1e, 0x1e, 0x79, 0xe0, 0xd1, 0x91, 0x07, 0x7e, 0x3f, 0xf2, 0xc0, 0x83, 0x63, 0xaf,
+	0xf0, 0xe8, 0xd8, 0x2b, 0xfc, 0x7a, 0xec, 0x15, 0xde, 0xaf, 0x9e, 0xea, 0x90, 0x2a, 0x81, 0x4e,
+	0x53, 0xbf, 0x8d, 0x0e, 0xce, 0xb6, 0xc6, 0xd6, 0x13, 0xb2, 0x7b, 0xdf, 0xf9, 0x7b, 0x00, 0x86,
+	0x1c, 0x86, 0x00, 0x49, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -666,6 +796,9 @@ type MsgClient interface {
 	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+	// CreatePeriodicVestingAccount creates and funds a ClawbackVestingAccount
+	// with a vesting schedule and no lockup.
+	CreatePeriodicVestingAccount(ctx context.Context, in *MsgCreatePeriodicVestingAccount, opts ...grpc.CallOption) (*MsgCreatePeriodicVestingAccountResponse, error)
 }
 
 type msgClient struct {
@@ -721,6 +854,15 @@ func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVes
 	return out, nil
 }
 
+func (c *msgClient) CreatePeriodicVestingAccount(ctx context.Context, in *MsgCreatePeriodicVestingAccount, opts ...grpc.CallOption) (*MsgCreatePeriodicVestingAccountResponse, error) {
+	out := new(MsgCreatePeriodicVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/CreatePeriodicVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
@@ -735,6 +877,9 @@ type MsgServer interface {
 	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+	// CreatePeriodicVestingAccount creates and funds a ClawbackVestingAccount
+	// with a vesting schedule and no lockup.
+	CreatePeriodicVestingAccount(context.Context, *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -756,6 +901,9 @@ func (*UnimplementedMsgServer) UpdateVestingFunder(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) ConvertVestingAccount(ctx context.Context, req *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
 }
+func (*UnimplementedMsgServer) CreatePeriodicVestingAccount(ctx context.Context, req *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePeriodicVestingAccount not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -851,6 +999,24 @@ func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreatePeriodicVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePeriodicVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePeriodicVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/CreatePeriodicVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePeriodicVestingAccount(ctx, req.(*MsgCreatePeriodicVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.vesting.v2.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -875,6 +1041,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertVestingAccount",
 			Handler:    _Msg_ConvertVestingAccount_Handler,
 		},
+		{
+			MethodName: "CreatePeriodicVestingAccount",
+			Handler:    _Msg_CreatePeriodicVestingAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v2/tx.proto",
@@ -1247,6 +1417,98 @@ func (m *MsgConvertVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCreatePeriodicVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePeriodicVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnableGovClawback {
+		i--
+		if m.EnableGovClawback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePeriodicVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePeriodicVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePeriodicVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1415,6 +1677,43 @@ func (m *MsgConvertVestingAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreatePeriodicVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.EnableGovClawback {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreatePeriodicVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2428,6 +2727,257 @@ func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovClawback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableGovClawback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0